@@ -184,7 +184,7 @@ export const FILTERS: Record<NegatableFilter, NegatableFilterDefinition> &
     Record<Exclude<FilterType, NegatableFilter>, BaseFilterDefinition> = {
     [FilterType.after]: {
         alias: 'since',
-        description: 'Commits made after a certain date (in UTC)',
+        description: 'Commits, or matched lines last changed, after a certain date (in UTC)',
         placeholder: '"time frame"',
     },
     [FilterType.archived]: {
@@ -194,12 +194,13 @@ export const FILTERS: Record<NegatableFilter, NegatableFilterDefinition> &
     },
     [FilterType.author]: {
         negatable: true,
-        description: negated => `${negated ? 'Exclude' : 'Include only'} commits or diffs authored by a user.`,
+        description: negated =>
+            `${negated ? 'Exclude' : 'Include only'} commits, diffs or matched lines authored by a user.`,
         placeholder: '"author name/email"',
     },
     [FilterType.before]: {
         alias: 'until',
-        description: 'Commits made before a certain date (in UTC)',
+        description: 'Commits, or matched lines last changed, before a certain date (in UTC)',
         placeholder: '"time frame"',
     },
     [FilterType.case]: {
@@ -41,6 +41,7 @@ export interface PathMatch {
     repoLastFetched?: string
     branches?: string[]
     commit?: string
+    owners?: string[]
     debug?: string
 }
 
@@ -56,6 +57,7 @@ export interface ContentMatch {
     lineMatches?: LineMatch[]
     chunkMatches?: ChunkMatch[]
     hunks?: DecoratedHunk[]
+    owners?: string[]
     debug?: string
 }
 
@@ -6,8 +6,10 @@ import BitbucketIcon from 'mdi-react/BitbucketIcon'
 import GithubIcon from 'mdi-react/GithubIcon'
 import GitIcon from 'mdi-react/GitIcon'
 import GitLabIcon from 'mdi-react/GitlabIcon'
+import LanguageCsharpIcon from 'mdi-react/LanguageCsharpIcon'
 import LanguageGoIcon from 'mdi-react/LanguageGoIcon'
 import LanguageJavaIcon from 'mdi-react/LanguageJavaIcon'
+import LanguagePhpIcon from 'mdi-react/LanguagePhpIcon'
 import LanguagePythonIcon from 'mdi-react/LanguagePythonIcon'
 import LanguageRubyIcon from 'mdi-react/LanguageRubyIcon'
 import LanguageRustIcon from 'mdi-react/LanguageRustIcon'
@@ -17,6 +19,7 @@ import { PerforceIcon, PhabricatorIcon } from '@sourcegraph/shared/src/component
 import { Link, Code, Text } from '@sourcegraph/wildcard'
 
 import awsCodeCommitSchemaJSON from '../../../../../schema/aws_codecommit.schema.json'
+import azureDevOpsSchemaJSON from '../../../../../schema/azuredevops.schema.json'
 import bitbucketCloudSchemaJSON from '../../../../../schema/bitbucket_cloud.schema.json'
 import bitbucketServerSchemaJSON from '../../../../../schema/bitbucket_server.schema.json'
 import gerritSchemaJSON from '../../../../../schema/gerrit.schema.json'
@@ -26,10 +29,12 @@ import gitoliteSchemaJSON from '../../../../../schema/gitolite.schema.json'
 import goModulesSchemaJSON from '../../../../../schema/go-modules.schema.json'
 import jvmPackagesSchemaJSON from '../../../../../schema/jvm-packages.schema.json'
 import npmPackagesSchemaJSON from '../../../../../schema/npm-packages.schema.json'
+import nugetPackagesSchemaJSON from '../../../../../schema/nuget-packages.schema.json'
 import otherExternalServiceSchemaJSON from '../../../../../schema/other_external_service.schema.json'
 import pagureSchemaJSON from '../../../../../schema/pagure.schema.json'
 import perforceSchemaJSON from '../../../../../schema/perforce.schema.json'
 import phabricatorSchemaJSON from '../../../../../schema/phabricator.schema.json'
+import phpPackagesSchemaJSON from '../../../../../schema/php-packages.schema.json'
 import pythonPackagesJSON from '../../../../../schema/python-packages.schema.json'
 import rubyPackagesSchemaJSON from '../../../../../schema/ruby-packages.schema.json'
 import rustPackagesJSON from '../../../../../schema/rust-packages.schema.json'
@@ -1257,6 +1262,43 @@ const PAGURE: AddExternalServiceOptions = {
     editorActions: [],
 }
 
+const AZURE_DEVOPS: AddExternalServiceOptions = {
+    kind: ExternalServiceKind.AZUREDEVOPS,
+    title: 'Azure DevOps',
+    icon: GitIcon,
+    jsonSchema: azureDevOpsSchemaJSON,
+    defaultDisplayName: 'Azure DevOps',
+    defaultConfig: `{
+  "url": "https://dev.azure.com",
+  "username": "<username>",
+  "token": "<personal access token>",
+  "orgs": [],
+  "projects": []
+}`,
+    instructions: (
+        <div>
+            <ol>
+                <li>
+                    In the configuration below, set <Field>username</Field> to the user name of the account used to
+                    access Azure DevOps.
+                </li>
+                <li>
+                    Create a personal access token with the <Code>Code (Read)</Code> scope and set it as{' '}
+                    <Field>token</Field>. The <Code>Project and Team (Read)</Code>, <Code>Identity (Read)</Code> and{' '}
+                    <Code>Member Entitlement Management (Read)</Code> scopes are also required to enforce
+                    repository permissions.
+                </li>
+                <li>
+                    Set <Field>orgs</Field> to sync all repositories of the listed organizations, or{' '}
+                    <Field>projects</Field> to sync the repositories of specific projects, given as{' '}
+                    <Code>"org/project"</Code>.
+                </li>
+            </ol>
+        </div>
+    ),
+    editorActions: [],
+}
+
 const GERRIT: AddExternalServiceOptions = {
     kind: ExternalServiceKind.GERRIT,
     title: 'Gerrit',
@@ -1438,6 +1480,72 @@ const RUBY_PACKAGES: AddExternalServiceOptions = {
     editorActions: [],
 }
 
+const NUGET_PACKAGES: AddExternalServiceOptions = {
+    kind: ExternalServiceKind.NUGETPACKAGES,
+    title: 'NuGet Dependencies',
+    icon: LanguageCsharpIcon,
+    jsonSchema: nugetPackagesSchemaJSON,
+    defaultDisplayName: 'NuGet Dependencies',
+    defaultConfig: `{
+  "repository": "https://api.nuget.org/v3-flatcontainer/",
+  "dependencies": ["Newtonsoft.Json@13.0.1"]
+}`,
+    instructions: (
+        <div>
+            <ol>
+                <li>
+                    The URL https://api.nuget.org/v3-flatcontainer/ is used if the field
+                    <Code>"repository"</Code> is empty. Other repositories must implement the NuGet package content
+                    resource (flat container).
+                </li>
+                <li>
+                    Use the syntax <Code>"PACKAGE_ID@VERSION"</Code> to list a dependency for the{' '}
+                    <Code>"dependencies"</Code> field.
+                </li>
+                <li>
+                    The field <Code>"repository"</Code> is redacted because it can include <Code>admin:password</Code>{' '}
+                    credentials.
+                </li>
+            </ol>
+            <Text>⚠️ NuGet package repositories are visible by all users of the Sourcegraph instance.</Text>
+        </div>
+    ),
+    editorActions: [],
+}
+
+const PHP_PACKAGES: AddExternalServiceOptions = {
+    kind: ExternalServiceKind.PHPPACKAGES,
+    title: 'PHP Dependencies',
+    icon: LanguagePhpIcon,
+    jsonSchema: phpPackagesSchemaJSON,
+    defaultDisplayName: 'PHP Dependencies',
+    defaultConfig: `{
+  "repository": "https://repo.packagist.org/",
+  "dependencies": ["monolog/monolog@3.2.0"]
+}`,
+    instructions: (
+        <div>
+            <ol>
+                <li>
+                    The URL https://repo.packagist.org/ is used if the field
+                    <Code>"repository"</Code> is empty. Other repositories must implement the Composer v2 metadata
+                    API.
+                </li>
+                <li>
+                    Use the syntax <Code>"VENDOR/PACKAGE@VERSION"</Code> to list a dependency for the{' '}
+                    <Code>"dependencies"</Code> field.
+                </li>
+                <li>
+                    The field <Code>"repository"</Code> is redacted because it can include <Code>admin:password</Code>{' '}
+                    credentials.
+                </li>
+            </ol>
+            <Text>⚠️ PHP package repositories are visible by all users of the Sourcegraph instance.</Text>
+        </div>
+    ),
+    editorActions: [],
+}
+
 export const codeHostExternalServices: Record<string, AddExternalServiceOptions> = {
     github: GITHUB_DOTCOM,
     ghe: GITHUB_ENTERPRISE,
@@ -1446,12 +1554,15 @@ export const codeHostExternalServices: Record<string, AddExternalServiceOptions>
     bitbucket: BITBUCKET_CLOUD,
     bitbucketserver: BITBUCKET_SERVER,
     aws_codecommit: AWS_CODE_COMMIT,
+    azuredevops: AZURE_DEVOPS,
     srcservegit: SRC_SERVE_GIT,
     gitolite: GITOLITE,
     git: GENERIC_GIT,
     ...(window.context?.experimentalFeatures?.pythonPackages === 'enabled' ? { pythonPackages: PYTHON_PACKAGES } : {}),
     ...(window.context?.experimentalFeatures?.rustPackages === 'enabled' ? { rustPackages: RUST_PACKAGES } : {}),
     ...(window.context?.experimentalFeatures?.rubyPackages === 'enabled' ? { rubyPackages: RUBY_PACKAGES } : {}),
+    ...(window.context?.experimentalFeatures?.nugetPackages === 'enabled' ? { nugetPackages: NUGET_PACKAGES } : {}),
+    ...(window.context?.experimentalFeatures?.phpPackages === 'enabled' ? { phpPackages: PHP_PACKAGES } : {}),
     ...(window.context?.experimentalFeatures?.goPackages === 'enabled' ? { goModules: GO_MODULES } : {}),
     ...(window.context?.experimentalFeatures?.jvmPackages === 'enabled' ? { jvmPackages: JVM_PACKAGES } : {}),
     ...(window.context?.experimentalFeatures?.npmPackages === 'enabled' ? { npmPackages: NPM_PACKAGES } : {}),
@@ -1478,6 +1589,7 @@ export const defaultExternalServices: Record<ExternalServiceKind, AddExternalSer
     [ExternalServiceKind.PHABRICATOR]: PHABRICATOR_SERVICE,
     [ExternalServiceKind.OTHER]: GENERIC_GIT,
     [ExternalServiceKind.AWSCODECOMMIT]: AWS_CODE_COMMIT,
+    [ExternalServiceKind.AZUREDEVOPS]: AZURE_DEVOPS,
     [ExternalServiceKind.PERFORCE]: PERFORCE,
     [ExternalServiceKind.GERRIT]: GERRIT,
     [ExternalServiceKind.PAGURE]: PAGURE,
@@ -1487,6 +1599,8 @@ export const defaultExternalServices: Record<ExternalServiceKind, AddExternalSer
     [ExternalServiceKind.PYTHONPACKAGES]: PYTHON_PACKAGES,
     [ExternalServiceKind.RUSTPACKAGES]: RUST_PACKAGES,
     [ExternalServiceKind.RUBYPACKAGES]: RUBY_PACKAGES,
+    [ExternalServiceKind.NUGETPACKAGES]: NUGET_PACKAGES,
+    [ExternalServiceKind.PHPPACKAGES]: PHP_PACKAGES,
 }
 
 export const externalRepoIcon = (
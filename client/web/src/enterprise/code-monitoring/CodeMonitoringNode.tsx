@@ -69,6 +69,8 @@ export const CodeMonitorNode: React.FunctionComponent<React.PropsWithChildren<Co
                             return 'Sends email notification'
                         case 'MonitorSlackWebhook':
                             return 'Sends Slack notification'
+                        case 'MonitorTeamsWebhook':
+                            return 'Sends Microsoft Teams notification'
                         case 'MonitorWebhook':
                             return 'Calls webhook'
                         default:
@@ -8,8 +8,10 @@ import {
     MonitorEmailPriority,
     MonitorWebhookInput,
     MonitorSlackWebhookInput,
+    MonitorTeamsWebhookInput,
     MonitorWebhookFields,
     MonitorSlackWebhookFields,
+    MonitorTeamsWebhookFields,
     MonitorEmailFields,
 } from '../../graphql-operations'
 
@@ -34,6 +36,14 @@ function convertSlackWebhookAction(action: MonitorSlackWebhookFields): MonitorSl
     }
 }
 
+function convertTeamsWebhookAction(action: MonitorTeamsWebhookFields): MonitorTeamsWebhookInput {
+    return {
+        enabled: action.enabled,
+        includeResults: action.includeResults,
+        url: action.url,
+    }
+}
+
 function convertWebhookAction(action: MonitorWebhookFields): MonitorWebhookInput {
     return {
         enabled: action.enabled,
@@ -60,6 +70,10 @@ export function convertActionsForCreate(
                 return {
                     webhook: convertWebhookAction(action),
                 }
+            case 'MonitorTeamsWebhook':
+                return {
+                    teamsWebhook: convertTeamsWebhookAction(action),
+                }
         }
     })
 }
@@ -92,6 +106,13 @@ export function convertActionsForUpdate(
                         update: convertWebhookAction(action),
                     },
                 }
+            case 'MonitorTeamsWebhook':
+                return {
+                    teamsWebhook: {
+                        id: action.id || null,
+                        update: convertTeamsWebhookAction(action),
+                    },
+                }
         }
     })
 }
@@ -61,6 +61,16 @@ const MonitorSlackWebhookFragment = gql`
     }
 `
 
+const MonitorTeamsWebhookFragment = gql`
+    fragment MonitorTeamsWebhookFields on MonitorTeamsWebhook {
+        __typename
+        id
+        enabled
+        includeResults
+        url
+    }
+`
+
 const CodeMonitorFragment = gql`
     fragment CodeMonitorFields on Monitor {
         id
@@ -78,12 +88,14 @@ const CodeMonitorFragment = gql`
                 ...MonitorEmailFields
                 ...MonitorWebhookFields
                 ...MonitorSlackWebhookFields
+                ...MonitorTeamsWebhookFields
             }
         }
     }
     ${MonitorEmailFragment}
     ${MonitorWebhookFragment}
     ${MonitorSlackWebhookFragment}
+    ${MonitorTeamsWebhookFragment}
 `
 
 const ListCodeMonitorsFragment = gql`
@@ -227,6 +239,12 @@ export const fetchCodeMonitor = (id: string): Observable<FetchCodeMonitorResult>
                                 includeResults
                                 url
                             }
+                            ... on MonitorTeamsWebhook {
+                                id
+                                enabled
+                                includeResults
+                                url
+                            }
                         }
                     }
                     trigger {
@@ -8,6 +8,7 @@ import { useExperimentalFeatures } from '../../../stores'
 
 import { EmailAction } from './actions/EmailAction'
 import { SlackWebhookAction } from './actions/SlackWebhookAction'
+import { TeamsWebhookAction } from './actions/TeamsWebhookAction'
 import { WebhookAction } from './actions/WebhookAction'
 
 export interface ActionAreaProps {
@@ -57,10 +58,14 @@ export const FormActionArea: React.FunctionComponent<React.PropsWithChildren<Act
         actions.nodes.find(action => action.__typename === 'MonitorWebhook')
     )
 
+    const [teamsWebhookAction, setTeamsWebhookAction] = useState<MonitorAction | undefined>(
+        actions.nodes.find(action => action.__typename === 'MonitorTeamsWebhook')
+    )
+
     // Form is completed if there is at least one action
     useEffect(() => {
-        setActionsCompleted(!!emailAction || !!slackWebhookAction || !!webhookAction)
-    }, [emailAction, setActionsCompleted, slackWebhookAction, webhookAction])
+        setActionsCompleted(!!emailAction || !!slackWebhookAction || !!webhookAction || !!teamsWebhookAction)
+    }, [emailAction, setActionsCompleted, slackWebhookAction, webhookAction, teamsWebhookAction])
 
     useEffect(() => {
         const actions: CodeMonitorFields['actions'] = { nodes: [] }
@@ -73,8 +78,11 @@ export const FormActionArea: React.FunctionComponent<React.PropsWithChildren<Act
         if (webhookAction) {
             actions.nodes.push(webhookAction)
         }
+        if (teamsWebhookAction) {
+            actions.nodes.push(teamsWebhookAction)
+        }
         onActionsChange(actions)
-    }, [emailAction, onActionsChange, slackWebhookAction, webhookAction])
+    }, [emailAction, onActionsChange, slackWebhookAction, webhookAction, teamsWebhookAction])
 
     const showWebhooks = useExperimentalFeatures(features => features.codeMonitoringWebHooks)
 
@@ -111,6 +119,16 @@ export const FormActionArea: React.FunctionComponent<React.PropsWithChildren<Act
                 />
             )}
 
+            {(showWebhooks || teamsWebhookAction) && (
+                <TeamsWebhookAction
+                    disabled={disabled}
+                    action={teamsWebhookAction}
+                    setAction={setTeamsWebhookAction}
+                    monitorName={monitorName}
+                    authenticatedUser={authenticatedUser}
+                />
+            )}
+
             <small className="text-muted">
                 What other actions would you like to take?{' '}
                 <Link to="mailto:feedback@sourcegraph.com" target="_blank" rel="noopener">
@@ -29,7 +29,7 @@ interface TriggerAreaProps extends ThemeProps {
     isSourcegraphDotCom: boolean
 }
 
-const isDiffOrCommit = (value: string): boolean => value === 'diff' || value === 'commit'
+const isMonitorableType = (value: string): boolean => value === 'diff' || value === 'commit' || value === 'file'
 const isLiteralOrRegexp = (value: string): boolean => value === 'literal' || value === 'regexp'
 
 const ValidQueryChecklistItem: React.FunctionComponent<
@@ -112,17 +112,17 @@ export const FormTriggerArea: React.FunctionComponent<React.PropsWithChildren<Tr
     }, [])
 
     const [isValidQuery, setIsValidQuery] = useState(false)
-    const [hasTypeDiffOrCommitFilter, setHasTypeDiffOrCommitFilter] = useState(false)
+    const [hasMonitorableTypeFilter, setHasMonitorableTypeFilter] = useState(false)
     const [hasRepoFilter, setHasRepoFilter] = useState(false)
     const [hasPatternTypeFilter, setHasPatternTypeFilter] = useState(false)
     const [hasValidPatternTypeFilter, setHasValidPatternTypeFilter] = useState(true)
     const isTriggerQueryComplete = useMemo(
         () =>
             isValidQuery &&
-            hasTypeDiffOrCommitFilter &&
+            hasMonitorableTypeFilter &&
             (!isSourcegraphDotCom || hasRepoFilter) &&
             hasValidPatternTypeFilter,
-        [hasRepoFilter, hasTypeDiffOrCommitFilter, hasValidPatternTypeFilter, isValidQuery, isSourcegraphDotCom]
+        [hasRepoFilter, hasMonitorableTypeFilter, hasValidPatternTypeFilter, isValidQuery, isSourcegraphDotCom]
     )
 
     const [queryState, setQueryState] = useState<QueryState>({ query: query || '' })
@@ -138,19 +138,19 @@ export const FormTriggerArea: React.FunctionComponent<React.PropsWithChildren<Tr
         const isValidQuery = !!value && tokens.type === 'success'
         setIsValidQuery(isValidQuery)
 
-        let hasTypeDiffOrCommitFilter = false
+        let hasMonitorableTypeFilter = false
         let hasRepoFilter = false
         let hasPatternTypeFilter = false
         let hasValidPatternTypeFilter = true
 
         if (tokens.type === 'success') {
             const filters = tokens.term.filter(token => token.type === 'filter')
-            hasTypeDiffOrCommitFilter = filters.some(
+            hasMonitorableTypeFilter = filters.some(
                 filter =>
                     filter.type === 'filter' &&
                     resolveFilter(filter.field.value)?.type === FilterType.type &&
                     filter.value &&
-                    isDiffOrCommit(filter.value.value)
+                    isMonitorableType(filter.value.value)
             )
 
             hasRepoFilter = filters.some(
@@ -181,7 +181,7 @@ export const FormTriggerArea: React.FunctionComponent<React.PropsWithChildren<Tr
                 )
         }
 
-        setHasTypeDiffOrCommitFilter(hasTypeDiffOrCommitFilter)
+        setHasMonitorableTypeFilter(hasMonitorableTypeFilter)
         setHasRepoFilter(hasRepoFilter)
         setHasPatternTypeFilter(hasPatternTypeFilter)
         setHasValidPatternTypeFilter(hasValidPatternTypeFilter)
@@ -284,11 +284,11 @@ export const FormTriggerArea: React.FunctionComponent<React.PropsWithChildren<Tr
                             </li>
                             <li>
                                 <ValidQueryChecklistItem
-                                    checked={hasTypeDiffOrCommitFilter}
-                                    hint="type:diff targets code present in new commits, while type:commit targets commit messages"
+                                    checked={hasMonitorableTypeFilter}
+                                    hint="type:diff targets code present in new commits, type:commit targets commit messages, and type:file targets new matches in file contents"
                                     dataTestid="type-checkbox"
                                 >
-                                    Contains a <Code>type:diff</Code> or <Code>type:commit</Code> filter
+                                    Contains a <Code>type:diff</Code>, <Code>type:commit</Code> or <Code>type:file</Code> filter
                                 </ValidQueryChecklistItem>
                             </li>
                             {/* Enforce repo filter on sourcegraph.com because otherwise it's too easy to generate a lot of load */}
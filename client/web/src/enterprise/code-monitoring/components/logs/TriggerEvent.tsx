@@ -151,6 +151,8 @@ function getActionEventTitle(action: MonitorTriggerEventWithActions['actions']['
             return 'Email'
         case 'MonitorSlackWebhook':
             return 'Slack'
+        case 'MonitorTeamsWebhook':
+            return 'Microsoft Teams'
         case 'MonitorWebhook':
             return 'Webhook'
     }
@@ -36,10 +36,10 @@ export const SearchQueryChecks: FC<SearchQueryChecksProps> = ({ checks }) => (
             expression boolean operators can still be used)
         </CheckListItem>
         <CheckListItem
-            errorMessage="shouldn't contain patternType:literal or patternType:structural"
+            errorMessage="shouldn't contain patternType:literal"
             valid={checks?.isValidPatternType}
         >
-            Does not contain <Code>patternType:literal</Code> or <Code>patternType:structural</Code>
+            Does not contain <Code>patternType:literal</Code>
         </CheckListItem>
         <CheckListItem errorMessage="shouldn't contain repo filter" valid={checks?.isNotRepo}>
             Does not contain <Code>repo:</Code> filter
@@ -39,12 +39,6 @@ export const searchQueryValidator = (value: string | undefined): Checks => {
                 resolveFilter(filter.field.value)?.type === FilterType.patterntype && filter.value?.value === 'literal'
         )
 
-        const hasStructuralPattern = filters.some(
-            filter =>
-                resolveFilter(filter.field.value)?.type === FilterType.patterntype &&
-                filter.value?.value === 'structural'
-        )
-
         const hasRepo = filters.some(
             filter => resolveFilter(filter.field.value)?.type === FilterType.repo && filter.value
         )
@@ -65,7 +59,7 @@ export const searchQueryValidator = (value: string | undefined): Checks => {
 
         return {
             isValidOperator: !hasAnd && !hasOr && !hasNot,
-            isValidPatternType: !hasLiteralPattern && !hasStructuralPattern,
+            isValidPatternType: !hasLiteralPattern,
             isNotRepo: !hasRepo,
             isNotContext: !hasContext,
             isNotCommitOrDiff: !hasCommit && !hasDiff,
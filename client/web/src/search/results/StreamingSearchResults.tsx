@@ -33,7 +33,7 @@ import { useExperimentalFeatures, useNavbarQueryState, useNotepad } from '../../
 import { GettingStartedTour } from '../../tour/GettingStartedTour'
 import { submitSearch } from '../helpers'
 import { DidYouMean } from '../suggestion/DidYouMean'
-import { SmartSearch, smartSearchEvent } from '../suggestion/SmartSearch'
+import { SmartSearch, smartSearchEvent, smartSearchRules } from '../suggestion/SmartSearch'
 
 import { AggregationUIMode, SearchAggregationResult, useAggregationUIMode } from './components/aggregation'
 import { SearchAlert } from './SearchAlert'
@@ -208,6 +208,21 @@ export const StreamingSearchResults: FC<StreamingSearchResultsProps> = props =>
         }
     }, [results, telemetryService])
 
+    // Log the rules that generated the queries Smart Search proposed, to order
+    // rules by how often their queries are clicked.
+    useEffect(() => {
+        if (
+            results?.state === 'complete' &&
+            (results.alert?.kind === 'smart-search-additional-results' ||
+                results.alert?.kind === 'smart-search-pure-results') &&
+            results.alert.proposedQueries
+        ) {
+            telemetryService.log('SmartSearchQueriesShown', {
+                rules: results.alert.proposedQueries.flatMap(entry => smartSearchRules(entry.description || '')),
+            })
+        }
+    }, [results, telemetryService])
+
     // Reset expanded state when new search is started
     useEffect(() => {
         setAllExpanded(false)
@@ -385,7 +400,11 @@ export const StreamingSearchResults: FC<StreamingSearchResultsProps> = props =>
                         />
 
                         {results?.alert?.kind && (
-                            <SmartSearch alert={results?.alert} onDisableSmartSearch={onDisableSmartSearch} />
+                            <SmartSearch
+                                alert={results?.alert}
+                                onDisableSmartSearch={onDisableSmartSearch}
+                                telemetryService={telemetryService}
+                            />
                         )}
 
                         <GettingStartedTour.Info
@@ -2,6 +2,7 @@ import { Meta, Story } from '@storybook/react'
 
 import { AggregateStreamingSearchResults } from '@sourcegraph/shared/src/search/stream'
 import { MockTemporarySettings } from '@sourcegraph/shared/src/settings/temporary/testUtils'
+import { NOOP_TELEMETRY_SERVICE } from '@sourcegraph/shared/src/telemetry/telemetryService'
 import { H2 } from '@sourcegraph/wildcard'
 
 import { WebStory } from '../../components/WebStory'
@@ -83,25 +84,49 @@ export const DefaultStory: Story = () => (
         {() => (
             <div style={{ padding: '1rem' }}>
                 <H2>One item, additional results</H2>
-                <SmartSearch alert={oneItemAdditionalAlert} onDisableSmartSearch={() => {}} />
+                <SmartSearch
+                    alert={oneItemAdditionalAlert}
+                    onDisableSmartSearch={() => {}}
+                    telemetryService={NOOP_TELEMETRY_SERVICE}
+                />
 
                 <H2>One item, pure results</H2>
-                <SmartSearch alert={oneItemPureAlert} onDisableSmartSearch={() => {}} />
+                <SmartSearch
+                    alert={oneItemPureAlert}
+                    onDisableSmartSearch={() => {}}
+                    telemetryService={NOOP_TELEMETRY_SERVICE}
+                />
 
                 <H2>Many items, additional results</H2>
-                <SmartSearch alert={twoItemAdditionalAlert} onDisableSmartSearch={() => {}} />
+                <SmartSearch
+                    alert={twoItemAdditionalAlert}
+                    onDisableSmartSearch={() => {}}
+                    telemetryService={NOOP_TELEMETRY_SERVICE}
+                />
 
                 <H2>Many items, pure results</H2>
-                <SmartSearch alert={twoItemPureAlert} onDisableSmartSearch={() => {}} />
+                <SmartSearch
+                    alert={twoItemPureAlert}
+                    onDisableSmartSearch={() => {}}
+                    telemetryService={NOOP_TELEMETRY_SERVICE}
+                />
 
                 <H2>Collapsed, additional results</H2>
                 <MockTemporarySettings settings={{ 'search.results.collapseSmartSearch': true }}>
-                    <SmartSearch alert={oneItemAdditionalAlert} onDisableSmartSearch={() => {}} />
+                    <SmartSearch
+                        alert={oneItemAdditionalAlert}
+                        onDisableSmartSearch={() => {}}
+                        telemetryService={NOOP_TELEMETRY_SERVICE}
+                    />
                 </MockTemporarySettings>
 
                 <H2>Collapsed, pure results</H2>
                 <MockTemporarySettings settings={{ 'search.results.collapseSmartSearch': true }}>
-                    <SmartSearch alert={oneItemPureAlert} onDisableSmartSearch={() => {}} />
+                    <SmartSearch
+                        alert={oneItemPureAlert}
+                        onDisableSmartSearch={() => {}}
+                        telemetryService={NOOP_TELEMETRY_SERVICE}
+                    />
                 </MockTemporarySettings>
             </div>
         )}
@@ -6,6 +6,7 @@ import { formatSearchParameters, pluralize } from '@sourcegraph/common'
 import { SyntaxHighlightedSearchQuery, smartSearchIconSvgPath } from '@sourcegraph/search-ui'
 import { AggregateStreamingSearchResults, AlertKind } from '@sourcegraph/shared/src/search/stream'
 import { useTemporarySetting } from '@sourcegraph/shared/src/settings/temporary/useTemporarySetting'
+import { TelemetryProps } from '@sourcegraph/shared/src/telemetry/telemetryService'
 import {
     Link,
     createLinkUrl,
@@ -22,13 +23,18 @@ import { SearchPatternType } from '../../graphql-operations'
 
 import styles from './QuerySuggestion.module.scss'
 
-interface SmartSearchProps {
+interface SmartSearchProps extends TelemetryProps {
     alert: Required<AggregateStreamingSearchResults>['alert'] | undefined
     onDisableSmartSearch: () => void
 }
 
+/**
+ * Returns the descriptions of the rules that generated a proposed query.
+ */
+export const smartSearchRules = (description: string): string[] => description.split(' ⚬ ')
+
 const processDescription = (description: string): string => {
-    const split = description.split(' ⚬ ')
+    const split = smartSearchRules(description)
 
     split[0] = split[0][0].toUpperCase() + split[0].slice(1)
     return split.join(', ')
@@ -66,6 +72,7 @@ const alertContent: { [key in AlertKind]: (queryCount: number) => { title: JSX.E
 export const SmartSearch: React.FunctionComponent<React.PropsWithChildren<SmartSearchProps>> = ({
     alert,
     onDisableSmartSearch,
+    telemetryService,
 }) => {
     const [isCollapsed, setIsCollapsed] = useTemporarySetting('search.results.collapseSmartSearch')
 
@@ -134,6 +141,11 @@ export const SmartSearch: React.FunctionComponent<React.PropsWithChildren<SmartS
                                         search: formatSearchParameters(new URLSearchParams({ q: entry.query })),
                                     })}
                                     className={styles.link}
+                                    onClick={() =>
+                                        telemetryService.log('SmartSearchQueryClicked', {
+                                            rules: smartSearchRules(entry.description || ''),
+                                        })
+                                    }
                                 >
                                     <span>
                                         <span className={styles.listItemDescription}>{`${processDescription(
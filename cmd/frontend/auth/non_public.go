@@ -129,6 +129,7 @@ func AllowAnonymousRequest(req *http.Request) bool {
 		"/.api/gitlab-webhooks",
 		"/.api/bitbucket-server-webhooks",
 		"/.api/bitbucket-cloud-webhooks",
+		"/.api/azuredevops-webhooks",
 	} {
 		if strings.HasPrefix(req.URL.Path, prefix) {
 			return true
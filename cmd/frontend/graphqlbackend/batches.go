@@ -54,6 +54,16 @@ type DeleteBatchChangeArgs struct {
 	BatchChange graphql.ID
 }
 
+type SetBatchChangeExecutionScheduleArgs struct {
+	BatchChange   graphql.ID
+	IntervalHours int32
+	AutoApply     bool
+}
+
+type RemoveBatchChangeExecutionScheduleArgs struct {
+	BatchChange graphql.ID
+}
+
 type SyncChangesetArgs struct {
 	Changeset graphql.ID
 }
@@ -267,6 +277,8 @@ type BatchChangesResolver interface {
 	CloseBatchChange(ctx context.Context, args *CloseBatchChangeArgs) (BatchChangeResolver, error)
 	MoveBatchChange(ctx context.Context, args *MoveBatchChangeArgs) (BatchChangeResolver, error)
 	DeleteBatchChange(ctx context.Context, args *DeleteBatchChangeArgs) (*EmptyResponse, error)
+	SetBatchChangeExecutionSchedule(ctx context.Context, args *SetBatchChangeExecutionScheduleArgs) (BatchChangeResolver, error)
+	RemoveBatchChangeExecutionSchedule(ctx context.Context, args *RemoveBatchChangeExecutionScheduleArgs) (BatchChangeResolver, error)
 	CreateBatchChangesCredential(ctx context.Context, args *CreateBatchChangesCredentialArgs) (BatchChangesCredentialResolver, error)
 	DeleteBatchChangesCredential(ctx context.Context, args *DeleteBatchChangesCredentialArgs) (*EmptyResponse, error)
 
@@ -654,10 +666,20 @@ type BatchChangeResolver interface {
 	ClosedAt() *gqlutil.DateTime
 	DiffStat(ctx context.Context) (*DiffStat, error)
 	CurrentSpec(ctx context.Context) (BatchSpecResolver, error)
+	ExecutionSchedule(ctx context.Context) (BatchChangeExecutionScheduleResolver, error)
 	BulkOperations(ctx context.Context, args *ListBatchChangeBulkOperationArgs) (BulkOperationConnectionResolver, error)
 	BatchSpecs(ctx context.Context, args *ListBatchSpecArgs) (BatchSpecConnectionResolver, error)
 }
 
+type BatchChangeExecutionScheduleResolver interface {
+	IntervalHours() int32
+	AutoApply() bool
+	User(ctx context.Context) (*UserResolver, error)
+	NextRunAt() gqlutil.DateTime
+	LastRunAt() *gqlutil.DateTime
+	BatchSpecInProgress(ctx context.Context) (BatchSpecResolver, error)
+}
+
 type BatchChangesConnectionResolver interface {
 	Nodes(ctx context.Context) ([]BatchChangeResolver, error)
 	TotalCount(ctx context.Context) (int32, error)
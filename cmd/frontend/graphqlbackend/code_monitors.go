@@ -23,6 +23,7 @@ type CodeMonitorsResolver interface {
 	TriggerTestEmailAction(ctx context.Context, args *TriggerTestEmailActionArgs) (*EmptyResponse, error)
 	TriggerTestWebhookAction(ctx context.Context, args *TriggerTestWebhookActionArgs) (*EmptyResponse, error)
 	TriggerTestSlackWebhookAction(ctx context.Context, args *TriggerTestSlackWebhookActionArgs) (*EmptyResponse, error)
+	TriggerTestTeamsWebhookAction(ctx context.Context, args *TriggerTestTeamsWebhookActionArgs) (*EmptyResponse, error)
 
 	NodeResolvers() map[string]NodeByIDFunc
 }
@@ -81,6 +82,7 @@ type MonitorAction interface {
 	ToMonitorEmail() (MonitorEmailResolver, bool)
 	ToMonitorWebhook() (MonitorWebhookResolver, bool)
 	ToMonitorSlackWebhook() (MonitorSlackWebhookResolver, bool)
+	ToMonitorTeamsWebhook() (MonitorTeamsWebhookResolver, bool)
 }
 
 type MonitorEmailResolver interface {
@@ -98,6 +100,8 @@ type MonitorWebhookResolver interface {
 	Enabled() bool
 	IncludeResults() bool
 	URL() string
+	PayloadTemplate() string
+	HeaderNames(ctx context.Context) ([]string, error)
 	Events(ctx context.Context, args *ListEventsArgs) (MonitorActionEventConnectionResolver, error)
 }
 
@@ -109,6 +113,14 @@ type MonitorSlackWebhookResolver interface {
 	Events(ctx context.Context, args *ListEventsArgs) (MonitorActionEventConnectionResolver, error)
 }
 
+type MonitorTeamsWebhookResolver interface {
+	ID() graphql.ID
+	Enabled() bool
+	IncludeResults() bool
+	URL() string
+	Events(ctx context.Context, args *ListEventsArgs) (MonitorActionEventConnectionResolver, error)
+}
+
 type MonitorEmailRecipient interface {
 	ToUser() (*UserResolver, bool)
 }
@@ -130,6 +142,15 @@ type MonitorActionEventResolver interface {
 	Status() (string, error)
 	Message() *string
 	Timestamp() gqlutil.DateTime
+	Deliveries(ctx context.Context) ([]MonitorActionDeliveryResolver, error)
+}
+
+type MonitorActionDeliveryResolver interface {
+	Attempt() int32
+	StatusCode() *int32
+	Error() *string
+	DurationMs() int32
+	Timestamp() gqlutil.DateTime
 }
 
 type ListEventsArgs struct {
@@ -166,6 +187,7 @@ type CreateActionArgs struct {
 	Email        *CreateActionEmailArgs
 	Webhook      *CreateActionWebhookArgs
 	SlackWebhook *CreateActionSlackWebhookArgs
+	TeamsWebhook *CreateActionTeamsWebhookArgs
 }
 
 type CreateActionEmailArgs struct {
@@ -177,12 +199,25 @@ type CreateActionEmailArgs struct {
 }
 
 type CreateActionWebhookArgs struct {
+	Enabled         bool
+	IncludeResults  bool
+	URL             string
+	PayloadTemplate *string
+	Headers         *[]*WebhookHeaderArgs
+}
+
+type WebhookHeaderArgs struct {
+	Name  string
+	Value string
+}
+
+type CreateActionSlackWebhookArgs struct {
 	Enabled        bool
 	IncludeResults bool
 	URL            string
 }
 
-type CreateActionSlackWebhookArgs struct {
+type CreateActionTeamsWebhookArgs struct {
 	Enabled        bool
 	IncludeResults bool
 	URL            string
@@ -219,6 +254,12 @@ type TriggerTestSlackWebhookActionArgs struct {
 	SlackWebhook *CreateActionSlackWebhookArgs
 }
 
+type TriggerTestTeamsWebhookActionArgs struct {
+	Namespace    graphql.ID
+	Description  string
+	TeamsWebhook *CreateActionTeamsWebhookArgs
+}
+
 type CreateMonitorArgs struct {
 	Namespace   graphql.ID
 	Description string
@@ -240,10 +281,16 @@ type EditActionSlackWebhookArgs struct {
 	Update *CreateActionSlackWebhookArgs
 }
 
+type EditActionTeamsWebhookArgs struct {
+	Id     *graphql.ID
+	Update *CreateActionTeamsWebhookArgs
+}
+
 type EditActionArgs struct {
 	Email        *EditActionEmailArgs
 	Webhook      *EditActionWebhookArgs
 	SlackWebhook *EditActionSlackWebhookArgs
+	TeamsWebhook *EditActionTeamsWebhookArgs
 }
 
 type EditTriggerArgs struct {
@@ -130,6 +130,10 @@ func (r *externalServiceResolver) WebhookURL(ctx context.Context) (*string, erro
 			if c.WebhookSecret != "" {
 				r.webhookURL = u
 			}
+		case *schema.AzureDevOpsConnection:
+			if c.WebhookSecret != "" {
+				r.webhookURL = u
+			}
 		case *schema.BitbucketServerConnection:
 			if c.Webhooks != nil {
 				r.webhookURL = u
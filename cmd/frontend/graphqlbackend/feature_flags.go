@@ -2,6 +2,7 @@ package graphqlbackend
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/graph-gophers/graphql-go"
 	"github.com/graph-gophers/graphql-go/relay"
@@ -10,6 +11,8 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/auth"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/featureflag"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/requestclient"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -40,6 +43,9 @@ type FeatureFlagBooleanResolver struct {
 
 func (f *FeatureFlagBooleanResolver) Name() string { return f.inner.Name }
 func (f *FeatureFlagBooleanResolver) Value() bool  { return f.inner.Bool.Value }
+func (f *FeatureFlagBooleanResolver) Rules() []*FeatureFlagRuleResolver {
+	return rulesToResolvers(f.db, f.inner.Rules)
+}
 func (f *FeatureFlagBooleanResolver) Overrides(ctx context.Context) ([]*FeatureFlagOverrideResolver, error) {
 	overrides, err := f.db.FeatureFlags().GetOverridesForFlag(ctx, f.inner.Name)
 	if err != nil {
@@ -56,6 +62,9 @@ type FeatureFlagRolloutResolver struct {
 
 func (f *FeatureFlagRolloutResolver) Name() string              { return f.inner.Name }
 func (f *FeatureFlagRolloutResolver) RolloutBasisPoints() int32 { return f.inner.Rollout.Rollout }
+func (f *FeatureFlagRolloutResolver) Rules() []*FeatureFlagRuleResolver {
+	return rulesToResolvers(f.db, f.inner.Rules)
+}
 func (f *FeatureFlagRolloutResolver) Overrides(ctx context.Context) ([]*FeatureFlagOverrideResolver, error) {
 	overrides, err := f.db.FeatureFlags().GetOverridesForFlag(ctx, f.inner.Name)
 	if err != nil {
@@ -64,6 +73,92 @@ func (f *FeatureFlagRolloutResolver) Overrides(ctx context.Context) ([]*FeatureF
 	return overridesToResolvers(f.db, overrides), nil
 }
 
+func rulesToResolvers(db database.DB, input []*featureflag.Rule) []*FeatureFlagRuleResolver {
+	res := make([]*FeatureFlagRuleResolver, 0, len(input))
+	for _, rule := range input {
+		res = append(res, &FeatureFlagRuleResolver{db, rule})
+	}
+	return res
+}
+
+type FeatureFlagRuleResolver struct {
+	db    database.DB
+	inner *featureflag.Rule
+}
+
+func (f *FeatureFlagRuleResolver) Organizations(ctx context.Context) ([]*OrgResolver, error) {
+	res := make([]*OrgResolver, 0, len(f.inner.OrgIDs))
+	for _, id := range f.inner.OrgIDs {
+		o, err := OrgByIDInt32(ctx, f.db, id)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, o)
+	}
+	return res, nil
+}
+func (f *FeatureFlagRuleResolver) SiteAdmin() *bool { return f.inner.SiteAdmin }
+func (f *FeatureFlagRuleResolver) CreatedAfter() *gqlutil.DateTime {
+	return gqlutil.DateTimeOrNil(f.inner.CreatedAfter)
+}
+func (f *FeatureFlagRuleResolver) EmailDomains() []string {
+	res := make([]string, 0, len(f.inner.EmailDomains))
+	return append(res, f.inner.EmailDomains...)
+}
+func (f *FeatureFlagRuleResolver) ClientTypes() []string {
+	res := make([]string, 0, len(f.inner.ClientTypes))
+	for _, clientType := range f.inner.ClientTypes {
+		res = append(res, string(clientType))
+	}
+	return res
+}
+func (f *FeatureFlagRuleResolver) Value() bool { return f.inner.Value }
+
+type featureFlagRuleInput struct {
+	Organizations *[]graphql.ID
+	SiteAdmin     *bool
+	CreatedAfter  *gqlutil.DateTime
+	EmailDomains  *[]string
+	ClientTypes   *[]string
+	Value         bool
+}
+
+func unmarshalFeatureFlagRules(input *[]featureFlagRuleInput) ([]*featureflag.Rule, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	rules := make([]*featureflag.Rule, 0, len(*input))
+	for _, in := range *input {
+		rule := &featureflag.Rule{
+			SiteAdmin: in.SiteAdmin,
+			Value:     in.Value,
+		}
+		if in.Organizations != nil {
+			for _, id := range *in.Organizations {
+				orgID, err := UnmarshalOrgID(id)
+				if err != nil {
+					return nil, err
+				}
+				rule.OrgIDs = append(rule.OrgIDs, orgID)
+			}
+		}
+		if in.CreatedAfter != nil {
+			rule.CreatedAfter = &in.CreatedAfter.Time
+		}
+		if in.EmailDomains != nil {
+			rule.EmailDomains = *in.EmailDomains
+		}
+		if in.ClientTypes != nil {
+			for _, clientType := range *in.ClientTypes {
+				rule.ClientTypes = append(rule.ClientTypes, requestclient.ClientType(clientType))
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
 func overridesToResolvers(db database.DB, input []*featureflag.Override) []*FeatureFlagOverrideResolver {
 	res := make([]*FeatureFlagOverrideResolver, 0, len(input))
 	for _, flag := range input {
@@ -137,6 +232,55 @@ func (r *schemaResolver) EvaluateFeatureFlag(ctx context.Context, args *struct {
 	return nil
 }
 
+type FeatureFlagEvaluationResolver struct {
+	db    database.DB
+	name  string
+	inner featureflag.Evaluation
+}
+
+func (e *FeatureFlagEvaluationResolver) Name() string   { return e.name }
+func (e *FeatureFlagEvaluationResolver) Value() bool    { return e.inner.Value }
+func (e *FeatureFlagEvaluationResolver) Reason() string { return string(e.inner.Reason) }
+func (e *FeatureFlagEvaluationResolver) RuleIndex() *int32 {
+	if e.inner.Reason != featureflag.ReasonRule {
+		return nil
+	}
+	ruleIndex := int32(e.inner.RuleIndex)
+	return &ruleIndex
+}
+func (e *FeatureFlagEvaluationResolver) Organization(ctx context.Context) (*OrgResolver, error) {
+	if e.inner.Reason != featureflag.ReasonOrgOverride {
+		return nil, nil
+	}
+	return OrgByIDInt32(ctx, e.db, e.inner.OrgID)
+}
+
+func (r *schemaResolver) EvaluateFeatureFlagWithReason(ctx context.Context, args *struct {
+	FlagName string
+}) (*FeatureFlagEvaluationResolver, error) {
+	a := actor.FromContext(ctx)
+	if a.IsAuthenticated() {
+		evaluation, err := r.db.FeatureFlags().GetUserFlagEvaluation(ctx, a.UID, args.FlagName)
+		if err != nil || evaluation == nil {
+			return nil, err
+		}
+		return &FeatureFlagEvaluationResolver{r.db, args.FlagName, *evaluation}, nil
+	}
+
+	flag, err := r.db.FeatureFlags().GetFeatureFlag(ctx, args.FlagName)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	ec := &featureflag.EvaluationContext{AnonymousUID: a.AnonymousUID}
+	if client := requestclient.FromContext(ctx); client != nil {
+		ec.ClientType = client.Type
+	}
+	return &FeatureFlagEvaluationResolver{r.db, args.FlagName, flag.Evaluate(ec)}, nil
+}
+
 func (r *schemaResolver) EvaluatedFeatureFlags(ctx context.Context) []*EvaluatedFeatureFlagResolver {
 	return evaluatedFlagsToResolvers(featureflag.GetEvaluatedFlagSet(ctx))
 }
@@ -222,23 +366,27 @@ func (r *schemaResolver) CreateFeatureFlag(ctx context.Context, args struct {
 	Name               string
 	Value              *bool
 	RolloutBasisPoints *int32
+	Rules              *[]featureFlagRuleInput
 }) (*FeatureFlagResolver, error) {
 	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
 		return nil, err
 	}
 
-	ff := r.db.FeatureFlags()
+	rules, err := unmarshalFeatureFlagRules(args.Rules)
+	if err != nil {
+		return nil, err
+	}
 
-	var res *featureflag.FeatureFlag
-	var err error
+	ff := &featureflag.FeatureFlag{Name: args.Name, Rules: rules}
 	if args.Value != nil {
-		res, err = ff.CreateBool(ctx, args.Name, *args.Value)
+		ff.Bool = &featureflag.FeatureFlagBool{Value: *args.Value}
 	} else if args.RolloutBasisPoints != nil {
-		res, err = ff.CreateRollout(ctx, args.Name, *args.RolloutBasisPoints)
+		ff.Rollout = &featureflag.FeatureFlagRollout{Rollout: *args.RolloutBasisPoints}
 	} else {
 		return nil, errors.Errorf("either 'value' or 'rolloutBasisPoints' must be set")
 	}
 
+	res, err := r.db.FeatureFlags().CreateFeatureFlag(ctx, ff)
 	return &FeatureFlagResolver{r.db, res}, err
 }
 
@@ -255,11 +403,28 @@ func (r *schemaResolver) UpdateFeatureFlag(ctx context.Context, args struct {
 	Name               string
 	Value              *bool
 	RolloutBasisPoints *int32
+	Rules              *[]featureFlagRuleInput
 }) (*FeatureFlagResolver, error) {
 	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
 		return nil, err
 	}
-	ff := &featureflag.FeatureFlag{Name: args.Name}
+	var rules []*featureflag.Rule
+	if args.Rules != nil {
+		var err error
+		rules, err = unmarshalFeatureFlagRules(args.Rules)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Clients that don't know about rules don't send them, so keep the
+		// stored rules instead of removing them.
+		existing, err := r.db.FeatureFlags().GetFeatureFlag(ctx, args.Name)
+		if err != nil {
+			return nil, err
+		}
+		rules = existing.Rules
+	}
+	ff := &featureflag.FeatureFlag{Name: args.Name, Rules: rules}
 	if args.Value != nil {
 		ff.Bool = &featureflag.FeatureFlagBool{Value: *args.Value}
 	} else if args.RolloutBasisPoints != nil {
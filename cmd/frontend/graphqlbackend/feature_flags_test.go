@@ -2,6 +2,7 @@ package graphqlbackend
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/featureflag"
+	"github.com/sourcegraph/sourcegraph/internal/requestclient"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 )
 
@@ -295,3 +297,251 @@ func TestEvaluateFeatureFlag(t *testing.T) {
 		})
 	})
 }
+
+func TestEvaluateFeatureFlagWithReason(t *testing.T) {
+	t.Run("return flag evaluation for user", func(t *testing.T) {
+		users := database.NewMockUserStore()
+		users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{ID: 1}, nil)
+
+		ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1})
+
+		orgs := database.NewMockOrgStore()
+		mockedOrg := types.Org{ID: 1, Name: "acme"}
+		orgs.GetByIDFunc.SetDefaultReturn(&mockedOrg, nil)
+
+		flags := database.NewMockFeatureFlagStore()
+		flags.GetUserFlagEvaluationFunc.SetDefaultHook(func(ctx context.Context, uid int32, flagName string) (*featureflag.Evaluation, error) {
+			assert.Equal(t, int32(1), uid)
+			switch flagName {
+			case "rule-flag":
+				return &featureflag.Evaluation{Value: true, Reason: featureflag.ReasonRule, RuleIndex: 2}, nil
+			case "org-flag":
+				return &featureflag.Evaluation{Value: false, Reason: featureflag.ReasonOrgOverride, OrgID: 1}, nil
+			}
+			return nil, nil
+		})
+
+		db := database.NewMockDB()
+		db.OrgsFunc.SetDefaultReturn(orgs)
+		db.UsersFunc.SetDefaultReturn(users)
+		db.FeatureFlagsFunc.SetDefaultReturn(flags)
+
+		RunTests(t, []*Test{
+			{
+				Context: ctx,
+				Schema:  mustParseGraphQLSchema(t, db),
+				Query: `
+				{
+					evaluateFeatureFlagWithReason(flagName: "rule-flag") {
+						name
+						value
+						reason
+						ruleIndex
+						organization {
+							name
+						}
+					}
+				}
+				`,
+				ExpectedResult: `
+					{
+						"evaluateFeatureFlagWithReason": {
+							"name": "rule-flag",
+							"value": true,
+							"reason": "RULE",
+							"ruleIndex": 2,
+							"organization": null
+						}
+					}
+				`,
+			},
+			{
+				Context: ctx,
+				Schema:  mustParseGraphQLSchema(t, db),
+				Query: `
+				{
+					evaluateFeatureFlagWithReason(flagName: "org-flag") {
+						value
+						reason
+						ruleIndex
+						organization {
+							name
+						}
+					}
+				}
+				`,
+				ExpectedResult: `
+					{
+						"evaluateFeatureFlagWithReason": {
+							"value": false,
+							"reason": "ORG_OVERRIDE",
+							"ruleIndex": null,
+							"organization": {
+								"name": "acme"
+							}
+						}
+					}
+				`,
+			},
+			{
+				Context: ctx,
+				Schema:  mustParseGraphQLSchema(t, db),
+				Query: `
+				{
+					evaluateFeatureFlagWithReason(flagName: "non-existing-flag") {
+						value
+					}
+				}
+				`,
+				ExpectedResult: `
+					{
+						"evaluateFeatureFlagWithReason": null
+					}
+				`,
+			},
+		})
+	})
+
+	t.Run("return flag evaluation for anonymous user", func(t *testing.T) {
+		ctx := actor.WithActor(context.Background(), actor.FromAnonymousUser("anon"))
+		ctx = requestclient.WithClient(ctx, &requestclient.Client{Type: requestclient.ClientTypeBrowserExtension})
+
+		flags := database.NewMockFeatureFlagStore()
+		flags.GetFeatureFlagFunc.SetDefaultHook(func(ctx context.Context, flagName string) (*featureflag.FeatureFlag, error) {
+			if flagName != "test-flag" {
+				return nil, sql.ErrNoRows
+			}
+			return &featureflag.FeatureFlag{
+				Name:  "test-flag",
+				Bool:  &featureflag.FeatureFlagBool{Value: false},
+				Rules: []*featureflag.Rule{{ClientTypes: []requestclient.ClientType{requestclient.ClientTypeBrowserExtension}, Value: true}},
+			}, nil
+		})
+
+		db := database.NewMockDB()
+		db.FeatureFlagsFunc.SetDefaultReturn(flags)
+
+		RunTests(t, []*Test{
+			{
+				Context: ctx,
+				Schema:  mustParseGraphQLSchema(t, db),
+				Query: `
+				{
+					evaluateFeatureFlagWithReason(flagName: "test-flag") {
+						value
+						reason
+						ruleIndex
+					}
+				}
+				`,
+				ExpectedResult: `
+					{
+						"evaluateFeatureFlagWithReason": {
+							"value": true,
+							"reason": "RULE",
+							"ruleIndex": 0
+						}
+					}
+				`,
+			},
+			{
+				Context: ctx,
+				Schema:  mustParseGraphQLSchema(t, db),
+				Query: `
+				{
+					evaluateFeatureFlagWithReason(flagName: "non-existing-flag") {
+						value
+					}
+				}
+				`,
+				ExpectedResult: `
+					{
+						"evaluateFeatureFlagWithReason": null
+					}
+				`,
+			},
+		})
+	})
+}
+
+func TestUpdateFeatureFlagRules(t *testing.T) {
+	users := database.NewMockUserStore()
+	users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{ID: 1, SiteAdmin: true}, nil)
+
+	ctx := actor.WithActor(context.Background(), &actor.Actor{UID: 1})
+
+	siteAdmin := true
+	storedRules := []*featureflag.Rule{{SiteAdmin: &siteAdmin, Value: true}}
+
+	var updated *featureflag.FeatureFlag
+	flags := database.NewMockFeatureFlagStore()
+	flags.GetFeatureFlagFunc.SetDefaultHook(func(ctx context.Context, flagName string) (*featureflag.FeatureFlag, error) {
+		return &featureflag.FeatureFlag{Name: flagName, Bool: &featureflag.FeatureFlagBool{Value: false}, Rules: storedRules}, nil
+	})
+	flags.UpdateFeatureFlagFunc.SetDefaultHook(func(ctx context.Context, flag *featureflag.FeatureFlag) (*featureflag.FeatureFlag, error) {
+		updated = flag
+		return flag, nil
+	})
+
+	db := database.NewMockDB()
+	db.UsersFunc.SetDefaultReturn(users)
+	db.FeatureFlagsFunc.SetDefaultReturn(flags)
+
+	t.Run("omitted rules are kept", func(t *testing.T) {
+		RunTest(t, &Test{
+			Context: ctx,
+			Schema:  mustParseGraphQLSchema(t, db),
+			Query: `
+			mutation {
+				updateFeatureFlag(name: "test-flag", value: true) {
+					... on FeatureFlagBoolean {
+						value
+						rules {
+							value
+						}
+					}
+				}
+			}
+			`,
+			ExpectedResult: `
+				{
+					"updateFeatureFlag": {
+						"value": true,
+						"rules": [
+							{
+								"value": true
+							}
+						]
+					}
+				}
+			`,
+		})
+		assert.Equal(t, storedRules, updated.Rules)
+	})
+
+	t.Run("empty rules remove the rules", func(t *testing.T) {
+		RunTest(t, &Test{
+			Context: ctx,
+			Schema:  mustParseGraphQLSchema(t, db),
+			Query: `
+			mutation {
+				updateFeatureFlag(name: "test-flag", value: true, rules: []) {
+					... on FeatureFlagBoolean {
+						rules {
+							value
+						}
+					}
+				}
+			}
+			`,
+			ExpectedResult: `
+				{
+					"updateFeatureFlag": {
+						"rules": []
+					}
+				}
+			`,
+		})
+		assert.Empty(t, updated.Rules)
+	})
+}
@@ -0,0 +1,99 @@
+package graphqlbackend
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/repos"
+)
+
+type gitserverRebalanceResolver struct {
+	db     database.DB
+	status database.GitserverRebalanceStatus
+}
+
+func newGitserverRebalanceResolver(ctx context.Context, db database.DB) (*gitserverRebalanceResolver, error) {
+	status, err := db.GitserverRepos().GetRebalanceStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &gitserverRebalanceResolver{db: db, status: status}, nil
+}
+
+func (r *gitserverRebalanceResolver) Queued() int32    { return int32(r.status.Queued) }
+func (r *gitserverRebalanceResolver) Migrating() int32 { return int32(r.status.Migrating) }
+func (r *gitserverRebalanceResolver) Completed() int32 { return int32(r.status.Completed) }
+func (r *gitserverRebalanceResolver) Errored() int32   { return int32(r.status.Errored) }
+func (r *gitserverRebalanceResolver) Total() int32     { return int32(r.status.Total()) }
+
+func (r *gitserverRebalanceResolver) Failures(ctx context.Context, args *struct{ First int32 }) ([]*gitserverRepositoryMoveResolver, error) {
+	moves, err := r.db.GitserverRepos().ListRebalanceMoves(ctx, database.ListGitserverRepoMovesOptions{
+		States:      []database.GitserverRebalanceState{database.GitserverRebalanceErrored},
+		LimitOffset: &database.LimitOffset{Limit: int(args.First)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*gitserverRepositoryMoveResolver, 0, len(moves))
+	for _, m := range moves {
+		resolvers = append(resolvers, &gitserverRepositoryMoveResolver{move: m})
+	}
+	return resolvers, nil
+}
+
+type gitserverRepositoryMoveResolver struct {
+	move *database.GitserverRepoMove
+}
+
+func (r *gitserverRepositoryMoveResolver) RepositoryName() string { return string(r.move.RepoName) }
+func (r *gitserverRepositoryMoveResolver) From() string           { return r.move.From }
+func (r *gitserverRepositoryMoveResolver) To() string             { return r.move.To }
+
+func (r *gitserverRepositoryMoveResolver) Error() *string {
+	if r.move.Error == "" {
+		return nil
+	}
+	return &r.move.Error
+}
+
+func (r *gitserverRepositoryMoveResolver) UpdatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.move.UpdatedAt}
+}
+
+func (r *schemaResolver) GitserverRebalance(ctx context.Context) (*gitserverRebalanceResolver, error) {
+	// 🚨 SECURITY: Only site admins may query the gitserver rebalancing.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	return newGitserverRebalanceResolver(ctx, r.db)
+}
+
+func (r *schemaResolver) RebalanceGitservers(ctx context.Context, args *struct{ Addresses []string }) (*gitserverRebalanceResolver, error) {
+	// 🚨 SECURITY: Only site admins may rebalance gitservers.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if _, err := repos.PlanGitserverRebalance(ctx, r.db, args.Addresses); err != nil {
+		return nil, err
+	}
+
+	return newGitserverRebalanceResolver(ctx, r.db)
+}
+
+func (r *schemaResolver) CancelGitserverRebalance(ctx context.Context) (*EmptyResponse, error) {
+	// 🚨 SECURITY: Only site admins may cancel the gitserver rebalancing.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.GitserverRepos().QueueRebalance(ctx); err != nil {
+		return nil, err
+	}
+
+	return &EmptyResponse{}, nil
+}
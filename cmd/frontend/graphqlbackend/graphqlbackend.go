@@ -611,6 +611,12 @@ func newSchemaResolver(db database.DB, gitserverClient gitserver.Client) *schema
 		"ExecutorSecretAccessLog": func(ctx context.Context, id graphql.ID) (Node, error) {
 			return executorSecretAccessLogByID(ctx, db, id)
 		},
+		"Role": func(ctx context.Context, id graphql.ID) (Node, error) {
+			return roleByID(ctx, db, id)
+		},
+		"Permission": func(ctx context.Context, id graphql.ID) (Node, error) {
+			return permissionByID(ctx, db, id)
+		},
 	}
 	return r
 }
@@ -79,6 +79,11 @@ func (r *NodeResolver) ToMonitorSlackWebhook() (MonitorSlackWebhookResolver, boo
 	return n, ok
 }
 
+func (r *NodeResolver) ToMonitorTeamsWebhook() (MonitorTeamsWebhookResolver, bool) {
+	n, ok := r.Node.(MonitorTeamsWebhookResolver)
+	return n, ok
+}
+
 func (r *NodeResolver) ToMonitorActionEvent() (MonitorActionEventResolver, bool) {
 	n, ok := r.Node.(MonitorActionEventResolver)
 	return n, ok
@@ -308,3 +313,13 @@ func (r *NodeResolver) ToPermissionsSyncJob() (PermissionsSyncJobResolver, bool)
 	n, ok := r.Node.(PermissionsSyncJobResolver)
 	return n, ok
 }
+
+func (r *NodeResolver) ToRole() (*roleResolver, bool) {
+	n, ok := r.Node.(*roleResolver)
+	return n, ok
+}
+
+func (r *NodeResolver) ToPermission() (*permissionResolver, bool) {
+	n, ok := r.Node.(*permissionResolver)
+	return n, ok
+}
@@ -0,0 +1,454 @@
+package graphqlbackend
+
+import (
+	"context"
+	"sync"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func marshalRoleID(id int32) graphql.ID { return relay.MarshalID("Role", id) }
+
+func unmarshalRoleID(id graphql.ID) (roleID int32, err error) {
+	if kind := relay.UnmarshalKind(id); kind != "Role" {
+		return 0, errors.Newf("invalid role id of kind %q", kind)
+	}
+	err = relay.UnmarshalSpec(id, &roleID)
+	return
+}
+
+func marshalPermissionID(id int32) graphql.ID { return relay.MarshalID("Permission", id) }
+
+func unmarshalPermissionID(id graphql.ID) (permissionID int32, err error) {
+	if kind := relay.UnmarshalKind(id); kind != "Permission" {
+		return 0, errors.Newf("invalid permission id of kind %q", kind)
+	}
+	err = relay.UnmarshalSpec(id, &permissionID)
+	return
+}
+
+func unmarshalPermissionIDs(ids []graphql.ID) ([]int32, error) {
+	permissionIDs := make([]int32, 0, len(ids))
+	for _, id := range ids {
+		permissionID, err := unmarshalPermissionID(id)
+		if err != nil {
+			return nil, err
+		}
+		permissionIDs = append(permissionIDs, permissionID)
+	}
+	return permissionIDs, nil
+}
+
+func roleByID(ctx context.Context, db database.DB, id graphql.ID) (*roleResolver, error) {
+	// 🚨 SECURITY: Only site admins can view roles.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, db); err != nil {
+		return nil, err
+	}
+
+	roleID, err := unmarshalRoleID(id)
+	if err != nil {
+		return nil, err
+	}
+	role, err := db.Roles().GetByID(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+	return &roleResolver{db: db, role: role}, nil
+}
+
+func permissionByID(ctx context.Context, db database.DB, id graphql.ID) (*permissionResolver, error) {
+	// 🚨 SECURITY: Only site admins can view permissions.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, db); err != nil {
+		return nil, err
+	}
+
+	permissionID, err := unmarshalPermissionID(id)
+	if err != nil {
+		return nil, err
+	}
+	perm, err := db.Permissions().GetByID(ctx, permissionID)
+	if err != nil {
+		return nil, err
+	}
+	return &permissionResolver{permission: perm}, nil
+}
+
+type ListRolesArgs struct {
+	First int32
+	After *string
+	User  *graphql.ID
+	Org   *graphql.ID
+}
+
+func (r *schemaResolver) Roles(ctx context.Context, args *ListRolesArgs) (*roleConnectionResolver, error) {
+	// 🚨 SECURITY: Only site admins can list roles.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	limit, err := connectionLimitOffset(args.First, args.After)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := database.RolesListOptions{LimitOffset: limit}
+	if args.User != nil {
+		if opts.UserID, err = UnmarshalUserID(*args.User); err != nil {
+			return nil, err
+		}
+	}
+	if args.Org != nil {
+		if opts.OrgID, err = UnmarshalOrgID(*args.Org); err != nil {
+			return nil, err
+		}
+	}
+
+	return &roleConnectionResolver{db: r.db, opts: opts}, nil
+}
+
+type ListPermissionsArgs struct {
+	First int32
+	After *string
+}
+
+func (r *schemaResolver) Permissions(ctx context.Context, args *ListPermissionsArgs) (*permissionConnectionResolver, error) {
+	// 🚨 SECURITY: Only site admins can list permissions.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	limit, err := connectionLimitOffset(args.First, args.After)
+	if err != nil {
+		return nil, err
+	}
+
+	return &permissionConnectionResolver{db: r.db, opts: database.PermissionListOptions{LimitOffset: limit}}, nil
+}
+
+func connectionLimitOffset(first int32, after *string) (*database.LimitOffset, error) {
+	limit := &database.LimitOffset{Limit: int(first)}
+	if after != nil {
+		offset, err := graphqlutil.DecodeIntCursor(after)
+		if err != nil {
+			return nil, err
+		}
+		limit.Offset = offset
+	}
+	return limit, nil
+}
+
+type CreateRoleArgs struct {
+	Name        string
+	Permissions []graphql.ID
+}
+
+func (r *schemaResolver) CreateRole(ctx context.Context, args *CreateRoleArgs) (_ *roleResolver, err error) {
+	// 🚨 SECURITY: Only site admins can create roles.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	permissionIDs, err := unmarshalPermissionIDs(args.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.db.Roles().Transact(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	role, err := tx.Create(ctx, args.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.SetPermissions(ctx, role.ID, permissionIDs); err != nil {
+		return nil, err
+	}
+
+	return &roleResolver{db: r.db, role: role}, nil
+}
+
+type DeleteRoleArgs struct {
+	Role graphql.ID
+}
+
+func (r *schemaResolver) DeleteRole(ctx context.Context, args *DeleteRoleArgs) (*EmptyResponse, error) {
+	// 🚨 SECURITY: Only site admins can delete roles.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	roleID, err := unmarshalRoleID(args.Role)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.db.Roles().Delete(ctx, roleID); err != nil {
+		return nil, err
+	}
+	return &EmptyResponse{}, nil
+}
+
+type SetRolePermissionsArgs struct {
+	Role        graphql.ID
+	Permissions []graphql.ID
+}
+
+func (r *schemaResolver) SetRolePermissions(ctx context.Context, args *SetRolePermissionsArgs) (*roleResolver, error) {
+	// 🚨 SECURITY: Only site admins can change the permissions of roles.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	roleID, err := unmarshalRoleID(args.Role)
+	if err != nil {
+		return nil, err
+	}
+	permissionIDs, err := unmarshalPermissionIDs(args.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.db.Roles().SetPermissions(ctx, roleID, permissionIDs); err != nil {
+		return nil, err
+	}
+	role, err := r.db.Roles().GetByID(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+	return &roleResolver{db: r.db, role: role}, nil
+}
+
+type RoleUserArgs struct {
+	Role graphql.ID
+	User graphql.ID
+}
+
+func (r *schemaResolver) AssignRoleToUser(ctx context.Context, args *RoleUserArgs) (*EmptyResponse, error) {
+	return r.updateUserRole(ctx, args, database.RoleStore.AssignToUser)
+}
+
+func (r *schemaResolver) RevokeRoleFromUser(ctx context.Context, args *RoleUserArgs) (*EmptyResponse, error) {
+	return r.updateUserRole(ctx, args, database.RoleStore.RevokeFromUser)
+}
+
+func (r *schemaResolver) updateUserRole(ctx context.Context, args *RoleUserArgs, update func(database.RoleStore, context.Context, int32, int32) error) (*EmptyResponse, error) {
+	// 🚨 SECURITY: Only site admins can assign and revoke roles.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	roleID, err := unmarshalRoleID(args.Role)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := UnmarshalUserID(args.User)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := update(r.db.Roles(), ctx, roleID, userID); err != nil {
+		return nil, err
+	}
+	return &EmptyResponse{}, nil
+}
+
+type RoleOrgArgs struct {
+	Role graphql.ID
+	Org  graphql.ID
+}
+
+func (r *schemaResolver) AssignRoleToOrg(ctx context.Context, args *RoleOrgArgs) (*EmptyResponse, error) {
+	return r.updateOrgRole(ctx, args, database.RoleStore.AssignToOrg)
+}
+
+func (r *schemaResolver) RevokeRoleFromOrg(ctx context.Context, args *RoleOrgArgs) (*EmptyResponse, error) {
+	return r.updateOrgRole(ctx, args, database.RoleStore.RevokeFromOrg)
+}
+
+func (r *schemaResolver) updateOrgRole(ctx context.Context, args *RoleOrgArgs, update func(database.RoleStore, context.Context, int32, int32) error) (*EmptyResponse, error) {
+	// 🚨 SECURITY: Only site admins can assign and revoke roles.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	roleID, err := unmarshalRoleID(args.Role)
+	if err != nil {
+		return nil, err
+	}
+	orgID, err := UnmarshalOrgID(args.Org)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := update(r.db.Roles(), ctx, roleID, orgID); err != nil {
+		return nil, err
+	}
+	return &EmptyResponse{}, nil
+}
+
+type roleResolver struct {
+	db   database.DB
+	role *types.Role
+}
+
+func (r *roleResolver) ID() graphql.ID              { return marshalRoleID(r.role.ID) }
+func (r *roleResolver) Name() string                { return r.role.Name }
+func (r *roleResolver) System() bool                { return r.role.System }
+func (r *roleResolver) CreatedAt() gqlutil.DateTime { return gqlutil.DateTime{Time: r.role.CreatedAt} }
+
+func (r *roleResolver) Permissions(ctx context.Context) ([]*permissionResolver, error) {
+	perms, err := r.db.Permissions().List(ctx, database.PermissionListOptions{RoleID: r.role.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*permissionResolver, 0, len(perms))
+	for _, perm := range perms {
+		resolvers = append(resolvers, &permissionResolver{permission: perm})
+	}
+	return resolvers, nil
+}
+
+type permissionResolver struct {
+	permission *types.Permission
+}
+
+func (r *permissionResolver) ID() graphql.ID      { return marshalPermissionID(r.permission.ID) }
+func (r *permissionResolver) Namespace() string   { return r.permission.Namespace }
+func (r *permissionResolver) Action() string      { return r.permission.Action }
+func (r *permissionResolver) DisplayName() string { return r.permission.DisplayName() }
+func (r *permissionResolver) CreatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.permission.CreatedAt}
+}
+
+// roleConnectionResolver resolves a list of roles.
+//
+// 🚨 SECURITY: When instantiating a roleConnectionResolver value, the caller
+// MUST check permissions.
+type roleConnectionResolver struct {
+	db   database.DB
+	opts database.RolesListOptions
+
+	once  sync.Once
+	roles []*types.Role
+	err   error
+}
+
+func (r *roleConnectionResolver) compute(ctx context.Context) ([]*types.Role, error) {
+	r.once.Do(func() {
+		opts := r.opts
+		if opts.LimitOffset != nil {
+			tmp := *opts.LimitOffset
+			opts.LimitOffset = &tmp
+			opts.Limit++ // so we can detect if there is a next page
+		}
+		r.roles, r.err = r.db.Roles().List(ctx, opts)
+	})
+	return r.roles, r.err
+}
+
+func (r *roleConnectionResolver) Nodes(ctx context.Context) ([]*roleResolver, error) {
+	roles, err := r.compute(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.opts.LimitOffset != nil && len(roles) > r.opts.Limit {
+		roles = roles[:r.opts.Limit]
+	}
+
+	resolvers := make([]*roleResolver, 0, len(roles))
+	for _, role := range roles {
+		resolvers = append(resolvers, &roleResolver{db: r.db, role: role})
+	}
+	return resolvers, nil
+}
+
+func (r *roleConnectionResolver) TotalCount(ctx context.Context) (int32, error) {
+	opts := r.opts
+	opts.LimitOffset = nil
+	count, err := r.db.Roles().Count(ctx, opts)
+	return int32(count), err
+}
+
+func (r *roleConnectionResolver) PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error) {
+	roles, err := r.compute(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.opts.LimitOffset != nil && len(roles) > r.opts.Limit {
+		next := int32(r.opts.Offset + r.opts.Limit)
+		return graphqlutil.EncodeIntCursor(&next), nil
+	}
+	return graphqlutil.HasNextPage(false), nil
+}
+
+// permissionConnectionResolver resolves a list of permissions.
+//
+// 🚨 SECURITY: When instantiating a permissionConnectionResolver value, the
+// caller MUST check permissions.
+type permissionConnectionResolver struct {
+	db   database.DB
+	opts database.PermissionListOptions
+
+	once        sync.Once
+	permissions []*types.Permission
+	err         error
+}
+
+func (r *permissionConnectionResolver) compute(ctx context.Context) ([]*types.Permission, error) {
+	r.once.Do(func() {
+		opts := r.opts
+		if opts.LimitOffset != nil {
+			tmp := *opts.LimitOffset
+			opts.LimitOffset = &tmp
+			opts.Limit++ // so we can detect if there is a next page
+		}
+		r.permissions, r.err = r.db.Permissions().List(ctx, opts)
+	})
+	return r.permissions, r.err
+}
+
+func (r *permissionConnectionResolver) Nodes(ctx context.Context) ([]*permissionResolver, error) {
+	perms, err := r.compute(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.opts.LimitOffset != nil && len(perms) > r.opts.Limit {
+		perms = perms[:r.opts.Limit]
+	}
+
+	resolvers := make([]*permissionResolver, 0, len(perms))
+	for _, perm := range perms {
+		resolvers = append(resolvers, &permissionResolver{permission: perm})
+	}
+	return resolvers, nil
+}
+
+func (r *permissionConnectionResolver) TotalCount(ctx context.Context) (int32, error) {
+	opts := r.opts
+	opts.LimitOffset = nil
+	count, err := r.db.Permissions().Count(ctx, opts)
+	return int32(count), err
+}
+
+func (r *permissionConnectionResolver) PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error) {
+	perms, err := r.compute(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if r.opts.LimitOffset != nil && len(perms) > r.opts.Limit {
+		next := int32(r.opts.Offset + r.opts.Limit)
+		return graphqlutil.EncodeIntCursor(&next), nil
+	}
+	return graphqlutil.HasNextPage(false), nil
+}
@@ -0,0 +1,137 @@
+package graphqlbackend
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	mockrequire "github.com/derision-test/go-mockgen/testutil/require"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestRoles(t *testing.T) {
+	createdAt := time.Date(2022, 11, 23, 0, 0, 0, 0, time.UTC)
+
+	newDB := func(siteAdmin bool) (*database.MockDB, *database.MockRoleStore) {
+		users := database.NewMockUserStore()
+		users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{ID: 1, SiteAdmin: siteAdmin}, nil)
+
+		roles := database.NewMockRoleStore()
+		roles.ListFunc.SetDefaultReturn([]*types.Role{
+			{ID: 1, Name: "USER", System: true, CreatedAt: createdAt},
+			{ID: 3, Name: "PLATFORM", CreatedAt: createdAt},
+		}, nil)
+		roles.CountFunc.SetDefaultReturn(2, nil)
+
+		permissions := database.NewMockPermissionStore()
+		permissions.ListFunc.SetDefaultHook(func(_ context.Context, opts database.PermissionListOptions) ([]*types.Permission, error) {
+			if opts.RoleID != 3 {
+				return nil, nil
+			}
+			return []*types.Permission{{ID: 1, Namespace: "BATCH_CHANGES", Action: "ADMIN", CreatedAt: createdAt}}, nil
+		})
+
+		db := database.NewMockDB()
+		db.UsersFunc.SetDefaultReturn(users)
+		db.RolesFunc.SetDefaultReturn(roles)
+		db.PermissionsFunc.SetDefaultReturn(permissions)
+		return db, roles
+	}
+
+	ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+	query := `
+		query {
+			roles {
+				totalCount
+				nodes {
+					name
+					system
+					permissions {
+						displayName
+					}
+				}
+			}
+		}
+	`
+
+	t.Run("non site admin", func(t *testing.T) {
+		db, roles := newDB(false)
+
+		RunTests(t, []*Test{
+			{
+				Context:        ctx,
+				Schema:         mustParseGraphQLSchema(t, db),
+				Query:          query,
+				ExpectedResult: "null",
+				ExpectedErrors: []*gqlerrors.QueryError{
+					{
+						Path:          []any{"roles"},
+						Message:       auth.ErrMustBeSiteAdmin.Error(),
+						ResolverError: auth.ErrMustBeSiteAdmin,
+					},
+				},
+			},
+		})
+
+		mockrequire.NotCalled(t, roles.ListFunc)
+	})
+
+	t.Run("site admin", func(t *testing.T) {
+		db, roles := newDB(true)
+
+		RunTests(t, []*Test{
+			{
+				Context: ctx,
+				Schema:  mustParseGraphQLSchema(t, db),
+				Query:   query,
+				ExpectedResult: `
+					{
+						"roles": {
+							"totalCount": 2,
+							"nodes": [
+								{"name": "USER", "system": true, "permissions": []},
+								{"name": "PLATFORM", "system": false, "permissions": [{"displayName": "BATCH_CHANGES#ADMIN"}]}
+							]
+						}
+					}
+				`,
+			},
+		})
+
+		mockrequire.Called(t, roles.ListFunc)
+	})
+}
+
+func TestAssignRoleToUser(t *testing.T) {
+	users := database.NewMockUserStore()
+	users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{ID: 1, SiteAdmin: true}, nil)
+
+	roles := database.NewMockRoleStore()
+
+	db := database.NewMockDB()
+	db.UsersFunc.SetDefaultReturn(users)
+	db.RolesFunc.SetDefaultReturn(roles)
+
+	RunTests(t, []*Test{
+		{
+			Context: actor.WithActor(context.Background(), actor.FromUser(1)),
+			Schema:  mustParseGraphQLSchema(t, db),
+			Query: fmt.Sprintf(`
+				mutation {
+					assignRoleToUser(role: %q, user: %q) {
+						alwaysNil
+					}
+				}
+			`, marshalRoleID(3), MarshalUserID(2)),
+			ExpectedResult: `{"assignRoleToUser": {"alwaysNil": null}}`,
+		},
+	})
+
+	mockrequire.CalledOnceWith(t, roles.AssignToUserFunc, mockrequire.Values(mockrequire.Skip, int32(3), int32(2)))
+}
@@ -137,12 +137,13 @@ func (r *schemaResolver) SendSavedSearchTestNotification(ctx context.Context, ar
 }
 
 func (r *schemaResolver) CreateSavedSearch(ctx context.Context, args *struct {
-	Description string
-	Query       string
-	NotifyOwner bool
-	NotifySlack bool
-	OrgID       *graphql.ID
-	UserID      *graphql.ID
+	Description     string
+	Query           string
+	NotifyOwner     bool
+	NotifySlack     bool
+	SlackWebhookURL *string
+	OrgID           *graphql.ID
+	UserID          *graphql.ID
 }) (*savedSearchResolver, error) {
 	var userID, orgID *int32
 	// 🚨 SECURITY: Make sure the current user has permission to create a saved search for the specified user or org.
@@ -172,13 +173,22 @@ func (r *schemaResolver) CreateSavedSearch(ctx context.Context, args *struct {
 		return nil, errMissingPatternType
 	}
 
+	slackWebhookURL := args.SlackWebhookURL
+	if slackWebhookURL != nil && *slackWebhookURL == "" {
+		slackWebhookURL = nil
+	}
+	if err := validateSavedSearchNotifications(args.NotifyOwner, args.NotifySlack, userID, slackWebhookURL); err != nil {
+		return nil, err
+	}
+
 	ss, err := r.db.SavedSearches().Create(ctx, &types.SavedSearch{
-		Description: args.Description,
-		Query:       args.Query,
-		Notify:      args.NotifyOwner,
-		NotifySlack: args.NotifySlack,
-		UserID:      userID,
-		OrgID:       orgID,
+		Description:     args.Description,
+		Query:           args.Query,
+		Notify:          args.NotifyOwner,
+		NotifySlack:     args.NotifySlack,
+		UserID:          userID,
+		OrgID:           orgID,
+		SlackWebhookURL: slackWebhookURL,
 	})
 	if err != nil {
 		return nil, err
@@ -188,13 +198,14 @@ func (r *schemaResolver) CreateSavedSearch(ctx context.Context, args *struct {
 }
 
 func (r *schemaResolver) UpdateSavedSearch(ctx context.Context, args *struct {
-	ID          graphql.ID
-	Description string
-	Query       string
-	NotifyOwner bool
-	NotifySlack bool
-	OrgID       *graphql.ID
-	UserID      *graphql.ID
+	ID              graphql.ID
+	Description     string
+	Query           string
+	NotifyOwner     bool
+	NotifySlack     bool
+	SlackWebhookURL *string
+	OrgID           *graphql.ID
+	UserID          *graphql.ID
 }) (*savedSearchResolver, error) {
 	id, err := unmarshalSavedSearchID(args.ID)
 	if err != nil {
@@ -223,14 +234,32 @@ func (r *schemaResolver) UpdateSavedSearch(ctx context.Context, args *struct {
 		return nil, errMissingPatternType
 	}
 
+	// The existing Slack webhook URL is kept if none is given, and removed if
+	// it is empty.
+	slackWebhookURL := old.Config.SlackWebhookURL
+	if args.SlackWebhookURL != nil {
+		slackWebhookURL = args.SlackWebhookURL
+		if *slackWebhookURL == "" {
+			slackWebhookURL = nil
+		}
+	}
+	// Only newly enabled notifications are validated, so that saved searches which were created
+	// with notifications before they were validated (such as org-owned ones) can still be updated.
+	enablesNotify := args.NotifyOwner && !old.Config.Notify
+	enablesNotifySlack := args.NotifySlack && !old.Config.NotifySlack
+	if err := validateSavedSearchNotifications(enablesNotify, enablesNotifySlack, old.Config.UserID, slackWebhookURL); err != nil {
+		return nil, err
+	}
+
 	ss, err := r.db.SavedSearches().Update(ctx, &types.SavedSearch{
-		ID:          id,
-		Description: args.Description,
-		Query:       args.Query,
-		Notify:      args.NotifyOwner,
-		NotifySlack: args.NotifySlack,
-		UserID:      old.Config.UserID,
-		OrgID:       old.Config.OrgID,
+		ID:              id,
+		Description:     args.Description,
+		Query:           args.Query,
+		Notify:          args.NotifyOwner,
+		NotifySlack:     args.NotifySlack,
+		UserID:          old.Config.UserID,
+		OrgID:           old.Config.OrgID,
+		SlackWebhookURL: slackWebhookURL,
 	})
 	if err != nil {
 		return nil, err
@@ -239,6 +268,20 @@ func (r *schemaResolver) UpdateSavedSearch(ctx context.Context, args *struct {
 	return r.toSavedSearchResolver(*ss), nil
 }
 
+// validateSavedSearchNotifications returns an error if notifications are
+// enabled for a saved search which can't be notified. Saved searches are run
+// as their owner to check for new results, so only saved searches owned by a
+// user support notifications.
+func validateSavedSearchNotifications(notifyOwner, notifySlack bool, userID *int32, slackWebhookURL *string) error {
+	if (notifyOwner || notifySlack) && userID == nil {
+		return errors.New("notifications are only supported for saved searches owned by a user")
+	}
+	if notifySlack && slackWebhookURL == nil {
+		return errors.New("a Slack webhook URL is required to notify on Slack")
+	}
+	return nil
+}
+
 func (r *schemaResolver) DeleteSavedSearch(ctx context.Context, args *struct {
 	ID graphql.ID
 }) (*EmptyResponse, error) {
@@ -163,12 +163,13 @@ func TestCreateSavedSearch(t *testing.T) {
 
 	userID := MarshalUserID(key)
 	savedSearches, err := newSchemaResolver(db, gitserver.NewClient(db)).CreateSavedSearch(ctx, &struct {
-		Description string
-		Query       string
-		NotifyOwner bool
-		NotifySlack bool
-		OrgID       *graphql.ID
-		UserID      *graphql.ID
+		Description     string
+		Query           string
+		NotifyOwner     bool
+		NotifySlack     bool
+		SlackWebhookURL *string
+		OrgID           *graphql.ID
+		UserID          *graphql.ID
 	}{Description: "test query", Query: "test type:diff patternType:regexp", NotifyOwner: true, NotifySlack: false, OrgID: nil, UserID: &userID})
 	if err != nil {
 		t.Fatal(err)
@@ -191,12 +192,13 @@ func TestCreateSavedSearch(t *testing.T) {
 
 	// Ensure create saved search errors when patternType is not provided in the query.
 	_, err = newSchemaResolver(db, gitserver.NewClient(db)).CreateSavedSearch(ctx, &struct {
-		Description string
-		Query       string
-		NotifyOwner bool
-		NotifySlack bool
-		OrgID       *graphql.ID
-		UserID      *graphql.ID
+		Description     string
+		Query           string
+		NotifyOwner     bool
+		NotifySlack     bool
+		SlackWebhookURL *string
+		OrgID           *graphql.ID
+		UserID          *graphql.ID
 	}{Description: "test query", Query: "test type:diff", NotifyOwner: true, NotifySlack: false, OrgID: nil, UserID: &userID})
 	if err == nil {
 		t.Error("Expected error for createSavedSearch when query does not provide a patternType: field.")
@@ -234,13 +236,14 @@ func TestUpdateSavedSearch(t *testing.T) {
 
 	userID := MarshalUserID(key)
 	savedSearches, err := newSchemaResolver(db, gitserver.NewClient(db)).UpdateSavedSearch(ctx, &struct {
-		ID          graphql.ID
-		Description string
-		Query       string
-		NotifyOwner bool
-		NotifySlack bool
-		OrgID       *graphql.ID
-		UserID      *graphql.ID
+		ID              graphql.ID
+		Description     string
+		Query           string
+		NotifyOwner     bool
+		NotifySlack     bool
+		SlackWebhookURL *string
+		OrgID           *graphql.ID
+		UserID          *graphql.ID
 	}{
 		ID:          marshalSavedSearchID(key),
 		Description: "updated query description",
@@ -268,13 +271,14 @@ func TestUpdateSavedSearch(t *testing.T) {
 
 	// Ensure update saved search errors when patternType is not provided in the query.
 	_, err = newSchemaResolver(db, gitserver.NewClient(db)).UpdateSavedSearch(ctx, &struct {
-		ID          graphql.ID
-		Description string
-		Query       string
-		NotifyOwner bool
-		NotifySlack bool
-		OrgID       *graphql.ID
-		UserID      *graphql.ID
+		ID              graphql.ID
+		Description     string
+		Query           string
+		NotifyOwner     bool
+		NotifySlack     bool
+		SlackWebhookURL *string
+		OrgID           *graphql.ID
+		UserID          *graphql.ID
 	}{ID: marshalSavedSearchID(key), Description: "updated query description", Query: "test type:diff", NotifyOwner: true, NotifySlack: false, OrgID: nil, UserID: &userID})
 	if err == nil {
 		t.Error("Expected error for updateSavedSearch when query does not provide a patternType: field.")
@@ -361,13 +365,14 @@ func TestUpdateSavedSearchPermissions(t *testing.T) {
 			db.OrgMembersFunc.SetDefaultReturn(orgMembers)
 
 			_, err := newSchemaResolver(db, gitserver.NewClient(db)).UpdateSavedSearch(ctx, &struct {
-				ID          graphql.ID
-				Description string
-				Query       string
-				NotifyOwner bool
-				NotifySlack bool
-				OrgID       *graphql.ID
-				UserID      *graphql.ID
+				ID              graphql.ID
+				Description     string
+				Query           string
+				NotifyOwner     bool
+				NotifySlack     bool
+				SlackWebhookURL *string
+				OrgID           *graphql.ID
+				UserID          *graphql.ID
 			}{
 				ID:    marshalSavedSearchID(1),
 				Query: "patterntype:literal",
@@ -419,3 +424,116 @@ func TestDeleteSavedSearch(t *testing.T) {
 
 	mockrequire.Called(t, ss.DeleteFunc)
 }
+
+func TestSavedSearchNotificationsValidation(t *testing.T) {
+	ctx := context.Background()
+
+	key := int32(1)
+	users := database.NewMockUserStore()
+	users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{SiteAdmin: true, ID: key}, nil)
+
+	ss := database.NewMockSavedSearchStore()
+	ss.CreateFunc.SetDefaultHook(func(_ context.Context, s *types.SavedSearch) (*types.SavedSearch, error) {
+		return s, nil
+	})
+
+	db := database.NewMockDB()
+	db.UsersFunc.SetDefaultReturn(users)
+	db.SavedSearchesFunc.SetDefaultReturn(ss)
+
+	type createArgs = struct {
+		Description     string
+		Query           string
+		NotifyOwner     bool
+		NotifySlack     bool
+		SlackWebhookURL *string
+		OrgID           *graphql.ID
+		UserID          *graphql.ID
+	}
+	userID := MarshalUserID(key)
+	orgID := MarshalOrgID(key)
+	webhookURL := "https://hooks.slack.com/services/test"
+	r := newSchemaResolver(db, gitserver.NewClient(db))
+
+	_, err := r.CreateSavedSearch(ctx, &createArgs{Query: "test patternType:literal", NotifyOwner: true, OrgID: &orgID})
+	require.ErrorContains(t, err, "only supported for saved searches owned by a user")
+
+	_, err = r.CreateSavedSearch(ctx, &createArgs{Query: "test patternType:literal", NotifySlack: true, UserID: &userID})
+	require.ErrorContains(t, err, "Slack webhook URL is required")
+
+	created, err := r.CreateSavedSearch(ctx, &createArgs{Query: "test patternType:literal", NotifySlack: true, SlackWebhookURL: &webhookURL, UserID: &userID})
+	require.NoError(t, err)
+	require.Equal(t, &webhookURL, created.SlackWebhookURL())
+}
+
+func TestUpdateSavedSearchExistingNotifications(t *testing.T) {
+	ctx := context.Background()
+
+	key := int32(1)
+	users := database.NewMockUserStore()
+	users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{SiteAdmin: true, ID: key}, nil)
+
+	ss := database.NewMockSavedSearchStore()
+	ss.UpdateFunc.SetDefaultHook(func(_ context.Context, s *types.SavedSearch) (*types.SavedSearch, error) {
+		return s, nil
+	})
+
+	db := database.NewMockDB()
+	db.UsersFunc.SetDefaultReturn(users)
+	db.SavedSearchesFunc.SetDefaultReturn(ss)
+
+	type updateArgs = struct {
+		ID              graphql.ID
+		Description     string
+		Query           string
+		NotifyOwner     bool
+		NotifySlack     bool
+		SlackWebhookURL *string
+		OrgID           *graphql.ID
+		UserID          *graphql.ID
+	}
+	orgID := MarshalOrgID(key)
+	r := newSchemaResolver(db, gitserver.NewClient(db))
+
+	t.Run("org-owned search with existing notifications", func(t *testing.T) {
+		ss.GetByIDFunc.SetDefaultReturn(&api.SavedQuerySpecAndConfig{
+			Config: api.ConfigSavedQuery{
+				Notify:      true,
+				NotifySlack: true,
+				OrgID:       &key,
+			},
+		}, nil)
+
+		updated, err := r.UpdateSavedSearch(ctx, &updateArgs{
+			ID:          marshalSavedSearchID(key),
+			Description: "renamed",
+			Query:       "test patternType:literal",
+			NotifyOwner: true,
+			NotifySlack: true,
+			OrgID:       &orgID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "renamed", updated.Description())
+		require.True(t, updated.Notify())
+		require.True(t, updated.NotifySlack())
+	})
+
+	t.Run("org-owned search enabling notifications", func(t *testing.T) {
+		ss.GetByIDFunc.SetDefaultReturn(&api.SavedQuerySpecAndConfig{
+			Config: api.ConfigSavedQuery{
+				Notify: true,
+				OrgID:  &key,
+			},
+		}, nil)
+
+		_, err := r.UpdateSavedSearch(ctx, &updateArgs{
+			ID:          marshalSavedSearchID(key),
+			Description: "renamed",
+			Query:       "test patternType:literal",
+			NotifyOwner: true,
+			NotifySlack: true,
+			OrgID:       &orgID,
+		})
+		require.ErrorContains(t, err, "only supported for saved searches owned by a user")
+	})
+}
@@ -0,0 +1,50 @@
+package graphqlbackend
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/search/lucky"
+)
+
+// SmartSearchRules resolves the rules Smart Search applies, with their
+// click-through statistics.
+func (r *schemaResolver) SmartSearchRules(ctx context.Context) ([]*smartSearchRuleResolver, error) {
+	// 🚨 SECURITY: Only site admins may view Smart Search rule statistics.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	reports := lucky.RuleReports()
+	resolvers := make([]*smartSearchRuleResolver, 0, len(reports))
+	for _, report := range reports {
+		resolvers = append(resolvers, &smartSearchRuleResolver{report: report})
+	}
+	return resolvers, nil
+}
+
+type smartSearchRuleResolver struct {
+	report lucky.RuleReport
+}
+
+func (r *smartSearchRuleResolver) Description() string { return r.report.Description }
+
+func (r *smartSearchRuleResolver) Kind() string { return string(r.report.Kind) }
+
+func (r *smartSearchRuleResolver) Impressions() int32 { return int32(r.report.Impressions) }
+
+func (r *smartSearchRuleResolver) Clicks() int32 { return int32(r.report.Clicks) }
+
+func (r *smartSearchRuleResolver) ClickThroughRate() float64 { return r.report.ClickThroughRate() }
+
+func (r *smartSearchRuleResolver) DefaultPosition() int32 { return int32(r.report.DefaultPosition) }
+
+func (r *smartSearchRuleResolver) LearnedPosition() *int32 {
+	if r.report.Suppressed {
+		return nil
+	}
+	position := int32(r.report.LearnedPosition)
+	return &position
+}
+
+func (r *smartSearchRuleResolver) Suppressed() bool { return r.report.Suppressed }
@@ -0,0 +1,69 @@
+package graphqlbackend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/search/lucky"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestSmartSearchRules(t *testing.T) {
+	users := database.NewMockUserStore()
+	db := database.NewMockDB()
+	db.UsersFunc.SetDefaultReturn(users)
+
+	t.Run("non-admin", func(t *testing.T) {
+		users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{ID: 1}, nil)
+
+		_, err := newSchemaResolver(db, nil).SmartSearchRules(context.Background())
+		if want := auth.ErrMustBeSiteAdmin; err != want {
+			t.Errorf("got err %v, want %v", err, want)
+		}
+	})
+
+	t.Run("admin", func(t *testing.T) {
+		users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{ID: 1, SiteAdmin: true}, nil)
+
+		lucky.SetRuleStatistics(map[string]lucky.RuleStatistics{
+			"AND patterns together":           {Impressions: 100, Clicks: 25},
+			"patterns as regular expressions": {Impressions: 1000, Clicks: 1},
+		})
+		t.Cleanup(func() { lucky.SetRuleStatistics(nil) })
+
+		RunTests(t, []*Test{
+			{
+				Schema: mustParseGraphQLSchema(t, db),
+				Query: `
+				{
+					smartSearchRules {
+						description
+						kind
+						impressions
+						clicks
+						clickThroughRate
+						defaultPosition
+						learnedPosition
+						suppressed
+					}
+				}
+			`,
+				ExpectedResult: `
+				{
+					"smartSearchRules": [
+						{"description": "unquote patterns", "kind": "NARROW", "impressions": 0, "clicks": 0, "clickThroughRate": 0, "defaultPosition": 0, "learnedPosition": 0, "suppressed": false},
+						{"description": "apply search type for pattern", "kind": "NARROW", "impressions": 0, "clicks": 0, "clickThroughRate": 0, "defaultPosition": 1, "learnedPosition": 1, "suppressed": false},
+						{"description": "apply language filter for pattern", "kind": "NARROW", "impressions": 0, "clicks": 0, "clickThroughRate": 0, "defaultPosition": 2, "learnedPosition": 2, "suppressed": false},
+						{"description": "apply symbol select for pattern", "kind": "NARROW", "impressions": 0, "clicks": 0, "clickThroughRate": 0, "defaultPosition": 3, "learnedPosition": 3, "suppressed": false},
+						{"description": "expand URL to filters", "kind": "NARROW", "impressions": 0, "clicks": 0, "clickThroughRate": 0, "defaultPosition": 4, "learnedPosition": 4, "suppressed": false},
+						{"description": "patterns as regular expressions", "kind": "WIDEN", "impressions": 1000, "clicks": 1, "clickThroughRate": 0.001, "defaultPosition": 0, "learnedPosition": null, "suppressed": true},
+						{"description": "AND patterns together", "kind": "WIDEN", "impressions": 100, "clicks": 25, "clickThroughRate": 0.25, "defaultPosition": 1, "learnedPosition": 0, "suppressed": false}
+					]
+				}
+			`,
+			},
+		})
+	})
+}
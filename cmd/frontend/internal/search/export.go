@@ -0,0 +1,514 @@
+package search
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	otlog "github.com/opentracing/opentracing-go/log"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/envvar"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/client"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming/api"
+	streamclient "github.com/sourcegraph/sourcegraph/internal/search/streaming/client"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ExportHandler is an http handler which runs a search and streams back its
+// results as CSV or JSON Lines, one row per match. Unlike StreamHandler, it
+// sends every result up to the limit of the query (including count:all) and
+// is meant to be consumed by spreadsheets and scripts.
+//
+// The final progress of the search, including the reasons why results may
+// have been skipped, is written after the last match.
+func ExportHandler(db database.DB) http.Handler {
+	logger := log.Scoped("searchExportHandler", "")
+	return &exportHandler{
+		logger:       logger,
+		db:           db,
+		searchClient: client.NewSearchClient(logger, db, search.Indexed(), search.SearcherURLs()),
+	}
+}
+
+type exportHandler struct {
+	logger       log.Logger
+	db           database.DB
+	searchClient client.SearchClient
+}
+
+func (h *exportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tr, ctx := trace.New(r.Context(), "search.ServeExport", "")
+	defer tr.Finish()
+	r = r.WithContext(ctx)
+
+	args, err := parseExportURLQuery(r.URL.Query())
+	if err != nil {
+		tr.SetError(err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	tr.TagFields(
+		otlog.String("query", args.Query),
+		otlog.String("version", args.Version),
+		otlog.String("pattern_type", args.PatternType),
+		otlog.String("format", string(args.Format)),
+	)
+
+	w.Header().Set("Content-Type", args.Format.contentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "search-results."+string(args.Format)))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+
+	ew := newExportWriter(w, args.Format)
+	if err := h.serveHTTP(r, tr, args, ew); err != nil {
+		tr.SetError(err)
+		_ = ew.Error(err)
+	}
+	_ = ew.Flush()
+}
+
+func (h *exportHandler) serveHTTP(r *http.Request, tr *trace.Trace, args *exportArgs, ew exportWriter) error {
+	ctx := r.Context()
+	start := time.Now()
+
+	settings, err := graphqlbackend.DecodedViewerFinalSettings(ctx, h.db)
+	if err != nil {
+		return err
+	}
+
+	inputs, err := h.searchClient.Plan(
+		ctx,
+		args.Version,
+		strPtr(args.PatternType),
+		args.Query,
+		search.Precise,
+		search.Streaming,
+		settings,
+		envvar.SourcegraphDotComMode(),
+	)
+	if err != nil {
+		var queryErr *client.QueryError
+		if errors.As(err, &queryErr) {
+			return ew.Alert(search.AlertForQuery(queryErr.Query, queryErr.Err))
+		}
+		return err
+	}
+
+	limit := inputs.MaxResults()
+	progress := &streamclient.ProgressAggregator{
+		Start:        start,
+		Limit:        limit,
+		Trace:        trace.URL(trace.ID(ctx), conf.DefaultClient()),
+		DisplayLimit: limit,
+		RepoNamer:    streamclient.RepoNamer(ctx, h.db),
+	}
+
+	stream := &exportStream{
+		ctx:       ctx,
+		handler:   h,
+		writer:    ew,
+		progress:  progress,
+		remaining: limit,
+	}
+
+	// timeout: and count: are enforced by the jobs created from the plan, so
+	// we only need to pass the results through.
+	alert, err := h.searchClient.Execute(ctx, stream, inputs)
+	if alert != nil {
+		if alertErr := ew.Alert(alert); alertErr != nil {
+			err = errors.Append(err, alertErr)
+		}
+	}
+	logSearch(ctx, h.logger, alert, err, start, inputs.OriginalQuery, progress)
+	if err != nil {
+		return err
+	}
+
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+	return ew.Progress(progress.Final())
+}
+
+// exportStream is a streaming.Sender which writes the results it receives to
+// an exportWriter.
+type exportStream struct {
+	ctx     context.Context
+	handler *exportHandler
+
+	mu        sync.Mutex
+	writer    exportWriter
+	progress  *streamclient.ProgressAggregator
+	remaining int
+}
+
+func (s *exportStream) Send(event streaming.SearchEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.progress.Update(event)
+	s.remaining = event.Results.Limit(s.remaining)
+
+	repoMetadata, err := getEventRepoMetadata(s.ctx, s.handler.db, event)
+	if err != nil {
+		s.handler.logger.Error("failed to get repo metadata", log.Error(err))
+		return
+	}
+
+	for _, match := range event.Results {
+		repo := match.RepoName()
+
+		// Don't export matches which we cannot map to a repo the actor has
+		// access to. See eventHandler.Send.
+		if md, ok := repoMetadata[repo.ID]; !ok || md.Name != repo.Name {
+			continue
+		}
+
+		for _, row := range exportRowsFromMatch(match) {
+			if err := s.writer.Row(row); err != nil {
+				s.handler.logger.Warn("failed to write export row", log.Error(err))
+				return
+			}
+		}
+	}
+
+	if err := s.writer.Flush(); err != nil {
+		s.handler.logger.Warn("failed to flush export", log.Error(err))
+	}
+}
+
+// exportFormat is the format of a search export.
+type exportFormat string
+
+const (
+	exportFormatCSV   exportFormat = "csv"
+	exportFormatJSONL exportFormat = "jsonl"
+)
+
+func (f exportFormat) contentType() string {
+	if f == exportFormatJSONL {
+		return "application/x-ndjson; charset=utf-8"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+type exportArgs struct {
+	Query       string
+	Version     string
+	PatternType string
+	Format      exportFormat
+}
+
+func parseExportURLQuery(q url.Values) (*exportArgs, error) {
+	get := func(k, def string) string {
+		v := q.Get(k)
+		if v == "" {
+			return def
+		}
+		return v
+	}
+
+	a := exportArgs{
+		Query:       get("q", ""),
+		Version:     get("v", "V3"),
+		PatternType: get("t", ""),
+		Format:      exportFormat(strings.ToLower(get("format", string(exportFormatCSV)))),
+	}
+
+	if a.Query == "" {
+		return nil, errors.New("no query found")
+	}
+
+	switch a.Format {
+	case exportFormatCSV, exportFormatJSONL:
+	default:
+		return nil, errors.Errorf("format must be one of %q or %q, got %q", exportFormatCSV, exportFormatJSONL, a.Format)
+	}
+
+	return &a, nil
+}
+
+// exportRow is a single row of a search export. Which fields are set depends
+// on the type of the match the row was created from.
+type exportRow struct {
+	Type            string     `json:"type"`
+	Repository      string     `json:"repository"`
+	Revision        string     `json:"revision,omitempty"`
+	Path            string     `json:"path,omitempty"`
+	Line            int        `json:"line,omitempty"`
+	Preview         string     `json:"preview,omitempty"`
+	SymbolName      string     `json:"symbolName,omitempty"`
+	SymbolKind      string     `json:"symbolKind,omitempty"`
+	SymbolContainer string     `json:"symbolContainer,omitempty"`
+	Commit          string     `json:"commit,omitempty"`
+	Author          string     `json:"author,omitempty"`
+	AuthorDate      *time.Time `json:"authorDate,omitempty"`
+}
+
+var exportCSVHeader = []string{
+	"type",
+	"repository",
+	"revision",
+	"path",
+	"line",
+	"preview",
+	"symbol_name",
+	"symbol_kind",
+	"symbol_container",
+	"commit",
+	"author",
+	"author_date",
+}
+
+func (r exportRow) csvRecord() []string {
+	var line, authorDate string
+	if r.Line > 0 {
+		line = strconv.Itoa(r.Line)
+	}
+	if r.AuthorDate != nil {
+		authorDate = r.AuthorDate.UTC().Format(time.RFC3339)
+	}
+	return []string{
+		r.Type,
+		r.Repository,
+		r.Revision,
+		r.Path,
+		line,
+		r.Preview,
+		r.SymbolName,
+		r.SymbolKind,
+		r.SymbolContainer,
+		r.Commit,
+		r.Author,
+		authorDate,
+	}
+}
+
+// exportRowsFromMatch converts a match into the rows of an export. Content
+// matches result in one row per matched line and symbol matches in one row
+// per symbol.
+func exportRowsFromMatch(match result.Match) []exportRow {
+	switch v := match.(type) {
+	case *result.FileMatch:
+		base := exportRow{
+			Repository: string(v.Repo.Name),
+			Revision:   string(v.CommitID),
+			Path:       v.Path,
+		}
+		if v.InputRev != nil && *v.InputRev != "" {
+			base.Revision = *v.InputRev
+		}
+
+		if len(v.Symbols) > 0 {
+			rows := make([]exportRow, 0, len(v.Symbols))
+			for _, sym := range v.Symbols {
+				row := base
+				row.Type = "symbol"
+				row.Line = sym.Symbol.Line
+				row.SymbolName = sym.Symbol.Name
+				row.SymbolKind = "UNKNOWN"
+				if kind := sym.Symbol.LSPKind(); kind != 0 {
+					row.SymbolKind = strings.ToUpper(kind.String())
+				}
+				row.SymbolContainer = sym.Symbol.Parent
+				rows = append(rows, row)
+			}
+			return rows
+		}
+
+		if v.ChunkMatches.MatchCount() > 0 {
+			lineMatches := v.ChunkMatches.AsLineMatches()
+			rows := make([]exportRow, 0, len(lineMatches))
+			for _, lm := range lineMatches {
+				row := base
+				row.Type = "content"
+				row.Line = int(lm.LineNumber) + 1
+				row.Preview = lm.Preview
+				rows = append(rows, row)
+			}
+			return rows
+		}
+
+		base.Type = "path"
+		return []exportRow{base}
+
+	case *result.RepoMatch:
+		return []exportRow{{
+			Type:       "repo",
+			Repository: string(v.Name),
+			Revision:   v.Rev,
+		}}
+
+	case *result.CommitMatch:
+		typ := "commit"
+		if v.DiffPreview != nil {
+			typ = "diff"
+		}
+		return []exportRow{{
+			Type:       typ,
+			Repository: string(v.Repo.Name),
+			Commit:     string(v.Commit.ID),
+			Preview:    v.Commit.Message.Subject(),
+			Author:     v.Commit.Author.Name,
+			AuthorDate: &v.Commit.Author.Date,
+		}}
+
+	default:
+		panic(fmt.Sprintf("unknown match type %T", v))
+	}
+}
+
+// exportWriter writes the rows of a search export, followed by the final
+// progress or an alert or error, in a specific format.
+type exportWriter interface {
+	Row(exportRow) error
+	Progress(api.Progress) error
+	Alert(*search.Alert) error
+	Error(error) error
+	// Flush writes any buffered data to the client.
+	Flush() error
+}
+
+func newExportWriter(w http.ResponseWriter, format exportFormat) exportWriter {
+	flush := func() {}
+	if f, ok := w.(http.Flusher); ok {
+		flush = f.Flush
+	}
+
+	if format == exportFormatJSONL {
+		return &jsonlExportWriter{enc: json.NewEncoder(w), flush: flush}
+	}
+	return &csvExportWriter{w: csv.NewWriter(w), flush: flush}
+}
+
+// csvExportWriter writes a CSV export. The progress, alerts and errors are
+// written as trailing rows with the type "progress", "skipped", "alert" and
+// "error", respectively, and their message in the preview column.
+type csvExportWriter struct {
+	w           *csv.Writer
+	flush       func()
+	wroteHeader bool
+}
+
+func (c *csvExportWriter) Row(row exportRow) error {
+	if !c.wroteHeader {
+		c.wroteHeader = true
+		if err := c.w.Write(exportCSVHeader); err != nil {
+			return err
+		}
+	}
+	record := row.csvRecord()
+	for i, cell := range record {
+		record[i] = escapeCSVFormula(cell)
+	}
+	return c.w.Write(record)
+}
+
+// escapeCSVFormula prefixes cells that spreadsheet applications would
+// interpret as a formula with a single quote, so that matched content can't
+// run formulas when the export is opened (CSV injection).
+func escapeCSVFormula(cell string) string {
+	if cell == "" {
+		return cell
+	}
+	switch cell[0] {
+	case '=', '+', '-', '@', '\t', '\r':
+		return "'" + cell
+	}
+	return cell
+}
+
+func (c *csvExportWriter) Progress(progress api.Progress) error {
+	if err := c.Row(exportRow{
+		Type:    "progress",
+		Preview: fmt.Sprintf("%d results in %dms", progress.MatchCount, progress.DurationMs),
+	}); err != nil {
+		return err
+	}
+	for _, sk := range progress.Skipped {
+		if err := c.Row(exportRow{
+			Type:    "skipped",
+			Preview: fmt.Sprintf("%s (%s): %s", sk.Title, sk.Reason, sk.Message),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *csvExportWriter) Alert(alert *search.Alert) error {
+	return c.Row(exportRow{
+		Type:    "alert",
+		Preview: fmt.Sprintf("%s: %s", alert.Title, alert.Description),
+	})
+}
+
+func (c *csvExportWriter) Error(err error) error {
+	return c.Row(exportRow{Type: "error", Preview: err.Error()})
+}
+
+func (c *csvExportWriter) Flush() error {
+	// Always write the header, so that empty exports are valid CSV files
+	// with the expected columns.
+	if !c.wroteHeader {
+		c.wroteHeader = true
+		if err := c.w.Write(exportCSVHeader); err != nil {
+			return err
+		}
+	}
+	c.w.Flush()
+	c.flush()
+	return c.w.Error()
+}
+
+// jsonlExportWriter writes a JSON Lines export. Every line is a JSON object
+// with a "type" field. The progress, alerts and errors are written as lines
+// with the type "progress", "alert" and "error", respectively.
+type jsonlExportWriter struct {
+	enc   *json.Encoder
+	flush func()
+}
+
+func (j *jsonlExportWriter) Row(row exportRow) error {
+	return j.enc.Encode(row)
+}
+
+func (j *jsonlExportWriter) Progress(progress api.Progress) error {
+	return j.enc.Encode(struct {
+		Type string `json:"type"`
+		api.Progress
+	}{Type: "progress", Progress: progress})
+}
+
+func (j *jsonlExportWriter) Alert(alert *search.Alert) error {
+	return j.enc.Encode(struct {
+		Type        string `json:"type"`
+		Title       string `json:"title"`
+		Description string `json:"description"`
+	}{Type: "alert", Title: alert.Title, Description: alert.Description})
+}
+
+func (j *jsonlExportWriter) Error(err error) error {
+	return j.enc.Encode(struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	}{Type: "error", Message: err.Error()})
+}
+
+func (j *jsonlExportWriter) Flush() error {
+	j.flush()
+	return nil
+}
@@ -0,0 +1,168 @@
+package search
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	api2 "github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/client"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestServeExport(t *testing.T) {
+	graphqlbackend.MockDecodedViewerFinalSettings = &schema.Settings{}
+	t.Cleanup(func() { graphqlbackend.MockDecodedViewerFinalSettings = nil })
+
+	repo := types.MinimalRepo{ID: 1, Name: "github.com/sourcegraph/sourcegraph"}
+	authorDate := time.Date(2022, 11, 28, 12, 0, 0, 0, time.UTC)
+
+	mock := client.NewMockSearchClient()
+	mock.PlanFunc.SetDefaultReturn(&search.Inputs{Query: query.Q{query.Parameter{Field: "count", Value: "1000"}}}, nil)
+	mock.ExecuteFunc.SetDefaultHook(func(_ context.Context, s streaming.Sender, _ *search.Inputs) (*search.Alert, error) {
+		s.Send(streaming.SearchEvent{
+			Results: result.Matches{
+				&result.FileMatch{
+					File: result.File{Repo: repo, CommitID: "deadbeef", Path: "main.go"},
+					ChunkMatches: result.ChunkMatches{{
+						Content:      "func main() {",
+						ContentStart: result.Location{Line: 4},
+						Ranges: result.Ranges{{
+							Start: result.Location{Offset: 5, Line: 4, Column: 5},
+							End:   result.Location{Offset: 9, Line: 4, Column: 9},
+						}},
+					}},
+				},
+				// Content that looks like a spreadsheet formula is escaped in CSV exports.
+				&result.FileMatch{
+					File: result.File{Repo: repo, CommitID: "deadbeef", Path: "=cmd.csv"},
+					ChunkMatches: result.ChunkMatches{{
+						Content:      `=HYPERLINK("http://example.com","main")`,
+						ContentStart: result.Location{Line: 0},
+						Ranges: result.Ranges{{
+							Start: result.Location{Offset: 33, Line: 0, Column: 33},
+							End:   result.Location{Offset: 37, Line: 0, Column: 37},
+						}},
+					}},
+				},
+				&result.FileMatch{
+					File: result.File{Repo: repo, CommitID: "deadbeef", Path: "main.go"},
+					Symbols: []*result.SymbolMatch{{
+						Symbol: result.Symbol{Name: "main", Kind: "function", Line: 5},
+					}},
+				},
+				&result.RepoMatch{Name: repo.Name, ID: repo.ID},
+				&result.CommitMatch{
+					Repo: repo,
+					Commit: gitdomain.Commit{
+						ID:      "cafebabe",
+						Message: "Add main\n\nWith a body.",
+						Author:  gitdomain.Signature{Name: "Alice", Date: authorDate},
+					},
+					MessagePreview: &result.MatchedString{Content: "Add main\n\nWith a body."},
+				},
+				// Matches in repositories the actor cannot access are not exported.
+				&result.RepoMatch{Name: "private", ID: 2},
+			},
+		})
+		return nil, nil
+	})
+
+	mockRepos := database.NewMockRepoStore()
+	mockRepos.MetadataFunc.SetDefaultHook(func(_ context.Context, ids ...api2.RepoID) ([]*types.SearchedRepo, error) {
+		out := make([]*types.SearchedRepo, 0, len(ids))
+		for _, id := range ids {
+			if id == repo.ID {
+				out = append(out, &types.SearchedRepo{ID: id, Name: repo.Name})
+			}
+		}
+		return out, nil
+	})
+	mockRepos.GetByIDsFunc.SetDefaultReturn(nil, nil)
+
+	db := database.NewMockDB()
+	db.ReposFunc.SetDefaultReturn(mockRepos)
+
+	ts := httptest.NewServer(&exportHandler{
+		logger:       logtest.Scoped(t),
+		db:           db,
+		searchClient: mock,
+	})
+	t.Cleanup(ts.Close)
+
+	get := func(t *testing.T, params string) *http.Response {
+		t.Helper()
+		res, err := http.Get(ts.URL + "?q=main" + params)
+		require.NoError(t, err)
+		t.Cleanup(func() { res.Body.Close() })
+		return res
+	}
+
+	t.Run("csv", func(t *testing.T) {
+		res := get(t, "")
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.Equal(t, "text/csv; charset=utf-8", res.Header.Get("Content-Type"))
+
+		records, err := csv.NewReader(res.Body).ReadAll()
+		require.NoError(t, err)
+
+		want := [][]string{
+			exportCSVHeader,
+			{"content", "github.com/sourcegraph/sourcegraph", "deadbeef", "main.go", "5", "func main() {", "", "", "", "", "", ""},
+			{"content", "github.com/sourcegraph/sourcegraph", "deadbeef", "'=cmd.csv", "1", `'=HYPERLINK("http://example.com","main")`, "", "", "", "", "", ""},
+			{"symbol", "github.com/sourcegraph/sourcegraph", "deadbeef", "main.go", "5", "", "main", "FUNCTION", "", "", "", ""},
+			{"repo", "github.com/sourcegraph/sourcegraph", "", "", "", "", "", "", "", "", "", ""},
+			{"commit", "github.com/sourcegraph/sourcegraph", "", "", "", "Add main", "", "", "", "cafebabe", "Alice", "2022-11-28T12:00:00Z"},
+		}
+		require.GreaterOrEqual(t, len(records), len(want)+1)
+		if diff := cmp.Diff(want, records[:len(want)]); diff != "" {
+			t.Fatalf("unexpected records (-want +got):\n%s", diff)
+		}
+		require.Equal(t, "progress", records[len(want)][0])
+	})
+
+	t.Run("jsonl", func(t *testing.T) {
+		res := get(t, "&format=jsonl")
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.Equal(t, "application/x-ndjson; charset=utf-8", res.Header.Get("Content-Type"))
+
+		var rowTypes []string
+		var last map[string]any
+		scanner := bufio.NewScanner(res.Body)
+		for scanner.Scan() {
+			last = map[string]any{}
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &last))
+			rowTypes = append(rowTypes, last["type"].(string))
+		}
+		require.NoError(t, scanner.Err())
+
+		if diff := cmp.Diff([]string{"content", "content", "symbol", "repo", "commit", "progress"}, rowTypes); diff != "" {
+			t.Fatalf("unexpected types (-want +got):\n%s", diff)
+		}
+		// Like the stream, the progress counts all matches found by the search.
+		require.Equal(t, float64(6), last["matchCount"])
+		require.Equal(t, true, last["done"])
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		res := get(t, "&format=xlsx")
+		require.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+}
@@ -323,6 +323,7 @@ func fromPathMatch(fm *result.FileMatch, repoCache map[api.RepoID]*types.Searche
 		Repository:   string(fm.Repo.Name),
 		RepositoryID: int32(fm.Repo.ID),
 		Commit:       string(fm.CommitID),
+		Owners:       fm.Owners,
 	}
 
 	if r, ok := repoCache[fm.Repo.ID]; ok {
@@ -402,6 +403,7 @@ func fromContentMatch(fm *result.FileMatch, repoCache map[api.RepoID]*types.Sear
 		Commit:       string(fm.CommitID),
 		LineMatches:  eventLineMatches,
 		ChunkMatches: eventChunkMatches,
+		Owners:       fm.Owners,
 	}
 
 	if fm.InputRev != nil {
@@ -196,6 +196,14 @@ func (s *Server) cleanupRepos(ctx context.Context, gitServerAddrs gitserver.GitS
 	bCtx, bCancel := s.serverContext()
 	defer bCancel()
 
+	// Repos that a gitserver rebalancing moved to this shard are expected to be
+	// here, even though the current gitserver addresses don't assign them to this
+	// shard yet.
+	rebalancedHere, rebalanceErr := s.reposRebalancedHere(bCtx)
+	if rebalanceErr != nil {
+		logger.Warn("failed to list repos moved to this shard, will not delete repos cloned on the wrong shard", log.Error(rebalanceErr))
+	}
+
 	stats := protocol.ReposStats{
 		UpdatedAt: time.Now(),
 	}
@@ -233,11 +241,15 @@ func (s *Server) cleanupRepos(ctx context.Context, gitServerAddrs gitserver.GitS
 			return
 		}
 
+		if _, ok := rebalancedHere[name]; ok {
+			return false, nil
+		}
+
 		if !s.hostnameMatch(addr) {
 			wrongShardRepoCount++
 			wrongShardRepoSize += size
 
-			if knownGitServerShard && wrongShardReposDeleteLimit > 0 && wrongShardReposDeleted < int64(wrongShardReposDeleteLimit) {
+			if knownGitServerShard && rebalancedHere != nil && wrongShardReposDeleteLimit > 0 && wrongShardReposDeleted < int64(wrongShardReposDeleteLimit) {
 				logger.Info(
 					"removing repo cloned on the wrong shard",
 					log.String("dir", string(dir)),
@@ -571,6 +583,28 @@ func (s *Server) cleanupRepos(ctx context.Context, gitServerAddrs gitserver.GitS
 	}
 }
 
+// reposRebalancedHere returns the repos that a gitserver rebalancing is moving
+// or has moved to this shard. The returned map is never nil if err is nil.
+func (s *Server) reposRebalancedHere(ctx context.Context) (map[api.RepoName]struct{}, error) {
+	moves, err := s.DB.GitserverRepos().ListRebalanceMoves(ctx, database.ListGitserverRepoMovesOptions{
+		States: []database.GitserverRebalanceState{
+			database.GitserverRebalanceMigrating,
+			database.GitserverRebalanceCompleted,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	repos := make(map[api.RepoName]struct{})
+	for _, m := range moves {
+		if s.hostnameMatch(m.To) {
+			repos[protocol.NormalizeRepo(m.RepoName)] = struct{}{}
+		}
+	}
+	return repos, nil
+}
+
 // setRepoSizes uses calculated sizes of repos to update database entries of repos
 // with actual sizes, but only up to 10,000 in one run.
 func (s *Server) setRepoSizes(ctx context.Context, repoToSize map[api.RepoName]int64) error {
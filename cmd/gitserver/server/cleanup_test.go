@@ -219,6 +219,47 @@ func TestCleanupWrongShard(t *testing.T) {
 			t.Error("expected repoD assigned to different shard to be removed")
 		}
 	})
+	t.Run("rebalancedToShard", func(t *testing.T) {
+		root := t.TempDir()
+		// should be allocated to shard gitserver-1
+		testRepoD := "testrepo-D"
+
+		repoD := path.Join(root, testRepoD, ".git")
+		cmd := exec.Command("git", "--bare", "init", repoD)
+		if err := cmd.Run(); err != nil {
+			t.Fatal(err)
+		}
+
+		s := &Server{ReposDir: root,
+			Logger: logtest.Scoped(t),
+			DB:     database.NewMockDB(),
+		}
+		s.testSetup(t)
+
+		ctx := context.Background()
+		repo := &types.Repo{Name: api.RepoName(testRepoD)}
+		if err := s.DB.Repos().Create(ctx, repo); err != nil {
+			t.Fatal(err)
+		}
+		// A rebalancing moved repoD to gitserver-0, before the gitserver addresses
+		// were updated.
+		store := s.DB.GitserverRepos()
+		if err := store.QueueRebalance(ctx, &database.GitserverRepoMove{RepoID: repo.ID, From: "gitserver-1", To: "gitserver-0"}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := store.ClaimRebalance(ctx, 1, time.Now()); err != nil {
+			t.Fatal(err)
+		}
+		if err := store.SetRebalanceState(ctx, repo.ID, database.GitserverRebalanceCompleted, ""); err != nil {
+			t.Fatal(err)
+		}
+
+		s.cleanupRepos(ctx, gitserver.GitServerAddresses{Addresses: []string{"gitserver-0", "gitserver-1"}})
+
+		if _, err := os.Stat(repoD); err != nil {
+			t.Error("expected repoD moved to this shard not to be removed", err)
+		}
+	})
 	t.Run("cleanupDisabled", func(t *testing.T) {
 		root := t.TempDir()
 		// should be allocated to shard gitserver-1
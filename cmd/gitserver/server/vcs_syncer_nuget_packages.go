@@ -0,0 +1,120 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/nuget"
+	"github.com/sourcegraph/sourcegraph/internal/unpack"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func NewNuGetPackagesSyncer(
+	connection *schema.NuGetPackagesConnection,
+	svc *dependencies.Service,
+	client *nuget.Client,
+) VCSSyncer {
+	return &vcsPackagesSyncer{
+		logger:      log.Scoped("NuGetPackagesSyncer", "sync NuGet packages"),
+		typ:         "nuget_packages",
+		scheme:      dependencies.NuGetPackagesScheme,
+		placeholder: reposource.NewNuGetVersionedPackage("Sourcegraph.Placeholder", "0.0.0"),
+		svc:         svc,
+		configDeps:  connection.Dependencies,
+		source:      &nugetDependencySource{client: client},
+	}
+}
+
+// nugetDependencySource implements packagesSource
+type nugetDependencySource struct {
+	client *nuget.Client
+}
+
+func (nugetDependencySource) ParseVersionedPackageFromNameAndVersion(name reposource.PackageName, version string) (reposource.VersionedPackage, error) {
+	return reposource.ParseNuGetVersionedPackage(string(name) + "@" + version)
+}
+
+func (nugetDependencySource) ParseVersionedPackageFromConfiguration(dep string) (reposource.VersionedPackage, error) {
+	return reposource.ParseNuGetVersionedPackage(dep)
+}
+
+func (nugetDependencySource) ParsePackageFromName(name reposource.PackageName) (reposource.Package, error) {
+	return reposource.ParseNuGetPackageFromName(name)
+}
+
+func (nugetDependencySource) ParsePackageFromRepoName(repoName api.RepoName) (reposource.Package, error) {
+	return reposource.ParseNuGetPackageFromRepoName(repoName)
+}
+
+func (s *nugetDependencySource) Download(ctx context.Context, dir string, dep reposource.VersionedPackage) error {
+	pkgContents, packageURL, err := s.client.GetPackageContents(ctx, dep)
+	if err != nil {
+		return errors.Wrapf(err, "error downloading NuGet package with URL '%s'", packageURL)
+	}
+	defer pkgContents.Close()
+
+	if err = unpackNuGetPackage(pkgContents, dir); err != nil {
+		return errors.Wrapf(err, "failed to unzip NuGet package from URL %s", packageURL)
+	}
+
+	return nil
+}
+
+// unpackNuGetPackage unpacks the given .nupkg archive into workDir, skipping the
+// parts of the archive which only describe the package format, and any files
+// that aren't valid or that are potentially malicious.
+func unpackNuGetPackage(pkg io.Reader, workDir string) error {
+	logger := log.Scoped("unpackNuGetPackage", "unpackNuGetPackage unpacks the given NuGet package archive into workDir")
+
+	pkgBytes, err := io.ReadAll(pkg)
+	if err != nil {
+		return err
+	}
+
+	opts := unpack.Opts{
+		SkipInvalid:    true,
+		SkipDuplicates: true,
+		Filter: func(p string, file fs.FileInfo) bool {
+			if isNuGetPackagingPart(p) {
+				return false
+			}
+
+			size := file.Size()
+
+			const sizeLimit = 15 * 1024 * 1024
+			if size >= sizeLimit {
+				logger.With(
+					log.String("path", file.Name()),
+					log.Int64("size", size),
+					log.Float64("limit", sizeLimit),
+				).Warn("skipping large file in NuGet package")
+				return false
+			}
+
+			_, malicious := isPotentiallyMaliciousFilepathInArchive(p, workDir)
+			return !malicious
+		},
+	}
+
+	return unpack.Zip(bytes.NewReader(pkgBytes), int64(len(pkgBytes)), workDir, opts)
+}
+
+// isNuGetPackagingPart returns true for the files of the Open Packaging Conventions
+// container format of .nupkg archives, and for the package signature.
+func isNuGetPackagingPart(p string) bool {
+	p = strings.TrimPrefix(path.Clean(p), "/")
+	return p == "[Content_Types].xml" ||
+		p == ".signature.p7s" ||
+		strings.HasPrefix(p, "_rels/") ||
+		strings.HasPrefix(p, "package/services/metadata/")
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUnpackNuGetPackage(t *testing.T) {
+	pkg := bytes.NewReader(createZip(t, []fileInfo{
+		{path: "_rels/.rels", contents: []byte("rels")},
+		{path: "[Content_Types].xml", contents: []byte("types")},
+		{path: "package/services/metadata/core-properties/abc.psmdcp", contents: []byte("props")},
+		{path: ".signature.p7s", contents: []byte("signature")},
+		{path: "Example.nuspec", contents: []byte("nuspec")},
+		{path: "lib/netstandard2.0/Example.xml", contents: []byte("docs")},
+		{path: "contentFiles/cs/any/Example.cs", contents: []byte("class Example {}")},
+		{path: "../escape.cs", contents: []byte("filter me")},
+	}))
+
+	tmp := t.TempDir()
+	if err := unpackNuGetPackage(pkg, tmp); err != nil {
+		t.Fatal(err)
+	}
+
+	// Unlike other package archives, the files of a NuGet package are not nested in
+	// a single directory, so nothing is stripped.
+	want := []string{"/Example.nuspec", "/contentFiles/cs/any/Example.cs", "/lib/netstandard2.0/Example.xml"}
+	if d := cmp.Diff(want, listFiles(t, tmp)); d != "" {
+		t.Fatalf("-want,+got\n%s", d)
+	}
+}
+
+func createZip(t *testing.T, fileInfos []fileInfo) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, f := range fileInfos {
+		fw, err := zw.Create(f.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := fw.Write(f.contents); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+// listFiles returns the sorted paths of the files in dir, relative to dir.
+func listFiles(t *testing.T, dir string) []string {
+	t.Helper()
+
+	var files []string
+	if err := filepath.Walk(dir, func(path string, info fs.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		files = append(files, "/"+filepath.ToSlash(rel))
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	sort.Strings(files)
+	return files
+}
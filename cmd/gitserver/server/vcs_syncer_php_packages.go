@@ -0,0 +1,131 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/fs"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/packagist"
+	"github.com/sourcegraph/sourcegraph/internal/unpack"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func NewPhpPackagesSyncer(
+	connection *schema.PhpPackagesConnection,
+	svc *dependencies.Service,
+	client *packagist.Client,
+) VCSSyncer {
+	return &vcsPackagesSyncer{
+		logger:      log.Scoped("PhpPackagesSyncer", "sync PHP packages"),
+		typ:         "php_packages",
+		scheme:      dependencies.PhpPackagesScheme,
+		placeholder: reposource.NewPhpVersionedPackage("sourcegraph/placeholder", "0.0.0"),
+		svc:         svc,
+		configDeps:  connection.Dependencies,
+		source:      &phpDependencySource{client: client},
+	}
+}
+
+// phpDependencySource implements packagesSource
+type phpDependencySource struct {
+	client *packagist.Client
+}
+
+func (phpDependencySource) ParseVersionedPackageFromNameAndVersion(name reposource.PackageName, version string) (reposource.VersionedPackage, error) {
+	return reposource.ParsePhpVersionedPackage(string(name) + "@" + version)
+}
+
+func (phpDependencySource) ParseVersionedPackageFromConfiguration(dep string) (reposource.VersionedPackage, error) {
+	return reposource.ParsePhpVersionedPackage(dep)
+}
+
+func (phpDependencySource) ParsePackageFromName(name reposource.PackageName) (reposource.Package, error) {
+	return reposource.ParsePhpPackageFromName(name)
+}
+
+func (phpDependencySource) ParsePackageFromRepoName(repoName api.RepoName) (reposource.Package, error) {
+	return reposource.ParsePhpPackageFromRepoName(repoName)
+}
+
+func (s *phpDependencySource) Download(ctx context.Context, dir string, dep reposource.VersionedPackage) error {
+	version, err := s.client.Version(ctx, dep.PackageSyntax(), dep.PackageVersion())
+	if err != nil {
+		return err
+	}
+	if version.Dist == nil || version.Dist.URL == "" {
+		return errors.Newf("PHP package %s has no dist archive", dep.VersionedPackageSyntax())
+	}
+
+	dist, err := s.client.Download(ctx, version.Dist.URL)
+	if err != nil {
+		return errors.Wrapf(err, "error downloading PHP package with URL '%s'", version.Dist.URL)
+	}
+	defer dist.Close()
+
+	if err = unpackPhpPackage(dist, version.Dist.Type, dir); err != nil {
+		return errors.Wrapf(err, "failed to unpack PHP package from URL %s", version.Dist.URL)
+	}
+
+	return nil
+}
+
+// unpackPhpPackage unpacks the given dist archive of a PHP package into workDir,
+// skipping any files that aren't valid or that are potentially malicious. Dist
+// archives are usually zipballs of a tag, which contain a single top-level
+// directory that is stripped.
+func unpackPhpPackage(dist io.Reader, distType, workDir string) error {
+	logger := log.Scoped("unpackPhpPackage", "unpackPhpPackage unpacks the given PHP package archive into workDir")
+
+	opts := unpack.Opts{
+		SkipInvalid:    true,
+		SkipDuplicates: true,
+		Filter: func(path string, file fs.FileInfo) bool {
+			size := file.Size()
+
+			const sizeLimit = 15 * 1024 * 1024
+			if size >= sizeLimit {
+				logger.With(
+					log.String("path", file.Name()),
+					log.Int64("size", size),
+					log.Float64("limit", sizeLimit),
+				).Warn("skipping large file in PHP package")
+				return false
+			}
+
+			_, malicious := isPotentiallyMaliciousFilepathInArchive(path, workDir)
+			return !malicious
+		},
+	}
+
+	distBytes, err := io.ReadAll(dist)
+	if err != nil {
+		return err
+	}
+
+	switch distType {
+	case "zip":
+		err = unpack.Zip(bytes.NewReader(distBytes), int64(len(distBytes)), workDir, opts)
+	case "tar":
+		// Composer tar dists may or may not be gzip compressed.
+		if bytes.HasPrefix(distBytes, []byte{0x1f, 0x8b}) {
+			err = unpack.Tgz(bytes.NewReader(distBytes), workDir, opts)
+		} else {
+			err = unpack.Tar(bytes.NewReader(distBytes), workDir, opts)
+		}
+	default:
+		return errors.Errorf("unsupported PHP package dist type %q", distType)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return stripSingleOutermostDirectory(workDir)
+}
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUnpackPhpPackage(t *testing.T) {
+	files := []fileInfo{
+		{path: "Seldaek-monolog-e1ea2aa/composer.json", contents: []byte("{}")},
+		{path: "Seldaek-monolog-e1ea2aa/src/Monolog/Logger.php", contents: []byte("<?php")},
+		{path: "Seldaek-monolog-e1ea2aa/.git/index", contents: []byte("filter me")},
+	}
+	want := []string{"/composer.json", "/src/Monolog/Logger.php"}
+
+	for _, tc := range []struct {
+		distType string
+		dist     []byte
+	}{
+		{"zip", createZip(t, files)},
+		{"tar", createTgz(t, files)},
+	} {
+		t.Run(tc.distType, func(t *testing.T) {
+			tmp := t.TempDir()
+			if err := unpackPhpPackage(bytes.NewReader(tc.dist), tc.distType, tmp); err != nil {
+				t.Fatal(err)
+			}
+
+			if d := cmp.Diff(want, listFiles(t, tmp)); d != "" {
+				t.Fatalf("-want,+got\n%s", d)
+			}
+		})
+	}
+
+	if err := unpackPhpPackage(bytes.NewReader(nil), "rar", t.TempDir()); err == nil {
+		t.Fatal("expected error for unsupported dist type")
+	}
+}
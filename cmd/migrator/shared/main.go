@@ -40,11 +40,7 @@ func Start(logger log.Logger, registerEnterpriseMigrators registerMigratorsUsing
 
 	outputFactory := func() *output.Output { return out }
 
-	newRunnerWithSchemas := func(ctx context.Context, schemaNames []string, schemas []*schemas.Schema) (cliutil.Runner, error) {
-		dsns, err := postgresdsn.DSNsBySchema(schemaNames)
-		if err != nil {
-			return nil, err
-		}
+	newRunnerWithDSNs := func(ctx context.Context, dsns map[string]string, schemas []*schemas.Schema) (cliutil.Runner, error) {
 		storeFactory := func(db *sql.DB, migrationsTable string) connections.Store {
 			return connections.NewStoreShim(store.NewWithDB(db, migrationsTable, operations))
 		}
@@ -55,6 +51,14 @@ func Start(logger log.Logger, registerEnterpriseMigrators registerMigratorsUsing
 
 		return cliutil.NewShim(r), nil
 	}
+	newRunnerWithSchemas := func(ctx context.Context, schemaNames []string, schemas []*schemas.Schema) (cliutil.Runner, error) {
+		dsns, err := postgresdsn.DSNsBySchema(schemaNames)
+		if err != nil {
+			return nil, err
+		}
+
+		return newRunnerWithDSNs(ctx, dsns, schemas)
+	}
 	newRunner := func(ctx context.Context, schemaNames []string) (cliutil.Runner, error) {
 		return newRunnerWithSchemas(ctx, schemaNames, schemas.Schemas)
 	}
@@ -83,6 +87,7 @@ func Start(logger log.Logger, registerEnterpriseMigrators registerMigratorsUsing
 			cliutil.Drift(appName, newRunner, outputFactory, schemaFactories...),
 			cliutil.AddLog(appName, newRunner, outputFactory),
 			cliutil.Upgrade(appName, newRunnerWithSchemas, outputFactory, registerMigrators, schemaFactories...),
+			cliutil.Plan(appName, newRunner, postgresdsn.DSNsBySchema, newRunnerWithDSNs, outputFactory, schemaFactories...),
 			cliutil.Downgrade(appName, newRunnerWithSchemas, outputFactory, registerMigrators),
 			cliutil.RunOutOfBandMigrations(appName, newRunner, outputFactory, registerMigrators),
 		},
@@ -651,7 +651,7 @@ func TestServer_RepoLookup(t *testing.T) {
 				Sourcer: repos.NewFakeSourcer(nil, tc.src),
 			}
 
-			scheduler := repos.NewUpdateScheduler(logtest.Scoped(t), database.NewMockDB())
+			scheduler := repos.NewUpdateScheduler(logtest.Scoped(t), database.NewDB(logger, db))
 
 			s := &Server{
 				Logger:    logger,
@@ -679,7 +679,10 @@ func TestServer_RepoLookup(t *testing.T) {
 			}
 
 			if tc.args.Update {
-				scheduleInfo := scheduler.ScheduleInfo(res.Repo.ID)
+				scheduleInfo, err := scheduler.ScheduleInfo(ctx, res.Repo.ID)
+				if err != nil {
+					t.Fatal(err)
+				}
 				if have, want := scheduleInfo.Queue.Priority, 1; have != want { // highPriority
 					t.Fatalf("scheduler update priority mismatch: have %d, want %d", have, want)
 				}
@@ -702,8 +705,8 @@ func TestServer_RepoLookup(t *testing.T) {
 type fakeScheduler struct{}
 
 func (s *fakeScheduler) UpdateOnce(_ api.RepoID, _ api.RepoName) {}
-func (s *fakeScheduler) ScheduleInfo(id api.RepoID) *protocol.RepoUpdateSchedulerInfoResult {
-	return &protocol.RepoUpdateSchedulerInfoResult{}
+func (s *fakeScheduler) ScheduleInfo(_ context.Context, _ api.RepoID) (*protocol.RepoUpdateSchedulerInfoResult, error) {
+	return &protocol.RepoUpdateSchedulerInfoResult{}, nil
 }
 
 type fakePermsSyncer struct{}
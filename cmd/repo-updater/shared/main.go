@@ -51,6 +51,8 @@ import (
 
 const port = "3182"
 
+var gitserverRebalanceConcurrency = env.MustGetInt("SRC_GITSERVER_REBALANCE_CONCURRENCY", 4, "the maximum number of repos moved between gitserver shards at the same time while rebalancing")
+
 //go:embed state.html.tmpl
 var stateHTMLTemplate string
 
@@ -204,6 +206,9 @@ func Main(enterpriseInit EnterpriseInit) {
 	}
 	go repos.RunRepositoryPurgeWorker(ctx, log.Scoped("RepositoryPurgeWorker", ""), db, purgeTTL)
 
+	// git-server repos rebalancing thread
+	go repos.RunGitserverRebalanceWorker(ctx, log.Scoped("GitserverRebalanceWorker", "moves repositories between gitserver shards"), db, gitserverRebalanceConcurrency)
+
 	// Git fetches scheduler
 	go repos.RunScheduler(ctx, logger, updateScheduler)
 	logger.Debug("started scheduler")
@@ -41,6 +41,11 @@ var (
 // This only interacts with zoekt so that we can leverage the normal searcher
 // code paths for the unindexed parts. IE unsearched is expected to be used to
 // fetch a zip via the store and then do a normal unindexed search.
+//
+// Zoekt can't run structural searches of unindexed commits. For structural
+// patterns zoekt only narrows down the unchanged paths which may contain a
+// match, and unsearched contains those candidates as well as the changed
+// paths.
 func (s *Service) hybrid(ctx context.Context, p *protocol.Request, sender matchSender) (unsearched []string, ok bool, err error) {
 	rootLogger := logWithTrace(ctx, s.Log).Scoped("hybrid", "experimental hybrid search").With(
 		log.String("repo", string(p.Repo)),
@@ -104,7 +109,18 @@ func (s *Service) hybrid(ctx context.Context, p *protocol.Request, sender matchS
 
 		logger.Debug("starting zoekt search")
 
-		ok, err = zoektSearchIgnorePaths(ctx, client, p, sender, indexed, indexedIgnore)
+		var candidates []string
+		if p.IsStructuralPat {
+			var limitHit bool
+			candidates, limitHit, ok, err = zoektStructuralCandidates(ctx, client, p, indexed, indexedIgnore)
+			if err == nil && ok && limitHit {
+				logger.Debug("not doing hybrid search since zoekt did not return all structural search candidates")
+				recordHybridFinalState("candidates-limit-hit")
+				return nil, false, nil
+			}
+		} else {
+			ok, err = zoektSearchIgnorePaths(ctx, client, p, sender, indexed, indexedIgnore)
+		}
 		if err != nil {
 			recordHybridFinalState("zoekt-search-error")
 			return nil, false, err
@@ -114,6 +130,25 @@ func (s *Service) hybrid(ctx context.Context, p *protocol.Request, sender matchS
 			continue
 		}
 
+		if p.IsStructuralPat {
+			totalLenCandidates := totalStringsLen(candidates)
+			logger = logger.With(
+				log.Int("candidatePaths", len(candidates)),
+				log.Int("totalLenCandidatePaths", totalLenCandidates))
+
+			if totalLenCandidates+totalLenUnindexedSearch > s.MaxTotalPathsLength {
+				logger.Debug("not doing hybrid search due to structural search candidate list exceeding MAX_TOTAL_PATHS_LENGTH",
+					log.Int("MAX_TOTAL_PATHS_LENGTH", s.MaxTotalPathsLength))
+				recordHybridFinalState("candidates-too-large")
+				return nil, false, nil
+			}
+
+			// Candidates only contain unchanged paths, so they are disjoint
+			// from the changed paths.
+			unindexedSearch = append(candidates, unindexedSearch...)
+			sort.Strings(unindexedSearch)
+		}
+
 		recordHybridFinalState("success")
 		return unindexedSearch, true, nil
 	}
@@ -133,34 +168,14 @@ func zoektSearchIgnorePaths(ctx context.Context, client zoekt.Streamer, p *proto
 	if err != nil {
 		return false, errors.Wrap(err, "failed to compile query for zoekt")
 	}
-	q := zoektquery.Simplify(zoektquery.NewAnd(
-		zoektquery.NewSingleBranchesRepos("HEAD", uint32(p.RepoID)),
-		qText,
-		zoektIgnorePaths(ignoredPaths),
-	))
-
-	opts := (&zoektutil.Options{
-		NumRepos:       1,
-		FileMatchLimit: int32(p.Limit),
-	}).ToSearch(ctx)
-	if deadline, ok := ctx.Deadline(); ok {
-		opts.MaxWallTime = time.Until(deadline) - 100*time.Millisecond
-	}
 
 	// We only support chunk matches below.
-	opts.ChunkMatches = true
-
-	res, err := client.Search(ctx, q, opts)
-	if err != nil {
+	res, ok, err := zoektSearchIndexedCommit(ctx, client, p, qText, indexed, ignoredPaths, int32(p.Limit), true)
+	if err != nil || !ok {
 		return false, err
 	}
 
 	for _, fm := range res.Files {
-		// Unexpected commit searched, signal to retry.
-		if fm.Version != string(indexed) {
-			return false, nil
-		}
-
 		cms := make([]protocol.ChunkMatch, 0, len(fm.ChunkMatches))
 		for _, cm := range fm.ChunkMatches {
 			if cm.FileName {
@@ -200,21 +215,93 @@ func zoektSearchIgnorePaths(ctx context.Context, client zoekt.Streamer, p *proto
 		})
 	}
 
+	return true, nil
+}
+
+// zoektStructuralCandidates returns the paths which may contain a match for
+// the structural pattern of p on the indexed commit. It will not search paths
+// listed under ignoredPaths. Zoekt searches for the regular expression comby
+// uses to prefilter files, so it is up to the caller to run the structural
+// search on the candidates.
+//
+// Candidates are not limited by the file match limit of p, since comby may
+// not match all of them. If zoekt did not return all candidates anyway,
+// limitHit is true.
+//
+// If we did not search the correct commit or we don't know if we did, ok is
+// false.
+func zoektStructuralCandidates(ctx context.Context, client zoekt.Streamer, p *protocol.Request, indexed api.CommitID, ignoredPaths []string) (candidates []string, limitHit, ok bool, err error) {
+	qText, err := zoektCompile(toStructuralPrefilter(&p.PatternInfo))
+	if err != nil {
+		return nil, false, false, errors.Wrap(err, "failed to compile query for zoekt")
+	}
+
+	res, ok, err := zoektSearchIndexedCommit(ctx, client, p, qText, indexed, ignoredPaths, 0, false)
+	if err != nil || !ok {
+		return nil, false, false, err
+	}
+
+	candidates = make([]string, 0, len(res.Files))
+	for _, fm := range res.Files {
+		candidates = append(candidates, fm.FileName)
+	}
+	limitHit = res.Stats.FilesSkipped+res.Stats.ShardsSkipped > 0
+	return candidates, limitHit, true, nil
+}
+
+// zoektSearchIndexedCommit runs qText on zoekt against the indexed commit of
+// the repository of p. It will not search paths listed under ignoredPaths. A
+// fileMatchLimit of 0 returns all matching files.
+//
+// If we did not search the correct commit or we don't know if we did, ok is
+// false.
+func zoektSearchIndexedCommit(ctx context.Context, client zoekt.Streamer, p *protocol.Request, qText zoektquery.Q, indexed api.CommitID, ignoredPaths []string, fileMatchLimit int32, chunkMatches bool) (_ *zoekt.SearchResult, ok bool, err error) {
+	q := zoektquery.Simplify(zoektquery.NewAnd(
+		zoektquery.NewSingleBranchesRepos("HEAD", uint32(p.RepoID)),
+		qText,
+		zoektIgnorePaths(ignoredPaths),
+	))
+
+	opts := (&zoektutil.Options{
+		NumRepos:       1,
+		FileMatchLimit: fileMatchLimit,
+	}).ToSearch(ctx)
+	if fileMatchLimit == 0 {
+		opts.MaxDocDisplayCount = 0
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		opts.MaxWallTime = time.Until(deadline) - 100*time.Millisecond
+	}
+	opts.ChunkMatches = chunkMatches
+
+	res, err := client.Search(ctx, q, opts)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, fm := range res.Files {
+		// Unexpected commit searched, signal to retry.
+		if fm.Version != string(indexed) {
+			return nil, false, nil
+		}
+	}
+
 	// we have no matches, so we don't know if we searched the correct commit.
 	if len(res.Files) == 0 {
 		newIndexed, ok, err := zoektIndexedCommit(ctx, client, p.Repo)
 		if err != nil {
-			return false, errors.Wrap(err, "failed to double check indexed commit")
+			return nil, false, errors.Wrap(err, "failed to double check indexed commit")
 		}
 		if !ok {
 			// let the retry logic handle the call to zoektIndexedCommit again
-			return false, nil
+			return nil, false, nil
+		}
+		if newIndexed != indexed {
+			return nil, false, nil
 		}
-		retry := newIndexed != indexed
-		return !retry, nil
 	}
 
-	return true, nil
+	return res, true, nil
 }
 
 // zoektCompile builds a text search zoekt query for p.
@@ -222,7 +309,7 @@ func zoektSearchIgnorePaths(ctx context.Context, client zoekt.Streamer, p *proto
 // This function should support the same features as the "compile" function,
 // but return a zoektquery instead of a readerGrep.
 //
-// Note: This is used by hybrid search and not structural search.
+// Note: This is used by hybrid search and not indexed structural search.
 func zoektCompile(p *protocol.PatternInfo) (zoektquery.Q, error) {
 	var parts []zoektquery.Q
 	// we are redoing work here, but ensures we generate the same regex and it
@@ -197,11 +197,27 @@ unchanged.md
 changed.go
 unchanged.md:3:3:
 Hello world example in go
+`,
+	}, {
+		// Zoekt only narrows down the candidates, so this relies on
+		// unchanged.md not being fetched since it can't match.
+		Name: "structural",
+		Pattern: protocol.PatternInfo{
+			Pattern:         "fmt.Println(:[x])",
+			IsStructuralPat: true,
+		},
+		Want: `
+changed.go:6:6:
+	fmt.Println("Hello world")
 `,
 	}}
 
 	for _, tc := range cases {
 		t.Run(tc.Name, func(t *testing.T) {
+			if tc.Pattern.IsStructuralPat {
+				maybeSkipComby(t)
+			}
+
 			req := protocol.Request{
 				Repo:         "foo",
 				RepoID:       123,
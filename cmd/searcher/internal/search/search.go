@@ -233,7 +233,8 @@ func (s *Service) search(ctx context.Context, p *protocol.Request, sender matchS
 		return path, zf, err
 	}
 
-	hybrid := !p.IsStructuralPat && p.FeatHybrid
+	// Hybrid search relies on Zoekt, which evaluates a single pattern.
+	hybrid := p.FeatHybrid && p.Expression == nil
 	if hybrid {
 		unsearched, ok, err := s.hybrid(ctx, p, sender)
 		if err != nil {
@@ -294,12 +295,20 @@ func validateParams(p *protocol.Request) error {
 	if len(p.Commit) != 40 {
 		return errors.Errorf("Commit must be resolved (Commit=%q)", p.Commit)
 	}
-	if p.Pattern == "" && p.ExcludePattern == "" && len(p.IncludePatterns) == 0 {
+	if p.Pattern == "" && p.Expression == nil && p.ExcludePattern == "" && len(p.IncludePatterns) == 0 {
 		return errors.New("At least one of pattern and include/exclude pattners must be non-empty")
 	}
 	if p.IsNegated && p.IsStructuralPat {
 		return errors.New("Negated patterns are not supported for structural searches")
 	}
+	if p.Expression != nil {
+		if p.IsStructuralPat {
+			return errors.New("Pattern expressions are not supported for structural searches")
+		}
+		if p.Pattern != "" || p.IsNegated {
+			return errors.New("Pattern and IsNegated must be empty when a pattern expression is set")
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,161 @@
+package search
+
+import (
+	"sort"
+
+	"github.com/sourcegraph/sourcegraph/cmd/searcher/protocol"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// matchTree is a compiled protocol.PatternExpression. Each leaf matches its
+// pattern with its own readerGrep, so like readerGrep it is not concurrency
+// safe. Use Copy to get a matchTree for another goroutine.
+type matchTree struct {
+	// op is the operator of the node, or empty for a leaf.
+	op       protocol.PatternOp
+	operands []*matchTree
+
+	// rg and negated are only set for leaves.
+	rg      *readerGrep
+	negated bool
+}
+
+// compileExpression returns a matchTree for matching e. The patterns of e are
+// compiled with the options of p.
+func compileExpression(e *protocol.PatternExpression, p *protocol.PatternInfo) (*matchTree, error) {
+	switch e.Op {
+	case "":
+		if e.Pattern == "" {
+			return nil, errors.New("patterns in an expression must be non-empty")
+		}
+		re, literalSubstring, err := compilePattern(e.Pattern, p)
+		if err != nil {
+			return nil, err
+		}
+		return &matchTree{
+			rg: &readerGrep{
+				re:               re,
+				ignoreCase:       !p.IsCaseSensitive,
+				literalSubstring: literalSubstring,
+			},
+			negated: e.IsNegated,
+		}, nil
+
+	case protocol.PatternOpAnd, protocol.PatternOpOr:
+		if len(e.Operands) == 0 {
+			return nil, errors.Errorf("%s expression must have at least one operand", e.Op)
+		}
+		operands := make([]*matchTree, 0, len(e.Operands))
+		for _, operand := range e.Operands {
+			t, err := compileExpression(operand, p)
+			if err != nil {
+				return nil, err
+			}
+			operands = append(operands, t)
+		}
+		return &matchTree{op: e.Op, operands: operands}, nil
+	}
+
+	return nil, errors.Errorf("unknown expression operator %q", e.Op)
+}
+
+// Copy returns a copied version of t that is safe to use from another
+// goroutine.
+func (t *matchTree) Copy() *matchTree {
+	if t == nil {
+		return nil
+	}
+	if t.rg != nil {
+		return &matchTree{rg: t.rg.Copy(), negated: t.negated}
+	}
+
+	operands := make([]*matchTree, 0, len(t.operands))
+	for _, operand := range t.operands {
+		operands = append(operands, operand.Copy())
+	}
+	return &matchTree{op: t.op, operands: operands}
+}
+
+// match evaluates t against the file f. It returns whether f matches t, and
+// the ranges matched by the patterns that make f match. All operands are
+// evaluated, so that the ranges of every matching pattern are returned.
+// Negated patterns never contribute ranges.
+func (t *matchTree) match(zf *zipFile, f *srcFile, limit int, patternMatchesPaths bool) (bool, []protocol.Range) {
+	switch t.op {
+	case protocol.PatternOpAnd:
+		var ranges []protocol.Range
+		for _, operand := range t.operands {
+			match, operandRanges := operand.match(zf, f, limit, patternMatchesPaths)
+			if !match {
+				return false, nil
+			}
+			ranges = append(ranges, operandRanges...)
+		}
+		return true, ranges
+
+	case protocol.PatternOpOr:
+		var (
+			matched bool
+			ranges  []protocol.Range
+		)
+		for _, operand := range t.operands {
+			match, operandRanges := operand.match(zf, f, limit, patternMatchesPaths)
+			if match {
+				matched = true
+				ranges = append(ranges, operandRanges...)
+			}
+		}
+		return matched, ranges
+	}
+
+	ranges := t.rg.findRanges(zf, f, limit)
+	match := len(ranges) > 0 || (patternMatchesPaths && t.rg.matchString(f.Name))
+	if t.negated {
+		return !match, nil
+	}
+	return match, ranges
+}
+
+// matchString returns whether t matches s. It is intended to be used to match
+// file paths.
+func (t *matchTree) matchString(s string) bool {
+	switch t.op {
+	case protocol.PatternOpAnd:
+		for _, operand := range t.operands {
+			if !operand.matchString(s) {
+				return false
+			}
+		}
+		return true
+
+	case protocol.PatternOpOr:
+		for _, operand := range t.operands {
+			if operand.matchString(s) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return t.rg.matchString(s) != t.negated
+}
+
+// dedupeRanges sorts ranges and removes duplicates, which occur when several
+// patterns of an expression match the same text.
+func dedupeRanges(ranges []protocol.Range) []protocol.Range {
+	sort.Slice(ranges, func(i, j int) bool {
+		if ranges[i].Start.Offset != ranges[j].Start.Offset {
+			return ranges[i].Start.Offset < ranges[j].Start.Offset
+		}
+		return ranges[i].End.Offset < ranges[j].End.Offset
+	})
+
+	deduped := ranges[:0]
+	for _, r := range ranges {
+		if len(deduped) > 0 && r == deduped[len(deduped)-1] {
+			continue
+		}
+		deduped = append(deduped, r)
+	}
+	return deduped
+}
@@ -0,0 +1,162 @@
+package search
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/searcher/protocol"
+)
+
+func TestExpressionSearch(t *testing.T) {
+	zipData, err := createZip(map[string]string{
+		"both.go":     "foo\nbar\n",
+		"foo.go":      "foo foo\n",
+		"bar.go":      "bar\n",
+		"neither.go":  "baz\n",
+		"foo/path.md": "baz\n",
+	})
+	require.NoError(t, err)
+	zf, err := mockZipFile(zipData)
+	require.NoError(t, err)
+
+	leaf := func(pattern string) *protocol.PatternExpression {
+		return &protocol.PatternExpression{Pattern: pattern}
+	}
+	not := func(pattern string) *protocol.PatternExpression {
+		return &protocol.PatternExpression{Pattern: pattern, IsNegated: true}
+	}
+	and := func(operands ...*protocol.PatternExpression) *protocol.PatternExpression {
+		return &protocol.PatternExpression{Op: protocol.PatternOpAnd, Operands: operands}
+	}
+	or := func(operands ...*protocol.PatternExpression) *protocol.PatternExpression {
+		return &protocol.PatternExpression{Op: protocol.PatternOpOr, Operands: operands}
+	}
+
+	cases := []struct {
+		name                string
+		expression          *protocol.PatternExpression
+		patternMatchesPaths bool
+		// want maps the paths of the expected file matches to the matched
+		// substrings of the file content.
+		want map[string][]string
+	}{{
+		name:       "and",
+		expression: and(leaf("foo"), leaf("bar")),
+		want:       map[string][]string{"both.go": {"foo", "bar"}},
+	}, {
+		name:       "or",
+		expression: or(leaf("foo"), leaf("bar")),
+		want: map[string][]string{
+			"both.go": {"foo", "bar"},
+			"foo.go":  {"foo", "foo"},
+			"bar.go":  {"bar"},
+		},
+	}, {
+		name:       "and not",
+		expression: and(leaf("foo"), not("bar")),
+		want:       map[string][]string{"foo.go": {"foo", "foo"}},
+	}, {
+		name:       "nested",
+		expression: or(and(leaf("foo"), leaf("bar")), leaf("baz")),
+		want: map[string][]string{
+			"both.go":     {"foo", "bar"},
+			"neither.go":  {"baz"},
+			"foo/path.md": {"baz"},
+		},
+	}, {
+		name:       "overlapping patterns",
+		expression: or(leaf("fo"), leaf("foo")),
+		want: map[string][]string{
+			"both.go": {"fo", "foo"},
+			"foo.go":  {"fo", "foo", "fo", "foo"},
+		},
+	}, {
+		name:       "duplicate patterns",
+		expression: and(leaf("bar"), leaf("bar")),
+		want: map[string][]string{
+			"both.go": {"bar"},
+			"bar.go":  {"bar"},
+		},
+	}, {
+		name:                "path",
+		expression:          and(leaf("foo"), leaf("baz")),
+		patternMatchesPaths: true,
+		want:                map[string][]string{"foo/path.md": {"baz"}},
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rg, err := compile(&protocol.PatternInfo{Expression: tc.expression})
+			require.NoError(t, err)
+
+			fileMatches, _, err := regexSearchBatch(context.Background(), rg, zf, 100, true, tc.patternMatchesPaths, false)
+			require.NoError(t, err)
+
+			got := map[string][]string{}
+			for _, fm := range fileMatches {
+				matched := []string{}
+				for _, cm := range fm.ChunkMatches {
+					for _, r := range cm.Ranges {
+						start := r.Start.Offset - cm.ContentStart.Offset
+						end := r.End.Offset - cm.ContentStart.Offset
+						matched = append(matched, cm.Content[start:end])
+					}
+				}
+				got[fm.Path] = matched
+			}
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestExpressionPathOnlySearch(t *testing.T) {
+	zipData, err := createZip(map[string]string{
+		"a/foo.go":  "",
+		"a/bar.go":  "",
+		"b/foo.go":  "",
+		"b/test.go": "",
+	})
+	require.NoError(t, err)
+	zf, err := mockZipFile(zipData)
+	require.NoError(t, err)
+
+	rg, err := compile(&protocol.PatternInfo{
+		Expression: &protocol.PatternExpression{
+			Op: protocol.PatternOpAnd,
+			Operands: []*protocol.PatternExpression{
+				{Pattern: "go"},
+				{Pattern: "bar", IsNegated: true},
+				{Pattern: "test", IsNegated: true},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	fileMatches, _, err := regexSearchBatch(context.Background(), rg, zf, 100, false, true, false)
+	require.NoError(t, err)
+
+	got := make([]string, 0, len(fileMatches))
+	for _, fm := range fileMatches {
+		got = append(got, fm.Path)
+	}
+	sort.Strings(got)
+	require.Equal(t, []string{"a/foo.go", "b/foo.go"}, got)
+}
+
+func TestCompileExpressionErrors(t *testing.T) {
+	cases := map[string]*protocol.PatternExpression{
+		"empty pattern":    {Op: protocol.PatternOpAnd, Operands: []*protocol.PatternExpression{{Pattern: "a"}, {}}},
+		"no operands":      {Op: protocol.PatternOpOr},
+		"unknown operator": {Op: "xor", Operands: []*protocol.PatternExpression{{Pattern: "a"}}},
+		"invalid regexp":   {Op: protocol.PatternOpAnd, Operands: []*protocol.PatternExpression{{Pattern: "("}}},
+	}
+	for name, expression := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := compile(&protocol.PatternInfo{Expression: expression, IsRegExp: true})
+			require.Error(t, err)
+		})
+	}
+}
@@ -60,6 +60,10 @@ type readerGrep struct {
 	// re. It is the output of the longestLiteral function. It is only set if
 	// the regex has an empty LiteralPrefix.
 	literalSubstring []byte
+
+	// expr is compiled from the pattern expression of the request, if any. If
+	// set, re is nil and files are matched by evaluating expr instead.
+	expr *matchTree
 }
 
 // compile returns a readerGrep for matching p.
@@ -67,57 +71,18 @@ func compile(p *protocol.PatternInfo) (*readerGrep, error) {
 	var (
 		re               *regexp.Regexp
 		literalSubstring []byte
+		expr             *matchTree
+		err              error
 	)
-	if p.Pattern != "" {
-		expr := p.Pattern
-		if !p.IsRegExp {
-			expr = regexp.QuoteMeta(expr)
-		}
-		if p.IsWordMatch {
-			expr = `\b` + expr + `\b`
-		}
-		if p.IsRegExp {
-			// We don't do the search line by line, therefore we want the
-			// regex engine to consider newlines for anchors (^$).
-			expr = "(?m:" + expr + ")"
-		}
-
-		// Transforms on the parsed regex
-		{
-			re, err := syntax.Parse(expr, syntax.Perl)
-			if err != nil {
-				return nil, err
-			}
-
-			if !p.IsCaseSensitive {
-				// We don't just use (?i) because regexp library doesn't seem
-				// to contain good optimizations for case insensitive
-				// search. Instead we lowercase the input and pattern.
-				casetransform.LowerRegexpASCII(re)
-			}
-
-			// OptimizeRegexp currently only converts capture groups into
-			// non-capture groups (faster for stdlib regexp to execute).
-			re = query.OptimizeRegexp(re, syntax.Perl)
-
-			expr = re.String()
-		}
-
-		var err error
-		re, err = regexp.Compile(expr)
+	if p.Expression != nil {
+		expr, err = compileExpression(p.Expression, p)
 		if err != nil {
 			return nil, err
 		}
-
-		// Only use literalSubstring optimization if the regex engine doesn't
-		// have a prefix to use.
-		if pre, _ := re.LiteralPrefix(); pre == "" {
-			ast, err := syntax.Parse(expr, syntax.Perl)
-			if err != nil {
-				return nil, err
-			}
-			ast = ast.Simplify()
-			literalSubstring = []byte(longestLiteral(ast))
+	} else if p.Pattern != "" {
+		re, literalSubstring, err = compilePattern(p.Pattern, p)
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -131,9 +96,68 @@ func compile(p *protocol.PatternInfo) (*readerGrep, error) {
 		ignoreCase:       !p.IsCaseSensitive,
 		matchPath:        matchPath,
 		literalSubstring: literalSubstring,
+		expr:             expr,
 	}, nil
 }
 
+// compilePattern compiles pattern according to the options in p. It returns the
+// regexp to match and the literal substring guaranteed to appear in any match
+// of it, if the regexp has no literal prefix.
+func compilePattern(pattern string, p *protocol.PatternInfo) (*regexp.Regexp, []byte, error) {
+	expr := pattern
+	if !p.IsRegExp {
+		expr = regexp.QuoteMeta(expr)
+	}
+	if p.IsWordMatch {
+		expr = `\b` + expr + `\b`
+	}
+	if p.IsRegExp {
+		// We don't do the search line by line, therefore we want the
+		// regex engine to consider newlines for anchors (^$).
+		expr = "(?m:" + expr + ")"
+	}
+
+	// Transforms on the parsed regex
+	{
+		re, err := syntax.Parse(expr, syntax.Perl)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if !p.IsCaseSensitive {
+			// We don't just use (?i) because regexp library doesn't seem
+			// to contain good optimizations for case insensitive
+			// search. Instead we lowercase the input and pattern.
+			casetransform.LowerRegexpASCII(re)
+		}
+
+		// OptimizeRegexp currently only converts capture groups into
+		// non-capture groups (faster for stdlib regexp to execute).
+		re = query.OptimizeRegexp(re, syntax.Perl)
+
+		expr = re.String()
+	}
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Only use literalSubstring optimization if the regex engine doesn't
+	// have a prefix to use.
+	var literalSubstring []byte
+	if pre, _ := re.LiteralPrefix(); pre == "" {
+		ast, err := syntax.Parse(expr, syntax.Perl)
+		if err != nil {
+			return nil, nil, err
+		}
+		ast = ast.Simplify()
+		literalSubstring = []byte(longestLiteral(ast))
+	}
+
+	return re, literalSubstring, nil
+}
+
 // Copy returns a copied version of rg that is safe to use from another
 // goroutine.
 func (rg *readerGrep) Copy() *readerGrep {
@@ -142,12 +166,16 @@ func (rg *readerGrep) Copy() *readerGrep {
 		ignoreCase:       rg.ignoreCase,
 		matchPath:        rg.matchPath,
 		literalSubstring: rg.literalSubstring,
+		expr:             rg.expr.Copy(),
 	}
 }
 
 // matchString returns whether rg's regexp pattern matches s. It is intended to be
 // used to match file paths.
 func (rg *readerGrep) matchString(s string) bool {
+	if rg.expr != nil {
+		return rg.expr.matchString(s)
+	}
 	if rg.re == nil {
 		return true
 	}
@@ -161,6 +189,18 @@ func (rg *readerGrep) matchString(s string) bool {
 // LimitHit is true if some matches may not have been included in the result.
 // NOTE: This is not safe to use concurrently.
 func (rg *readerGrep) Find(zf *zipFile, f *srcFile, limit int) (matches []protocol.ChunkMatch, err error) {
+	ranges := rg.findRanges(zf, f, limit)
+	if len(ranges) == 0 {
+		return nil, nil // short-circuit if we have no matches
+	}
+	chunks := chunkRanges(ranges, 0)
+	return chunksToMatches(zf.DataFor(f), chunks), nil
+}
+
+// findRanges returns the ranges in f matched by rg. At most limit+1 ranges are
+// returned, so that callers know whether they hit the limit.
+// NOTE: This is not safe to use concurrently.
+func (rg *readerGrep) findRanges(zf *zipFile, f *srcFile, limit int) []protocol.Range {
 	// fileMatchBuf is what we run match on, fileBuf is the original
 	// data (for Preview).
 	fileBuf := zf.DataFor(f)
@@ -187,17 +227,15 @@ func (rg *readerGrep) Find(zf *zipFile, f *srcFile, limit int) (matches []protoc
 	// per-line. Additionally if we have a non-empty literalSubstring, we use
 	// that to prune out files since doing bytes.Index is very fast.
 	if !bytes.Contains(fileMatchBuf, rg.literalSubstring) {
-		return nil, nil
+		return nil
 	}
 
 	// find limit+1 matches so we know whether we hit the limit
 	locs := rg.re.FindAllIndex(fileMatchBuf, limit+1)
 	if len(locs) == 0 {
-		return nil, nil // short-circuit if we have no matches
+		return nil
 	}
-	ranges := locsToRanges(fileBuf, locs)
-	chunks := chunkRanges(ranges, 0)
-	return chunksToMatches(fileBuf, chunks), nil
+	return locsToRanges(fileBuf, locs)
 }
 
 // locs must be sorted, non-overlapping, and must be valid slices of buf.
@@ -253,6 +291,30 @@ func (rg *readerGrep) FindZip(zf *zipFile, f *srcFile, limit int) (protocol.File
 	}, err
 }
 
+// matchFile returns the FileMatch for f and whether f matches rg, either by its
+// content or, if patternMatchesPaths is true, by its path.
+func (rg *readerGrep) matchFile(zf *zipFile, f *srcFile, limit int, patternMatchesPaths bool) (protocol.FileMatch, bool, error) {
+	if rg.expr != nil {
+		match, ranges := rg.expr.match(zf, f, limit, patternMatchesPaths)
+		fm := protocol.FileMatch{Path: f.Name}
+		if match && len(ranges) > 0 {
+			fm.ChunkMatches = chunksToMatches(zf.DataFor(f), chunkRanges(dedupeRanges(ranges), 0))
+		}
+		return fm, match, nil
+	}
+
+	fm, err := rg.FindZip(zf, f, limit)
+	if err != nil {
+		return fm, false, err
+	}
+	match := len(fm.ChunkMatches) > 0
+	if !match && patternMatchesPaths {
+		// Try matching against the file path.
+		match = rg.matchString(f.Name)
+	}
+	return fm, match, nil
+}
+
 func regexSearchBatch(ctx context.Context, rg *readerGrep, zf *zipFile, limit int, patternMatchesContent, patternMatchesPaths bool, isPatternNegated bool) ([]protocol.FileMatch, bool, error) {
 	ctx, cancel, sender := newLimitedStreamCollector(ctx, limit)
 	defer cancel()
@@ -297,7 +359,7 @@ func regexSearch(ctx context.Context, rg *readerGrep, zf *zipFile, patternMatche
 		files = zf.Files
 	)
 
-	if rg.re == nil || (patternMatchesPaths && !patternMatchesContent) {
+	if (rg.re == nil && rg.expr == nil) || (patternMatchesPaths && !patternMatchesContent) {
 		// Fast path for only matching file paths (or with a nil pattern, which matches all files,
 		// so is effectively matching only on file paths).
 		for _, f := range files {
@@ -349,18 +411,10 @@ func regexSearch(ctx context.Context, rg *readerGrep, zf *zipFile, patternMatche
 				filesSearched.Inc()
 
 				// process
-				fm, err := rg.FindZip(zf, f, sender.Remaining())
+				fm, match, err := rg.matchFile(zf, f, sender.Remaining(), patternMatchesPaths)
 				if err != nil {
 					return err
 				}
-				match := len(fm.ChunkMatches) > 0
-				if !match && patternMatchesPaths {
-					// Try matching against the file path.
-					match = rg.matchString(f.Name)
-					if match {
-						fm.Path = f.Name
-					}
-				}
 				if match == !isPatternNegated {
 					sender.Send(fm)
 				}
@@ -10,7 +10,6 @@ import (
 	"io"
 	"path/filepath"
 	"sort"
-	"strings"
 	"sync"
 	"time"
 
@@ -26,7 +25,6 @@ import (
 	"github.com/sourcegraph/sourcegraph/cmd/searcher/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/comby"
-	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/trace/ot"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -203,97 +201,6 @@ func chunksToMatches(buf []byte, chunks []rangeChunk) []protocol.ChunkMatch {
 	return chunkMatches
 }
 
-var isValidMatcher = lazyregexp.New(`\.(s|sh|bib|c|cs|css|dart|clj|elm|erl|ex|f|fsx|go|html|hs|java|js|json|jl|kt|tex|lisp|nim|md|ml|org|pas|php|py|re|rb|rs|rst|scala|sql|swift|tex|txt|ts)$`)
-
-func extensionToMatcher(extension string) string {
-	if isValidMatcher.MatchString(extension) {
-		return extension
-	}
-	return ".generic"
-}
-
-// lookupMatcher looks up a key for specifying -matcher in comby. Comby accepts
-// a representative file extension to set a language, so this lookup does not
-// need to consider all possible file extensions for a language. There is a generic
-// fallback language, so this lookup does not need to be exhaustive either.
-func lookupMatcher(language string) string {
-	switch strings.ToLower(language) {
-	case "assembly", "asm":
-		return ".s"
-	case "bash":
-		return ".sh"
-	case "c":
-		return ".c"
-	case "c#, csharp":
-		return ".cs"
-	case "css":
-		return ".css"
-	case "dart":
-		return ".dart"
-	case "clojure":
-		return ".clj"
-	case "elm":
-		return ".elm"
-	case "erlang":
-		return ".erl"
-	case "elixir":
-		return ".ex"
-	case "fortran":
-		return ".f"
-	case "f#", "fsharp":
-		return ".fsx"
-	case "go":
-		return ".go"
-	case "html":
-		return ".html"
-	case "haskell":
-		return ".hs"
-	case "java":
-		return ".java"
-	case "javascript":
-		return ".js"
-	case "json":
-		return ".json"
-	case "julia":
-		return ".jl"
-	case "kotlin":
-		return ".kt"
-	case "laTeX":
-		return ".tex"
-	case "lisp":
-		return ".lisp"
-	case "nim":
-		return ".nim"
-	case "ocaml":
-		return ".ml"
-	case "pascal":
-		return ".pas"
-	case "php":
-		return ".php"
-	case "python":
-		return ".py"
-	case "reason":
-		return ".re"
-	case "ruby":
-		return ".rb"
-	case "rust":
-		return ".rs"
-	case "scala":
-		return ".scala"
-	case "sql":
-		return ".sql"
-	case "swift":
-		return ".swift"
-	case "text":
-		return ".txt"
-	case "typescript", "ts":
-		return ".ts"
-	case "xml":
-		return ".xml"
-	}
-	return ".generic"
-}
-
 func structuralSearchWithZoekt(ctx context.Context, indexed zoekt.Streamer, p *protocol.Request, sender matchSender) (err error) {
 	patternInfo := &search.TextPatternInfo{
 		Pattern:                      p.Pattern,
@@ -324,14 +231,22 @@ func structuralSearchWithZoekt(ctx context.Context, indexed zoekt.Streamer, p *p
 	return nil
 }
 
-// filteredStructuralSearch filters the list of files with a regex search before passing the zip to comby
-func filteredStructuralSearch(ctx context.Context, zipPath string, zf *zipFile, p *protocol.PatternInfo, repo api.RepoName, sender matchSender) error {
+// toStructuralPrefilter returns the pattern info of the regex search which
+// finds the files that may contain a match for the structural pattern of p.
+func toStructuralPrefilter(p *protocol.PatternInfo) *protocol.PatternInfo {
 	// Make a copy of the pattern info to modify it to work for a regex search
 	rp := *p
 	rp.Pattern = comby.StructuralPatToRegexpQuery(p.Pattern, false)
 	rp.IsStructuralPat = false
 	rp.IsRegExp = true
-	rg, err := compile(&rp)
+	rp.PatternMatchesContent = true
+	rp.PatternMatchesPath = false
+	return &rp
+}
+
+// filteredStructuralSearch filters the list of files with a regex search before passing the zip to comby
+func filteredStructuralSearch(ctx context.Context, zipPath string, zf *zipFile, p *protocol.PatternInfo, repo api.RepoName, sender matchSender) error {
+	rg, err := compile(toStructuralPrefilter(p))
 	if err != nil {
 		return err
 	}
@@ -363,13 +278,13 @@ func toMatcher(languages []string, extensionHint string) string {
 	if len(languages) > 0 {
 		// Pick the first language, there is no support for applying
 		// multiple language matchers in a single search query.
-		matcher := lookupMatcher(languages[0])
+		matcher := comby.MatcherForLanguage(languages[0])
 		metricRequestTotalStructuralSearch.WithLabelValues(matcher).Inc()
 		return matcher
 	}
 
 	if extensionHint != "" {
-		extension := extensionToMatcher(extensionHint)
+		extension := comby.MatcherForExtension(extensionHint)
 		metricRequestTotalStructuralSearch.WithLabelValues("inferred:" + extension).Inc()
 		return extension
 	}
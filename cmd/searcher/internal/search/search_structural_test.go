@@ -13,6 +13,8 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/zoekt"
+	zoektquery "github.com/sourcegraph/zoekt/query"
 	"github.com/stretchr/testify/require"
 
 	"github.com/sourcegraph/sourcegraph/cmd/searcher/protocol"
@@ -736,3 +738,56 @@ func maybeSkipComby(t *testing.T) {
 		t.Skipf("skipping comby test when not on CI: %v", err)
 	}
 }
+
+// candidateStreamer returns the given files on the indexed commit, or only
+// the first maxDocs files with the remaining ones skipped if maxDocs is set.
+type candidateStreamer struct {
+	zoekt.Streamer
+	files   []string
+	maxDocs int
+	opts    *zoekt.SearchOptions
+}
+
+func (s *candidateStreamer) Search(_ context.Context, _ zoektquery.Q, opts *zoekt.SearchOptions) (*zoekt.SearchResult, error) {
+	s.opts = opts
+	res := &zoekt.SearchResult{}
+	for _, name := range s.files {
+		if s.maxDocs > 0 && len(res.Files) == s.maxDocs {
+			res.Stats.FilesSkipped++
+			continue
+		}
+		res.Files = append(res.Files, zoekt.FileMatch{FileName: name, Version: "indexed"})
+	}
+	return res, nil
+}
+
+func TestZoektStructuralCandidates(t *testing.T) {
+	p := &protocol.Request{
+		Repo:   "foo",
+		RepoID: 1,
+		PatternInfo: protocol.PatternInfo{
+			Pattern:         "fmt.Println(:[x])",
+			IsStructuralPat: true,
+			Limit:           1,
+		},
+	}
+	files := []string{"a.go", "b.go", "c.go"}
+
+	t.Run("ignores the file match limit", func(t *testing.T) {
+		client := &candidateStreamer{files: files}
+		candidates, limitHit, ok, err := zoektStructuralCandidates(context.Background(), client, p, "indexed", nil)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.False(t, limitHit)
+		require.Equal(t, files, candidates)
+		require.Zero(t, client.opts.MaxDocDisplayCount)
+	})
+
+	t.Run("reports skipped candidates", func(t *testing.T) {
+		client := &candidateStreamer{files: files, maxDocs: 2}
+		_, limitHit, ok, err := zoektStructuralCandidates(context.Background(), client, p, "indexed", nil)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.True(t, limitHit)
+	})
+}
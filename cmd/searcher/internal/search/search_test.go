@@ -454,6 +454,33 @@ func TestSearch_badrequest(t *testing.T) {
 				IsStructuralPat: true,
 			},
 		},
+
+		// pattern and pattern expression
+		{
+			Repo:   "foo",
+			URL:    "u",
+			Commit: "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef",
+			PatternInfo: protocol.PatternInfo{
+				Pattern: "test",
+				Expression: &protocol.PatternExpression{
+					Op:       protocol.PatternOpAnd,
+					Operands: []*protocol.PatternExpression{{Pattern: "a"}, {Pattern: "b"}},
+				},
+			},
+		},
+
+		// Empty pattern in pattern expression
+		{
+			Repo:   "foo",
+			URL:    "u",
+			Commit: "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef",
+			PatternInfo: protocol.PatternInfo{
+				Expression: &protocol.PatternExpression{
+					Op:       protocol.PatternOpOr,
+					Operands: []*protocol.PatternExpression{{Pattern: "a"}, {Pattern: ""}},
+				},
+			},
+		},
 	}
 
 	store := newStore(t, nil)
@@ -66,6 +66,12 @@ type PatternInfo struct {
 	// not supported for structural searches.
 	IsNegated bool
 
+	// Expression, if set, is a boolean expression of patterns that is evaluated
+	// against each file instead of Pattern, which must then be empty (as must
+	// IsNegated). The patterns of the expression share IsRegExp, IsWordMatch and
+	// IsCaseSensitive. Expressions are not supported for structural searches.
+	Expression *PatternExpression `json:",omitempty"`
+
 	// IsRegExp if true will treat the Pattern as a regular expression.
 	IsRegExp bool
 
@@ -127,6 +133,9 @@ type PatternInfo struct {
 
 func (p *PatternInfo) String() string {
 	args := []string{fmt.Sprintf("%q", p.Pattern)}
+	if p.Expression != nil {
+		args = []string{p.Expression.String()}
+	}
 	if p.IsRegExp {
 		args = append(args, "re")
 	}
@@ -173,6 +182,45 @@ func (p *PatternInfo) String() string {
 	return fmt.Sprintf("PatternInfo{%s}", strings.Join(args, ","))
 }
 
+// PatternOp is the boolean operator of a PatternExpression.
+type PatternOp string
+
+const (
+	PatternOpAnd PatternOp = "and"
+	PatternOpOr  PatternOp = "or"
+)
+
+// PatternExpression is a boolean expression of patterns. It is either an
+// operator node (Op is set) combining Operands, or a leaf node matching Pattern.
+//
+// A file matches an "and" node if it matches all operands, and an "or" node if
+// it matches any operand. A leaf matches a file if Pattern matches its content
+// (or its path, if PatternMatchesPath is set). A negated leaf matches a file
+// if Pattern does not match it.
+type PatternExpression struct {
+	Op       PatternOp            `json:",omitempty"`
+	Operands []*PatternExpression `json:",omitempty"`
+
+	Pattern   string `json:",omitempty"`
+	IsNegated bool   `json:",omitempty"`
+}
+
+func (e *PatternExpression) String() string {
+	if e.Op == "" {
+		if e.IsNegated {
+			return fmt.Sprintf("(not %q)", e.Pattern)
+		}
+		return fmt.Sprintf("%q", e.Pattern)
+	}
+
+	args := make([]string, 0, len(e.Operands)+1)
+	args = append(args, string(e.Op))
+	for _, operand := range e.Operands {
+		args = append(args, operand.String())
+	}
+	return "(" + strings.Join(args, " ") + ")"
+}
+
 // Response represents the response from a Search request.
 type Response struct {
 	Matches []FileMatch
@@ -42,11 +42,15 @@ func TestHandler(t *testing.T) {
 					Name: "x",
 					Path: "a.js",
 					Line: 1, // ctags line numbers are 1-based
+					Kind: "variable",
 				},
 				{
-					Name: "y",
-					Path: "a.js",
-					Line: 2,
+					Name:       "y",
+					Path:       "a.js",
+					Line:       2,
+					Kind:       "function",
+					Parent:     "Foo",
+					ParentKind: "class",
 				},
 			},
 		}
@@ -76,8 +80,8 @@ func TestHandler(t *testing.T) {
 		HTTPClient: httpcli.InternalDoer,
 	}
 
-	x := result.Symbol{Name: "x", Path: "a.js", Line: 0, Character: 4}
-	y := result.Symbol{Name: "y", Path: "a.js", Line: 1, Character: 4}
+	x := result.Symbol{Name: "x", Path: "a.js", Line: 0, Character: 4, Kind: "variable"}
+	y := result.Symbol{Name: "y", Path: "a.js", Line: 1, Character: 4, Kind: "function", Parent: "Foo", ParentKind: "class"}
 
 	testCases := map[string]struct {
 		args     search.SymbolsParameters
@@ -123,6 +127,18 @@ func TestHandler(t *testing.T) {
 			args:     search.SymbolsParameters{ExcludePattern: "a.js", IsCaseSensitive: true, First: 10},
 			expected: nil,
 		},
+		"kind": {
+			args:     search.SymbolsParameters{IncludeKinds: []string{"func", "FUNCTION"}, First: 10},
+			expected: []result.Symbol{y},
+		},
+		"caseinsensitivecontainer": {
+			args:     search.SymbolsParameters{ContainerPattern: "^foo$", First: 10},
+			expected: []result.Symbol{y},
+		},
+		"casesensitivecontainer": {
+			args:     search.SymbolsParameters{ContainerPattern: "^foo$", IsCaseSensitive: true, First: 10},
+			expected: nil,
+		},
 	}
 
 	for label, testCase := range testCases {
@@ -35,6 +35,8 @@ func MakeSqliteSearchFunc(operations *sharedobservability.Operations, cachedData
 			log.Int("numIncludePatterns", len(args.IncludePatterns)),
 			log.String("includePatterns", strings.Join(args.IncludePatterns, ":")),
 			log.String("excludePattern", args.ExcludePattern),
+			log.String("includeKinds", strings.Join(args.IncludeKinds, ":")),
+			log.String("containerPattern", args.ContainerPattern),
 			log.Int("first", args.First),
 			log.Int("timeout", args.Timeout),
 		}})
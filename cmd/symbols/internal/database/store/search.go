@@ -68,12 +68,14 @@ func (s *store) Search(ctx context.Context, args search.SymbolsParameters) ([]re
 }
 
 func makeSearchConditions(args search.SymbolsParameters) []*sqlf.Query {
-	conditions := make([]*sqlf.Query, 0, 2+len(args.IncludePatterns))
+	conditions := make([]*sqlf.Query, 0, 4+len(args.IncludePatterns))
 	conditions = append(conditions, makeSearchCondition("name", args.Query, args.IsCaseSensitive))
 	conditions = append(conditions, negate(makeSearchCondition("path", args.ExcludePattern, args.IsCaseSensitive)))
 	for _, includePattern := range args.IncludePatterns {
 		conditions = append(conditions, makeSearchCondition("path", includePattern, args.IsCaseSensitive))
 	}
+	conditions = append(conditions, makeKindCondition(args.IncludeKinds))
+	conditions = append(conditions, makeRegexpCondition("parent", args.ContainerPattern, args.IsCaseSensitive))
 
 	filtered := conditions[:0]
 	for _, condition := range conditions {
@@ -113,13 +115,32 @@ func makeSearchCondition(column string, regex string, isCaseSensitive bool) *sql
 		}
 	}
 
-	// Regex match
+	return makeRegexpCondition(column, regex, isCaseSensitive)
+}
+
+func makeRegexpCondition(column string, regex string, isCaseSensitive bool) *sqlf.Query {
+	if regex == "" {
+		return nil
+	}
+
 	if !isCaseSensitive {
 		regex = "(?i:" + regex + ")"
 	}
 	return sqlf.Sprintf(column+" REGEXP %s", regex)
 }
 
+func makeKindCondition(kinds []string) *sqlf.Query {
+	if len(kinds) == 0 {
+		return nil
+	}
+
+	values := make([]*sqlf.Query, 0, len(kinds))
+	for _, kind := range kinds {
+		values = append(values, sqlf.Sprintf("%s", strings.ToLower(kind)))
+	}
+	return sqlf.Sprintf("lower(kind) IN (%s)", sqlf.Join(values, ","))
+}
+
 // isLiteralEquality returns true if the given regex matches literal strings exactly.
 // If so, this function returns true along with the literal search query. If not, this
 // function returns false.
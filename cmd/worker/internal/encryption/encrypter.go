@@ -38,7 +38,11 @@ func (e *recordEncrypter) handleBatch(ctx context.Context, config database.Encry
 		return e.handleDecryptBatch(ctx, config)
 	}
 
-	return e.handleEncryptBatch(ctx, config)
+	if err := e.handleEncryptBatch(ctx, config); err != nil {
+		return err
+	}
+
+	return e.handleReencryptBatch(ctx, config)
 }
 
 func (e *recordEncrypter) handleEncryptBatch(ctx context.Context, config database.EncryptionConfig) error {
@@ -52,6 +56,17 @@ func (e *recordEncrypter) handleEncryptBatch(ctx context.Context, config databas
 	return nil
 }
 
+func (e *recordEncrypter) handleReencryptBatch(ctx context.Context, config database.EncryptionConfig) error {
+	count, err := e.store.ReencryptBatch(ctx, config)
+	if err != nil || count == 0 {
+		return err
+	}
+
+	e.metrics.numRecordsReencrypted.WithLabelValues(config.TableName).Add(float64(count))
+	e.logger.Debug("re-encrypted records", log.String("tableName", config.TableName), log.Int("count", count))
+	return nil
+}
+
 func (e *recordEncrypter) handleDecryptBatch(ctx context.Context, config database.EncryptionConfig) error {
 	count, err := e.store.DecryptBatch(ctx, config)
 	if err != nil || count == 0 {
@@ -13,9 +13,10 @@ type metrics struct {
 	numUnencryptedAtRest *prometheus.GaugeVec
 
 	// processing status
-	numRecordsEncrypted *prometheus.CounterVec
-	numRecordsDecrypted *prometheus.CounterVec
-	numErrors           prometheus.Counter
+	numRecordsEncrypted   *prometheus.CounterVec
+	numRecordsReencrypted *prometheus.CounterVec
+	numRecordsDecrypted   *prometheus.CounterVec
+	numErrors             prometheus.Counter
 }
 
 func newMetrics(observationContext *observation.Context) *metrics {
@@ -61,6 +62,10 @@ func newMetrics(observationContext *observation.Context) *metrics {
 		"src_records_encrypted_total",
 		"The number of unencrypted database records that have been encrypted.",
 	)
+	numRecordsReencrypted := counterVec(
+		"src_records_reencrypted_total",
+		"The number of database records encrypted with a previous key version that have been re-encrypted.",
+	)
 	numRecordsDecrypted := counterVec(
 		"src_records_decrypted_total",
 		"The number of encrypted database records that have been decrypted.",
@@ -73,14 +78,16 @@ func newMetrics(observationContext *observation.Context) *metrics {
 	for _, config := range database.EncryptionConfigs {
 		// Initialize counters to zero
 		numRecordsEncrypted.WithLabelValues(config.TableName).Add(0)
+		numRecordsReencrypted.WithLabelValues(config.TableName).Add(0)
 		numRecordsDecrypted.WithLabelValues(config.TableName).Add(0)
 	}
 
 	return &metrics{
-		numEncryptedAtRest:   numEncryptedAtRest,
-		numUnencryptedAtRest: numUnencryptedAtRest,
-		numRecordsEncrypted:  numRecordsEncrypted,
-		numRecordsDecrypted:  numRecordsDecrypted,
-		numErrors:            numErrors,
+		numEncryptedAtRest:    numEncryptedAtRest,
+		numUnencryptedAtRest:  numUnencryptedAtRest,
+		numRecordsEncrypted:   numRecordsEncrypted,
+		numRecordsReencrypted: numRecordsReencrypted,
+		numRecordsDecrypted:   numRecordsDecrypted,
+		numErrors:             numErrors,
 	}
 }
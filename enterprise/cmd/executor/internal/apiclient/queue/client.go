@@ -88,7 +88,37 @@ func (c *Client) Dequeue(ctx context.Context, queueName string, job *executor.Jo
 		return false, err
 	}
 
-	return c.client.DoAndDecode(ctx, req, &job)
+	dequeued, err := c.client.DoAndDecode(ctx, req, &job)
+	if dequeued {
+		c.operations.dequeued.WithLabelValues(queueName).Inc()
+	}
+	return dequeued, err
+}
+
+// DequeueAny dequeues a job from the first of the given queues that has a job
+// available. The queue the job was dequeued from is set on the job.
+func (c *Client) DequeueAny(ctx context.Context, queueNames []string, job *executor.Job) (_ bool, err error) {
+	ctx, _, endObservation := c.operations.dequeueAny.With(ctx, &err, observation.Args{LogFields: []otlog.Field{
+		otlog.String("queueNames", strings.Join(queueNames, ", ")),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	req, err := c.client.NewJSONRequest(http.MethodPost, "dequeue", executor.DequeueRequest{
+		ExecutorName: c.options.ExecutorName,
+		NumCPUs:      c.options.ResourceOptions.NumCPUs,
+		Memory:       c.options.ResourceOptions.Memory,
+		DiskSpace:    c.options.ResourceOptions.DiskSpace,
+		Queues:       queueNames,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	dequeued, err := c.client.DoAndDecode(ctx, req, &job)
+	if dequeued {
+		c.operations.dequeued.WithLabelValues(job.Queue).Inc()
+	}
+	return dequeued, err
 }
 
 func (c *Client) AddExecutionLogEntry(ctx context.Context, queueName string, jobID int, entry workerutil.ExecutionLogEntry) (entryID int, err error) {
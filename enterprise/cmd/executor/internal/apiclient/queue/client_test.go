@@ -87,6 +87,35 @@ func TestDequeueBadResponse(t *testing.T) {
 	})
 }
 
+func TestDequeueAny(t *testing.T) {
+	spec := routeSpec{
+		expectedMethod:   "POST",
+		expectedPath:     "/.executors/queue/dequeue",
+		expectedUsername: "test",
+		expectedToken:    "hunter2",
+		expectedPayload:  `{"executorName": "deadbeef", "queues": ["codeintel", "batches"]}`,
+		responseStatus:   http.StatusOK,
+		responsePayload:  `{"id": 42, "queue": "codeintel"}`,
+	}
+
+	testRoute(t, spec, func(client *Client) {
+		var job executor.Job
+		dequeued, err := client.DequeueAny(context.Background(), []string{"codeintel", "batches"}, &job)
+		if err != nil {
+			t.Fatalf("unexpected error dequeueing record: %s", err)
+		}
+		if !dequeued {
+			t.Fatalf("expected record to be dequeued")
+		}
+		if job.ID != 42 {
+			t.Errorf("unexpected id. want=%d have=%d", 42, job.ID)
+		}
+		if job.Queue != "codeintel" {
+			t.Errorf("unexpected queue. want=%q have=%q", "codeintel", job.Queue)
+		}
+	})
+}
+
 func TestAddExecutionLogEntry(t *testing.T) {
 	entry := workerutil.ExecutionLogEntry{
 		Key:        "foo",
@@ -3,18 +3,24 @@ package queue
 import (
 	"fmt"
 
+	"github.com/prometheus/client_golang/prometheus"
+
 	"github.com/sourcegraph/sourcegraph/internal/metrics"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 )
 
 type operations struct {
 	dequeue                 *observation.Operation
+	dequeueAny              *observation.Operation
 	addExecutionLogEntry    *observation.Operation
 	updateExecutionLogEntry *observation.Operation
 	markComplete            *observation.Operation
 	markErrored             *observation.Operation
 	markFailed              *observation.Operation
 	heartbeat               *observation.Operation
+
+	// dequeued counts the jobs dequeued from each queue.
+	dequeued *prometheus.CounterVec
 }
 
 func newOperations(observationContext *observation.Context) *operations {
@@ -33,13 +39,22 @@ func newOperations(observationContext *observation.Context) *operations {
 		})
 	}
 
+	dequeued := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "src_apiworker_apiclient_queue_dequeued_total",
+		Help: "Total number of jobs dequeued, by queue.",
+	}, []string{"queue"})
+	observationContext.Registerer.MustRegister(dequeued)
+
 	return &operations{
 		dequeue:                 op("Dequeue"),
+		dequeueAny:              op("DequeueAny"),
 		addExecutionLogEntry:    op("AddExecutionLogEntry"),
 		updateExecutionLogEntry: op("UpdateExecutionLogEntry"),
 		markComplete:            op("MarkComplete"),
 		markErrored:             op("MarkErrored"),
 		markFailed:              op("MarkFailed"),
 		heartbeat:               op("Heartbeat"),
+
+		dequeued: dequeued,
 	}
 }
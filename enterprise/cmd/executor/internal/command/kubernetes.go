@@ -0,0 +1,342 @@
+package command
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/inconshreveable/log15"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	// KubernetesManagedByLabel is the label set to KubernetesManagedByValue on all
+	// pods created by executors.
+	KubernetesManagedByLabel = "app.kubernetes.io/managed-by"
+	KubernetesManagedByValue = "sourcegraph-executor"
+
+	// KubernetesRunnerNameLabel is the label holding the name of the runner that
+	// created a pod. It is used by the janitor to find orphaned pods.
+	KubernetesRunnerNameLabel = "executor.sourcegraph.com/name"
+
+	kubernetesContainerName   = "job"
+	kubernetesWorkspaceVolume = "workspace"
+)
+
+// KubernetesPollInterval is the interval at which the status of a running pod is
+// checked. This can be replaced for testing.
+var KubernetesPollInterval = time.Second
+
+// kubernetesFatalWaitingReasons are the reasons for a container to be waiting that
+// will not resolve by waiting longer.
+var kubernetesFatalWaitingReasons = map[string]struct{}{
+	"ImagePullBackOff":           {},
+	"InvalidImageName":           {},
+	"ErrImageNeverPull":          {},
+	"CreateContainerConfigError": {},
+}
+
+type kubernetesRunner struct {
+	name    string
+	dir     string
+	logger  Logger
+	options Options
+}
+
+var _ Runner = &kubernetesRunner{}
+
+func (r *kubernetesRunner) Setup(ctx context.Context) error {
+	return nil
+}
+
+func (r *kubernetesRunner) Teardown(ctx context.Context) error {
+	return nil
+}
+
+func (r *kubernetesRunner) Run(ctx context.Context, command CommandSpec) error {
+	// Commands without an image, such as src-cli steps, are run in the executor's
+	// own container, which shares the workspace volume with the job pods.
+	if command.Image == "" {
+		return runCommand(ctx, formatRawOrDockerCommand(command, r.dir, r.options), r.logger)
+	}
+
+	pod, err := newKubernetesPod(command, r.name, r.dir, r.options)
+	if err != nil {
+		return err
+	}
+
+	return runKubernetesPod(ctx, r.options.KubernetesOptions, pod, command, r.logger)
+}
+
+// newKubernetesPod constructs a pod that runs the script of the given spec with the
+// workspace volume mounted at /data, subject to the resource limits specified in
+// the given options.
+func newKubernetesPod(spec CommandSpec, name, dir string, options Options) (*corev1.Pod, error) {
+	subPath, err := filepath.Rel(options.KubernetesOptions.WorkspaceMountPath, dir)
+	if err != nil || subPath == ".." || strings.HasPrefix(subPath, "../") {
+		return nil, errors.Newf("workspace %q is not within the volume mounted at %q", dir, options.KubernetesOptions.WorkspaceMountPath)
+	}
+
+	resources, err := kubernetesResources(options.ResourceOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	env := make([]corev1.EnvVar, 0, len(spec.Env))
+	for _, e := range spec.Env {
+		key, value, _ := strings.Cut(e, "=")
+		env = append(env, corev1.EnvVar{Name: key, Value: value})
+	}
+
+	var activeDeadlineSeconds *int64
+	if options.KubernetesOptions.MaximumRuntimePerJob > 0 {
+		seconds := int64(options.KubernetesOptions.MaximumRuntimePerJob / time.Second)
+		activeDeadlineSeconds = &seconds
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: kubernetesPodName(name, spec.Key),
+			Labels: map[string]string{
+				KubernetesManagedByLabel:  KubernetesManagedByValue,
+				KubernetesRunnerNameLabel: name,
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:         corev1.RestartPolicyNever,
+			ActiveDeadlineSeconds: activeDeadlineSeconds,
+			Containers: []corev1.Container{{
+				Name:       kubernetesContainerName,
+				Image:      spec.Image,
+				Command:    []string{"/bin/sh", filepath.Join("/data", ScriptsPath, spec.ScriptPath)},
+				WorkingDir: filepath.Join("/data", spec.Dir),
+				Env:        env,
+				Resources:  resources,
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:      kubernetesWorkspaceVolume,
+					MountPath: "/data",
+					SubPath:   subPath,
+				}},
+			}},
+			Volumes: []corev1.Volume{{
+				Name: kubernetesWorkspaceVolume,
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: options.KubernetesOptions.PersistenceVolumeClaimName,
+					},
+				},
+			}},
+		},
+	}, nil
+}
+
+func kubernetesResources(options ResourceOptions) (corev1.ResourceRequirements, error) {
+	limits := corev1.ResourceList{}
+	if options.NumCPUs != 0 {
+		limits[corev1.ResourceCPU] = *resource.NewQuantity(int64(options.NumCPUs), resource.DecimalSI)
+	}
+	if options.Memory != "0" && options.Memory != "" {
+		memory, err := resource.ParseQuantity(options.Memory)
+		if err != nil {
+			return corev1.ResourceRequirements{}, errors.Wrapf(err, "invalid memory %q", options.Memory)
+		}
+		limits[corev1.ResourceMemory] = memory
+	}
+
+	// Requesting the limits reserves the resources for the job on the node, which
+	// matches the behavior of the other runtimes.
+	return corev1.ResourceRequirements{Limits: limits, Requests: limits}, nil
+}
+
+var invalidPodNameCharacters = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// kubernetesPodName returns a valid pod name for the step with the given key run
+// by the runner with the given name.
+func kubernetesPodName(name, key string) string {
+	podName := invalidPodNameCharacters.ReplaceAllString(strings.ToLower(name+"-"+key), "-")
+	if len(podName) > 253 {
+		podName = podName[:253]
+	}
+
+	return strings.Trim(podName, "-")
+}
+
+// runKubernetesPod creates the given pod and waits for it to finish. The output of
+// the pod is written to the given logger. The pod is deleted once it has finished,
+// or when the context is canceled.
+func runKubernetesPod(ctx context.Context, options KubernetesOptions, pod *corev1.Pod, spec CommandSpec, logger Logger) (err error) {
+	ctx, _, endObservation := spec.Operation.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	log15.Info(fmt.Sprintf("Running pod: %s", pod.Name))
+
+	pods := options.Clientset.CoreV1().Pods(options.Namespace)
+	if _, err := pods.Create(ctx, pod, metav1.CreateOptions{}); err != nil {
+		return errors.Wrap(err, "creating pod")
+	}
+	defer func() {
+		// Perform this outside of the task execution context, so that the pod is
+		// also removed when the job is canceled or has timed out.
+		if deleteErr := deleteKubernetesPod(context.Background(), pods, pod.Name); deleteErr != nil {
+			err = errors.Append(err, deleteErr)
+		}
+	}()
+
+	handle := logger.Log(spec.Key, append([]string{"pod", pod.Name, spec.Image}, pod.Spec.Containers[0].Command...))
+	defer handle.Close()
+
+	exitCode, err := monitorKubernetesPod(ctx, pods, pod.Name, handle)
+	handle.Finalize(exitCode)
+	if err != nil {
+		return err
+	}
+	if exitCode != 0 {
+		// If is context cancelation, forward the ctx.Err().
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		return errors.New("command failed")
+	}
+	return nil
+}
+
+// monitorKubernetesPod waits for the pod with the given name to start, streams its
+// logs into the given writer, and returns the exit code of its container once it
+// has finished. A non-nil error is returned only if the pod could not be run.
+func monitorKubernetesPod(ctx context.Context, pods typedcorev1.PodInterface, name string, logWriter io.Writer) (int, error) {
+	// Logs can only be streamed once the container has started.
+	if _, err := waitForKubernetesPod(ctx, pods, name, func(pod *corev1.Pod) bool {
+		return pod.Status.Phase != corev1.PodPending
+	}); err != nil {
+		return 0, err
+	}
+
+	if err := streamKubernetesPodLogs(ctx, pods, name, logWriter); err != nil {
+		return 0, err
+	}
+
+	pod, err := waitForKubernetesPod(ctx, pods, name, func(pod *corev1.Pod) bool {
+		return pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == kubernetesContainerName && status.State.Terminated != nil {
+			return int(status.State.Terminated.ExitCode), nil
+		}
+	}
+
+	// The pod failed before its container terminated, for example because its
+	// deadline was exceeded.
+	return 0, errors.Newf("pod failed: %s %s", pod.Status.Reason, pod.Status.Message)
+}
+
+// waitForKubernetesPod polls the pod with the given name until the given condition
+// holds, and returns the pod at that time.
+func waitForKubernetesPod(ctx context.Context, pods typedcorev1.PodInterface, name string, condition func(pod *corev1.Pod) bool) (*corev1.Pod, error) {
+	for {
+		pod, err := pods.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, errors.Wrap(err, "getting pod")
+		}
+		if condition(pod) {
+			return pod, nil
+		}
+
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.State.Waiting == nil {
+				continue
+			}
+			if _, ok := kubernetesFatalWaitingReasons[status.State.Waiting.Reason]; ok {
+				return nil, errors.Newf("pod cannot start: %s: %s", status.State.Waiting.Reason, status.State.Waiting.Message)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(KubernetesPollInterval):
+		}
+	}
+}
+
+// streamKubernetesPodLogs writes the output of the pod with the given name into the
+// given writer until the container exits. Kubernetes does not distinguish between
+// the standard output and standard error streams of a container, so all output is
+// logged as standard output.
+func streamKubernetesPodLogs(ctx context.Context, pods typedcorev1.PodInterface, name string, logWriter io.Writer) error {
+	stream, err := pods.GetLogs(name, &corev1.PodLogOptions{Container: kubernetesContainerName, Follow: true}).Stream(ctx)
+	if err != nil {
+		return errors.Wrap(err, "streaming pod logs")
+	}
+	defer stream.Close()
+
+	scanner := bufio.NewScanner(stream)
+	// Allocate an initial buffer of 4k, and buffer tokens of up to 100M as we do
+	// for commands run on the host.
+	scanner.Buffer(make([]byte, 4*1024), 100*1024*1024)
+	for scanner.Scan() {
+		if _, err := fmt.Fprintf(logWriter, "stdout: %s\n", scanner.Text()); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		// A canceled context ends the stream, which is reported by the caller.
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return errors.Wrap(err, "reading pod logs")
+	}
+
+	return nil
+}
+
+func deleteKubernetesPod(ctx context.Context, pods typedcorev1.PodInterface, name string) error {
+	gracePeriodSeconds := int64(0)
+	propagation := metav1.DeletePropagationBackground
+	err := pods.Delete(ctx, name, metav1.DeleteOptions{
+		GracePeriodSeconds: &gracePeriodSeconds,
+		PropagationPolicy:  &propagation,
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "deleting pod %s", name)
+	}
+
+	return nil
+}
+
+// NewKubernetesClientset creates a client for the Kubernetes API server. When the
+// given kubeconfig path is empty, the in-cluster configuration of the pod the
+// executor runs in is used.
+func NewKubernetesClientset(kubeconfigPath string) (kubernetes.Interface, error) {
+	var config *rest.Config
+	var err error
+	if kubeconfigPath != "" {
+		config, err = clientcmd.BuildConfigFromFlags("", kubeconfigPath)
+	} else {
+		config, err = rest.InClusterConfig()
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "loading kubernetes config")
+	}
+
+	return kubernetes.NewForConfig(config)
+}
@@ -0,0 +1,249 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestNewKubernetesPod(t *testing.T) {
+	pod, err := newKubernetesPod(
+		CommandSpec{
+			Key:        "step.docker.My_Step",
+			Image:      "alpine:latest",
+			ScriptPath: "myscript.sh",
+			Dir:        "subdir",
+			Env:        []string{"TEST=true", "CONTAINS_EQUALS=a=b"},
+		},
+		"executor-1234",
+		"/workspaces/workspace-42-5678",
+		Options{
+			KubernetesOptions: KubernetesOptions{
+				Namespace:                  "executors",
+				PersistenceVolumeClaimName: "executor-workspaces",
+				WorkspaceMountPath:         "/workspaces",
+				MaximumRuntimePerJob:       30 * time.Minute,
+			},
+			ResourceOptions: ResourceOptions{
+				NumCPUs: 4,
+				Memory:  "12G",
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	deadline := int64(1800)
+	limits := corev1.ResourceList{
+		corev1.ResourceCPU:    *resource.NewQuantity(4, resource.DecimalSI),
+		corev1.ResourceMemory: resource.MustParse("12G"),
+	}
+	expected := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "executor-1234-step-docker-my-step",
+			Labels: map[string]string{
+				KubernetesManagedByLabel:  KubernetesManagedByValue,
+				KubernetesRunnerNameLabel: "executor-1234",
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:         corev1.RestartPolicyNever,
+			ActiveDeadlineSeconds: &deadline,
+			Containers: []corev1.Container{{
+				Name:       "job",
+				Image:      "alpine:latest",
+				Command:    []string{"/bin/sh", "/data/.sourcegraph-executor/myscript.sh"},
+				WorkingDir: "/data/subdir",
+				Env: []corev1.EnvVar{
+					{Name: "TEST", Value: "true"},
+					{Name: "CONTAINS_EQUALS", Value: "a=b"},
+				},
+				Resources: corev1.ResourceRequirements{Limits: limits, Requests: limits},
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:      "workspace",
+					MountPath: "/data",
+					SubPath:   "workspace-42-5678",
+				}},
+			}},
+			Volumes: []corev1.Volume{{
+				Name: "workspace",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "executor-workspaces"},
+				},
+			}},
+		},
+	}
+	assert.Equal(t, expected, pod)
+}
+
+func TestNewKubernetesPodWorkspaceOutsideVolume(t *testing.T) {
+	_, err := newKubernetesPod(
+		CommandSpec{Key: "step.docker.0", Image: "alpine:latest"},
+		"executor-1234",
+		"/tmp/workspace-42-5678",
+		Options{KubernetesOptions: KubernetesOptions{WorkspaceMountPath: "/workspaces"}},
+	)
+	assert.Error(t, err)
+}
+
+func TestKubernetesRunner(t *testing.T) {
+	oldPollInterval := KubernetesPollInterval
+	KubernetesPollInterval = time.Millisecond
+	t.Cleanup(func() { KubernetesPollInterval = oldPollInterval })
+
+	tests := []struct {
+		name          string
+		status        corev1.PodStatus
+		wantErr       string
+		wantExitCode  int
+		wantFinalized bool
+	}{
+		{
+			name:          "succeeded",
+			status:        terminatedPodStatus(corev1.PodSucceeded, 0),
+			wantExitCode:  0,
+			wantFinalized: true,
+		},
+		{
+			name:          "failed",
+			status:        terminatedPodStatus(corev1.PodFailed, 3),
+			wantErr:       "command failed",
+			wantExitCode:  3,
+			wantFinalized: true,
+		},
+		{
+			name: "deadline exceeded",
+			status: corev1.PodStatus{
+				Phase:  corev1.PodFailed,
+				Reason: "DeadlineExceeded",
+			},
+			wantErr:       "pod failed: DeadlineExceeded",
+			wantFinalized: true,
+		},
+		{
+			name: "image pull failure",
+			status: corev1.PodStatus{
+				Phase: corev1.PodPending,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:  "job",
+					State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}},
+				}},
+			},
+			wantErr:       "pod cannot start: ImagePullBackOff",
+			wantFinalized: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientset := fake.NewSimpleClientset()
+			clientset.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				// Let the tracker store the pod with the status it reaches.
+				action.(k8stesting.CreateAction).GetObject().(*corev1.Pod).Status = tt.status
+				return false, nil, nil
+			})
+
+			logEntry := NewMockLogEntry()
+			logger := NewMockLogger()
+			logger.LogFunc.SetDefaultReturn(logEntry)
+
+			runner := NewRunner("/workspaces/workspace-42", logger, Options{
+				ExecutorName: "executor-1234",
+				KubernetesOptions: KubernetesOptions{
+					Enabled:                    true,
+					Clientset:                  clientset,
+					Namespace:                  "executors",
+					PersistenceVolumeClaimName: "executor-workspaces",
+					WorkspaceMountPath:         "/workspaces",
+				},
+			}, nil)
+
+			err := runner.Run(context.Background(), CommandSpec{
+				Key:        "step.docker.0",
+				Image:      "alpine:latest",
+				ScriptPath: "myscript.sh",
+				Operation:  makeTestOperation(),
+			})
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tt.wantFinalized {
+				require.Len(t, logEntry.FinalizeFunc.History(), 1)
+				assert.Equal(t, tt.wantExitCode, logEntry.FinalizeFunc.History()[0].Arg0)
+			}
+			require.Len(t, logEntry.CloseFunc.History(), 1)
+
+			// The pod is deleted once it has finished.
+			pods, err := clientset.CoreV1().Pods("executors").List(context.Background(), metav1.ListOptions{})
+			require.NoError(t, err)
+			assert.Empty(t, pods.Items)
+		})
+	}
+}
+
+func TestKubernetesRunnerLogs(t *testing.T) {
+	oldPollInterval := KubernetesPollInterval
+	KubernetesPollInterval = time.Millisecond
+	t.Cleanup(func() { KubernetesPollInterval = oldPollInterval })
+
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		action.(k8stesting.CreateAction).GetObject().(*corev1.Pod).Status = terminatedPodStatus(corev1.PodSucceeded, 0)
+		return false, nil, nil
+	})
+
+	logEntry := NewMockLogEntry()
+	var written []byte
+	logEntry.WriteFunc.SetDefaultHook(func(p []byte) (int, error) {
+		written = append(written, p...)
+		return len(p), nil
+	})
+	logger := NewMockLogger()
+	logger.LogFunc.SetDefaultReturn(logEntry)
+
+	runner := NewRunner("/workspaces/workspace-42", logger, Options{
+		ExecutorName: "executor-1234",
+		KubernetesOptions: KubernetesOptions{
+			Enabled:            true,
+			Clientset:          clientset,
+			Namespace:          "executors",
+			WorkspaceMountPath: "/workspaces",
+		},
+	}, nil)
+
+	err := runner.Run(context.Background(), CommandSpec{
+		Key:       "step.docker.0",
+		Image:     "alpine:latest",
+		Operation: makeTestOperation(),
+	})
+	require.NoError(t, err)
+
+	// The fake clientset always returns "fake logs" as the pod logs.
+	assert.Equal(t, "stdout: fake logs\n", string(written))
+	require.Len(t, logger.LogFunc.History(), 1)
+	assert.Equal(t, "step.docker.0", logger.LogFunc.History()[0].Arg0)
+}
+
+func terminatedPodStatus(phase corev1.PodPhase, exitCode int32) corev1.PodStatus {
+	return corev1.PodStatus{
+		Phase: phase,
+		ContainerStatuses: []corev1.ContainerStatus{{
+			Name: "job",
+			State: corev1.ContainerState{
+				Terminated: &corev1.ContainerStateTerminated{ExitCode: exitCode},
+			},
+		}},
+	}
+}
@@ -3,6 +3,9 @@ package command
 import (
 	"context"
 	"os"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -11,7 +14,7 @@ import (
 // Runner is the interface between an executor and the host on which commands
 // are invoked. Having this interface at this level allows us to use the same
 // code paths for local development (via shell + docker) as well as production
-// usage (via Firecracker).
+// usage (via Firecracker or Kubernetes).
 type Runner interface {
 	// Setup prepares the runner to invoke a series of commands.
 	Setup(ctx context.Context) error
@@ -43,6 +46,9 @@ type Options struct {
 	// FirecrackerOptions configures the behavior of Firecracker virtual machine creation.
 	FirecrackerOptions FirecrackerOptions
 
+	// KubernetesOptions configures the behavior of Kubernetes pod creation.
+	KubernetesOptions KubernetesOptions
+
 	// ResourceOptions configures the resource limits of docker container and Firecracker
 	// virtual machines running on the executor.
 	ResourceOptions ResourceOptions
@@ -73,6 +79,29 @@ type FirecrackerOptions struct {
 	DockerRegistryMirrorURLs []string
 }
 
+type KubernetesOptions struct {
+	// Enabled determines if commands with an image will be run in Kubernetes pods.
+	Enabled bool
+
+	// Clientset is the client used to manage pods.
+	Clientset kubernetes.Interface
+
+	// Namespace is the namespace in which pods are created.
+	Namespace string
+
+	// PersistenceVolumeClaimName is the name of the persistent volume claim holding
+	// job workspaces. The claim must be mounted into the executor's own pod at
+	// WorkspaceMountPath, and is mounted into job pods to share the workspace.
+	PersistenceVolumeClaimName string
+
+	// WorkspaceMountPath is the path at which the persistent volume claim is mounted
+	// in the executor's pod.
+	WorkspaceMountPath string
+
+	// MaximumRuntimePerJob is the deadline set on each pod.
+	MaximumRuntimePerJob time.Duration
+}
+
 type ResourceOptions struct {
 	// NumCPUs is the number of virtual CPUs a container or VM can use.
 	NumCPUs int
@@ -101,6 +130,10 @@ type ResourceOptions struct {
 
 // NewRunner creates a new runner with the given options.
 func NewRunner(dir string, logger Logger, options Options, operations *Operations) Runner {
+	if options.KubernetesOptions.Enabled {
+		return &kubernetesRunner{name: options.ExecutorName, dir: dir, logger: logger, options: options}
+	}
+
 	if !options.FirecrackerOptions.Enabled {
 		return &dockerRunner{dir: dir, logger: logger, options: options}
 	}
@@ -3,6 +3,7 @@ package config
 import (
 	"runtime"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/c2h5oh/datasize"
@@ -19,6 +20,8 @@ type Config struct {
 	FrontendURL                   string
 	FrontendAuthorizationToken    string
 	QueueName                     string
+	QueueNames                    string
+	Queues                        []Queue
 	QueuePollInterval             time.Duration
 	MaximumNumJobs                int
 	FirecrackerImage              string
@@ -42,12 +45,18 @@ type Config struct {
 	DockerRegistryNodeExporterURL string
 	WorkerHostname                string
 	DockerRegistryMirrorURL       string
+	UseKubernetes                 bool
+	KubernetesNamespace           string
+	KubernetesPVCName             string
+	KubernetesWorkspaceMountPath  string
+	KubernetesConfigPath          string
 }
 
 func (c *Config) Load() {
 	c.FrontendURL = c.Get("EXECUTOR_FRONTEND_URL", "", "The external URL of the sourcegraph instance.")
 	c.FrontendAuthorizationToken = c.Get("EXECUTOR_FRONTEND_PASSWORD", "", "The authorization token supplied to the frontend.")
-	c.QueueName = c.Get("EXECUTOR_QUEUE_NAME", "", "The name of the queue to listen to.")
+	c.QueueName = c.GetOptional("EXECUTOR_QUEUE_NAME", "The name of the queue to listen to.")
+	c.QueueNames = c.GetOptional("EXECUTOR_QUEUE_NAMES", "A comma-separated list of queues to listen to, each optionally followed by a colon and a positive weight (e.g. batches:2,codeintel:1). Jobs are dequeued from the queues in proportion to their weights. Cannot be combined with EXECUTOR_QUEUE_NAME.")
 	c.QueuePollInterval = c.GetInterval("EXECUTOR_QUEUE_POLL_INTERVAL", "1s", "Interval between dequeue requests.")
 	c.MaximumNumJobs = c.GetInt("EXECUTOR_MAXIMUM_NUM_JOBS", "1", "Number of virtual machines or containers that can be running at once.")
 	c.UseFirecracker = c.GetBool("EXECUTOR_USE_FIRECRACKER", strconv.FormatBool(runtime.GOOS == "linux"), "Whether to isolate commands in virtual machines. Requires ignite and firecracker. Linux hosts only.")
@@ -70,6 +79,22 @@ func (c *Config) Load() {
 	c.DockerRegistryNodeExporterURL = c.GetOptional("DOCKER_REGISTRY_NODE_EXPORTER_URL", "The URL of the Docker Registry instance's node_exporter, without the /metrics path.")
 	c.MaxActiveTime = c.GetInterval("EXECUTOR_MAX_ACTIVE_TIME", "0", "The maximum time that can be spent by the worker dequeueing records to be handled.")
 	c.DockerRegistryMirrorURL = c.GetOptional("EXECUTOR_DOCKER_REGISTRY_MIRROR_URL", "The address of a docker registry mirror to use in firecracker VMs. Supports multiple values, separated with a comma.")
+	c.UseKubernetes = c.GetBool("EXECUTOR_USE_KUBERNETES", "false", "Whether to run each job step as a pod in a Kubernetes cluster. Cannot be combined with EXECUTOR_USE_FIRECRACKER.")
+	c.KubernetesNamespace = c.Get("EXECUTOR_KUBERNETES_NAMESPACE", "default", "The namespace in which to create job pods.")
+	c.KubernetesPVCName = c.GetOptional("EXECUTOR_KUBERNETES_PERSISTENCE_VOLUME_CLAIM_NAME", "The name of the persistent volume claim shared by the executor and its job pods, which holds the job workspaces.")
+	c.KubernetesWorkspaceMountPath = c.Get("EXECUTOR_KUBERNETES_WORKSPACE_MOUNT_PATH", "/data", "The path at which the persistent volume claim holding the job workspaces is mounted in the executor.")
+	c.KubernetesConfigPath = c.GetOptional("EXECUTOR_KUBERNETES_CONFIG_PATH", "The path to a kubeconfig file used to connect to the cluster. If unset, the in-cluster configuration is used.")
+
+	if c.QueueName != "" {
+		c.Queues = []Queue{{Name: c.QueueName, Weight: 1}}
+	}
+	if c.QueueNames != "" {
+		queues, err := parseQueues(c.QueueNames)
+		if err != nil {
+			c.AddError(errors.Wrapf(err, "invalid value %q for EXECUTOR_QUEUE_NAMES", c.QueueNames))
+		}
+		c.Queues = queues
+	}
 
 	hn := hostname.Get()
 	// Be unique but also descriptive.
@@ -77,9 +102,21 @@ func (c *Config) Load() {
 }
 
 func (c *Config) Validate() error {
+	if c.QueueName != "" && c.QueueNames != "" {
+		c.AddError(errors.New("only one of EXECUTOR_QUEUE_NAME and EXECUTOR_QUEUE_NAMES can be set"))
+	} else if c.QueueName == "" && c.QueueNames == "" {
+		c.AddError(errors.New("one of EXECUTOR_QUEUE_NAME or EXECUTOR_QUEUE_NAMES must be set"))
+	}
 	if c.QueueName != "" && c.QueueName != "batches" && c.QueueName != "codeintel" {
 		c.AddError(errors.New("EXECUTOR_QUEUE_NAME must be set to 'batches' or 'codeintel'"))
 	}
+	if c.QueueNames != "" {
+		for _, queue := range c.Queues {
+			if queue.Name != "batches" && queue.Name != "codeintel" {
+				c.AddError(errors.Newf("EXECUTOR_QUEUE_NAMES must only contain 'batches' or 'codeintel', got %q", queue.Name))
+			}
+		}
+	}
 
 	if c.UseFirecracker {
 		// Validate that firecracker can work on this host.
@@ -102,5 +139,62 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if c.UseKubernetes {
+		if c.UseFirecracker {
+			c.AddError(errors.New("EXECUTOR_USE_KUBERNETES cannot be combined with EXECUTOR_USE_FIRECRACKER"))
+		}
+		if c.KubernetesPVCName == "" {
+			c.AddError(errors.New("EXECUTOR_KUBERNETES_PERSISTENCE_VOLUME_CLAIM_NAME must be set when EXECUTOR_USE_KUBERNETES is enabled"))
+		}
+	}
+
 	return c.BaseConfig.Validate()
 }
+
+// Queue is a queue the executor processes work from.
+type Queue struct {
+	Name string
+	// Weight is the share of dequeue attempts that prefer this queue, relative to
+	// the weights of the other queues.
+	Weight int
+}
+
+// QueueDescription returns a short name for the set of queues, used to name the
+// worker and label its metrics.
+func (c *Config) QueueDescription() string {
+	names := make([]string, 0, len(c.Queues))
+	for _, queue := range c.Queues {
+		names = append(names, queue.Name)
+	}
+
+	return strings.Join(names, "_")
+}
+
+// parseQueues parses a comma-separated list of queue names, each optionally
+// followed by a colon and a weight. Queues without a weight have a weight of 1.
+func parseQueues(value string) ([]Queue, error) {
+	var queues []Queue
+	seen := map[string]struct{}{}
+	for _, part := range strings.Split(value, ",") {
+		name, rawWeight, hasWeight := strings.Cut(strings.TrimSpace(part), ":")
+		if name == "" {
+			return nil, errors.New("empty queue name")
+		}
+		if _, ok := seen[name]; ok {
+			return nil, errors.Newf("duplicate queue %q", name)
+		}
+		seen[name] = struct{}{}
+
+		weight := 1
+		if hasWeight {
+			var err error
+			if weight, err = strconv.Atoi(rawWeight); err != nil || weight <= 0 {
+				return nil, errors.Newf("weight of queue %q must be a positive integer", name)
+			}
+		}
+
+		queues = append(queues, Queue{Name: name, Weight: weight})
+	}
+
+	return queues, nil
+}
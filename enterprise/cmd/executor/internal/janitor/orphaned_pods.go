@@ -0,0 +1,118 @@
+package janitor
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/inconshreveable/log15"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/command"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type orphanedPodJanitor struct {
+	clientset kubernetes.Interface
+	namespace string
+	prefix    string
+	names     *NameSet
+	metrics   *metrics
+}
+
+var _ goroutine.Handler = &orphanedPodJanitor{}
+var _ goroutine.ErrorHandler = &orphanedPodJanitor{}
+
+// NewOrphanedPodJanitor returns a background routine that periodically removes all job
+// pods in the given namespace that were created by this executor instance but are not
+// known by the worker running within it.
+func NewOrphanedPodJanitor(
+	clientset kubernetes.Interface,
+	namespace string,
+	prefix string,
+	names *NameSet,
+	interval time.Duration,
+	metrics *metrics,
+) goroutine.BackgroundRoutine {
+	return goroutine.NewPeriodicGoroutine(context.Background(), interval, newOrphanedPodJanitor(
+		clientset,
+		namespace,
+		prefix,
+		names,
+		metrics,
+	))
+}
+
+func newOrphanedPodJanitor(
+	clientset kubernetes.Interface,
+	namespace string,
+	prefix string,
+	names *NameSet,
+	metrics *metrics,
+) *orphanedPodJanitor {
+	return &orphanedPodJanitor{
+		clientset: clientset,
+		namespace: namespace,
+		prefix:    prefix,
+		names:     names,
+		metrics:   metrics,
+	}
+}
+
+func (j *orphanedPodJanitor) Handle(ctx context.Context) (err error) {
+	pods, err := j.clientset.CoreV1().Pods(j.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: command.KubernetesManagedByLabel + "=" + command.KubernetesManagedByValue,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, name := range findOrphanedPods(pods.Items, j.prefix, j.names.Slice()) {
+		log15.Info("Removing orphaned pod", "name", name)
+
+		gracePeriod := int64(0)
+		removeErr := j.clientset.CoreV1().Pods(j.namespace).Delete(ctx, name, metav1.DeleteOptions{GracePeriodSeconds: &gracePeriod})
+		if removeErr != nil && !apierrors.IsNotFound(removeErr) {
+			err = errors.Append(err, removeErr)
+		} else {
+			j.metrics.numPodsRemoved.Inc()
+		}
+	}
+
+	return err
+}
+
+func (j *orphanedPodJanitor) HandleError(err error) {
+	j.metrics.numErrors.Inc()
+	log15.Error("Failed to remove orphaned pods", "error", err)
+}
+
+// findOrphanedPods returns the names of the given pods that were created by a runner
+// whose name has the given prefix but is absent from the expected runner names.
+func findOrphanedPods(pods []corev1.Pod, prefix string, expectedNames []string) []string {
+	expectedMap := make(map[string]struct{}, len(expectedNames))
+	for _, name := range expectedNames {
+		expectedMap[name] = struct{}{}
+	}
+
+	names := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		runnerName := pod.Labels[command.KubernetesRunnerNameLabel]
+		if !strings.HasPrefix(runnerName, prefix+"-") {
+			continue
+		}
+		if _, ok := expectedMap[runnerName]; ok {
+			continue
+		}
+
+		names = append(names, pod.Name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,76 @@
+package janitor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sourcegraph/log/logtest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/command"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestFindOrphanedPods(t *testing.T) {
+	orphans := findOrphanedPods(
+		[]corev1.Pod{
+			testPod("a-step-0", "executor-a"),
+			testPod("b-step-0", "executor-b"),
+			testPod("b-step-1", "executor-b"),
+			testPod("c-step-0", "executor-c"),
+			testPod("other-step-0", "other-a"),
+			testPod("unlabeled", ""),
+		},
+		"executor",
+		[]string{"executor-c", "executor-x"},
+	)
+	if diff := cmp.Diff([]string{"a-step-0", "b-step-0", "b-step-1"}, orphans); diff != "" {
+		t.Fatalf("unexpected orphans (-want +got):\n%s", diff)
+	}
+}
+
+func TestOrphanedPodJanitor(t *testing.T) {
+	running := testPod("running", "executor-a")
+	orphaned := testPod("orphaned", "executor-b")
+	clientset := fake.NewSimpleClientset(&running, &orphaned)
+
+	names := NewNameSet()
+	names.Add("executor-a")
+
+	observationContext := &observation.Context{Logger: logtest.Scoped(t), Registerer: prometheus.NewRegistry()}
+	janitor := newOrphanedPodJanitor(clientset, "executors", "executor", names, NewMetrics(observationContext))
+	if err := janitor.Handle(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	pods, err := clientset.CoreV1().Pods("executors").List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error listing pods: %s", err)
+	}
+	var podNames []string
+	for _, pod := range pods.Items {
+		podNames = append(podNames, pod.Name)
+	}
+	if diff := cmp.Diff([]string{"running"}, podNames); diff != "" {
+		t.Fatalf("unexpected pods (-want +got):\n%s", diff)
+	}
+}
+
+func testPod(name, runnerName string) corev1.Pod {
+	labels := map[string]string{command.KubernetesManagedByLabel: command.KubernetesManagedByValue}
+	if runnerName != "" {
+		labels[command.KubernetesRunnerNameLabel] = runnerName
+	}
+
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "executors",
+			Labels:    labels,
+		},
+	}
+}
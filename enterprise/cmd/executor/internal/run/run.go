@@ -12,6 +12,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/apiclient"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/apiclient/queue"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/command"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/config"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/ignite"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/janitor"
@@ -50,7 +51,7 @@ func RunRun(cliCtx *cli.Context, logger log.Logger, cfg *config.Config) error {
 	// TODO: This is too similar to the RunValidate func. Make it share even more code.
 	if cliCtx.Bool("verify") {
 		// Then, validate all tools that are required are installed.
-		if err := validateToolsRequired(cfg.UseFirecracker); err != nil {
+		if err := validateToolsRequired(cfg.UseFirecracker, cfg.UseKubernetes); err != nil {
 			return err
 		}
 
@@ -86,6 +87,14 @@ func RunRun(cliCtx *cli.Context, logger log.Logger, cfg *config.Config) error {
 		}
 	}
 
+	if cfg.UseKubernetes {
+		clientset, err := command.NewKubernetesClientset(cfg.KubernetesConfigPath)
+		if err != nil {
+			return err
+		}
+		opts.KubernetesOptions.Clientset = clientset
+	}
+
 	nameSet := janitor.NewNameSet()
 	ctx, cancel := context.WithCancel(cliCtx.Context)
 	worker, err := worker.NewWorker(logger, nameSet, opts, observationContext)
@@ -109,6 +118,17 @@ func RunRun(cliCtx *cli.Context, logger log.Logger, cfg *config.Config) error {
 		mustRegisterVMCountMetric(logger, observationContext, cfg.VMPrefix)
 	}
 
+	if cfg.UseKubernetes {
+		routines = append(routines, janitor.NewOrphanedPodJanitor(
+			opts.KubernetesOptions.Clientset,
+			cfg.KubernetesNamespace,
+			cfg.VMPrefix,
+			nameSet,
+			cfg.CleanupTaskInterval,
+			janitor.NewMetrics(observationContext),
+		))
+	}
+
 	go func() {
 		// Block until the worker has exited. The executor worker is unique
 		// in that we want a maximum runtime and/or number of jobs to be
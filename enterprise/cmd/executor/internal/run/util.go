@@ -17,6 +17,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/command"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/config"
 	apiworker "github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/store"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 	"github.com/sourcegraph/sourcegraph/internal/version"
@@ -94,12 +95,24 @@ func getIgniteVersion(ctx context.Context) (string, error) {
 }
 
 func apiWorkerOptions(c *config.Config, queueTelemetryOptions queue.TelemetryOptions) apiworker.Options {
+	var queueName string
+	var queues []store.WeightedQueue
+	if len(c.Queues) == 1 {
+		queueName = c.Queues[0].Name
+	} else {
+		for _, q := range c.Queues {
+			queues = append(queues, store.WeightedQueue{Name: q.Name, Weight: q.Weight})
+		}
+	}
+
 	return apiworker.Options{
 		VMPrefix:           c.VMPrefix,
 		KeepWorkspaces:     c.KeepWorkspaces,
-		QueueName:          c.QueueName,
+		QueueName:          queueName,
+		Queues:             queues,
 		WorkerOptions:      workerOptions(c),
 		FirecrackerOptions: firecrackerOptions(c),
+		KubernetesOptions:  kubernetesOptions(c),
 		ResourceOptions:    resourceOptions(c),
 		GitServicePath:     "/.executors/git",
 		QueueOptions:       queueOptions(c, queueTelemetryOptions),
@@ -117,12 +130,12 @@ func apiWorkerOptions(c *config.Config, queueTelemetryOptions queue.TelemetryOpt
 
 func workerOptions(c *config.Config) workerutil.WorkerOptions {
 	return workerutil.WorkerOptions{
-		Name:                 fmt.Sprintf("executor_%s_worker", c.QueueName),
+		Name:                 fmt.Sprintf("executor_%s_worker", c.QueueDescription()),
 		NumHandlers:          c.MaximumNumJobs,
 		Interval:             c.QueuePollInterval,
 		HeartbeatInterval:    5 * time.Second,
 		CancelInterval:       c.QueuePollInterval,
-		Metrics:              makeWorkerMetrics(c.QueueName),
+		Metrics:              makeWorkerMetrics(c.QueueDescription()),
 		NumTotalJobs:         c.NumTotalJobs,
 		MaxActiveTime:        c.MaxActiveTime,
 		WorkerHostname:       c.WorkerHostname,
@@ -145,6 +158,16 @@ func firecrackerOptions(c *config.Config) command.FirecrackerOptions {
 	}
 }
 
+func kubernetesOptions(c *config.Config) command.KubernetesOptions {
+	return command.KubernetesOptions{
+		Enabled:                    c.UseKubernetes,
+		Namespace:                  c.KubernetesNamespace,
+		PersistenceVolumeClaimName: c.KubernetesPVCName,
+		WorkspaceMountPath:         c.KubernetesWorkspaceMountPath,
+		MaximumRuntimePerJob:       c.MaximumRuntimePerJob,
+	}
+}
+
 func resourceOptions(c *config.Config) command.ResourceOptions {
 	return command.ResourceOptions{
 		NumCPUs:             c.JobNumCPUs,
@@ -67,6 +67,9 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, record workerut
 		log.Int("jobID", job.ID),
 		log.String("repositoryName", job.RepositoryName),
 		log.String("commit", job.Commit))
+	if job.Queue != "" {
+		logger = logger.With(log.String("queue", job.Queue))
+	}
 
 	start := time.Now()
 	defer func() {
@@ -120,6 +123,7 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, record workerut
 	options := command.Options{
 		ExecutorName:       name,
 		FirecrackerOptions: h.options.FirecrackerOptions,
+		KubernetesOptions:  h.options.KubernetesOptions,
 		ResourceOptions:    h.options.ResourceOptions,
 	}
 	runner := h.runnerFactory(workspace.Path(), commandLogger, options, h.operations)
@@ -0,0 +1,278 @@
+package store
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/executor"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// WeightedQueue is a queue to process work from, along with its share of the
+// dequeue attempts relative to the other queues of a MultiQueueShim.
+type WeightedQueue struct {
+	Name   string
+	Weight int
+}
+
+// MultiQueueStore is a QueueStore that can also dequeue from several queues with
+// a single request.
+type MultiQueueStore interface {
+	QueueStore
+	DequeueAny(ctx context.Context, queueNames []string, payload *executor.Job) (bool, error)
+}
+
+// MultiQueueShim wraps MultiQueueStore to implement workerutil.Store over several
+// queues. Each dequeue attempt prefers one queue, chosen by smooth weighted
+// round-robin, and falls back to the other queues when the preferred one is empty
+// so that the executor does not sit idle while there is work in any of its queues.
+//
+// Job identifiers are only unique within a single queue, but the worker tracks the
+// jobs it runs by identifier alone. A dequeued job keeps its identifier unless a
+// running job from another queue already uses it, in which case the job is given a
+// negative local identifier which cannot clash with one handed out by a queue.
+type MultiQueueShim struct {
+	queues []WeightedQueue
+	store  MultiQueueStore
+
+	mu             sync.Mutex
+	currentWeights []int
+	jobs           map[int]queuedJob
+	lastLocalID    int
+}
+
+// queuedJob identifies a job within its source queue.
+type queuedJob struct {
+	queue string
+	id    int
+}
+
+// Compile time validation.
+var _ workerutil.Store = &MultiQueueShim{}
+
+// NewMultiQueueShim creates a new shim that dequeues from the given queues.
+func NewMultiQueueShim(queues []WeightedQueue, store MultiQueueStore) *MultiQueueShim {
+	return &MultiQueueShim{
+		queues:         queues,
+		store:          store,
+		currentWeights: make([]int, len(queues)),
+		jobs:           map[int]queuedJob{},
+	}
+}
+
+func (s *MultiQueueShim) QueuedCount(ctx context.Context) (int, error) {
+	return 0, errors.New("unimplemented")
+}
+
+func (s *MultiQueueShim) Dequeue(ctx context.Context, workerHostname string, extraArguments any) (workerutil.Record, bool, error) {
+	var job executor.Job
+	dequeued, err := s.store.DequeueAny(ctx, s.nextQueueOrder(), &job)
+	if err != nil {
+		return nil, false, err
+	}
+	if !dequeued {
+		return nil, false, nil
+	}
+
+	if !s.hasQueue(job.Queue) {
+		return nil, false, errors.Newf("job %d was dequeued from unexpected queue %q", job.ID, job.Queue)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	localID := job.ID
+	if _, ok := s.jobs[localID]; ok {
+		s.lastLocalID--
+		localID = s.lastLocalID
+	}
+	s.jobs[localID] = queuedJob{queue: job.Queue, id: job.ID}
+	job.ID = localID
+
+	return job, true, nil
+}
+
+// nextQueueOrder returns the names of all queues, starting with the queue that
+// is preferred for the next dequeue attempt. Over time, each queue is preferred
+// in proportion to its weight.
+func (s *MultiQueueShim) nextQueueOrder() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	totalWeight := 0
+	preferred := 0
+	for i, queue := range s.queues {
+		s.currentWeights[i] += queue.Weight
+		totalWeight += queue.Weight
+
+		if s.currentWeights[i] > s.currentWeights[preferred] {
+			preferred = i
+		}
+	}
+	s.currentWeights[preferred] -= totalWeight
+
+	queueNames := make([]string, 0, len(s.queues))
+	queueNames = append(queueNames, s.queues[preferred].Name)
+	for i, queue := range s.queues {
+		if i != preferred {
+			queueNames = append(queueNames, queue.Name)
+		}
+	}
+
+	return queueNames
+}
+
+func (s *MultiQueueShim) hasQueue(queueName string) bool {
+	for _, queue := range s.queues {
+		if queue.Name == queueName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// lookup returns the source queue and identifier of the job with the given local
+// identifier.
+func (s *MultiQueueShim) lookup(id int) (queuedJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	job, ok := s.jobs[id]
+	if !ok {
+		return queuedJob{}, errors.Newf("unknown job %d", id)
+	}
+
+	return job, nil
+}
+
+// release forgets the job with the given local identifier. This is called once the
+// job has been marked as finished, after which the worker no longer references it.
+func (s *MultiQueueShim) release(id int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.jobs, id)
+}
+
+func (s *MultiQueueShim) Heartbeat(ctx context.Context, ids []int) (knownIDs []int, err error) {
+	remoteIDsByQueue, localIDs := s.remoteIDsByQueue(ids)
+
+	knownIDs = []int{}
+	for _, queue := range s.queues {
+		known, heartbeatErr := s.store.Heartbeat(ctx, queue.Name, remoteIDsByQueue[queue.Name])
+		if heartbeatErr != nil {
+			err = errors.Append(err, errors.Wrapf(heartbeatErr, "heartbeat for queue %s", queue.Name))
+			continue
+		}
+
+		for _, id := range known {
+			if localID, ok := localIDs[queuedJob{queue: queue.Name, id: id}]; ok {
+				knownIDs = append(knownIDs, localID)
+			}
+		}
+	}
+
+	return knownIDs, err
+}
+
+func (s *MultiQueueShim) AddExecutionLogEntry(ctx context.Context, id int, entry workerutil.ExecutionLogEntry) (int, error) {
+	job, err := s.lookup(id)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.store.AddExecutionLogEntry(ctx, job.queue, job.id, entry)
+}
+
+func (s *MultiQueueShim) UpdateExecutionLogEntry(ctx context.Context, jobID, entryID int, entry workerutil.ExecutionLogEntry) error {
+	job, err := s.lookup(jobID)
+	if err != nil {
+		return err
+	}
+
+	return s.store.UpdateExecutionLogEntry(ctx, job.queue, job.id, entryID, entry)
+}
+
+func (s *MultiQueueShim) MarkComplete(ctx context.Context, id int) (bool, error) {
+	job, err := s.lookup(id)
+	if err != nil {
+		return false, err
+	}
+	defer s.release(id)
+
+	return true, s.store.MarkComplete(ctx, job.queue, job.id)
+}
+
+func (s *MultiQueueShim) MarkErrored(ctx context.Context, id int, errorMessage string) (bool, error) {
+	job, err := s.lookup(id)
+	if err != nil {
+		return false, err
+	}
+	defer s.release(id)
+
+	return true, s.store.MarkErrored(ctx, job.queue, job.id, errorMessage)
+}
+
+func (s *MultiQueueShim) MarkFailed(ctx context.Context, id int, errorMessage string) (bool, error) {
+	job, err := s.lookup(id)
+	if err != nil {
+		return false, err
+	}
+	defer s.release(id)
+
+	return true, s.store.MarkFailed(ctx, job.queue, job.id, errorMessage)
+}
+
+func (s *MultiQueueShim) CanceledJobs(ctx context.Context, knownIDs []int) (canceledIDs []int, err error) {
+	remoteIDsByQueue, localIDs := s.remoteIDsByQueue(knownIDs)
+
+	for _, queue := range s.queues {
+		remoteIDs := remoteIDsByQueue[queue.Name]
+		if len(remoteIDs) == 0 {
+			continue
+		}
+
+		canceled, canceledErr := s.store.CanceledJobs(ctx, queue.Name, remoteIDs)
+		if canceledErr != nil {
+			err = errors.Append(err, errors.Wrapf(canceledErr, "canceled jobs for queue %s", queue.Name))
+			continue
+		}
+
+		for _, id := range canceled {
+			if localID, ok := localIDs[queuedJob{queue: queue.Name, id: id}]; ok {
+				canceledIDs = append(canceledIDs, localID)
+			}
+		}
+	}
+
+	return canceledIDs, err
+}
+
+// remoteIDsByQueue groups the source identifiers of the jobs with the given local
+// identifiers by queue. Every queue has an entry, so that queues without running
+// jobs are still sent heartbeats. The returned map translates source identifiers
+// back to local identifiers.
+func (s *MultiQueueShim) remoteIDsByQueue(ids []int) (map[string][]int, map[queuedJob]int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	remoteIDsByQueue := make(map[string][]int, len(s.queues))
+	for _, queue := range s.queues {
+		remoteIDsByQueue[queue.Name] = []int{}
+	}
+
+	localIDs := make(map[queuedJob]int, len(ids))
+	for _, id := range ids {
+		job, ok := s.jobs[id]
+		if !ok {
+			continue
+		}
+
+		remoteIDsByQueue[job.queue] = append(remoteIDsByQueue[job.queue], job.id)
+		localIDs[job] = id
+	}
+
+	return remoteIDsByQueue, localIDs
+}
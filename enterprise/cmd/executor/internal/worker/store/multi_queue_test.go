@@ -0,0 +1,107 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/executor"
+)
+
+func TestMultiQueueShim_Dequeue_WeightedOrder(t *testing.T) {
+	queueStore := new(queueStoreMock)
+	shim := store.NewMultiQueueShim([]store.WeightedQueue{
+		{Name: "batches", Weight: 2},
+		{Name: "codeintel", Weight: 1},
+	}, queueStore)
+
+	var orders [][]string
+	queueStore.On("DequeueAny", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { orders = append(orders, args.Get(1).([]string)) }).
+		Return(false, nil)
+
+	for i := 0; i < 6; i++ {
+		record, dequeued, err := shim.Dequeue(context.Background(), "host-name", nil)
+		require.NoError(t, err)
+		assert.False(t, dequeued)
+		assert.Nil(t, record)
+	}
+
+	batchesFirst := []string{"batches", "codeintel"}
+	codeintelFirst := []string{"codeintel", "batches"}
+	assert.Equal(t, [][]string{
+		batchesFirst, codeintelFirst, batchesFirst,
+		batchesFirst, codeintelFirst, batchesFirst,
+	}, orders)
+}
+
+func TestMultiQueueShim_Dequeue_UnexpectedQueue(t *testing.T) {
+	queueStore := new(queueStoreMock)
+	shim := store.NewMultiQueueShim([]store.WeightedQueue{{Name: "batches", Weight: 1}}, queueStore)
+
+	queueStore.On("DequeueAny", mock.Anything, []string{"batches"}, mock.Anything).
+		Run(func(args mock.Arguments) { *args.Get(2).(*executor.Job) = executor.Job{ID: 42, Queue: "codeintel"} }).
+		Return(true, nil)
+
+	record, dequeued, err := shim.Dequeue(context.Background(), "host-name", nil)
+	assert.Error(t, err)
+	assert.False(t, dequeued)
+	assert.Nil(t, record)
+}
+
+func TestMultiQueueShim_CollidingIDs(t *testing.T) {
+	queueStore := new(queueStoreMock)
+	shim := store.NewMultiQueueShim([]store.WeightedQueue{
+		{Name: "batches", Weight: 1},
+		{Name: "codeintel", Weight: 1},
+	}, queueStore)
+
+	queueStore.On("DequeueAny", mock.Anything, []string{"batches", "codeintel"}, mock.Anything).
+		Run(func(args mock.Arguments) { *args.Get(2).(*executor.Job) = executor.Job{ID: 42, Queue: "batches"} }).
+		Return(true, nil).
+		Once()
+	queueStore.On("DequeueAny", mock.Anything, []string{"codeintel", "batches"}, mock.Anything).
+		Run(func(args mock.Arguments) { *args.Get(2).(*executor.Job) = executor.Job{ID: 42, Queue: "codeintel"} }).
+		Return(true, nil).
+		Once()
+
+	batchesRecord, dequeued, err := shim.Dequeue(context.Background(), "host-name", nil)
+	require.NoError(t, err)
+	require.True(t, dequeued)
+	codeintelRecord, dequeued, err := shim.Dequeue(context.Background(), "host-name", nil)
+	require.NoError(t, err)
+	require.True(t, dequeued)
+
+	assert.Equal(t, 42, batchesRecord.RecordID())
+	assert.Equal(t, -1, codeintelRecord.RecordID())
+
+	queueStore.On("Heartbeat", mock.Anything, "batches", []int{42}).Return([]int{42}, nil)
+	queueStore.On("Heartbeat", mock.Anything, "codeintel", []int{42}).Return([]int{}, nil)
+
+	knownIDs, err := shim.Heartbeat(context.Background(), []int{42, -1})
+	require.NoError(t, err)
+	assert.Equal(t, []int{42}, knownIDs)
+
+	queueStore.On("CanceledJobs", mock.Anything, "batches", []int{42}).Return([]int{}, nil)
+	queueStore.On("CanceledJobs", mock.Anything, "codeintel", []int{42}).Return([]int{42}, nil)
+
+	canceledIDs, err := shim.CanceledJobs(context.Background(), []int{42, -1})
+	require.NoError(t, err)
+	assert.Equal(t, []int{-1}, canceledIDs)
+
+	queueStore.On("MarkFailed", mock.Anything, "codeintel", 42, "canceled").Return(nil)
+
+	marked, err := shim.MarkFailed(context.Background(), -1, "canceled")
+	require.NoError(t, err)
+	assert.True(t, marked)
+
+	// The job is forgotten once it has been marked as finished.
+	_, err = shim.MarkComplete(context.Background(), -1)
+	assert.Error(t, err)
+
+	mock.AssertExpectationsForObjects(t, queueStore)
+}
@@ -303,3 +303,8 @@ func (m *queueStoreMock) CanceledJobs(ctx context.Context, queueName string, kno
 	args := m.Called(ctx, queueName, knownIDs)
 	return args.Get(0).([]int), args.Error(1)
 }
+
+func (m *queueStoreMock) DequeueAny(ctx context.Context, queueNames []string, payload *executor.Job) (bool, error) {
+	args := m.Called(ctx, queueNames, payload)
+	return args.Bool(0), args.Error(1)
+}
@@ -40,6 +40,11 @@ type Options struct {
 	// horizontal scaling factors while still uniformly processing events.
 	QueueName string
 
+	// Queues are the queues to process work from when listening to more than one
+	// queue, in which case QueueName is ignored. Jobs are dequeued from the queues
+	// in proportion to their weights.
+	Queues []store.WeightedQueue
+
 	// GitServicePath is the path to the internal git service API proxy in the frontend.
 	// This path should contain the endpoints info/refs and git-upload-pack.
 	GitServicePath string
@@ -62,6 +67,9 @@ type Options struct {
 	// FirecrackerOptions configures the behavior of Firecracker virtual machine creation.
 	FirecrackerOptions command.FirecrackerOptions
 
+	// KubernetesOptions configures the behavior of running job steps as Kubernetes pods.
+	KubernetesOptions command.KubernetesOptions
+
 	// ResourceOptions configures the resource limits of docker container and Firecracker
 	// virtual machines running on the executor.
 	ResourceOptions command.ResourceOptions
@@ -91,7 +99,10 @@ func NewWorker(logger log.Logger, nameSet *janitor.NameSet, options Options, obs
 	if err != nil {
 		return nil, errors.Wrap(err, "building files store")
 	}
-	shim := &store.QueueShim{Name: options.QueueName, Store: queueStore}
+	var shim workerutil.Store = &store.QueueShim{Name: options.QueueName, Store: queueStore}
+	if len(options.Queues) > 0 {
+		shim = store.NewMultiQueueShim(options.Queues, queueStore)
+	}
 
 	if !connectToFrontend(logger, queueStore, options) {
 		os.Exit(1)
@@ -127,7 +138,7 @@ func connectToFrontend(logger log.Logger, queueStore *queue.Client, options Opti
 	defer signal.Stop(signals)
 
 	for {
-		err := queueStore.Ping(context.Background(), options.QueueName, nil)
+		err := pingQueues(queueStore, options)
 		if err == nil {
 			logger.Info("Connected to Sourcegraph instance")
 			return true
@@ -150,3 +161,18 @@ func connectToFrontend(logger log.Logger, queueStore *queue.Client, options Opti
 		}
 	}
 }
+
+// pingQueues pings each queue the worker processes work from.
+func pingQueues(queueStore *queue.Client, options Options) error {
+	if len(options.Queues) == 0 {
+		return queueStore.Ping(context.Background(), options.QueueName, nil)
+	}
+
+	for _, q := range options.Queues {
+		if err := queueStore.Ping(context.Background(), q.Name, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
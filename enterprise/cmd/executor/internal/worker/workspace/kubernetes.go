@@ -0,0 +1,36 @@
+package workspace
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/command"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/executor"
+)
+
+// NewKubernetesWorkspace creates a new workspace for Kubernetes-based execution. The
+// workspace is set up in a directory under mountPath, which is expected to be the path
+// at which the persistent volume shared with the job pods is mounted on the executor.
+func NewKubernetesWorkspace(
+	ctx context.Context,
+	filesStore store.FilesStore,
+	job executor.Job,
+	mountPath string,
+	commandRunner command.Runner,
+	logger command.Logger,
+	cloneOpts CloneOptions,
+	operations *command.Operations,
+) (Workspace, error) {
+	if err := os.MkdirAll(mountPath, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	workspaceDir, err := os.MkdirTemp(mountPath, "workspace-"+strconv.Itoa(job.ID)+"-*")
+	if err != nil {
+		return nil, err
+	}
+
+	return prepareHostWorkspace(ctx, workspaceDir, filesStore, job, commandRunner, logger, cloneOpts, operations)
+}
@@ -93,6 +93,15 @@ type BatchChange struct {
 	DiffStat                DiffStat
 	BulkOperations          BulkOperationConnection
 	BatchSpecs              BatchSpecConnection
+	ExecutionSchedule       *BatchChangeExecutionSchedule
+}
+
+type BatchChangeExecutionSchedule struct {
+	IntervalHours int
+	AutoApply     bool
+	User          *User
+	NextRunAt     string
+	LastRunAt     string
 }
 
 type BatchChangeConnection struct {
@@ -286,6 +286,20 @@ func (r *batchChangeResolver) CurrentSpec(ctx context.Context) (graphqlbackend.B
 	return &batchSpecResolver{store: r.store, batchSpec: batchSpec}, nil
 }
 
+func (r *batchChangeResolver) ExecutionSchedule(ctx context.Context) (graphqlbackend.BatchChangeExecutionScheduleResolver, error) {
+	schedule, err := r.store.GetBatchChangeExecutionSchedule(ctx, store.GetBatchChangeExecutionScheduleOpts{
+		BatchChangeID: r.batchChange.ID,
+	})
+	if err != nil {
+		if err == store.ErrNoResults {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &batchChangeExecutionScheduleResolver{store: r.store, schedule: schedule}, nil
+}
+
 func (r *batchChangeResolver) BulkOperations(
 	ctx context.Context,
 	args *graphqlbackend.ListBatchChangeBulkOperationArgs,
@@ -0,0 +1,62 @@
+package resolvers
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+)
+
+var _ graphqlbackend.BatchChangeExecutionScheduleResolver = &batchChangeExecutionScheduleResolver{}
+
+type batchChangeExecutionScheduleResolver struct {
+	store    *store.Store
+	schedule *btypes.BatchChangeExecutionSchedule
+}
+
+func (r *batchChangeExecutionScheduleResolver) IntervalHours() int32 {
+	return int32(r.schedule.Interval / time.Hour)
+}
+
+func (r *batchChangeExecutionScheduleResolver) AutoApply() bool {
+	return r.schedule.AutoApply
+}
+
+func (r *batchChangeExecutionScheduleResolver) User(ctx context.Context) (*graphqlbackend.UserResolver, error) {
+	user, err := graphqlbackend.UserByIDInt32(ctx, r.store.DatabaseDB(), r.schedule.UserID)
+	if errcode.IsNotFound(err) {
+		return nil, nil
+	}
+	return user, err
+}
+
+func (r *batchChangeExecutionScheduleResolver) NextRunAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.schedule.NextRunAt}
+}
+
+func (r *batchChangeExecutionScheduleResolver) LastRunAt() *gqlutil.DateTime {
+	if r.schedule.LastRunAt.IsZero() {
+		return nil
+	}
+	return &gqlutil.DateTime{Time: r.schedule.LastRunAt}
+}
+
+func (r *batchChangeExecutionScheduleResolver) BatchSpecInProgress(ctx context.Context) (graphqlbackend.BatchSpecResolver, error) {
+	if !r.schedule.InProgress() {
+		return nil, nil
+	}
+
+	batchSpec, err := r.store.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: r.schedule.BatchSpecID})
+	if err != nil {
+		if err == store.ErrNoResults {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &batchSpecResolver{store: r.store, batchSpec: batchSpec}, nil
+}
@@ -1303,6 +1303,110 @@ mutation($batchChange: ID!, $newName: String, $newNamespace: ID){
 }
 `
 
+func TestBatchChangeExecutionSchedule(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	ctx := context.Background()
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+
+	user := bt.CreateTestUser(t, db, false)
+	otherUser := bt.CreateTestUser(t, db, false)
+
+	bstore := store.New(db, &observation.TestContext, nil)
+
+	batchSpec := &btypes.BatchSpec{
+		RawSpec:         bt.TestRawBatchSpec,
+		UserID:          user.ID,
+		NamespaceUserID: user.ID,
+	}
+	if err := bstore.CreateBatchSpec(ctx, batchSpec); err != nil {
+		t.Fatal(err)
+	}
+
+	batchChange := &btypes.BatchChange{
+		BatchSpecID:     batchSpec.ID,
+		Name:            "scheduled",
+		CreatorID:       user.ID,
+		LastApplierID:   user.ID,
+		LastAppliedAt:   time.Now(),
+		NamespaceUserID: user.ID,
+	}
+	if err := bstore.CreateBatchChange(ctx, batchChange); err != nil {
+		t.Fatal(err)
+	}
+
+	r := &Resolver{store: bstore}
+	s, err := newSchema(db, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := map[string]any{
+		"batchChange":   string(marshalBatchChangeID(batchChange.ID)),
+		"intervalHours": 24,
+		"autoApply":     true,
+	}
+
+	t.Run("unauthorized", func(t *testing.T) {
+		var response struct{}
+		errs := apitest.Exec(actor.WithActor(ctx, actor.FromUser(otherUser.ID)), t, s, input, &response, mutationSetBatchChangeExecutionSchedule)
+		if len(errs) == 0 {
+			t.Fatal("expected error, got none")
+		}
+	})
+
+	actorCtx := actor.WithActor(ctx, actor.FromUser(user.ID))
+
+	t.Run("set", func(t *testing.T) {
+		var response struct{ SetBatchChangeExecutionSchedule apitest.BatchChange }
+		apitest.MustExec(actorCtx, t, s, input, &response, mutationSetBatchChangeExecutionSchedule)
+
+		have := response.SetBatchChangeExecutionSchedule.ExecutionSchedule
+		if have == nil {
+			t.Fatal("execution schedule is nil")
+		}
+		want := &apitest.BatchChangeExecutionSchedule{
+			IntervalHours: 24,
+			AutoApply:     true,
+			User:          &apitest.User{DatabaseID: user.ID},
+			NextRunAt:     have.NextRunAt,
+		}
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Fatalf("unexpected execution schedule (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		var response struct{ RemoveBatchChangeExecutionSchedule apitest.BatchChange }
+		apitest.MustExec(actorCtx, t, s, map[string]any{"batchChange": input["batchChange"]}, &response, mutationRemoveBatchChangeExecutionSchedule)
+
+		if have := response.RemoveBatchChangeExecutionSchedule.ExecutionSchedule; have != nil {
+			t.Fatalf("execution schedule is not nil: %+v", have)
+		}
+	})
+}
+
+const mutationSetBatchChangeExecutionSchedule = `
+mutation($batchChange: ID!, $intervalHours: Int!, $autoApply: Boolean){
+  setBatchChangeExecutionSchedule(batchChange: $batchChange, intervalHours: $intervalHours, autoApply: $autoApply) {
+	id
+	executionSchedule { intervalHours, autoApply, user { databaseID }, nextRunAt, lastRunAt }
+  }
+}
+`
+
+const mutationRemoveBatchChangeExecutionSchedule = `
+mutation($batchChange: ID!){
+  removeBatchChangeExecutionSchedule(batchChange: $batchChange) {
+	id
+	executionSchedule { intervalHours }
+  }
+}
+`
+
 func TestListChangesetOptsFromArgs(t *testing.T) {
 	var wantFirst int32 = 10
 	wantPublicationStates := []btypes.ChangesetPublicationState{
@@ -0,0 +1,169 @@
+package webhooks
+
+import (
+	"crypto/subtle"
+	"io"
+	"net/http"
+	"strconv"
+
+	fewebhooks "github.com/sourcegraph/sourcegraph/cmd/frontend/webhooks"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+type AzureDevOpsWebhook struct {
+	*webhook
+}
+
+func NewAzureDevOpsWebhook(store *store.Store, gitserverClient gitserver.Client) *AzureDevOpsWebhook {
+	return &AzureDevOpsWebhook{
+		webhook: &webhook{store, gitserverClient, extsvc.TypeAzureDevOps},
+	}
+}
+
+func (h *AzureDevOpsWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e, extSvc, hErr := h.parseEvent(r)
+	if hErr != nil {
+		respond(w, hErr.code, hErr)
+		return
+	}
+
+	fewebhooks.SetExternalServiceID(r.Context(), extSvc.ID)
+	if e == nil {
+		respond(w, http.StatusNoContent, nil)
+		return
+	}
+
+	// 🚨 SECURITY: now that the shared secret has been validated, we can use an
+	// internal actor on the context.
+	ctx := actor.WithInternalActor(r.Context())
+
+	externalServiceID, err := extractExternalServiceID(ctx, extSvc)
+	if err != nil {
+		respond(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	prs, ev, err := h.convertEvent(e)
+	if err != nil {
+		respond(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	var m error
+	for _, pr := range prs {
+		err := h.upsertChangesetEvent(ctx, externalServiceID, pr, ev)
+		if err != nil {
+			m = errors.Append(m, err)
+		}
+	}
+	if m != nil {
+		respond(w, http.StatusInternalServerError, m)
+	} else {
+		respond(w, http.StatusNoContent, nil)
+	}
+}
+
+func (h *AzureDevOpsWebhook) parseEvent(r *http.Request) (any, *types.ExternalService, *httpError) {
+	if r.Body == nil {
+		return nil, nil, &httpError{http.StatusBadRequest, errors.New("nil request body")}
+	}
+
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, nil, &httpError{http.StatusInternalServerError, err}
+	}
+
+	rawID := r.FormValue(extsvc.IDParam)
+	var externalServiceID int64
+	if rawID != "" {
+		externalServiceID, err = strconv.ParseInt(rawID, 10, 64)
+		if err != nil {
+			return nil, nil, &httpError{http.StatusBadRequest, errors.Wrap(err, "invalid external service id")}
+		}
+	}
+
+	args := database.ExternalServicesListOptions{Kinds: []string{extsvc.KindAzureDevOps}}
+	if externalServiceID != 0 {
+		args.IDs = append(args.IDs, externalServiceID)
+	}
+	es, err := h.Store.ExternalServices().List(r.Context(), args)
+	if err != nil {
+		return nil, nil, &httpError{http.StatusInternalServerError, err}
+	}
+
+	// Azure DevOps service hooks can't sign their payloads, but they can be
+	// configured to send basic authentication credentials, so we use the
+	// password as the shared secret.
+	_, password, ok := r.BasicAuth()
+	if !ok {
+		return nil, nil, &httpError{http.StatusUnauthorized, errors.New("missing basic authentication credentials")}
+	}
+
+	var extSvc *types.ExternalService
+	for _, e := range es {
+		if externalServiceID != 0 && e.ID != externalServiceID {
+			continue
+		}
+
+		c, _ := e.Configuration(r.Context())
+		con, ok := c.(*schema.AzureDevOpsConnection)
+		if !ok {
+			continue
+		}
+
+		if secret := con.WebhookSecret; secret != "" && subtle.ConstantTimeCompare([]byte(password), []byte(secret)) == 1 {
+			extSvc = e
+			break
+		}
+	}
+
+	if extSvc == nil {
+		return nil, nil, &httpError{http.StatusUnauthorized, errors.New("no matching external service")}
+	}
+
+	e, err := azuredevops.ParseWebhookEvent(payload)
+	if err != nil {
+		if errors.HasType(err, azuredevops.UnknownWebhookEventType("")) {
+			// Unknown type, so we'll just ignore it.
+			return nil, extSvc, nil
+		}
+		return nil, nil, &httpError{http.StatusBadRequest, errors.Wrap(err, "parsing webhook")}
+	}
+
+	return e, extSvc, nil
+}
+
+// convertEvent returns the pull requests the given event relates to, and the
+// event to store for them. Events we don't track, such as the creation of pull
+// requests, yield no pull requests.
+func (h *AzureDevOpsWebhook) convertEvent(theirs any) ([]PR, keyer, error) {
+	switch e := theirs.(type) {
+	case *azuredevops.PullRequestCreatedEvent:
+		return nil, e, nil
+	case *azuredevops.PullRequestUpdatedEvent:
+		return azureDevOpsPullRequestEventPRs(&e.PullRequest), e, nil
+	case *azuredevops.PullRequestMergedEvent:
+		return azureDevOpsPullRequestEventPRs(&e.PullRequest), e, nil
+	case *azuredevops.PullRequestCommentedEvent:
+		return azureDevOpsPullRequestEventPRs(&e.Resource.PullRequest), e, nil
+	default:
+		return nil, nil, errors.Newf("unknown event type: %T", theirs)
+	}
+}
+
+func azureDevOpsPullRequestEventPRs(pr *azuredevops.PullRequest) []PR {
+	return []PR{
+		{
+			ID:             int64(pr.ID),
+			RepoExternalID: pr.Repository.ID,
+		},
+	}
+}
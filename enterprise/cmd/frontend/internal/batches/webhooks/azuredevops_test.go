@@ -0,0 +1,52 @@
+package webhooks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
+)
+
+func TestAzureDevOpsWebhook_convertEvent(t *testing.T) {
+	h := &AzureDevOpsWebhook{}
+	pr := azuredevops.PullRequest{
+		ID:         42,
+		Repository: azuredevops.Repository{ID: "repo-id"},
+	}
+	want := []PR{{ID: 42, RepoExternalID: "repo-id"}}
+
+	t.Run("created", func(t *testing.T) {
+		e := &azuredevops.PullRequestCreatedEvent{
+			PullRequestEvent: azuredevops.PullRequestEvent{PullRequest: pr},
+		}
+		prs, ev, err := h.convertEvent(e)
+		assert.Nil(t, err)
+		assert.Empty(t, prs)
+		assert.Same(t, e, ev)
+	})
+
+	for name, e := range map[string]keyer{
+		"updated": &azuredevops.PullRequestUpdatedEvent{
+			PullRequestEvent: azuredevops.PullRequestEvent{PullRequest: pr},
+		},
+		"merged": &azuredevops.PullRequestMergedEvent{
+			PullRequestEvent: azuredevops.PullRequestEvent{PullRequest: pr},
+		},
+		"commented": &azuredevops.PullRequestCommentedEvent{
+			Resource: azuredevops.PullRequestCommentedResource{PullRequest: pr},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			prs, ev, err := h.convertEvent(e)
+			assert.Nil(t, err)
+			assert.Equal(t, want, prs)
+			assert.Same(t, e, ev)
+		})
+	}
+
+	t.Run("unknown event", func(t *testing.T) {
+		_, _, err := h.convertEvent(&azuredevops.PullRequest{})
+		assert.NotNil(t, err)
+	})
+}
@@ -59,6 +59,7 @@ type Action struct {
 	Email        *ActionEmail
 	Webhook      *ActionWebhook
 	SlackWebhook *ActionSlackWebhook
+	TeamsWebhook *ActionTeamsWebhook
 }
 
 func (a *Action) UnmarshalJSON(b []byte) error {
@@ -80,6 +81,9 @@ func (a *Action) UnmarshalJSON(b []byte) error {
 	case "MonitorSlackWebhook":
 		a.SlackWebhook = &ActionSlackWebhook{}
 		return json.Unmarshal(b, &a.SlackWebhook)
+	case "MonitorTeamsWebhook":
+		a.TeamsWebhook = &ActionTeamsWebhook{}
+		return json.Unmarshal(b, &a.TeamsWebhook)
 	default:
 		return errors.Errorf("unexpected typename %q", t.TypeName)
 	}
@@ -108,6 +112,13 @@ type ActionSlackWebhook struct {
 	Events  ActionEventConnection
 }
 
+type ActionTeamsWebhook struct {
+	Id      string
+	Enabled bool
+	URL     string
+	Events  ActionEventConnection
+}
+
 type RecipientsConnection struct {
 	Nodes      []UserOrg
 	TotalCount int
@@ -3,11 +3,14 @@ package resolvers
 import (
 	"context"
 	"net/url"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/graph-gophers/graphql-go"
 	"github.com/graph-gophers/graphql-go/relay"
 	"github.com/sourcegraph/log"
+	"golang.org/x/net/http/httpguts"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
@@ -278,7 +281,11 @@ func (r *Resolver) createActions(ctx context.Context, monitorID int64, args []*g
 				return err
 			}
 		case a.Webhook != nil:
-			_, err := r.db.CodeMonitors().CreateWebhookAction(ctx, monitorID, a.Webhook.Enabled, a.Webhook.IncludeResults, a.Webhook.URL)
+			webhookArgs, err := toWebhookActionArgs(a.Webhook)
+			if err != nil {
+				return err
+			}
+			_, err = r.db.CodeMonitors().CreateWebhookAction(ctx, monitorID, webhookArgs)
 			if err != nil {
 				return err
 			}
@@ -290,15 +297,23 @@ func (r *Resolver) createActions(ctx context.Context, monitorID int64, args []*g
 			if err != nil {
 				return err
 			}
+		case a.TeamsWebhook != nil:
+			if err := validateTeamsURL(a.TeamsWebhook.URL); err != nil {
+				return err
+			}
+			_, err := r.db.CodeMonitors().CreateTeamsWebhookAction(ctx, monitorID, a.TeamsWebhook.Enabled, a.TeamsWebhook.IncludeResults, a.TeamsWebhook.URL)
+			if err != nil {
+				return err
+			}
 		default:
-			return errors.New("exactly one of Email, Webhook, or SlackWebhook must be set")
+			return errors.New("exactly one of Email, Webhook, SlackWebhook, or TeamsWebhook must be set")
 		}
 	}
 	return nil
 }
 
 func (r *Resolver) deleteActions(ctx context.Context, monitorID int64, ids []graphql.ID) error {
-	var email, webhook, slackWebhook []int64
+	var email, webhook, slackWebhook, teamsWebhook []int64
 	for _, id := range ids {
 		var intID int64
 		err := relay.UnmarshalSpec(id, &intID)
@@ -313,8 +328,10 @@ func (r *Resolver) deleteActions(ctx context.Context, monitorID int64, ids []gra
 			webhook = append(webhook, intID)
 		case monitorActionSlackWebhookKind:
 			slackWebhook = append(slackWebhook, intID)
+		case monitorActionTeamsWebhookKind:
+			teamsWebhook = append(teamsWebhook, intID)
 		default:
-			return errors.New("action IDs must be exactly one of email, webhook, or slack webhook")
+			return errors.New("action IDs must be exactly one of email, webhook, slack webhook, or teams webhook")
 		}
 	}
 
@@ -330,6 +347,10 @@ func (r *Resolver) deleteActions(ctx context.Context, monitorID int64, ids []gra
 		return err
 	}
 
+	if err := r.db.CodeMonitors().DeleteTeamsWebhookActions(ctx, monitorID, teamsWebhook...); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -390,7 +411,16 @@ func (r *Resolver) TriggerTestWebhookAction(ctx context.Context, args *graphqlba
 		return nil, err
 	}
 
-	if err := background.SendTestWebhook(ctx, httpcli.ExternalDoer, args.Description, args.Webhook.URL); err != nil {
+	webhookArgs, err := toWebhookActionArgs(args.Webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	var payloadTemplate string
+	if webhookArgs.PayloadTemplate != nil {
+		payloadTemplate = *webhookArgs.PayloadTemplate
+	}
+	if err := background.SendTestWebhook(ctx, httpcli.ExternalDoer, args.Description, args.Webhook.URL, payloadTemplate, webhookArgs.Headers); err != nil {
 		return nil, err
 	}
 
@@ -410,6 +440,23 @@ func (r *Resolver) TriggerTestSlackWebhookAction(ctx context.Context, args *grap
 	return &graphqlbackend.EmptyResponse{}, nil
 }
 
+func (r *Resolver) TriggerTestTeamsWebhookAction(ctx context.Context, args *graphqlbackend.TriggerTestTeamsWebhookActionArgs) (*graphqlbackend.EmptyResponse, error) {
+	err := r.isAllowedToCreate(ctx, args.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateTeamsURL(args.TeamsWebhook.URL); err != nil {
+		return nil, err
+	}
+
+	if err := background.SendTestTeamsWebhook(ctx, httpcli.ExternalDoer, args.Description, args.TeamsWebhook.URL); err != nil {
+		return nil, err
+	}
+
+	return &graphqlbackend.EmptyResponse{}, nil
+}
+
 func sendTestEmail(ctx context.Context, db database.DB, recipient graphql.ID, description string) error {
 	var (
 		userID int32
@@ -441,7 +488,11 @@ func (r *Resolver) actionIDsForMonitorIDInt64(ctx context.Context, monitorID int
 	if err != nil {
 		return nil, err
 	}
-	ids := make([]graphql.ID, 0, len(emailActions)+len(webhookActions)+len(slackWebhookActions))
+	teamsWebhookActions, err := r.db.CodeMonitors().ListTeamsWebhookActions(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]graphql.ID, 0, len(emailActions)+len(webhookActions)+len(slackWebhookActions)+len(teamsWebhookActions))
 	for _, emailAction := range emailActions {
 		ids = append(ids, (&monitorEmail{EmailAction: emailAction}).ID())
 	}
@@ -451,6 +502,9 @@ func (r *Resolver) actionIDsForMonitorIDInt64(ctx context.Context, monitorID int
 	for _, slackWebhookAction := range slackWebhookActions {
 		ids = append(ids, (&monitorSlackWebhook{SlackWebhookAction: slackWebhookAction}).ID())
 	}
+	for _, teamsWebhookAction := range teamsWebhookActions {
+		ids = append(ids, (&monitorTeamsWebhook{TeamsWebhookAction: teamsWebhookAction}).ID())
+	}
 	return ids, nil
 }
 
@@ -495,6 +549,16 @@ func splitActionIDs(args *graphqlbackend.UpdateCodeMonitorArgs, actionIDs []grap
 			}
 			toUpdateActions = append(toUpdateActions, a)
 			delete(aMap, *a.SlackWebhook.Id)
+		case a.TeamsWebhook != nil:
+			if a.TeamsWebhook.Id == nil {
+				toCreate = append(toCreate, &graphqlbackend.CreateActionArgs{TeamsWebhook: a.TeamsWebhook.Update})
+				continue
+			}
+			if _, ok := aMap[*a.TeamsWebhook.Id]; !ok {
+				return nil, nil, errors.Errorf("unknown ID=%s for action", *a.TeamsWebhook.Id)
+			}
+			toUpdateActions = append(toUpdateActions, a)
+			delete(aMap, *a.TeamsWebhook.Id)
 		}
 	}
 
@@ -579,8 +643,13 @@ func (r *Resolver) updateCodeMonitor(ctx context.Context, args *graphqlbackend.U
 				return nil, err
 			}
 			err = r.updateSlackWebhookAction(ctx, *action.SlackWebhook)
+		case action.TeamsWebhook != nil:
+			if err := validateTeamsURL(action.TeamsWebhook.Update.URL); err != nil {
+				return nil, err
+			}
+			err = r.updateTeamsWebhookAction(ctx, *action.TeamsWebhook)
 		default:
-			err = errors.New("action must be one of email, webhook, or slack webhook")
+			err = errors.New("action must be one of email, webhook, slack webhook, or teams webhook")
 		}
 		if err != nil {
 			return nil, err
@@ -621,7 +690,12 @@ func (r *Resolver) updateWebhookAction(ctx context.Context, args graphqlbackend.
 		return err
 	}
 
-	_, err = r.db.CodeMonitors().UpdateWebhookAction(ctx, id, args.Update.Enabled, args.Update.IncludeResults, args.Update.URL)
+	webhookArgs, err := toWebhookActionArgs(args.Update)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.CodeMonitors().UpdateWebhookAction(ctx, id, webhookArgs)
 	return err
 }
 
@@ -636,6 +710,49 @@ func (r *Resolver) updateSlackWebhookAction(ctx context.Context, args graphqlbac
 	return err
 }
 
+func (r *Resolver) updateTeamsWebhookAction(ctx context.Context, args graphqlbackend.EditActionTeamsWebhookArgs) error {
+	var id int64
+	err := relay.UnmarshalSpec(*args.Id, &id)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.CodeMonitors().UpdateTeamsWebhookAction(ctx, id, args.Update.Enabled, args.Update.IncludeResults, args.Update.URL)
+	return err
+}
+
+// toWebhookActionArgs validates the payload template and custom headers of a
+// webhook action and converts them to their database representation.
+func toWebhookActionArgs(args *graphqlbackend.CreateActionWebhookArgs) (*edb.WebhookActionArgs, error) {
+	if args.PayloadTemplate != nil {
+		if err := background.ValidateWebhookPayloadTemplate(*args.PayloadTemplate); err != nil {
+			return nil, errors.Wrap(err, "invalid payload template")
+		}
+	}
+
+	var headers map[string]string
+	if args.Headers != nil {
+		headers = make(map[string]string, len(*args.Headers))
+		for _, h := range *args.Headers {
+			if !httpguts.ValidHeaderFieldName(h.Name) {
+				return nil, errors.Errorf("invalid header name %q", h.Name)
+			}
+			if !httpguts.ValidHeaderFieldValue(h.Value) {
+				return nil, errors.Errorf("invalid value for header %q", h.Name)
+			}
+			headers[h.Name] = h.Value
+		}
+	}
+
+	return &edb.WebhookActionArgs{
+		Enabled:         args.Enabled,
+		IncludeResults:  args.IncludeResults,
+		URL:             args.URL,
+		PayloadTemplate: args.PayloadTemplate,
+		Headers:         headers,
+	}, nil
+}
+
 func (r *Resolver) transact(ctx context.Context) (*Resolver, error) {
 	tx, err := r.db.Transact(ctx)
 	if err != nil {
@@ -721,9 +838,11 @@ const (
 	monitorActionEmailKind             = "CodeMonitorActionEmail"
 	monitorActionWebhookKind           = "CodeMonitorActionWebhook"
 	monitorActionSlackWebhookKind      = "CodeMonitorActionSlackWebhook"
+	monitorActionTeamsWebhookKind      = "CodeMonitorActionTeamsWebhook"
 	monitorActionEmailEventKind        = "CodeMonitorActionEmailEvent"
 	monitorActionWebhookEventKind      = "CodeMonitorActionWebhookEvent"
 	monitorActionSlackWebhookEventKind = "CodeMonitorActionSlackWebhookEvent"
+	monitorActionTeamsWebhookEventKind = "CodeMonitorActionTeamsWebhookEvent"
 	monitorActionEmailRecipientKind    = "CodeMonitorActionEmailRecipient"
 )
 
@@ -816,7 +935,12 @@ func (r *Resolver) actionConnectionResolverWithTriggerID(ctx context.Context, tr
 		return nil, err
 	}
 
-	actions := make([]graphqlbackend.MonitorAction, 0, len(es)+len(ws)+len(sws))
+	tws, err := r.db.CodeMonitors().ListTeamsWebhookActions(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	actions := make([]graphqlbackend.MonitorAction, 0, len(es)+len(ws)+len(sws)+len(tws))
 	for _, e := range es {
 		actions = append(actions, &action{
 			email: &monitorEmail{
@@ -844,6 +968,15 @@ func (r *Resolver) actionConnectionResolverWithTriggerID(ctx context.Context, tr
 			},
 		})
 	}
+	for _, tw := range tws {
+		actions = append(actions, &action{
+			teamsWebhook: &monitorTeamsWebhook{
+				Resolver:           r,
+				TeamsWebhookAction: tw,
+				triggerEventID:     triggerEventID,
+			},
+		})
+	}
 
 	totalCount := len(actions)
 	if args.After != nil {
@@ -1020,6 +1153,7 @@ type action struct {
 	email        graphqlbackend.MonitorEmailResolver
 	webhook      graphqlbackend.MonitorWebhookResolver
 	slackWebhook graphqlbackend.MonitorSlackWebhookResolver
+	teamsWebhook graphqlbackend.MonitorTeamsWebhookResolver
 }
 
 func (a *action) ID() graphql.ID {
@@ -1030,6 +1164,8 @@ func (a *action) ID() graphql.ID {
 		return a.webhook.ID()
 	case a.slackWebhook != nil:
 		return a.slackWebhook.ID()
+	case a.teamsWebhook != nil:
+		return a.teamsWebhook.ID()
 	default:
 		panic("action must have a type")
 	}
@@ -1047,6 +1183,10 @@ func (a *action) ToMonitorSlackWebhook() (graphqlbackend.MonitorSlackWebhookReso
 	return a.slackWebhook, a.slackWebhook != nil
 }
 
+func (a *action) ToMonitorTeamsWebhook() (graphqlbackend.MonitorTeamsWebhookResolver, bool) {
+	return a.teamsWebhook, a.teamsWebhook != nil
+}
+
 // Email
 type monitorEmail struct {
 	*Resolver
@@ -1176,6 +1316,23 @@ func (m *monitorWebhook) URL() string {
 	return m.WebhookAction.URL
 }
 
+func (m *monitorWebhook) PayloadTemplate() string {
+	return m.WebhookAction.PayloadTemplate
+}
+
+func (m *monitorWebhook) HeaderNames(ctx context.Context) ([]string, error) {
+	headers, err := m.WebhookAction.Headers.Decrypt(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
 func (m *monitorWebhook) Events(ctx context.Context, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorActionEventConnectionResolver, error) {
 	after, err := unmarshalAfter(args.After)
 	if err != nil {
@@ -1262,6 +1419,62 @@ func (m *monitorSlackWebhook) Events(ctx context.Context, args *graphqlbackend.L
 	return &monitorActionEventConnection{events: events, totalCount: int32(totalCount)}, nil
 }
 
+type monitorTeamsWebhook struct {
+	*Resolver
+	*edb.TeamsWebhookAction
+
+	// If triggerEventID == nil, all events of this action will be returned.
+	// Otherwise, only those events of this action which are related to the specified
+	// trigger event will be returned.
+	triggerEventID *int32
+}
+
+func (m *monitorTeamsWebhook) ID() graphql.ID {
+	return relay.MarshalID(monitorActionTeamsWebhookKind, m.TeamsWebhookAction.ID)
+}
+
+func (m *monitorTeamsWebhook) Enabled() bool {
+	return m.TeamsWebhookAction.Enabled
+}
+
+func (m *monitorTeamsWebhook) IncludeResults() bool {
+	return m.TeamsWebhookAction.IncludeResults
+}
+
+func (m *monitorTeamsWebhook) URL() string {
+	return m.TeamsWebhookAction.URL
+}
+
+func (m *monitorTeamsWebhook) Events(ctx context.Context, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorActionEventConnectionResolver, error) {
+	after, err := unmarshalAfter(args.After)
+	if err != nil {
+		return nil, err
+	}
+
+	ajs, err := m.db.CodeMonitors().ListActionJobs(ctx, edb.ListActionJobsOpts{
+		TeamsWebhookID: intPtr(int(m.TeamsWebhookAction.ID)),
+		TriggerEventID: m.triggerEventID,
+		First:          intPtr(int(args.First)),
+		After:          after,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount, err := m.db.CodeMonitors().CountActionJobs(ctx, edb.ListActionJobsOpts{
+		TeamsWebhookID: intPtr(int(m.TeamsWebhookAction.ID)),
+		TriggerEventID: m.triggerEventID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	events := make([]graphqlbackend.MonitorActionEventResolver, len(ajs))
+	for i, aj := range ajs {
+		events[i] = &monitorActionEvent{Resolver: m.Resolver, ActionJob: aj}
+	}
+	return &monitorActionEventConnection{events: events, totalCount: int32(totalCount)}, nil
+}
+
 func intPtr(i int) *int { return &i }
 func intPtrToInt64Ptr(i *int) *int64 {
 	if i == nil {
@@ -1343,6 +1556,43 @@ func (m *monitorActionEvent) Timestamp() gqlutil.DateTime {
 	return gqlutil.DateTime{Time: *m.FinishedAt}
 }
 
+func (m *monitorActionEvent) Deliveries(ctx context.Context) ([]graphqlbackend.MonitorActionDeliveryResolver, error) {
+	attempts, err := m.db.CodeMonitors().ListActionDeliveryAttempts(ctx, m.ActionJob.ID)
+	if err != nil {
+		return nil, err
+	}
+	deliveries := make([]graphqlbackend.MonitorActionDeliveryResolver, 0, len(attempts))
+	for _, a := range attempts {
+		deliveries = append(deliveries, &monitorActionDelivery{a})
+	}
+	return deliveries, nil
+}
+
+// MonitorActionDelivery
+type monitorActionDelivery struct {
+	*edb.ActionDeliveryAttempt
+}
+
+func (m *monitorActionDelivery) Attempt() int32 {
+	return m.ActionDeliveryAttempt.Attempt
+}
+
+func (m *monitorActionDelivery) StatusCode() *int32 {
+	return m.ActionDeliveryAttempt.StatusCode
+}
+
+func (m *monitorActionDelivery) Error() *string {
+	return m.ActionDeliveryAttempt.Error
+}
+
+func (m *monitorActionDelivery) DurationMs() int32 {
+	return int32(m.Duration.Milliseconds())
+}
+
+func (m *monitorActionDelivery) Timestamp() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: m.CreatedAt}
+}
+
 func validateSlackURL(urlString string) error {
 	u, err := url.Parse(urlString)
 	if err != nil {
@@ -1355,3 +1605,18 @@ func validateSlackURL(urlString string) error {
 	}
 	return nil
 }
+
+func validateTeamsURL(urlString string) error {
+	u, err := url.Parse(urlString)
+	if err != nil {
+		return err
+	}
+
+	// Restrict Teams webhooks to Microsoft hosted incoming webhooks and
+	// workflows, and HTTPS
+	validHost := strings.HasSuffix(u.Hostname(), ".webhook.office.com") || strings.HasSuffix(u.Hostname(), ".logic.azure.com")
+	if !validHost || u.Scheme != "https" {
+		return errors.New("teams webhook URL must be an HTTPS URL on webhook.office.com or logic.azure.com")
+	}
+	return nil
+}
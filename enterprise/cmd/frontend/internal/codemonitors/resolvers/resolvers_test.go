@@ -1323,3 +1323,56 @@ func TestValidateSlackURL(t *testing.T) {
 		require.Error(t, validateSlackURL(url))
 	}
 }
+
+func TestValidateTeamsURL(t *testing.T) {
+	valid := []string{
+		"https://contoso.webhook.office.com/webhookb2/8d8d8/IncomingWebhook/8dd88d/838383",
+		"https://prod-42.westus.logic.azure.com:443/workflows/8d8d8/triggers/manual/paths/invoke",
+	}
+
+	for _, url := range valid {
+		require.NoError(t, validateTeamsURL(url))
+	}
+
+	invalid := []string{
+		"http://contoso.webhook.office.com/webhookb2/8d8d8",
+		"https://webhook.office.com.attacker.com/webhookb2",
+		"https://internal:8989",
+	}
+
+	for _, url := range invalid {
+		require.Error(t, validateTeamsURL(url))
+	}
+}
+
+func TestToWebhookActionArgs(t *testing.T) {
+	payloadTemplate := `{"text": {{json .MonitorDescription}}}`
+	args, err := toWebhookActionArgs(&graphqlbackend.CreateActionWebhookArgs{
+		Enabled:         true,
+		URL:             "https://example.com/webhook",
+		PayloadTemplate: &payloadTemplate,
+		Headers:         &[]*graphqlbackend.WebhookHeaderArgs{{Name: "Authorization", Value: "Bearer secret"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &payloadTemplate, args.PayloadTemplate)
+	require.Equal(t, map[string]string{"Authorization": "Bearer secret"}, args.Headers)
+
+	// Headers are left untouched when unset.
+	args, err = toWebhookActionArgs(&graphqlbackend.CreateActionWebhookArgs{URL: "https://example.com/webhook"})
+	require.NoError(t, err)
+	require.Nil(t, args.Headers)
+
+	invalidTemplate := `{{.MonitorDescription`
+	_, err = toWebhookActionArgs(&graphqlbackend.CreateActionWebhookArgs{PayloadTemplate: &invalidTemplate})
+	require.Error(t, err)
+
+	_, err = toWebhookActionArgs(&graphqlbackend.CreateActionWebhookArgs{
+		Headers: &[]*graphqlbackend.WebhookHeaderArgs{{Name: "Bad Header", Value: "value"}},
+	})
+	require.Error(t, err)
+
+	_, err = toWebhookActionArgs(&graphqlbackend.CreateActionWebhookArgs{
+		Headers: &[]*graphqlbackend.WebhookHeaderArgs{{Name: "X-Injected", Value: "value\r\nX-Other: 1"}},
+	})
+	require.Error(t, err)
+}
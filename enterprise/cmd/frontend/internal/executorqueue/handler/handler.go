@@ -84,6 +84,34 @@ func (h *handler) dequeue(ctx context.Context, metadata executorMetadata) (_ api
 	return job, true, nil
 }
 
+// multiHandler serves requests that span several queues.
+type multiHandler struct {
+	handlersByQueue map[string]*handler
+}
+
+// dequeue tries to dequeue a job from each of the given queues in order, and
+// returns the first job found. The returned job records the queue it came from,
+// as job identifiers are only unique within a single queue.
+func (m *multiHandler) dequeue(ctx context.Context, queueNames []string, metadata executorMetadata) (_ apiclient.Job, dequeued bool, _ error) {
+	for _, queueName := range queueNames {
+		h, ok := m.handlersByQueue[queueName]
+		if !ok {
+			return apiclient.Job{}, false, errors.Newf("unknown queue %q", queueName)
+		}
+
+		job, dequeued, err := h.dequeue(ctx, metadata)
+		if err != nil {
+			return apiclient.Job{}, false, errors.Wrapf(err, "dequeueing from %s", queueName)
+		}
+		if dequeued {
+			job.Queue = queueName
+			return job, true, nil
+		}
+	}
+
+	return apiclient.Job{}, false, nil
+}
+
 // addExecutionLogEntry calls AddExecutionLogEntry for the given job.
 func (h *handler) addExecutionLogEntry(ctx context.Context, executorName string, jobID int, entry workerutil.ExecutionLogEntry) (entryID int, err error) {
 	entryID, err = h.Store.AddExecutionLogEntry(ctx, jobID, entry, store.ExecutionLogEntryOptions{
@@ -75,6 +75,53 @@ func TestDequeueNoRecord(t *testing.T) {
 	}
 }
 
+func TestMultiQueueDequeue(t *testing.T) {
+	emptyStore := workerstoremocks.NewMockStore()
+	batchesStore := workerstoremocks.NewMockStore()
+	batchesStore.DequeueFunc.SetDefaultReturn(testRecord{ID: 42}, true, nil)
+	codeintelStore := workerstoremocks.NewMockStore()
+	codeintelStore.DequeueFunc.SetDefaultReturn(testRecord{ID: 42}, true, nil)
+	recordTransformer := func(ctx context.Context, record workerutil.Record, _ ResourceMetadata) (apiclient.Job, error) {
+		return apiclient.Job{ID: record.RecordID()}, nil
+	}
+
+	executorStore := database.NewMockExecutorStore()
+	metricsStore := metricsstore.NewMockDistributedStore()
+
+	m := &multiHandler{handlersByQueue: map[string]*handler{
+		"empty":     newHandler(executorStore, metricsStore, QueueOptions{Name: "empty", Store: emptyStore, RecordTransformer: recordTransformer}),
+		"batches":   newHandler(executorStore, metricsStore, QueueOptions{Name: "batches", Store: batchesStore, RecordTransformer: recordTransformer}),
+		"codeintel": newHandler(executorStore, metricsStore, QueueOptions{Name: "codeintel", Store: codeintelStore, RecordTransformer: recordTransformer}),
+	}}
+
+	job, dequeued, err := m.dequeue(context.Background(), []string{"empty", "codeintel", "batches"}, executorMetadata{Name: "deadbeef"})
+	if err != nil {
+		t.Fatalf("unexpected error dequeueing job: %s", err)
+	}
+	if !dequeued {
+		t.Fatalf("expected job to be dequeued")
+	}
+	if diff := cmp.Diff(apiclient.Job{ID: 42, Queue: "codeintel"}, job); diff != "" {
+		t.Errorf("unexpected job (-want +got):\n%s", diff)
+	}
+	if callCount := len(emptyStore.DequeueFunc.History()); callCount != 1 {
+		t.Errorf("unexpected dequeue count for empty queue. want=%d have=%d", 1, callCount)
+	}
+	if callCount := len(batchesStore.DequeueFunc.History()); callCount != 0 {
+		t.Errorf("unexpected dequeue count for batches queue. want=%d have=%d", 0, callCount)
+	}
+
+	if _, dequeued, err := m.dequeue(context.Background(), []string{"empty"}, executorMetadata{Name: "deadbeef"}); err != nil {
+		t.Fatalf("unexpected error dequeueing job: %s", err)
+	} else if dequeued {
+		t.Fatalf("did not expect a job to be dequeued")
+	}
+
+	if _, _, err := m.dequeue(context.Background(), []string{"unknown"}, executorMetadata{Name: "deadbeef"}); err == nil {
+		t.Fatalf("expected an error for an unknown queue")
+	}
+}
+
 func TestAddExecutionLogEntry(t *testing.T) {
 	store := workerstoremocks.NewMockStore()
 	store.DequeueFunc.SetDefaultReturn(testRecord{ID: 42}, true, nil)
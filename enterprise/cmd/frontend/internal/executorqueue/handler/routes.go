@@ -27,8 +27,10 @@ import (
 // SetupRoutes registers all route handlers required for all configured executor
 // queues with the given router.
 func SetupRoutes(executorStore database.ExecutorStore, metricsStore metricsstore.DistributedStore, queueOptionsMap []QueueOptions, router *mux.Router) {
+	handlersByQueue := make(map[string]*handler, len(queueOptionsMap))
 	for _, queueOptions := range queueOptionsMap {
 		h := newHandler(executorStore, metricsStore, queueOptions)
+		handlersByQueue[queueOptions.Name] = h
 
 		subRouter := router.PathPrefix(fmt.Sprintf("/{queueName:(?:%s)}/", regexp.QuoteMeta(queueOptions.Name))).Subrouter()
 		routes := map[string]func(w http.ResponseWriter, r *http.Request){
@@ -45,13 +47,16 @@ func SetupRoutes(executorStore database.ExecutorStore, metricsStore metricsstore
 			subRouter.Path(fmt.Sprintf("/%s", path)).Methods("POST").HandlerFunc(handler)
 		}
 	}
+
+	m := &multiHandler{handlersByQueue: handlersByQueue}
+	router.Path("/dequeue").Methods("POST").HandlerFunc(m.handleDequeue)
 }
 
 // POST /{queueName}/dequeue
 func (h *handler) handleDequeue(w http.ResponseWriter, r *http.Request) {
 	var payload apiclient.DequeueRequest
 
-	h.wrapHandler(w, r, &payload, func() (int, any, error) {
+	wrapHandler(w, r, &payload, func() (int, any, error) {
 		job, dequeued, err := h.dequeue(r.Context(), executorMetadata{
 			Name: payload.ExecutorName,
 			Resources: ResourceMetadata{
@@ -68,11 +73,41 @@ func (h *handler) handleDequeue(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// POST /dequeue
+func (m *multiHandler) handleDequeue(w http.ResponseWriter, r *http.Request) {
+	var payload apiclient.DequeueRequest
+
+	wrapHandler(w, r, &payload, func() (int, any, error) {
+		if len(payload.Queues) == 0 {
+			return http.StatusBadRequest, errorResponse{Error: "no queues supplied"}, nil
+		}
+		for _, queueName := range payload.Queues {
+			if _, ok := m.handlersByQueue[queueName]; !ok {
+				return http.StatusBadRequest, errorResponse{Error: fmt.Sprintf("unknown queue %q", queueName)}, nil
+			}
+		}
+
+		job, dequeued, err := m.dequeue(r.Context(), payload.Queues, executorMetadata{
+			Name: payload.ExecutorName,
+			Resources: ResourceMetadata{
+				NumCPUs:   payload.NumCPUs,
+				Memory:    payload.Memory,
+				DiskSpace: payload.DiskSpace,
+			},
+		})
+		if !dequeued {
+			return http.StatusNoContent, nil, err
+		}
+
+		return http.StatusOK, job, err
+	})
+}
+
 // POST /{queueName}/addExecutionLogEntry
 func (h *handler) handleAddExecutionLogEntry(w http.ResponseWriter, r *http.Request) {
 	var payload apiclient.AddExecutionLogEntryRequest
 
-	h.wrapHandler(w, r, &payload, func() (int, any, error) {
+	wrapHandler(w, r, &payload, func() (int, any, error) {
 		id, err := h.addExecutionLogEntry(r.Context(), payload.ExecutorName, payload.JobID, payload.ExecutionLogEntry)
 		return http.StatusOK, id, err
 	})
@@ -82,7 +117,7 @@ func (h *handler) handleAddExecutionLogEntry(w http.ResponseWriter, r *http.Requ
 func (h *handler) handleUpdateExecutionLogEntry(w http.ResponseWriter, r *http.Request) {
 	var payload apiclient.UpdateExecutionLogEntryRequest
 
-	h.wrapHandler(w, r, &payload, func() (int, any, error) {
+	wrapHandler(w, r, &payload, func() (int, any, error) {
 		err := h.updateExecutionLogEntry(r.Context(), payload.ExecutorName, payload.JobID, payload.EntryID, payload.ExecutionLogEntry)
 		return http.StatusNoContent, nil, err
 	})
@@ -92,7 +127,7 @@ func (h *handler) handleUpdateExecutionLogEntry(w http.ResponseWriter, r *http.R
 func (h *handler) handleMarkComplete(w http.ResponseWriter, r *http.Request) {
 	var payload apiclient.MarkCompleteRequest
 
-	h.wrapHandler(w, r, &payload, func() (int, any, error) {
+	wrapHandler(w, r, &payload, func() (int, any, error) {
 		err := h.markComplete(r.Context(), payload.ExecutorName, payload.JobID)
 		if err == ErrUnknownJob {
 			return http.StatusNotFound, nil, nil
@@ -106,7 +141,7 @@ func (h *handler) handleMarkComplete(w http.ResponseWriter, r *http.Request) {
 func (h *handler) handleMarkErrored(w http.ResponseWriter, r *http.Request) {
 	var payload apiclient.MarkErroredRequest
 
-	h.wrapHandler(w, r, &payload, func() (int, any, error) {
+	wrapHandler(w, r, &payload, func() (int, any, error) {
 		err := h.markErrored(r.Context(), payload.ExecutorName, payload.JobID, payload.ErrorMessage)
 		if err == ErrUnknownJob {
 			return http.StatusNotFound, nil, nil
@@ -120,7 +155,7 @@ func (h *handler) handleMarkErrored(w http.ResponseWriter, r *http.Request) {
 func (h *handler) handleMarkFailed(w http.ResponseWriter, r *http.Request) {
 	var payload apiclient.MarkErroredRequest
 
-	h.wrapHandler(w, r, &payload, func() (int, any, error) {
+	wrapHandler(w, r, &payload, func() (int, any, error) {
 		err := h.markFailed(r.Context(), payload.ExecutorName, payload.JobID, payload.ErrorMessage)
 		if err == ErrUnknownJob {
 			return http.StatusNotFound, nil, nil
@@ -134,7 +169,7 @@ func (h *handler) handleMarkFailed(w http.ResponseWriter, r *http.Request) {
 func (h *handler) handleHeartbeat(w http.ResponseWriter, r *http.Request) {
 	var payload apiclient.HeartbeatRequest
 
-	h.wrapHandler(w, r, &payload, func() (int, any, error) {
+	wrapHandler(w, r, &payload, func() (int, any, error) {
 		executor := types.Executor{
 			Hostname:        payload.ExecutorName,
 			QueueName:       h.QueueOptions.Name,
@@ -172,7 +207,7 @@ func (h *handler) handleHeartbeat(w http.ResponseWriter, r *http.Request) {
 func (h *handler) handleCanceledJobs(w http.ResponseWriter, r *http.Request) {
 	var payload apiclient.CanceledJobsRequest
 
-	h.wrapHandler(w, r, &payload, func() (int, any, error) {
+	wrapHandler(w, r, &payload, func() (int, any, error) {
 		canceledIDs, err := h.canceled(r.Context(), payload.ExecutorName, payload.KnownJobIDs)
 		return http.StatusOK, canceledIDs, err
 	})
@@ -188,7 +223,7 @@ type errorResponse struct {
 // is returned. Otherwise, the response status will match the status code value returned from the
 // handler, and the payload value returned from the handler is encoded and written to the
 // response body.
-func (h *handler) wrapHandler(w http.ResponseWriter, r *http.Request, payload any, handler func() (int, any, error)) {
+func wrapHandler(w http.ResponseWriter, r *http.Request, payload any, handler func() (int, any, error)) {
 	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
 		http.Error(w, fmt.Sprintf("Failed to unmarshal payload: %s", err.Error()), http.StatusBadRequest)
 		return
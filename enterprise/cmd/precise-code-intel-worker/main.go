@@ -135,8 +135,27 @@ func main() {
 		Handler:      httpserver.NewHandler(nil),
 	})
 
+	routines := []goroutine.BackgroundRoutine{worker, server}
+	if backend := config.LSIFUploadStoreConfig.Backend; backend == "local" || backend == "azure" {
+		// These backends have no bucket lifecycle rules, so expired uploads are
+		// deleted periodically instead.
+		routines = append(routines, newUploadStoreExpirer(uploadStore, config.LSIFUploadStoreConfig.TTL))
+	}
+
 	// Go!
-	goroutine.MonitorBackgroundRoutines(context.Background(), worker, server)
+	goroutine.MonitorBackgroundRoutines(context.Background(), routines...)
+}
+
+const uploadStoreExpireInterval = time.Hour
+
+func newUploadStoreExpirer(uploadStore uploadstore.Store, ttl time.Duration) goroutine.BackgroundRoutine {
+	return goroutine.NewPeriodicGoroutine(
+		context.Background(),
+		uploadStoreExpireInterval,
+		goroutine.NewHandlerWithErrorMessage("expiring code intelligence uploads", func(ctx context.Context) error {
+			return uploadStore.ExpireObjects(ctx, "", ttl)
+		}),
+	)
 }
 
 func mustInitializeDB() *sql.DB {
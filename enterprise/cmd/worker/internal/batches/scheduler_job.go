@@ -36,6 +36,7 @@ func (j *schedulerJob) Routines(_ context.Context, logger log.Logger) ([]gorouti
 
 	routines := []goroutine.BackgroundRoutine{
 		scheduler.NewScheduler(workCtx, bstore),
+		scheduler.NewExecutionScheduler(workCtx, bstore),
 	}
 
 	return routines, nil
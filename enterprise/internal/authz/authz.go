@@ -11,6 +11,7 @@ import (
 
 	"github.com/sourcegraph/log"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/authz/azuredevops"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/authz/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/authz/github"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/authz/gitlab"
@@ -64,6 +65,7 @@ func ProvidersFromConfig(
 			extsvc.KindGitLab,
 			extsvc.KindBitbucketServer,
 			extsvc.KindPerforce,
+			extsvc.KindAzureDevOps,
 		},
 		LimitOffset: &database.LimitOffset{
 			Limit: 500, // The number is randomly chosen
@@ -75,6 +77,7 @@ func ProvidersFromConfig(
 		gitLabConns          []*types.GitLabConnection
 		bitbucketServerConns []*types.BitbucketServerConnection
 		perforceConns        []*types.PerforceConnection
+		azureDevOpsConns     []*types.AzureDevOpsConnection
 	)
 	for {
 		svcs, err := store.List(ctx, opt)
@@ -124,6 +127,11 @@ func ProvidersFromConfig(
 					URN:                svc.URN(),
 					PerforceConnection: c,
 				})
+			case *schema.AzureDevOpsConnection:
+				azureDevOpsConns = append(azureDevOpsConns, &types.AzureDevOpsConnection{
+					URN:                   svc.URN(),
+					AzureDevOpsConnection: c,
+				})
 			default:
 				log15.Error("ProvidersFromConfig", "error", errors.Errorf("unexpected connection type: %T", cfg))
 				continue
@@ -173,6 +181,14 @@ func ProvidersFromConfig(
 		invalidConnections = append(invalidConnections, pfInvalidConnections...)
 	}
 
+	if len(azureDevOpsConns) > 0 {
+		adoProviders, adoProblems, adoWarnings, adoInvalidConnections := azuredevops.NewAuthzProviders(azureDevOpsConns)
+		providers = append(providers, adoProviders...)
+		seriousProblems = append(seriousProblems, adoProblems...)
+		warnings = append(warnings, adoWarnings...)
+		invalidConnections = append(invalidConnections, adoInvalidConnections...)
+	}
+
 	// 🚨 SECURITY: Warn the admin when both code host authz provider and the permissions user mapping are configured.
 	if cfg.SiteConfig().PermissionsUserMapping != nil &&
 		cfg.SiteConfig().PermissionsUserMapping.Enabled {
@@ -274,6 +290,15 @@ func ProviderFromExternalService(
 			},
 			db,
 		)
+	case *schema.AzureDevOpsConnection:
+		providers, problems, _, _ = azuredevops.NewAuthzProviders(
+			[]*types.AzureDevOpsConnection{
+				{
+					URN:                   svc.URN(),
+					AzureDevOpsConnection: c,
+				},
+			},
+		)
 	default:
 		return nil, errors.Errorf("unsupported connection type %T", cfg)
 	}
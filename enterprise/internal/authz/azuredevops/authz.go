@@ -0,0 +1,41 @@
+package azuredevops
+
+import (
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/licensing"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// NewAuthzProviders returns the set of Azure DevOps authz providers derived
+// from the connections. Only connections with "enforcePermissions" enabled
+// yield a provider.
+//
+// This constructor does not and should not directly check connectivity to external services - if
+// desired, callers should use `(*Provider).ValidateConnection` directly to get warnings related
+// to connection issues.
+func NewAuthzProviders(
+	conns []*types.AzureDevOpsConnection,
+) (ps []authz.Provider, problems []string, warnings []string, invalidConnections []string) {
+	for _, c := range conns {
+		if !c.EnforcePermissions {
+			continue
+		}
+
+		if err := licensing.Check(licensing.FeatureACLs); err != nil {
+			invalidConnections = append(invalidConnections, extsvc.TypeAzureDevOps)
+			problems = append(problems, err.Error())
+			continue
+		}
+
+		p, err := NewProvider(c)
+		if err != nil {
+			invalidConnections = append(invalidConnections, extsvc.TypeAzureDevOps)
+			problems = append(problems, err.Error())
+			continue
+		}
+		ps = append(ps, p)
+	}
+
+	return ps, problems, warnings, invalidConnections
+}
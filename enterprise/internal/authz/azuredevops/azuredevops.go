@@ -0,0 +1,198 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Provider implements authz.Provider for Azure DevOps.
+//
+// Azure DevOps doesn't offer an API to list the repositories a user can
+// access, so permissions are derived from project membership: a user can
+// access all repositories of the projects they have an entitlement for. This
+// means that repository-level security settings within a project are not
+// reflected.
+type Provider struct {
+	urn      string
+	client   client
+	codeHost *extsvc.CodeHost
+	// orgs is the sorted list of organizations the code host connection syncs
+	// repositories from.
+	orgs []string
+}
+
+func NewProvider(conn *types.AzureDevOpsConnection) (*Provider, error) {
+	rawURL := conn.Url
+	if rawURL == "" {
+		rawURL = azuredevops.AzureDevOpsAPIURL
+	}
+	baseURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	cli, err := azuredevops.NewClient(conn.URN, conn.AzureDevOpsConnection, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Provider{
+		urn:      conn.URN,
+		client:   cli,
+		codeHost: extsvc.NewCodeHost(baseURL, extsvc.TypeAzureDevOps),
+		orgs:     connectionOrgs(conn),
+	}, nil
+}
+
+// connectionOrgs returns the set of organizations referenced by the "orgs"
+// and "projects" settings of the connection.
+func connectionOrgs(conn *types.AzureDevOpsConnection) []string {
+	set := make(map[string]struct{})
+	for _, org := range conn.Orgs {
+		set[org] = struct{}{}
+	}
+	for _, project := range conn.Projects {
+		org, _, _ := strings.Cut(project, "/")
+		set[org] = struct{}{}
+	}
+
+	orgs := make([]string, 0, len(set))
+	for org := range set {
+		orgs = append(orgs, org)
+	}
+	sort.Strings(orgs)
+	return orgs
+}
+
+// FetchAccount looks up the identity of the user in every organization of the
+// connection, using the verified email addresses of the user.
+func (p *Provider) FetchAccount(ctx context.Context, user *types.User, current []*extsvc.Account, verifiedEmails []string) (*extsvc.Account, error) {
+	for _, email := range verifiedEmails {
+		identities := make(map[string]string)
+		for _, org := range p.orgs {
+			ids, err := p.client.ListIdentitiesByEmail(ctx, org, email)
+			if err != nil {
+				return nil, err
+			}
+			for _, id := range ids {
+				if id.IsActive && strings.EqualFold(id.Email(), email) {
+					identities[org] = id.ID
+					break
+				}
+			}
+		}
+
+		if len(identities) > 0 {
+			return p.buildExtsvcAccount(user, email, identities)
+		}
+	}
+
+	return nil, nil
+}
+
+func (p *Provider) buildExtsvcAccount(user *types.User, email string, identities map[string]string) (*extsvc.Account, error) {
+	acctData, err := jsoniter.Marshal(azuredevops.AccountData{
+		Email:      email,
+		Identities: identities,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "marshaling account data")
+	}
+	return &extsvc.Account{
+		UserID: user.ID,
+		AccountSpec: extsvc.AccountSpec{
+			ServiceType: p.codeHost.ServiceType,
+			ServiceID:   p.codeHost.ServiceID,
+			AccountID:   email,
+		},
+		AccountData: extsvc.AccountData{
+			Data: extsvc.NewUnencryptedData(acctData),
+		},
+		CreatedAt: user.CreatedAt,
+		UpdatedAt: user.UpdatedAt,
+	}, nil
+}
+
+// FetchUserPerms returns the IDs of all repositories in the projects the user
+// is a member of.
+func (p *Provider) FetchUserPerms(ctx context.Context, account *extsvc.Account, opts authz.FetchPermsOptions) (*authz.ExternalUserPermissions, error) {
+	if account == nil {
+		return nil, errors.New("no account provided")
+	} else if !extsvc.IsHostOfAccount(p.codeHost, account) {
+		return nil, errors.Errorf("not a code host of the account: want %q but have %q",
+			account.AccountSpec.ServiceID, p.codeHost.ServiceID)
+	}
+
+	data, err := azuredevops.GetExternalAccountData(ctx, &account.AccountData)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting external account data")
+	} else if data == nil {
+		return nil, errors.New("no account data provided")
+	}
+
+	orgs := make([]string, 0, len(data.Identities))
+	for org := range data.Identities {
+		orgs = append(orgs, org)
+	}
+	sort.Strings(orgs)
+
+	perms := &authz.ExternalUserPermissions{}
+	for _, org := range orgs {
+		entitlement, err := p.client.GetUserEntitlement(ctx, org, data.Identities[org])
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting user entitlement in organization %q", org)
+		}
+
+		for _, pe := range entitlement.ProjectEntitlements {
+			repos, err := p.client.ListRepositoriesByProjectOrOrg(ctx, azuredevops.ListRepositoriesByProjectOrOrgArgs{
+				ProjectOrOrgName: org + "/" + pe.ProjectRef.Name,
+			})
+			if err != nil {
+				return nil, errors.Wrapf(err, "listing repositories of project %q", org+"/"+pe.ProjectRef.Name)
+			}
+			for _, r := range repos {
+				perms.Exacts = append(perms.Exacts, extsvc.RepoID(r.ID))
+			}
+		}
+	}
+
+	return perms, nil
+}
+
+func (p *Provider) FetchRepoPerms(ctx context.Context, repo *extsvc.Repository, opts authz.FetchPermsOptions) ([]extsvc.AccountID, error) {
+	return nil, &authz.ErrUnimplemented{Feature: "azuredevops.FetchRepoPerms"}
+}
+
+func (p *Provider) ServiceType() string {
+	return p.codeHost.ServiceType
+}
+
+func (p *Provider) ServiceID() string {
+	return p.codeHost.ServiceID
+}
+
+func (p *Provider) URN() string {
+	return p.urn
+}
+
+// ValidateConnection validates that the configured token can be used to
+// access Azure DevOps.
+func (p *Provider) ValidateConnection(ctx context.Context) (warnings []string) {
+	if err := p.client.Ping(ctx); err != nil {
+		return []string{
+			fmt.Sprintf("Unable to connect to Azure DevOps: %v", err),
+		}
+	}
+	return []string{}
+}
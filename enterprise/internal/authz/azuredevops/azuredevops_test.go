@@ -0,0 +1,159 @@
+package azuredevops
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestProvider_FetchAccount(t *testing.T) {
+	userEmail := "test-email@example.com"
+
+	p := newTestProvider(&mockClient{
+		mockListIdentitiesByEmail: func(ctx context.Context, org, email string) ([]azuredevops.IdentityDetails, error) {
+			if org != "org1" || email != userEmail {
+				return nil, nil
+			}
+			return []azuredevops.IdentityDetails{
+				{
+					ID:       "inactive",
+					IsActive: false,
+					Properties: map[string]azuredevops.IdentityProperty{
+						"Mail": {Value: userEmail},
+					},
+				},
+				{
+					ID:       "identity-1",
+					IsActive: true,
+					Properties: map[string]azuredevops.IdentityProperty{
+						"Mail": {Value: userEmail},
+					},
+				},
+			}, nil
+		},
+	})
+
+	acct, err := p.FetchAccount(context.Background(), &types.User{ID: 42}, nil, []string{"unknown@example.com", userEmail})
+	if err != nil {
+		t.Fatalf("error fetching account: %s", err)
+	}
+	if acct == nil {
+		t.Fatal("account was nil")
+	}
+	if have, want := acct.AccountID, userEmail; have != want {
+		t.Errorf("wrong account ID: have %q, want %q", have, want)
+	}
+
+	data, err := azuredevops.GetExternalAccountData(context.Background(), &acct.AccountData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(map[string]string{"org1": "identity-1"}, data.Identities); diff != "" {
+		t.Errorf("wrong identities (-want +got):\n%s", diff)
+	}
+
+	t.Run("no matching identity", func(t *testing.T) {
+		acct, err := p.FetchAccount(context.Background(), &types.User{ID: 42}, nil, []string{"unknown@example.com"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if acct != nil {
+			t.Fatalf("expected no account, got %+v", acct)
+		}
+	})
+}
+
+func TestProvider_FetchUserPerms(t *testing.T) {
+	p := newTestProvider(&mockClient{
+		mockGetUserEntitlement: func(ctx context.Context, org, userID string) (azuredevops.UserEntitlement, error) {
+			if org != "org1" || userID != "identity-1" {
+				return azuredevops.UserEntitlement{}, errors.Newf("unexpected org %q or user %q", org, userID)
+			}
+			return azuredevops.UserEntitlement{
+				ProjectEntitlements: []azuredevops.ProjectEntitlement{
+					{ProjectRef: azuredevops.ProjectRef{Name: "project1"}},
+					{ProjectRef: azuredevops.ProjectRef{Name: "project2"}},
+				},
+			}, nil
+		},
+		mockListRepositoriesByProjectOrOrg: func(ctx context.Context, args azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error) {
+			switch args.ProjectOrOrgName {
+			case "org1/project1":
+				return []azuredevops.Repository{{ID: "repo-1"}, {ID: "repo-2"}}, nil
+			case "org1/project2":
+				return []azuredevops.Repository{{ID: "repo-3"}}, nil
+			}
+			return nil, errors.Newf("unexpected project %q", args.ProjectOrOrgName)
+		},
+	})
+
+	acct, err := p.buildExtsvcAccount(&types.User{ID: 42}, "test-email@example.com", map[string]string{"org1": "identity-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	perms, err := p.FetchUserPerms(context.Background(), acct, authz.FetchPermsOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []extsvc.RepoID{"repo-1", "repo-2", "repo-3"}
+	if diff := cmp.Diff(want, perms.Exacts); diff != "" {
+		t.Fatalf("wrong permissions (-want +got):\n%s", diff)
+	}
+
+	t.Run("account of other code host", func(t *testing.T) {
+		other := *acct
+		other.ServiceID = "https://other.example.com/"
+		if _, err := p.FetchUserPerms(context.Background(), &other, authz.FetchPermsOptions{}); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
+
+func TestProvider_ValidateConnection(t *testing.T) {
+	p := newTestProvider(&mockClient{
+		mockPing: func(ctx context.Context) error {
+			return errors.New("fake error")
+		},
+	})
+	if diff := cmp.Diff([]string{"Unable to connect to Azure DevOps: fake error"}, p.ValidateConnection(context.Background())); diff != "" {
+		t.Fatalf("warnings did not match: %s", diff)
+	}
+
+	p = newTestProvider(&mockClient{})
+	if diff := cmp.Diff([]string{}, p.ValidateConnection(context.Background())); diff != "" {
+		t.Fatalf("warnings did not match: %s", diff)
+	}
+}
+
+func TestConnectionOrgs(t *testing.T) {
+	conn := &types.AzureDevOpsConnection{
+		AzureDevOpsConnection: &schema.AzureDevOpsConnection{
+			Orgs:     []string{"org2", "org1"},
+			Projects: []string{"org1/project", "org3/project"},
+		},
+	}
+	if diff := cmp.Diff([]string{"org1", "org2", "org3"}, connectionOrgs(conn)); diff != "" {
+		t.Fatalf("wrong orgs (-want +got):\n%s", diff)
+	}
+}
+
+func newTestProvider(client client) *Provider {
+	baseURL, _ := url.Parse("https://dev.azure.com")
+	return &Provider{
+		urn:      "AzureDevOps",
+		client:   client,
+		codeHost: extsvc.NewCodeHost(baseURL, extsvc.TypeAzureDevOps),
+		orgs:     []string{"org1", "org2"},
+	}
+}
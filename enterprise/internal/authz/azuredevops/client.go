@@ -0,0 +1,51 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
+)
+
+type client interface {
+	Ping(ctx context.Context) error
+	ListIdentitiesByEmail(ctx context.Context, org, email string) ([]azuredevops.IdentityDetails, error)
+	GetUserEntitlement(ctx context.Context, org, userID string) (azuredevops.UserEntitlement, error)
+	ListRepositoriesByProjectOrOrg(ctx context.Context, args azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error)
+}
+
+var _ client = (azuredevops.Client)(nil)
+
+type mockClient struct {
+	mockPing                           func(ctx context.Context) error
+	mockListIdentitiesByEmail          func(ctx context.Context, org, email string) ([]azuredevops.IdentityDetails, error)
+	mockGetUserEntitlement             func(ctx context.Context, org, userID string) (azuredevops.UserEntitlement, error)
+	mockListRepositoriesByProjectOrOrg func(ctx context.Context, args azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error)
+}
+
+func (m *mockClient) Ping(ctx context.Context) error {
+	if m.mockPing != nil {
+		return m.mockPing(ctx)
+	}
+	return nil
+}
+
+func (m *mockClient) ListIdentitiesByEmail(ctx context.Context, org, email string) ([]azuredevops.IdentityDetails, error) {
+	if m.mockListIdentitiesByEmail != nil {
+		return m.mockListIdentitiesByEmail(ctx, org, email)
+	}
+	return nil, nil
+}
+
+func (m *mockClient) GetUserEntitlement(ctx context.Context, org, userID string) (azuredevops.UserEntitlement, error) {
+	if m.mockGetUserEntitlement != nil {
+		return m.mockGetUserEntitlement(ctx, org, userID)
+	}
+	return azuredevops.UserEntitlement{}, nil
+}
+
+func (m *mockClient) ListRepositoriesByProjectOrOrg(ctx context.Context, args azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error) {
+	if m.mockListRepositoriesByProjectOrOrg != nil {
+		return m.mockListRepositoriesByProjectOrOrg(ctx, args)
+	}
+	return nil, nil
+}
@@ -16,7 +16,7 @@ var _ client = (*ClientAdapter)(nil)
 
 // ClientAdapter is an adapter for Gerrit API client.
 type ClientAdapter struct {
-	*gerrit.Client
+	gerrit.Client
 }
 
 type mockClient struct {
@@ -15,6 +15,7 @@ import (
 	"github.com/sourcegraph/log"
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources"
+	gerritbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gerrit"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/state"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
@@ -22,7 +23,9 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/api/internalapi"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/repos"
 	"github.com/sourcegraph/sourcegraph/internal/types"
@@ -183,6 +186,21 @@ func (e *executor) pushChangesetPatch(ctx context.Context) (err error) {
 		return errCannotPushToArchivedRepo
 	}
 
+	// Changesets on code hosts that identify them by their base branch have to
+	// be moved to a new base branch before we push to it.
+	if mcss, ok := css.(sources.MovableChangesetSource); ok && e.ch.Published() {
+		cs := &sources.Changeset{
+			BaseRef:    e.spec.BaseRef,
+			HeadRef:    e.spec.HeadRef,
+			RemoteRepo: remoteRepo,
+			TargetRepo: e.targetRepo,
+			Changeset:  e.ch,
+		}
+		if err := mcss.MoveChangeset(ctx, cs); err != nil {
+			return errors.Wrap(err, "moving changeset")
+		}
+	}
+
 	pushConf, err := css.GitserverPushConfig(remoteRepo)
 	if err != nil {
 		return err
@@ -637,6 +655,24 @@ func buildCommitOpts(repo *types.Repo, spec *btypes.ChangesetSpec, pushOpts *pro
 		Push:         pushOpts,
 	}
 
+	if repo.ExternalRepo.ServiceType == extsvc.TypeGerrit {
+		// Gerrit creates and updates changes when commits are pushed to the
+		// magic refs/for/<branch> ref, and uses the Change-Id footer of the
+		// commit message to tell which change a commit belongs to. Since
+		// changes have no source branch, we set the branch name as the topic
+		// of the change so we can still find the changeset by its branch.
+		//
+		// Gerrit has no title and description separate from the commit
+		// message, so we build it from the title and body of the changeset
+		// rather than the commit message of the spec. Otherwise, the source
+		// would have to set the commit message afterwards, which creates
+		// another patch set.
+		changeID := gerritbatches.GenerateChangeID(repo.Name, spec.HeadRef)
+		opts.CommitInfo.Message = gerritbatches.BuildCommitMessage(spec.Title, spec.Body, changeID)
+		pushRef := "refs/for/" + gitdomain.AbbreviateRef(spec.BaseRef) + "%topic=" + gitdomain.AbbreviateRef(spec.HeadRef)
+		opts.PushRef = &pushRef
+	}
+
 	return opts, nil
 }
 
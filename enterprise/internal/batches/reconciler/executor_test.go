@@ -15,16 +15,19 @@ import (
 	"github.com/sourcegraph/log/logtest"
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources"
+	gerritbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gerrit"
 	stesting "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/testing"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
 	bt "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/testing"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/api/internalapi"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
 	et "github.com/sourcegraph/sourcegraph/internal/encryption/testing"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	gitprotocol "github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
@@ -82,6 +85,7 @@ func TestExecutor_ExecutePlan(t *testing.T) {
 	type testCase struct {
 		changeset      bt.TestChangesetOpts
 		hasCurrentSpec bool
+		specBaseRef    string
 		plan           *Plan
 
 		sourcerMetadata any
@@ -90,6 +94,9 @@ func TestExecutor_ExecutePlan(t *testing.T) {
 		alreadyExists bool
 		// Whether or not the source responds to IsArchivedPushError with true
 		isRepoArchived bool
+		// Whether or not the source has to move changesets to a new base ref
+		// before pushing to it
+		isMovableSource bool
 
 		gitClientErr error
 
@@ -100,6 +107,7 @@ func TestExecutor_ExecutePlan(t *testing.T) {
 		wantCloseOnCodeHost       bool
 		wantLoadFromCodeHost      bool
 		wantReopenOnCodeHost      bool
+		wantMoveOnCodeHost        bool
 
 		wantGitserverCommit bool
 
@@ -282,6 +290,39 @@ func TestExecutor_ExecutePlan(t *testing.T) {
 				Body:  githubPR.Body,
 			},
 		},
+		"push and update with new base ref": {
+			hasCurrentSpec: true,
+			changeset: bt.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalID:       "12345",
+				ExternalBranch:   "head-ref-on-github",
+			},
+			specBaseRef:     "refs/heads/release",
+			isMovableSource: true,
+
+			plan: &Plan{
+				Ops: Operations{
+					btypes.ReconcilerOperationPush,
+					btypes.ReconcilerOperationUpdate,
+				},
+			},
+
+			// The changeset has to be moved to the new base ref before the
+			// commit for it is pushed.
+			wantMoveOnCodeHost:   true,
+			wantGitserverCommit:  true,
+			wantUpdateOnCodeHost: true,
+
+			wantChangeset: bt.ChangesetAssertions{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+				ExternalID:       githubPR.ID,
+				ExternalBranch:   githubHeadRef,
+				ExternalState:    btypes.ChangesetExternalStateOpen,
+				DiffStat:         state.DiffStat,
+				Title:            githubPR.Title,
+				Body:             githubPR.Body,
+			},
+		},
 		"update to archived repo": {
 			hasCurrentSpec: true,
 			changeset: bt.TestChangesetOpts{
@@ -575,6 +616,7 @@ func TestExecutor_ExecutePlan(t *testing.T) {
 					Repo:      repo.ID,
 					BatchSpec: batchSpec.ID,
 					Typ:       btypes.ChangesetSpecTypeBranch,
+					BaseRef:   tc.specBaseRef,
 				}
 				changesetSpec = bt.CreateChangesetSpec(t, ctx, bstore, specOpts)
 			}
@@ -596,9 +638,13 @@ func TestExecutor_ExecutePlan(t *testing.T) {
 			changeset := bt.CreateChangeset(t, ctx, bstore, changesetOpts)
 
 			var response string
-			var createCommitFromPatchCalled bool
+			var createCommitFromPatchCalled, movedBeforeCommit bool
+			var movableSource *movableChangesetSource
 			state.MockClient.CreateCommitFromPatchFunc.SetDefaultHook(func(_ context.Context, req gitprotocol.CreateCommitFromPatchRequest) (string, error) {
 				createCommitFromPatchCalled = true
+				if movableSource != nil {
+					movedBeforeCommit = len(movableSource.MovedChangesets) > 0
+				}
 				if changesetSpec != nil {
 					response = changesetSpec.HeadRef
 				}
@@ -625,7 +671,12 @@ func TestExecutor_ExecutePlan(t *testing.T) {
 				fakeSource.WantBaseRef = changesetSpec.BaseRef
 			}
 
-			sourcer := stesting.NewFakeSourcer(nil, fakeSource)
+			var source sources.ChangesetSource = fakeSource
+			if tc.isMovableSource {
+				movableSource = &movableChangesetSource{FakeChangesetSource: fakeSource}
+				source = movableSource
+			}
+			sourcer := stesting.NewFakeSourcer(nil, source)
 
 			tc.plan.Changeset = changeset
 			tc.plan.ChangesetSpec = changesetSpec
@@ -689,6 +740,20 @@ func TestExecutor_ExecutePlan(t *testing.T) {
 				t.Fatalf("wrong CloseChangeset call. wantCalled=%t, wasCalled=%t", want, have)
 			}
 
+			if movableSource != nil {
+				if have, want := len(movableSource.MovedChangesets) > 0, tc.wantMoveOnCodeHost; have != want {
+					t.Fatalf("wrong MoveChangeset call. wantCalled=%t, wasCalled=%t", want, have)
+				}
+				if tc.wantMoveOnCodeHost {
+					if have, want := movableSource.MovedChangesets[0].BaseRef, changesetSpec.BaseRef; have != want {
+						t.Fatalf("changeset moved to wrong base ref. want=%q, have=%q", want, have)
+					}
+					if tc.wantGitserverCommit && !movedBeforeCommit {
+						t.Fatal("changeset was not moved before pushing the commit")
+					}
+				}
+			}
+
 			if tc.wantNonRetryableErr {
 				return
 			}
@@ -1165,6 +1230,29 @@ func TestHandleArchivedRepo(t *testing.T) {
 	})
 }
 
+func TestBuildCommitOpts_Gerrit(t *testing.T) {
+	repo := &types.Repo{
+		Name:         "gerrit.example.com/repo",
+		ExternalRepo: api.ExternalRepoSpec{ServiceType: extsvc.TypeGerrit},
+	}
+	spec := &btypes.ChangesetSpec{
+		Title:         "title",
+		Body:          "body",
+		CommitMessage: "commit message",
+		BaseRef:       "refs/heads/main",
+		HeadRef:       "refs/heads/branch",
+	}
+
+	opts, err := buildCommitOpts(repo, spec, nil)
+	require.NoError(t, err)
+
+	// The commit message is built from the title and body, so that it doesn't
+	// have to be set on the change after pushing it.
+	changeID := gerritbatches.GenerateChangeID(repo.Name, spec.HeadRef)
+	assert.Equal(t, "title\n\nbody\n\nChange-Id: "+changeID+"\n", opts.CommitInfo.Message)
+	assert.Equal(t, "refs/for/main%topic=branch", *opts.PushRef)
+}
+
 func TestBatchChangeURL(t *testing.T) {
 	ctx := context.Background()
 
@@ -1244,3 +1332,19 @@ type mockRepoArchivedError struct{}
 func (mockRepoArchivedError) Archived() bool     { return true }
 func (mockRepoArchivedError) Error() string      { return "mock repo archived" }
 func (mockRepoArchivedError) NonRetryable() bool { return true }
+
+// movableChangesetSource is a FakeChangesetSource that has to move changesets
+// to a new base ref before pushing to it, like the Gerrit source.
+type movableChangesetSource struct {
+	*stesting.FakeChangesetSource
+
+	// MovedChangesets contains the changesets that were passed to MoveChangeset
+	MovedChangesets []*sources.Changeset
+}
+
+var _ sources.MovableChangesetSource = &movableChangesetSource{}
+
+func (s *movableChangesetSource) MoveChangeset(_ context.Context, cs *sources.Changeset) error {
+	s.MovedChangesets = append(s.MovedChangesets, cs)
+	return s.Err
+}
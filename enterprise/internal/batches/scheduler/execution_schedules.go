@@ -0,0 +1,47 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/service"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const executionScheduleInterval = 1 * time.Minute
+
+// NewExecutionScheduler returns a background routine that periodically
+// advances the execution schedules of batch changes: it starts scheduled
+// executions that are due, executes their batch specs once the workspaces are
+// resolved and applies them once the execution completed, if configured to.
+func NewExecutionScheduler(ctx context.Context, bstore *store.Store) goroutine.BackgroundRoutine {
+	svc := service.New(bstore)
+
+	return goroutine.NewPeriodicGoroutine(
+		ctx,
+		executionScheduleInterval,
+		goroutine.NewHandlerWithErrorMessage("run batch change execution schedules", func(ctx context.Context) error {
+			schedules, err := bstore.ListBatchChangeExecutionSchedules(ctx, store.ListBatchChangeExecutionSchedulesOpts{
+				OnlyActionable: true,
+			})
+			if err != nil {
+				return errors.Wrap(err, "listing execution schedules")
+			}
+
+			var errs error
+			for _, schedule := range schedules {
+				// Scheduled executions are performed on behalf of the user
+				// who set up the schedule, so that they are subject to the
+				// same permissions.
+				userCtx := actor.WithActor(ctx, actor.FromUser(schedule.UserID))
+				if err := svc.RunExecutionSchedule(userCtx, schedule); err != nil {
+					errs = errors.Append(errs, errors.Wrapf(err, "batch change %d", schedule.BatchChangeID))
+				}
+			}
+			return errs
+		}),
+	)
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/metrics"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/rbac"
 	"github.com/sourcegraph/sourcegraph/internal/repoupdater"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
@@ -90,6 +91,9 @@ type operations struct {
 	applyBatchChange                     *observation.Operation
 	reconcileBatchChange                 *observation.Operation
 	validateChangesetSpecs               *observation.Operation
+	setExecutionSchedule                 *observation.Operation
+	removeExecutionSchedule              *observation.Operation
+	runExecutionSchedule                 *observation.Operation
 }
 
 var (
@@ -140,6 +144,9 @@ func newOperations(observationContext *observation.Context) *operations {
 			applyBatchChange:                     op("ApplyBatchChange"),
 			reconcileBatchChange:                 op("ReconcileBatchChange"),
 			validateChangesetSpecs:               op("ValidateChangesetSpecs"),
+			setExecutionSchedule:                 op("SetExecutionSchedule"),
+			removeExecutionSchedule:              op("RemoveExecutionSchedule"),
+			runExecutionSchedule:                 op("RunExecutionSchedule"),
 		}
 	})
 
@@ -896,7 +903,7 @@ func (s *Service) MoveBatchChange(ctx context.Context, opts MoveBatchChangeOpts)
 	}
 
 	// 🚨 SECURITY: Only the Author of the batch change can move it.
-	if err := auth.CheckSiteAdminOrSameUser(ctx, s.store.DatabaseDB(), batchChange.CreatorID); err != nil {
+	if err := auth.CheckPermissionOrSameUser(ctx, s.store.DatabaseDB(), batchChange.CreatorID, rbac.BatchChangesAdmin); err != nil {
 		return nil, err
 	}
 	// Check if current user has access to target namespace if set.
@@ -936,7 +943,7 @@ func (s *Service) CloseBatchChange(ctx context.Context, id int64, closeChangeset
 		return batchChange, nil
 	}
 
-	if err := auth.CheckSiteAdminOrSameUser(ctx, s.store.DatabaseDB(), batchChange.CreatorID); err != nil {
+	if err := auth.CheckPermissionOrSameUser(ctx, s.store.DatabaseDB(), batchChange.CreatorID, rbac.BatchChangesAdmin); err != nil {
 		return nil, err
 	}
 
@@ -978,7 +985,7 @@ func (s *Service) DeleteBatchChange(ctx context.Context, id int64) (err error) {
 		return err
 	}
 
-	if err := auth.CheckSiteAdminOrSameUser(ctx, s.store.DatabaseDB(), batchChange.CreatorID); err != nil {
+	if err := auth.CheckPermissionOrSameUser(ctx, s.store.DatabaseDB(), batchChange.CreatorID, rbac.BatchChangesAdmin); err != nil {
 		return err
 	}
 
@@ -1016,7 +1023,7 @@ func (s *Service) EnqueueChangesetSync(ctx context.Context, id int64) (err error
 	)
 
 	for _, c := range batchChanges {
-		err := auth.CheckSiteAdminOrSameUser(ctx, s.store.DatabaseDB(), c.CreatorID)
+		err := auth.CheckPermissionOrSameUser(ctx, s.store.DatabaseDB(), c.CreatorID, rbac.BatchChangesAdmin)
 		if err != nil {
 			authErr = err
 		} else {
@@ -1067,7 +1074,7 @@ func (s *Service) ReenqueueChangeset(ctx context.Context, id int64) (changeset *
 	)
 
 	for _, c := range attachedBatchChanges {
-		err := auth.CheckSiteAdminOrSameUser(ctx, s.store.DatabaseDB(), c.CreatorID)
+		err := auth.CheckPermissionOrSameUser(ctx, s.store.DatabaseDB(), c.CreatorID, rbac.BatchChangesAdmin)
 		if err != nil {
 			authErr = err
 		} else {
@@ -1091,7 +1098,8 @@ func (s *Service) ReenqueueChangeset(ctx context.Context, id int64) (changeset *
 // to either the user ID or the org ID as a namespace.
 // If the userID is non-zero that will be checked. Otherwise the org ID will be
 // checked.
-// If the current user is an admin, true will be returned.
+// If the current user is an admin or a batch changes admin, true will be
+// returned.
 // Otherwise it checks whether the current user _is_ the namespace user or has
 // access to the namespace org.
 // If both values are zero, an error is returned.
@@ -1117,9 +1125,15 @@ func (s *Service) CanAdministerInNamespace(ctx context.Context, namespaceUserID,
 
 func (s *Service) checkNamespaceAccessWithDB(ctx context.Context, db database.DB, namespaceUserID, namespaceOrgID int32) (err error) {
 	if namespaceOrgID != 0 {
-		return auth.CheckOrgAccessOrSiteAdmin(ctx, db, namespaceOrgID)
+		err := auth.CheckOrgAccessOrSiteAdmin(ctx, db, namespaceOrgID)
+		if err == auth.ErrNotAnOrgMember && auth.CheckCurrentUserHasPermission(ctx, db, rbac.BatchChangesAdmin) == nil {
+			// Batch changes admins can administer batch changes in all
+			// namespaces.
+			return nil
+		}
+		return err
 	} else if namespaceUserID != 0 {
-		return auth.CheckSiteAdminOrSameUser(ctx, db, namespaceUserID)
+		return auth.CheckPermissionOrSameUser(ctx, db, namespaceUserID, rbac.BatchChangesAdmin)
 	} else {
 		return ErrNoNamespace
 	}
@@ -1218,7 +1232,7 @@ func (s *Service) CreateChangesetJobs(ctx context.Context, batchChangeID int64,
 	}
 
 	// 🚨 SECURITY: Only the author of the batch change can create jobs.
-	if err := auth.CheckSiteAdminOrSameUser(ctx, s.store.DatabaseDB(), batchChange.CreatorID); err != nil {
+	if err := auth.CheckPermissionOrSameUser(ctx, s.store.DatabaseDB(), batchChange.CreatorID, rbac.BatchChangesAdmin); err != nil {
 		return bulkGroupID, err
 	}
 
@@ -11,6 +11,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/auth"
 	"github.com/sourcegraph/sourcegraph/internal/database/locker"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/rbac"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -63,8 +64,9 @@ func (s *Service) ApplyBatchChange(
 		return nil, err
 	}
 
-	// 🚨 SECURITY: Only site-admins or the creator of batchSpec can apply it.
-	if err := auth.CheckSiteAdminOrSameUser(ctx, s.store.DatabaseDB(), batchSpec.UserID); err != nil {
+	// 🚨 SECURITY: Only site-admins, batch changes admins or the creator of
+	// batchSpec can apply it.
+	if err := auth.CheckPermissionOrSameUser(ctx, s.store.DatabaseDB(), batchSpec.UserID, rbac.BatchChangesAdmin); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// MinExecutionScheduleInterval is the shortest interval at which a batch
+// change can be re-executed on a schedule.
+const MinExecutionScheduleInterval = time.Hour
+
+// ErrExecutionScheduleNotApplied is returned by SetExecutionSchedule when
+// the batch change has never had a batch spec applied, so there is nothing to
+// re-execute.
+var ErrExecutionScheduleNotApplied = errors.New("cannot schedule executions of a batch change that has not been applied")
+
+// ErrExecutionScheduleClosed is returned by SetExecutionSchedule when the
+// batch change is closed.
+var ErrExecutionScheduleClosed = errors.New("cannot schedule executions of a closed batch change")
+
+type SetExecutionScheduleOpts struct {
+	BatchChangeID int64
+	Interval      time.Duration
+	AutoApply     bool
+}
+
+func (o SetExecutionScheduleOpts) String() string {
+	return fmt.Sprintf(
+		"BatchChangeID %d, Interval %s, AutoApply %t",
+		o.BatchChangeID,
+		o.Interval,
+		o.AutoApply,
+	)
+}
+
+// SetExecutionSchedule configures the batch change to be re-executed every
+// opts.Interval, replacing its existing schedule if there is one. The first
+// scheduled execution starts once the interval has passed.
+//
+// Scheduled executions are performed on behalf of the current user.
+func (s *Service) SetExecutionSchedule(ctx context.Context, opts SetExecutionScheduleOpts) (schedule *btypes.BatchChangeExecutionSchedule, err error) {
+	ctx, _, endObservation := s.operations.setExecutionSchedule.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("opts", opts.String()),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if opts.Interval < MinExecutionScheduleInterval {
+		return nil, errors.Newf("execution schedule interval must be at least %s", MinExecutionScheduleInterval)
+	}
+
+	batchChange, err := s.store.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: opts.BatchChangeID})
+	if err != nil {
+		return nil, errors.Wrap(err, "getting batch change")
+	}
+
+	// 🚨 SECURITY: Scheduled executions create batch specs in the namespace
+	// of the batch change on behalf of the current user, so they need to be
+	// able to do that themselves.
+	if err := s.CheckNamespaceAccess(ctx, batchChange.NamespaceUserID, batchChange.NamespaceOrgID); err != nil {
+		return nil, err
+	}
+
+	if batchChange.Closed() {
+		return nil, ErrExecutionScheduleClosed
+	}
+	if batchChange.IsDraft() {
+		return nil, ErrExecutionScheduleNotApplied
+	}
+
+	schedule = &btypes.BatchChangeExecutionSchedule{
+		BatchChangeID: batchChange.ID,
+		// Actor is guaranteed to be set here, because CheckNamespaceAccess above enforces it.
+		UserID:    actor.FromContext(ctx).UID,
+		Interval:  opts.Interval,
+		AutoApply: opts.AutoApply,
+		NextRunAt: s.clock().Add(opts.Interval),
+	}
+	if err := s.store.UpsertBatchChangeExecutionSchedule(ctx, schedule); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// RemoveExecutionSchedule removes the execution schedule of the given batch
+// change. A scheduled execution that is already in progress is not canceled,
+// but its batch spec won't be applied automatically.
+func (s *Service) RemoveExecutionSchedule(ctx context.Context, batchChangeID int64) (err error) {
+	ctx, _, endObservation := s.operations.removeExecutionSchedule.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("batchChangeID", int(batchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	batchChange, err := s.store.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: batchChangeID})
+	if err != nil {
+		return errors.Wrap(err, "getting batch change")
+	}
+
+	if err := s.CheckNamespaceAccess(ctx, batchChange.NamespaceUserID, batchChange.NamespaceOrgID); err != nil {
+		return err
+	}
+
+	err = s.store.DeleteBatchChangeExecutionSchedule(ctx, batchChange.ID)
+	if err == store.ErrNoResults {
+		return nil
+	}
+	return err
+}
+
+// RunExecutionSchedule advances the given execution schedule by one step:
+//
+//   - If no scheduled execution is in progress and the schedule is due, a new
+//     batch spec is created from the raw spec currently applied to the batch
+//     change. Its workspaces are resolved again, so repositories that started
+//     matching the `on` queries since then are included.
+//   - Once the workspaces are resolved, the batch spec is executed. Workspaces
+//     for which all steps have cached results from previous executions are
+//     not run again.
+//   - Once the execution completes, the batch spec is applied if the schedule
+//     has AutoApply set. Otherwise, it is left for the user to preview and
+//     apply.
+//
+// The context must carry the actor of the schedule's user.
+func (s *Service) RunExecutionSchedule(ctx context.Context, schedule *btypes.BatchChangeExecutionSchedule) (err error) {
+	ctx, _, endObservation := s.operations.runExecutionSchedule.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("batchChangeID", int(schedule.BatchChangeID)),
+		log.Int("batchSpecID", int(schedule.BatchSpecID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	batchChange, err := s.store.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: schedule.BatchChangeID})
+	if err != nil {
+		return errors.Wrap(err, "getting batch change")
+	}
+
+	// Closed batch changes can't have batch specs applied anymore, so there's
+	// nothing left to schedule.
+	if batchChange.Closed() {
+		return s.store.DeleteBatchChangeExecutionSchedule(ctx, batchChange.ID)
+	}
+
+	if schedule.InProgress() {
+		return s.advanceScheduledExecution(ctx, schedule, batchChange)
+	}
+
+	now := s.clock()
+	if schedule.NextRunAt.After(now) {
+		return nil
+	}
+
+	// We move the schedule forward even if we fail to start the execution, so
+	// that a persistent error doesn't make us retry on every tick.
+	schedule.LastRunAt = now
+	schedule.NextRunAt = now.Add(schedule.Interval)
+
+	current, err := s.store.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: batchChange.BatchSpecID})
+	if err != nil {
+		return errors.Append(errors.Wrap(err, "getting current batch spec"), s.store.UpdateBatchChangeExecutionSchedule(ctx, schedule))
+	}
+
+	spec, err := s.CreateBatchSpecFromRaw(ctx, CreateBatchSpecFromRawOpts{
+		RawSpec:          current.RawSpec,
+		NamespaceUserID:  batchChange.NamespaceUserID,
+		NamespaceOrgID:   batchChange.NamespaceOrgID,
+		AllowIgnored:     current.AllowIgnored,
+		AllowUnsupported: current.AllowUnsupported,
+		BatchChange:      batchChange.ID,
+	})
+	if err != nil {
+		return errors.Append(errors.Wrap(err, "creating batch spec"), s.store.UpdateBatchChangeExecutionSchedule(ctx, schedule))
+	}
+
+	schedule.BatchSpecID = spec.ID
+	return s.store.UpdateBatchChangeExecutionSchedule(ctx, schedule)
+}
+
+func (s *Service) advanceScheduledExecution(ctx context.Context, schedule *btypes.BatchChangeExecutionSchedule, batchChange *btypes.BatchChange) error {
+	spec, err := s.store.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: schedule.BatchSpecID})
+	if err != nil {
+		if err == store.ErrNoResults {
+			// The batch spec has been deleted in the meantime.
+			return s.finishScheduledExecution(ctx, schedule)
+		}
+		return err
+	}
+
+	stats, err := s.LoadBatchSpecStats(ctx, spec)
+	if err != nil {
+		return err
+	}
+	if !stats.ResolutionDone {
+		return nil
+	}
+
+	if stats.Executions == 0 {
+		if _, err := s.ExecuteBatchSpec(ctx, ExecuteBatchSpecOpts{BatchSpecRandID: spec.RandID}); err != nil {
+			if errors.HasType(err, ErrBatchSpecResolutionErrored{}) {
+				return errors.Append(err, s.finishScheduledExecution(ctx, schedule))
+			}
+			return err
+		}
+
+		if stats, err = s.LoadBatchSpecStats(ctx, spec); err != nil {
+			return err
+		}
+	}
+
+	state := btypes.ComputeBatchSpecState(spec, stats)
+	if stats.Executions == 0 {
+		// All workspaces had cached results or were skipped, so there was
+		// nothing to execute.
+		state = btypes.BatchSpecStateCompleted
+	}
+
+	switch state {
+	case btypes.BatchSpecStateCompleted:
+		if schedule.AutoApply {
+			_, err := s.ApplyBatchChange(ctx, ApplyBatchChangeOpts{
+				BatchSpecRandID:     spec.RandID,
+				EnsureBatchChangeID: batchChange.ID,
+			})
+			if err != nil {
+				return errors.Append(errors.Wrap(err, "applying batch spec"), s.finishScheduledExecution(ctx, schedule))
+			}
+		}
+		return s.finishScheduledExecution(ctx, schedule)
+
+	case btypes.BatchSpecStateFailed, btypes.BatchSpecStateCanceled:
+		return s.finishScheduledExecution(ctx, schedule)
+
+	default:
+		return nil
+	}
+}
+
+func (s *Service) finishScheduledExecution(ctx context.Context, schedule *btypes.BatchChangeExecutionSchedule) error {
+	schedule.BatchSpecID = 0
+	return s.store.UpdateBatchChangeExecutionSchedule(ctx, schedule)
+}
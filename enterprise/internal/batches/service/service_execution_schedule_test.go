@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	bt "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/timeutil"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+)
+
+func TestServiceExecutionSchedule(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	ctx := actor.WithInternalActor(context.Background())
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+
+	user := bt.CreateTestUser(t, db, false)
+	userCtx := actor.WithActor(context.Background(), actor.FromUser(user.ID))
+	otherUser := bt.CreateTestUser(t, db, false)
+	otherUserCtx := actor.WithActor(context.Background(), actor.FromUser(otherUser.ID))
+
+	now := timeutil.Now()
+	clock := func() time.Time { return now }
+	s := store.NewWithClock(db, &observation.TestContext, nil, clock)
+	svc := New(s)
+
+	createBatchChange := func(t *testing.T, applied bool) *btypes.BatchChange {
+		t.Helper()
+
+		bt.TruncateTables(t, db, "batch_change_execution_schedules", "batch_changes", "batch_specs")
+
+		spec := &btypes.BatchSpec{
+			RawSpec:         bt.TestRawBatchSpecYAML,
+			Spec:            &batcheslib.BatchSpec{Name: "my-unique-name"},
+			UserID:          user.ID,
+			NamespaceUserID: user.ID,
+		}
+		require.NoError(t, s.CreateBatchSpec(ctx, spec))
+
+		batchChange := testBatchChange(user.ID, spec)
+		batchChange.Name = "my-unique-name"
+		if !applied {
+			batchChange.LastAppliedAt = time.Time{}
+		}
+		require.NoError(t, s.CreateBatchChange(ctx, batchChange))
+		return batchChange
+	}
+
+	completeResolution := func(t *testing.T, batchSpecID int64) {
+		t.Helper()
+
+		require.NoError(t, s.Exec(ctx, sqlf.Sprintf(
+			"UPDATE batch_spec_resolution_jobs SET state = %s WHERE batch_spec_id = %s",
+			btypes.BatchSpecResolutionJobStateCompleted,
+			batchSpecID,
+		)))
+	}
+
+	t.Run("SetExecutionSchedule", func(t *testing.T) {
+		batchChange := createBatchChange(t, true)
+
+		_, err := svc.SetExecutionSchedule(userCtx, SetExecutionScheduleOpts{BatchChangeID: batchChange.ID, Interval: time.Minute})
+		assert.Error(t, err)
+
+		_, err = svc.SetExecutionSchedule(otherUserCtx, SetExecutionScheduleOpts{BatchChangeID: batchChange.ID, Interval: time.Hour})
+		assert.Error(t, err)
+
+		schedule, err := svc.SetExecutionSchedule(userCtx, SetExecutionScheduleOpts{BatchChangeID: batchChange.ID, Interval: time.Hour})
+		require.NoError(t, err)
+		assert.Equal(t, user.ID, schedule.UserID)
+		assert.Equal(t, now.Add(time.Hour), schedule.NextRunAt)
+
+		require.NoError(t, svc.RemoveExecutionSchedule(userCtx, batchChange.ID))
+		_, err = s.GetBatchChangeExecutionSchedule(ctx, store.GetBatchChangeExecutionScheduleOpts{BatchChangeID: batchChange.ID})
+		assert.Equal(t, store.ErrNoResults, err)
+
+		draft := createBatchChange(t, false)
+		_, err = svc.SetExecutionSchedule(userCtx, SetExecutionScheduleOpts{BatchChangeID: draft.ID, Interval: time.Hour})
+		assert.Equal(t, ErrExecutionScheduleNotApplied, err)
+	})
+
+	t.Run("RunExecutionSchedule", func(t *testing.T) {
+		for _, autoApply := range []bool{false, true} {
+			batchChange := createBatchChange(t, true)
+			previousSpecID := batchChange.BatchSpecID
+
+			schedule, err := svc.SetExecutionSchedule(userCtx, SetExecutionScheduleOpts{
+				BatchChangeID: batchChange.ID,
+				Interval:      time.Hour,
+				AutoApply:     autoApply,
+			})
+			require.NoError(t, err)
+
+			// The schedule is not due yet.
+			require.NoError(t, svc.RunExecutionSchedule(userCtx, schedule))
+			assert.False(t, schedule.InProgress())
+
+			now = now.Add(time.Hour)
+			require.NoError(t, svc.RunExecutionSchedule(userCtx, schedule))
+			require.True(t, schedule.InProgress())
+			assert.Equal(t, now, schedule.LastRunAt)
+			assert.Equal(t, now.Add(time.Hour), schedule.NextRunAt)
+
+			spec, err := s.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: schedule.BatchSpecID})
+			require.NoError(t, err)
+			assert.Equal(t, bt.TestRawBatchSpecYAML, spec.RawSpec)
+			assert.Equal(t, batchChange.ID, spec.BatchChangeID)
+			assert.Equal(t, user.ID, spec.UserID)
+
+			// Nothing happens until the workspaces have been resolved.
+			require.NoError(t, svc.RunExecutionSchedule(userCtx, schedule))
+			require.True(t, schedule.InProgress())
+
+			// Without any workspaces to execute, the execution completes right
+			// away.
+			completeResolution(t, spec.ID)
+			require.NoError(t, svc.RunExecutionSchedule(userCtx, schedule))
+			assert.False(t, schedule.InProgress())
+
+			batchChange, err = s.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: batchChange.ID})
+			require.NoError(t, err)
+			if autoApply {
+				assert.Equal(t, spec.ID, batchChange.BatchSpecID)
+			} else {
+				assert.Equal(t, previousSpecID, batchChange.BatchSpecID)
+			}
+		}
+	})
+
+	t.Run("RunExecutionSchedule closed batch change", func(t *testing.T) {
+		batchChange := createBatchChange(t, true)
+
+		schedule, err := svc.SetExecutionSchedule(userCtx, SetExecutionScheduleOpts{BatchChangeID: batchChange.ID, Interval: time.Hour})
+		require.NoError(t, err)
+
+		_, err = svc.CloseBatchChange(userCtx, batchChange.ID, false)
+		require.NoError(t, err)
+
+		require.NoError(t, svc.RunExecutionSchedule(userCtx, schedule))
+		_, err = s.GetBatchChangeExecutionSchedule(ctx, store.GetBatchChangeExecutionScheduleOpts{BatchChangeID: batchChange.ID})
+		assert.Equal(t, store.ErrNoResults, err)
+	})
+}
@@ -0,0 +1,466 @@
+package sources
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	adobatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/azuredevops"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+type AzureDevOpsSource struct {
+	client azuredevops.Client
+}
+
+var (
+	_ ForkableChangesetSource = AzureDevOpsSource{}
+	_ DraftChangesetSource    = AzureDevOpsSource{}
+)
+
+func NewAzureDevOpsSource(ctx context.Context, svc *types.ExternalService, cf *httpcli.Factory) (*AzureDevOpsSource, error) {
+	rawConfig, err := svc.Config.Decrypt(ctx)
+	if err != nil {
+		return nil, errors.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	var c schema.AzureDevOpsConnection
+	if err := jsonc.Unmarshal(rawConfig, &c); err != nil {
+		return nil, errors.Wrapf(err, "external service id=%d", svc.ID)
+	}
+
+	if cf == nil {
+		cf = httpcli.ExternalClientFactory
+	}
+
+	cli, err := cf.Doer()
+	if err != nil {
+		return nil, errors.Wrap(err, "creating external client")
+	}
+
+	client, err := azuredevops.NewClient(svc.URN(), &c, cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating Azure DevOps client")
+	}
+
+	return &AzureDevOpsSource{client: client}, nil
+}
+
+// GitserverPushConfig returns an authenticated push config used for pushing
+// commits to the code host.
+func (s AzureDevOpsSource) GitserverPushConfig(repo *types.Repo) (*protocol.PushConfig, error) {
+	return GitserverPushConfig(repo, s.client.Authenticator())
+}
+
+// WithAuthenticator returns a copy of the original Source configured to use the
+// given authenticator, provided that authenticator type is supported by the
+// code host.
+func (s AzureDevOpsSource) WithAuthenticator(a auth.Authenticator) (ChangesetSource, error) {
+	switch a.(type) {
+	case *auth.BasicAuth,
+		*auth.BasicAuthWithSSH:
+		break
+
+	default:
+		return nil, newUnsupportedAuthenticatorError("AzureDevOpsSource", a)
+	}
+
+	return &AzureDevOpsSource{client: s.client.WithAuthenticator(a)}, nil
+}
+
+// ValidateAuthenticator validates the currently set authenticator is usable.
+// Returns an error, when validating the Authenticator yielded an error.
+func (s AzureDevOpsSource) ValidateAuthenticator(ctx context.Context) error {
+	return s.client.Ping(ctx)
+}
+
+// LoadChangeset loads the given Changeset from the source and updates it. If
+// the Changeset could not be found on the source, a ChangesetNotFoundError is
+// returned.
+func (s AzureDevOpsSource) LoadChangeset(ctx context.Context, cs *Changeset) error {
+	args, err := s.createCommonPullRequestArgs(cs.TargetRepo.Metadata.(*azuredevops.Repository), cs.ExternalID)
+	if err != nil {
+		return err
+	}
+
+	pr, err := s.client.GetPullRequest(ctx, args)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return ChangesetNotFoundError{Changeset: cs}
+		}
+		return errors.Wrap(err, "getting pull request")
+	}
+
+	return s.setChangesetMetadata(ctx, args, &pr, cs)
+}
+
+// CreateChangeset will create the Changeset on the source. If it already
+// exists, *Changeset will be populated and the return value will be true.
+func (s AzureDevOpsSource) CreateChangeset(ctx context.Context, cs *Changeset) (bool, error) {
+	return s.createChangeset(ctx, cs, false)
+}
+
+// CreateDraftChangeset creates the given changeset on the code host in draft
+// mode.
+func (s AzureDevOpsSource) CreateDraftChangeset(ctx context.Context, cs *Changeset) (bool, error) {
+	return s.createChangeset(ctx, cs, true)
+}
+
+func (s AzureDevOpsSource) createChangeset(ctx context.Context, cs *Changeset, draft bool) (bool, error) {
+	targetRepo := cs.TargetRepo.Metadata.(*azuredevops.Repository)
+	org, err := targetRepo.GetOrganization()
+	if err != nil {
+		return false, errors.Wrap(err, "getting organization of target repository")
+	}
+
+	input := s.changesetToPullRequestInput(cs)
+	input.IsDraft = draft
+
+	repoArgs := azuredevops.OrgProjectRepoArgs{
+		Org:          org,
+		Project:      targetRepo.Project.Name,
+		RepoNameOrID: targetRepo.ID,
+	}
+
+	var exists bool
+	pr, err := s.client.CreatePullRequest(ctx, repoArgs, input)
+	if err != nil {
+		if !azuredevops.IsPullRequestExists(err) {
+			return false, errors.Wrap(err, "creating pull request")
+		}
+
+		sourceRepoID := targetRepo.ID
+		if input.ForkSource != nil {
+			sourceRepoID = input.ForkSource.Repository.ID
+		}
+		pr, err = s.getActivePullRequestByRefs(ctx, repoArgs, sourceRepoID, input.SourceRefName, input.TargetRefName)
+		if err != nil {
+			return false, errors.Wrap(err, "fetching existing pull request")
+		}
+		exists = true
+	}
+
+	args, err := s.createCommonPullRequestArgs(targetRepo, strconv.Itoa(pr.ID))
+	if err != nil {
+		return false, err
+	}
+
+	if err := s.setChangesetMetadata(ctx, args, &pr, cs); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// getActivePullRequestByRefs returns the active pull request from the source
+// branch in the given source repository to the target branch.
+func (s AzureDevOpsSource) getActivePullRequestByRefs(ctx context.Context, args azuredevops.OrgProjectRepoArgs, sourceRepoID, sourceRefName, targetRefName string) (azuredevops.PullRequest, error) {
+	prs, err := s.client.ListPullRequests(ctx, args, azuredevops.ListPullRequestsCriteria{
+		Status:             azuredevops.PullRequestStatusActive,
+		SourceRefName:      sourceRefName,
+		TargetRefName:      targetRefName,
+		SourceRepositoryID: sourceRepoID,
+	})
+	if err != nil {
+		return azuredevops.PullRequest{}, err
+	}
+	if len(prs) == 0 {
+		return azuredevops.PullRequest{}, errors.Errorf("no active pull request from %s to %s found", sourceRefName, targetRefName)
+	}
+
+	return prs[0], nil
+}
+
+// CloseChangeset will close the Changeset on the source, where "close"
+// means the appropriate final state on the codehost (e.g. "abandoned" on
+// Azure DevOps).
+func (s AzureDevOpsSource) CloseChangeset(ctx context.Context, cs *Changeset) error {
+	args, err := s.createCommonPullRequestArgs(cs.TargetRepo.Metadata.(*azuredevops.Repository), cs.ExternalID)
+	if err != nil {
+		return err
+	}
+
+	updated, err := s.client.AbandonPullRequest(ctx, args)
+	if err != nil {
+		return errors.Wrap(err, "abandoning pull request")
+	}
+
+	return s.setChangesetMetadata(ctx, args, &updated, cs)
+}
+
+// UpdateChangeset can update Changesets.
+func (s AzureDevOpsSource) UpdateChangeset(ctx context.Context, cs *Changeset) error {
+	args, err := s.createCommonPullRequestArgs(cs.TargetRepo.Metadata.(*azuredevops.Repository), cs.ExternalID)
+	if err != nil {
+		return err
+	}
+
+	targetRef := gitdomain.EnsureRefPrefix(cs.BaseRef)
+	updated, err := s.client.UpdatePullRequest(ctx, args, azuredevops.PullRequestUpdateInput{
+		Title:         &cs.Title,
+		Description:   &cs.Body,
+		TargetRefName: &targetRef,
+	})
+	if err != nil {
+		return errors.Wrap(err, "updating pull request")
+	}
+
+	return s.setChangesetMetadata(ctx, args, &updated, cs)
+}
+
+// UndraftChangeset will update the Changeset on the source to be not in draft
+// mode anymore.
+func (s AzureDevOpsSource) UndraftChangeset(ctx context.Context, cs *Changeset) error {
+	args, err := s.createCommonPullRequestArgs(cs.TargetRepo.Metadata.(*azuredevops.Repository), cs.ExternalID)
+	if err != nil {
+		return err
+	}
+
+	isDraft := false
+	updated, err := s.client.UpdatePullRequest(ctx, args, azuredevops.PullRequestUpdateInput{
+		IsDraft: &isDraft,
+	})
+	if err != nil {
+		return errors.Wrap(err, "undrafting pull request")
+	}
+
+	return s.setChangesetMetadata(ctx, args, &updated, cs)
+}
+
+// ReopenChangeset will reopen the Changeset on the source, if it's closed.
+// If not, it's a noop.
+func (s AzureDevOpsSource) ReopenChangeset(ctx context.Context, cs *Changeset) error {
+	args, err := s.createCommonPullRequestArgs(cs.TargetRepo.Metadata.(*azuredevops.Repository), cs.ExternalID)
+	if err != nil {
+		return err
+	}
+
+	updated, err := s.client.ReactivatePullRequest(ctx, args)
+	if err != nil {
+		return errors.Wrap(err, "reactivating pull request")
+	}
+
+	return s.setChangesetMetadata(ctx, args, &updated, cs)
+}
+
+// CreateComment posts a comment on the Changeset.
+func (s AzureDevOpsSource) CreateComment(ctx context.Context, cs *Changeset, comment string) error {
+	args, err := s.createCommonPullRequestArgs(cs.TargetRepo.Metadata.(*azuredevops.Repository), cs.ExternalID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.CreatePullRequestCommentThread(ctx, args, azuredevops.PullRequestCommentInput{
+		Comments: []azuredevops.PullRequestCommentForInput{
+			{
+				ParentCommentID: 0,
+				Content:         comment,
+				// 1 is the "text" comment type.
+				CommentType: 1,
+			},
+		},
+	})
+	return err
+}
+
+// MergeChangeset merges a Changeset on the code host, if in a mergeable state.
+// If squash is true, and the code host supports squash merges, the source
+// must attempt a squash merge. Otherwise, it is expected to perform a regular
+// merge. If the changeset cannot be merged, because it is in an unmergeable
+// state, ChangesetNotMergeableError must be returned.
+func (s AzureDevOpsSource) MergeChangeset(ctx context.Context, cs *Changeset, squash bool) error {
+	args, err := s.createCommonPullRequestArgs(cs.TargetRepo.Metadata.(*azuredevops.Repository), cs.ExternalID)
+	if err != nil {
+		return err
+	}
+
+	pr := cs.Metadata.(*adobatches.AnnotatedPullRequest)
+	if pr.LastMergeSourceCommit == nil {
+		return ChangesetNotMergeableError{ErrorMsg: "pull request has no source commit"}
+	}
+
+	var mergeStrategy *azuredevops.PullRequestMergeStrategy
+	if squash {
+		ms := azuredevops.PullRequestMergeStrategySquash
+		mergeStrategy = &ms
+	}
+
+	updated, err := s.client.CompletePullRequest(ctx, args, azuredevops.PullRequestCompleteInput{
+		CommitID:      pr.LastMergeSourceCommit.CommitID,
+		MergeStrategy: mergeStrategy,
+	})
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return errors.Wrap(err, "completing pull request")
+		}
+		return ChangesetNotMergeableError{ErrorMsg: err.Error()}
+	}
+
+	return s.setChangesetMetadata(ctx, args, &updated, cs)
+}
+
+// GetNamespaceFork returns a repo pointing to a fork of the given repo in
+// the given namespace, ensuring that the fork exists and is a fork of the
+// target repo.
+//
+// On Azure DevOps, the namespace is the name of a project within the
+// organization of the target repo. The fork is named after the project and
+// name of the target repo, so that repositories with the same name in
+// different projects don't end up with the same fork.
+func (s AzureDevOpsSource) GetNamespaceFork(ctx context.Context, targetRepo *types.Repo, namespace string) (*types.Repo, error) {
+	targetMeta := targetRepo.Metadata.(*azuredevops.Repository)
+	org, err := targetMeta.GetOrganization()
+	if err != nil {
+		return nil, errors.Wrap(err, "getting organization of target repository")
+	}
+
+	forkName := targetMeta.Project.Name + "-" + targetMeta.Name
+
+	// Figure out if we already have the repo.
+	if fork, err := s.client.GetRepo(ctx, azuredevops.OrgProjectRepoArgs{
+		Org:          org,
+		Project:      namespace,
+		RepoNameOrID: forkName,
+	}); err == nil {
+		return s.copyRepoAsFork(targetRepo, &fork, org)
+	} else if !errcode.IsNotFound(err) {
+		return nil, errors.Wrap(err, "checking for fork existence")
+	}
+
+	projects, err := s.client.ListProjects(ctx, org)
+	if err != nil {
+		return nil, errors.Wrap(err, "listing projects")
+	}
+	var projectID string
+	for _, p := range projects {
+		if p.Name == namespace {
+			projectID = p.ID
+			break
+		}
+	}
+	if projectID == "" {
+		return nil, errors.Errorf("project %q not found in organization %q", namespace, org)
+	}
+
+	fork, err := s.client.ForkRepository(ctx, org, azuredevops.ForkRepositoryInput{
+		Name:    forkName,
+		Project: azuredevops.ForkRepositoryInputProject{ID: projectID},
+		ParentRepository: azuredevops.ForkRepositoryInputParentRepository{
+			ID:      targetMeta.ID,
+			Project: azuredevops.ForkRepositoryInputProject{ID: targetMeta.Project.ID},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "forking repository")
+	}
+
+	return s.copyRepoAsFork(targetRepo, &fork, org)
+}
+
+// GetUserFork returns a repo pointing to a fork of the given repo in the
+// currently authenticated user's namespace.
+//
+// Azure DevOps has no personal namespaces, so forks always have to be created
+// in an explicitly configured project.
+func (s AzureDevOpsSource) GetUserFork(ctx context.Context, targetRepo *types.Repo) (*types.Repo, error) {
+	return nil, errors.New("Azure DevOps does not support forking into a user namespace: specify the project to fork into as the namespace")
+}
+
+func (s AzureDevOpsSource) copyRepoAsFork(targetRepo *types.Repo, fork *azuredevops.Repository, org string) (*types.Repo, error) {
+	targetMeta := targetRepo.Metadata.(*azuredevops.Repository)
+
+	// Azure DevOps uses different paths in HTTPS and SSH clone URLs, so we
+	// have to rewrite both forms. Replacing a path that isn't part of the
+	// clone URL is a no-op.
+	forkRepo, err := CopyRepoAsFork(
+		targetRepo,
+		fork,
+		org+"/"+url.PathEscape(targetMeta.Project.Name)+"/_git/"+url.PathEscape(targetMeta.Name),
+		org+"/"+url.PathEscape(fork.Project.Name)+"/_git/"+url.PathEscape(fork.Name),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "updating target repo sources")
+	}
+	forkRepo, err = CopyRepoAsFork(
+		forkRepo,
+		fork,
+		"v3/"+org+"/"+url.PathEscape(targetMeta.Project.Name)+"/"+url.PathEscape(targetMeta.Name),
+		"v3/"+org+"/"+url.PathEscape(fork.Project.Name)+"/"+url.PathEscape(fork.Name),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "updating target repo sources")
+	}
+
+	return forkRepo, nil
+}
+
+func (s AzureDevOpsSource) createCommonPullRequestArgs(repo *azuredevops.Repository, externalID string) (azuredevops.PullRequestCommonArgs, error) {
+	org, err := repo.GetOrganization()
+	if err != nil {
+		return azuredevops.PullRequestCommonArgs{}, errors.Wrap(err, "getting organization of repository")
+	}
+	return azuredevops.PullRequestCommonArgs{
+		PullRequestID: externalID,
+		Org:           org,
+		Project:       repo.Project.Name,
+		RepoNameOrID:  repo.ID,
+	}, nil
+}
+
+func (s AzureDevOpsSource) annotatePullRequest(ctx context.Context, args azuredevops.PullRequestCommonArgs, pr *azuredevops.PullRequest) (*adobatches.AnnotatedPullRequest, error) {
+	srs, err := s.client.GetPullRequestStatuses(ctx, args)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting pull request statuses")
+	}
+
+	statuses := make([]*azuredevops.PullRequestBuildStatus, 0, len(srs))
+	for i := range srs {
+		statuses = append(statuses, &srs[i])
+	}
+
+	return &adobatches.AnnotatedPullRequest{
+		PullRequest: pr,
+		Statuses:    statuses,
+	}, nil
+}
+
+func (s AzureDevOpsSource) setChangesetMetadata(ctx context.Context, args azuredevops.PullRequestCommonArgs, pr *azuredevops.PullRequest, cs *Changeset) error {
+	apr, err := s.annotatePullRequest(ctx, args, pr)
+	if err != nil {
+		return errors.Wrap(err, "annotating pull request")
+	}
+
+	if err := cs.SetMetadata(apr); err != nil {
+		return errors.Wrap(err, "setting changeset metadata")
+	}
+
+	return nil
+}
+
+func (s AzureDevOpsSource) changesetToPullRequestInput(cs *Changeset) azuredevops.CreatePullRequestInput {
+	input := azuredevops.CreatePullRequestInput{
+		Title:         cs.Title,
+		Description:   cs.Body,
+		SourceRefName: gitdomain.EnsureRefPrefix(cs.HeadRef),
+		TargetRefName: gitdomain.EnsureRefPrefix(cs.BaseRef),
+	}
+
+	// If we're forking, then we need to set the source repository as well.
+	if cs.RemoteRepo != cs.TargetRepo {
+		input.ForkSource = &azuredevops.ForkRefInput{
+			Repository: azuredevops.ForkRefInputRepository{
+				ID: cs.RemoteRepo.Metadata.(*azuredevops.Repository).ID,
+			},
+		}
+	}
+
+	return input
+}
@@ -48,6 +48,17 @@ type DraftChangesetSource interface {
 	UndraftChangeset(context.Context, *Changeset) error
 }
 
+// A MovableChangesetSource identifies changesets by their base branch, so a
+// published changeset has to be moved to a new base branch before commits are
+// pushed for it.
+type MovableChangesetSource interface {
+	ChangesetSource
+
+	// MoveChangeset moves the Changeset on the source to its BaseRef, if it
+	// targets another branch.
+	MoveChangeset(context.Context, *Changeset) error
+}
+
 type ForkableChangesetSource interface {
 	ChangesetSource
 
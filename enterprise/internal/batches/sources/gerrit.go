@@ -0,0 +1,351 @@
+package sources
+
+import (
+	"context"
+	"net/url"
+
+	gerritbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gerrit"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// GerritSource is a ChangesetSource for Gerrit. Gerrit has no pull requests:
+// changes are created by pushing a commit with a Change-Id footer to the
+// magic refs/for/<branch> ref, which the reconciler does before the source is
+// asked to create the changeset. The source then only has to look up the
+// change and keep its metadata in sync with the changeset spec.
+type GerritSource struct {
+	client gerrit.Client
+}
+
+var (
+	_ DraftChangesetSource   = GerritSource{}
+	_ MovableChangesetSource = GerritSource{}
+)
+
+func NewGerritSource(ctx context.Context, svc *types.ExternalService, cf *httpcli.Factory) (*GerritSource, error) {
+	rawConfig, err := svc.Config.Decrypt(ctx)
+	if err != nil {
+		return nil, errors.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	var c schema.GerritConnection
+	if err := jsonc.Unmarshal(rawConfig, &c); err != nil {
+		return nil, errors.Wrapf(err, "external service id=%d", svc.ID)
+	}
+
+	if cf == nil {
+		cf = httpcli.ExternalClientFactory
+	}
+
+	cli, err := cf.Doer()
+	if err != nil {
+		return nil, errors.Wrap(err, "creating external client")
+	}
+
+	client, err := gerrit.NewClient(svc.URN(), &c, cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating Gerrit client")
+	}
+
+	return &GerritSource{client: client}, nil
+}
+
+// GitserverPushConfig returns an authenticated push config used for pushing
+// commits to the code host.
+func (s GerritSource) GitserverPushConfig(repo *types.Repo) (*protocol.PushConfig, error) {
+	return GitserverPushConfig(repo, s.client.Authenticator())
+}
+
+// WithAuthenticator returns a copy of the original Source configured to use the
+// given authenticator, provided that authenticator type is supported by the
+// code host.
+func (s GerritSource) WithAuthenticator(a auth.Authenticator) (ChangesetSource, error) {
+	switch a.(type) {
+	case *auth.BasicAuth,
+		*auth.BasicAuthWithSSH:
+		break
+
+	default:
+		return nil, newUnsupportedAuthenticatorError("GerritSource", a)
+	}
+
+	return &GerritSource{client: s.client.WithAuthenticator(a)}, nil
+}
+
+// ValidateAuthenticator validates the currently set authenticator is usable.
+// Returns an error, when validating the Authenticator yielded an error.
+func (s GerritSource) ValidateAuthenticator(ctx context.Context) error {
+	_, err := s.client.GetAuthenticatedUserAccount(ctx)
+	return err
+}
+
+// LoadChangeset loads the given Changeset from the source and updates it. If
+// the Changeset could not be found on the source, a ChangesetNotFoundError is
+// returned.
+func (s GerritSource) LoadChangeset(ctx context.Context, cs *Changeset) error {
+	change, err := s.client.GetChange(ctx, cs.ExternalID)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return ChangesetNotFoundError{Changeset: cs}
+		}
+		return errors.Wrap(err, "getting change")
+	}
+
+	return s.setChangesetMetadata(change, cs)
+}
+
+// CreateChangeset will create the Changeset on the source. If it already
+// exists, *Changeset will be populated and the return value will be true.
+//
+// The change itself has already been created by pushing its commit, so this
+// loads it and brings its commit message in line with the title and body of
+// the changeset.
+func (s GerritSource) CreateChangeset(ctx context.Context, cs *Changeset) (bool, error) {
+	return s.createChangeset(ctx, cs, false)
+}
+
+// CreateDraftChangeset creates the given changeset on the code host in draft
+// mode, which is the work-in-progress state on Gerrit.
+func (s GerritSource) CreateDraftChangeset(ctx context.Context, cs *Changeset) (bool, error) {
+	return s.createChangeset(ctx, cs, true)
+}
+
+func (s GerritSource) createChangeset(ctx context.Context, cs *Changeset, draft bool) (bool, error) {
+	id, err := baseRefTripletID(cs)
+	if err != nil {
+		return false, err
+	}
+
+	change, err := s.client.GetChange(ctx, id)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return false, errors.Wrap(err, "change was not created by pushing the commit")
+		}
+		return false, errors.Wrap(err, "getting change")
+	}
+
+	if err := s.updateCommitMessage(ctx, change, cs); err != nil {
+		return false, err
+	}
+
+	if draft && !change.WorkInProgress {
+		if err := s.client.SetWIP(ctx, id); err != nil {
+			return false, errors.Wrap(err, "marking change as work in progress")
+		}
+	}
+
+	// Reload the change, since we may have created a new patch set or
+	// changed its state.
+	if change, err = s.client.GetChange(ctx, id); err != nil {
+		return false, errors.Wrap(err, "getting change")
+	}
+
+	if err := s.setChangesetMetadata(change, cs); err != nil {
+		return false, err
+	}
+
+	return false, nil
+}
+
+// CloseChangeset will close the Changeset on the source, where "close"
+// means the appropriate final state on the codehost (e.g. "abandoned" on
+// Gerrit).
+func (s GerritSource) CloseChangeset(ctx context.Context, cs *Changeset) error {
+	change, err := s.client.AbandonChange(ctx, cs.ExternalID)
+	if err != nil {
+		return errors.Wrap(err, "abandoning change")
+	}
+
+	return s.reloadChangesetMetadata(ctx, change, cs)
+}
+
+// UpdateChangeset can update Changesets.
+func (s GerritSource) UpdateChangeset(ctx context.Context, cs *Changeset) error {
+	change, err := s.client.GetChange(ctx, cs.ExternalID)
+	if err != nil {
+		return errors.Wrap(err, "getting change")
+	}
+
+	if change, err = s.moveChange(ctx, change, cs); err != nil {
+		return err
+	}
+
+	if err := s.updateCommitMessage(ctx, change, cs); err != nil {
+		return err
+	}
+
+	if change, err = s.client.GetChange(ctx, changeTripletID(change)); err != nil {
+		return errors.Wrap(err, "getting change")
+	}
+
+	return s.setChangesetMetadata(change, cs)
+}
+
+// MoveChangeset moves the change to the base ref of the changeset, if it is on
+// another branch. Gerrit tells changes apart by their project, branch and
+// Change-Id, so pushing a commit for a new branch before moving the change
+// would create a second change on that branch.
+func (s GerritSource) MoveChangeset(ctx context.Context, cs *Changeset) error {
+	// A previous attempt may have moved the change already, in which case its
+	// ID is outdated.
+	id, err := baseRefTripletID(cs)
+	if err != nil {
+		return err
+	}
+	change, err := s.client.GetChange(ctx, id)
+	if err != nil {
+		if !errcode.IsNotFound(err) {
+			return errors.Wrap(err, "getting change")
+		}
+
+		if change, err = s.client.GetChange(ctx, cs.ExternalID); err != nil {
+			return errors.Wrap(err, "getting change")
+		}
+		if change, err = s.moveChange(ctx, change, cs); err != nil {
+			return err
+		}
+	}
+
+	return s.setChangesetMetadata(change, cs)
+}
+
+// moveChange moves the change to the base ref of the changeset, if it is on
+// another branch, and returns the moved change.
+func (s GerritSource) moveChange(ctx context.Context, change *gerrit.Change, cs *Changeset) (*gerrit.Change, error) {
+	branch := gitdomain.AbbreviateRef(cs.BaseRef)
+	if change.Branch == branch {
+		return change, nil
+	}
+
+	moved, err := s.client.MoveChange(ctx, changeTripletID(change), gerrit.MoveChangeInput{
+		DestinationBranch: branch,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "moving change")
+	}
+	// Moving the change changes its ID, and the moved change doesn't include
+	// its current patch set, so we reload it by its new ID.
+	if change, err = s.client.GetChange(ctx, changeTripletID(moved)); err != nil {
+		return nil, errors.Wrap(err, "getting change")
+	}
+	return change, nil
+}
+
+// UndraftChangeset will update the Changeset on the source to be not in draft
+// mode anymore.
+func (s GerritSource) UndraftChangeset(ctx context.Context, cs *Changeset) error {
+	if err := s.client.SetReadyForReview(ctx, cs.ExternalID); err != nil {
+		return errors.Wrap(err, "marking change as ready for review")
+	}
+
+	return s.LoadChangeset(ctx, cs)
+}
+
+// ReopenChangeset will reopen the Changeset on the source, if it's closed.
+// If not, it's a noop.
+func (s GerritSource) ReopenChangeset(ctx context.Context, cs *Changeset) error {
+	change, err := s.client.RestoreChange(ctx, cs.ExternalID)
+	if err != nil {
+		return errors.Wrap(err, "restoring change")
+	}
+
+	return s.reloadChangesetMetadata(ctx, change, cs)
+}
+
+// CreateComment posts a comment on the Changeset.
+func (s GerritSource) CreateComment(ctx context.Context, cs *Changeset, comment string) error {
+	return s.client.WriteReviewComment(ctx, cs.ExternalID, gerrit.ReviewInput{
+		Message: comment,
+	})
+}
+
+// MergeChangeset merges a Changeset on the code host, if in a mergeable state.
+// If squash is true, and the code host supports squash merges, the source
+// must attempt a squash merge. Otherwise, it is expected to perform a regular
+// merge. If the changeset cannot be merged, because it is in an unmergeable
+// state, ChangesetNotMergeableError must be returned.
+//
+// Gerrit changes consist of a single commit and are submitted using the
+// submit type configured for the project, so squash is ignored.
+func (s GerritSource) MergeChangeset(ctx context.Context, cs *Changeset, squash bool) error {
+	change, err := s.client.SubmitChange(ctx, cs.ExternalID)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return errors.Wrap(err, "submitting change")
+		}
+		return ChangesetNotMergeableError{ErrorMsg: err.Error()}
+	}
+
+	return s.reloadChangesetMetadata(ctx, change, cs)
+}
+
+// updateCommitMessage sets the commit message of the current patch set of the
+// change to the title and body of the changeset, if they differ. Gerrit
+// doesn't have a title and description separate from the commit message.
+//
+// The reconciler already pushes commits with this message, so this only
+// creates a new patch set if the title or body changed without a new commit.
+func (s GerritSource) updateCommitMessage(ctx context.Context, change *gerrit.Change, cs *Changeset) error {
+	current := (&gerritbatches.AnnotatedChange{Change: change}).CommitMessage()
+	wantTitle, wantBody := gerritbatches.ParseCommitMessage(gerritbatches.BuildCommitMessage(cs.Title, cs.Body, change.ChangeID))
+	if title, body := gerritbatches.ParseCommitMessage(current); title == wantTitle && body == wantBody {
+		return nil
+	}
+
+	if err := s.client.SetCommitMessage(ctx, changeTripletID(change), gerrit.CommitMessageInput{
+		Message: gerritbatches.BuildCommitMessage(cs.Title, cs.Body, change.ChangeID),
+	}); err != nil {
+		return errors.Wrap(err, "updating commit message")
+	}
+	return nil
+}
+
+// reloadChangesetMetadata updates the changeset with the given change. The
+// change returned by the endpoints modifying a change doesn't include its
+// labels and revisions, so we only use it as a fallback if reloading fails.
+func (s GerritSource) reloadChangesetMetadata(ctx context.Context, change *gerrit.Change, cs *Changeset) error {
+	if full, err := s.client.GetChange(ctx, changeTripletID(change)); err == nil {
+		change = full
+	}
+	return s.setChangesetMetadata(change, cs)
+}
+
+// baseRefTripletID returns the ID of the change of the changeset on its base
+// ref, see gerritbatches.ChangeTripletID.
+func baseRefTripletID(cs *Changeset) (string, error) {
+	// The ID of a Gerrit project is its URL-encoded name.
+	project, err := url.PathUnescape(cs.TargetRepo.ExternalRepo.ID)
+	if err != nil {
+		return "", errors.Wrap(err, "decoding project name")
+	}
+	return gerritbatches.ChangeTripletID(
+		project,
+		gitdomain.AbbreviateRef(cs.BaseRef),
+		gerritbatches.GenerateChangeID(cs.TargetRepo.Name, cs.HeadRef),
+	), nil
+}
+
+// changeTripletID returns the ID we use for the given change, see
+// gerritbatches.ChangeTripletID.
+func changeTripletID(change *gerrit.Change) string {
+	return gerritbatches.ChangeTripletID(change.Project, change.Branch, change.ChangeID)
+}
+
+func (s GerritSource) setChangesetMetadata(change *gerrit.Change, cs *Changeset) error {
+	if err := cs.SetMetadata(&gerritbatches.AnnotatedChange{
+		Change:      change,
+		CodeHostURL: *s.client.GetURL(),
+	}); err != nil {
+		return errors.Wrap(err, "setting changeset metadata")
+	}
+
+	return nil
+}
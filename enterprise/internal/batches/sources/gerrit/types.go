@@ -0,0 +1,87 @@
+package gerrit
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"net/url"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
+)
+
+// AnnotatedChange adds metadata we need that lives outside the main Change
+// type returned by the Gerrit API alongside the change. This type is used as
+// the primary metadata type for Gerrit changesets.
+type AnnotatedChange struct {
+	*gerrit.Change
+	// CodeHostURL is the base URL of the Gerrit instance, which we need to
+	// build the URL of the change.
+	CodeHostURL url.URL `json:"codeHostURL"`
+}
+
+// URL returns the URL of the change in the Gerrit web interface.
+func (c *AnnotatedChange) URL() string {
+	return gerrit.ChangeURL(&c.CodeHostURL, c.Change)
+}
+
+// CommitMessage returns the full commit message of the current patch set of
+// the change, if known.
+func (c *AnnotatedChange) CommitMessage() string {
+	if r, ok := c.CurrentRevisionInfo(); ok && r.Commit != nil {
+		return r.Commit.Message
+	}
+	return ""
+}
+
+// GenerateChangeID returns the Change-Id used for the changeset pushed to the
+// given head ref of the given repository. Gerrit identifies the change a
+// commit belongs to by the Change-Id footer of its commit message, so the ID
+// has to be stable across pushes for subsequent pushes to create new patch
+// sets of the same change instead of new changes.
+func GenerateChangeID(repo api.RepoName, headRef string) string {
+	h := sha1.Sum([]byte(string(repo) + "\x00" + headRef))
+	return "I" + hex.EncodeToString(h[:])
+}
+
+// ChangeTripletID returns the "project~branch~Change-Id" triplet identifying
+// a change. Unlike the bare Change-Id, which is the same for the changes of
+// cherry-picks to other branches or projects, the triplet is unique.
+func ChangeTripletID(project, branch, changeID string) string {
+	return project + "~" + branch + "~" + changeID
+}
+
+// changeIDFooter is the prefix of the footer Gerrit uses to associate a commit
+// with a change.
+const changeIDFooter = "Change-Id: "
+
+// BuildCommitMessage returns the commit message for a change with the given
+// title and body, including the Change-Id footer Gerrit requires.
+func BuildCommitMessage(title, body, changeID string) string {
+	var b strings.Builder
+	b.WriteString(strings.TrimSpace(title))
+	if body = strings.TrimSpace(body); body != "" {
+		b.WriteString("\n\n")
+		b.WriteString(body)
+	}
+	b.WriteString("\n\n")
+	b.WriteString(changeIDFooter)
+	b.WriteString(changeID)
+	b.WriteString("\n")
+	return b.String()
+}
+
+// ParseCommitMessage splits the given commit message into the title and body
+// of a change, removing the Change-Id footer.
+func ParseCommitMessage(message string) (title, body string) {
+	lines := strings.Split(strings.TrimSpace(message), "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		if !strings.HasPrefix(line, changeIDFooter) {
+			kept = append(kept, line)
+		}
+	}
+
+	title, body, _ = strings.Cut(strings.Join(kept, "\n"), "\n")
+	return strings.TrimSpace(title), strings.TrimSpace(body)
+}
@@ -0,0 +1,43 @@
+package gerrit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateChangeID(t *testing.T) {
+	id := GenerateChangeID("gerrit.example.com/repo", "refs/heads/branch")
+	assert.Len(t, id, 41)
+	assert.Equal(t, "I", id[:1])
+	assert.Equal(t, id, GenerateChangeID("gerrit.example.com/repo", "refs/heads/branch"))
+	assert.NotEqual(t, id, GenerateChangeID("gerrit.example.com/repo", "refs/heads/other"))
+	assert.NotEqual(t, id, GenerateChangeID("gerrit.example.com/other", "refs/heads/branch"))
+}
+
+func TestCommitMessage(t *testing.T) {
+	for name, tc := range map[string]struct {
+		title string
+		body  string
+		want  string
+	}{
+		"title only": {
+			title: "title",
+			want:  "title\n\nChange-Id: I123\n",
+		},
+		"title and body": {
+			title: "title",
+			body:  "first line\n\nsecond paragraph",
+			want:  "title\n\nfirst line\n\nsecond paragraph\n\nChange-Id: I123\n",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			message := BuildCommitMessage(tc.title, tc.body, "I123")
+			assert.Equal(t, tc.want, message)
+
+			title, body := ParseCommitMessage(message)
+			assert.Equal(t, tc.title, title)
+			assert.Equal(t, tc.body, body)
+		})
+	}
+}
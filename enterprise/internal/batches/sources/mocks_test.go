@@ -8,13 +8,16 @@ package sources
 
 import (
 	"context"
+	"net/url"
 	"sync"
 
 	store "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
 	types1 "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	database "github.com/sourcegraph/sourcegraph/internal/database"
 	auth "github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	azuredevops "github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
 	bitbucketcloud "github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	gerrit "github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
 	protocol "github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	types "github.com/sourcegraph/sourcegraph/internal/types"
 )
@@ -5403,3 +5406,4571 @@ func (c BitbucketCloudClientWithAuthenticatorFuncCall) Args() []interface{} {
 func (c BitbucketCloudClientWithAuthenticatorFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
+
+// MockAzureDevOpsClient is a mock implementation of the Client interface
+// (from the package
+// github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops) used for
+// unit testing.
+type MockAzureDevOpsClient struct {
+	// AbandonPullRequestFunc is an instance of a mock function object
+	// controlling the behavior of the method AbandonPullRequest.
+	AbandonPullRequestFunc *AzureDevOpsClientAbandonPullRequestFunc
+	// AuthenticatorFunc is an instance of a mock function object
+	// controlling the behavior of the method Authenticator.
+	AuthenticatorFunc *AzureDevOpsClientAuthenticatorFunc
+	// CompletePullRequestFunc is an instance of a mock function object
+	// controlling the behavior of the method CompletePullRequest.
+	CompletePullRequestFunc *AzureDevOpsClientCompletePullRequestFunc
+	// CreatePullRequestFunc is an instance of a mock function object
+	// controlling the behavior of the method CreatePullRequest.
+	CreatePullRequestFunc *AzureDevOpsClientCreatePullRequestFunc
+	// CreatePullRequestCommentThreadFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// CreatePullRequestCommentThread.
+	CreatePullRequestCommentThreadFunc *AzureDevOpsClientCreatePullRequestCommentThreadFunc
+	// ForkRepositoryFunc is an instance of a mock function object
+	// controlling the behavior of the method ForkRepository.
+	ForkRepositoryFunc *AzureDevOpsClientForkRepositoryFunc
+	// GetAuthorizedProfileFunc is an instance of a mock function object
+	// controlling the behavior of the method GetAuthorizedProfile.
+	GetAuthorizedProfileFunc *AzureDevOpsClientGetAuthorizedProfileFunc
+	// GetPullRequestFunc is an instance of a mock function object
+	// controlling the behavior of the method GetPullRequest.
+	GetPullRequestFunc *AzureDevOpsClientGetPullRequestFunc
+	// GetPullRequestStatusesFunc is an instance of a mock function object
+	// controlling the behavior of the method GetPullRequestStatuses.
+	GetPullRequestStatusesFunc *AzureDevOpsClientGetPullRequestStatusesFunc
+	// GetRepoFunc is an instance of a mock function object controlling the
+	// behavior of the method GetRepo.
+	GetRepoFunc *AzureDevOpsClientGetRepoFunc
+	// GetUserEntitlementFunc is an instance of a mock function object
+	// controlling the behavior of the method GetUserEntitlement.
+	GetUserEntitlementFunc *AzureDevOpsClientGetUserEntitlementFunc
+	// ListIdentitiesByEmailFunc is an instance of a mock function object
+	// controlling the behavior of the method ListIdentitiesByEmail.
+	ListIdentitiesByEmailFunc *AzureDevOpsClientListIdentitiesByEmailFunc
+	// ListProjectsFunc is an instance of a mock function object controlling
+	// the behavior of the method ListProjects.
+	ListProjectsFunc *AzureDevOpsClientListProjectsFunc
+	// ListPullRequestsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListPullRequests.
+	ListPullRequestsFunc *AzureDevOpsClientListPullRequestsFunc
+	// ListRepositoriesByProjectOrOrgFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// ListRepositoriesByProjectOrOrg.
+	ListRepositoriesByProjectOrOrgFunc *AzureDevOpsClientListRepositoriesByProjectOrOrgFunc
+	// PingFunc is an instance of a mock function object controlling the
+	// behavior of the method Ping.
+	PingFunc *AzureDevOpsClientPingFunc
+	// ReactivatePullRequestFunc is an instance of a mock function object
+	// controlling the behavior of the method ReactivatePullRequest.
+	ReactivatePullRequestFunc *AzureDevOpsClientReactivatePullRequestFunc
+	// UpdatePullRequestFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdatePullRequest.
+	UpdatePullRequestFunc *AzureDevOpsClientUpdatePullRequestFunc
+	// WithAuthenticatorFunc is an instance of a mock function object
+	// controlling the behavior of the method WithAuthenticator.
+	WithAuthenticatorFunc *AzureDevOpsClientWithAuthenticatorFunc
+}
+
+// NewMockAzureDevOpsClient creates a new mock of the Client interface. All
+// methods return zero values for all results, unless overwritten.
+func NewMockAzureDevOpsClient() *MockAzureDevOpsClient {
+	return &MockAzureDevOpsClient{
+		AbandonPullRequestFunc: &AzureDevOpsClientAbandonPullRequestFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs) (r0 azuredevops.PullRequest, r1 error) {
+				return
+			},
+		},
+		AuthenticatorFunc: &AzureDevOpsClientAuthenticatorFunc{
+			defaultHook: func() (r0 auth.Authenticator) {
+				return
+			},
+		},
+		CompletePullRequestFunc: &AzureDevOpsClientCompletePullRequestFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCompleteInput) (r0 azuredevops.PullRequest, r1 error) {
+				return
+			},
+		},
+		CreatePullRequestFunc: &AzureDevOpsClientCreatePullRequestFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.CreatePullRequestInput) (r0 azuredevops.PullRequest, r1 error) {
+				return
+			},
+		},
+		CreatePullRequestCommentThreadFunc: &AzureDevOpsClientCreatePullRequestCommentThreadFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCommentInput) (r0 azuredevops.PullRequestCommentResponse, r1 error) {
+				return
+			},
+		},
+		ForkRepositoryFunc: &AzureDevOpsClientForkRepositoryFunc{
+			defaultHook: func(context.Context, string, azuredevops.ForkRepositoryInput) (r0 azuredevops.Repository, r1 error) {
+				return
+			},
+		},
+		GetAuthorizedProfileFunc: &AzureDevOpsClientGetAuthorizedProfileFunc{
+			defaultHook: func(context.Context) (r0 azuredevops.Profile, r1 error) {
+				return
+			},
+		},
+		GetPullRequestFunc: &AzureDevOpsClientGetPullRequestFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs) (r0 azuredevops.PullRequest, r1 error) {
+				return
+			},
+		},
+		GetPullRequestStatusesFunc: &AzureDevOpsClientGetPullRequestStatusesFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs) (r0 []azuredevops.PullRequestBuildStatus, r1 error) {
+				return
+			},
+		},
+		GetRepoFunc: &AzureDevOpsClientGetRepoFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs) (r0 azuredevops.Repository, r1 error) {
+				return
+			},
+		},
+		GetUserEntitlementFunc: &AzureDevOpsClientGetUserEntitlementFunc{
+			defaultHook: func(context.Context, string, string) (r0 azuredevops.UserEntitlement, r1 error) {
+				return
+			},
+		},
+		ListIdentitiesByEmailFunc: &AzureDevOpsClientListIdentitiesByEmailFunc{
+			defaultHook: func(context.Context, string, string) (r0 []azuredevops.IdentityDetails, r1 error) {
+				return
+			},
+		},
+		ListProjectsFunc: &AzureDevOpsClientListProjectsFunc{
+			defaultHook: func(context.Context, string) (r0 []azuredevops.Project, r1 error) {
+				return
+			},
+		},
+		ListPullRequestsFunc: &AzureDevOpsClientListPullRequestsFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListPullRequestsCriteria) (r0 []azuredevops.PullRequest, r1 error) {
+				return
+			},
+		},
+		ListRepositoriesByProjectOrOrgFunc: &AzureDevOpsClientListRepositoriesByProjectOrOrgFunc{
+			defaultHook: func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) (r0 []azuredevops.Repository, r1 error) {
+				return
+			},
+		},
+		PingFunc: &AzureDevOpsClientPingFunc{
+			defaultHook: func(context.Context) (r0 error) {
+				return
+			},
+		},
+		ReactivatePullRequestFunc: &AzureDevOpsClientReactivatePullRequestFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs) (r0 azuredevops.PullRequest, r1 error) {
+				return
+			},
+		},
+		UpdatePullRequestFunc: &AzureDevOpsClientUpdatePullRequestFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestUpdateInput) (r0 azuredevops.PullRequest, r1 error) {
+				return
+			},
+		},
+		WithAuthenticatorFunc: &AzureDevOpsClientWithAuthenticatorFunc{
+			defaultHook: func(auth.Authenticator) (r0 azuredevops.Client) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockAzureDevOpsClient creates a new mock of the Client
+// interface. All methods panic on invocation, unless overwritten.
+func NewStrictMockAzureDevOpsClient() *MockAzureDevOpsClient {
+	return &MockAzureDevOpsClient{
+		AbandonPullRequestFunc: &AzureDevOpsClientAbandonPullRequestFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.AbandonPullRequest")
+			},
+		},
+		AuthenticatorFunc: &AzureDevOpsClientAuthenticatorFunc{
+			defaultHook: func() auth.Authenticator {
+				panic("unexpected invocation of MockAzureDevOpsClient.Authenticator")
+			},
+		},
+		CompletePullRequestFunc: &AzureDevOpsClientCompletePullRequestFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.CompletePullRequest")
+			},
+		},
+		CreatePullRequestFunc: &AzureDevOpsClientCreatePullRequestFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.CreatePullRequest")
+			},
+		},
+		CreatePullRequestCommentThreadFunc: &AzureDevOpsClientCreatePullRequestCommentThreadFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCommentInput) (azuredevops.PullRequestCommentResponse, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.CreatePullRequestCommentThread")
+			},
+		},
+		ForkRepositoryFunc: &AzureDevOpsClientForkRepositoryFunc{
+			defaultHook: func(context.Context, string, azuredevops.ForkRepositoryInput) (azuredevops.Repository, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ForkRepository")
+			},
+		},
+		GetAuthorizedProfileFunc: &AzureDevOpsClientGetAuthorizedProfileFunc{
+			defaultHook: func(context.Context) (azuredevops.Profile, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.GetAuthorizedProfile")
+			},
+		},
+		GetPullRequestFunc: &AzureDevOpsClientGetPullRequestFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.GetPullRequest")
+			},
+		},
+		GetPullRequestStatusesFunc: &AzureDevOpsClientGetPullRequestStatusesFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.GetPullRequestStatuses")
+			},
+		},
+		GetRepoFunc: &AzureDevOpsClientGetRepoFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs) (azuredevops.Repository, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.GetRepo")
+			},
+		},
+		GetUserEntitlementFunc: &AzureDevOpsClientGetUserEntitlementFunc{
+			defaultHook: func(context.Context, string, string) (azuredevops.UserEntitlement, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.GetUserEntitlement")
+			},
+		},
+		ListIdentitiesByEmailFunc: &AzureDevOpsClientListIdentitiesByEmailFunc{
+			defaultHook: func(context.Context, string, string) ([]azuredevops.IdentityDetails, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListIdentitiesByEmail")
+			},
+		},
+		ListProjectsFunc: &AzureDevOpsClientListProjectsFunc{
+			defaultHook: func(context.Context, string) ([]azuredevops.Project, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListProjects")
+			},
+		},
+		ListPullRequestsFunc: &AzureDevOpsClientListPullRequestsFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListPullRequestsCriteria) ([]azuredevops.PullRequest, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListPullRequests")
+			},
+		},
+		ListRepositoriesByProjectOrOrgFunc: &AzureDevOpsClientListRepositoriesByProjectOrOrgFunc{
+			defaultHook: func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListRepositoriesByProjectOrOrg")
+			},
+		},
+		PingFunc: &AzureDevOpsClientPingFunc{
+			defaultHook: func(context.Context) error {
+				panic("unexpected invocation of MockAzureDevOpsClient.Ping")
+			},
+		},
+		ReactivatePullRequestFunc: &AzureDevOpsClientReactivatePullRequestFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ReactivatePullRequest")
+			},
+		},
+		UpdatePullRequestFunc: &AzureDevOpsClientUpdatePullRequestFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.UpdatePullRequest")
+			},
+		},
+		WithAuthenticatorFunc: &AzureDevOpsClientWithAuthenticatorFunc{
+			defaultHook: func(auth.Authenticator) azuredevops.Client {
+				panic("unexpected invocation of MockAzureDevOpsClient.WithAuthenticator")
+			},
+		},
+	}
+}
+
+// NewMockAzureDevOpsClientFrom creates a new mock of the
+// MockAzureDevOpsClient interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockAzureDevOpsClientFrom(i azuredevops.Client) *MockAzureDevOpsClient {
+	return &MockAzureDevOpsClient{
+		AbandonPullRequestFunc: &AzureDevOpsClientAbandonPullRequestFunc{
+			defaultHook: i.AbandonPullRequest,
+		},
+		AuthenticatorFunc: &AzureDevOpsClientAuthenticatorFunc{
+			defaultHook: i.Authenticator,
+		},
+		CompletePullRequestFunc: &AzureDevOpsClientCompletePullRequestFunc{
+			defaultHook: i.CompletePullRequest,
+		},
+		CreatePullRequestFunc: &AzureDevOpsClientCreatePullRequestFunc{
+			defaultHook: i.CreatePullRequest,
+		},
+		CreatePullRequestCommentThreadFunc: &AzureDevOpsClientCreatePullRequestCommentThreadFunc{
+			defaultHook: i.CreatePullRequestCommentThread,
+		},
+		ForkRepositoryFunc: &AzureDevOpsClientForkRepositoryFunc{
+			defaultHook: i.ForkRepository,
+		},
+		GetAuthorizedProfileFunc: &AzureDevOpsClientGetAuthorizedProfileFunc{
+			defaultHook: i.GetAuthorizedProfile,
+		},
+		GetPullRequestFunc: &AzureDevOpsClientGetPullRequestFunc{
+			defaultHook: i.GetPullRequest,
+		},
+		GetPullRequestStatusesFunc: &AzureDevOpsClientGetPullRequestStatusesFunc{
+			defaultHook: i.GetPullRequestStatuses,
+		},
+		GetRepoFunc: &AzureDevOpsClientGetRepoFunc{
+			defaultHook: i.GetRepo,
+		},
+		GetUserEntitlementFunc: &AzureDevOpsClientGetUserEntitlementFunc{
+			defaultHook: i.GetUserEntitlement,
+		},
+		ListIdentitiesByEmailFunc: &AzureDevOpsClientListIdentitiesByEmailFunc{
+			defaultHook: i.ListIdentitiesByEmail,
+		},
+		ListProjectsFunc: &AzureDevOpsClientListProjectsFunc{
+			defaultHook: i.ListProjects,
+		},
+		ListPullRequestsFunc: &AzureDevOpsClientListPullRequestsFunc{
+			defaultHook: i.ListPullRequests,
+		},
+		ListRepositoriesByProjectOrOrgFunc: &AzureDevOpsClientListRepositoriesByProjectOrOrgFunc{
+			defaultHook: i.ListRepositoriesByProjectOrOrg,
+		},
+		PingFunc: &AzureDevOpsClientPingFunc{
+			defaultHook: i.Ping,
+		},
+		ReactivatePullRequestFunc: &AzureDevOpsClientReactivatePullRequestFunc{
+			defaultHook: i.ReactivatePullRequest,
+		},
+		UpdatePullRequestFunc: &AzureDevOpsClientUpdatePullRequestFunc{
+			defaultHook: i.UpdatePullRequest,
+		},
+		WithAuthenticatorFunc: &AzureDevOpsClientWithAuthenticatorFunc{
+			defaultHook: i.WithAuthenticator,
+		},
+	}
+}
+
+// AzureDevOpsClientAbandonPullRequestFunc describes the behavior when the
+// AbandonPullRequest method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientAbandonPullRequestFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)
+	history     []AzureDevOpsClientAbandonPullRequestFuncCall
+	mutex       sync.Mutex
+}
+
+// AbandonPullRequest delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) AbandonPullRequest(v0 context.Context, v1 azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+	r0, r1 := m.AbandonPullRequestFunc.nextHook()(v0, v1)
+	m.AbandonPullRequestFunc.appendCall(AzureDevOpsClientAbandonPullRequestFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the AbandonPullRequest
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientAbandonPullRequestFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// AbandonPullRequest method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientAbandonPullRequestFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientAbandonPullRequestFunc) SetDefaultReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientAbandonPullRequestFunc) PushReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientAbandonPullRequestFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientAbandonPullRequestFunc) appendCall(r0 AzureDevOpsClientAbandonPullRequestFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientAbandonPullRequestFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientAbandonPullRequestFunc) History() []AzureDevOpsClientAbandonPullRequestFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientAbandonPullRequestFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientAbandonPullRequestFuncCall is an object that describes
+// an invocation of method AbandonPullRequest on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientAbandonPullRequestFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.PullRequest
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientAbandonPullRequestFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientAbandonPullRequestFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientAuthenticatorFunc describes the behavior when the
+// Authenticator method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientAuthenticatorFunc struct {
+	defaultHook func() auth.Authenticator
+	hooks       []func() auth.Authenticator
+	history     []AzureDevOpsClientAuthenticatorFuncCall
+	mutex       sync.Mutex
+}
+
+// Authenticator delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) Authenticator() auth.Authenticator {
+	r0 := m.AuthenticatorFunc.nextHook()()
+	m.AuthenticatorFunc.appendCall(AzureDevOpsClientAuthenticatorFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Authenticator method
+// of the parent MockAzureDevOpsClient instance is invoked and the hook
+// queue is empty.
+func (f *AzureDevOpsClientAuthenticatorFunc) SetDefaultHook(hook func() auth.Authenticator) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Authenticator method of the parent MockAzureDevOpsClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientAuthenticatorFunc) PushHook(hook func() auth.Authenticator) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientAuthenticatorFunc) SetDefaultReturn(r0 auth.Authenticator) {
+	f.SetDefaultHook(func() auth.Authenticator {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientAuthenticatorFunc) PushReturn(r0 auth.Authenticator) {
+	f.PushHook(func() auth.Authenticator {
+		return r0
+	})
+}
+
+func (f *AzureDevOpsClientAuthenticatorFunc) nextHook() func() auth.Authenticator {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientAuthenticatorFunc) appendCall(r0 AzureDevOpsClientAuthenticatorFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientAuthenticatorFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientAuthenticatorFunc) History() []AzureDevOpsClientAuthenticatorFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientAuthenticatorFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientAuthenticatorFuncCall is an object that describes an
+// invocation of method Authenticator on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientAuthenticatorFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 auth.Authenticator
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientAuthenticatorFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientAuthenticatorFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// AzureDevOpsClientCompletePullRequestFunc describes the behavior when the
+// CompletePullRequest method of the parent MockAzureDevOpsClient instance
+// is invoked.
+type AzureDevOpsClientCompletePullRequestFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error)
+	history     []AzureDevOpsClientCompletePullRequestFuncCall
+	mutex       sync.Mutex
+}
+
+// CompletePullRequest delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) CompletePullRequest(v0 context.Context, v1 azuredevops.PullRequestCommonArgs, v2 azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error) {
+	r0, r1 := m.CompletePullRequestFunc.nextHook()(v0, v1, v2)
+	m.CompletePullRequestFunc.appendCall(AzureDevOpsClientCompletePullRequestFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CompletePullRequest
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientCompletePullRequestFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CompletePullRequest method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientCompletePullRequestFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientCompletePullRequestFunc) SetDefaultReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientCompletePullRequestFunc) PushReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientCompletePullRequestFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientCompletePullRequestFunc) appendCall(r0 AzureDevOpsClientCompletePullRequestFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientCompletePullRequestFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientCompletePullRequestFunc) History() []AzureDevOpsClientCompletePullRequestFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientCompletePullRequestFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientCompletePullRequestFuncCall is an object that describes
+// an invocation of method CompletePullRequest on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientCompletePullRequestFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 azuredevops.PullRequestCompleteInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.PullRequest
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientCompletePullRequestFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientCompletePullRequestFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientCreatePullRequestFunc describes the behavior when the
+// CreatePullRequest method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientCreatePullRequestFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error)
+	history     []AzureDevOpsClientCreatePullRequestFuncCall
+	mutex       sync.Mutex
+}
+
+// CreatePullRequest delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) CreatePullRequest(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error) {
+	r0, r1 := m.CreatePullRequestFunc.nextHook()(v0, v1, v2)
+	m.CreatePullRequestFunc.appendCall(AzureDevOpsClientCreatePullRequestFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CreatePullRequest
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientCreatePullRequestFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreatePullRequest method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientCreatePullRequestFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientCreatePullRequestFunc) SetDefaultReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientCreatePullRequestFunc) PushReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientCreatePullRequestFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientCreatePullRequestFunc) appendCall(r0 AzureDevOpsClientCreatePullRequestFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientCreatePullRequestFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientCreatePullRequestFunc) History() []AzureDevOpsClientCreatePullRequestFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientCreatePullRequestFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientCreatePullRequestFuncCall is an object that describes an
+// invocation of method CreatePullRequest on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientCreatePullRequestFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 azuredevops.CreatePullRequestInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.PullRequest
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientCreatePullRequestFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientCreatePullRequestFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientCreatePullRequestCommentThreadFunc describes the
+// behavior when the CreatePullRequestCommentThread method of the parent
+// MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientCreatePullRequestCommentThreadFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCommentInput) (azuredevops.PullRequestCommentResponse, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCommentInput) (azuredevops.PullRequestCommentResponse, error)
+	history     []AzureDevOpsClientCreatePullRequestCommentThreadFuncCall
+	mutex       sync.Mutex
+}
+
+// CreatePullRequestCommentThread delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) CreatePullRequestCommentThread(v0 context.Context, v1 azuredevops.PullRequestCommonArgs, v2 azuredevops.PullRequestCommentInput) (azuredevops.PullRequestCommentResponse, error) {
+	r0, r1 := m.CreatePullRequestCommentThreadFunc.nextHook()(v0, v1, v2)
+	m.CreatePullRequestCommentThreadFunc.appendCall(AzureDevOpsClientCreatePullRequestCommentThreadFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// CreatePullRequestCommentThread method of the parent MockAzureDevOpsClient
+// instance is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientCreatePullRequestCommentThreadFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCommentInput) (azuredevops.PullRequestCommentResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreatePullRequestCommentThread method of the parent MockAzureDevOpsClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *AzureDevOpsClientCreatePullRequestCommentThreadFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCommentInput) (azuredevops.PullRequestCommentResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientCreatePullRequestCommentThreadFunc) SetDefaultReturn(r0 azuredevops.PullRequestCommentResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCommentInput) (azuredevops.PullRequestCommentResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientCreatePullRequestCommentThreadFunc) PushReturn(r0 azuredevops.PullRequestCommentResponse, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCommentInput) (azuredevops.PullRequestCommentResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientCreatePullRequestCommentThreadFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCommentInput) (azuredevops.PullRequestCommentResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientCreatePullRequestCommentThreadFunc) appendCall(r0 AzureDevOpsClientCreatePullRequestCommentThreadFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientCreatePullRequestCommentThreadFuncCall objects
+// describing the invocations of this function.
+func (f *AzureDevOpsClientCreatePullRequestCommentThreadFunc) History() []AzureDevOpsClientCreatePullRequestCommentThreadFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientCreatePullRequestCommentThreadFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientCreatePullRequestCommentThreadFuncCall is an object that
+// describes an invocation of method CreatePullRequestCommentThread on an
+// instance of MockAzureDevOpsClient.
+type AzureDevOpsClientCreatePullRequestCommentThreadFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 azuredevops.PullRequestCommentInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.PullRequestCommentResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientCreatePullRequestCommentThreadFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientCreatePullRequestCommentThreadFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientForkRepositoryFunc describes the behavior when the
+// ForkRepository method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientForkRepositoryFunc struct {
+	defaultHook func(context.Context, string, azuredevops.ForkRepositoryInput) (azuredevops.Repository, error)
+	hooks       []func(context.Context, string, azuredevops.ForkRepositoryInput) (azuredevops.Repository, error)
+	history     []AzureDevOpsClientForkRepositoryFuncCall
+	mutex       sync.Mutex
+}
+
+// ForkRepository delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ForkRepository(v0 context.Context, v1 string, v2 azuredevops.ForkRepositoryInput) (azuredevops.Repository, error) {
+	r0, r1 := m.ForkRepositoryFunc.nextHook()(v0, v1, v2)
+	m.ForkRepositoryFunc.appendCall(AzureDevOpsClientForkRepositoryFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ForkRepository
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientForkRepositoryFunc) SetDefaultHook(hook func(context.Context, string, azuredevops.ForkRepositoryInput) (azuredevops.Repository, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ForkRepository method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientForkRepositoryFunc) PushHook(hook func(context.Context, string, azuredevops.ForkRepositoryInput) (azuredevops.Repository, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientForkRepositoryFunc) SetDefaultReturn(r0 azuredevops.Repository, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, azuredevops.ForkRepositoryInput) (azuredevops.Repository, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientForkRepositoryFunc) PushReturn(r0 azuredevops.Repository, r1 error) {
+	f.PushHook(func(context.Context, string, azuredevops.ForkRepositoryInput) (azuredevops.Repository, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientForkRepositoryFunc) nextHook() func(context.Context, string, azuredevops.ForkRepositoryInput) (azuredevops.Repository, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientForkRepositoryFunc) appendCall(r0 AzureDevOpsClientForkRepositoryFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientForkRepositoryFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientForkRepositoryFunc) History() []AzureDevOpsClientForkRepositoryFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientForkRepositoryFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientForkRepositoryFuncCall is an object that describes an
+// invocation of method ForkRepository on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientForkRepositoryFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 azuredevops.ForkRepositoryInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.Repository
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientForkRepositoryFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientForkRepositoryFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetAuthorizedProfileFunc describes the behavior when the
+// GetAuthorizedProfile method of the parent MockAzureDevOpsClient instance
+// is invoked.
+type AzureDevOpsClientGetAuthorizedProfileFunc struct {
+	defaultHook func(context.Context) (azuredevops.Profile, error)
+	hooks       []func(context.Context) (azuredevops.Profile, error)
+	history     []AzureDevOpsClientGetAuthorizedProfileFuncCall
+	mutex       sync.Mutex
+}
+
+// GetAuthorizedProfile delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetAuthorizedProfile(v0 context.Context) (azuredevops.Profile, error) {
+	r0, r1 := m.GetAuthorizedProfileFunc.nextHook()(v0)
+	m.GetAuthorizedProfileFunc.appendCall(AzureDevOpsClientGetAuthorizedProfileFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetAuthorizedProfile
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientGetAuthorizedProfileFunc) SetDefaultHook(hook func(context.Context) (azuredevops.Profile, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetAuthorizedProfile method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientGetAuthorizedProfileFunc) PushHook(hook func(context.Context) (azuredevops.Profile, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetAuthorizedProfileFunc) SetDefaultReturn(r0 azuredevops.Profile, r1 error) {
+	f.SetDefaultHook(func(context.Context) (azuredevops.Profile, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetAuthorizedProfileFunc) PushReturn(r0 azuredevops.Profile, r1 error) {
+	f.PushHook(func(context.Context) (azuredevops.Profile, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientGetAuthorizedProfileFunc) nextHook() func(context.Context) (azuredevops.Profile, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetAuthorizedProfileFunc) appendCall(r0 AzureDevOpsClientGetAuthorizedProfileFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientGetAuthorizedProfileFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientGetAuthorizedProfileFunc) History() []AzureDevOpsClientGetAuthorizedProfileFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetAuthorizedProfileFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetAuthorizedProfileFuncCall is an object that describes
+// an invocation of method GetAuthorizedProfile on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientGetAuthorizedProfileFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.Profile
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetAuthorizedProfileFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetAuthorizedProfileFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetPullRequestFunc describes the behavior when the
+// GetPullRequest method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientGetPullRequestFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)
+	history     []AzureDevOpsClientGetPullRequestFuncCall
+	mutex       sync.Mutex
+}
+
+// GetPullRequest delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetPullRequest(v0 context.Context, v1 azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+	r0, r1 := m.GetPullRequestFunc.nextHook()(v0, v1)
+	m.GetPullRequestFunc.appendCall(AzureDevOpsClientGetPullRequestFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetPullRequest
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientGetPullRequestFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetPullRequest method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientGetPullRequestFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetPullRequestFunc) SetDefaultReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetPullRequestFunc) PushReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientGetPullRequestFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetPullRequestFunc) appendCall(r0 AzureDevOpsClientGetPullRequestFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientGetPullRequestFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientGetPullRequestFunc) History() []AzureDevOpsClientGetPullRequestFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetPullRequestFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetPullRequestFuncCall is an object that describes an
+// invocation of method GetPullRequest on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientGetPullRequestFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.PullRequest
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetPullRequestFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetPullRequestFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetPullRequestStatusesFunc describes the behavior when
+// the GetPullRequestStatuses method of the parent MockAzureDevOpsClient
+// instance is invoked.
+type AzureDevOpsClientGetPullRequestStatusesFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error)
+	history     []AzureDevOpsClientGetPullRequestStatusesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetPullRequestStatuses delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetPullRequestStatuses(v0 context.Context, v1 azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error) {
+	r0, r1 := m.GetPullRequestStatusesFunc.nextHook()(v0, v1)
+	m.GetPullRequestStatusesFunc.appendCall(AzureDevOpsClientGetPullRequestStatusesFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetPullRequestStatuses method of the parent MockAzureDevOpsClient
+// instance is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientGetPullRequestStatusesFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetPullRequestStatuses method of the parent MockAzureDevOpsClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *AzureDevOpsClientGetPullRequestStatusesFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetPullRequestStatusesFunc) SetDefaultReturn(r0 []azuredevops.PullRequestBuildStatus, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetPullRequestStatusesFunc) PushReturn(r0 []azuredevops.PullRequestBuildStatus, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientGetPullRequestStatusesFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetPullRequestStatusesFunc) appendCall(r0 AzureDevOpsClientGetPullRequestStatusesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientGetPullRequestStatusesFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientGetPullRequestStatusesFunc) History() []AzureDevOpsClientGetPullRequestStatusesFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetPullRequestStatusesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetPullRequestStatusesFuncCall is an object that
+// describes an invocation of method GetPullRequestStatuses on an instance
+// of MockAzureDevOpsClient.
+type AzureDevOpsClientGetPullRequestStatusesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.PullRequestBuildStatus
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetPullRequestStatusesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetPullRequestStatusesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetRepoFunc describes the behavior when the GetRepo
+// method of the parent MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientGetRepoFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs) (azuredevops.Repository, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs) (azuredevops.Repository, error)
+	history     []AzureDevOpsClientGetRepoFuncCall
+	mutex       sync.Mutex
+}
+
+// GetRepo delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetRepo(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs) (azuredevops.Repository, error) {
+	r0, r1 := m.GetRepoFunc.nextHook()(v0, v1)
+	m.GetRepoFunc.appendCall(AzureDevOpsClientGetRepoFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetRepo method of
+// the parent MockAzureDevOpsClient instance is invoked and the hook queue
+// is empty.
+func (f *AzureDevOpsClientGetRepoFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs) (azuredevops.Repository, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetRepo method of the parent MockAzureDevOpsClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientGetRepoFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs) (azuredevops.Repository, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetRepoFunc) SetDefaultReturn(r0 azuredevops.Repository, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs) (azuredevops.Repository, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetRepoFunc) PushReturn(r0 azuredevops.Repository, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs) (azuredevops.Repository, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientGetRepoFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs) (azuredevops.Repository, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetRepoFunc) appendCall(r0 AzureDevOpsClientGetRepoFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientGetRepoFuncCall objects
+// describing the invocations of this function.
+func (f *AzureDevOpsClientGetRepoFunc) History() []AzureDevOpsClientGetRepoFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetRepoFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetRepoFuncCall is an object that describes an
+// invocation of method GetRepo on an instance of MockAzureDevOpsClient.
+type AzureDevOpsClientGetRepoFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.Repository
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetRepoFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetRepoFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetUserEntitlementFunc describes the behavior when the
+// GetUserEntitlement method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientGetUserEntitlementFunc struct {
+	defaultHook func(context.Context, string, string) (azuredevops.UserEntitlement, error)
+	hooks       []func(context.Context, string, string) (azuredevops.UserEntitlement, error)
+	history     []AzureDevOpsClientGetUserEntitlementFuncCall
+	mutex       sync.Mutex
+}
+
+// GetUserEntitlement delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetUserEntitlement(v0 context.Context, v1 string, v2 string) (azuredevops.UserEntitlement, error) {
+	r0, r1 := m.GetUserEntitlementFunc.nextHook()(v0, v1, v2)
+	m.GetUserEntitlementFunc.appendCall(AzureDevOpsClientGetUserEntitlementFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetUserEntitlement
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientGetUserEntitlementFunc) SetDefaultHook(hook func(context.Context, string, string) (azuredevops.UserEntitlement, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetUserEntitlement method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientGetUserEntitlementFunc) PushHook(hook func(context.Context, string, string) (azuredevops.UserEntitlement, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetUserEntitlementFunc) SetDefaultReturn(r0 azuredevops.UserEntitlement, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string) (azuredevops.UserEntitlement, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetUserEntitlementFunc) PushReturn(r0 azuredevops.UserEntitlement, r1 error) {
+	f.PushHook(func(context.Context, string, string) (azuredevops.UserEntitlement, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientGetUserEntitlementFunc) nextHook() func(context.Context, string, string) (azuredevops.UserEntitlement, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetUserEntitlementFunc) appendCall(r0 AzureDevOpsClientGetUserEntitlementFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientGetUserEntitlementFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientGetUserEntitlementFunc) History() []AzureDevOpsClientGetUserEntitlementFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetUserEntitlementFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetUserEntitlementFuncCall is an object that describes
+// an invocation of method GetUserEntitlement on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientGetUserEntitlementFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.UserEntitlement
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetUserEntitlementFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetUserEntitlementFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientListIdentitiesByEmailFunc describes the behavior when
+// the ListIdentitiesByEmail method of the parent MockAzureDevOpsClient
+// instance is invoked.
+type AzureDevOpsClientListIdentitiesByEmailFunc struct {
+	defaultHook func(context.Context, string, string) ([]azuredevops.IdentityDetails, error)
+	hooks       []func(context.Context, string, string) ([]azuredevops.IdentityDetails, error)
+	history     []AzureDevOpsClientListIdentitiesByEmailFuncCall
+	mutex       sync.Mutex
+}
+
+// ListIdentitiesByEmail delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListIdentitiesByEmail(v0 context.Context, v1 string, v2 string) ([]azuredevops.IdentityDetails, error) {
+	r0, r1 := m.ListIdentitiesByEmailFunc.nextHook()(v0, v1, v2)
+	m.ListIdentitiesByEmailFunc.appendCall(AzureDevOpsClientListIdentitiesByEmailFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListIdentitiesByEmail method of the parent MockAzureDevOpsClient instance
+// is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientListIdentitiesByEmailFunc) SetDefaultHook(hook func(context.Context, string, string) ([]azuredevops.IdentityDetails, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListIdentitiesByEmail method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientListIdentitiesByEmailFunc) PushHook(hook func(context.Context, string, string) ([]azuredevops.IdentityDetails, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListIdentitiesByEmailFunc) SetDefaultReturn(r0 []azuredevops.IdentityDetails, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string) ([]azuredevops.IdentityDetails, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListIdentitiesByEmailFunc) PushReturn(r0 []azuredevops.IdentityDetails, r1 error) {
+	f.PushHook(func(context.Context, string, string) ([]azuredevops.IdentityDetails, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListIdentitiesByEmailFunc) nextHook() func(context.Context, string, string) ([]azuredevops.IdentityDetails, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListIdentitiesByEmailFunc) appendCall(r0 AzureDevOpsClientListIdentitiesByEmailFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientListIdentitiesByEmailFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientListIdentitiesByEmailFunc) History() []AzureDevOpsClientListIdentitiesByEmailFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListIdentitiesByEmailFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListIdentitiesByEmailFuncCall is an object that
+// describes an invocation of method ListIdentitiesByEmail on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientListIdentitiesByEmailFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.IdentityDetails
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListIdentitiesByEmailFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListIdentitiesByEmailFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientListProjectsFunc describes the behavior when the
+// ListProjects method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientListProjectsFunc struct {
+	defaultHook func(context.Context, string) ([]azuredevops.Project, error)
+	hooks       []func(context.Context, string) ([]azuredevops.Project, error)
+	history     []AzureDevOpsClientListProjectsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListProjects delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListProjects(v0 context.Context, v1 string) ([]azuredevops.Project, error) {
+	r0, r1 := m.ListProjectsFunc.nextHook()(v0, v1)
+	m.ListProjectsFunc.appendCall(AzureDevOpsClientListProjectsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListProjects method
+// of the parent MockAzureDevOpsClient instance is invoked and the hook
+// queue is empty.
+func (f *AzureDevOpsClientListProjectsFunc) SetDefaultHook(hook func(context.Context, string) ([]azuredevops.Project, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListProjects method of the parent MockAzureDevOpsClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientListProjectsFunc) PushHook(hook func(context.Context, string) ([]azuredevops.Project, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListProjectsFunc) SetDefaultReturn(r0 []azuredevops.Project, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) ([]azuredevops.Project, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListProjectsFunc) PushReturn(r0 []azuredevops.Project, r1 error) {
+	f.PushHook(func(context.Context, string) ([]azuredevops.Project, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListProjectsFunc) nextHook() func(context.Context, string) ([]azuredevops.Project, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListProjectsFunc) appendCall(r0 AzureDevOpsClientListProjectsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientListProjectsFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientListProjectsFunc) History() []AzureDevOpsClientListProjectsFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListProjectsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListProjectsFuncCall is an object that describes an
+// invocation of method ListProjects on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientListProjectsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.Project
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListProjectsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListProjectsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientListPullRequestsFunc describes the behavior when the
+// ListPullRequests method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientListPullRequestsFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListPullRequestsCriteria) ([]azuredevops.PullRequest, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListPullRequestsCriteria) ([]azuredevops.PullRequest, error)
+	history     []AzureDevOpsClientListPullRequestsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListPullRequests delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListPullRequests(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 azuredevops.ListPullRequestsCriteria) ([]azuredevops.PullRequest, error) {
+	r0, r1 := m.ListPullRequestsFunc.nextHook()(v0, v1, v2)
+	m.ListPullRequestsFunc.appendCall(AzureDevOpsClientListPullRequestsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListPullRequests
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientListPullRequestsFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListPullRequestsCriteria) ([]azuredevops.PullRequest, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListPullRequests method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientListPullRequestsFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListPullRequestsCriteria) ([]azuredevops.PullRequest, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListPullRequestsFunc) SetDefaultReturn(r0 []azuredevops.PullRequest, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListPullRequestsCriteria) ([]azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListPullRequestsFunc) PushReturn(r0 []azuredevops.PullRequest, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListPullRequestsCriteria) ([]azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListPullRequestsFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListPullRequestsCriteria) ([]azuredevops.PullRequest, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListPullRequestsFunc) appendCall(r0 AzureDevOpsClientListPullRequestsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientListPullRequestsFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientListPullRequestsFunc) History() []AzureDevOpsClientListPullRequestsFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListPullRequestsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListPullRequestsFuncCall is an object that describes an
+// invocation of method ListPullRequests on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientListPullRequestsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 azuredevops.ListPullRequestsCriteria
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.PullRequest
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListPullRequestsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListPullRequestsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientListRepositoriesByProjectOrOrgFunc describes the
+// behavior when the ListRepositoriesByProjectOrOrg method of the parent
+// MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientListRepositoriesByProjectOrOrgFunc struct {
+	defaultHook func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error)
+	hooks       []func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error)
+	history     []AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall
+	mutex       sync.Mutex
+}
+
+// ListRepositoriesByProjectOrOrg delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListRepositoriesByProjectOrOrg(v0 context.Context, v1 azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error) {
+	r0, r1 := m.ListRepositoriesByProjectOrOrgFunc.nextHook()(v0, v1)
+	m.ListRepositoriesByProjectOrOrgFunc.appendCall(AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListRepositoriesByProjectOrOrg method of the parent MockAzureDevOpsClient
+// instance is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientListRepositoriesByProjectOrOrgFunc) SetDefaultHook(hook func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListRepositoriesByProjectOrOrg method of the parent MockAzureDevOpsClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *AzureDevOpsClientListRepositoriesByProjectOrOrgFunc) PushHook(hook func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListRepositoriesByProjectOrOrgFunc) SetDefaultReturn(r0 []azuredevops.Repository, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListRepositoriesByProjectOrOrgFunc) PushReturn(r0 []azuredevops.Repository, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListRepositoriesByProjectOrOrgFunc) nextHook() func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListRepositoriesByProjectOrOrgFunc) appendCall(r0 AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall objects
+// describing the invocations of this function.
+func (f *AzureDevOpsClientListRepositoriesByProjectOrOrgFunc) History() []AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall is an object that
+// describes an invocation of method ListRepositoriesByProjectOrOrg on an
+// instance of MockAzureDevOpsClient.
+type AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.ListRepositoriesByProjectOrOrgArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.Repository
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientPingFunc describes the behavior when the Ping method of
+// the parent MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientPingFunc struct {
+	defaultHook func(context.Context) error
+	hooks       []func(context.Context) error
+	history     []AzureDevOpsClientPingFuncCall
+	mutex       sync.Mutex
+}
+
+// Ping delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) Ping(v0 context.Context) error {
+	r0 := m.PingFunc.nextHook()(v0)
+	m.PingFunc.appendCall(AzureDevOpsClientPingFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Ping method of the
+// parent MockAzureDevOpsClient instance is invoked and the hook queue is
+// empty.
+func (f *AzureDevOpsClientPingFunc) SetDefaultHook(hook func(context.Context) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Ping method of the parent MockAzureDevOpsClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *AzureDevOpsClientPingFunc) PushHook(hook func(context.Context) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientPingFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientPingFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context) error {
+		return r0
+	})
+}
+
+func (f *AzureDevOpsClientPingFunc) nextHook() func(context.Context) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientPingFunc) appendCall(r0 AzureDevOpsClientPingFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientPingFuncCall objects
+// describing the invocations of this function.
+func (f *AzureDevOpsClientPingFunc) History() []AzureDevOpsClientPingFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientPingFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientPingFuncCall is an object that describes an invocation
+// of method Ping on an instance of MockAzureDevOpsClient.
+type AzureDevOpsClientPingFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientPingFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientPingFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// AzureDevOpsClientReactivatePullRequestFunc describes the behavior when
+// the ReactivatePullRequest method of the parent MockAzureDevOpsClient
+// instance is invoked.
+type AzureDevOpsClientReactivatePullRequestFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)
+	history     []AzureDevOpsClientReactivatePullRequestFuncCall
+	mutex       sync.Mutex
+}
+
+// ReactivatePullRequest delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ReactivatePullRequest(v0 context.Context, v1 azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+	r0, r1 := m.ReactivatePullRequestFunc.nextHook()(v0, v1)
+	m.ReactivatePullRequestFunc.appendCall(AzureDevOpsClientReactivatePullRequestFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ReactivatePullRequest method of the parent MockAzureDevOpsClient instance
+// is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientReactivatePullRequestFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ReactivatePullRequest method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientReactivatePullRequestFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientReactivatePullRequestFunc) SetDefaultReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientReactivatePullRequestFunc) PushReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientReactivatePullRequestFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientReactivatePullRequestFunc) appendCall(r0 AzureDevOpsClientReactivatePullRequestFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientReactivatePullRequestFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientReactivatePullRequestFunc) History() []AzureDevOpsClientReactivatePullRequestFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientReactivatePullRequestFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientReactivatePullRequestFuncCall is an object that
+// describes an invocation of method ReactivatePullRequest on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientReactivatePullRequestFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.PullRequest
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientReactivatePullRequestFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientReactivatePullRequestFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientUpdatePullRequestFunc describes the behavior when the
+// UpdatePullRequest method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientUpdatePullRequestFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error)
+	history     []AzureDevOpsClientUpdatePullRequestFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdatePullRequest delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) UpdatePullRequest(v0 context.Context, v1 azuredevops.PullRequestCommonArgs, v2 azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error) {
+	r0, r1 := m.UpdatePullRequestFunc.nextHook()(v0, v1, v2)
+	m.UpdatePullRequestFunc.appendCall(AzureDevOpsClientUpdatePullRequestFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the UpdatePullRequest
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientUpdatePullRequestFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdatePullRequest method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientUpdatePullRequestFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientUpdatePullRequestFunc) SetDefaultReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientUpdatePullRequestFunc) PushReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientUpdatePullRequestFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientUpdatePullRequestFunc) appendCall(r0 AzureDevOpsClientUpdatePullRequestFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientUpdatePullRequestFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientUpdatePullRequestFunc) History() []AzureDevOpsClientUpdatePullRequestFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientUpdatePullRequestFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientUpdatePullRequestFuncCall is an object that describes an
+// invocation of method UpdatePullRequest on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientUpdatePullRequestFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 azuredevops.PullRequestUpdateInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.PullRequest
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientUpdatePullRequestFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientUpdatePullRequestFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientWithAuthenticatorFunc describes the behavior when the
+// WithAuthenticator method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientWithAuthenticatorFunc struct {
+	defaultHook func(auth.Authenticator) azuredevops.Client
+	hooks       []func(auth.Authenticator) azuredevops.Client
+	history     []AzureDevOpsClientWithAuthenticatorFuncCall
+	mutex       sync.Mutex
+}
+
+// WithAuthenticator delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) WithAuthenticator(v0 auth.Authenticator) azuredevops.Client {
+	r0 := m.WithAuthenticatorFunc.nextHook()(v0)
+	m.WithAuthenticatorFunc.appendCall(AzureDevOpsClientWithAuthenticatorFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the WithAuthenticator
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientWithAuthenticatorFunc) SetDefaultHook(hook func(auth.Authenticator) azuredevops.Client) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// WithAuthenticator method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientWithAuthenticatorFunc) PushHook(hook func(auth.Authenticator) azuredevops.Client) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientWithAuthenticatorFunc) SetDefaultReturn(r0 azuredevops.Client) {
+	f.SetDefaultHook(func(auth.Authenticator) azuredevops.Client {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientWithAuthenticatorFunc) PushReturn(r0 azuredevops.Client) {
+	f.PushHook(func(auth.Authenticator) azuredevops.Client {
+		return r0
+	})
+}
+
+func (f *AzureDevOpsClientWithAuthenticatorFunc) nextHook() func(auth.Authenticator) azuredevops.Client {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientWithAuthenticatorFunc) appendCall(r0 AzureDevOpsClientWithAuthenticatorFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientWithAuthenticatorFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientWithAuthenticatorFunc) History() []AzureDevOpsClientWithAuthenticatorFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientWithAuthenticatorFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientWithAuthenticatorFuncCall is an object that describes an
+// invocation of method WithAuthenticator on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientWithAuthenticatorFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 auth.Authenticator
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.Client
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientWithAuthenticatorFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientWithAuthenticatorFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// MockGerritClient is a mock implementation of the Client interface (from
+// the package github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit)
+// used for unit testing.
+type MockGerritClient struct {
+	// AbandonChangeFunc is an instance of a mock function object
+	// controlling the behavior of the method AbandonChange.
+	AbandonChangeFunc *GerritClientAbandonChangeFunc
+	// AuthenticatorFunc is an instance of a mock function object
+	// controlling the behavior of the method Authenticator.
+	AuthenticatorFunc *GerritClientAuthenticatorFunc
+	// GetAuthenticatedUserAccountFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// GetAuthenticatedUserAccount.
+	GetAuthenticatedUserAccountFunc *GerritClientGetAuthenticatedUserAccountFunc
+	// GetChangeFunc is an instance of a mock function object controlling
+	// the behavior of the method GetChange.
+	GetChangeFunc *GerritClientGetChangeFunc
+	// GetGroupFunc is an instance of a mock function object controlling the
+	// behavior of the method GetGroup.
+	GetGroupFunc *GerritClientGetGroupFunc
+	// GetURLFunc is an instance of a mock function object controlling the
+	// behavior of the method GetURL.
+	GetURLFunc *GerritClientGetURLFunc
+	// ListAccountsByEmailFunc is an instance of a mock function object
+	// controlling the behavior of the method ListAccountsByEmail.
+	ListAccountsByEmailFunc *GerritClientListAccountsByEmailFunc
+	// ListAccountsByUsernameFunc is an instance of a mock function object
+	// controlling the behavior of the method ListAccountsByUsername.
+	ListAccountsByUsernameFunc *GerritClientListAccountsByUsernameFunc
+	// ListProjectsFunc is an instance of a mock function object controlling
+	// the behavior of the method ListProjects.
+	ListProjectsFunc *GerritClientListProjectsFunc
+	// MoveChangeFunc is an instance of a mock function object controlling
+	// the behavior of the method MoveChange.
+	MoveChangeFunc *GerritClientMoveChangeFunc
+	// RestoreChangeFunc is an instance of a mock function object
+	// controlling the behavior of the method RestoreChange.
+	RestoreChangeFunc *GerritClientRestoreChangeFunc
+	// SetCommitMessageFunc is an instance of a mock function object
+	// controlling the behavior of the method SetCommitMessage.
+	SetCommitMessageFunc *GerritClientSetCommitMessageFunc
+	// SetReadyForReviewFunc is an instance of a mock function object
+	// controlling the behavior of the method SetReadyForReview.
+	SetReadyForReviewFunc *GerritClientSetReadyForReviewFunc
+	// SetWIPFunc is an instance of a mock function object controlling the
+	// behavior of the method SetWIP.
+	SetWIPFunc *GerritClientSetWIPFunc
+	// SubmitChangeFunc is an instance of a mock function object controlling
+	// the behavior of the method SubmitChange.
+	SubmitChangeFunc *GerritClientSubmitChangeFunc
+	// WithAuthenticatorFunc is an instance of a mock function object
+	// controlling the behavior of the method WithAuthenticator.
+	WithAuthenticatorFunc *GerritClientWithAuthenticatorFunc
+	// WriteReviewCommentFunc is an instance of a mock function object
+	// controlling the behavior of the method WriteReviewComment.
+	WriteReviewCommentFunc *GerritClientWriteReviewCommentFunc
+}
+
+// NewMockGerritClient creates a new mock of the Client interface. All
+// methods return zero values for all results, unless overwritten.
+func NewMockGerritClient() *MockGerritClient {
+	return &MockGerritClient{
+		AbandonChangeFunc: &GerritClientAbandonChangeFunc{
+			defaultHook: func(context.Context, string) (r0 *gerrit.Change, r1 error) {
+				return
+			},
+		},
+		AuthenticatorFunc: &GerritClientAuthenticatorFunc{
+			defaultHook: func() (r0 auth.Authenticator) {
+				return
+			},
+		},
+		GetAuthenticatedUserAccountFunc: &GerritClientGetAuthenticatedUserAccountFunc{
+			defaultHook: func(context.Context) (r0 *gerrit.Account, r1 error) {
+				return
+			},
+		},
+		GetChangeFunc: &GerritClientGetChangeFunc{
+			defaultHook: func(context.Context, string) (r0 *gerrit.Change, r1 error) {
+				return
+			},
+		},
+		GetGroupFunc: &GerritClientGetGroupFunc{
+			defaultHook: func(context.Context, string) (r0 gerrit.Group, r1 error) {
+				return
+			},
+		},
+		GetURLFunc: &GerritClientGetURLFunc{
+			defaultHook: func() (r0 *url.URL) {
+				return
+			},
+		},
+		ListAccountsByEmailFunc: &GerritClientListAccountsByEmailFunc{
+			defaultHook: func(context.Context, string) (r0 gerrit.ListAccountsResponse, r1 error) {
+				return
+			},
+		},
+		ListAccountsByUsernameFunc: &GerritClientListAccountsByUsernameFunc{
+			defaultHook: func(context.Context, string) (r0 gerrit.ListAccountsResponse, r1 error) {
+				return
+			},
+		},
+		ListProjectsFunc: &GerritClientListProjectsFunc{
+			defaultHook: func(context.Context, gerrit.ListProjectsArgs) (r0 *gerrit.ListProjectsResponse, r1 bool, r2 error) {
+				return
+			},
+		},
+		MoveChangeFunc: &GerritClientMoveChangeFunc{
+			defaultHook: func(context.Context, string, gerrit.MoveChangeInput) (r0 *gerrit.Change, r1 error) {
+				return
+			},
+		},
+		RestoreChangeFunc: &GerritClientRestoreChangeFunc{
+			defaultHook: func(context.Context, string) (r0 *gerrit.Change, r1 error) {
+				return
+			},
+		},
+		SetCommitMessageFunc: &GerritClientSetCommitMessageFunc{
+			defaultHook: func(context.Context, string, gerrit.CommitMessageInput) (r0 error) {
+				return
+			},
+		},
+		SetReadyForReviewFunc: &GerritClientSetReadyForReviewFunc{
+			defaultHook: func(context.Context, string) (r0 error) {
+				return
+			},
+		},
+		SetWIPFunc: &GerritClientSetWIPFunc{
+			defaultHook: func(context.Context, string) (r0 error) {
+				return
+			},
+		},
+		SubmitChangeFunc: &GerritClientSubmitChangeFunc{
+			defaultHook: func(context.Context, string) (r0 *gerrit.Change, r1 error) {
+				return
+			},
+		},
+		WithAuthenticatorFunc: &GerritClientWithAuthenticatorFunc{
+			defaultHook: func(auth.Authenticator) (r0 gerrit.Client) {
+				return
+			},
+		},
+		WriteReviewCommentFunc: &GerritClientWriteReviewCommentFunc{
+			defaultHook: func(context.Context, string, gerrit.ReviewInput) (r0 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockGerritClient creates a new mock of the Client interface. All
+// methods panic on invocation, unless overwritten.
+func NewStrictMockGerritClient() *MockGerritClient {
+	return &MockGerritClient{
+		AbandonChangeFunc: &GerritClientAbandonChangeFunc{
+			defaultHook: func(context.Context, string) (*gerrit.Change, error) {
+				panic("unexpected invocation of MockGerritClient.AbandonChange")
+			},
+		},
+		AuthenticatorFunc: &GerritClientAuthenticatorFunc{
+			defaultHook: func() auth.Authenticator {
+				panic("unexpected invocation of MockGerritClient.Authenticator")
+			},
+		},
+		GetAuthenticatedUserAccountFunc: &GerritClientGetAuthenticatedUserAccountFunc{
+			defaultHook: func(context.Context) (*gerrit.Account, error) {
+				panic("unexpected invocation of MockGerritClient.GetAuthenticatedUserAccount")
+			},
+		},
+		GetChangeFunc: &GerritClientGetChangeFunc{
+			defaultHook: func(context.Context, string) (*gerrit.Change, error) {
+				panic("unexpected invocation of MockGerritClient.GetChange")
+			},
+		},
+		GetGroupFunc: &GerritClientGetGroupFunc{
+			defaultHook: func(context.Context, string) (gerrit.Group, error) {
+				panic("unexpected invocation of MockGerritClient.GetGroup")
+			},
+		},
+		GetURLFunc: &GerritClientGetURLFunc{
+			defaultHook: func() *url.URL {
+				panic("unexpected invocation of MockGerritClient.GetURL")
+			},
+		},
+		ListAccountsByEmailFunc: &GerritClientListAccountsByEmailFunc{
+			defaultHook: func(context.Context, string) (gerrit.ListAccountsResponse, error) {
+				panic("unexpected invocation of MockGerritClient.ListAccountsByEmail")
+			},
+		},
+		ListAccountsByUsernameFunc: &GerritClientListAccountsByUsernameFunc{
+			defaultHook: func(context.Context, string) (gerrit.ListAccountsResponse, error) {
+				panic("unexpected invocation of MockGerritClient.ListAccountsByUsername")
+			},
+		},
+		ListProjectsFunc: &GerritClientListProjectsFunc{
+			defaultHook: func(context.Context, gerrit.ListProjectsArgs) (*gerrit.ListProjectsResponse, bool, error) {
+				panic("unexpected invocation of MockGerritClient.ListProjects")
+			},
+		},
+		MoveChangeFunc: &GerritClientMoveChangeFunc{
+			defaultHook: func(context.Context, string, gerrit.MoveChangeInput) (*gerrit.Change, error) {
+				panic("unexpected invocation of MockGerritClient.MoveChange")
+			},
+		},
+		RestoreChangeFunc: &GerritClientRestoreChangeFunc{
+			defaultHook: func(context.Context, string) (*gerrit.Change, error) {
+				panic("unexpected invocation of MockGerritClient.RestoreChange")
+			},
+		},
+		SetCommitMessageFunc: &GerritClientSetCommitMessageFunc{
+			defaultHook: func(context.Context, string, gerrit.CommitMessageInput) error {
+				panic("unexpected invocation of MockGerritClient.SetCommitMessage")
+			},
+		},
+		SetReadyForReviewFunc: &GerritClientSetReadyForReviewFunc{
+			defaultHook: func(context.Context, string) error {
+				panic("unexpected invocation of MockGerritClient.SetReadyForReview")
+			},
+		},
+		SetWIPFunc: &GerritClientSetWIPFunc{
+			defaultHook: func(context.Context, string) error {
+				panic("unexpected invocation of MockGerritClient.SetWIP")
+			},
+		},
+		SubmitChangeFunc: &GerritClientSubmitChangeFunc{
+			defaultHook: func(context.Context, string) (*gerrit.Change, error) {
+				panic("unexpected invocation of MockGerritClient.SubmitChange")
+			},
+		},
+		WithAuthenticatorFunc: &GerritClientWithAuthenticatorFunc{
+			defaultHook: func(auth.Authenticator) gerrit.Client {
+				panic("unexpected invocation of MockGerritClient.WithAuthenticator")
+			},
+		},
+		WriteReviewCommentFunc: &GerritClientWriteReviewCommentFunc{
+			defaultHook: func(context.Context, string, gerrit.ReviewInput) error {
+				panic("unexpected invocation of MockGerritClient.WriteReviewComment")
+			},
+		},
+	}
+}
+
+// NewMockGerritClientFrom creates a new mock of the MockGerritClient
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockGerritClientFrom(i gerrit.Client) *MockGerritClient {
+	return &MockGerritClient{
+		AbandonChangeFunc: &GerritClientAbandonChangeFunc{
+			defaultHook: i.AbandonChange,
+		},
+		AuthenticatorFunc: &GerritClientAuthenticatorFunc{
+			defaultHook: i.Authenticator,
+		},
+		GetAuthenticatedUserAccountFunc: &GerritClientGetAuthenticatedUserAccountFunc{
+			defaultHook: i.GetAuthenticatedUserAccount,
+		},
+		GetChangeFunc: &GerritClientGetChangeFunc{
+			defaultHook: i.GetChange,
+		},
+		GetGroupFunc: &GerritClientGetGroupFunc{
+			defaultHook: i.GetGroup,
+		},
+		GetURLFunc: &GerritClientGetURLFunc{
+			defaultHook: i.GetURL,
+		},
+		ListAccountsByEmailFunc: &GerritClientListAccountsByEmailFunc{
+			defaultHook: i.ListAccountsByEmail,
+		},
+		ListAccountsByUsernameFunc: &GerritClientListAccountsByUsernameFunc{
+			defaultHook: i.ListAccountsByUsername,
+		},
+		ListProjectsFunc: &GerritClientListProjectsFunc{
+			defaultHook: i.ListProjects,
+		},
+		MoveChangeFunc: &GerritClientMoveChangeFunc{
+			defaultHook: i.MoveChange,
+		},
+		RestoreChangeFunc: &GerritClientRestoreChangeFunc{
+			defaultHook: i.RestoreChange,
+		},
+		SetCommitMessageFunc: &GerritClientSetCommitMessageFunc{
+			defaultHook: i.SetCommitMessage,
+		},
+		SetReadyForReviewFunc: &GerritClientSetReadyForReviewFunc{
+			defaultHook: i.SetReadyForReview,
+		},
+		SetWIPFunc: &GerritClientSetWIPFunc{
+			defaultHook: i.SetWIP,
+		},
+		SubmitChangeFunc: &GerritClientSubmitChangeFunc{
+			defaultHook: i.SubmitChange,
+		},
+		WithAuthenticatorFunc: &GerritClientWithAuthenticatorFunc{
+			defaultHook: i.WithAuthenticator,
+		},
+		WriteReviewCommentFunc: &GerritClientWriteReviewCommentFunc{
+			defaultHook: i.WriteReviewComment,
+		},
+	}
+}
+
+// GerritClientAbandonChangeFunc describes the behavior when the
+// AbandonChange method of the parent MockGerritClient instance is invoked.
+type GerritClientAbandonChangeFunc struct {
+	defaultHook func(context.Context, string) (*gerrit.Change, error)
+	hooks       []func(context.Context, string) (*gerrit.Change, error)
+	history     []GerritClientAbandonChangeFuncCall
+	mutex       sync.Mutex
+}
+
+// AbandonChange delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGerritClient) AbandonChange(v0 context.Context, v1 string) (*gerrit.Change, error) {
+	r0, r1 := m.AbandonChangeFunc.nextHook()(v0, v1)
+	m.AbandonChangeFunc.appendCall(GerritClientAbandonChangeFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the AbandonChange method
+// of the parent MockGerritClient instance is invoked and the hook queue is
+// empty.
+func (f *GerritClientAbandonChangeFunc) SetDefaultHook(hook func(context.Context, string) (*gerrit.Change, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// AbandonChange method of the parent MockGerritClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GerritClientAbandonChangeFunc) PushHook(hook func(context.Context, string) (*gerrit.Change, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GerritClientAbandonChangeFunc) SetDefaultReturn(r0 *gerrit.Change, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (*gerrit.Change, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GerritClientAbandonChangeFunc) PushReturn(r0 *gerrit.Change, r1 error) {
+	f.PushHook(func(context.Context, string) (*gerrit.Change, error) {
+		return r0, r1
+	})
+}
+
+func (f *GerritClientAbandonChangeFunc) nextHook() func(context.Context, string) (*gerrit.Change, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GerritClientAbandonChangeFunc) appendCall(r0 GerritClientAbandonChangeFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GerritClientAbandonChangeFuncCall objects
+// describing the invocations of this function.
+func (f *GerritClientAbandonChangeFunc) History() []GerritClientAbandonChangeFuncCall {
+	f.mutex.Lock()
+	history := make([]GerritClientAbandonChangeFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GerritClientAbandonChangeFuncCall is an object that describes an
+// invocation of method AbandonChange on an instance of MockGerritClient.
+type GerritClientAbandonChangeFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gerrit.Change
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GerritClientAbandonChangeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GerritClientAbandonChangeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GerritClientAuthenticatorFunc describes the behavior when the
+// Authenticator method of the parent MockGerritClient instance is invoked.
+type GerritClientAuthenticatorFunc struct {
+	defaultHook func() auth.Authenticator
+	hooks       []func() auth.Authenticator
+	history     []GerritClientAuthenticatorFuncCall
+	mutex       sync.Mutex
+}
+
+// Authenticator delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGerritClient) Authenticator() auth.Authenticator {
+	r0 := m.AuthenticatorFunc.nextHook()()
+	m.AuthenticatorFunc.appendCall(GerritClientAuthenticatorFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Authenticator method
+// of the parent MockGerritClient instance is invoked and the hook queue is
+// empty.
+func (f *GerritClientAuthenticatorFunc) SetDefaultHook(hook func() auth.Authenticator) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Authenticator method of the parent MockGerritClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GerritClientAuthenticatorFunc) PushHook(hook func() auth.Authenticator) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GerritClientAuthenticatorFunc) SetDefaultReturn(r0 auth.Authenticator) {
+	f.SetDefaultHook(func() auth.Authenticator {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GerritClientAuthenticatorFunc) PushReturn(r0 auth.Authenticator) {
+	f.PushHook(func() auth.Authenticator {
+		return r0
+	})
+}
+
+func (f *GerritClientAuthenticatorFunc) nextHook() func() auth.Authenticator {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GerritClientAuthenticatorFunc) appendCall(r0 GerritClientAuthenticatorFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GerritClientAuthenticatorFuncCall objects
+// describing the invocations of this function.
+func (f *GerritClientAuthenticatorFunc) History() []GerritClientAuthenticatorFuncCall {
+	f.mutex.Lock()
+	history := make([]GerritClientAuthenticatorFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GerritClientAuthenticatorFuncCall is an object that describes an
+// invocation of method Authenticator on an instance of MockGerritClient.
+type GerritClientAuthenticatorFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 auth.Authenticator
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GerritClientAuthenticatorFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GerritClientAuthenticatorFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GerritClientGetAuthenticatedUserAccountFunc describes the behavior when
+// the GetAuthenticatedUserAccount method of the parent MockGerritClient
+// instance is invoked.
+type GerritClientGetAuthenticatedUserAccountFunc struct {
+	defaultHook func(context.Context) (*gerrit.Account, error)
+	hooks       []func(context.Context) (*gerrit.Account, error)
+	history     []GerritClientGetAuthenticatedUserAccountFuncCall
+	mutex       sync.Mutex
+}
+
+// GetAuthenticatedUserAccount delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockGerritClient) GetAuthenticatedUserAccount(v0 context.Context) (*gerrit.Account, error) {
+	r0, r1 := m.GetAuthenticatedUserAccountFunc.nextHook()(v0)
+	m.GetAuthenticatedUserAccountFunc.appendCall(GerritClientGetAuthenticatedUserAccountFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetAuthenticatedUserAccount method of the parent MockGerritClient
+// instance is invoked and the hook queue is empty.
+func (f *GerritClientGetAuthenticatedUserAccountFunc) SetDefaultHook(hook func(context.Context) (*gerrit.Account, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetAuthenticatedUserAccount method of the parent MockGerritClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *GerritClientGetAuthenticatedUserAccountFunc) PushHook(hook func(context.Context) (*gerrit.Account, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GerritClientGetAuthenticatedUserAccountFunc) SetDefaultReturn(r0 *gerrit.Account, r1 error) {
+	f.SetDefaultHook(func(context.Context) (*gerrit.Account, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GerritClientGetAuthenticatedUserAccountFunc) PushReturn(r0 *gerrit.Account, r1 error) {
+	f.PushHook(func(context.Context) (*gerrit.Account, error) {
+		return r0, r1
+	})
+}
+
+func (f *GerritClientGetAuthenticatedUserAccountFunc) nextHook() func(context.Context) (*gerrit.Account, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GerritClientGetAuthenticatedUserAccountFunc) appendCall(r0 GerritClientGetAuthenticatedUserAccountFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GerritClientGetAuthenticatedUserAccountFuncCall objects describing the
+// invocations of this function.
+func (f *GerritClientGetAuthenticatedUserAccountFunc) History() []GerritClientGetAuthenticatedUserAccountFuncCall {
+	f.mutex.Lock()
+	history := make([]GerritClientGetAuthenticatedUserAccountFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GerritClientGetAuthenticatedUserAccountFuncCall is an object that
+// describes an invocation of method GetAuthenticatedUserAccount on an
+// instance of MockGerritClient.
+type GerritClientGetAuthenticatedUserAccountFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gerrit.Account
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GerritClientGetAuthenticatedUserAccountFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GerritClientGetAuthenticatedUserAccountFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GerritClientGetChangeFunc describes the behavior when the GetChange
+// method of the parent MockGerritClient instance is invoked.
+type GerritClientGetChangeFunc struct {
+	defaultHook func(context.Context, string) (*gerrit.Change, error)
+	hooks       []func(context.Context, string) (*gerrit.Change, error)
+	history     []GerritClientGetChangeFuncCall
+	mutex       sync.Mutex
+}
+
+// GetChange delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGerritClient) GetChange(v0 context.Context, v1 string) (*gerrit.Change, error) {
+	r0, r1 := m.GetChangeFunc.nextHook()(v0, v1)
+	m.GetChangeFunc.appendCall(GerritClientGetChangeFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetChange method of
+// the parent MockGerritClient instance is invoked and the hook queue is
+// empty.
+func (f *GerritClientGetChangeFunc) SetDefaultHook(hook func(context.Context, string) (*gerrit.Change, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetChange method of the parent MockGerritClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GerritClientGetChangeFunc) PushHook(hook func(context.Context, string) (*gerrit.Change, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GerritClientGetChangeFunc) SetDefaultReturn(r0 *gerrit.Change, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (*gerrit.Change, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GerritClientGetChangeFunc) PushReturn(r0 *gerrit.Change, r1 error) {
+	f.PushHook(func(context.Context, string) (*gerrit.Change, error) {
+		return r0, r1
+	})
+}
+
+func (f *GerritClientGetChangeFunc) nextHook() func(context.Context, string) (*gerrit.Change, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GerritClientGetChangeFunc) appendCall(r0 GerritClientGetChangeFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GerritClientGetChangeFuncCall objects
+// describing the invocations of this function.
+func (f *GerritClientGetChangeFunc) History() []GerritClientGetChangeFuncCall {
+	f.mutex.Lock()
+	history := make([]GerritClientGetChangeFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GerritClientGetChangeFuncCall is an object that describes an invocation
+// of method GetChange on an instance of MockGerritClient.
+type GerritClientGetChangeFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gerrit.Change
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GerritClientGetChangeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GerritClientGetChangeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GerritClientGetGroupFunc describes the behavior when the GetGroup method
+// of the parent MockGerritClient instance is invoked.
+type GerritClientGetGroupFunc struct {
+	defaultHook func(context.Context, string) (gerrit.Group, error)
+	hooks       []func(context.Context, string) (gerrit.Group, error)
+	history     []GerritClientGetGroupFuncCall
+	mutex       sync.Mutex
+}
+
+// GetGroup delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGerritClient) GetGroup(v0 context.Context, v1 string) (gerrit.Group, error) {
+	r0, r1 := m.GetGroupFunc.nextHook()(v0, v1)
+	m.GetGroupFunc.appendCall(GerritClientGetGroupFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetGroup method of
+// the parent MockGerritClient instance is invoked and the hook queue is
+// empty.
+func (f *GerritClientGetGroupFunc) SetDefaultHook(hook func(context.Context, string) (gerrit.Group, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetGroup method of the parent MockGerritClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GerritClientGetGroupFunc) PushHook(hook func(context.Context, string) (gerrit.Group, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GerritClientGetGroupFunc) SetDefaultReturn(r0 gerrit.Group, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (gerrit.Group, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GerritClientGetGroupFunc) PushReturn(r0 gerrit.Group, r1 error) {
+	f.PushHook(func(context.Context, string) (gerrit.Group, error) {
+		return r0, r1
+	})
+}
+
+func (f *GerritClientGetGroupFunc) nextHook() func(context.Context, string) (gerrit.Group, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GerritClientGetGroupFunc) appendCall(r0 GerritClientGetGroupFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GerritClientGetGroupFuncCall objects
+// describing the invocations of this function.
+func (f *GerritClientGetGroupFunc) History() []GerritClientGetGroupFuncCall {
+	f.mutex.Lock()
+	history := make([]GerritClientGetGroupFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GerritClientGetGroupFuncCall is an object that describes an invocation of
+// method GetGroup on an instance of MockGerritClient.
+type GerritClientGetGroupFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 gerrit.Group
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GerritClientGetGroupFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GerritClientGetGroupFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GerritClientGetURLFunc describes the behavior when the GetURL method of
+// the parent MockGerritClient instance is invoked.
+type GerritClientGetURLFunc struct {
+	defaultHook func() *url.URL
+	hooks       []func() *url.URL
+	history     []GerritClientGetURLFuncCall
+	mutex       sync.Mutex
+}
+
+// GetURL delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGerritClient) GetURL() *url.URL {
+	r0 := m.GetURLFunc.nextHook()()
+	m.GetURLFunc.appendCall(GerritClientGetURLFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the GetURL method of the
+// parent MockGerritClient instance is invoked and the hook queue is empty.
+func (f *GerritClientGetURLFunc) SetDefaultHook(hook func() *url.URL) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetURL method of the parent MockGerritClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GerritClientGetURLFunc) PushHook(hook func() *url.URL) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GerritClientGetURLFunc) SetDefaultReturn(r0 *url.URL) {
+	f.SetDefaultHook(func() *url.URL {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GerritClientGetURLFunc) PushReturn(r0 *url.URL) {
+	f.PushHook(func() *url.URL {
+		return r0
+	})
+}
+
+func (f *GerritClientGetURLFunc) nextHook() func() *url.URL {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GerritClientGetURLFunc) appendCall(r0 GerritClientGetURLFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GerritClientGetURLFuncCall objects
+// describing the invocations of this function.
+func (f *GerritClientGetURLFunc) History() []GerritClientGetURLFuncCall {
+	f.mutex.Lock()
+	history := make([]GerritClientGetURLFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GerritClientGetURLFuncCall is an object that describes an invocation of
+// method GetURL on an instance of MockGerritClient.
+type GerritClientGetURLFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *url.URL
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GerritClientGetURLFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GerritClientGetURLFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GerritClientListAccountsByEmailFunc describes the behavior when the
+// ListAccountsByEmail method of the parent MockGerritClient instance is
+// invoked.
+type GerritClientListAccountsByEmailFunc struct {
+	defaultHook func(context.Context, string) (gerrit.ListAccountsResponse, error)
+	hooks       []func(context.Context, string) (gerrit.ListAccountsResponse, error)
+	history     []GerritClientListAccountsByEmailFuncCall
+	mutex       sync.Mutex
+}
+
+// ListAccountsByEmail delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGerritClient) ListAccountsByEmail(v0 context.Context, v1 string) (gerrit.ListAccountsResponse, error) {
+	r0, r1 := m.ListAccountsByEmailFunc.nextHook()(v0, v1)
+	m.ListAccountsByEmailFunc.appendCall(GerritClientListAccountsByEmailFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListAccountsByEmail
+// method of the parent MockGerritClient instance is invoked and the hook
+// queue is empty.
+func (f *GerritClientListAccountsByEmailFunc) SetDefaultHook(hook func(context.Context, string) (gerrit.ListAccountsResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListAccountsByEmail method of the parent MockGerritClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GerritClientListAccountsByEmailFunc) PushHook(hook func(context.Context, string) (gerrit.ListAccountsResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GerritClientListAccountsByEmailFunc) SetDefaultReturn(r0 gerrit.ListAccountsResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (gerrit.ListAccountsResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GerritClientListAccountsByEmailFunc) PushReturn(r0 gerrit.ListAccountsResponse, r1 error) {
+	f.PushHook(func(context.Context, string) (gerrit.ListAccountsResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *GerritClientListAccountsByEmailFunc) nextHook() func(context.Context, string) (gerrit.ListAccountsResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GerritClientListAccountsByEmailFunc) appendCall(r0 GerritClientListAccountsByEmailFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GerritClientListAccountsByEmailFuncCall
+// objects describing the invocations of this function.
+func (f *GerritClientListAccountsByEmailFunc) History() []GerritClientListAccountsByEmailFuncCall {
+	f.mutex.Lock()
+	history := make([]GerritClientListAccountsByEmailFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GerritClientListAccountsByEmailFuncCall is an object that describes an
+// invocation of method ListAccountsByEmail on an instance of
+// MockGerritClient.
+type GerritClientListAccountsByEmailFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 gerrit.ListAccountsResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GerritClientListAccountsByEmailFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GerritClientListAccountsByEmailFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GerritClientListAccountsByUsernameFunc describes the behavior when the
+// ListAccountsByUsername method of the parent MockGerritClient instance is
+// invoked.
+type GerritClientListAccountsByUsernameFunc struct {
+	defaultHook func(context.Context, string) (gerrit.ListAccountsResponse, error)
+	hooks       []func(context.Context, string) (gerrit.ListAccountsResponse, error)
+	history     []GerritClientListAccountsByUsernameFuncCall
+	mutex       sync.Mutex
+}
+
+// ListAccountsByUsername delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockGerritClient) ListAccountsByUsername(v0 context.Context, v1 string) (gerrit.ListAccountsResponse, error) {
+	r0, r1 := m.ListAccountsByUsernameFunc.nextHook()(v0, v1)
+	m.ListAccountsByUsernameFunc.appendCall(GerritClientListAccountsByUsernameFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListAccountsByUsername method of the parent MockGerritClient instance is
+// invoked and the hook queue is empty.
+func (f *GerritClientListAccountsByUsernameFunc) SetDefaultHook(hook func(context.Context, string) (gerrit.ListAccountsResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListAccountsByUsername method of the parent MockGerritClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GerritClientListAccountsByUsernameFunc) PushHook(hook func(context.Context, string) (gerrit.ListAccountsResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GerritClientListAccountsByUsernameFunc) SetDefaultReturn(r0 gerrit.ListAccountsResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (gerrit.ListAccountsResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GerritClientListAccountsByUsernameFunc) PushReturn(r0 gerrit.ListAccountsResponse, r1 error) {
+	f.PushHook(func(context.Context, string) (gerrit.ListAccountsResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *GerritClientListAccountsByUsernameFunc) nextHook() func(context.Context, string) (gerrit.ListAccountsResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GerritClientListAccountsByUsernameFunc) appendCall(r0 GerritClientListAccountsByUsernameFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GerritClientListAccountsByUsernameFuncCall
+// objects describing the invocations of this function.
+func (f *GerritClientListAccountsByUsernameFunc) History() []GerritClientListAccountsByUsernameFuncCall {
+	f.mutex.Lock()
+	history := make([]GerritClientListAccountsByUsernameFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GerritClientListAccountsByUsernameFuncCall is an object that describes an
+// invocation of method ListAccountsByUsername on an instance of
+// MockGerritClient.
+type GerritClientListAccountsByUsernameFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 gerrit.ListAccountsResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GerritClientListAccountsByUsernameFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GerritClientListAccountsByUsernameFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GerritClientListProjectsFunc describes the behavior when the ListProjects
+// method of the parent MockGerritClient instance is invoked.
+type GerritClientListProjectsFunc struct {
+	defaultHook func(context.Context, gerrit.ListProjectsArgs) (*gerrit.ListProjectsResponse, bool, error)
+	hooks       []func(context.Context, gerrit.ListProjectsArgs) (*gerrit.ListProjectsResponse, bool, error)
+	history     []GerritClientListProjectsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListProjects delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGerritClient) ListProjects(v0 context.Context, v1 gerrit.ListProjectsArgs) (*gerrit.ListProjectsResponse, bool, error) {
+	r0, r1, r2 := m.ListProjectsFunc.nextHook()(v0, v1)
+	m.ListProjectsFunc.appendCall(GerritClientListProjectsFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the ListProjects method
+// of the parent MockGerritClient instance is invoked and the hook queue is
+// empty.
+func (f *GerritClientListProjectsFunc) SetDefaultHook(hook func(context.Context, gerrit.ListProjectsArgs) (*gerrit.ListProjectsResponse, bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListProjects method of the parent MockGerritClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GerritClientListProjectsFunc) PushHook(hook func(context.Context, gerrit.ListProjectsArgs) (*gerrit.ListProjectsResponse, bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GerritClientListProjectsFunc) SetDefaultReturn(r0 *gerrit.ListProjectsResponse, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, gerrit.ListProjectsArgs) (*gerrit.ListProjectsResponse, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GerritClientListProjectsFunc) PushReturn(r0 *gerrit.ListProjectsResponse, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, gerrit.ListProjectsArgs) (*gerrit.ListProjectsResponse, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *GerritClientListProjectsFunc) nextHook() func(context.Context, gerrit.ListProjectsArgs) (*gerrit.ListProjectsResponse, bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GerritClientListProjectsFunc) appendCall(r0 GerritClientListProjectsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GerritClientListProjectsFuncCall objects
+// describing the invocations of this function.
+func (f *GerritClientListProjectsFunc) History() []GerritClientListProjectsFuncCall {
+	f.mutex.Lock()
+	history := make([]GerritClientListProjectsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GerritClientListProjectsFuncCall is an object that describes an
+// invocation of method ListProjects on an instance of MockGerritClient.
+type GerritClientListProjectsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 gerrit.ListProjectsArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gerrit.ListProjectsResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GerritClientListProjectsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GerritClientListProjectsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// GerritClientMoveChangeFunc describes the behavior when the MoveChange
+// method of the parent MockGerritClient instance is invoked.
+type GerritClientMoveChangeFunc struct {
+	defaultHook func(context.Context, string, gerrit.MoveChangeInput) (*gerrit.Change, error)
+	hooks       []func(context.Context, string, gerrit.MoveChangeInput) (*gerrit.Change, error)
+	history     []GerritClientMoveChangeFuncCall
+	mutex       sync.Mutex
+}
+
+// MoveChange delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGerritClient) MoveChange(v0 context.Context, v1 string, v2 gerrit.MoveChangeInput) (*gerrit.Change, error) {
+	r0, r1 := m.MoveChangeFunc.nextHook()(v0, v1, v2)
+	m.MoveChangeFunc.appendCall(GerritClientMoveChangeFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the MoveChange method of
+// the parent MockGerritClient instance is invoked and the hook queue is
+// empty.
+func (f *GerritClientMoveChangeFunc) SetDefaultHook(hook func(context.Context, string, gerrit.MoveChangeInput) (*gerrit.Change, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// MoveChange method of the parent MockGerritClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GerritClientMoveChangeFunc) PushHook(hook func(context.Context, string, gerrit.MoveChangeInput) (*gerrit.Change, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GerritClientMoveChangeFunc) SetDefaultReturn(r0 *gerrit.Change, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, gerrit.MoveChangeInput) (*gerrit.Change, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GerritClientMoveChangeFunc) PushReturn(r0 *gerrit.Change, r1 error) {
+	f.PushHook(func(context.Context, string, gerrit.MoveChangeInput) (*gerrit.Change, error) {
+		return r0, r1
+	})
+}
+
+func (f *GerritClientMoveChangeFunc) nextHook() func(context.Context, string, gerrit.MoveChangeInput) (*gerrit.Change, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GerritClientMoveChangeFunc) appendCall(r0 GerritClientMoveChangeFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GerritClientMoveChangeFuncCall objects
+// describing the invocations of this function.
+func (f *GerritClientMoveChangeFunc) History() []GerritClientMoveChangeFuncCall {
+	f.mutex.Lock()
+	history := make([]GerritClientMoveChangeFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GerritClientMoveChangeFuncCall is an object that describes an invocation
+// of method MoveChange on an instance of MockGerritClient.
+type GerritClientMoveChangeFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 gerrit.MoveChangeInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gerrit.Change
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GerritClientMoveChangeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GerritClientMoveChangeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GerritClientRestoreChangeFunc describes the behavior when the
+// RestoreChange method of the parent MockGerritClient instance is invoked.
+type GerritClientRestoreChangeFunc struct {
+	defaultHook func(context.Context, string) (*gerrit.Change, error)
+	hooks       []func(context.Context, string) (*gerrit.Change, error)
+	history     []GerritClientRestoreChangeFuncCall
+	mutex       sync.Mutex
+}
+
+// RestoreChange delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGerritClient) RestoreChange(v0 context.Context, v1 string) (*gerrit.Change, error) {
+	r0, r1 := m.RestoreChangeFunc.nextHook()(v0, v1)
+	m.RestoreChangeFunc.appendCall(GerritClientRestoreChangeFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RestoreChange method
+// of the parent MockGerritClient instance is invoked and the hook queue is
+// empty.
+func (f *GerritClientRestoreChangeFunc) SetDefaultHook(hook func(context.Context, string) (*gerrit.Change, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RestoreChange method of the parent MockGerritClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GerritClientRestoreChangeFunc) PushHook(hook func(context.Context, string) (*gerrit.Change, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GerritClientRestoreChangeFunc) SetDefaultReturn(r0 *gerrit.Change, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (*gerrit.Change, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GerritClientRestoreChangeFunc) PushReturn(r0 *gerrit.Change, r1 error) {
+	f.PushHook(func(context.Context, string) (*gerrit.Change, error) {
+		return r0, r1
+	})
+}
+
+func (f *GerritClientRestoreChangeFunc) nextHook() func(context.Context, string) (*gerrit.Change, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GerritClientRestoreChangeFunc) appendCall(r0 GerritClientRestoreChangeFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GerritClientRestoreChangeFuncCall objects
+// describing the invocations of this function.
+func (f *GerritClientRestoreChangeFunc) History() []GerritClientRestoreChangeFuncCall {
+	f.mutex.Lock()
+	history := make([]GerritClientRestoreChangeFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GerritClientRestoreChangeFuncCall is an object that describes an
+// invocation of method RestoreChange on an instance of MockGerritClient.
+type GerritClientRestoreChangeFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gerrit.Change
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GerritClientRestoreChangeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GerritClientRestoreChangeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GerritClientSetCommitMessageFunc describes the behavior when the
+// SetCommitMessage method of the parent MockGerritClient instance is
+// invoked.
+type GerritClientSetCommitMessageFunc struct {
+	defaultHook func(context.Context, string, gerrit.CommitMessageInput) error
+	hooks       []func(context.Context, string, gerrit.CommitMessageInput) error
+	history     []GerritClientSetCommitMessageFuncCall
+	mutex       sync.Mutex
+}
+
+// SetCommitMessage delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGerritClient) SetCommitMessage(v0 context.Context, v1 string, v2 gerrit.CommitMessageInput) error {
+	r0 := m.SetCommitMessageFunc.nextHook()(v0, v1, v2)
+	m.SetCommitMessageFunc.appendCall(GerritClientSetCommitMessageFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the SetCommitMessage
+// method of the parent MockGerritClient instance is invoked and the hook
+// queue is empty.
+func (f *GerritClientSetCommitMessageFunc) SetDefaultHook(hook func(context.Context, string, gerrit.CommitMessageInput) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SetCommitMessage method of the parent MockGerritClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GerritClientSetCommitMessageFunc) PushHook(hook func(context.Context, string, gerrit.CommitMessageInput) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GerritClientSetCommitMessageFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, gerrit.CommitMessageInput) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GerritClientSetCommitMessageFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, gerrit.CommitMessageInput) error {
+		return r0
+	})
+}
+
+func (f *GerritClientSetCommitMessageFunc) nextHook() func(context.Context, string, gerrit.CommitMessageInput) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GerritClientSetCommitMessageFunc) appendCall(r0 GerritClientSetCommitMessageFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GerritClientSetCommitMessageFuncCall
+// objects describing the invocations of this function.
+func (f *GerritClientSetCommitMessageFunc) History() []GerritClientSetCommitMessageFuncCall {
+	f.mutex.Lock()
+	history := make([]GerritClientSetCommitMessageFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GerritClientSetCommitMessageFuncCall is an object that describes an
+// invocation of method SetCommitMessage on an instance of MockGerritClient.
+type GerritClientSetCommitMessageFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 gerrit.CommitMessageInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GerritClientSetCommitMessageFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GerritClientSetCommitMessageFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GerritClientSetReadyForReviewFunc describes the behavior when the
+// SetReadyForReview method of the parent MockGerritClient instance is
+// invoked.
+type GerritClientSetReadyForReviewFunc struct {
+	defaultHook func(context.Context, string) error
+	hooks       []func(context.Context, string) error
+	history     []GerritClientSetReadyForReviewFuncCall
+	mutex       sync.Mutex
+}
+
+// SetReadyForReview delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGerritClient) SetReadyForReview(v0 context.Context, v1 string) error {
+	r0 := m.SetReadyForReviewFunc.nextHook()(v0, v1)
+	m.SetReadyForReviewFunc.appendCall(GerritClientSetReadyForReviewFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the SetReadyForReview
+// method of the parent MockGerritClient instance is invoked and the hook
+// queue is empty.
+func (f *GerritClientSetReadyForReviewFunc) SetDefaultHook(hook func(context.Context, string) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SetReadyForReview method of the parent MockGerritClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GerritClientSetReadyForReviewFunc) PushHook(hook func(context.Context, string) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GerritClientSetReadyForReviewFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GerritClientSetReadyForReviewFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string) error {
+		return r0
+	})
+}
+
+func (f *GerritClientSetReadyForReviewFunc) nextHook() func(context.Context, string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GerritClientSetReadyForReviewFunc) appendCall(r0 GerritClientSetReadyForReviewFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GerritClientSetReadyForReviewFuncCall
+// objects describing the invocations of this function.
+func (f *GerritClientSetReadyForReviewFunc) History() []GerritClientSetReadyForReviewFuncCall {
+	f.mutex.Lock()
+	history := make([]GerritClientSetReadyForReviewFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GerritClientSetReadyForReviewFuncCall is an object that describes an
+// invocation of method SetReadyForReview on an instance of
+// MockGerritClient.
+type GerritClientSetReadyForReviewFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GerritClientSetReadyForReviewFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GerritClientSetReadyForReviewFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GerritClientSetWIPFunc describes the behavior when the SetWIP method of
+// the parent MockGerritClient instance is invoked.
+type GerritClientSetWIPFunc struct {
+	defaultHook func(context.Context, string) error
+	hooks       []func(context.Context, string) error
+	history     []GerritClientSetWIPFuncCall
+	mutex       sync.Mutex
+}
+
+// SetWIP delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGerritClient) SetWIP(v0 context.Context, v1 string) error {
+	r0 := m.SetWIPFunc.nextHook()(v0, v1)
+	m.SetWIPFunc.appendCall(GerritClientSetWIPFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the SetWIP method of the
+// parent MockGerritClient instance is invoked and the hook queue is empty.
+func (f *GerritClientSetWIPFunc) SetDefaultHook(hook func(context.Context, string) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SetWIP method of the parent MockGerritClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GerritClientSetWIPFunc) PushHook(hook func(context.Context, string) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GerritClientSetWIPFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GerritClientSetWIPFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string) error {
+		return r0
+	})
+}
+
+func (f *GerritClientSetWIPFunc) nextHook() func(context.Context, string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GerritClientSetWIPFunc) appendCall(r0 GerritClientSetWIPFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GerritClientSetWIPFuncCall objects
+// describing the invocations of this function.
+func (f *GerritClientSetWIPFunc) History() []GerritClientSetWIPFuncCall {
+	f.mutex.Lock()
+	history := make([]GerritClientSetWIPFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GerritClientSetWIPFuncCall is an object that describes an invocation of
+// method SetWIP on an instance of MockGerritClient.
+type GerritClientSetWIPFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GerritClientSetWIPFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GerritClientSetWIPFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GerritClientSubmitChangeFunc describes the behavior when the SubmitChange
+// method of the parent MockGerritClient instance is invoked.
+type GerritClientSubmitChangeFunc struct {
+	defaultHook func(context.Context, string) (*gerrit.Change, error)
+	hooks       []func(context.Context, string) (*gerrit.Change, error)
+	history     []GerritClientSubmitChangeFuncCall
+	mutex       sync.Mutex
+}
+
+// SubmitChange delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGerritClient) SubmitChange(v0 context.Context, v1 string) (*gerrit.Change, error) {
+	r0, r1 := m.SubmitChangeFunc.nextHook()(v0, v1)
+	m.SubmitChangeFunc.appendCall(GerritClientSubmitChangeFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the SubmitChange method
+// of the parent MockGerritClient instance is invoked and the hook queue is
+// empty.
+func (f *GerritClientSubmitChangeFunc) SetDefaultHook(hook func(context.Context, string) (*gerrit.Change, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SubmitChange method of the parent MockGerritClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GerritClientSubmitChangeFunc) PushHook(hook func(context.Context, string) (*gerrit.Change, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GerritClientSubmitChangeFunc) SetDefaultReturn(r0 *gerrit.Change, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (*gerrit.Change, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GerritClientSubmitChangeFunc) PushReturn(r0 *gerrit.Change, r1 error) {
+	f.PushHook(func(context.Context, string) (*gerrit.Change, error) {
+		return r0, r1
+	})
+}
+
+func (f *GerritClientSubmitChangeFunc) nextHook() func(context.Context, string) (*gerrit.Change, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GerritClientSubmitChangeFunc) appendCall(r0 GerritClientSubmitChangeFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GerritClientSubmitChangeFuncCall objects
+// describing the invocations of this function.
+func (f *GerritClientSubmitChangeFunc) History() []GerritClientSubmitChangeFuncCall {
+	f.mutex.Lock()
+	history := make([]GerritClientSubmitChangeFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GerritClientSubmitChangeFuncCall is an object that describes an
+// invocation of method SubmitChange on an instance of MockGerritClient.
+type GerritClientSubmitChangeFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gerrit.Change
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GerritClientSubmitChangeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GerritClientSubmitChangeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GerritClientWithAuthenticatorFunc describes the behavior when the
+// WithAuthenticator method of the parent MockGerritClient instance is
+// invoked.
+type GerritClientWithAuthenticatorFunc struct {
+	defaultHook func(auth.Authenticator) gerrit.Client
+	hooks       []func(auth.Authenticator) gerrit.Client
+	history     []GerritClientWithAuthenticatorFuncCall
+	mutex       sync.Mutex
+}
+
+// WithAuthenticator delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGerritClient) WithAuthenticator(v0 auth.Authenticator) gerrit.Client {
+	r0 := m.WithAuthenticatorFunc.nextHook()(v0)
+	m.WithAuthenticatorFunc.appendCall(GerritClientWithAuthenticatorFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the WithAuthenticator
+// method of the parent MockGerritClient instance is invoked and the hook
+// queue is empty.
+func (f *GerritClientWithAuthenticatorFunc) SetDefaultHook(hook func(auth.Authenticator) gerrit.Client) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// WithAuthenticator method of the parent MockGerritClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GerritClientWithAuthenticatorFunc) PushHook(hook func(auth.Authenticator) gerrit.Client) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GerritClientWithAuthenticatorFunc) SetDefaultReturn(r0 gerrit.Client) {
+	f.SetDefaultHook(func(auth.Authenticator) gerrit.Client {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GerritClientWithAuthenticatorFunc) PushReturn(r0 gerrit.Client) {
+	f.PushHook(func(auth.Authenticator) gerrit.Client {
+		return r0
+	})
+}
+
+func (f *GerritClientWithAuthenticatorFunc) nextHook() func(auth.Authenticator) gerrit.Client {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GerritClientWithAuthenticatorFunc) appendCall(r0 GerritClientWithAuthenticatorFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GerritClientWithAuthenticatorFuncCall
+// objects describing the invocations of this function.
+func (f *GerritClientWithAuthenticatorFunc) History() []GerritClientWithAuthenticatorFuncCall {
+	f.mutex.Lock()
+	history := make([]GerritClientWithAuthenticatorFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GerritClientWithAuthenticatorFuncCall is an object that describes an
+// invocation of method WithAuthenticator on an instance of
+// MockGerritClient.
+type GerritClientWithAuthenticatorFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 auth.Authenticator
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 gerrit.Client
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GerritClientWithAuthenticatorFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GerritClientWithAuthenticatorFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// GerritClientWriteReviewCommentFunc describes the behavior when the
+// WriteReviewComment method of the parent MockGerritClient instance is
+// invoked.
+type GerritClientWriteReviewCommentFunc struct {
+	defaultHook func(context.Context, string, gerrit.ReviewInput) error
+	hooks       []func(context.Context, string, gerrit.ReviewInput) error
+	history     []GerritClientWriteReviewCommentFuncCall
+	mutex       sync.Mutex
+}
+
+// WriteReviewComment delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGerritClient) WriteReviewComment(v0 context.Context, v1 string, v2 gerrit.ReviewInput) error {
+	r0 := m.WriteReviewCommentFunc.nextHook()(v0, v1, v2)
+	m.WriteReviewCommentFunc.appendCall(GerritClientWriteReviewCommentFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the WriteReviewComment
+// method of the parent MockGerritClient instance is invoked and the hook
+// queue is empty.
+func (f *GerritClientWriteReviewCommentFunc) SetDefaultHook(hook func(context.Context, string, gerrit.ReviewInput) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// WriteReviewComment method of the parent MockGerritClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GerritClientWriteReviewCommentFunc) PushHook(hook func(context.Context, string, gerrit.ReviewInput) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GerritClientWriteReviewCommentFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, string, gerrit.ReviewInput) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GerritClientWriteReviewCommentFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, string, gerrit.ReviewInput) error {
+		return r0
+	})
+}
+
+func (f *GerritClientWriteReviewCommentFunc) nextHook() func(context.Context, string, gerrit.ReviewInput) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GerritClientWriteReviewCommentFunc) appendCall(r0 GerritClientWriteReviewCommentFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GerritClientWriteReviewCommentFuncCall
+// objects describing the invocations of this function.
+func (f *GerritClientWriteReviewCommentFunc) History() []GerritClientWriteReviewCommentFuncCall {
+	f.mutex.Lock()
+	history := make([]GerritClientWriteReviewCommentFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GerritClientWriteReviewCommentFuncCall is an object that describes an
+// invocation of method WriteReviewComment on an instance of
+// MockGerritClient.
+type GerritClientWriteReviewCommentFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 gerrit.ReviewInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GerritClientWriteReviewCommentFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GerritClientWriteReviewCommentFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
@@ -6,7 +6,10 @@ import (
 
 	"github.com/inconshreveable/log15"
 
+	adobatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/azuredevops"
+	gerritbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gerrit"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -56,18 +59,21 @@ var RequiredEventTypesForHistory = []btypes.ChangesetEventKind{
 	btypes.ChangesetEventKindGitLabMarkWorkInProgress,
 
 	// Closed, unmerged.
+	btypes.ChangesetEventKindAzureDevOpsPullRequestAbandoned,
 	btypes.ChangesetEventKindBitbucketCloudPullRequestRejected,
 	btypes.ChangesetEventKindBitbucketServerDeclined,
 	btypes.ChangesetEventKindGitHubClosed,
 	btypes.ChangesetEventKindGitLabClosed,
 
 	// Closed, merged.
+	btypes.ChangesetEventKindAzureDevOpsPullRequestCompleted,
 	btypes.ChangesetEventKindBitbucketCloudPullRequestFulfilled,
 	btypes.ChangesetEventKindBitbucketServerMerged,
 	btypes.ChangesetEventKindGitHubMerged,
 	btypes.ChangesetEventKindGitLabMerged,
 
 	// Reopened
+	btypes.ChangesetEventKindAzureDevOpsPullRequestUpdated,
 	btypes.ChangesetEventKindBitbucketServerReopened,
 	btypes.ChangesetEventKindGitHubReopened,
 	btypes.ChangesetEventKindGitLabReopened,
@@ -76,6 +82,10 @@ var RequiredEventTypesForHistory = []btypes.ChangesetEventKind{
 	btypes.ChangesetEventKindGitHubReviewed,
 
 	// Reviewed, approved.
+	btypes.ChangesetEventKindAzureDevOpsApproved,
+	btypes.ChangesetEventKindAzureDevOpsApprovedWithSuggestions,
+	btypes.ChangesetEventKindGerritApproved,
+	btypes.ChangesetEventKindGerritRecommended,
 	btypes.ChangesetEventKindBitbucketCloudApproved,
 	btypes.ChangesetEventKindBitbucketCloudPullRequestApproved,
 	btypes.ChangesetEventKindBitbucketServerApproved,
@@ -83,6 +93,10 @@ var RequiredEventTypesForHistory = []btypes.ChangesetEventKind{
 	btypes.ChangesetEventKindGitLabApproved,
 
 	// Reviewed, not approved.
+	btypes.ChangesetEventKindAzureDevOpsWaitingForAuthor,
+	btypes.ChangesetEventKindAzureDevOpsRejected,
+	btypes.ChangesetEventKindGerritDisliked,
+	btypes.ChangesetEventKindGerritRejected,
 	btypes.ChangesetEventKindBitbucketCloudPullRequestChangesRequestRemoved,
 	btypes.ChangesetEventKindBitbucketCloudPullRequestUnapproved,
 	btypes.ChangesetEventKindBitbucketServerUnapproved,
@@ -143,7 +157,8 @@ func computeHistory(ch *btypes.Changeset, ce ChangesetEvents) (changesetHistory,
 		case btypes.ChangesetEventKindGitHubClosed,
 			btypes.ChangesetEventKindBitbucketServerDeclined,
 			btypes.ChangesetEventKindGitLabClosed,
-			btypes.ChangesetEventKindBitbucketCloudPullRequestRejected:
+			btypes.ChangesetEventKindBitbucketCloudPullRequestRejected,
+			btypes.ChangesetEventKindAzureDevOpsPullRequestAbandoned:
 			// Merged and ReadOnly are final states. We can ignore everything after.
 			if currentExtState != btypes.ChangesetExternalStateMerged &&
 				currentExtState != btypes.ChangesetExternalStateReadOnly {
@@ -154,7 +169,8 @@ func computeHistory(ch *btypes.Changeset, ce ChangesetEvents) (changesetHistory,
 		case btypes.ChangesetEventKindGitHubMerged,
 			btypes.ChangesetEventKindBitbucketServerMerged,
 			btypes.ChangesetEventKindGitLabMerged,
-			btypes.ChangesetEventKindBitbucketCloudPullRequestFulfilled:
+			btypes.ChangesetEventKindBitbucketCloudPullRequestFulfilled,
+			btypes.ChangesetEventKindAzureDevOpsPullRequestCompleted:
 			currentExtState = btypes.ChangesetExternalStateMerged
 			pushStates(et)
 
@@ -198,12 +214,42 @@ func computeHistory(ch *btypes.Changeset, ce ChangesetEvents) (changesetHistory,
 				pushStates(et)
 			}
 
+		case btypes.ChangesetEventKindAzureDevOpsPullRequestUpdated:
+			// Azure DevOps sends the same event for all updates of an active
+			// pull request, so it can either be a reactivation or a change of
+			// the draft state.
+			ev, ok := e.Metadata.(*azuredevops.PullRequestUpdatedEvent)
+			if !ok {
+				continue
+			}
+			isDraft = ev.PullRequest.IsDraft
+			// Merged and ReadOnly are final states. We can ignore everything after.
+			if currentExtState != btypes.ChangesetExternalStateMerged &&
+				currentExtState != btypes.ChangesetExternalStateReadOnly {
+				newExtState := btypes.ChangesetExternalStateOpen
+				if isDraft {
+					newExtState = btypes.ChangesetExternalStateDraft
+				}
+				if newExtState != currentExtState {
+					currentExtState = newExtState
+					pushStates(et)
+				}
+			}
+
 		case btypes.ChangesetEventKindGitHubReviewed,
 			btypes.ChangesetEventKindBitbucketServerApproved,
 			btypes.ChangesetEventKindBitbucketServerReviewed,
 			btypes.ChangesetEventKindGitLabApproved,
 			btypes.ChangesetEventKindBitbucketCloudApproved,
-			btypes.ChangesetEventKindBitbucketCloudPullRequestApproved:
+			btypes.ChangesetEventKindBitbucketCloudPullRequestApproved,
+			btypes.ChangesetEventKindAzureDevOpsApproved,
+			btypes.ChangesetEventKindAzureDevOpsApprovedWithSuggestions,
+			btypes.ChangesetEventKindAzureDevOpsWaitingForAuthor,
+			btypes.ChangesetEventKindAzureDevOpsRejected,
+			btypes.ChangesetEventKindGerritApproved,
+			btypes.ChangesetEventKindGerritRecommended,
+			btypes.ChangesetEventKindGerritDisliked,
+			btypes.ChangesetEventKindGerritRejected:
 			s, err := e.ReviewState()
 			if err != nil {
 				return nil, err
@@ -321,6 +367,16 @@ func initialExternalState(ch *btypes.Changeset, ce ChangesetEvents) btypes.Chang
 		if m.WorkInProgress {
 			open = false
 		}
+
+	case *adobatches.AnnotatedPullRequest:
+		if m.IsDraft {
+			open = false
+		}
+
+	case *gerritbatches.AnnotatedChange:
+		if m.WorkInProgress {
+			open = false
+		}
 	default:
 		return btypes.ChangesetExternalStateOpen
 	}
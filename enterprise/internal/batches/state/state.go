@@ -11,15 +11,19 @@ import (
 
 	"github.com/sourcegraph/log"
 
+	adobatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/azuredevops"
 	bbcs "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/bitbucketcloud"
+	gerritbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gerrit"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
@@ -112,6 +116,12 @@ func computeCheckState(c *btypes.Changeset, events ChangesetEvents) btypes.Chang
 
 	case *bbcs.AnnotatedPullRequest:
 		return computeBitbucketCloudBuildState(c.UpdatedAt, m, events)
+
+	case *adobatches.AnnotatedPullRequest:
+		return computeAzureDevOpsBuildState(m)
+
+	case *gerritbatches.AnnotatedChange:
+		return computeGerritCheckState(m)
 	}
 
 	return btypes.ChangesetCheckStateUnknown
@@ -251,6 +261,63 @@ func parseBitbucketCloudBuildState(s bitbucketcloud.PullRequestStatusState) btyp
 	}
 }
 
+func computeAzureDevOpsBuildState(apr *adobatches.AnnotatedPullRequest) btypes.ChangesetCheckState {
+	// Azure DevOps never updates a status in place: posting a status for the
+	// same context again adds a new one. We only consider the most recent
+	// status for each context, which is the one with the highest ID.
+	latest := make(map[azuredevops.PullRequestBuildStatusContext]*azuredevops.PullRequestBuildStatus)
+	for _, status := range apr.Statuses {
+		if l, ok := latest[status.Context]; !ok || l.ID < status.ID {
+			latest[status.Context] = status
+		}
+	}
+
+	states := make([]btypes.ChangesetCheckState, 0, len(latest))
+	for _, status := range latest {
+		states = append(states, parseAzureDevOpsBuildState(status.State))
+	}
+
+	return combineCheckStates(states)
+}
+
+func parseAzureDevOpsBuildState(s azuredevops.PullRequestBuildStatusState) btypes.ChangesetCheckState {
+	switch s {
+	case azuredevops.PullRequestBuildStatusStateError, azuredevops.PullRequestBuildStatusStateFailed:
+		return btypes.ChangesetCheckStateFailed
+	case azuredevops.PullRequestBuildStatusStatePending:
+		return btypes.ChangesetCheckStatePending
+	case azuredevops.PullRequestBuildStatusStateSucceeded:
+		return btypes.ChangesetCheckStatePassed
+	default:
+		return btypes.ChangesetCheckStateUnknown
+	}
+}
+
+// computeGerritCheckState computes the check state from the votes on the
+// Verified label, which is where CI systems report their results on Gerrit.
+func computeGerritCheckState(c *gerritbatches.AnnotatedChange) btypes.ChangesetCheckState {
+	label, ok := c.Labels[gerrit.VerifiedLabel]
+	if !ok {
+		return btypes.ChangesetCheckStateUnknown
+	}
+
+	states := make([]btypes.ChangesetCheckState, 0, len(label.All))
+	for _, approval := range label.All {
+		switch {
+		case approval.Value > 0:
+			states = append(states, btypes.ChangesetCheckStatePassed)
+		case approval.Value < 0:
+			states = append(states, btypes.ChangesetCheckStateFailed)
+		}
+	}
+	if len(states) == 0 {
+		// The label applies to the change, but nobody has voted yet.
+		return btypes.ChangesetCheckStatePending
+	}
+
+	return combineCheckStates(states)
+}
+
 func computeGitHubCheckState(lastSynced time.Time, pr *github.PullRequest, events []*btypes.ChangesetEvent) btypes.ChangesetCheckState {
 	// We should only consider the latest commit. This could be from a sync or a webhook that
 	// has occurred later
@@ -515,6 +582,36 @@ func computeSingleChangesetExternalState(c *btypes.Changeset) (s btypes.Changese
 		default:
 			return "", errors.Errorf("unknown Bitbucket Cloud pull request state: %s", m.State)
 		}
+	case *adobatches.AnnotatedPullRequest:
+		switch m.Status {
+		case azuredevops.PullRequestStatusAbandoned:
+			s = btypes.ChangesetExternalStateClosed
+		case azuredevops.PullRequestStatusCompleted:
+			s = btypes.ChangesetExternalStateMerged
+		case azuredevops.PullRequestStatusActive:
+			if m.IsDraft {
+				s = btypes.ChangesetExternalStateDraft
+			} else {
+				s = btypes.ChangesetExternalStateOpen
+			}
+		default:
+			return "", errors.Errorf("unknown Azure DevOps pull request status: %s", m.Status)
+		}
+	case *gerritbatches.AnnotatedChange:
+		switch m.Status {
+		case gerrit.ChangeStatusAbandoned:
+			s = btypes.ChangesetExternalStateClosed
+		case gerrit.ChangeStatusMerged:
+			s = btypes.ChangesetExternalStateMerged
+		case gerrit.ChangeStatusNew:
+			if m.WorkInProgress {
+				s = btypes.ChangesetExternalStateDraft
+			} else {
+				s = btypes.ChangesetExternalStateOpen
+			}
+		default:
+			return "", errors.Errorf("unknown Gerrit change status: %s", m.Status)
+		}
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -584,6 +681,30 @@ func computeSingleChangesetReviewState(c *btypes.Changeset) (s btypes.ChangesetR
 			}
 		}
 
+	case *adobatches.AnnotatedPullRequest:
+		for _, reviewer := range m.Reviewers {
+			switch reviewer.Vote {
+			case azuredevops.PullRequestVoteApproved, azuredevops.PullRequestVoteApprovedWithSuggestions:
+				states[btypes.ChangesetReviewStateApproved] = true
+			case azuredevops.PullRequestVoteWaitingForAuthor, azuredevops.PullRequestVoteRejected:
+				states[btypes.ChangesetReviewStateChangesRequested] = true
+			default:
+				states[btypes.ChangesetReviewStatePending] = true
+			}
+		}
+
+	case *gerritbatches.AnnotatedChange:
+		for _, approval := range m.Labels[gerrit.CodeReviewLabel].All {
+			switch {
+			case approval.Value > 0:
+				states[btypes.ChangesetReviewStateApproved] = true
+			case approval.Value < 0:
+				states[btypes.ChangesetReviewStateChangesRequested] = true
+			default:
+				states[btypes.ChangesetReviewStatePending] = true
+			}
+		}
+
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -7,9 +7,11 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
+	gerritbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gerrit"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
 	"github.com/sourcegraph/sourcegraph/internal/timeutil"
@@ -407,6 +409,53 @@ func TestComputeGitLabCheckState(t *testing.T) {
 	})
 }
 
+func TestComputeGerritCheckState(t *testing.T) {
+	t.Parallel()
+
+	verified := func(values ...int) map[string]gerrit.LabelInfo {
+		label := gerrit.LabelInfo{}
+		for _, v := range values {
+			label.All = append(label.All, gerrit.ApprovalInfo{Value: v})
+		}
+		return map[string]gerrit.LabelInfo{gerrit.VerifiedLabel: label}
+	}
+
+	for name, tc := range map[string]struct {
+		labels map[string]gerrit.LabelInfo
+		want   btypes.ChangesetCheckState
+	}{
+		"no label": {
+			labels: map[string]gerrit.LabelInfo{},
+			want:   btypes.ChangesetCheckStateUnknown,
+		},
+		"no votes": {
+			labels: verified(),
+			want:   btypes.ChangesetCheckStatePending,
+		},
+		"only neutral votes": {
+			labels: verified(0),
+			want:   btypes.ChangesetCheckStatePending,
+		},
+		"passed": {
+			labels: verified(1, 0),
+			want:   btypes.ChangesetCheckStatePassed,
+		},
+		"failed": {
+			labels: verified(1, -1),
+			want:   btypes.ChangesetCheckStateFailed,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			have := computeGerritCheckState(&gerritbatches.AnnotatedChange{
+				Change: &gerrit.Change{Labels: tc.labels},
+			})
+			if have != tc.want {
+				t.Errorf("unexpected check state: have %s; want %s", have, tc.want)
+			}
+		})
+	}
+}
+
 func TestComputeReviewState(t *testing.T) {
 	t.Parallel()
 
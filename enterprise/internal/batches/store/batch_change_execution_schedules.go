@@ -0,0 +1,296 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/opentracing/opentracing-go/log"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// batchChangeExecutionScheduleInsertColumns is the list of
+// batch_change_execution_schedules columns that are modified in
+// UpsertBatchChangeExecutionSchedule and UpdateBatchChangeExecutionSchedule.
+var batchChangeExecutionScheduleInsertColumns = SQLColumns{
+	"batch_change_id",
+	"user_id",
+	"interval_seconds",
+	"auto_apply",
+	"batch_spec_id",
+	"next_run_at",
+	"last_run_at",
+	"created_at",
+	"updated_at",
+}
+
+// batchChangeExecutionScheduleColumns are used by the execution schedule
+// related Store methods to query and create execution schedules.
+var batchChangeExecutionScheduleColumns = SQLColumns{
+	"batch_change_execution_schedules.id",
+	"batch_change_execution_schedules.batch_change_id",
+	"batch_change_execution_schedules.user_id",
+	"batch_change_execution_schedules.interval_seconds",
+	"batch_change_execution_schedules.auto_apply",
+	"batch_change_execution_schedules.batch_spec_id",
+	"batch_change_execution_schedules.next_run_at",
+	"batch_change_execution_schedules.last_run_at",
+	"batch_change_execution_schedules.created_at",
+	"batch_change_execution_schedules.updated_at",
+}
+
+// UpsertBatchChangeExecutionSchedule creates the given execution schedule, or
+// replaces the existing schedule of the same batch change.
+func (s *Store) UpsertBatchChangeExecutionSchedule(ctx context.Context, es *btypes.BatchChangeExecutionSchedule) (err error) {
+	ctx, _, endObservation := s.operations.upsertBatchChangeExecutionSchedule.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("batchChangeID", int(es.BatchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := s.upsertBatchChangeExecutionScheduleQuery(es)
+
+	return s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanBatchChangeExecutionSchedule(es, sc)
+	})
+}
+
+var upsertBatchChangeExecutionScheduleQueryFmtstr = `
+INSERT INTO batch_change_execution_schedules (%s)
+VALUES ` + batchChangeExecutionScheduleInsertColumns.FmtStr() + `
+ON CONFLICT (batch_change_id) DO UPDATE SET
+	user_id = EXCLUDED.user_id,
+	interval_seconds = EXCLUDED.interval_seconds,
+	auto_apply = EXCLUDED.auto_apply,
+	next_run_at = EXCLUDED.next_run_at,
+	updated_at = EXCLUDED.updated_at
+RETURNING %s
+`
+
+func (s *Store) upsertBatchChangeExecutionScheduleQuery(es *btypes.BatchChangeExecutionSchedule) *sqlf.Query {
+	if es.CreatedAt.IsZero() {
+		es.CreatedAt = s.now()
+	}
+
+	if es.UpdatedAt.IsZero() {
+		es.UpdatedAt = es.CreatedAt
+	}
+
+	return sqlf.Sprintf(
+		upsertBatchChangeExecutionScheduleQueryFmtstr,
+		sqlf.Join(batchChangeExecutionScheduleInsertColumns.ToSqlf(), ", "),
+		es.BatchChangeID,
+		es.UserID,
+		int64(es.Interval/time.Second),
+		es.AutoApply,
+		dbutil.NullInt64Column(es.BatchSpecID),
+		es.NextRunAt,
+		dbutil.NullTimeColumn(es.LastRunAt),
+		es.CreatedAt,
+		es.UpdatedAt,
+		sqlf.Join(batchChangeExecutionScheduleColumns.ToSqlf(), ", "),
+	)
+}
+
+// UpdateBatchChangeExecutionSchedule updates the given execution schedule.
+func (s *Store) UpdateBatchChangeExecutionSchedule(ctx context.Context, es *btypes.BatchChangeExecutionSchedule) (err error) {
+	ctx, _, endObservation := s.operations.updateBatchChangeExecutionSchedule.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("ID", int(es.ID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	es.UpdatedAt = s.now()
+
+	q := sqlf.Sprintf(
+		updateBatchChangeExecutionScheduleQueryFmtstr,
+		sqlf.Join(batchChangeExecutionScheduleInsertColumns.ToSqlf(), ", "),
+		es.BatchChangeID,
+		es.UserID,
+		int64(es.Interval/time.Second),
+		es.AutoApply,
+		dbutil.NullInt64Column(es.BatchSpecID),
+		es.NextRunAt,
+		dbutil.NullTimeColumn(es.LastRunAt),
+		es.CreatedAt,
+		es.UpdatedAt,
+		es.ID,
+		sqlf.Join(batchChangeExecutionScheduleColumns.ToSqlf(), ", "),
+	)
+
+	return s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanBatchChangeExecutionSchedule(es, sc)
+	})
+}
+
+var updateBatchChangeExecutionScheduleQueryFmtstr = `
+UPDATE batch_change_execution_schedules
+SET (%s) = ` + batchChangeExecutionScheduleInsertColumns.FmtStr() + `
+WHERE id = %s
+RETURNING %s
+`
+
+// DeleteBatchChangeExecutionSchedule deletes the execution schedule of the
+// given batch change.
+func (s *Store) DeleteBatchChangeExecutionSchedule(ctx context.Context, batchChangeID int64) (err error) {
+	ctx, _, endObservation := s.operations.deleteBatchChangeExecutionSchedule.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("batchChangeID", int(batchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	res, err := s.ExecResult(ctx, sqlf.Sprintf(deleteBatchChangeExecutionScheduleQueryFmtstr, batchChangeID))
+	if err != nil {
+		return err
+	}
+
+	if rows, err := res.RowsAffected(); err != nil {
+		return err
+	} else if rows == 0 {
+		return ErrNoResults
+	}
+	return nil
+}
+
+var deleteBatchChangeExecutionScheduleQueryFmtstr = `
+DELETE FROM batch_change_execution_schedules WHERE batch_change_id = %s
+`
+
+// GetBatchChangeExecutionScheduleOpts captures the query options needed for
+// getting an execution schedule.
+type GetBatchChangeExecutionScheduleOpts struct {
+	ID            int64
+	BatchChangeID int64
+}
+
+// GetBatchChangeExecutionSchedule gets an execution schedule matching the
+// given options.
+func (s *Store) GetBatchChangeExecutionSchedule(ctx context.Context, opts GetBatchChangeExecutionScheduleOpts) (es *btypes.BatchChangeExecutionSchedule, err error) {
+	ctx, _, endObservation := s.operations.getBatchChangeExecutionSchedule.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("ID", int(opts.ID)),
+		log.Int("batchChangeID", int(opts.BatchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := getBatchChangeExecutionScheduleQuery(opts)
+
+	var c btypes.BatchChangeExecutionSchedule
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanBatchChangeExecutionSchedule(&c, sc)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if c.ID == 0 {
+		return nil, ErrNoResults
+	}
+
+	return &c, nil
+}
+
+var getBatchChangeExecutionScheduleQueryFmtstr = `
+SELECT %s FROM batch_change_execution_schedules
+WHERE %s
+LIMIT 1
+`
+
+func getBatchChangeExecutionScheduleQuery(opts GetBatchChangeExecutionScheduleOpts) *sqlf.Query {
+	preds := []*sqlf.Query{}
+
+	if opts.ID != 0 {
+		preds = append(preds, sqlf.Sprintf("batch_change_execution_schedules.id = %s", opts.ID))
+	}
+
+	if opts.BatchChangeID != 0 {
+		preds = append(preds, sqlf.Sprintf("batch_change_execution_schedules.batch_change_id = %s", opts.BatchChangeID))
+	}
+
+	if len(preds) == 0 {
+		preds = append(preds, sqlf.Sprintf("TRUE"))
+	}
+
+	return sqlf.Sprintf(
+		getBatchChangeExecutionScheduleQueryFmtstr,
+		sqlf.Join(batchChangeExecutionScheduleColumns.ToSqlf(), ", "),
+		sqlf.Join(preds, "\n AND "),
+	)
+}
+
+// ListBatchChangeExecutionSchedulesOpts captures the query options needed for
+// listing execution schedules.
+type ListBatchChangeExecutionSchedulesOpts struct {
+	// OnlyActionable restricts the list to schedules that are either due to
+	// start a new execution or have an execution in progress.
+	OnlyActionable bool
+}
+
+// ListBatchChangeExecutionSchedules lists execution schedules with the given
+// filters.
+func (s *Store) ListBatchChangeExecutionSchedules(ctx context.Context, opts ListBatchChangeExecutionSchedulesOpts) (cs []*btypes.BatchChangeExecutionSchedule, err error) {
+	ctx, _, endObservation := s.operations.listBatchChangeExecutionSchedules.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	q := s.listBatchChangeExecutionSchedulesQuery(opts)
+
+	cs = make([]*btypes.BatchChangeExecutionSchedule, 0)
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		var c btypes.BatchChangeExecutionSchedule
+		if err := scanBatchChangeExecutionSchedule(&c, sc); err != nil {
+			return err
+		}
+		cs = append(cs, &c)
+		return nil
+	})
+
+	return cs, err
+}
+
+var listBatchChangeExecutionSchedulesQueryFmtstr = `
+SELECT %s FROM batch_change_execution_schedules
+WHERE %s
+ORDER BY next_run_at ASC, id ASC
+`
+
+func (s *Store) listBatchChangeExecutionSchedulesQuery(opts ListBatchChangeExecutionSchedulesOpts) *sqlf.Query {
+	preds := []*sqlf.Query{}
+
+	if opts.OnlyActionable {
+		preds = append(preds, sqlf.Sprintf(
+			"(batch_change_execution_schedules.batch_spec_id IS NOT NULL OR batch_change_execution_schedules.next_run_at <= %s)",
+			s.now(),
+		))
+	}
+
+	if len(preds) == 0 {
+		preds = append(preds, sqlf.Sprintf("TRUE"))
+	}
+
+	return sqlf.Sprintf(
+		listBatchChangeExecutionSchedulesQueryFmtstr,
+		sqlf.Join(batchChangeExecutionScheduleColumns.ToSqlf(), ", "),
+		sqlf.Join(preds, "\n AND "),
+	)
+}
+
+func scanBatchChangeExecutionSchedule(es *btypes.BatchChangeExecutionSchedule, s dbutil.Scanner) error {
+	var intervalSeconds int64
+
+	if err := s.Scan(
+		&es.ID,
+		&es.BatchChangeID,
+		&es.UserID,
+		&intervalSeconds,
+		&es.AutoApply,
+		&dbutil.NullInt64{N: &es.BatchSpecID},
+		&es.NextRunAt,
+		&dbutil.NullTime{Time: &es.LastRunAt},
+		&es.CreatedAt,
+		&es.UpdatedAt,
+	); err != nil {
+		return err
+	}
+
+	es.Interval = time.Duration(intervalSeconds) * time.Second
+	return nil
+}
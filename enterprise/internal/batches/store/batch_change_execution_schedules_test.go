@@ -0,0 +1,93 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bt "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+)
+
+func testStoreBatchChangeExecutionSchedules(t *testing.T, ctx context.Context, s *Store, clock bt.Clock) {
+	schedules := make([]*btypes.BatchChangeExecutionSchedule, 0, 2)
+	for i := 0; i < cap(schedules); i++ {
+		schedules = append(schedules, &btypes.BatchChangeExecutionSchedule{
+			BatchChangeID: int64(i + 100),
+			UserID:        int32(i + 200),
+			Interval:      time.Duration(i+1) * time.Hour,
+			NextRunAt:     clock.Now().Add(time.Duration(i) * time.Hour),
+		})
+	}
+
+	t.Run("Upsert", func(t *testing.T) {
+		for _, es := range schedules {
+			require.NoError(t, s.UpsertBatchChangeExecutionSchedule(ctx, es))
+			require.NotZero(t, es.ID)
+			assert.Equal(t, clock.Now(), es.CreatedAt)
+		}
+
+		// Upserting the schedule of the same batch change updates it in place,
+		// but keeps the execution in progress.
+		es := schedules[0]
+		es.BatchSpecID = 4321
+		require.NoError(t, s.UpdateBatchChangeExecutionSchedule(ctx, es))
+
+		replaced := &btypes.BatchChangeExecutionSchedule{
+			BatchChangeID: es.BatchChangeID,
+			UserID:        es.UserID,
+			Interval:      24 * time.Hour,
+			AutoApply:     true,
+			NextRunAt:     es.NextRunAt,
+		}
+		require.NoError(t, s.UpsertBatchChangeExecutionSchedule(ctx, replaced))
+		assert.Equal(t, es.ID, replaced.ID)
+		assert.Equal(t, int64(4321), replaced.BatchSpecID)
+		assert.Equal(t, 24*time.Hour, replaced.Interval)
+		assert.True(t, replaced.AutoApply)
+		schedules[0] = replaced
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		for _, es := range schedules {
+			have, err := s.GetBatchChangeExecutionSchedule(ctx, GetBatchChangeExecutionScheduleOpts{BatchChangeID: es.BatchChangeID})
+			require.NoError(t, err)
+			if diff := cmp.Diff(es, have); diff != "" {
+				t.Fatal(diff)
+			}
+		}
+
+		_, err := s.GetBatchChangeExecutionSchedule(ctx, GetBatchChangeExecutionScheduleOpts{BatchChangeID: 999})
+		assert.Equal(t, ErrNoResults, err)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		have, err := s.ListBatchChangeExecutionSchedules(ctx, ListBatchChangeExecutionSchedulesOpts{})
+		require.NoError(t, err)
+		assert.Len(t, have, 2)
+
+		// The first schedule has an execution in progress, the second one is
+		// not due yet.
+		have, err = s.ListBatchChangeExecutionSchedules(ctx, ListBatchChangeExecutionSchedulesOpts{OnlyActionable: true})
+		require.NoError(t, err)
+		require.Len(t, have, 1)
+		assert.Equal(t, schedules[0].ID, have[0].ID)
+
+		clock.Add(2 * time.Hour)
+		have, err = s.ListBatchChangeExecutionSchedules(ctx, ListBatchChangeExecutionSchedulesOpts{OnlyActionable: true})
+		require.NoError(t, err)
+		assert.Len(t, have, 2)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		for _, es := range schedules {
+			require.NoError(t, s.DeleteBatchChangeExecutionSchedule(ctx, es.BatchChangeID))
+		}
+
+		assert.Equal(t, ErrNoResults, s.DeleteBatchChangeExecutionSchedule(ctx, schedules[0].BatchChangeID))
+	})
+}
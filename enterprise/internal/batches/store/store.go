@@ -276,6 +276,12 @@ type operations struct {
 	getBatchSpecResolutionJob    *observation.Operation
 	listBatchSpecResolutionJobs  *observation.Operation
 
+	upsertBatchChangeExecutionSchedule *observation.Operation
+	updateBatchChangeExecutionSchedule *observation.Operation
+	deleteBatchChangeExecutionSchedule *observation.Operation
+	getBatchChangeExecutionSchedule    *observation.Operation
+	listBatchChangeExecutionSchedules  *observation.Operation
+
 	listBatchSpecExecutionCacheEntries     *observation.Operation
 	markUsedBatchSpecExecutionCacheEntries *observation.Operation
 	createBatchSpecExecutionCacheEntry     *observation.Operation
@@ -419,6 +425,12 @@ func newOperations(observationContext *observation.Context) *operations {
 			getBatchSpecResolutionJob:    op("GetBatchSpecResolutionJob"),
 			listBatchSpecResolutionJobs:  op("ListBatchSpecResolutionJobs"),
 
+			upsertBatchChangeExecutionSchedule: op("UpsertBatchChangeExecutionSchedule"),
+			updateBatchChangeExecutionSchedule: op("UpdateBatchChangeExecutionSchedule"),
+			deleteBatchChangeExecutionSchedule: op("DeleteBatchChangeExecutionSchedule"),
+			getBatchChangeExecutionSchedule:    op("GetBatchChangeExecutionSchedule"),
+			listBatchChangeExecutionSchedules:  op("ListBatchChangeExecutionSchedules"),
+
 			listBatchSpecExecutionCacheEntries:     op("ListBatchSpecExecutionCacheEntries"),
 			markUsedBatchSpecExecutionCacheEntries: op("MarkUsedBatchSpecExecutionCacheEntries"),
 			createBatchSpecExecutionCacheEntry:     op("CreateBatchSpecExecutionCacheEntry"),
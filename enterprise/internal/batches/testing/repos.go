@@ -42,6 +42,10 @@ func TestRepo(t *testing.T, store database.ExternalServiceStore, serviceKind str
 		svc.Config = extsvc.NewUnencryptedConfig(`{"url": "https://bitbucket.org", "username": "user", "appPassword": "pass"}`)
 	case extsvc.KindBitbucketServer:
 		svc.Config = extsvc.NewUnencryptedConfig(`{"url": "https://bitbucket.org", "username": "user", "token": "abc", "repos": ["owner/name"]}`)
+	case extsvc.KindAzureDevOps:
+		svc.Config = extsvc.NewUnencryptedConfig(`{"url": "https://dev.azure.com", "username": "user", "token": "abc", "projects": ["org/project"]}`)
+	case extsvc.KindGerrit:
+		svc.Config = extsvc.NewUnencryptedConfig(`{"url": "https://gerrit.example.com", "username": "user", "password": "pass"}`)
 	case extsvc.KindAWSCodeCommit:
 		svc.Config = extsvc.NewUnencryptedConfig(`{"region": "us-east-1", "accessKeyID": "abc", "secretAccessKey": "abc", "gitCredentials": {"username": "user", "password": "pass"}}`)
 	default:
@@ -0,0 +1,33 @@
+package types
+
+import "time"
+
+// A BatchChangeExecutionSchedule periodically re-executes the batch spec
+// applied to a BatchChange server-side, so that repositories that started
+// matching the `on` queries of the spec since it was last applied are picked
+// up.
+type BatchChangeExecutionSchedule struct {
+	ID            int64
+	BatchChangeID int64
+
+	// UserID is the user who set up the schedule. Scheduled executions are
+	// performed on their behalf.
+	UserID int32
+
+	Interval  time.Duration
+	AutoApply bool
+
+	// BatchSpecID is the ID of the batch spec created by the scheduled
+	// execution currently in progress, or 0 if there is none.
+	BatchSpecID int64
+
+	NextRunAt time.Time
+	LastRunAt time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// InProgress returns true if a scheduled execution has been started but not
+// yet finished.
+func (s *BatchChangeExecutionSchedule) InProgress() bool { return s.BatchSpecID != 0 }
@@ -8,11 +8,15 @@ import (
 	"github.com/inconshreveable/log15"
 	"github.com/sourcegraph/go-diff/diff"
 
+	adobatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/azuredevops"
 	bbcs "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/bitbucketcloud"
+	gerritbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gerrit"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
@@ -420,6 +424,41 @@ func (c *Changeset) SetMetadata(meta any) error {
 		} else {
 			c.ExternalForkNamespace = ""
 		}
+	case *adobatches.AnnotatedPullRequest:
+		c.Metadata = pr
+		c.ExternalID = strconv.Itoa(pr.ID)
+		c.ExternalServiceType = extsvc.TypeAzureDevOps
+		c.ExternalBranch = gitdomain.EnsureRefPrefix(pr.SourceRefName)
+		// Azure DevOps doesn't track when a pull request was last updated, so
+		// we use the most recent timestamp we know of.
+		c.ExternalUpdatedAt = pr.CreationDate
+		if pr.ClosedDate != nil && pr.ClosedDate.After(c.ExternalUpdatedAt) {
+			c.ExternalUpdatedAt = *pr.ClosedDate
+		}
+		for _, status := range pr.Statuses {
+			if status.UpdatedDate.After(c.ExternalUpdatedAt) {
+				c.ExternalUpdatedAt = status.UpdatedDate
+			}
+		}
+
+		if pr.ForkSource != nil {
+			c.ExternalForkNamespace = pr.ForkSource.Repository.Project.Name
+		} else {
+			c.ExternalForkNamespace = ""
+		}
+	case *gerritbatches.AnnotatedChange:
+		c.Metadata = pr
+		c.ExternalID = gerritbatches.ChangeTripletID(pr.Project, pr.Branch, pr.ChangeID)
+		c.ExternalServiceType = extsvc.TypeGerrit
+		// Gerrit changes don't have a source branch, so the reconciler pushes
+		// them with the branch name of the changeset spec as their topic.
+		if pr.Topic != "" {
+			c.ExternalBranch = gitdomain.EnsureRefPrefix(pr.Topic)
+		} else {
+			c.ExternalBranch = ""
+		}
+		c.ExternalUpdatedAt = pr.Updated.Time
+		c.ExternalForkNamespace = ""
 	default:
 		return errors.New("unknown changeset type")
 	}
@@ -447,6 +486,10 @@ func (c *Changeset) Title() (string, error) {
 		return m.Title, nil
 	case *bbcs.AnnotatedPullRequest:
 		return m.Title, nil
+	case *adobatches.AnnotatedPullRequest:
+		return m.Title, nil
+	case *gerritbatches.AnnotatedChange:
+		return m.Subject, nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -466,6 +509,10 @@ func (c *Changeset) AuthorName() (string, error) {
 		return m.Author.Username, nil
 	case *bbcs.AnnotatedPullRequest:
 		return m.Author.Username, nil
+	case *adobatches.AnnotatedPullRequest:
+		return m.CreatedBy.UniqueName, nil
+	case *gerritbatches.AnnotatedChange:
+		return m.Owner.Username, nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -493,6 +540,15 @@ func (c *Changeset) AuthorEmail() (string, error) {
 		// Bitbucket Cloud does not provide the e-mail of the author under any
 		// circumstances.
 		return "", nil
+	case *adobatches.AnnotatedPullRequest:
+		// The unique name of Azure Active Directory and Microsoft accounts is
+		// their e-mail address.
+		if strings.Contains(m.CreatedBy.UniqueName, "@") {
+			return m.CreatedBy.UniqueName, nil
+		}
+		return "", nil
+	case *gerritbatches.AnnotatedChange:
+		return m.Owner.Email, nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -511,6 +567,10 @@ func (c *Changeset) ExternalCreatedAt() time.Time {
 		return m.CreatedAt.Time
 	case *bbcs.AnnotatedPullRequest:
 		return m.CreatedOn
+	case *adobatches.AnnotatedPullRequest:
+		return m.CreationDate
+	case *gerritbatches.AnnotatedChange:
+		return m.Created.Time
 	default:
 		return time.Time{}
 	}
@@ -527,6 +587,11 @@ func (c *Changeset) Body() (string, error) {
 		return m.Description, nil
 	case *bbcs.AnnotatedPullRequest:
 		return m.Rendered.Description.Raw, nil
+	case *adobatches.AnnotatedPullRequest:
+		return m.Description, nil
+	case *gerritbatches.AnnotatedChange:
+		_, body := gerritbatches.ParseCommitMessage(m.CommitMessage())
+		return body, nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -572,6 +637,10 @@ func (c *Changeset) URL() (s string, err error) {
 		// pull request ID, but since the link _should_ be there, we'll error
 		// instead.
 		return "", errors.New("Bitbucket Cloud pull request does not have a html link")
+	case *adobatches.AnnotatedPullRequest:
+		return m.WebURL(), nil
+	case *gerritbatches.AnnotatedChange:
+		return m.URL(), nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -744,6 +813,61 @@ func (c *Changeset) Events() (events []*ChangesetEvent, err error) {
 				Metadata:    status,
 			})
 		}
+
+	case *adobatches.AnnotatedPullRequest:
+		// As with Bitbucket Cloud, we create review events based on the
+		// reviewers of the pull request, and check events based on the build
+		// statuses.
+		var kind ChangesetEventKind
+
+		for i := range m.Reviewers {
+			reviewer := &m.Reviewers[i]
+			if kind, err = ChangesetEventKindFor(reviewer); err != nil {
+				return
+			}
+			appendEvent(&ChangesetEvent{
+				ChangesetID: c.ID,
+				// Reviewers don't have a unique ID beyond their identity, so
+				// we combine it with the repo ID and the PR ID.
+				Key:      m.Repository.ID + ":" + strconv.Itoa(m.ID) + ":" + reviewer.ID,
+				Kind:     kind,
+				Metadata: reviewer,
+			})
+		}
+
+		for _, status := range m.Statuses {
+			if kind, err = ChangesetEventKindFor(status); err != nil {
+				return
+			}
+			appendEvent(&ChangesetEvent{
+				ChangesetID: c.ID,
+				Key:         status.Key(),
+				Kind:        kind,
+				Metadata:    status,
+			})
+		}
+
+	case *gerritbatches.AnnotatedChange:
+		// Gerrit only returns the current votes on the labels of a change, so
+		// we create review events based on the votes on the Code-Review label.
+		// The check state is computed from the Verified label directly.
+		var kind ChangesetEventKind
+
+		label := m.Labels[gerrit.CodeReviewLabel]
+		for i := range label.All {
+			approval := &label.All[i]
+			if kind, err = ChangesetEventKindFor(approval); err != nil {
+				return
+			}
+			appendEvent(&ChangesetEvent{
+				ChangesetID: c.ID,
+				// Approvals don't have a unique ID beyond the account that
+				// voted, so we combine it with the change ID.
+				Key:      m.ChangeID + ":" + approval.Key(),
+				Kind:     kind,
+				Metadata: approval,
+			})
+		}
 	}
 	return events, nil
 }
@@ -761,6 +885,13 @@ func (c *Changeset) HeadRefOid() (string, error) {
 		return m.DiffRefs.HeadSHA, nil
 	case *bbcs.AnnotatedPullRequest:
 		return m.Source.Commit.Hash, nil
+	case *adobatches.AnnotatedPullRequest:
+		if m.LastMergeSourceCommit == nil {
+			return "", nil
+		}
+		return m.LastMergeSourceCommit.CommitID, nil
+	case *gerritbatches.AnnotatedChange:
+		return m.CurrentRevision, nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -778,6 +909,13 @@ func (c *Changeset) HeadRef() (string, error) {
 		return "refs/heads/" + m.SourceBranch, nil
 	case *bbcs.AnnotatedPullRequest:
 		return "refs/heads/" + m.Source.Branch.Name, nil
+	case *adobatches.AnnotatedPullRequest:
+		return m.SourceRefName, nil
+	case *gerritbatches.AnnotatedChange:
+		if m.Topic == "" {
+			return "", nil
+		}
+		return gitdomain.EnsureRefPrefix(m.Topic), nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -796,6 +934,16 @@ func (c *Changeset) BaseRefOid() (string, error) {
 		return m.DiffRefs.BaseSHA, nil
 	case *bbcs.AnnotatedPullRequest:
 		return m.Destination.Commit.Hash, nil
+	case *adobatches.AnnotatedPullRequest:
+		if m.LastMergeTargetCommit == nil {
+			return "", nil
+		}
+		return m.LastMergeTargetCommit.CommitID, nil
+	case *gerritbatches.AnnotatedChange:
+		if r, ok := m.CurrentRevisionInfo(); ok && r.Commit != nil && len(r.Commit.Parents) > 0 {
+			return r.Commit.Parents[0].Commit, nil
+		}
+		return "", nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -813,6 +961,10 @@ func (c *Changeset) BaseRef() (string, error) {
 		return "refs/heads/" + m.TargetBranch, nil
 	case *bbcs.AnnotatedPullRequest:
 		return "refs/heads/" + m.Destination.Branch.Name, nil
+	case *adobatches.AnnotatedPullRequest:
+		return m.TargetRefName, nil
+	case *gerritbatches.AnnotatedChange:
+		return "refs/heads/" + m.Branch, nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -1135,6 +1287,50 @@ func ChangesetEventKindFor(e any) (ChangesetEventKind, error) {
 		return ChangesetEventKindBitbucketCloudRepoCommitStatusCreated, nil
 	case *bitbucketcloud.RepoCommitStatusUpdatedEvent:
 		return ChangesetEventKindBitbucketCloudRepoCommitStatusUpdated, nil
+
+	case *azuredevops.Reviewer:
+		switch e.Vote {
+		case azuredevops.PullRequestVoteApproved:
+			return ChangesetEventKindAzureDevOpsApproved, nil
+		case azuredevops.PullRequestVoteApprovedWithSuggestions:
+			return ChangesetEventKindAzureDevOpsApprovedWithSuggestions, nil
+		case azuredevops.PullRequestVoteWaitingForAuthor:
+			return ChangesetEventKindAzureDevOpsWaitingForAuthor, nil
+		case azuredevops.PullRequestVoteRejected:
+			return ChangesetEventKindAzureDevOpsRejected, nil
+		default:
+			return ChangesetEventKindAzureDevOpsReviewed, nil
+		}
+	case *azuredevops.PullRequestBuildStatus:
+		return ChangesetEventKindAzureDevOpsBuildStatus, nil
+
+	case *azuredevops.PullRequestUpdatedEvent:
+		switch e.PullRequest.Status {
+		case azuredevops.PullRequestStatusAbandoned:
+			return ChangesetEventKindAzureDevOpsPullRequestAbandoned, nil
+		case azuredevops.PullRequestStatusCompleted:
+			return ChangesetEventKindAzureDevOpsPullRequestCompleted, nil
+		default:
+			return ChangesetEventKindAzureDevOpsPullRequestUpdated, nil
+		}
+	case *azuredevops.PullRequestMergedEvent:
+		return ChangesetEventKindAzureDevOpsPullRequestMergeAttempted, nil
+	case *azuredevops.PullRequestCommentedEvent:
+		return ChangesetEventKindAzureDevOpsPullRequestCommented, nil
+
+	case *gerrit.ApprovalInfo:
+		switch {
+		case e.Value >= 2:
+			return ChangesetEventKindGerritApproved, nil
+		case e.Value == 1:
+			return ChangesetEventKindGerritRecommended, nil
+		case e.Value == -1:
+			return ChangesetEventKindGerritDisliked, nil
+		case e.Value <= -2:
+			return ChangesetEventKindGerritRejected, nil
+		default:
+			return ChangesetEventKindGerritReviewed, nil
+		}
 	}
 
 	return ChangesetEventKindInvalid, errors.Errorf("unknown changeset event kind for %T", e)
@@ -1144,6 +1340,35 @@ func ChangesetEventKindFor(e any) (ChangesetEventKind, error) {
 // ChangesetEventKind.
 func NewChangesetEventMetadata(k ChangesetEventKind) (any, error) {
 	switch {
+	case strings.HasPrefix(string(k), "gerrit"):
+		switch k {
+		case ChangesetEventKindGerritApproved,
+			ChangesetEventKindGerritRecommended,
+			ChangesetEventKindGerritReviewed,
+			ChangesetEventKindGerritDisliked,
+			ChangesetEventKindGerritRejected:
+			return new(gerrit.ApprovalInfo), nil
+		}
+	case strings.HasPrefix(string(k), "azuredevops"):
+		switch k {
+		case ChangesetEventKindAzureDevOpsApproved,
+			ChangesetEventKindAzureDevOpsApprovedWithSuggestions,
+			ChangesetEventKindAzureDevOpsReviewed,
+			ChangesetEventKindAzureDevOpsWaitingForAuthor,
+			ChangesetEventKindAzureDevOpsRejected:
+			return new(azuredevops.Reviewer), nil
+		case ChangesetEventKindAzureDevOpsBuildStatus:
+			return new(azuredevops.PullRequestBuildStatus), nil
+
+		case ChangesetEventKindAzureDevOpsPullRequestUpdated,
+			ChangesetEventKindAzureDevOpsPullRequestAbandoned,
+			ChangesetEventKindAzureDevOpsPullRequestCompleted:
+			return new(azuredevops.PullRequestUpdatedEvent), nil
+		case ChangesetEventKindAzureDevOpsPullRequestMergeAttempted:
+			return new(azuredevops.PullRequestMergedEvent), nil
+		case ChangesetEventKindAzureDevOpsPullRequestCommented:
+			return new(azuredevops.PullRequestCommentedEvent), nil
+		}
 	case strings.HasPrefix(string(k), "bitbucketcloud"):
 		switch k {
 		case ChangesetEventKindBitbucketCloudApproved,
@@ -8,8 +8,10 @@ import (
 
 	"github.com/inconshreveable/log15"
 
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
 	gitlabwebhooks "github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab/webhooks"
@@ -102,6 +104,32 @@ const (
 	ChangesetEventKindBitbucketCloudRepoCommitStatusCreated          ChangesetEventKind = "bitbucketcloud:repo:commit_status_created"          // RepoCommitStatusCreatedEvent
 	ChangesetEventKindBitbucketCloudRepoCommitStatusUpdated          ChangesetEventKind = "bitbucketcloud:repo:commit_status_updated"          // RepoCommitStatusUpdatedEvent
 
+	// These changeset events are created as the result of regular syncs with
+	// Azure DevOps.
+	ChangesetEventKindAzureDevOpsApproved                ChangesetEventKind = "azuredevops:approved"
+	ChangesetEventKindAzureDevOpsApprovedWithSuggestions ChangesetEventKind = "azuredevops:approved_with_suggestions"
+	ChangesetEventKindAzureDevOpsReviewed                ChangesetEventKind = "azuredevops:reviewed"
+	ChangesetEventKindAzureDevOpsWaitingForAuthor        ChangesetEventKind = "azuredevops:waiting_for_author"
+	ChangesetEventKindAzureDevOpsRejected                ChangesetEventKind = "azuredevops:rejected"
+	ChangesetEventKindAzureDevOpsBuildStatus             ChangesetEventKind = "azuredevops:build_status"
+
+	// These changes events are created in response to webhooks received from
+	// Azure DevOps. The exact type that matches each event is included in a
+	// comment after the constant.
+	ChangesetEventKindAzureDevOpsPullRequestUpdated        ChangesetEventKind = "azuredevops:pullrequest:updated"         // PullRequestUpdatedEvent
+	ChangesetEventKindAzureDevOpsPullRequestAbandoned      ChangesetEventKind = "azuredevops:pullrequest:abandoned"       // PullRequestUpdatedEvent
+	ChangesetEventKindAzureDevOpsPullRequestCompleted      ChangesetEventKind = "azuredevops:pullrequest:completed"       // PullRequestUpdatedEvent
+	ChangesetEventKindAzureDevOpsPullRequestMergeAttempted ChangesetEventKind = "azuredevops:pullrequest:merge_attempted" // PullRequestMergedEvent
+	ChangesetEventKindAzureDevOpsPullRequestCommented      ChangesetEventKind = "azuredevops:pullrequest:commented"       // PullRequestCommentedEvent
+
+	// These changeset events are created as the result of regular syncs with
+	// Gerrit, based on the votes on the Code-Review label of a change.
+	ChangesetEventKindGerritApproved    ChangesetEventKind = "gerrit:approved"
+	ChangesetEventKindGerritRecommended ChangesetEventKind = "gerrit:recommended"
+	ChangesetEventKindGerritReviewed    ChangesetEventKind = "gerrit:reviewed"
+	ChangesetEventKindGerritDisliked    ChangesetEventKind = "gerrit:disliked"
+	ChangesetEventKindGerritRejected    ChangesetEventKind = "gerrit:rejected"
+
 	ChangesetEventKindInvalid ChangesetEventKind = "invalid"
 )
 
@@ -163,6 +191,12 @@ func (e *ChangesetEvent) ReviewAuthor() string {
 	case *bitbucketcloud.PullRequestChangesRequestRemovedEvent:
 		return meta.ChangesRequest.User.UUID
 
+	case *azuredevops.Reviewer:
+		return meta.ID
+
+	case *gerrit.ApprovalInfo:
+		return meta.Username
+
 	default:
 		return ""
 	}
@@ -174,14 +208,22 @@ func (e *ChangesetEvent) ReviewState() (ChangesetReviewState, error) {
 	case ChangesetEventKindBitbucketServerApproved,
 		ChangesetEventKindGitLabApproved,
 		ChangesetEventKindBitbucketCloudApproved,
-		ChangesetEventKindBitbucketCloudPullRequestApproved:
+		ChangesetEventKindBitbucketCloudPullRequestApproved,
+		ChangesetEventKindAzureDevOpsApproved,
+		ChangesetEventKindAzureDevOpsApprovedWithSuggestions,
+		ChangesetEventKindGerritApproved,
+		ChangesetEventKindGerritRecommended:
 		return ChangesetReviewStateApproved, nil
 
 	// BitbucketServer's "REVIEWED" activity is created when someone clicks
 	// the "Needs work" button in the UI, which is why we map it to "Changes Requested"
 	case ChangesetEventKindBitbucketServerReviewed,
 		ChangesetEventKindBitbucketCloudChangesRequested,
-		ChangesetEventKindBitbucketCloudPullRequestChangesRequestCreated:
+		ChangesetEventKindBitbucketCloudPullRequestChangesRequestCreated,
+		ChangesetEventKindAzureDevOpsWaitingForAuthor,
+		ChangesetEventKindAzureDevOpsRejected,
+		ChangesetEventKindGerritDisliked,
+		ChangesetEventKindGerritRejected:
 		return ChangesetReviewStateChangesRequested, nil
 
 	case ChangesetEventKindGitHubReviewed:
@@ -316,6 +358,22 @@ func (e *ChangesetEvent) Timestamp() time.Time {
 		t = ev.CommitStatus.CreatedOn
 	case *bitbucketcloud.RepoCommitStatusUpdatedEvent:
 		t = ev.CommitStatus.UpdatedOn
+	case *azuredevops.Reviewer:
+		// Azure DevOps doesn't tell us when a vote was cast, so we fall back
+		// to the event record we created when we synced the changeset.
+		t = e.CreatedAt
+	case *azuredevops.PullRequestBuildStatus:
+		t = ev.UpdatedDate
+	case *azuredevops.PullRequestUpdatedEvent:
+		t = ev.CreatedDate
+	case *azuredevops.PullRequestMergedEvent:
+		t = ev.CreatedDate
+	case *azuredevops.PullRequestCommentedEvent:
+		t = ev.CreatedDate
+	case *gerrit.ApprovalInfo:
+		if ev.Date != nil {
+			t = ev.Date.Time
+		}
 	}
 
 	return t
@@ -854,6 +912,30 @@ func (e *ChangesetEvent) Update(o *ChangesetEvent) error {
 		o := o.Metadata.(*bitbucketcloud.RepoCommitStatusUpdatedEvent)
 		*e = *o
 
+	case *azuredevops.Reviewer:
+		o := o.Metadata.(*azuredevops.Reviewer)
+		*e = *o
+
+	case *azuredevops.PullRequestBuildStatus:
+		o := o.Metadata.(*azuredevops.PullRequestBuildStatus)
+		*e = *o
+
+	case *azuredevops.PullRequestUpdatedEvent:
+		o := o.Metadata.(*azuredevops.PullRequestUpdatedEvent)
+		*e = *o
+
+	case *azuredevops.PullRequestMergedEvent:
+		o := o.Metadata.(*azuredevops.PullRequestMergedEvent)
+		*e = *o
+
+	case *azuredevops.PullRequestCommentedEvent:
+		o := o.Metadata.(*azuredevops.PullRequestCommentedEvent)
+		*e = *o
+
+	case *gerrit.ApprovalInfo:
+		o := o.Metadata.(*gerrit.ApprovalInfo)
+		*e = *o
+
 	default:
 		return errors.Errorf("unknown changeset event metadata %T", e)
 	}
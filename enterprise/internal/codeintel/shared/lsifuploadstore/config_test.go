@@ -106,6 +106,50 @@ func TestConfigGCS(t *testing.T) {
 	}
 }
 
+func TestConfigAzure(t *testing.T) {
+	env := map[string]string{
+		"PRECISE_CODE_INTEL_UPLOAD_BACKEND":            "Azure",
+		"PRECISE_CODE_INTEL_UPLOAD_AZURE_ACCOUNT_NAME": "test-account",
+		"PRECISE_CODE_INTEL_UPLOAD_AZURE_ACCOUNT_KEY":  "dGVzdC1rZXk=",
+	}
+
+	config := Config{}
+	config.SetMockGetter(mapGetter(env))
+	config.Load()
+
+	if err := config.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %s", err)
+	}
+
+	if config.AzureAccountName != "test-account" {
+		t.Errorf("unexpected value for Azure.AccountName. want=%s have=%s", "test-account", config.AzureAccountName)
+	}
+	if config.AzureAccountKey != "dGVzdC1rZXk=" {
+		t.Errorf("unexpected value for Azure.AccountKey. want=%s have=%s", "dGVzdC1rZXk=", config.AzureAccountKey)
+	}
+	if config.AzureEndpoint != "" {
+		t.Errorf("unexpected value for Azure.Endpoint. want=%s have=%s", "", config.AzureEndpoint)
+	}
+}
+
+func TestConfigLocal(t *testing.T) {
+	env := map[string]string{
+		"PRECISE_CODE_INTEL_UPLOAD_BACKEND": "Local",
+	}
+
+	config := Config{}
+	config.SetMockGetter(mapGetter(env))
+	config.Load()
+
+	if err := config.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %s", err)
+	}
+
+	if config.LocalRoot != "/data/uploadstore" {
+		t.Errorf("unexpected value for Local.Root. want=%s have=%s", "/data/uploadstore", config.LocalRoot)
+	}
+}
+
 func mapGetter(env map[string]string) func(name, defaultValue, description string) string {
 	return func(name, defaultValue, description string) string {
 		if v, ok := env[name]; ok {
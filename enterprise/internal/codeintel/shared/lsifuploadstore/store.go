@@ -26,6 +26,14 @@ func New(ctx context.Context, conf *Config, observationContext *observation.Cont
 			CredentialsFile:         conf.GCSCredentialsFile,
 			CredentialsFileContents: conf.GCSCredentialsFileContents,
 		},
+		Local: uploadstore.LocalConfig{
+			Root: conf.LocalRoot,
+		},
+		Azure: uploadstore.AzureConfig{
+			AccountName: conf.AzureAccountName,
+			AccountKey:  conf.AzureAccountKey,
+			Endpoint:    conf.AzureEndpoint,
+		},
 	}
 
 	return uploadstore.CreateLazy(ctx, c, uploadstore.NewOperations(observationContext, "codeintel", "uploadstore"))
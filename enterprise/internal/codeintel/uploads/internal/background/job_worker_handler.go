@@ -1,8 +1,10 @@
 package background
 
 import (
+	"bufio"
 	"compress/gzip"
 	"context"
+	"encoding/binary"
 	"fmt"
 	"io"
 	"sync/atomic"
@@ -13,6 +15,9 @@ import (
 	otlog "github.com/opentracing/opentracing-go/log"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sourcegraph/log"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
 
 	codeinteltypes "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/shared/types"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads/internal/lsifstore"
@@ -27,6 +32,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker"
 	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/conversion"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/pathexistence"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -232,9 +238,9 @@ func (s *handler) HandleRawUpload(ctx context.Context, logger log.Logger, upload
 	}
 
 	return false, withUploadData(ctx, logger, uploadStore, upload.ID, trace, func(r io.Reader) (err error) {
-		groupedBundleData, err := conversion.Correlate(ctx, r, upload.Root, getChildren)
+		groupedBundleData, err := correlateUpload(ctx, r, upload.Root, getChildren, trace)
 		if err != nil {
-			return errors.Wrap(err, "conversion.Correlate")
+			return err
 		}
 
 		// Find the commit date for the commit attached to this upload record and insert it into the
@@ -398,6 +404,120 @@ func withUploadData(ctx context.Context, logger log.Logger, uploadStore uploadst
 	return nil
 }
 
+// correlateUpload converts the raw upload data into grouped bundle data ready to be written into
+// the codeintel database. Uploads may either be LSIF indexes (newline-delimited JSON) or SCIP
+// indexes (protobuf), which are told apart by their leading bytes. SCIP indexes are
+// translated into an LSIF graph in-memory and correlated the same way as LSIF uploads, so that
+// both are stored in the same shape and served by the same code navigation queries.
+func correlateUpload(ctx context.Context, r io.Reader, root string, getChildren pathexistence.GetChildrenFunc, trace observation.TraceLogger) (*precise.GroupedBundleDataChans, error) {
+	br := bufio.NewReader(r)
+
+	isSCIP, err := isSCIPIndex(br)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading upload")
+	}
+	trace.Log(otlog.Bool("scip", isSCIP))
+
+	if !isSCIP {
+		groupedBundleData, err := conversion.Correlate(ctx, br, root, getChildren)
+		if err != nil {
+			return nil, errors.Wrap(err, "conversion.Correlate")
+		}
+		return groupedBundleData, nil
+	}
+
+	content, err := io.ReadAll(br)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading upload")
+	}
+
+	var index scip.Index
+	if err := proto.Unmarshal(content, &index); err != nil {
+		return nil, errors.Wrap(err, "proto.Unmarshal")
+	}
+	trace.Log(otlog.Int("numSCIPDocuments", len(index.Documents)))
+
+	elements, err := scip.ConvertSCIPToLSIF(&index)
+	if err != nil {
+		return nil, errors.Wrap(err, "scip.ConvertSCIPToLSIF")
+	}
+
+	groupedBundleData, err := conversion.CorrelateElements(ctx, elements, root, getChildren)
+	if err != nil {
+		return nil, errors.Wrap(err, "conversion.CorrelateElements")
+	}
+	return groupedBundleData, nil
+}
+
+// scipMetadataTag is the first byte of a SCIP index with metadata: the tag of the length-delimited
+// metadata field (field number 1). It is the same byte as a newline.
+const scipMetadataTag = 0x0A
+
+// isSCIPIndex returns true if the buffered upload data looks like a SCIP index. LSIF uploads are
+// newline-delimited JSON objects and always start with an opening brace after optional whitespace,
+// which is never a valid first byte of a serialized SCIP index. A leading newline may also be the
+// metadata field tag of a SCIP index, in which case the metadata that follows tells them apart.
+func isSCIPIndex(r *bufio.Reader) (bool, error) {
+	peeked, err := r.Peek(1)
+	if err != nil {
+		if err == io.EOF {
+			// Empty uploads are handled as LSIF, which produces a descriptive
+			// missing metadata error.
+			return false, nil
+		}
+		return false, err
+	}
+	if peeked[0] == scipMetadataTag {
+		return hasSCIPMetadata(r)
+	}
+
+	for i := 1; ; i++ {
+		peeked, err := r.Peek(i)
+		if err != nil {
+			if err == io.EOF {
+				return false, nil
+			}
+			return false, err
+		}
+
+		switch c := peeked[i-1]; c {
+		case ' ', '\t', '\r', '\n':
+			continue
+		default:
+			return c != '{', nil
+		}
+	}
+}
+
+// hasSCIPMetadata returns true if the buffered upload data starts with a serialized SCIP metadata
+// field: the field tag, a varint length, and a metadata message of that length.
+func hasSCIPMetadata(r *bufio.Reader) (bool, error) {
+	header, err := r.Peek(1 + binary.MaxVarintLen64)
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+	length, n := protowire.ConsumeVarint(header[1:])
+	if n < 0 {
+		return false, nil
+	}
+	if length > uint64(r.Size()-1-n) {
+		// A newline starting an LSIF upload is followed by a single byte below 0x80, so
+		// a metadata message too large to peek at can only belong to a SCIP index.
+		return true, nil
+	}
+
+	field, err := r.Peek(1 + n + int(length))
+	if err != nil {
+		if err == io.EOF {
+			return false, nil
+		}
+		return false, err
+	}
+
+	var metadata scip.Metadata
+	return proto.Unmarshal(field[1+n:], &metadata) == nil, nil
+}
+
 // writeData transactionally writes the given grouped bundle data into the given LSIF store.
 func writeData(ctx context.Context, lsifStore lsifstore.LsifStore, upload codeinteltypes.Upload, repo *types.Repo, isDefaultBranch bool, groupedBundleData *precise.GroupedBundleDataChans, trace observation.TraceLogger) (err error) {
 	tx, err := lsifStore.Transact(ctx)
@@ -1,15 +1,22 @@
 package background
 
 import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
 	"context"
+	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/sourcegraph/log/logtest"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
 	codeinteltypes "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/shared/types"
@@ -148,6 +155,80 @@ func TestHandle(t *testing.T) {
 	}
 }
 
+func TestHandleSCIP(t *testing.T) {
+	setupRepoMocks(t)
+
+	upload := codeinteltypes.Upload{
+		ID:           42,
+		Root:         "root/",
+		Commit:       "deadbeef",
+		RepositoryID: 50,
+		Indexer:      "scip-go",
+	}
+
+	mockWorkerStore := NewMockWorkerStore()
+	mockDBStore := NewMockStore()
+	mockRepoStore := NewMockRepoStore()
+	mockLSIFStore := NewMockLsifStore()
+	mockUploadStore := uploadstoremocks.NewMockStore()
+	gitserverClient := NewMockGitserverClient()
+
+	// Set default transaction behavior
+	mockDBStore.TransactFunc.SetDefaultReturn(mockDBStore, nil)
+	mockDBStore.DoneFunc.SetDefaultHook(func(err error) error { return err })
+
+	// Set default transaction behavior
+	mockLSIFStore.TransactFunc.SetDefaultReturn(mockLSIFStore, nil)
+	mockLSIFStore.DoneFunc.SetDefaultHook(func(err error) error { return err })
+
+	// Give correlation package a valid SCIP index
+	mockUploadStore.GetFunc.SetDefaultHook(copyTestSCIPIndex(t))
+
+	// Allowlist all files in index
+	gitserverClient.DirectoryChildrenFunc.SetDefaultReturn(map[string][]string{
+		"":     {"root"},
+		"root": {"root/foo.go", "root/bar.go"},
+	}, nil)
+
+	gitserverClient.CommitDateFunc.SetDefaultReturn("deadbeef", time.Unix(1587396557, 0).UTC(), true, nil)
+
+	var paths []string
+	mockLSIFStore.WriteDocumentsFunc.SetDefaultHook(func(ctx context.Context, bundleID int, documents chan precise.KeyedDocumentData) (uint32, error) {
+		for document := range documents {
+			paths = append(paths, document.Path)
+		}
+		return uint32(len(paths)), nil
+	})
+
+	svc := &handler{
+		store:           mockDBStore,
+		lsifstore:       mockLSIFStore,
+		gitserverClient: gitserverClient,
+		repoStore:       mockRepoStore,
+		workerStore:     mockWorkerStore,
+	}
+
+	requeued, err := svc.HandleRawUpload(context.Background(), logtest.Scoped(t), upload, mockUploadStore, observation.TestTraceLogger(logtest.Scoped(t)))
+	if err != nil {
+		t.Fatalf("unexpected error handling upload: %s", err)
+	} else if requeued {
+		t.Errorf("unexpected requeue")
+	}
+
+	sort.Strings(paths)
+	if diff := cmp.Diff([]string{"bar.go", "foo.go"}, paths); diff != "" {
+		t.Errorf("unexpected document paths (-want +got):\n%s", diff)
+	}
+
+	if len(mockDBStore.UpdatePackagesFunc.History()) != 1 {
+		t.Errorf("unexpected number of UpdatePackages calls. want=%d have=%d", 1, len(mockDBStore.UpdatePackagesFunc.History()))
+	}
+
+	if len(mockUploadStore.DeleteFunc.History()) != 1 {
+		t.Errorf("unexpected number of Delete calls. want=%d have=%d", 1, len(mockUploadStore.DeleteFunc.History()))
+	}
+}
+
 func TestHandleError(t *testing.T) {
 	setupRepoMocks(t)
 
@@ -261,6 +342,120 @@ func copyTestDump(ctx context.Context, key string) (io.ReadCloser, error) {
 	return os.Open("./testdata/dump1.lsif.gz")
 }
 
+// copyTestSCIPIndex returns an upload store hook that serves a small gzipped
+// SCIP index with a definition in foo.go and a reference to it in bar.go.
+func copyTestSCIPIndex(t *testing.T) func(ctx context.Context, key string) (io.ReadCloser, error) {
+	const symbol = "scip-go gomod github.com/sourcegraph/test v1.0.0 `github.com/sourcegraph/test`/Foo()."
+
+	index := &scip.Index{
+		// The serialized metadata length (0x7B) is the same byte as an opening brace
+		Metadata: testSCIPMetadata(t, &scip.Metadata{
+			ToolInfo:             &scip.ToolInfo{Name: "scip-go", Version: "0.1.0"},
+			ProjectRoot:          "file:///root",
+			TextDocumentEncoding: scip.TextEncoding_UTF8,
+		}, '{'),
+		Documents: []*scip.Document{
+			{
+				RelativePath: "foo.go",
+				Occurrences: []*scip.Occurrence{
+					{Range: []int32{2, 5, 8}, Symbol: symbol, SymbolRoles: int32(scip.SymbolRole_Definition)},
+				},
+				Symbols: []*scip.SymbolInformation{
+					{Symbol: symbol, Documentation: []string{"```go\nfunc Foo()\n```"}},
+				},
+			},
+			{
+				RelativePath: "bar.go",
+				Occurrences: []*scip.Occurrence{
+					{Range: []int32{4, 1, 4}, Symbol: symbol},
+				},
+			},
+		},
+	}
+
+	payload, err := proto.Marshal(index)
+	if err != nil {
+		t.Fatalf("unexpected error marshalling index: %s", err)
+	}
+
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	if _, err := gzipWriter.Write(payload); err != nil {
+		t.Fatalf("unexpected error compressing index: %s", err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		t.Fatalf("unexpected error compressing index: %s", err)
+	}
+
+	return func(ctx context.Context, key string) (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(buf.Bytes())), nil
+	}
+}
+
+// testSCIPMetadata pads the tool arguments of the given SCIP metadata so that it serializes to
+// exactly the given number of bytes.
+func testSCIPMetadata(t *testing.T, metadata *scip.Metadata, size int) *scip.Metadata {
+	for i := 0; i < size; i++ {
+		metadata.ToolInfo.Arguments = []string{strings.Repeat("-", i)}
+		if proto.Size(metadata) == size {
+			return metadata
+		}
+	}
+
+	t.Fatalf("cannot construct SCIP metadata of %d bytes", size)
+	return nil
+}
+
+func TestIsSCIPIndex(t *testing.T) {
+	marshal := func(index *scip.Index) string {
+		payload, err := proto.Marshal(index)
+		if err != nil {
+			t.Fatalf("unexpected error marshalling index: %s", err)
+		}
+		return string(payload)
+	}
+
+	const lsif = `{"id":1,"type":"vertex","label":"metaData","version":"0.4.3","projectRoot":"file:///root"}` + "\n"
+
+	testCases := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{name: "empty", input: "", expected: false},
+		{name: "lsif", input: lsif, expected: false},
+		{name: "lsif with leading whitespace", input: " \t\r\n" + lsif, expected: false},
+		{name: "lsif with leading newline", input: "\n" + lsif, expected: false},
+		{name: "lsif with leading newlines", input: "\n\n\n" + lsif, expected: false},
+		{name: "scip without metadata", input: marshal(&scip.Index{Documents: []*scip.Document{{RelativePath: "foo.go"}}}), expected: true},
+	}
+
+	// Metadata lengths that serialize to the same byte as whitespace or an opening brace
+	for _, size := range []int{'\t', '\n', '\r', ' ', '{', 300} {
+		testCases = append(testCases, struct {
+			name     string
+			input    string
+			expected bool
+		}{
+			name:     fmt.Sprintf("scip with %d-byte metadata", size),
+			input:    marshal(&scip.Index{Metadata: testSCIPMetadata(t, &scip.Metadata{ToolInfo: &scip.ToolInfo{}}, size), Documents: []*scip.Document{{RelativePath: "foo.go"}}}),
+			expected: true,
+		})
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			isSCIP, err := isSCIPIndex(bufio.NewReader(strings.NewReader(testCase.input)))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if isSCIP != testCase.expected {
+				t.Errorf("unexpected result. want=%v have=%v", testCase.expected, isSCIP)
+			}
+		})
+	}
+}
+
 func setupRepoMocks(t *testing.T) {
 	t.Cleanup(func() {
 		backend.Mocks.Repos.Get = nil
@@ -0,0 +1,112 @@
+package background
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	maxDeliveryAttempts    = 5
+	initialDeliveryBackoff = time.Second
+	maxDeliveryBackoff     = 30 * time.Second
+)
+
+// deliveryBackoff returns how long to wait before the given retry, starting
+// at 1. It is a variable so tests can avoid sleeping.
+var deliveryBackoff = func(retry int) time.Duration {
+	backoff := initialDeliveryBackoff << (retry - 1)
+	if backoff <= 0 || backoff > maxDeliveryBackoff {
+		return maxDeliveryBackoff
+	}
+	return backoff
+}
+
+// deliverWithRetries calls deliver until it succeeds, fails with an error that
+// is not worth retrying, or maxDeliveryAttempts is reached. Every attempt is
+// recorded in the database so users can inspect the delivery log of an action
+// job.
+//
+// Delivery errors are returned as non-retryable, so that the worker marks the
+// action job as failed instead of retrying all attempts again.
+//
+// The given store must not be a transaction of the action job handler, as
+// attempts must be recorded even if the handler fails.
+func deliverWithRetries(ctx context.Context, logger log.Logger, store edb.CodeMonitorStore, actionJobID int32, doer httpcli.Doer, deliver func(context.Context, httpcli.Doer) error) error {
+	previous, err := store.ListActionDeliveryAttempts(ctx, actionJobID)
+	if err != nil {
+		return errors.Wrap(err, "ListActionDeliveryAttempts")
+	}
+
+	var deliverErr error
+	for i := 0; i < maxDeliveryAttempts; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return errors.Append(deliverErr, ctx.Err())
+			case <-time.After(deliveryBackoff(i)):
+			}
+		}
+
+		recorder := &statusRecordingDoer{Doer: doer}
+		start := time.Now()
+		deliverErr = deliver(ctx, recorder)
+
+		args := edb.ActionDeliveryAttemptArgs{
+			ActionJob:  actionJobID,
+			Attempt:    int32(len(previous) + i + 1),
+			StatusCode: recorder.statusCode,
+			Duration:   time.Since(start),
+		}
+		if deliverErr != nil {
+			msg := deliverErr.Error()
+			args.Error = &msg
+		}
+		if _, err := store.CreateActionDeliveryAttempt(ctx, args); err != nil {
+			logger.Warn("failed to record delivery attempt", log.Int32("actionJobID", actionJobID), log.Error(err))
+		}
+
+		if deliverErr == nil || !isRetryableDeliveryError(deliverErr) {
+			break
+		}
+	}
+	if deliverErr == nil || errors.IsContextError(deliverErr) {
+		return deliverErr
+	}
+	return errcode.MakeNonRetryable(deliverErr)
+}
+
+// isRetryableDeliveryError returns true for network errors, rate limiting and
+// server errors.
+func isRetryableDeliveryError(err error) bool {
+	if errors.IsContextError(err) {
+		return false
+	}
+	var sce StatusCodeError
+	if errors.As(err, &sce) {
+		return sce.Code == http.StatusTooManyRequests || sce.Code >= 500
+	}
+	return true
+}
+
+// statusRecordingDoer records the status code of the last response received.
+type statusRecordingDoer struct {
+	httpcli.Doer
+	statusCode *int32
+}
+
+func (d *statusRecordingDoer) Do(req *http.Request) (*http.Response, error) {
+	resp, err := d.Doer.Do(req)
+	if resp != nil {
+		code := int32(resp.StatusCode)
+		d.statusCode = &code
+	}
+	return resp, err
+}
@@ -0,0 +1,96 @@
+package background
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+)
+
+func TestDeliverWithRetries(t *testing.T) {
+	oldBackoff := deliveryBackoff
+	deliveryBackoff = func(int) time.Duration { return 0 }
+	t.Cleanup(func() { deliveryBackoff = oldBackoff })
+
+	run := func(t *testing.T, statusCodes ...int) ([]edb.ActionDeliveryAttemptArgs, int, error) {
+		t.Helper()
+
+		requests := 0
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			code := statusCodes[len(statusCodes)-1]
+			if requests < len(statusCodes) {
+				code = statusCodes[requests]
+			}
+			requests++
+			w.WriteHeader(code)
+		}))
+		t.Cleanup(s.Close)
+
+		var attempts []edb.ActionDeliveryAttemptArgs
+		store := edb.NewMockCodeMonitorStore()
+		store.CreateActionDeliveryAttemptFunc.SetDefaultHook(func(_ context.Context, args edb.ActionDeliveryAttemptArgs) (*edb.ActionDeliveryAttempt, error) {
+			attempts = append(attempts, args)
+			return &edb.ActionDeliveryAttempt{}, nil
+		})
+
+		err := deliverWithRetries(context.Background(), logtest.Scoped(t), store, 7, s.Client(), func(ctx context.Context, doer httpcli.Doer) error {
+			return postJSON(ctx, doer, s.URL, []byte("{}"), nil)
+		})
+		return attempts, requests, err
+	}
+
+	t.Run("success", func(t *testing.T) {
+		attempts, requests, err := run(t, 200)
+		require.NoError(t, err)
+		require.Equal(t, 1, requests)
+		require.Len(t, attempts, 1)
+		require.Equal(t, int32(7), attempts[0].ActionJob)
+		require.Equal(t, int32(1), attempts[0].Attempt)
+		require.Equal(t, int32(200), *attempts[0].StatusCode)
+		require.Nil(t, attempts[0].Error)
+	})
+
+	t.Run("retries server errors", func(t *testing.T) {
+		attempts, requests, err := run(t, 503, 429, 200)
+		require.NoError(t, err)
+		require.Equal(t, 3, requests)
+		require.Len(t, attempts, 3)
+		require.Equal(t, int32(503), *attempts[0].StatusCode)
+		require.NotNil(t, attempts[0].Error)
+		require.Equal(t, int32(429), *attempts[1].StatusCode)
+		require.Equal(t, int32(3), attempts[2].Attempt)
+		require.Nil(t, attempts[2].Error)
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		attempts, requests, err := run(t, 500)
+		require.Error(t, err)
+		require.True(t, errcode.IsNonRetryable(err))
+		require.Equal(t, maxDeliveryAttempts, requests)
+		require.Len(t, attempts, maxDeliveryAttempts)
+	})
+
+	t.Run("does not retry client errors", func(t *testing.T) {
+		attempts, requests, err := run(t, 400)
+		require.Error(t, err)
+		require.True(t, errcode.IsNonRetryable(err))
+		require.Equal(t, 1, requests)
+		require.Len(t, attempts, 1)
+	})
+}
+
+func TestDeliveryBackoff(t *testing.T) {
+	require.Equal(t, time.Second, deliveryBackoff(1))
+	require.Equal(t, 2*time.Second, deliveryBackoff(2))
+	require.Equal(t, 8*time.Second, deliveryBackoff(4))
+	require.Equal(t, maxDeliveryBackoff, deliveryBackoff(10))
+	require.Equal(t, maxDeliveryBackoff, deliveryBackoff(100))
+}
@@ -0,0 +1,396 @@
+package background
+
+import (
+	"context"
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/slack-go/slack"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/featureflag"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/txemail"
+	"github.com/sourcegraph/sourcegraph/internal/txemail/txtypes"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	// savedSearchRunInterval is how often each saved search with
+	// notifications enabled is run.
+	savedSearchRunInterval = time.Hour
+	// savedSearchBatchSize is the maximum number of saved searches run by
+	// one iteration of the notifier.
+	savedSearchBatchSize = 20
+	// savedSearchMaxDisplayResults is the maximum number of new results
+	// included in a notification.
+	savedSearchMaxDisplayResults = 5
+
+	utmSourceSavedSearchEmail = "saved-search-email"
+	utmSourceSavedSearchSlack = "saved-search-slack"
+)
+
+var (
+	//go:embed saved_search_email_template.html.tmpl
+	savedSearchHTMLTemplate string
+
+	//go:embed saved_search_email_template.txt.tmpl
+	savedSearchTextTemplate string
+)
+
+var savedSearchResultsEmailTemplates = txemail.MustValidate(txtypes.Templates{
+	Subject: `Sourcegraph saved search {{.Description}} has {{.Summary}}`,
+	Text:    savedSearchTextTemplate,
+	HTML:    savedSearchHTMLTemplate,
+})
+
+type TemplateDataSavedSearchResults struct {
+	Description               string
+	SearchURL                 string
+	Summary                   string
+	TruncatedResults          []*SavedSearchDisplayResult
+	TruncatedCount            int
+	TruncatedResultPluralized string
+}
+
+type SavedSearchDisplayResult struct {
+	ResultType string
+	Label      string
+	URL        string
+}
+
+func newSavedSearchNotifier(ctx context.Context, logger log.Logger, db database.DB) goroutine.BackgroundRoutine {
+	n := &savedSearchNotifier{
+		logger: logger,
+		db:     db,
+		doer:   httpcli.ExternalDoer,
+		search: func(ctx context.Context, query string) (result.Matches, streaming.Stats, error) {
+			settings, err := codemonitors.Settings(ctx)
+			if err != nil {
+				return nil, streaming.Stats{}, errors.Wrap(err, "query settings")
+			}
+			return codemonitors.SearchMatches(ctx, logger, db, query, settings)
+		},
+		sendEmail: func(ctx context.Context, userID int32, data *TemplateDataSavedSearchResults) error {
+			return sendEmail(ctx, db, userID, "saved-search", savedSearchResultsEmailTemplates, data)
+		},
+		externalURL: getExternalURL,
+		now:         time.Now,
+	}
+	return goroutine.NewPeriodicGoroutine(ctx, time.Minute, goroutine.NewHandlerWithErrorMessage("saved_search_notifier", n.runDue))
+}
+
+// savedSearchNotifier runs saved searches with notifications enabled on a
+// schedule, and notifies their owners when results appear or disappear.
+type savedSearchNotifier struct {
+	logger      log.Logger
+	db          database.DB
+	doer        httpcli.Doer
+	search      func(ctx context.Context, query string) (result.Matches, streaming.Stats, error)
+	sendEmail   func(ctx context.Context, userID int32, data *TemplateDataSavedSearchResults) error
+	externalURL func(ctx context.Context) (*url.URL, error)
+	now         func() time.Time
+}
+
+func (n *savedSearchNotifier) runDue(ctx context.Context) error {
+	due, err := n.db.SavedSearches().ListDueForNotification(ctx, n.now().Add(-savedSearchRunInterval), savedSearchBatchSize)
+	if err != nil {
+		return err
+	}
+
+	var errs error
+	for _, ss := range due {
+		if err := n.run(ctx, ss); err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "saved search %d", ss.ID))
+		}
+	}
+	return errs
+}
+
+func (n *savedSearchNotifier) run(ctx context.Context, ss *types.SavedSearchNotificationState) error {
+	if ss.UserID == nil {
+		return errors.New("saved search is not owned by a user")
+	}
+
+	// SECURITY: run the search as the user that owns the saved search, so
+	// that notifications only contain results they have access to.
+	ctx = actor.WithActor(ctx, actor.FromUser(*ss.UserID))
+	ctx = featureflag.WithFlags(ctx, n.db.FeatureFlags())
+
+	matches, stats, searchErr := n.search(ctx, ss.Query)
+	if searchErr != nil || incompleteSavedSearchResults(stats) {
+		// Keep the results of the previous run, but record this run so that
+		// the saved search is not retried before its next scheduled run. The
+		// results missing from an incomplete search must not be reported as
+		// no longer matching.
+		if err := n.db.SavedSearches().UpdateNotificationState(ctx, ss.ID, ss.ResultsFingerprint, ss.ResultHashes); err != nil {
+			return err
+		}
+		if searchErr != nil {
+			return errors.Wrap(searchErr, "execute search")
+		}
+		n.logger.Warn("skipping incomplete saved search results", log.Int32("savedSearchID", ss.ID))
+		return nil
+	}
+
+	results := hashSavedSearchResults(matches)
+	hashes := make([]string, 0, len(results))
+	for _, r := range results {
+		hashes = append(hashes, r.hash)
+	}
+	sort.Strings(hashes)
+	fingerprint := savedSearchFingerprint(hashes)
+
+	// The state is recorded before notifying, so that a failing notification
+	// is not repeated for the same change on every iteration.
+	if err := n.db.SavedSearches().UpdateNotificationState(ctx, ss.ID, fingerprint, hashes); err != nil {
+		return err
+	}
+
+	// The first run after the saved search is created or its query changes
+	// only records the current results.
+	if ss.ResultsFingerprint == "" || ss.ResultsFingerprint == fingerprint {
+		return nil
+	}
+
+	appeared, disappeared := diffSavedSearchResults(ss.ResultHashes, results)
+	if len(appeared) == 0 && disappeared == 0 {
+		return nil
+	}
+	return n.notify(ctx, ss, appeared, disappeared)
+}
+
+func (n *savedSearchNotifier) notify(ctx context.Context, ss *types.SavedSearchNotificationState, appeared []result.Match, disappeared int) error {
+	externalURL, err := n.externalURL(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs error
+	if ss.Notify {
+		data := newTemplateDataForSavedSearchResults(externalURL, ss.Description, ss.Query, appeared, disappeared)
+		if err := n.sendEmail(ctx, *ss.UserID, data); err != nil {
+			errs = errors.Append(errs, errors.Wrap(err, "send email"))
+		}
+	}
+	if ss.NotifySlack && ss.SlackWebhookURL != nil && *ss.SlackWebhookURL != "" {
+		owner, err := n.db.Users().GetByID(ctx, *ss.UserID)
+		if err != nil {
+			return errors.Append(errs, err)
+		}
+		msg := savedSearchSlackPayload(externalURL, owner.Username, ss.Description, ss.Query, appeared, disappeared)
+		if err := postSlackWebhook(ctx, n.doer, *ss.SlackWebhookURL, msg); err != nil {
+			errs = errors.Append(errs, errors.Wrap(err, "post Slack webhook"))
+		}
+	}
+	return errs
+}
+
+func newTemplateDataForSavedSearchResults(externalURL *url.URL, description, query string, appeared []result.Match, disappeared int) *TemplateDataSavedSearchResults {
+	truncated := appeared
+	if len(truncated) > savedSearchMaxDisplayResults {
+		truncated = truncated[:savedSearchMaxDisplayResults]
+	}
+	displayResults := make([]*SavedSearchDisplayResult, 0, len(truncated))
+	for _, m := range truncated {
+		displayResults = append(displayResults, toSavedSearchDisplayResult(externalURL, m, utmSourceSavedSearchEmail))
+	}
+
+	truncatedCount := len(appeared) - len(truncated)
+	return &TemplateDataSavedSearchResults{
+		Description:               description,
+		SearchURL:                 getSearchURL(externalURL, query, utmSourceSavedSearchEmail),
+		Summary:                   savedSearchChangeSummary(len(appeared), disappeared),
+		TruncatedResults:          displayResults,
+		TruncatedCount:            truncatedCount,
+		TruncatedResultPluralized: pluralize("result", truncatedCount),
+	}
+}
+
+func savedSearchSlackPayload(externalURL *url.URL, ownerName, description, query string, appeared []result.Match, disappeared int) *slack.WebhookMessage {
+	newMarkdownSection := func(s string) slack.Block {
+		return slack.NewSectionBlock(slack.NewTextBlockObject("mrkdwn", s, false, false), nil, nil)
+	}
+
+	blocks := []slack.Block{
+		newMarkdownSection(fmt.Sprintf(
+			"%s's Sourcegraph saved search, *%s*, has %s.",
+			ownerName,
+			description,
+			savedSearchChangeSummary(len(appeared), disappeared),
+		)),
+	}
+
+	for i, m := range appeared {
+		if i == savedSearchMaxDisplayResults {
+			break
+		}
+		r := toSavedSearchDisplayResult(externalURL, m, utmSourceSavedSearchSlack)
+		blocks = append(blocks, newMarkdownSection(fmt.Sprintf("%s match: <%s|%s>", r.ResultType, r.URL, r.Label)))
+	}
+
+	searchURL := getSearchURL(externalURL, query, utmSourceSavedSearchSlack)
+	if truncatedCount := len(appeared) - savedSearchMaxDisplayResults; truncatedCount > 0 {
+		blocks = append(blocks, newMarkdownSection(fmt.Sprintf("...and <%s|%d more new %s>.", searchURL, truncatedCount, pluralize("result", truncatedCount))))
+	} else {
+		blocks = append(blocks, newMarkdownSection(fmt.Sprintf("<%s|View results>", searchURL)))
+	}
+	return &slack.WebhookMessage{Blocks: &slack.Blocks{BlockSet: blocks}}
+}
+
+// savedSearchChangeSummary describes a change of the results of a saved
+// search, e.g. "2 new results and 1 result no longer matching".
+func savedSearchChangeSummary(appeared, disappeared int) string {
+	var parts []string
+	if appeared > 0 {
+		parts = append(parts, fmt.Sprintf("%d new %s", appeared, pluralize("result", appeared)))
+	}
+	if disappeared > 0 {
+		parts = append(parts, fmt.Sprintf("%d %s no longer matching", disappeared, pluralize("result", disappeared)))
+	}
+	return strings.Join(parts, " and ")
+}
+
+func toSavedSearchDisplayResult(externalURL *url.URL, m result.Match, utmSource string) *SavedSearchDisplayResult {
+	var (
+		resultType, label string
+		u                 *url.URL
+	)
+	switch v := m.(type) {
+	case *result.FileMatch:
+		resultType, label, u = "File", fmt.Sprintf("%s/%s", v.Repo.Name, v.Path), v.File.URL()
+	case *result.RepoMatch:
+		resultType, label, u = "Repository", string(v.Name), v.URL()
+	case *result.CommitMatch:
+		resultType = "Commit"
+		if v.DiffPreview != nil {
+			resultType = "Diff"
+		}
+		label, u = fmt.Sprintf("%s@%s", v.Repo.Name, v.Commit.ID.Short()), v.URL()
+	case *result.CommitDiffMatch:
+		resultType = "Diff"
+		label = fmt.Sprintf("%s@%s: %s", v.Repo.Name, v.Commit.ID.Short(), v.Path())
+		u = (&result.CommitMatch{Repo: v.Repo, Commit: v.Commit}).URL()
+	default:
+		key := m.Key()
+		resultType, label, u = "Result", string(key.Repo), (&result.RepoMatch{Name: key.Repo}).URL()
+	}
+	return &SavedSearchDisplayResult{
+		ResultType: resultType,
+		Label:      label,
+		URL:        sourcegraphURL(externalURL, strings.TrimPrefix(u.Path, "/"), "", utmSource),
+	}
+}
+
+// incompleteSavedSearchResults returns true if a search did not return all
+// of its results, because it hit a limit or some repositories could not be
+// searched.
+func incompleteSavedSearchResults(stats streaming.Stats) bool {
+	return stats.IsLimitHit || stats.Status.Any(search.RepoStatusCloning|search.RepoStatusMissing|search.RepoStatusLimitHit|search.RepoStatusTimedout)
+}
+
+type savedSearchResult struct {
+	hash  string
+	match result.Match
+}
+
+// hashSavedSearchResults returns the unique results of matches, in the order
+// of matches. The hash of a result identifies it independently of the commit
+// its repository is at, so that file matches are only new if the matched
+// content changed.
+func hashSavedSearchResults(matches result.Matches) []savedSearchResult {
+	seen := make(map[string]struct{}, len(matches))
+	results := make([]savedSearchResult, 0, len(matches))
+	for _, m := range matches {
+		hash := savedSearchResultHash(m)
+		if _, ok := seen[hash]; ok {
+			continue
+		}
+		seen[hash] = struct{}{}
+		results = append(results, savedSearchResult{hash: hash, match: m})
+	}
+	return results
+}
+
+func savedSearchResultHash(m result.Match) string {
+	h := sha256.New()
+	write := func(parts ...string) {
+		for _, p := range parts {
+			io.WriteString(h, p)
+			h.Write([]byte{0})
+		}
+	}
+
+	switch v := m.(type) {
+	case *result.FileMatch:
+		var rev string
+		if v.InputRev != nil {
+			rev = *v.InputRev
+		}
+		write("file", string(v.Repo.Name), rev, v.Path)
+		for _, chunk := range v.ChunkMatches {
+			write(chunk.Content)
+		}
+		for _, symbol := range v.Symbols {
+			write(symbol.Symbol.Name)
+		}
+	case *result.RepoMatch:
+		write("repo", string(v.Name), v.Rev)
+	case *result.CommitMatch:
+		write("commit", string(v.Repo.Name), string(v.Commit.ID))
+	case *result.CommitDiffMatch:
+		write("diff", string(v.Repo.Name), string(v.Commit.ID), v.Path())
+	default:
+		key := m.Key()
+		write(fmt.Sprintf("%T", m), string(key.Repo), key.Rev, string(key.Commit), key.Path)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// savedSearchFingerprint returns a hash of the result set with the given
+// sorted result hashes.
+func savedSearchFingerprint(sortedHashes []string) string {
+	h := sha256.New()
+	for _, hash := range sortedHashes {
+		io.WriteString(h, hash)
+		h.Write([]byte{'\n'})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// diffSavedSearchResults returns the matches of current which are not in
+// previous, and the number of results in previous which are not in current.
+func diffSavedSearchResults(previous []string, current []savedSearchResult) (appeared []result.Match, disappeared int) {
+	previousSet := make(map[string]struct{}, len(previous))
+	for _, hash := range previous {
+		previousSet[hash] = struct{}{}
+	}
+	currentSet := make(map[string]struct{}, len(current))
+	for _, r := range current {
+		currentSet[r.hash] = struct{}{}
+		if _, ok := previousSet[r.hash]; !ok {
+			appeared = append(appeared, r.match)
+		}
+	}
+	for hash := range previousSet {
+		if _, ok := currentSet[hash]; !ok {
+			disappeared++
+		}
+	}
+	return appeared, disappeared
+}
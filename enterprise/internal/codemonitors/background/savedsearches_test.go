@@ -0,0 +1,249 @@
+package background
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/hexops/autogold"
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/txemail"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestSavedSearchNotifier(t *testing.T) {
+	externalURL, err := url.Parse("https://sourcegraph.com")
+	require.NoError(t, err)
+
+	fileMatch := func(path, content string) *result.FileMatch {
+		return &result.FileMatch{
+			File: result.File{
+				Repo:     types.MinimalRepo{ID: 1, Name: "github.com/sourcegraph/sourcegraph"},
+				CommitID: api.CommitID("deadbeef"),
+				Path:     path,
+			},
+			ChunkMatches: result.ChunkMatches{{Content: content}},
+		}
+	}
+	removed := fileMatch("removed.go", "TODO")
+	kept := fileMatch("kept.go", "TODO")
+	added := fileMatch("added.go", "TODO")
+	previous := hashSavedSearchResults(result.Matches{removed, kept})
+	previousHashes := []string{previous[0].hash, previous[1].hash}
+	previousFingerprint := savedSearchFingerprint(sortedCopy(previousHashes))
+
+	userID := int32(1)
+	newState := func(fingerprint string, hashes []string) *types.SavedSearchNotificationState {
+		webhookURL := ""
+		return &types.SavedSearchNotificationState{
+			SavedSearch: types.SavedSearch{
+				ID:              1,
+				Description:     "TODOs",
+				Query:           "TODO",
+				Notify:          true,
+				NotifySlack:     true,
+				UserID:          &userID,
+				SlackWebhookURL: &webhookURL,
+			},
+			ResultsFingerprint: fingerprint,
+			ResultHashes:       hashes,
+		}
+	}
+
+	setup := func(t *testing.T, state *types.SavedSearchNotificationState, matches result.Matches, stats streaming.Stats, searchErr error) (*savedSearchNotifier, *database.MockSavedSearchStore, *[]*TemplateDataSavedSearchResults, *[][]byte) {
+		var posted [][]byte
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			posted = append(posted, b)
+			w.WriteHeader(http.StatusOK)
+		}))
+		t.Cleanup(s.Close)
+		*state.SlackWebhookURL = s.URL
+
+		savedSearches := database.NewMockSavedSearchStore()
+		savedSearches.ListDueForNotificationFunc.SetDefaultReturn([]*types.SavedSearchNotificationState{state}, nil)
+		users := database.NewMockUserStore()
+		users.GetByIDFunc.SetDefaultReturn(&types.User{ID: userID, Username: "alice"}, nil)
+		db := database.NewMockDB()
+		db.SavedSearchesFunc.SetDefaultReturn(savedSearches)
+		db.UsersFunc.SetDefaultReturn(users)
+		db.FeatureFlagsFunc.SetDefaultReturn(database.NewMockFeatureFlagStore())
+
+		var emails []*TemplateDataSavedSearchResults
+		n := &savedSearchNotifier{
+			logger: logtest.Scoped(t),
+			db:     db,
+			doer:   s.Client(),
+			search: func(context.Context, string) (result.Matches, streaming.Stats, error) {
+				return matches, stats, searchErr
+			},
+			sendEmail: func(_ context.Context, _ int32, data *TemplateDataSavedSearchResults) error {
+				emails = append(emails, data)
+				return nil
+			},
+			externalURL: func(context.Context) (*url.URL, error) { return externalURL, nil },
+			now:         time.Now,
+		}
+		return n, savedSearches, &emails, &posted
+	}
+
+	t.Run("first run", func(t *testing.T) {
+		n, savedSearches, emails, posted := setup(t, newState("", nil), result.Matches{removed, kept}, streaming.Stats{}, nil)
+		require.NoError(t, n.runDue(context.Background()))
+
+		require.Len(t, savedSearches.UpdateNotificationStateFunc.History(), 1)
+		call := savedSearches.UpdateNotificationStateFunc.History()[0]
+		require.Equal(t, previousFingerprint, call.Arg2)
+		require.Equal(t, sortedCopy(previousHashes), call.Arg3)
+		require.Empty(t, *emails)
+		require.Empty(t, *posted)
+	})
+
+	t.Run("unchanged", func(t *testing.T) {
+		n, savedSearches, emails, posted := setup(t, newState(previousFingerprint, previousHashes), result.Matches{kept, removed}, streaming.Stats{}, nil)
+		require.NoError(t, n.runDue(context.Background()))
+
+		require.Len(t, savedSearches.UpdateNotificationStateFunc.History(), 1)
+		require.Empty(t, *emails)
+		require.Empty(t, *posted)
+	})
+
+	t.Run("changed", func(t *testing.T) {
+		n, savedSearches, emails, posted := setup(t, newState(previousFingerprint, previousHashes), result.Matches{kept, added}, streaming.Stats{}, nil)
+		require.NoError(t, n.runDue(context.Background()))
+
+		require.Len(t, savedSearches.UpdateNotificationStateFunc.History(), 1)
+		require.Len(t, *emails, 1)
+		require.Equal(t, "1 new result and 1 result no longer matching", (*emails)[0].Summary)
+		require.Equal(t, []*SavedSearchDisplayResult{{
+			ResultType: "File",
+			Label:      "github.com/sourcegraph/sourcegraph/added.go",
+			URL:        "https://sourcegraph.com/github.com/sourcegraph/sourcegraph/-/blob/added.go?utm_source=saved-search-email",
+		}}, (*emails)[0].TruncatedResults)
+		require.Len(t, *posted, 1)
+		require.Contains(t, string((*posted)[0]), "alice's Sourcegraph saved search, *TODOs*, has 1 new result and 1 result no longer matching.")
+	})
+
+	t.Run("search error", func(t *testing.T) {
+		n, savedSearches, emails, _ := setup(t, newState(previousFingerprint, previousHashes), nil, streaming.Stats{}, errors.New("boom"))
+		require.Error(t, n.runDue(context.Background()))
+
+		require.Len(t, savedSearches.UpdateNotificationStateFunc.History(), 1)
+		call := savedSearches.UpdateNotificationStateFunc.History()[0]
+		require.Equal(t, previousFingerprint, call.Arg2)
+		require.Equal(t, previousHashes, call.Arg3)
+		require.Empty(t, *emails)
+	})
+
+	timedOut := streaming.Stats{}
+	timedOut.Status.Update(1, search.RepoStatusTimedout)
+	missing := streaming.Stats{}
+	missing.Status.Update(1, search.RepoStatusMissing)
+	for name, stats := range map[string]streaming.Stats{
+		"limit hit":       {IsLimitHit: true},
+		"repo timed out":  timedOut,
+		"repo is missing": missing,
+	} {
+		t.Run("incomplete results, "+name, func(t *testing.T) {
+			n, savedSearches, emails, posted := setup(t, newState(previousFingerprint, previousHashes), result.Matches{kept, added}, stats, nil)
+			require.NoError(t, n.runDue(context.Background()))
+
+			// The previous results are kept, so that the results missing from this
+			// run are not reported as no longer matching on the next run either.
+			require.Len(t, savedSearches.UpdateNotificationStateFunc.History(), 1)
+			call := savedSearches.UpdateNotificationStateFunc.History()[0]
+			require.Equal(t, previousFingerprint, call.Arg2)
+			require.Equal(t, previousHashes, call.Arg3)
+			require.Empty(t, *emails)
+			require.Empty(t, *posted)
+		})
+	}
+}
+
+func TestSavedSearchResultHash(t *testing.T) {
+	fileMatch := func(commit api.CommitID, content string) *result.FileMatch {
+		return &result.FileMatch{
+			File: result.File{
+				Repo:     types.MinimalRepo{ID: 1, Name: "github.com/sourcegraph/sourcegraph"},
+				CommitID: commit,
+				Path:     "main.go",
+			},
+			ChunkMatches: result.ChunkMatches{{Content: content}},
+		}
+	}
+
+	// File matches are identified by their content rather than by the commit
+	// they were found at.
+	require.Equal(t, savedSearchResultHash(fileMatch("a", "TODO")), savedSearchResultHash(fileMatch("b", "TODO")))
+	require.NotEqual(t, savedSearchResultHash(fileMatch("a", "TODO")), savedSearchResultHash(fileMatch("a", "TODO: more")))
+
+	commit := &result.CommitMatch{
+		Repo:   types.MinimalRepo{ID: 1, Name: "github.com/sourcegraph/sourcegraph"},
+		Commit: gitdomain.Commit{ID: "a"},
+	}
+	repo := &result.RepoMatch{Name: "github.com/sourcegraph/sourcegraph"}
+	require.NotEqual(t, savedSearchResultHash(commit), savedSearchResultHash(repo))
+
+	results := hashSavedSearchResults(result.Matches{fileMatch("a", "TODO"), repo, fileMatch("b", "TODO")})
+	require.Len(t, results, 2, "duplicate results are removed")
+}
+
+func TestSavedSearchEmail(t *testing.T) {
+	template := txemail.MustParseTemplate(savedSearchResultsEmailTemplates)
+
+	externalURL, err := url.Parse("https://sourcegraph.com")
+	require.NoError(t, err)
+
+	var appeared []result.Match
+	for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+		appeared = append(appeared, &result.RepoMatch{Name: api.RepoName("github.com/sourcegraph/" + name)})
+	}
+	templateData := newTemplateDataForSavedSearchResults(externalURL, "New repos", "type:repo", appeared, 2)
+
+	t.Run("html", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, template.Html.Execute(&buf, templateData))
+		autogold.Equal(t, autogold.Raw(buf.String()))
+	})
+
+	t.Run("text", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, template.Text.Execute(&buf, templateData))
+		autogold.Equal(t, autogold.Raw(buf.String()))
+	})
+
+	t.Run("subject", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, template.Subj.Execute(&buf, templateData))
+		require.Equal(t, "Sourcegraph saved search New repos has 6 new results and 2 results no longer matching", buf.String())
+	})
+
+	t.Run("slack", func(t *testing.T) {
+		b, err := json.MarshalIndent(savedSearchSlackPayload(externalURL, "alice", "New repos", "type:repo", appeared, 2), " ", " ")
+		require.NoError(t, err)
+		autogold.Equal(t, autogold.Raw(b))
+	})
+}
+
+func sortedCopy(s []string) []string {
+	c := append([]string(nil), s...)
+	sort.Strings(c)
+	return c
+}
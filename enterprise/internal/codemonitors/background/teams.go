@@ -0,0 +1,147 @@
+package background
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// teamsMessage is the body of a request to a Microsoft Teams incoming
+// webhook carrying a single Adaptive Card.
+type teamsMessage struct {
+	Type        string            `json:"type"`
+	Attachments []teamsAttachment `json:"attachments"`
+}
+
+type teamsAttachment struct {
+	ContentType string       `json:"contentType"`
+	Content     adaptiveCard `json:"content"`
+}
+
+type adaptiveCard struct {
+	Schema  string                `json:"$schema"`
+	Type    string                `json:"type"`
+	Version string                `json:"version"`
+	Body    []adaptiveCardElement `json:"body"`
+	Actions []adaptiveCardAction  `json:"actions,omitempty"`
+}
+
+type adaptiveCardElement struct {
+	Type     string `json:"type"`
+	Text     string `json:"text"`
+	Wrap     bool   `json:"wrap,omitempty"`
+	Weight   string `json:"weight,omitempty"`
+	Size     string `json:"size,omitempty"`
+	FontType string `json:"fontType,omitempty"`
+}
+
+type adaptiveCardAction struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+func newTeamsMessage(body []adaptiveCardElement, actions []adaptiveCardAction) *teamsMessage {
+	return &teamsMessage{
+		Type: "message",
+		Attachments: []teamsAttachment{{
+			ContentType: "application/vnd.microsoft.card.adaptive",
+			Content: adaptiveCard{
+				Schema:  "http://adaptivecards.io/schemas/adaptive-card.json",
+				Type:    "AdaptiveCard",
+				Version: "1.4",
+				Body:    body,
+				Actions: actions,
+			},
+		}},
+	}
+}
+
+func newTextBlock(s string) adaptiveCardElement {
+	return adaptiveCardElement{Type: "TextBlock", Text: s, Wrap: true}
+}
+
+func newCodeBlock(s string) adaptiveCardElement {
+	return adaptiveCardElement{Type: "TextBlock", Text: s, Wrap: true, FontType: "Monospace"}
+}
+
+func newOpenURLAction(title, url string) adaptiveCardAction {
+	return adaptiveCardAction{Type: "Action.OpenUrl", Title: title, URL: url}
+}
+
+func sendTeamsNotification(ctx context.Context, doer httpcli.Doer, url string, args actionArgs) error {
+	return postTeamsWebhook(ctx, doer, url, teamsPayload(args))
+}
+
+func teamsPayload(args actionArgs) *teamsMessage {
+	truncatedResults, totalCount, truncatedCount := truncateResults(args.Results, 5)
+
+	title := newTextBlock(fmt.Sprintf(
+		"%s's Sourcegraph Code monitor, **%s**, detected **%d** new matches.",
+		args.MonitorOwnerName,
+		args.MonitorDescription,
+		totalCount,
+	))
+	title.Size = "Medium"
+	title.Weight = "Bolder"
+	body := []adaptiveCardElement{title}
+
+	if args.IncludeResults {
+		for _, result := range truncatedResults {
+			resultType := "Message"
+			if result.DiffPreview != nil {
+				resultType = "Diff"
+			}
+			body = append(body, newTextBlock(fmt.Sprintf(
+				"%s match: [%s@%s](%s)",
+				resultType,
+				result.Repo.Name,
+				result.Commit.ID.Short(),
+				getCommitURL(args.ExternalURL, string(result.Repo.Name), string(result.Commit.ID), args.UTMSource),
+			)))
+			var contentRaw string
+			if result.DiffPreview != nil {
+				contentRaw = truncateString(result.DiffPreview.Content, 10)
+			} else {
+				contentRaw = truncateString(result.MessagePreview.Content, 10)
+			}
+			body = append(body, newCodeBlock(contentRaw))
+		}
+		if truncatedCount > 0 {
+			body = append(body, newTextBlock(fmt.Sprintf(
+				"...and [%d more matches](%s).",
+				truncatedCount,
+				getSearchURL(args.ExternalURL, args.Query, args.UTMSource),
+			)))
+		}
+	}
+
+	body = append(body, newTextBlock(fmt.Sprintf(
+		"If you are %s, you can edit your code monitor.",
+		args.MonitorOwnerName,
+	)))
+
+	return newTeamsMessage(body, []adaptiveCardAction{
+		newOpenURLAction("View results", getSearchURL(args.ExternalURL, args.Query, args.UTMSource)),
+		newOpenURLAction("Edit code monitor", getCodeMonitorURL(args.ExternalURL, args.MonitorID, args.UTMSource)),
+	})
+}
+
+func postTeamsWebhook(ctx context.Context, doer httpcli.Doer, url string, msg *teamsMessage) error {
+	raw, err := json.Marshal(msg)
+	if err != nil {
+		return errors.Wrap(err, "marshal failed")
+	}
+	return postJSON(ctx, doer, url, raw, nil)
+}
+
+func SendTestTeamsWebhook(ctx context.Context, doer httpcli.Doer, description, url string) error {
+	testMessage := newTeamsMessage([]adaptiveCardElement{
+		newTextBlock(fmt.Sprintf("Test message for Code Monitor '%s'", description)),
+	}, nil)
+
+	return postTeamsWebhook(ctx, doer, url, testMessage)
+}
@@ -7,14 +7,19 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"text/template"
 
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
-func sendWebhookNotification(ctx context.Context, url string, args actionArgs) error {
-	return postWebhook(ctx, httpcli.ExternalDoer, url, generateWebhookPayload(args))
+func sendWebhookNotification(ctx context.Context, doer httpcli.Doer, url, payloadTemplate string, headers map[string]string, args actionArgs) error {
+	body, err := renderWebhookPayload(payloadTemplate, args)
+	if err != nil {
+		return err
+	}
+	return postJSON(ctx, doer, url, body, headers)
 }
 
 func postWebhook(ctx context.Context, doer httpcli.Doer, url string, payload webhookPayload) error {
@@ -22,12 +27,21 @@ func postWebhook(ctx context.Context, doer httpcli.Doer, url string, payload web
 	if err != nil {
 		return errors.Wrap(err, "marshal failed")
 	}
+	return postJSON(ctx, doer, url, raw, nil)
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(raw))
+// postJSON posts the given body to url. Custom headers are added to the
+// request, and the Content-Type defaults to application/json unless
+// overridden. Any non-2xx response is returned as a StatusCodeError.
+func postJSON(ctx context.Context, doer httpcli.Doer, url string, body []byte, headers map[string]string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
 	if err != nil {
 		return errors.Wrap(err, "failed new request")
 	}
 	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
 
 	resp, err := doer.Do(req)
 	if err != nil {
@@ -35,7 +49,7 @@ func postWebhook(ctx context.Context, doer httpcli.Doer, url string, payload web
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		body, _ := io.ReadAll(resp.Body)
 		return StatusCodeError{
 			Code:   resp.StatusCode,
@@ -47,13 +61,97 @@ func postWebhook(ctx context.Context, doer httpcli.Doer, url string, payload web
 	return nil
 }
 
-func SendTestWebhook(ctx context.Context, doer httpcli.Doer, description string, u string) error {
+func SendTestWebhook(ctx context.Context, doer httpcli.Doer, description, u, payloadTemplate string, headers map[string]string) error {
 	args := actionArgs{
 		ExternalURL:        &url.URL{},
 		MonitorDescription: description,
 		Query:              "test query",
 	}
-	return postWebhook(ctx, httpcli.ExternalDoer, u, generateWebhookPayload(args))
+	return sendWebhookNotification(ctx, doer, u, payloadTemplate, headers, args)
+}
+
+// webhookTemplateData is the data available to the payload template of a
+// webhook action.
+type webhookTemplateData struct {
+	MonitorDescription string
+	MonitorURL         string
+	MonitorOwnerName   string
+	Query              string
+	SearchURL          string
+
+	// ResultCount is the number of new matches, whether or not the results
+	// themselves are included.
+	ResultCount int
+	// Results is only populated if the action is configured to include
+	// results.
+	Results []*result.CommitMatch
+}
+
+var webhookTemplateFuncs = template.FuncMap{
+	// json renders any value as a JSON literal, which allows templates to
+	// safely embed strings and results in a JSON document.
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+	"truncate": truncateString,
+}
+
+func parseWebhookPayloadTemplate(payloadTemplate string) (*template.Template, error) {
+	return template.New("payload").Funcs(webhookTemplateFuncs).Option("missingkey=error").Parse(payloadTemplate)
+}
+
+// ValidateWebhookPayloadTemplate returns an error if the given payload template
+// cannot be parsed, or fails to render against sample data.
+func ValidateWebhookPayloadTemplate(payloadTemplate string) error {
+	if payloadTemplate == "" {
+		return nil
+	}
+	t, err := parseWebhookPayloadTemplate(payloadTemplate)
+	if err != nil {
+		return err
+	}
+	return t.Execute(io.Discard, webhookTemplateData{Results: []*result.CommitMatch{}})
+}
+
+// renderWebhookPayload renders the body of a webhook request. If no payload
+// template is configured, the default JSON payload is used.
+func renderWebhookPayload(payloadTemplate string, args actionArgs) ([]byte, error) {
+	if payloadTemplate == "" {
+		raw, err := json.Marshal(generateWebhookPayload(args))
+		if err != nil {
+			return nil, errors.Wrap(err, "marshal failed")
+		}
+		return raw, nil
+	}
+
+	t, err := parseWebhookPayloadTemplate(payloadTemplate)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing payload template")
+	}
+
+	totalCount := 0
+	for _, r := range args.Results {
+		totalCount += r.ResultCount()
+	}
+	data := webhookTemplateData{
+		MonitorDescription: args.MonitorDescription,
+		MonitorURL:         getCodeMonitorURL(args.ExternalURL, args.MonitorID, args.UTMSource),
+		MonitorOwnerName:   args.MonitorOwnerName,
+		Query:              args.Query,
+		SearchURL:          getSearchURL(args.ExternalURL, args.Query, args.UTMSource),
+		ResultCount:        totalCount,
+		Results:            []*result.CommitMatch{},
+	}
+	if args.IncludeResults {
+		data.Results = args.Results
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return nil, errors.Wrap(err, "rendering payload template")
+	}
+	return buf.Bytes(), nil
 }
 
 type webhookPayload struct {
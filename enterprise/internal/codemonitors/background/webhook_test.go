@@ -79,6 +79,73 @@ func TestTriggerTestWebhookAction(t *testing.T) {
 	defer s.Close()
 
 	client := s.Client()
-	err := SendTestWebhook(context.Background(), client, "My test monitor", s.URL)
+	err := SendTestWebhook(context.Background(), client, "My test monitor", s.URL, "", nil)
 	require.NoError(t, err)
 }
+
+func TestWebhookPayloadTemplate(t *testing.T) {
+	eu, err := url.Parse("https://sourcegraph.com")
+	require.NoError(t, err)
+
+	action := actionArgs{
+		MonitorDescription: "My test monitor",
+		MonitorOwnerName:   "Camden Cheek",
+		ExternalURL:        eu,
+		MonitorID:          42,
+		Query:              "repo:camdentest -file:id_rsa.pub BEGIN",
+		Results:            []*result.CommitMatch{&diffResultMock, &commitResultMock},
+		IncludeResults:     true,
+	}
+
+	payloadTemplate := `{"title": {{json .MonitorDescription}}, "count": {{.ResultCount}}, "link": {{json .MonitorURL}}, "commits": [{{range $i, $r := .Results}}{{if $i}},{{end}}{{json $r.Commit.ID}}{{end}}]}`
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		require.Equal(t, "application/vnd.incident+json", r.Header.Get("Content-Type"))
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		autogold.Equal(t, autogold.Raw(b))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer s.Close()
+
+	headers := map[string]string{
+		"Authorization": "Bearer secret",
+		"Content-Type":  "application/vnd.incident+json",
+	}
+	err = sendWebhookNotification(context.Background(), s.Client(), s.URL, payloadTemplate, headers, action)
+	require.NoError(t, err)
+
+	t.Run("results are omitted unless included", func(t *testing.T) {
+		actionCopy := action
+		actionCopy.IncludeResults = false
+
+		b, err := renderWebhookPayload(`{{len .Results}} of {{.ResultCount}}`, actionCopy)
+		require.NoError(t, err)
+		require.Equal(t, "0 of 3", string(b))
+	})
+}
+
+func TestValidateWebhookPayloadTemplate(t *testing.T) {
+	for _, tc := range []struct {
+		template string
+		valid    bool
+	}{
+		{template: "", valid: true},
+		{template: `{"text": {{json .MonitorDescription}}}`, valid: true},
+		{template: `{{range .Results}}{{.Repo.Name}}{{end}}`, valid: true},
+		{template: `{{truncate .Query 3}}`, valid: true},
+		{template: `{{.MonitorDescription`, valid: false},
+		{template: `{{.NotAField}}`, valid: false},
+		{template: `{{unknownFunc .Query}}`, valid: false},
+	} {
+		t.Run(tc.template, func(t *testing.T) {
+			err := ValidateWebhookPayloadTemplate(tc.template)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/featureflag"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker"
@@ -172,8 +173,9 @@ func (r *queryRunner) Handle(ctx context.Context, logger log.Logger, record work
 	}
 
 	query := q.QueryString
-	if !featureflag.FromContext(ctx).GetBoolOr("cc-repo-aware-monitors", true) {
-		// Only add an after filter when repo-aware monitors is disabled
+	if !featureflag.FromContext(ctx).GetBoolOr("cc-repo-aware-monitors", true) && !codemonitors.IsContentMonitorQuery(query) {
+		// Only add an after filter when repo-aware monitors is disabled.
+		// Content monitors always diff their matches against the last run.
 		query = newQueryWithAfterFilter(q)
 	}
 	results, searchErr := codemonitors.Search(ctx, logger, r.db, query, m.ID, settings)
@@ -226,11 +228,13 @@ func (r *actionRunner) Handle(ctx context.Context, logger log.Logger, record wor
 	case j.Email != nil:
 		return r.handleEmail(ctx, j)
 	case j.Webhook != nil:
-		return r.handleWebhook(ctx, j)
+		return r.handleWebhook(ctx, logger, j)
 	case j.SlackWebhook != nil:
-		return r.handleSlackWebhook(ctx, j)
+		return r.handleSlackWebhook(ctx, logger, j)
+	case j.TeamsWebhook != nil:
+		return r.handleTeamsWebhook(ctx, logger, j)
 	default:
-		return errors.New("job must be one of type email, webhook, or slack webhook")
+		return errors.New("job must be one of type email, webhook, slack webhook, or teams webhook")
 	}
 }
 
@@ -292,21 +296,43 @@ func (r *actionRunner) handleEmail(ctx context.Context, j *edb.ActionJob) error
 	return nil
 }
 
-func (r *actionRunner) handleWebhook(ctx context.Context, j *edb.ActionJob) error {
+// readActionJob runs f in a transaction to read what is needed to run an action
+// job. The transaction ends when readActionJob returns, so that it is not held
+// open while notifications are delivered.
+func (r *actionRunner) readActionJob(ctx context.Context, f func(s edb.CodeMonitorStore) error) (err error) {
 	s, err := r.CodeMonitorStore.Transact(ctx)
 	if err != nil {
 		return err
 	}
 	defer func() { err = s.Done(err) }()
 
-	m, err := s.GetActionJobMetadata(ctx, j.ID)
+	return f(s)
+}
+
+func (r *actionRunner) handleWebhook(ctx context.Context, logger log.Logger, j *edb.ActionJob) error {
+	var (
+		m *edb.ActionJobMetadata
+		w *edb.WebhookAction
+	)
+	err := r.readActionJob(ctx, func(s edb.CodeMonitorStore) (err error) {
+		m, err = s.GetActionJobMetadata(ctx, j.ID)
+		if err != nil {
+			return errors.Wrap(err, "GetActionJobMetadata")
+		}
+
+		w, err = s.GetWebhookAction(ctx, *j.Webhook)
+		if err != nil {
+			return errors.Wrap(err, "GetWebhookAction")
+		}
+		return nil
+	})
 	if err != nil {
-		return errors.Wrap(err, "GetActionJobMetadata")
+		return err
 	}
 
-	w, err := s.GetWebhookAction(ctx, *j.Webhook)
+	headers, err := w.Headers.Decrypt(ctx)
 	if err != nil {
-		return errors.Wrap(err, "GetWebhookAction")
+		return errors.Wrap(err, "decrypting webhook headers")
 	}
 
 	externalURL, err := getExternalURL(ctx)
@@ -325,24 +351,79 @@ func (r *actionRunner) handleWebhook(ctx context.Context, j *edb.ActionJob) erro
 		IncludeResults:     w.IncludeResults,
 	}
 
-	return sendWebhookNotification(ctx, w.URL, args)
+	// Render the payload once up front, as template errors are not worth
+	// retrying.
+	body, err := renderWebhookPayload(w.PayloadTemplate, args)
+	if err != nil {
+		return err
+	}
+
+	return deliverWithRetries(ctx, logger, r.CodeMonitorStore, j.ID, httpcli.ExternalDoer, func(ctx context.Context, doer httpcli.Doer) error {
+		return postJSON(ctx, doer, w.URL, body, headers)
+	})
 }
 
-func (r *actionRunner) handleSlackWebhook(ctx context.Context, j *edb.ActionJob) error {
-	s, err := r.CodeMonitorStore.Transact(ctx)
+func (r *actionRunner) handleSlackWebhook(ctx context.Context, logger log.Logger, j *edb.ActionJob) error {
+	var (
+		m *edb.ActionJobMetadata
+		w *edb.SlackWebhookAction
+	)
+	err := r.readActionJob(ctx, func(s edb.CodeMonitorStore) (err error) {
+		m, err = s.GetActionJobMetadata(ctx, j.ID)
+		if err != nil {
+			return errors.Wrap(err, "GetActionJobMetadata")
+		}
+
+		w, err = s.GetSlackWebhookAction(ctx, *j.SlackWebhook)
+		if err != nil {
+			return errors.Wrap(err, "GetSlackWebhookAction")
+		}
+		return nil
+	})
 	if err != nil {
 		return err
 	}
-	defer func() { err = s.Done(err) }()
 
-	m, err := s.GetActionJobMetadata(ctx, j.ID)
+	externalURL, err := getExternalURL(ctx)
 	if err != nil {
-		return errors.Wrap(err, "GetActionJobMetadata")
+		return err
+	}
+
+	args := actionArgs{
+		MonitorDescription: m.Description,
+		MonitorID:          w.Monitor,
+		ExternalURL:        externalURL,
+		UTMSource:          "code-monitor-slack-webhook",
+		Query:              m.Query,
+		MonitorOwnerName:   m.OwnerName,
+		Results:            m.Results,
+		IncludeResults:     w.IncludeResults,
 	}
 
-	w, err := s.GetSlackWebhookAction(ctx, *j.SlackWebhook)
+	return deliverWithRetries(ctx, logger, r.CodeMonitorStore, j.ID, httpcli.ExternalDoer, func(ctx context.Context, doer httpcli.Doer) error {
+		return sendSlackNotification(ctx, doer, w.URL, args)
+	})
+}
+
+func (r *actionRunner) handleTeamsWebhook(ctx context.Context, logger log.Logger, j *edb.ActionJob) error {
+	var (
+		m *edb.ActionJobMetadata
+		w *edb.TeamsWebhookAction
+	)
+	err := r.readActionJob(ctx, func(s edb.CodeMonitorStore) (err error) {
+		m, err = s.GetActionJobMetadata(ctx, j.ID)
+		if err != nil {
+			return errors.Wrap(err, "GetActionJobMetadata")
+		}
+
+		w, err = s.GetTeamsWebhookAction(ctx, *j.TeamsWebhook)
+		if err != nil {
+			return errors.Wrap(err, "GetTeamsWebhookAction")
+		}
+		return nil
+	})
 	if err != nil {
-		return errors.Wrap(err, "GetSlackWebhookAction")
+		return err
 	}
 
 	externalURL, err := getExternalURL(ctx)
@@ -354,14 +435,16 @@ func (r *actionRunner) handleSlackWebhook(ctx context.Context, j *edb.ActionJob)
 		MonitorDescription: m.Description,
 		MonitorID:          w.Monitor,
 		ExternalURL:        externalURL,
-		UTMSource:          "code-monitor-slack-webhook",
+		UTMSource:          "code-monitor-teams-webhook",
 		Query:              m.Query,
 		MonitorOwnerName:   m.OwnerName,
 		Results:            m.Results,
 		IncludeResults:     w.IncludeResults,
 	}
 
-	return sendSlackNotification(ctx, w.URL, args)
+	return deliverWithRetries(ctx, logger, r.CodeMonitorStore, j.ID, httpcli.ExternalDoer, func(ctx context.Context, doer httpcli.Doer) error {
+		return sendTeamsNotification(ctx, doer, w.URL, args)
+	})
 }
 
 type StatusCodeError struct {
@@ -0,0 +1,331 @@
+package codemonitors
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/search/commit"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/jobutil"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/repos"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/searcher"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/search/structural"
+	"github.com/sourcegraph/sourcegraph/internal/search/zoekt"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// isContentMonitorJob returns whether the planned job searches file contents
+// rather than commits or diffs. Content monitors report matches that newly
+// appear at the indexed commit of each repo instead of matches in new commits.
+func isContentMonitorJob(j job.Job) bool {
+	hasCommitSearch := false
+	job.VisitType(j, func(*commit.SearchJob) {
+		hasCommitSearch = true
+	})
+	return !hasCommitSearch
+}
+
+// IsContentMonitorQuery returns whether the query of a code monitor searches
+// file contents rather than commits or diffs.
+func IsContentMonitorQuery(q string) bool {
+	plan, err := query.Pipeline(query.InitLiteral(q))
+	if err != nil {
+		return false
+	}
+	for _, b := range plan {
+		types, _ := b.IncludeExcludeValues(query.FieldType)
+		for _, t := range types {
+			if t == "commit" || t == "diff" {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// prepareContentMonitorJob validates that the job only consists of jobs that
+// search file contents, dropping jobs whose results are not used by content
+// monitors.
+func prepareContentMonitorJob(in job.Job) (_ job.Job, err error) {
+	return job.Map(in, func(j job.Job) job.Job {
+		switch j.(type) {
+		case *zoekt.RepoSubsetTextSearchJob, *zoekt.GlobalTextSearchJob, *searcher.TextSearchJob, *structural.SearchJob:
+			return j
+		case *jobutil.RepoSearchJob, *repos.ComputeExcludedJob, *jobutil.NoopJob:
+			// Repository matches are not file content matches, so they can
+			// never trigger a content monitor.
+			return jobutil.NewNoopJob()
+		default:
+			if len(j.Children()) == 0 {
+				if err == nil {
+					err = errors.Errorf("found invalid atom job type %T for code monitor search", j)
+				}
+			}
+			return j
+		}
+	}), err
+}
+
+// searchContent runs a content monitor search and returns the matches that did
+// not exist on the previous run, converted to commit matches at the indexed
+// commit so that they can be sent by the existing actions. If snapshot is
+// true, the current matches are only recorded.
+func searchContent(ctx context.Context, db database.DB, clients job.RuntimeClients, planJob job.Job, monitorID int64, snapshot bool) ([]*result.CommitMatch, error) {
+	planJob, err := prepareContentMonitorJob(planJob)
+	if err != nil {
+		return nil, err
+	}
+
+	agg := streaming.NewAggregatingStream()
+	_, err = planJob.Run(ctx, clients, agg)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffContentMatches(ctx, edb.NewEnterpriseDB(db).CodeMonitors(), monitorID, agg.Results, agg.Stats, snapshot)
+}
+
+// diffContentMatches records the matches of a content monitor search and
+// returns those that did not exist on the previous run. If the search hit its
+// limit, the matches are only a subset of all matches, so they are added to
+// the recorded matches instead of replacing them. Otherwise matches that are
+// missing from a truncated run would be reported as new on the next run.
+func diffContentMatches(ctx context.Context, cm edb.CodeMonitorStore, monitorID int64, matches result.Matches, stats streaming.Stats, snapshot bool) ([]*result.CommitMatch, error) {
+	lastSearched, err := cm.ListLastSearchedContentMatches(ctx, monitorID)
+	if err != nil {
+		return nil, err
+	}
+
+	byRepo := groupContentMatches(matches)
+
+	var results []*result.CommitMatch
+	for _, repoID := range sortedRepoIDs(byRepo) {
+		matches := byRepo[repoID]
+		if !snapshot {
+			// A repo that has never been searched has only new matches.
+			results = append(results, matches.newMatches(lastSearched[repoID])...)
+		}
+		hashes := matches.hashes()
+		if stats.IsLimitHit {
+			hashes = mergeHashes(lastSearched[repoID], hashes)
+		}
+		if err := cm.UpsertLastSearchedContentMatches(ctx, monitorID, repoID, matches.commitOIDs(), hashes); err != nil {
+			return nil, err
+		}
+	}
+
+	// Forget the matches of repos that were searched completely but no longer
+	// match, so that a match which reappears is reported again.
+	if !stats.IsLimitHit {
+		for repoID, hashes := range lastSearched {
+			if _, ok := byRepo[repoID]; ok || len(hashes) == 0 {
+				continue
+			}
+			if _, ok := stats.Repos[repoID]; !ok || stats.Status.Get(repoID) != 0 {
+				continue
+			}
+			if err := cm.UpsertLastSearchedContentMatches(ctx, monitorID, repoID, nil, nil); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// repoContentMatches are the file matches of a single repo.
+type repoContentMatches struct {
+	fileMatches []*result.FileMatch
+}
+
+func groupContentMatches(matches result.Matches) map[api.RepoID]*repoContentMatches {
+	byRepo := make(map[api.RepoID]*repoContentMatches)
+	for _, match := range matches {
+		fm, ok := match.(*result.FileMatch)
+		if !ok {
+			continue
+		}
+		rm, ok := byRepo[fm.Repo.ID]
+		if !ok {
+			rm = &repoContentMatches{}
+			byRepo[fm.Repo.ID] = rm
+		}
+		rm.fileMatches = append(rm.fileMatches, fm)
+	}
+	return byRepo
+}
+
+// commitOIDs returns the indexed commits the matches were found at.
+func (rm *repoContentMatches) commitOIDs() []string {
+	set := make(map[string]struct{})
+	for _, fm := range rm.fileMatches {
+		set[string(fm.CommitID)] = struct{}{}
+	}
+	return sortedKeys(set)
+}
+
+// hashes returns the deduplicated hashes identifying each match.
+func (rm *repoContentMatches) hashes() []string {
+	set := make(map[string]struct{})
+	for _, fm := range rm.fileMatches {
+		if len(fm.ChunkMatches) == 0 {
+			set[contentMatchHash(fm.Path, nil)] = struct{}{}
+		}
+		for i := range fm.ChunkMatches {
+			set[contentMatchHash(fm.Path, &fm.ChunkMatches[i])] = struct{}{}
+		}
+	}
+	return sortedKeys(set)
+}
+
+// newMatches returns the matches whose hash is not in previous as commit
+// matches. Chunks of a file that matched before are omitted from its result.
+func (rm *repoContentMatches) newMatches(previous []string) []*result.CommitMatch {
+	seen := make(map[string]struct{}, len(previous))
+	for _, hash := range previous {
+		seen[hash] = struct{}{}
+	}
+	isNew := func(path string, chunk *result.ChunkMatch) bool {
+		_, ok := seen[contentMatchHash(path, chunk)]
+		return !ok
+	}
+
+	var results []*result.CommitMatch
+	for _, fm := range rm.fileMatches {
+		if len(fm.ChunkMatches) == 0 {
+			if isNew(fm.Path, nil) {
+				results = append(results, contentMatchToCommitMatch(fm, nil))
+			}
+			continue
+		}
+
+		var chunks result.ChunkMatches
+		for i := range fm.ChunkMatches {
+			if isNew(fm.Path, &fm.ChunkMatches[i]) {
+				chunks = append(chunks, fm.ChunkMatches[i])
+			}
+		}
+		if len(chunks) > 0 {
+			results = append(results, contentMatchToCommitMatch(fm, chunks))
+		}
+	}
+	return results
+}
+
+// contentMatchHash identifies a match by its path and matched lines. Line
+// numbers are deliberately not part of the hash so that a match which moves
+// within its file is not reported as new.
+func contentMatchHash(path string, chunk *result.ChunkMatch) string {
+	h := sha256.New()
+	h.Write([]byte(path))
+	if chunk != nil {
+		h.Write([]byte{0})
+		h.Write([]byte(chunk.Content))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// contentMatchToCommitMatch represents the given chunks of a file match as a
+// diff that adds the matched lines at the indexed commit.
+func contentMatchToCommitMatch(fm *result.FileMatch, chunks result.ChunkMatches) *result.CommitMatch {
+	diffFile := result.DiffFile{OrigName: fm.Path, NewName: fm.Path}
+
+	// Track the position of the next hunk in the formatted diff so that the
+	// matched ranges can be translated into ranges of the diff preview.
+	header := result.FormatDiffFiles([]result.DiffFile{diffFile})
+	offset, line := len(header), strings.Count(header, "\n")
+
+	var ranges result.Ranges
+	for _, chunk := range chunks {
+		lines := strings.Split(strings.TrimSuffix(chunk.Content, "\n"), "\n")
+		hunk := result.Hunk{
+			OldStart: chunk.ContentStart.Line + 1,
+			NewStart: chunk.ContentStart.Line + 1,
+			NewCount: len(lines),
+			Lines:    make([]string, 0, len(lines)),
+		}
+		for _, l := range lines {
+			hunk.Lines = append(hunk.Lines, "+"+l)
+		}
+
+		hunkHeader := fmt.Sprintf("@@ -%d,%d +%d,%d @@\n", hunk.OldStart, hunk.OldCount, hunk.NewStart, hunk.NewCount)
+		offset += len(hunkHeader)
+		line++
+
+		for _, r := range chunk.Ranges {
+			rel := r.Sub(chunk.ContentStart)
+			ranges = append(ranges, result.Range{
+				Start: hunkLocation(rel.Start, offset, line),
+				End:   hunkLocation(rel.End, offset, line),
+			})
+		}
+
+		for _, l := range hunk.Lines {
+			offset += len(l) + 1
+		}
+		line += len(hunk.Lines)
+		diffFile.Hunks = append(diffFile.Hunks, hunk)
+	}
+
+	return &result.CommitMatch{
+		Commit: gitdomain.Commit{ID: fm.CommitID},
+		Repo:   fm.Repo,
+		DiffPreview: &result.MatchedString{
+			Content:       result.FormatDiffFiles([]result.DiffFile{diffFile}),
+			MatchedRanges: ranges,
+		},
+		Diff: []result.DiffFile{diffFile},
+	}
+}
+
+// hunkLocation translates a location relative to the start of a chunk into a
+// location in a hunk whose lines start at the given offset and line, taking
+// the "+" prefixed to every line into account.
+func hunkLocation(rel result.Location, offset, line int) result.Location {
+	return result.Location{
+		Offset: offset + rel.Offset + rel.Line + 1,
+		Line:   line + rel.Line,
+		Column: rel.Column + 1,
+	}
+}
+
+func sortedRepoIDs(byRepo map[api.RepoID]*repoContentMatches) []api.RepoID {
+	ids := make([]api.RepoID, 0, len(byRepo))
+	for id := range byRepo {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// mergeHashes returns the sorted union of the given hashes.
+func mergeHashes(a, b []string) []string {
+	set := make(map[string]struct{}, len(a)+len(b))
+	for _, hash := range a {
+		set[hash] = struct{}{}
+	}
+	for _, hash := range b {
+		set[hash] = struct{}{}
+	}
+	return sortedKeys(set)
+}
+
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,174 @@
+package codemonitors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/search/commit"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/jobutil"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/searcher"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/search/zoekt"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestIsContentMonitorQuery(t *testing.T) {
+	cases := map[string]bool{
+		"type:file secret":               true,
+		"type:file repo:a secret":        true,
+		"content:secret":                 true,
+		"type:diff secret":               false,
+		"type:commit fix":                false,
+		"(type:file a) or (type:diff b)": false,
+	}
+	for q, want := range cases {
+		t.Run(q, func(t *testing.T) {
+			require.Equal(t, want, IsContentMonitorQuery(q))
+		})
+	}
+}
+
+func TestPrepareContentMonitorJob(t *testing.T) {
+	t.Run("errors on non-content search", func(t *testing.T) {
+		erroringJobs := []job.Job{
+			&searcher.SymbolSearchJob{},
+			jobutil.NewParallelJob(&zoekt.GlobalTextSearchJob{}, &zoekt.SymbolSearchJob{}),
+		}
+
+		for _, j := range erroringJobs {
+			_, err := prepareContentMonitorJob(j)
+			require.Error(t, err)
+		}
+	})
+
+	t.Run("drops repo search", func(t *testing.T) {
+		j, err := prepareContentMonitorJob(jobutil.NewParallelJob(&jobutil.RepoSearchJob{}, &zoekt.GlobalTextSearchJob{}))
+		require.NoError(t, err)
+		job.VisitType(j, func(*jobutil.RepoSearchJob) {
+			t.Fatal("expected repo search job to be removed")
+		})
+	})
+
+	t.Run("content jobs are not commit jobs", func(t *testing.T) {
+		require.True(t, isContentMonitorJob(jobutil.NewTimeoutJob(0, &searcher.TextSearchJob{})))
+		require.False(t, isContentMonitorJob(jobutil.NewTimeoutJob(0, &commit.SearchJob{})))
+	})
+}
+
+func TestContentMatchToCommitMatch(t *testing.T) {
+	content := "first\nconst secret = 1\nlast\n"
+	fm := &result.FileMatch{
+		File: result.File{
+			Repo:     types.MinimalRepo{ID: 1, Name: "a"},
+			CommitID: "deadbeef",
+			Path:     "b/c.go",
+		},
+		ChunkMatches: result.ChunkMatches{{
+			Content:      "const secret = 1",
+			ContentStart: result.Location{Offset: 6, Line: 1},
+			Ranges: result.Ranges{{
+				Start: result.Location{Offset: 12, Line: 1, Column: 6},
+				End:   result.Location{Offset: 18, Line: 1, Column: 12},
+			}},
+		}},
+	}
+	require.Equal(t, "secret", content[12:18])
+
+	cm := contentMatchToCommitMatch(fm, fm.ChunkMatches)
+	require.Equal(t, "deadbeef", string(cm.Commit.ID))
+	require.Equal(t, "b/c.go b/c.go\n@@ -2,0 +2,1 @@\n+const secret = 1\n", cm.DiffPreview.Content)
+	require.Len(t, cm.DiffPreview.MatchedRanges, 1)
+
+	r := cm.DiffPreview.MatchedRanges[0]
+	require.Equal(t, "secret", cm.DiffPreview.Content[r.Start.Offset:r.End.Offset])
+	require.Equal(t, 2, r.Start.Line)
+	require.Equal(t, 7, r.Start.Column)
+
+	parsed, err := result.ParseDiffString(cm.DiffPreview.Content)
+	require.NoError(t, err)
+	require.Equal(t, cm.Diff, parsed)
+}
+
+func TestRepoContentMatchesNewMatches(t *testing.T) {
+	chunk := func(content string, line int) result.ChunkMatch {
+		return result.ChunkMatch{Content: content, ContentStart: result.Location{Line: line}}
+	}
+	fileMatch := func(path string, chunks ...result.ChunkMatch) *result.FileMatch {
+		return &result.FileMatch{
+			File:         result.File{Repo: types.MinimalRepo{ID: 1}, CommitID: "c1", Path: path},
+			ChunkMatches: chunks,
+		}
+	}
+
+	previous := (&repoContentMatches{fileMatches: []*result.FileMatch{
+		fileMatch("a.go", chunk("old secret", 3)),
+	}}).hashes()
+
+	current := &repoContentMatches{fileMatches: []*result.FileMatch{
+		// The old match moved to another line, and a new match appeared.
+		fileMatch("a.go", chunk("old secret", 10), chunk("new secret", 20)),
+		fileMatch("b.go", chunk("old secret", 1)),
+	}}
+
+	got := current.newMatches(previous)
+	require.Len(t, got, 2)
+	require.Equal(t, "a.go a.go\n@@ -21,0 +21,1 @@\n+new secret\n", got[0].DiffPreview.Content)
+	require.Equal(t, "b.go b.go\n@@ -2,0 +2,1 @@\n+old secret\n", got[1].DiffPreview.Content)
+
+	require.Empty(t, current.newMatches(current.hashes()))
+	require.Equal(t, []string{"c1"}, current.commitOIDs())
+}
+
+func TestDiffContentMatchesLimitHit(t *testing.T) {
+	ctx := context.Background()
+	repo := types.MinimalRepo{ID: 1}
+	fileMatch := func(path string) *result.FileMatch {
+		return &result.FileMatch{
+			File:         result.File{Repo: repo, CommitID: "c1", Path: path},
+			ChunkMatches: result.ChunkMatches{{Content: "secret"}},
+		}
+	}
+
+	stored := map[api.RepoID][]string{}
+	cm := edb.NewMockCodeMonitorStore()
+	cm.ListLastSearchedContentMatchesFunc.SetDefaultHook(func(context.Context, int64) (map[api.RepoID][]string, error) {
+		return stored, nil
+	})
+	cm.UpsertLastSearchedContentMatchesFunc.SetDefaultHook(func(_ context.Context, _ int64, repoID api.RepoID, _, hashes []string) error {
+		stored = map[api.RepoID][]string{repoID: hashes}
+		return nil
+	})
+
+	run := func(stats streaming.Stats, paths ...string) []string {
+		var matches result.Matches
+		for _, path := range paths {
+			matches = append(matches, fileMatch(path))
+		}
+		results, err := diffContentMatches(ctx, cm, 1, matches, stats, false)
+		require.NoError(t, err)
+		var got []string
+		for _, r := range results {
+			got = append(got, r.Diff[0].NewName)
+		}
+		return got
+	}
+
+	limitHit := streaming.Stats{IsLimitHit: true}
+	require.Equal(t, []string{"a.go", "b.go"}, run(limitHit, "a.go", "b.go"))
+
+	// Truncated runs return different subsets of the matches, none of which
+	// are new.
+	require.Empty(t, run(limitHit, "b.go"))
+	require.Empty(t, run(limitHit, "a.go"))
+	require.Equal(t, []string{"c.go"}, run(limitHit, "a.go", "c.go"))
+
+	// A complete run replaces the recorded matches.
+	require.Empty(t, run(streaming.Stats{}, "c.go"))
+	require.Equal(t, []string{"a.go"}, run(streaming.Stats{}, "a.go"))
+}
@@ -130,6 +130,10 @@ func Search(ctx context.Context, logger log.Logger, db database.DB, query string
 		return nil, errcode.MakeNonRetryable(err)
 	}
 
+	if isContentMonitorJob(planJob) {
+		return searchContent(ctx, db, clients, planJob, monitorID, false)
+	}
+
 	if featureflag.FromContext(ctx).GetBoolOr("cc-repo-aware-monitors", true) {
 		hook := func(ctx context.Context, db database.DB, gs commit.GitserverClient, args *gitprotocol.SearchRequest, repoID api.RepoID, doSearch commit.DoSearchFunc) error {
 			return hookWithID(ctx, db, gs, monitorID, repoID, args, doSearch)
@@ -184,9 +188,36 @@ func Search(ctx context.Context, logger log.Logger, db database.DB, query string
 	return results, nil
 }
 
+// SearchMatches runs query as the actor in ctx and returns all of its matches,
+// along with the stats that tell whether they are complete. Unlike Search, it
+// is not specific to commit searches and keeps no state between runs.
+func SearchMatches(ctx context.Context, logger log.Logger, db database.DB, query string, settings *schema.Settings) (result.Matches, streaming.Stats, error) {
+	searchClient := client.NewSearchClient(logger, db, search.Indexed(), search.SearcherURLs())
+	inputs, err := searchClient.Plan(
+		ctx,
+		"V3",
+		nil,
+		query,
+		search.Precise,
+		search.Streaming,
+		settings,
+		envvar.SourcegraphDotComMode(),
+	)
+	if err != nil {
+		return nil, streaming.Stats{}, errcode.MakeNonRetryable(err)
+	}
+
+	agg := streaming.NewAggregatingStream()
+	if _, err := searchClient.Execute(ctx, agg, inputs); err != nil {
+		return nil, streaming.Stats{}, err
+	}
+	return agg.Results, agg.Stats, nil
+}
+
 // Snapshot runs a dummy search that just saves the current state of the searched repos in the database.
 // On subsequent runs, this allows us to treat all new repos or sets of args as something new that should
-// be searched from the beginning.
+// be searched from the beginning. For content monitors, the current matches are recorded so that only
+// matches that appear later are reported.
 func Snapshot(ctx context.Context, logger log.Logger, db database.DB, query string, monitorID int64, settings *schema.Settings) error {
 	searchClient := client.NewSearchClient(logger, db, search.Indexed(), search.SearcherURLs())
 	inputs, err := searchClient.Plan(
@@ -209,6 +240,11 @@ func Snapshot(ctx context.Context, logger log.Logger, db database.DB, query stri
 		return err
 	}
 
+	if isContentMonitorJob(planJob) {
+		_, err = searchContent(ctx, db, clients, planJob, monitorID, true)
+		return err
+	}
+
 	hook := func(ctx context.Context, db database.DB, gs commit.GitserverClient, args *gitprotocol.SearchRequest, repoID api.RepoID, _ commit.DoSearchFunc) error {
 		return snapshotHook(ctx, db, gs, args, monitorID, repoID)
 	}
@@ -3,10 +3,13 @@ package compute
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/grafana/regexp"
 
+	"github.com/sourcegraph/sourcegraph/internal/comby"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 )
@@ -19,6 +22,10 @@ type MatchOnly struct {
 	// (e.g., default case insensitivity), but which may differ
 	// syntactically (e.g., by wrapping a pattern in (?i:<MatchPattern>).
 	ComputePattern MatchPattern
+
+	// Languages are the values of lang: filters in the query, which select
+	// the comby matcher of structural patterns.
+	Languages []string
 }
 
 func (c *MatchOnly) ToSearchPattern() string {
@@ -89,10 +96,110 @@ func matchOnly(fm *result.FileMatch, r *regexp.Regexp) *MatchContext {
 	return &MatchContext{Matches: matches, Path: fm.Path, RepositoryID: int32(fm.Repo.ID), Repository: string(fm.Repo.Name)}
 }
 
-func (c *MatchOnly) Run(_ context.Context, db database.DB, r result.Match) (Result, error) {
+// fromCombyMatch converts a comby match to a Match at the given offset in the
+// file, with the values of the named holes as its environment.
+func fromCombyMatch(m comby.Match, holes map[string]struct{}, offset int) Match {
+	env := make(Environment)
+	for _, e := range m.Environment {
+		if _, ok := holes[e.Variable]; !ok {
+			// Skip anonymous holes like :[_].
+			continue
+		}
+		env[e.Variable] = Data{
+			Value: e.Value,
+			Range: newRange(offset+e.Range.Start.Offset, offset+e.Range.End.Offset),
+		}
+	}
+	return Match{
+		Value:       m.Matched,
+		Range:       newRange(offset+m.Range.Start.Offset, offset+m.Range.End.Offset),
+		Environment: env,
+	}
+}
+
+// StructuralMatches matches the structural pattern against the content of the
+// chunk matches in the file match again to obtain the values of its holes. The
+// chunks are matched in a single comby invocation, using the matcher of the
+// first language or else the file extension, and only matches within the
+// ranges of the chunk matches are returned.
+func StructuralMatches(ctx context.Context, fm *result.FileMatch, pattern string, languages []string) ([]Match, error) {
+	if len(fm.ChunkMatches) == 0 {
+		return nil, nil
+	}
+
+	holes := make(map[string]struct{})
+	for _, name := range comby.Holes(pattern) {
+		holes[name] = struct{}{}
+	}
+
+	// Join the chunks with newlines, remembering where each chunk starts.
+	var b strings.Builder
+	starts := make([]int, 0, len(fm.ChunkMatches))
+	for i, cm := range fm.ChunkMatches {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		starts = append(starts, b.Len())
+		b.WriteString(cm.Content)
+	}
+
+	fileMatches, err := comby.Matches(ctx, comby.Args{
+		Input:         comby.FileContent(b.String()),
+		MatchTemplate: pattern,
+		Matcher:       comby.Matcher(languages, fm.Path),
+		ResultKind:    comby.MatchOnly,
+		NumWorkers:    0,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []Match
+	for _, fileMatch := range fileMatches {
+		for _, m := range fileMatch.Matches {
+			// Find the chunk the match starts in.
+			i := sort.Search(len(starts), func(i int) bool { return starts[i] > m.Range.Start.Offset }) - 1
+			if i < 0 {
+				continue
+			}
+			cm := fm.ChunkMatches[i]
+			offset := cm.ContentStart.Offset - starts[i]
+			if !withinRanges(cm.Ranges, offset+m.Range.Start.Offset, offset+m.Range.End.Offset) {
+				// Skip matches that span chunks or that the search didn't find.
+				continue
+			}
+			matches = append(matches, fromCombyMatch(m, holes, offset))
+		}
+	}
+	return matches, nil
+}
+
+func withinRanges(ranges result.Ranges, start, end int) bool {
+	for _, r := range ranges {
+		if r.Start.Offset <= start && end <= r.End.Offset {
+			return true
+		}
+	}
+	return false
+}
+
+func matchOnlyStructural(ctx context.Context, fm *result.FileMatch, pattern string, languages []string) (*MatchContext, error) {
+	matches, err := StructuralMatches(ctx, fm, pattern, languages)
+	if err != nil {
+		return nil, err
+	}
+	return &MatchContext{Matches: matches, Path: fm.Path, RepositoryID: int32(fm.Repo.ID), Repository: string(fm.Repo.Name)}, nil
+}
+
+func (c *MatchOnly) Run(ctx context.Context, db database.DB, r result.Match) (Result, error) {
 	switch m := r.(type) {
 	case *result.FileMatch:
-		return matchOnly(m, c.ComputePattern.(*Regexp).Value), nil
+		switch p := c.ComputePattern.(type) {
+		case *Comby:
+			return matchOnlyStructural(ctx, m, p.Value, c.Languages)
+		case *Regexp:
+			return matchOnly(m, p.Value), nil
+		}
 	}
 	return nil, nil
 }
@@ -1,9 +1,13 @@
 package compute
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"os"
 	"testing"
 
+	"github.com/sourcegraph/sourcegraph/internal/comby"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 
 	"github.com/grafana/regexp"
@@ -77,3 +81,93 @@ func Test_matchOnly(t *testing.T) {
 		})
 	}
 }
+
+func Test_fromCombyMatch(t *testing.T) {
+	// log.Fatal(:[x]) matched against "log.Fatal(err)" at offset 10 of a file.
+	m := comby.Match{
+		Matched: "log.Fatal(err)",
+		Range: comby.Range{
+			Start: comby.Location{Offset: 0},
+			End:   comby.Location{Offset: 14},
+		},
+		Environment: []comby.Environment{
+			{Variable: "x", Value: "err", Range: comby.Range{Start: comby.Location{Offset: 10}, End: comby.Location{Offset: 13}}},
+			{Variable: "_", Value: "log"},
+		},
+	}
+	got := fromCombyMatch(m, map[string]struct{}{"x": {}}, 10)
+	v, _ := json.MarshalIndent(got, "", "  ")
+	autogold.Want("structural match", `{
+  "value": "log.Fatal(err)",
+  "range": {
+    "start": {
+      "offset": 10,
+      "line": -1,
+      "column": -1
+    },
+    "end": {
+      "offset": 24,
+      "line": -1,
+      "column": -1
+    }
+  },
+  "environment": {
+    "x": {
+      "value": "err",
+      "range": {
+        "start": {
+          "offset": 20,
+          "line": -1,
+          "column": -1
+        },
+        "end": {
+          "offset": 23,
+          "line": -1,
+          "column": -1
+        }
+      }
+    }
+  }
+}`).Equal(t, string(v))
+}
+
+func TestStructuralMatches(t *testing.T) {
+	// If we are not on CI skip the test if comby is not installed.
+	if os.Getenv("CI") == "" && !comby.Exists() {
+		t.Skip("comby is not installed on the PATH. Try running 'bash <(curl -sL get.comby.dev)'.")
+	}
+
+	chunk := func(content string, offset int, ranges ...[2]int) result.ChunkMatch {
+		cm := result.ChunkMatch{Content: content, ContentStart: result.Location{Offset: offset}}
+		for _, r := range ranges {
+			cm.Ranges = append(cm.Ranges, result.Range{
+				Start: result.Location{Offset: r[0]},
+				End:   result.Location{Offset: r[1]},
+			})
+		}
+		return cm
+	}
+	fm := &result.FileMatch{
+		File: result.File{Path: "main.go"},
+		ChunkMatches: result.ChunkMatches{
+			chunk("\tlog.Fatal(err)", 24, [2]int{25, 39}),
+			chunk("\tlog.Fatal(ctx.Err())", 50, [2]int{51, 71}),
+			// Only part of this chunk matched, so its match is skipped.
+			chunk("\tlog.Fatal(nil)", 80, [2]int{91, 94}),
+		},
+	}
+
+	matches, err := StructuralMatches(context.Background(), fm, "log.Fatal(:[x])", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, m := range matches {
+		x := m.Environment["x"]
+		got = append(got, fmt.Sprintf("%s %d-%d x=%s %d-%d", m.Value, m.Range.Start.Offset, m.Range.End.Offset, x.Value, x.Range.Start.Offset, x.Range.End.Offset))
+	}
+	autogold.Want("structural matches in file offsets", []string{
+		"log.Fatal(err) 25-39 x=err 35-38",
+		"log.Fatal(ctx.Err()) 51-71 x=ctx.Err() 61-70",
+	}).Equal(t, got)
+}
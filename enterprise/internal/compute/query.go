@@ -223,6 +223,14 @@ func parseMatchOnly(q *query.Basic) (Command, bool, error) {
 		return nil, false, err
 	}
 
+	if q.IsStructural() {
+		// structural search doesn't do any match pattern validation, and is
+		// always case sensitive.
+		cp := &Comby{Value: pattern.Value}
+		languages, _ := q.IncludeExcludeValues(query.FieldLang)
+		return &MatchOnly{SearchPattern: cp, ComputePattern: cp, Languages: languages}, true, nil
+	}
+
 	sp, err := toRegexpPattern(pattern.Value)
 	if err != nil {
 		return nil, false, err
@@ -283,8 +291,27 @@ func toComputeQuery(plan query.Plan) (*Query, error) {
 	}, nil
 }
 
+// searchTypeForQuery returns the search type to parse q with. Compute queries
+// are regular expressions, unless they are explicitly structural.
+func searchTypeForQuery(q string) query.SearchType {
+	searchType := query.SearchTypeRegex
+	parseTree, err := query.Parse(q, query.SearchTypeLiteral)
+	if err != nil {
+		// Any actual parse errors will be raised when parsing the query
+		// with the search type we return.
+		return searchType
+	}
+	query.VisitField(query.LowercaseFieldNames(parseTree), query.FieldPatternType, func(value string, _ bool, _ query.Annotation) {
+		if value == "structural" {
+			searchType = query.SearchTypeStructural
+		}
+	})
+	return searchType
+}
+
 func Parse(q string) (*Query, error) {
-	parseTree, err := query.ParseRegexp(q)
+	searchType := searchTypeForQuery(q)
+	parseTree, err := query.Parse(q, searchType)
 	if err != nil {
 		return nil, err
 	}
@@ -297,7 +324,7 @@ func Parse(q string) (*Query, error) {
 		return nil, errors.New("compute endpoint cannot currently support expressions in patterns containing 'and', 'or', 'not' (or negation) right now!")
 	}
 
-	plan, err := query.Pipeline(query.InitRegexp(q))
+	plan, err := query.Pipeline(query.Init(q, searchType))
 	if err != nil {
 		return nil, err
 	}
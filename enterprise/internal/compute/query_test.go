@@ -50,6 +50,10 @@ func TestParse(t *testing.T) {
 	autogold.Want("replace no left hand side",
 		"Command: `Replace in place: () -> (b)`").
 		Equal(t, test("content:replace(->b)"))
+
+	autogold.Want("structural match only",
+		"Command: `Match only search pattern: log.Fatal(:[x]), compute pattern: log.Fatal(:[x])`, Parameters: `patterntype:structural`").
+		Equal(t, test("log.Fatal(:[x]) patterntype:structural"))
 }
 
 func TestToSearchQuery(t *testing.T) {
@@ -73,4 +77,8 @@ func TestToSearchQuery(t *testing.T) {
 	autogold.Want("allow expressions on search parameters (filters)",
 		"((repo:foo file:bar lang:go OR repo:foo file:bar lang:text) AND colarado)").
 		Equal(t, test("content:replace(colarado -> colorodo) repo:foo file:bar (lang:go or lang:text)"))
+
+	autogold.Want("convert structural match-only to search query",
+		"(repo:foo patterntype:structural AND log.Fatal(:[x]))").
+		Equal(t, test("repo:foo log.Fatal(:[x]) patterntype:structural"))
 }
@@ -0,0 +1,129 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+// ActionDeliveryAttempt records a single attempt at delivering the
+// notification of an action job to an external service.
+type ActionDeliveryAttempt struct {
+	ID        int64
+	ActionJob int32
+	Attempt   int32
+
+	// StatusCode is the HTTP status code returned by the remote service, if
+	// any response was received.
+	StatusCode *int32
+	// Error is the error encountered during the attempt, if any.
+	Error    *string
+	Duration time.Duration
+
+	CreatedAt time.Time
+}
+
+// ActionDeliveryAttemptArgs is the set of arguments to record an attempt at
+// delivering an action job.
+type ActionDeliveryAttemptArgs struct {
+	ActionJob  int32
+	Attempt    int32
+	StatusCode *int32
+	Error      *string
+	Duration   time.Duration
+}
+
+const createActionDeliveryAttemptQuery = `
+INSERT INTO cm_action_delivery_attempts
+(action_job, attempt, status_code, error, duration_ms, created_at)
+VALUES (%s,%s,%s,%s,%s,%s)
+RETURNING %s;
+`
+
+func (s *codeMonitorStore) CreateActionDeliveryAttempt(ctx context.Context, args ActionDeliveryAttemptArgs) (*ActionDeliveryAttempt, error) {
+	q := sqlf.Sprintf(
+		createActionDeliveryAttemptQuery,
+		args.ActionJob,
+		args.Attempt,
+		args.StatusCode,
+		args.Error,
+		args.Duration.Milliseconds(),
+		s.Now(),
+		sqlf.Join(actionDeliveryAttemptColumns, ","),
+	)
+
+	row := s.QueryRow(ctx, q)
+	return scanActionDeliveryAttempt(row)
+}
+
+const listActionDeliveryAttemptsQuery = `
+SELECT %s -- actionDeliveryAttemptColumns
+FROM cm_action_delivery_attempts
+WHERE action_job = %s
+ORDER BY attempt ASC, id ASC;
+`
+
+// ListActionDeliveryAttempts returns all recorded delivery attempts of the
+// given action job, oldest first.
+func (s *codeMonitorStore) ListActionDeliveryAttempts(ctx context.Context, actionJobID int32) ([]*ActionDeliveryAttempt, error) {
+	q := sqlf.Sprintf(
+		listActionDeliveryAttemptsQuery,
+		sqlf.Join(actionDeliveryAttemptColumns, ","),
+		actionJobID,
+	)
+	rows, err := s.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanActionDeliveryAttempts(rows)
+}
+
+// actionDeliveryAttemptColumns is the set of columns in the
+// cm_action_delivery_attempts table. This must be kept in sync with
+// scanActionDeliveryAttempt.
+var actionDeliveryAttemptColumns = []*sqlf.Query{
+	sqlf.Sprintf("cm_action_delivery_attempts.id"),
+	sqlf.Sprintf("cm_action_delivery_attempts.action_job"),
+	sqlf.Sprintf("cm_action_delivery_attempts.attempt"),
+	sqlf.Sprintf("cm_action_delivery_attempts.status_code"),
+	sqlf.Sprintf("cm_action_delivery_attempts.error"),
+	sqlf.Sprintf("cm_action_delivery_attempts.duration_ms"),
+	sqlf.Sprintf("cm_action_delivery_attempts.created_at"),
+}
+
+func scanActionDeliveryAttempts(rows *sql.Rows) ([]*ActionDeliveryAttempt, error) {
+	var as []*ActionDeliveryAttempt
+	for rows.Next() {
+		a, err := scanActionDeliveryAttempt(rows)
+		if err != nil {
+			return nil, err
+		}
+		as = append(as, a)
+	}
+	return as, rows.Err()
+}
+
+// scanActionDeliveryAttempt scans an ActionDeliveryAttempt from a *sql.Row or
+// *sql.Rows. It must be kept in sync with actionDeliveryAttemptColumns.
+func scanActionDeliveryAttempt(scanner dbutil.Scanner) (*ActionDeliveryAttempt, error) {
+	var (
+		a          ActionDeliveryAttempt
+		durationMs int64
+	)
+	err := scanner.Scan(
+		&a.ID,
+		&a.ActionJob,
+		&a.Attempt,
+		&a.StatusCode,
+		&a.Error,
+		&durationMs,
+		&a.CreatedAt,
+	)
+	a.Duration = time.Duration(durationMs) * time.Millisecond
+	return &a, err
+}
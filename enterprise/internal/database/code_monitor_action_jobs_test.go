@@ -96,3 +96,52 @@ func TestScanActionJob(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, int(actionJobID), job.RecordID())
 }
+
+func TestActionDeliveryAttempts(t *testing.T) {
+	ctx, db, s := newTestStore(t)
+	_, _, userCTX := newTestUser(ctx, t, db)
+	fixtures := s.insertTestMonitor(userCTX, t)
+
+	triggerJobs, err := s.EnqueueQueryTriggerJobs(ctx)
+	require.NoError(t, err)
+	require.Len(t, triggerJobs, 1)
+
+	actionJobs, err := s.EnqueueActionJobsForMonitor(ctx, fixtures.monitor.ID, triggerJobs[0].ID)
+	require.NoError(t, err)
+	require.Len(t, actionJobs, 2)
+	actionJobID := actionJobs[0].ID
+
+	statusCode := int32(503)
+	errMsg := "unexpected status code 503"
+	_, err = s.CreateActionDeliveryAttempt(ctx, ActionDeliveryAttemptArgs{
+		ActionJob:  actionJobID,
+		Attempt:    1,
+		StatusCode: &statusCode,
+		Error:      &errMsg,
+		Duration:   120 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	okStatusCode := int32(200)
+	_, err = s.CreateActionDeliveryAttempt(ctx, ActionDeliveryAttemptArgs{
+		ActionJob:  actionJobID,
+		Attempt:    2,
+		StatusCode: &okStatusCode,
+		Duration:   30 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	attempts, err := s.ListActionDeliveryAttempts(ctx, actionJobID)
+	require.NoError(t, err)
+	require.Len(t, attempts, 2)
+	require.Equal(t, int32(1), attempts[0].Attempt)
+	require.Equal(t, &statusCode, attempts[0].StatusCode)
+	require.Equal(t, &errMsg, attempts[0].Error)
+	require.Equal(t, 120*time.Millisecond, attempts[0].Duration)
+	require.Equal(t, int32(2), attempts[1].Attempt)
+	require.Nil(t, attempts[1].Error)
+
+	attempts, err = s.ListActionDeliveryAttempts(ctx, actionJobs[1].ID)
+	require.NoError(t, err)
+	require.Empty(t, attempts)
+}
@@ -56,3 +56,51 @@ func (s *codeMonitorStore) GetLastSearched(ctx context.Context, monitorID int64,
 	}
 	return commitOIDs, err
 }
+
+func (s *codeMonitorStore) UpsertLastSearchedContentMatches(ctx context.Context, monitorID int64, repoID api.RepoID, commitOIDs, matchHashes []string) error {
+	rawQuery := `
+	INSERT INTO cm_last_searched (monitor_id, repo_id, commit_oids, content_match_hashes)
+	VALUES (%s, %s, %s, %s)
+	ON CONFLICT (monitor_id, repo_id) DO UPDATE
+	SET commit_oids = EXCLUDED.commit_oids,
+		content_match_hashes = EXCLUDED.content_match_hashes
+	`
+
+	// Appease non-null constraint on columns
+	if commitOIDs == nil {
+		commitOIDs = []string{}
+	}
+	if matchHashes == nil {
+		matchHashes = []string{}
+	}
+	q := sqlf.Sprintf(rawQuery, monitorID, int64(repoID), pq.StringArray(commitOIDs), pq.StringArray(matchHashes))
+	return s.Exec(ctx, q)
+}
+
+func (s *codeMonitorStore) ListLastSearchedContentMatches(ctx context.Context, monitorID int64) (map[api.RepoID][]string, error) {
+	rawQuery := `
+	SELECT repo_id, content_match_hashes
+	FROM cm_last_searched
+	WHERE monitor_id = %s
+	`
+
+	q := sqlf.Sprintf(rawQuery, monitorID)
+	rows, err := s.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make(map[api.RepoID][]string)
+	for rows.Next() {
+		var (
+			repoID      int32
+			matchHashes []string
+		)
+		if err := rows.Scan(&repoID, (*pq.StringArray)(&matchHashes)); err != nil {
+			return nil, err
+		}
+		res[api.RepoID(repoID)] = matchHashes
+	}
+	return res, rows.Err()
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/sourcegraph/log/logtest"
 
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
 )
@@ -116,3 +117,37 @@ func TestCodeMonitorHasAnyLastSearched(t *testing.T) {
 		require.True(t, hasLastSearched)
 	})
 }
+
+func TestCodeMonitorLastSearchedContentMatches(t *testing.T) {
+	t.Parallel()
+	logger := logtest.Scoped(t)
+	ctx := context.Background()
+	db := NewEnterpriseDB(database.NewDB(logger, dbtest.NewDB(logger, t)))
+	fixtures := populateCodeMonitorFixtures(t, db)
+	cm := db.CodeMonitors()
+
+	// Nothing recorded yet
+	matches, err := cm.ListLastSearchedContentMatches(ctx, fixtures.Monitor.ID)
+	require.NoError(t, err)
+	require.Empty(t, matches)
+
+	// Insert
+	err = cm.UpsertLastSearchedContentMatches(ctx, fixtures.Monitor.ID, fixtures.Repo.ID, []string{"commit1"}, []string{"hash1", "hash2"})
+	require.NoError(t, err)
+
+	matches, err = cm.ListLastSearchedContentMatches(ctx, fixtures.Monitor.ID)
+	require.NoError(t, err)
+	require.Equal(t, map[api.RepoID][]string{fixtures.Repo.ID: {"hash1", "hash2"}}, matches)
+
+	lastSearched, err := cm.GetLastSearched(ctx, fixtures.Monitor.ID, fixtures.Repo.ID)
+	require.NoError(t, err)
+	require.Equal(t, []string{"commit1"}, lastSearched)
+
+	// Update with no matches
+	err = cm.UpsertLastSearchedContentMatches(ctx, fixtures.Monitor.ID, fixtures.Repo.ID, nil, nil)
+	require.NoError(t, err)
+
+	matches, err = cm.ListLastSearchedContentMatches(ctx, fixtures.Monitor.ID)
+	require.NoError(t, err)
+	require.Equal(t, map[api.RepoID][]string{fixtures.Repo.ID: {}}, matches)
+}
@@ -3,12 +3,15 @@ package database
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/keegancsmith/sqlf"
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/encryption"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 type WebhookAction struct {
@@ -18,17 +21,42 @@ type WebhookAction struct {
 	URL            string
 	IncludeResults bool
 
+	// PayloadTemplate is a Go template rendering the request body. When
+	// empty, the default JSON payload is sent.
+	PayloadTemplate string
+	// Headers are the custom headers sent with every request. They are
+	// encrypted at rest as they commonly contain secrets.
+	Headers *encryption.JSONEncryptable[map[string]string]
+
 	CreatedBy int32
 	CreatedAt time.Time
 	ChangedBy int32
 	ChangedAt time.Time
 }
 
+// WebhookActionArgs is the set of arguments to create or update a webhook
+// action.
+type WebhookActionArgs struct {
+	Enabled        bool
+	IncludeResults bool
+	URL            string
+
+	// PayloadTemplate is the Go template rendering the request body. When
+	// updating an action, a nil template leaves the existing template
+	// unchanged.
+	PayloadTemplate *string
+	// Headers are the custom headers to send with every request. When
+	// updating an action, nil headers leave the existing headers unchanged.
+	Headers map[string]string
+}
+
 const updateWebhookActionQuery = `
 UPDATE cm_webhooks
 SET enabled = %s,
     include_results = %s,
 	url = %s,
+	payload_template = COALESCE(%s, payload_template),
+	%s
 	changed_by = %s,
 	changed_at = %s
 WHERE
@@ -41,13 +69,24 @@ WHERE
 RETURNING %s;
 `
 
-func (s *codeMonitorStore) UpdateWebhookAction(ctx context.Context, id int64, enabled, includeResults bool, url string) (*WebhookAction, error) {
+func (s *codeMonitorStore) UpdateWebhookAction(ctx context.Context, id int64, args *WebhookActionArgs) (*WebhookAction, error) {
+	setHeaders := sqlf.Sprintf("")
+	if args.Headers != nil {
+		headers, keyID, err := s.encryptWebhookHeaders(ctx, args.Headers)
+		if err != nil {
+			return nil, err
+		}
+		setHeaders = sqlf.Sprintf("headers = %s, encryption_key_id = %s,", headers, keyID)
+	}
+
 	a := actor.FromContext(ctx)
 	q := sqlf.Sprintf(
 		updateWebhookActionQuery,
-		enabled,
-		includeResults,
-		url,
+		args.Enabled,
+		args.IncludeResults,
+		args.URL,
+		args.PayloadTemplate,
+		setHeaders,
 		a.UID,
 		s.Now(),
 		id,
@@ -56,25 +95,38 @@ func (s *codeMonitorStore) UpdateWebhookAction(ctx context.Context, id int64, en
 	)
 
 	row := s.QueryRow(ctx, q)
-	return scanWebhookAction(row)
+	return s.scanWebhookAction(row)
 }
 
 const createWebhookActionQuery = `
 INSERT INTO cm_webhooks
-(monitor, enabled, include_results, url, created_by, created_at, changed_by, changed_at)
-VALUES (%s,%s,%s,%s,%s,%s,%s,%s)
+(monitor, enabled, include_results, url, payload_template, headers, encryption_key_id, created_by, created_at, changed_by, changed_at)
+VALUES (%s,%s,%s,%s,%s,%s,%s,%s,%s,%s,%s)
 RETURNING %s;
 `
 
-func (s *codeMonitorStore) CreateWebhookAction(ctx context.Context, monitorID int64, enabled, includeResults bool, url string) (*WebhookAction, error) {
+func (s *codeMonitorStore) CreateWebhookAction(ctx context.Context, monitorID int64, args *WebhookActionArgs) (*WebhookAction, error) {
+	headers, keyID, err := s.encryptWebhookHeaders(ctx, args.Headers)
+	if err != nil {
+		return nil, err
+	}
+
+	payloadTemplate := ""
+	if args.PayloadTemplate != nil {
+		payloadTemplate = *args.PayloadTemplate
+	}
+
 	now := s.Now()
 	a := actor.FromContext(ctx)
 	q := sqlf.Sprintf(
 		createWebhookActionQuery,
 		monitorID,
-		enabled,
-		includeResults,
-		url,
+		args.Enabled,
+		args.IncludeResults,
+		args.URL,
+		payloadTemplate,
+		headers,
+		keyID,
 		a.UID,
 		now,
 		a.UID,
@@ -83,7 +135,24 @@ func (s *codeMonitorStore) CreateWebhookAction(ctx context.Context, monitorID in
 	)
 
 	row := s.QueryRow(ctx, q)
-	return scanWebhookAction(row)
+	return s.scanWebhookAction(row)
+}
+
+// encryptWebhookHeaders serializes and encrypts the headers of a webhook
+// action, returning the value to store and the ID of the key used.
+func (s *codeMonitorStore) encryptWebhookHeaders(ctx context.Context, headers map[string]string) (string, string, error) {
+	if headers == nil {
+		headers = map[string]string{}
+	}
+	raw, err := json.Marshal(headers)
+	if err != nil {
+		return "", "", err
+	}
+	encrypted, keyID, err := encryption.MaybeEncrypt(ctx, s.getWebhookEncryptionKey(), string(raw))
+	if err != nil {
+		return "", "", errors.Wrap(err, "encrypting webhook headers")
+	}
+	return encrypted, keyID, nil
 }
 
 const deleteWebhookActionQuery = `
@@ -135,7 +204,7 @@ func (s *codeMonitorStore) GetWebhookAction(ctx context.Context, webhookID int64
 		webhookID,
 	)
 	row := s.QueryRow(ctx, q)
-	return scanWebhookAction(row)
+	return s.scanWebhookAction(row)
 }
 
 const listWebhookActionsQuery = `
@@ -158,7 +227,7 @@ func (s *codeMonitorStore) ListWebhookActions(ctx context.Context, opts ListActi
 		return nil, err
 	}
 	defer rows.Close()
-	return scanWebhookActions(rows)
+	return s.scanWebhookActions(rows)
 }
 
 // webhookActionColumns is the set of columns in the cm_webhooks table
@@ -169,16 +238,19 @@ var webhookActionColumns = []*sqlf.Query{
 	sqlf.Sprintf("cm_webhooks.enabled"),
 	sqlf.Sprintf("cm_webhooks.url"),
 	sqlf.Sprintf("cm_webhooks.include_results"),
+	sqlf.Sprintf("cm_webhooks.payload_template"),
+	sqlf.Sprintf("cm_webhooks.headers"),
+	sqlf.Sprintf("cm_webhooks.encryption_key_id"),
 	sqlf.Sprintf("cm_webhooks.created_by"),
 	sqlf.Sprintf("cm_webhooks.created_at"),
 	sqlf.Sprintf("cm_webhooks.changed_by"),
 	sqlf.Sprintf("cm_webhooks.changed_at"),
 }
 
-func scanWebhookActions(rows *sql.Rows) ([]*WebhookAction, error) {
+func (s *codeMonitorStore) scanWebhookActions(rows *sql.Rows) ([]*WebhookAction, error) {
 	var ws []*WebhookAction
 	for rows.Next() {
-		w, err := scanWebhookAction(rows)
+		w, err := s.scanWebhookAction(rows)
 		if err != nil {
 			return nil, err
 		}
@@ -189,18 +261,28 @@ func scanWebhookActions(rows *sql.Rows) ([]*WebhookAction, error) {
 
 // scanWebhookAction scans a WebhookAction from a *sql.Row or *sql.Rows.
 // It must be kept in sync with webhookActionColumns.
-func scanWebhookAction(scanner dbutil.Scanner) (*WebhookAction, error) {
-	var w WebhookAction
+func (s *codeMonitorStore) scanWebhookAction(scanner dbutil.Scanner) (*WebhookAction, error) {
+	var (
+		w              WebhookAction
+		headers, keyID string
+	)
 	err := scanner.Scan(
 		&w.ID,
 		&w.Monitor,
 		&w.Enabled,
 		&w.URL,
 		&w.IncludeResults,
+		&w.PayloadTemplate,
+		&headers,
+		&keyID,
 		&w.CreatedBy,
 		&w.CreatedAt,
 		&w.ChangedBy,
 		&w.ChangedAt,
 	)
-	return &w, err
+	if err != nil {
+		return nil, err
+	}
+	w.Headers = encryption.NewEncryptedJSON[map[string]string](headers, keyID, s.getWebhookEncryptionKey())
+	return &w, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/keegancsmith/sqlf"
 	"github.com/stretchr/testify/require"
 
 	"github.com/sourcegraph/log/logtest"
@@ -11,6 +12,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	et "github.com/sourcegraph/sourcegraph/internal/encryption/testing"
 )
 
 func TestCodeMonitorStoreWebhooks(t *testing.T) {
@@ -28,7 +30,7 @@ func TestCodeMonitorStoreWebhooks(t *testing.T) {
 		s := CodeMonitors(db)
 		fixtures := s.insertTestMonitor(ctx, t)
 
-		action, err := s.CreateWebhookAction(ctx, fixtures.monitor.ID, true, false, url1)
+		action, err := s.CreateWebhookAction(ctx, fixtures.monitor.ID, &WebhookActionArgs{Enabled: true, IncludeResults: false, URL: url1})
 		require.NoError(t, err)
 
 		got, err := s.GetWebhookAction(ctx, action.ID)
@@ -45,10 +47,10 @@ func TestCodeMonitorStoreWebhooks(t *testing.T) {
 		s := CodeMonitors(db)
 		fixtures := s.insertTestMonitor(ctx, t)
 
-		action, err := s.CreateWebhookAction(ctx, fixtures.monitor.ID, true, false, url1)
+		action, err := s.CreateWebhookAction(ctx, fixtures.monitor.ID, &WebhookActionArgs{Enabled: true, IncludeResults: false, URL: url1})
 		require.NoError(t, err)
 
-		updated, err := s.UpdateWebhookAction(ctx, action.ID, false, false, url2)
+		updated, err := s.UpdateWebhookAction(ctx, action.ID, &WebhookActionArgs{Enabled: false, IncludeResults: false, URL: url2})
 		require.NoError(t, err)
 		require.Equal(t, false, updated.Enabled)
 		require.Equal(t, url2, updated.URL)
@@ -58,6 +60,59 @@ func TestCodeMonitorStoreWebhooks(t *testing.T) {
 		require.Equal(t, updated, got)
 	})
 
+	t.Run("TemplateAndHeaders", func(t *testing.T) {
+		t.Parallel()
+
+		db := database.NewDB(logger, dbtest.NewDB(logger, t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitors(db)
+		s.key = et.TestKey{}
+		fixtures := s.insertTestMonitor(ctx, t)
+
+		headers := map[string]string{"Authorization": "Bearer secret"}
+		payloadTemplate := `{"text": {{json .MonitorDescription}}}`
+		action, err := s.CreateWebhookAction(ctx, fixtures.monitor.ID, &WebhookActionArgs{
+			Enabled:         true,
+			URL:             url1,
+			PayloadTemplate: &payloadTemplate,
+			Headers:         headers,
+		})
+		require.NoError(t, err)
+
+		var rawHeaders, keyID string
+		err = s.QueryRow(ctx, sqlf.Sprintf("SELECT headers, encryption_key_id FROM cm_webhooks WHERE id = %s", action.ID)).Scan(&rawHeaders, &keyID)
+		require.NoError(t, err)
+		require.NotContains(t, rawHeaders, "secret")
+		require.NotEmpty(t, keyID)
+
+		got, err := s.GetWebhookAction(ctx, action.ID)
+		require.NoError(t, err)
+		require.Equal(t, payloadTemplate, got.PayloadTemplate)
+		gotHeaders, err := got.Headers.Decrypt(ctx)
+		require.NoError(t, err)
+		require.Equal(t, headers, gotHeaders)
+
+		// Nil template and headers leave the existing values untouched.
+		_, err = s.UpdateWebhookAction(ctx, action.ID, &WebhookActionArgs{Enabled: true, URL: url2})
+		require.NoError(t, err)
+		got, err = s.GetWebhookAction(ctx, action.ID)
+		require.NoError(t, err)
+		require.Equal(t, payloadTemplate, got.PayloadTemplate)
+		gotHeaders, err = got.Headers.Decrypt(ctx)
+		require.NoError(t, err)
+		require.Equal(t, headers, gotHeaders)
+
+		emptyTemplate := ""
+		_, err = s.UpdateWebhookAction(ctx, action.ID, &WebhookActionArgs{Enabled: true, URL: url2, PayloadTemplate: &emptyTemplate, Headers: map[string]string{}})
+		require.NoError(t, err)
+		got, err = s.GetWebhookAction(ctx, action.ID)
+		require.NoError(t, err)
+		require.Empty(t, got.PayloadTemplate)
+		gotHeaders, err = got.Headers.Decrypt(ctx)
+		require.NoError(t, err)
+		require.Empty(t, gotHeaders)
+	})
+
 	t.Run("ErrorOnUpdateNonexistent", func(t *testing.T) {
 		t.Parallel()
 
@@ -65,7 +120,7 @@ func TestCodeMonitorStoreWebhooks(t *testing.T) {
 		_, _, ctx := newTestUser(ctx, t, db)
 		s := CodeMonitors(db)
 
-		_, err := s.UpdateWebhookAction(ctx, 383838, false, false, url2)
+		_, err := s.UpdateWebhookAction(ctx, 383838, &WebhookActionArgs{Enabled: false, IncludeResults: false, URL: url2})
 		require.Error(t, err)
 	})
 
@@ -77,10 +132,10 @@ func TestCodeMonitorStoreWebhooks(t *testing.T) {
 		s := CodeMonitors(db)
 		fixtures := s.insertTestMonitor(ctx, t)
 
-		action1, err := s.CreateWebhookAction(ctx, fixtures.monitor.ID, true, false, url1)
+		action1, err := s.CreateWebhookAction(ctx, fixtures.monitor.ID, &WebhookActionArgs{Enabled: true, IncludeResults: false, URL: url1})
 		require.NoError(t, err)
 
-		action2, err := s.CreateWebhookAction(ctx, fixtures.monitor.ID, true, false, url1)
+		action2, err := s.CreateWebhookAction(ctx, fixtures.monitor.ID, &WebhookActionArgs{Enabled: true, IncludeResults: false, URL: url1})
 		require.NoError(t, err)
 
 		err = s.DeleteWebhookActions(ctx, fixtures.monitor.ID, action1.ID)
@@ -105,7 +160,7 @@ func TestCodeMonitorStoreWebhooks(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, 0, count)
 
-		_, err = s.CreateWebhookAction(ctx, fixtures.monitor.ID, true, false, url1)
+		_, err = s.CreateWebhookAction(ctx, fixtures.monitor.ID, &WebhookActionArgs{Enabled: true, IncludeResults: false, URL: url1})
 		require.NoError(t, err)
 
 		count, err = s.CountWebhookActions(ctx, fixtures.monitor.ID)
@@ -125,10 +180,10 @@ func TestCodeMonitorStoreWebhooks(t *testing.T) {
 		require.NoError(t, err)
 		require.Len(t, actions, 0)
 
-		_, err = s.CreateWebhookAction(ctx, fixtures.monitor.ID, true, false, url1)
+		_, err = s.CreateWebhookAction(ctx, fixtures.monitor.ID, &WebhookActionArgs{Enabled: true, IncludeResults: false, URL: url1})
 		require.NoError(t, err)
 
-		_, err = s.CreateWebhookAction(ctx, fixtures.monitor.ID, true, false, url2)
+		_, err = s.CreateWebhookAction(ctx, fixtures.monitor.ID, &WebhookActionArgs{Enabled: true, IncludeResults: false, URL: url2})
 		require.NoError(t, err)
 
 		actions2, err := s.ListWebhookActions(ctx, ListActionsOpts{MonitorID: &fixtures.monitor.ID})
@@ -150,15 +205,15 @@ func TestCodeMonitorStoreWebhooks(t *testing.T) {
 		fixtures := s.insertTestMonitor(ctx1, t)
 		_ = s.insertTestMonitor(ctx2, t)
 
-		wa, err := s.CreateWebhookAction(ctx1, fixtures.monitor.ID, true, true, "https://true.com")
+		wa, err := s.CreateWebhookAction(ctx1, fixtures.monitor.ID, &WebhookActionArgs{Enabled: true, IncludeResults: true, URL: "https://true.com"})
 		require.NoError(t, err)
 
 		// User1 can update it
-		_, err = s.UpdateWebhookAction(ctx1, wa.ID, true, true, "https://false.com")
+		_, err = s.UpdateWebhookAction(ctx1, wa.ID, &WebhookActionArgs{Enabled: true, IncludeResults: true, URL: "https://false.com"})
 		require.NoError(t, err)
 
 		// User2 cannot update it
-		_, err = s.UpdateWebhookAction(ctx2, wa.ID, true, true, "https://truer.com")
+		_, err = s.UpdateWebhookAction(ctx2, wa.ID, &WebhookActionArgs{Enabled: true, IncludeResults: true, URL: "https://truer.com"})
 		require.Error(t, err)
 
 		wa, err = s.GetWebhookAction(ctx1, wa.ID)
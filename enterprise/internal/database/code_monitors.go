@@ -18,6 +18,8 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/encryption"
+	"github.com/sourcegraph/sourcegraph/internal/encryption/keyring"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/timeutil"
 )
@@ -58,8 +60,8 @@ type CodeMonitorStore interface {
 	GetEmailAction(ctx context.Context, emailID int64) (*EmailAction, error)
 	ListEmailActions(context.Context, ListActionsOpts) ([]*EmailAction, error)
 
-	UpdateWebhookAction(_ context.Context, id int64, enabled, includeResults bool, url string) (*WebhookAction, error)
-	CreateWebhookAction(ctx context.Context, monitorID int64, enabled, includeResults bool, url string) (*WebhookAction, error)
+	UpdateWebhookAction(_ context.Context, id int64, _ *WebhookActionArgs) (*WebhookAction, error)
+	CreateWebhookAction(ctx context.Context, monitorID int64, _ *WebhookActionArgs) (*WebhookAction, error)
 	DeleteWebhookActions(ctx context.Context, monitorID int64, ids ...int64) error
 	CountWebhookActions(ctx context.Context, monitorID int64) (int, error)
 	GetWebhookAction(ctx context.Context, id int64) (*WebhookAction, error)
@@ -72,6 +74,13 @@ type CodeMonitorStore interface {
 	GetSlackWebhookAction(ctx context.Context, id int64) (*SlackWebhookAction, error)
 	ListSlackWebhookActions(context.Context, ListActionsOpts) ([]*SlackWebhookAction, error)
 
+	UpdateTeamsWebhookAction(_ context.Context, id int64, enabled, includeResults bool, url string) (*TeamsWebhookAction, error)
+	CreateTeamsWebhookAction(ctx context.Context, monitorID int64, enabled, includeResults bool, url string) (*TeamsWebhookAction, error)
+	DeleteTeamsWebhookActions(ctx context.Context, monitorID int64, ids ...int64) error
+	CountTeamsWebhookActions(ctx context.Context, monitorID int64) (int, error)
+	GetTeamsWebhookAction(ctx context.Context, id int64) (*TeamsWebhookAction, error)
+	ListTeamsWebhookActions(context.Context, ListActionsOpts) ([]*TeamsWebhookAction, error)
+
 	CreateRecipient(ctx context.Context, emailID int64, userID, orgID *int32) (*Recipient, error)
 	DeleteRecipients(ctx context.Context, emailID int64) error
 	ListRecipients(context.Context, ListRecipientsOpts) ([]*Recipient, error)
@@ -83,6 +92,9 @@ type CodeMonitorStore interface {
 	GetActionJob(ctx context.Context, jobID int32) (*ActionJob, error)
 	EnqueueActionJobsForMonitor(ctx context.Context, monitorID int64, triggerJob int32) ([]*ActionJob, error)
 
+	CreateActionDeliveryAttempt(context.Context, ActionDeliveryAttemptArgs) (*ActionDeliveryAttempt, error)
+	ListActionDeliveryAttempts(ctx context.Context, actionJobID int32) ([]*ActionDeliveryAttempt, error)
+
 	// HasAnyLastSearched returns whether there have ever been any repo-aware code monitor
 	// searches executed for this code monitor. This should only be needed during the transition
 	// version so that we don't detect every repo as a new repo and search their entire history
@@ -90,6 +102,12 @@ type CodeMonitorStore interface {
 	HasAnyLastSearched(ctx context.Context, monitorID int64) (bool, error)
 	UpsertLastSearched(ctx context.Context, monitorID int64, repoID api.RepoID, lastSearched []string) error
 	GetLastSearched(ctx context.Context, monitorID int64, repoID api.RepoID) ([]string, error)
+
+	// UpsertLastSearchedContentMatches and ListLastSearchedContentMatches
+	// track the file content matches of content monitors, keyed by repo, so
+	// that only newly appearing matches are reported.
+	UpsertLastSearchedContentMatches(ctx context.Context, monitorID int64, repoID api.RepoID, commitOIDs, matchHashes []string) error
+	ListLastSearchedContentMatches(ctx context.Context, monitorID int64) (map[api.RepoID][]string, error)
 }
 
 // codeMonitorStore exposes methods to read and write codemonitors domain models
@@ -97,6 +115,10 @@ type CodeMonitorStore interface {
 type codeMonitorStore struct {
 	*basestore.Store
 	now func() time.Time
+
+	// key is used to encrypt the custom headers of webhook actions. When nil,
+	// the key configured in the site keyring is used.
+	key encryption.Key
 }
 
 var _ CodeMonitorStore = (*codeMonitorStore)(nil)
@@ -129,7 +151,15 @@ func (s *codeMonitorStore) Transact(ctx context.Context) (CodeMonitorStore, erro
 	if err != nil {
 		return nil, err
 	}
-	return &codeMonitorStore{Store: txBase, now: s.now}, nil
+	return &codeMonitorStore{Store: txBase, now: s.now, key: s.key}, nil
+}
+
+func (s *codeMonitorStore) getWebhookEncryptionKey() encryption.Key {
+	if s.key != nil {
+		return s.key
+	}
+
+	return keyring.Default().CodeMonitorWebhookKey
 }
 
 type JobTable int
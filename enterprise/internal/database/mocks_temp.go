@@ -46,9 +46,16 @@ type MockCodeMonitorStore struct {
 	// CountSlackWebhookActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method CountSlackWebhookActions.
 	CountSlackWebhookActionsFunc *CodeMonitorStoreCountSlackWebhookActionsFunc
+	// CountTeamsWebhookActionsFunc is an instance of a mock function object
+	// controlling the behavior of the method CountTeamsWebhookActions.
+	CountTeamsWebhookActionsFunc *CodeMonitorStoreCountTeamsWebhookActionsFunc
 	// CountWebhookActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method CountWebhookActions.
 	CountWebhookActionsFunc *CodeMonitorStoreCountWebhookActionsFunc
+	// CreateActionDeliveryAttemptFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// CreateActionDeliveryAttempt.
+	CreateActionDeliveryAttemptFunc *CodeMonitorStoreCreateActionDeliveryAttemptFunc
 	// CreateEmailActionFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateEmailAction.
 	CreateEmailActionFunc *CodeMonitorStoreCreateEmailActionFunc
@@ -64,6 +71,9 @@ type MockCodeMonitorStore struct {
 	// CreateSlackWebhookActionFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateSlackWebhookAction.
 	CreateSlackWebhookActionFunc *CodeMonitorStoreCreateSlackWebhookActionFunc
+	// CreateTeamsWebhookActionFunc is an instance of a mock function object
+	// controlling the behavior of the method CreateTeamsWebhookAction.
+	CreateTeamsWebhookActionFunc *CodeMonitorStoreCreateTeamsWebhookActionFunc
 	// CreateWebhookActionFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateWebhookAction.
 	CreateWebhookActionFunc *CodeMonitorStoreCreateWebhookActionFunc
@@ -83,6 +93,10 @@ type MockCodeMonitorStore struct {
 	// object controlling the behavior of the method
 	// DeleteSlackWebhookActions.
 	DeleteSlackWebhookActionsFunc *CodeMonitorStoreDeleteSlackWebhookActionsFunc
+	// DeleteTeamsWebhookActionsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// DeleteTeamsWebhookActions.
+	DeleteTeamsWebhookActionsFunc *CodeMonitorStoreDeleteTeamsWebhookActionsFunc
 	// DeleteWebhookActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method DeleteWebhookActions.
 	DeleteWebhookActionsFunc *CodeMonitorStoreDeleteWebhookActionsFunc
@@ -124,6 +138,9 @@ type MockCodeMonitorStore struct {
 	// GetSlackWebhookActionFunc is an instance of a mock function object
 	// controlling the behavior of the method GetSlackWebhookAction.
 	GetSlackWebhookActionFunc *CodeMonitorStoreGetSlackWebhookActionFunc
+	// GetTeamsWebhookActionFunc is an instance of a mock function object
+	// controlling the behavior of the method GetTeamsWebhookAction.
+	GetTeamsWebhookActionFunc *CodeMonitorStoreGetTeamsWebhookActionFunc
 	// GetWebhookActionFunc is an instance of a mock function object
 	// controlling the behavior of the method GetWebhookAction.
 	GetWebhookActionFunc *CodeMonitorStoreGetWebhookActionFunc
@@ -133,12 +150,20 @@ type MockCodeMonitorStore struct {
 	// HasAnyLastSearchedFunc is an instance of a mock function object
 	// controlling the behavior of the method HasAnyLastSearched.
 	HasAnyLastSearchedFunc *CodeMonitorStoreHasAnyLastSearchedFunc
+	// ListActionDeliveryAttemptsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// ListActionDeliveryAttempts.
+	ListActionDeliveryAttemptsFunc *CodeMonitorStoreListActionDeliveryAttemptsFunc
 	// ListActionJobsFunc is an instance of a mock function object
 	// controlling the behavior of the method ListActionJobs.
 	ListActionJobsFunc *CodeMonitorStoreListActionJobsFunc
 	// ListEmailActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method ListEmailActions.
 	ListEmailActionsFunc *CodeMonitorStoreListEmailActionsFunc
+	// ListLastSearchedContentMatchesFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// ListLastSearchedContentMatches.
+	ListLastSearchedContentMatchesFunc *CodeMonitorStoreListLastSearchedContentMatchesFunc
 	// ListMonitorsFunc is an instance of a mock function object controlling
 	// the behavior of the method ListMonitors.
 	ListMonitorsFunc *CodeMonitorStoreListMonitorsFunc
@@ -151,6 +176,9 @@ type MockCodeMonitorStore struct {
 	// ListSlackWebhookActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method ListSlackWebhookActions.
 	ListSlackWebhookActionsFunc *CodeMonitorStoreListSlackWebhookActionsFunc
+	// ListTeamsWebhookActionsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListTeamsWebhookActions.
+	ListTeamsWebhookActionsFunc *CodeMonitorStoreListTeamsWebhookActionsFunc
 	// ListWebhookActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method ListWebhookActions.
 	ListWebhookActionsFunc *CodeMonitorStoreListWebhookActionsFunc
@@ -182,6 +210,9 @@ type MockCodeMonitorStore struct {
 	// UpdateSlackWebhookActionFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateSlackWebhookAction.
 	UpdateSlackWebhookActionFunc *CodeMonitorStoreUpdateSlackWebhookActionFunc
+	// UpdateTeamsWebhookActionFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdateTeamsWebhookAction.
+	UpdateTeamsWebhookActionFunc *CodeMonitorStoreUpdateTeamsWebhookActionFunc
 	// UpdateTriggerJobWithResultsFunc is an instance of a mock function
 	// object controlling the behavior of the method
 	// UpdateTriggerJobWithResults.
@@ -192,6 +223,10 @@ type MockCodeMonitorStore struct {
 	// UpsertLastSearchedFunc is an instance of a mock function object
 	// controlling the behavior of the method UpsertLastSearched.
 	UpsertLastSearchedFunc *CodeMonitorStoreUpsertLastSearchedFunc
+	// UpsertLastSearchedContentMatchesFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// UpsertLastSearchedContentMatches.
+	UpsertLastSearchedContentMatchesFunc *CodeMonitorStoreUpsertLastSearchedContentMatchesFunc
 }
 
 // NewMockCodeMonitorStore creates a new mock of the CodeMonitorStore
@@ -229,11 +264,21 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		CountTeamsWebhookActionsFunc: &CodeMonitorStoreCountTeamsWebhookActionsFunc{
+			defaultHook: func(context.Context, int64) (r0 int, r1 error) {
+				return
+			},
+		},
 		CountWebhookActionsFunc: &CodeMonitorStoreCountWebhookActionsFunc{
 			defaultHook: func(context.Context, int64) (r0 int, r1 error) {
 				return
 			},
 		},
+		CreateActionDeliveryAttemptFunc: &CodeMonitorStoreCreateActionDeliveryAttemptFunc{
+			defaultHook: func(context.Context, ActionDeliveryAttemptArgs) (r0 *ActionDeliveryAttempt, r1 error) {
+				return
+			},
+		},
 		CreateEmailActionFunc: &CodeMonitorStoreCreateEmailActionFunc{
 			defaultHook: func(context.Context, int64, *EmailActionArgs) (r0 *EmailAction, r1 error) {
 				return
@@ -259,8 +304,13 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		CreateTeamsWebhookActionFunc: &CodeMonitorStoreCreateTeamsWebhookActionFunc{
+			defaultHook: func(context.Context, int64, bool, bool, string) (r0 *TeamsWebhookAction, r1 error) {
+				return
+			},
+		},
 		CreateWebhookActionFunc: &CodeMonitorStoreCreateWebhookActionFunc{
-			defaultHook: func(context.Context, int64, bool, bool, string) (r0 *WebhookAction, r1 error) {
+			defaultHook: func(context.Context, int64, *WebhookActionArgs) (r0 *WebhookAction, r1 error) {
 				return
 			},
 		},
@@ -289,6 +339,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		DeleteTeamsWebhookActionsFunc: &CodeMonitorStoreDeleteTeamsWebhookActionsFunc{
+			defaultHook: func(context.Context, int64, ...int64) (r0 error) {
+				return
+			},
+		},
 		DeleteWebhookActionsFunc: &CodeMonitorStoreDeleteWebhookActionsFunc{
 			defaultHook: func(context.Context, int64, ...int64) (r0 error) {
 				return
@@ -354,6 +409,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		GetTeamsWebhookActionFunc: &CodeMonitorStoreGetTeamsWebhookActionFunc{
+			defaultHook: func(context.Context, int64) (r0 *TeamsWebhookAction, r1 error) {
+				return
+			},
+		},
 		GetWebhookActionFunc: &CodeMonitorStoreGetWebhookActionFunc{
 			defaultHook: func(context.Context, int64) (r0 *WebhookAction, r1 error) {
 				return
@@ -369,6 +429,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		ListActionDeliveryAttemptsFunc: &CodeMonitorStoreListActionDeliveryAttemptsFunc{
+			defaultHook: func(context.Context, int32) (r0 []*ActionDeliveryAttempt, r1 error) {
+				return
+			},
+		},
 		ListActionJobsFunc: &CodeMonitorStoreListActionJobsFunc{
 			defaultHook: func(context.Context, ListActionJobsOpts) (r0 []*ActionJob, r1 error) {
 				return
@@ -379,6 +444,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		ListLastSearchedContentMatchesFunc: &CodeMonitorStoreListLastSearchedContentMatchesFunc{
+			defaultHook: func(context.Context, int64) (r0 map[api.RepoID][]string, r1 error) {
+				return
+			},
+		},
 		ListMonitorsFunc: &CodeMonitorStoreListMonitorsFunc{
 			defaultHook: func(context.Context, ListMonitorsOpts) (r0 []*Monitor, r1 error) {
 				return
@@ -399,6 +469,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		ListTeamsWebhookActionsFunc: &CodeMonitorStoreListTeamsWebhookActionsFunc{
+			defaultHook: func(context.Context, ListActionsOpts) (r0 []*TeamsWebhookAction, r1 error) {
+				return
+			},
+		},
 		ListWebhookActionsFunc: &CodeMonitorStoreListWebhookActionsFunc{
 			defaultHook: func(context.Context, ListActionsOpts) (r0 []*WebhookAction, r1 error) {
 				return
@@ -449,13 +524,18 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		UpdateTeamsWebhookActionFunc: &CodeMonitorStoreUpdateTeamsWebhookActionFunc{
+			defaultHook: func(context.Context, int64, bool, bool, string) (r0 *TeamsWebhookAction, r1 error) {
+				return
+			},
+		},
 		UpdateTriggerJobWithResultsFunc: &CodeMonitorStoreUpdateTriggerJobWithResultsFunc{
 			defaultHook: func(context.Context, int32, string, []*result.CommitMatch) (r0 error) {
 				return
 			},
 		},
 		UpdateWebhookActionFunc: &CodeMonitorStoreUpdateWebhookActionFunc{
-			defaultHook: func(context.Context, int64, bool, bool, string) (r0 *WebhookAction, r1 error) {
+			defaultHook: func(context.Context, int64, *WebhookActionArgs) (r0 *WebhookAction, r1 error) {
 				return
 			},
 		},
@@ -464,6 +544,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		UpsertLastSearchedContentMatchesFunc: &CodeMonitorStoreUpsertLastSearchedContentMatchesFunc{
+			defaultHook: func(context.Context, int64, api.RepoID, []string, []string) (r0 error) {
+				return
+			},
+		},
 	}
 }
 
@@ -501,11 +586,21 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.CountSlackWebhookActions")
 			},
 		},
+		CountTeamsWebhookActionsFunc: &CodeMonitorStoreCountTeamsWebhookActionsFunc{
+			defaultHook: func(context.Context, int64) (int, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.CountTeamsWebhookActions")
+			},
+		},
 		CountWebhookActionsFunc: &CodeMonitorStoreCountWebhookActionsFunc{
 			defaultHook: func(context.Context, int64) (int, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.CountWebhookActions")
 			},
 		},
+		CreateActionDeliveryAttemptFunc: &CodeMonitorStoreCreateActionDeliveryAttemptFunc{
+			defaultHook: func(context.Context, ActionDeliveryAttemptArgs) (*ActionDeliveryAttempt, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.CreateActionDeliveryAttempt")
+			},
+		},
 		CreateEmailActionFunc: &CodeMonitorStoreCreateEmailActionFunc{
 			defaultHook: func(context.Context, int64, *EmailActionArgs) (*EmailAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.CreateEmailAction")
@@ -531,8 +626,13 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.CreateSlackWebhookAction")
 			},
 		},
+		CreateTeamsWebhookActionFunc: &CodeMonitorStoreCreateTeamsWebhookActionFunc{
+			defaultHook: func(context.Context, int64, bool, bool, string) (*TeamsWebhookAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.CreateTeamsWebhookAction")
+			},
+		},
 		CreateWebhookActionFunc: &CodeMonitorStoreCreateWebhookActionFunc{
-			defaultHook: func(context.Context, int64, bool, bool, string) (*WebhookAction, error) {
+			defaultHook: func(context.Context, int64, *WebhookActionArgs) (*WebhookAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.CreateWebhookAction")
 			},
 		},
@@ -561,6 +661,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.DeleteSlackWebhookActions")
 			},
 		},
+		DeleteTeamsWebhookActionsFunc: &CodeMonitorStoreDeleteTeamsWebhookActionsFunc{
+			defaultHook: func(context.Context, int64, ...int64) error {
+				panic("unexpected invocation of MockCodeMonitorStore.DeleteTeamsWebhookActions")
+			},
+		},
 		DeleteWebhookActionsFunc: &CodeMonitorStoreDeleteWebhookActionsFunc{
 			defaultHook: func(context.Context, int64, ...int64) error {
 				panic("unexpected invocation of MockCodeMonitorStore.DeleteWebhookActions")
@@ -626,6 +731,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.GetSlackWebhookAction")
 			},
 		},
+		GetTeamsWebhookActionFunc: &CodeMonitorStoreGetTeamsWebhookActionFunc{
+			defaultHook: func(context.Context, int64) (*TeamsWebhookAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.GetTeamsWebhookAction")
+			},
+		},
 		GetWebhookActionFunc: &CodeMonitorStoreGetWebhookActionFunc{
 			defaultHook: func(context.Context, int64) (*WebhookAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.GetWebhookAction")
@@ -641,6 +751,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.HasAnyLastSearched")
 			},
 		},
+		ListActionDeliveryAttemptsFunc: &CodeMonitorStoreListActionDeliveryAttemptsFunc{
+			defaultHook: func(context.Context, int32) ([]*ActionDeliveryAttempt, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.ListActionDeliveryAttempts")
+			},
+		},
 		ListActionJobsFunc: &CodeMonitorStoreListActionJobsFunc{
 			defaultHook: func(context.Context, ListActionJobsOpts) ([]*ActionJob, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.ListActionJobs")
@@ -651,6 +766,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.ListEmailActions")
 			},
 		},
+		ListLastSearchedContentMatchesFunc: &CodeMonitorStoreListLastSearchedContentMatchesFunc{
+			defaultHook: func(context.Context, int64) (map[api.RepoID][]string, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.ListLastSearchedContentMatches")
+			},
+		},
 		ListMonitorsFunc: &CodeMonitorStoreListMonitorsFunc{
 			defaultHook: func(context.Context, ListMonitorsOpts) ([]*Monitor, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.ListMonitors")
@@ -671,6 +791,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.ListSlackWebhookActions")
 			},
 		},
+		ListTeamsWebhookActionsFunc: &CodeMonitorStoreListTeamsWebhookActionsFunc{
+			defaultHook: func(context.Context, ListActionsOpts) ([]*TeamsWebhookAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.ListTeamsWebhookActions")
+			},
+		},
 		ListWebhookActionsFunc: &CodeMonitorStoreListWebhookActionsFunc{
 			defaultHook: func(context.Context, ListActionsOpts) ([]*WebhookAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.ListWebhookActions")
@@ -721,13 +846,18 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateSlackWebhookAction")
 			},
 		},
+		UpdateTeamsWebhookActionFunc: &CodeMonitorStoreUpdateTeamsWebhookActionFunc{
+			defaultHook: func(context.Context, int64, bool, bool, string) (*TeamsWebhookAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.UpdateTeamsWebhookAction")
+			},
+		},
 		UpdateTriggerJobWithResultsFunc: &CodeMonitorStoreUpdateTriggerJobWithResultsFunc{
 			defaultHook: func(context.Context, int32, string, []*result.CommitMatch) error {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateTriggerJobWithResults")
 			},
 		},
 		UpdateWebhookActionFunc: &CodeMonitorStoreUpdateWebhookActionFunc{
-			defaultHook: func(context.Context, int64, bool, bool, string) (*WebhookAction, error) {
+			defaultHook: func(context.Context, int64, *WebhookActionArgs) (*WebhookAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateWebhookAction")
 			},
 		},
@@ -736,6 +866,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.UpsertLastSearched")
 			},
 		},
+		UpsertLastSearchedContentMatchesFunc: &CodeMonitorStoreUpsertLastSearchedContentMatchesFunc{
+			defaultHook: func(context.Context, int64, api.RepoID, []string, []string) error {
+				panic("unexpected invocation of MockCodeMonitorStore.UpsertLastSearchedContentMatches")
+			},
+		},
 	}
 }
 
@@ -762,9 +897,15 @@ func NewMockCodeMonitorStoreFrom(i CodeMonitorStore) *MockCodeMonitorStore {
 		CountSlackWebhookActionsFunc: &CodeMonitorStoreCountSlackWebhookActionsFunc{
 			defaultHook: i.CountSlackWebhookActions,
 		},
+		CountTeamsWebhookActionsFunc: &CodeMonitorStoreCountTeamsWebhookActionsFunc{
+			defaultHook: i.CountTeamsWebhookActions,
+		},
 		CountWebhookActionsFunc: &CodeMonitorStoreCountWebhookActionsFunc{
 			defaultHook: i.CountWebhookActions,
 		},
+		CreateActionDeliveryAttemptFunc: &CodeMonitorStoreCreateActionDeliveryAttemptFunc{
+			defaultHook: i.CreateActionDeliveryAttempt,
+		},
 		CreateEmailActionFunc: &CodeMonitorStoreCreateEmailActionFunc{
 			defaultHook: i.CreateEmailAction,
 		},
@@ -780,6 +921,9 @@ func NewMockCodeMonitorStoreFrom(i CodeMonitorStore) *MockCodeMonitorStore {
 		CreateSlackWebhookActionFunc: &CodeMonitorStoreCreateSlackWebhookActionFunc{
 			defaultHook: i.CreateSlackWebhookAction,
 		},
+		CreateTeamsWebhookActionFunc: &CodeMonitorStoreCreateTeamsWebhookActionFunc{
+			defaultHook: i.CreateTeamsWebhookAction,
+		},
 		CreateWebhookActionFunc: &CodeMonitorStoreCreateWebhookActionFunc{
 			defaultHook: i.CreateWebhookAction,
 		},
@@ -798,6 +942,9 @@ func NewMockCodeMonitorStoreFrom(i CodeMonitorStore) *MockCodeMonitorStore {
 		DeleteSlackWebhookActionsFunc: &CodeMonitorStoreDeleteSlackWebhookActionsFunc{
 			defaultHook: i.DeleteSlackWebhookActions,
 		},
+		DeleteTeamsWebhookActionsFunc: &CodeMonitorStoreDeleteTeamsWebhookActionsFunc{
+			defaultHook: i.DeleteTeamsWebhookActions,
+		},
 		DeleteWebhookActionsFunc: &CodeMonitorStoreDeleteWebhookActionsFunc{
 			defaultHook: i.DeleteWebhookActions,
 		},
@@ -837,6 +984,9 @@ func NewMockCodeMonitorStoreFrom(i CodeMonitorStore) *MockCodeMonitorStore {
 		GetSlackWebhookActionFunc: &CodeMonitorStoreGetSlackWebhookActionFunc{
 			defaultHook: i.GetSlackWebhookAction,
 		},
+		GetTeamsWebhookActionFunc: &CodeMonitorStoreGetTeamsWebhookActionFunc{
+			defaultHook: i.GetTeamsWebhookAction,
+		},
 		GetWebhookActionFunc: &CodeMonitorStoreGetWebhookActionFunc{
 			defaultHook: i.GetWebhookAction,
 		},
@@ -846,12 +996,18 @@ func NewMockCodeMonitorStoreFrom(i CodeMonitorStore) *MockCodeMonitorStore {
 		HasAnyLastSearchedFunc: &CodeMonitorStoreHasAnyLastSearchedFunc{
 			defaultHook: i.HasAnyLastSearched,
 		},
+		ListActionDeliveryAttemptsFunc: &CodeMonitorStoreListActionDeliveryAttemptsFunc{
+			defaultHook: i.ListActionDeliveryAttempts,
+		},
 		ListActionJobsFunc: &CodeMonitorStoreListActionJobsFunc{
 			defaultHook: i.ListActionJobs,
 		},
 		ListEmailActionsFunc: &CodeMonitorStoreListEmailActionsFunc{
 			defaultHook: i.ListEmailActions,
 		},
+		ListLastSearchedContentMatchesFunc: &CodeMonitorStoreListLastSearchedContentMatchesFunc{
+			defaultHook: i.ListLastSearchedContentMatches,
+		},
 		ListMonitorsFunc: &CodeMonitorStoreListMonitorsFunc{
 			defaultHook: i.ListMonitors,
 		},
@@ -864,6 +1020,9 @@ func NewMockCodeMonitorStoreFrom(i CodeMonitorStore) *MockCodeMonitorStore {
 		ListSlackWebhookActionsFunc: &CodeMonitorStoreListSlackWebhookActionsFunc{
 			defaultHook: i.ListSlackWebhookActions,
 		},
+		ListTeamsWebhookActionsFunc: &CodeMonitorStoreListTeamsWebhookActionsFunc{
+			defaultHook: i.ListTeamsWebhookActions,
+		},
 		ListWebhookActionsFunc: &CodeMonitorStoreListWebhookActionsFunc{
 			defaultHook: i.ListWebhookActions,
 		},
@@ -894,6 +1053,9 @@ func NewMockCodeMonitorStoreFrom(i CodeMonitorStore) *MockCodeMonitorStore {
 		UpdateSlackWebhookActionFunc: &CodeMonitorStoreUpdateSlackWebhookActionFunc{
 			defaultHook: i.UpdateSlackWebhookAction,
 		},
+		UpdateTeamsWebhookActionFunc: &CodeMonitorStoreUpdateTeamsWebhookActionFunc{
+			defaultHook: i.UpdateTeamsWebhookAction,
+		},
 		UpdateTriggerJobWithResultsFunc: &CodeMonitorStoreUpdateTriggerJobWithResultsFunc{
 			defaultHook: i.UpdateTriggerJobWithResults,
 		},
@@ -903,6 +1065,9 @@ func NewMockCodeMonitorStoreFrom(i CodeMonitorStore) *MockCodeMonitorStore {
 		UpsertLastSearchedFunc: &CodeMonitorStoreUpsertLastSearchedFunc{
 			defaultHook: i.UpsertLastSearched,
 		},
+		UpsertLastSearchedContentMatchesFunc: &CodeMonitorStoreUpsertLastSearchedContentMatchesFunc{
+			defaultHook: i.UpsertLastSearchedContentMatches,
+		},
 	}
 }
 
@@ -1561,6 +1726,118 @@ func (c CodeMonitorStoreCountSlackWebhookActionsFuncCall) Results() []interface{
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreCountTeamsWebhookActionsFunc describes the behavior when
+// the CountTeamsWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreCountTeamsWebhookActionsFunc struct {
+	defaultHook func(context.Context, int64) (int, error)
+	hooks       []func(context.Context, int64) (int, error)
+	history     []CodeMonitorStoreCountTeamsWebhookActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// CountTeamsWebhookActions delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) CountTeamsWebhookActions(v0 context.Context, v1 int64) (int, error) {
+	r0, r1 := m.CountTeamsWebhookActionsFunc.nextHook()(v0, v1)
+	m.CountTeamsWebhookActionsFunc.appendCall(CodeMonitorStoreCountTeamsWebhookActionsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// CountTeamsWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreCountTeamsWebhookActionsFunc) SetDefaultHook(hook func(context.Context, int64) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CountTeamsWebhookActions method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreCountTeamsWebhookActionsFunc) PushHook(hook func(context.Context, int64) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreCountTeamsWebhookActionsFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreCountTeamsWebhookActionsFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, int64) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreCountTeamsWebhookActionsFunc) nextHook() func(context.Context, int64) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreCountTeamsWebhookActionsFunc) appendCall(r0 CodeMonitorStoreCountTeamsWebhookActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreCountTeamsWebhookActionsFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreCountTeamsWebhookActionsFunc) History() []CodeMonitorStoreCountTeamsWebhookActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreCountTeamsWebhookActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreCountTeamsWebhookActionsFuncCall is an object that
+// describes an invocation of method CountTeamsWebhookActions on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreCountTeamsWebhookActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreCountTeamsWebhookActionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreCountTeamsWebhookActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreCountWebhookActionsFunc describes the behavior when the
 // CountWebhookActions method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -1672,6 +1949,118 @@ func (c CodeMonitorStoreCountWebhookActionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreCreateActionDeliveryAttemptFunc describes the behavior
+// when the CreateActionDeliveryAttempt method of the parent
+// MockCodeMonitorStore instance is invoked.
+type CodeMonitorStoreCreateActionDeliveryAttemptFunc struct {
+	defaultHook func(context.Context, ActionDeliveryAttemptArgs) (*ActionDeliveryAttempt, error)
+	hooks       []func(context.Context, ActionDeliveryAttemptArgs) (*ActionDeliveryAttempt, error)
+	history     []CodeMonitorStoreCreateActionDeliveryAttemptFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateActionDeliveryAttempt delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) CreateActionDeliveryAttempt(v0 context.Context, v1 ActionDeliveryAttemptArgs) (*ActionDeliveryAttempt, error) {
+	r0, r1 := m.CreateActionDeliveryAttemptFunc.nextHook()(v0, v1)
+	m.CreateActionDeliveryAttemptFunc.appendCall(CodeMonitorStoreCreateActionDeliveryAttemptFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// CreateActionDeliveryAttempt method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreCreateActionDeliveryAttemptFunc) SetDefaultHook(hook func(context.Context, ActionDeliveryAttemptArgs) (*ActionDeliveryAttempt, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateActionDeliveryAttempt method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreCreateActionDeliveryAttemptFunc) PushHook(hook func(context.Context, ActionDeliveryAttemptArgs) (*ActionDeliveryAttempt, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreCreateActionDeliveryAttemptFunc) SetDefaultReturn(r0 *ActionDeliveryAttempt, r1 error) {
+	f.SetDefaultHook(func(context.Context, ActionDeliveryAttemptArgs) (*ActionDeliveryAttempt, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreCreateActionDeliveryAttemptFunc) PushReturn(r0 *ActionDeliveryAttempt, r1 error) {
+	f.PushHook(func(context.Context, ActionDeliveryAttemptArgs) (*ActionDeliveryAttempt, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreCreateActionDeliveryAttemptFunc) nextHook() func(context.Context, ActionDeliveryAttemptArgs) (*ActionDeliveryAttempt, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreCreateActionDeliveryAttemptFunc) appendCall(r0 CodeMonitorStoreCreateActionDeliveryAttemptFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreCreateActionDeliveryAttemptFuncCall objects describing
+// the invocations of this function.
+func (f *CodeMonitorStoreCreateActionDeliveryAttemptFunc) History() []CodeMonitorStoreCreateActionDeliveryAttemptFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreCreateActionDeliveryAttemptFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreCreateActionDeliveryAttemptFuncCall is an object that
+// describes an invocation of method CreateActionDeliveryAttempt on an
+// instance of MockCodeMonitorStore.
+type CodeMonitorStoreCreateActionDeliveryAttemptFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 ActionDeliveryAttemptArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *ActionDeliveryAttempt
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreCreateActionDeliveryAttemptFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreCreateActionDeliveryAttemptFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreCreateEmailActionFunc describes the behavior when the
 // CreateEmailAction method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -2248,37 +2637,158 @@ func (c CodeMonitorStoreCreateSlackWebhookActionFuncCall) Results() []interface{
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// CodeMonitorStoreCreateWebhookActionFunc describes the behavior when the
-// CreateWebhookAction method of the parent MockCodeMonitorStore instance is
-// invoked.
-type CodeMonitorStoreCreateWebhookActionFunc struct {
-	defaultHook func(context.Context, int64, bool, bool, string) (*WebhookAction, error)
-	hooks       []func(context.Context, int64, bool, bool, string) (*WebhookAction, error)
-	history     []CodeMonitorStoreCreateWebhookActionFuncCall
+// CodeMonitorStoreCreateTeamsWebhookActionFunc describes the behavior when
+// the CreateTeamsWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreCreateTeamsWebhookActionFunc struct {
+	defaultHook func(context.Context, int64, bool, bool, string) (*TeamsWebhookAction, error)
+	hooks       []func(context.Context, int64, bool, bool, string) (*TeamsWebhookAction, error)
+	history     []CodeMonitorStoreCreateTeamsWebhookActionFuncCall
 	mutex       sync.Mutex
 }
 
-// CreateWebhookAction delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) CreateWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string) (*WebhookAction, error) {
-	r0, r1 := m.CreateWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.CreateWebhookActionFunc.appendCall(CodeMonitorStoreCreateWebhookActionFuncCall{v0, v1, v2, v3, v4, r0, r1})
+// CreateTeamsWebhookAction delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) CreateTeamsWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string) (*TeamsWebhookAction, error) {
+	r0, r1 := m.CreateTeamsWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.CreateTeamsWebhookActionFunc.appendCall(CodeMonitorStoreCreateTeamsWebhookActionFuncCall{v0, v1, v2, v3, v4, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the CreateWebhookAction
-// method of the parent MockCodeMonitorStore instance is invoked and the
-// hook queue is empty.
-func (f *CodeMonitorStoreCreateWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string) (*WebhookAction, error)) {
+// SetDefaultHook sets function that is called when the
+// CreateTeamsWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreCreateTeamsWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string) (*TeamsWebhookAction, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CreateWebhookAction method of the parent MockCodeMonitorStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
+// CreateTeamsWebhookAction method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreCreateTeamsWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string) (*TeamsWebhookAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreCreateTeamsWebhookActionFunc) SetDefaultReturn(r0 *TeamsWebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, bool, bool, string) (*TeamsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreCreateTeamsWebhookActionFunc) PushReturn(r0 *TeamsWebhookAction, r1 error) {
+	f.PushHook(func(context.Context, int64, bool, bool, string) (*TeamsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreCreateTeamsWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string) (*TeamsWebhookAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreCreateTeamsWebhookActionFunc) appendCall(r0 CodeMonitorStoreCreateTeamsWebhookActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreCreateTeamsWebhookActionFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreCreateTeamsWebhookActionFunc) History() []CodeMonitorStoreCreateTeamsWebhookActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreCreateTeamsWebhookActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreCreateTeamsWebhookActionFuncCall is an object that
+// describes an invocation of method CreateTeamsWebhookAction on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreCreateTeamsWebhookActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 bool
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 bool
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *TeamsWebhookAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreCreateTeamsWebhookActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreCreateTeamsWebhookActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeMonitorStoreCreateWebhookActionFunc describes the behavior when the
+// CreateWebhookAction method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreCreateWebhookActionFunc struct {
+	defaultHook func(context.Context, int64, *WebhookActionArgs) (*WebhookAction, error)
+	hooks       []func(context.Context, int64, *WebhookActionArgs) (*WebhookAction, error)
+	history     []CodeMonitorStoreCreateWebhookActionFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateWebhookAction delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) CreateWebhookAction(v0 context.Context, v1 int64, v2 *WebhookActionArgs) (*WebhookAction, error) {
+	r0, r1 := m.CreateWebhookActionFunc.nextHook()(v0, v1, v2)
+	m.CreateWebhookActionFunc.appendCall(CodeMonitorStoreCreateWebhookActionFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CreateWebhookAction
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreCreateWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, *WebhookActionArgs) (*WebhookAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateWebhookAction method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
 // action.
-func (f *CodeMonitorStoreCreateWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string) (*WebhookAction, error)) {
+func (f *CodeMonitorStoreCreateWebhookActionFunc) PushHook(hook func(context.Context, int64, *WebhookActionArgs) (*WebhookAction, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2287,19 +2797,19 @@ func (f *CodeMonitorStoreCreateWebhookActionFunc) PushHook(hook func(context.Con
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
 func (f *CodeMonitorStoreCreateWebhookActionFunc) SetDefaultReturn(r0 *WebhookAction, r1 error) {
-	f.SetDefaultHook(func(context.Context, int64, bool, bool, string) (*WebhookAction, error) {
+	f.SetDefaultHook(func(context.Context, int64, *WebhookActionArgs) (*WebhookAction, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
 func (f *CodeMonitorStoreCreateWebhookActionFunc) PushReturn(r0 *WebhookAction, r1 error) {
-	f.PushHook(func(context.Context, int64, bool, bool, string) (*WebhookAction, error) {
+	f.PushHook(func(context.Context, int64, *WebhookActionArgs) (*WebhookAction, error) {
 		return r0, r1
 	})
 }
 
-func (f *CodeMonitorStoreCreateWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string) (*WebhookAction, error) {
+func (f *CodeMonitorStoreCreateWebhookActionFunc) nextHook() func(context.Context, int64, *WebhookActionArgs) (*WebhookAction, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2341,13 +2851,7 @@ type CodeMonitorStoreCreateWebhookActionFuncCall struct {
 	Arg1 int64
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 bool
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 bool
-	// Arg4 is the value of the 5th argument passed to this method
-	// invocation.
-	Arg4 string
+	Arg2 *WebhookActionArgs
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 *WebhookAction
@@ -2359,7 +2863,7 @@ type CodeMonitorStoreCreateWebhookActionFuncCall struct {
 // Args returns an interface slice containing the arguments of this
 // invocation.
 func (c CodeMonitorStoreCreateWebhookActionFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
@@ -2922,6 +3426,125 @@ func (c CodeMonitorStoreDeleteSlackWebhookActionsFuncCall) Results() []interface
 	return []interface{}{c.Result0}
 }
 
+// CodeMonitorStoreDeleteTeamsWebhookActionsFunc describes the behavior when
+// the DeleteTeamsWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreDeleteTeamsWebhookActionsFunc struct {
+	defaultHook func(context.Context, int64, ...int64) error
+	hooks       []func(context.Context, int64, ...int64) error
+	history     []CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteTeamsWebhookActions delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) DeleteTeamsWebhookActions(v0 context.Context, v1 int64, v2 ...int64) error {
+	r0 := m.DeleteTeamsWebhookActionsFunc.nextHook()(v0, v1, v2...)
+	m.DeleteTeamsWebhookActionsFunc.appendCall(CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// DeleteTeamsWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreDeleteTeamsWebhookActionsFunc) SetDefaultHook(hook func(context.Context, int64, ...int64) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteTeamsWebhookActions method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreDeleteTeamsWebhookActionsFunc) PushHook(hook func(context.Context, int64, ...int64) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreDeleteTeamsWebhookActionsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, ...int64) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreDeleteTeamsWebhookActionsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64, ...int64) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreDeleteTeamsWebhookActionsFunc) nextHook() func(context.Context, int64, ...int64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreDeleteTeamsWebhookActionsFunc) appendCall(r0 CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreDeleteTeamsWebhookActionsFunc) History() []CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall is an object that
+// describes an invocation of method DeleteTeamsWebhookActions on an
+// instance of MockCodeMonitorStore.
+type CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreDeleteTeamsWebhookActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // CodeMonitorStoreDeleteWebhookActionsFunc describes the behavior when the
 // DeleteWebhookActions method of the parent MockCodeMonitorStore instance
 // is invoked.
@@ -4361,37 +4984,37 @@ func (c CodeMonitorStoreGetSlackWebhookActionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// CodeMonitorStoreGetWebhookActionFunc describes the behavior when the
-// GetWebhookAction method of the parent MockCodeMonitorStore instance is
-// invoked.
-type CodeMonitorStoreGetWebhookActionFunc struct {
-	defaultHook func(context.Context, int64) (*WebhookAction, error)
-	hooks       []func(context.Context, int64) (*WebhookAction, error)
-	history     []CodeMonitorStoreGetWebhookActionFuncCall
+// CodeMonitorStoreGetTeamsWebhookActionFunc describes the behavior when the
+// GetTeamsWebhookAction method of the parent MockCodeMonitorStore instance
+// is invoked.
+type CodeMonitorStoreGetTeamsWebhookActionFunc struct {
+	defaultHook func(context.Context, int64) (*TeamsWebhookAction, error)
+	hooks       []func(context.Context, int64) (*TeamsWebhookAction, error)
+	history     []CodeMonitorStoreGetTeamsWebhookActionFuncCall
 	mutex       sync.Mutex
 }
 
-// GetWebhookAction delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) GetWebhookAction(v0 context.Context, v1 int64) (*WebhookAction, error) {
-	r0, r1 := m.GetWebhookActionFunc.nextHook()(v0, v1)
-	m.GetWebhookActionFunc.appendCall(CodeMonitorStoreGetWebhookActionFuncCall{v0, v1, r0, r1})
+// GetTeamsWebhookAction delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) GetTeamsWebhookAction(v0 context.Context, v1 int64) (*TeamsWebhookAction, error) {
+	r0, r1 := m.GetTeamsWebhookActionFunc.nextHook()(v0, v1)
+	m.GetTeamsWebhookActionFunc.appendCall(CodeMonitorStoreGetTeamsWebhookActionFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetWebhookAction
-// method of the parent MockCodeMonitorStore instance is invoked and the
-// hook queue is empty.
-func (f *CodeMonitorStoreGetWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64) (*WebhookAction, error)) {
+// SetDefaultHook sets function that is called when the
+// GetTeamsWebhookAction method of the parent MockCodeMonitorStore instance
+// is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreGetTeamsWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64) (*TeamsWebhookAction, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetWebhookAction method of the parent MockCodeMonitorStore instance
+// GetTeamsWebhookAction method of the parent MockCodeMonitorStore instance
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *CodeMonitorStoreGetWebhookActionFunc) PushHook(hook func(context.Context, int64) (*WebhookAction, error)) {
+func (f *CodeMonitorStoreGetTeamsWebhookActionFunc) PushHook(hook func(context.Context, int64) (*TeamsWebhookAction, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4399,20 +5022,20 @@ func (f *CodeMonitorStoreGetWebhookActionFunc) PushHook(hook func(context.Contex
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *CodeMonitorStoreGetWebhookActionFunc) SetDefaultReturn(r0 *WebhookAction, r1 error) {
-	f.SetDefaultHook(func(context.Context, int64) (*WebhookAction, error) {
+func (f *CodeMonitorStoreGetTeamsWebhookActionFunc) SetDefaultReturn(r0 *TeamsWebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (*TeamsWebhookAction, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *CodeMonitorStoreGetWebhookActionFunc) PushReturn(r0 *WebhookAction, r1 error) {
-	f.PushHook(func(context.Context, int64) (*WebhookAction, error) {
+func (f *CodeMonitorStoreGetTeamsWebhookActionFunc) PushReturn(r0 *TeamsWebhookAction, r1 error) {
+	f.PushHook(func(context.Context, int64) (*TeamsWebhookAction, error) {
 		return r0, r1
 	})
 }
 
-func (f *CodeMonitorStoreGetWebhookActionFunc) nextHook() func(context.Context, int64) (*WebhookAction, error) {
+func (f *CodeMonitorStoreGetTeamsWebhookActionFunc) nextHook() func(context.Context, int64) (*TeamsWebhookAction, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4425,27 +5048,28 @@ func (f *CodeMonitorStoreGetWebhookActionFunc) nextHook() func(context.Context,
 	return hook
 }
 
-func (f *CodeMonitorStoreGetWebhookActionFunc) appendCall(r0 CodeMonitorStoreGetWebhookActionFuncCall) {
+func (f *CodeMonitorStoreGetTeamsWebhookActionFunc) appendCall(r0 CodeMonitorStoreGetTeamsWebhookActionFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of CodeMonitorStoreGetWebhookActionFuncCall
-// objects describing the invocations of this function.
-func (f *CodeMonitorStoreGetWebhookActionFunc) History() []CodeMonitorStoreGetWebhookActionFuncCall {
+// History returns a sequence of
+// CodeMonitorStoreGetTeamsWebhookActionFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreGetTeamsWebhookActionFunc) History() []CodeMonitorStoreGetTeamsWebhookActionFuncCall {
 	f.mutex.Lock()
-	history := make([]CodeMonitorStoreGetWebhookActionFuncCall, len(f.history))
+	history := make([]CodeMonitorStoreGetTeamsWebhookActionFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// CodeMonitorStoreGetWebhookActionFuncCall is an object that describes an
-// invocation of method GetWebhookAction on an instance of
+// CodeMonitorStoreGetTeamsWebhookActionFuncCall is an object that describes
+// an invocation of method GetTeamsWebhookAction on an instance of
 // MockCodeMonitorStore.
-type CodeMonitorStoreGetWebhookActionFuncCall struct {
+type CodeMonitorStoreGetTeamsWebhookActionFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -4454,7 +5078,7 @@ type CodeMonitorStoreGetWebhookActionFuncCall struct {
 	Arg1 int64
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *WebhookAction
+	Result0 *TeamsWebhookAction
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -4462,27 +5086,138 @@ type CodeMonitorStoreGetWebhookActionFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c CodeMonitorStoreGetWebhookActionFuncCall) Args() []interface{} {
+func (c CodeMonitorStoreGetTeamsWebhookActionFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c CodeMonitorStoreGetWebhookActionFuncCall) Results() []interface{} {
+func (c CodeMonitorStoreGetTeamsWebhookActionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// CodeMonitorStoreHandleFunc describes the behavior when the Handle method
-// of the parent MockCodeMonitorStore instance is invoked.
-type CodeMonitorStoreHandleFunc struct {
-	defaultHook func() basestore.TransactableHandle
-	hooks       []func() basestore.TransactableHandle
-	history     []CodeMonitorStoreHandleFuncCall
-	mutex       sync.Mutex
-}
-
-// Handle delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
+// CodeMonitorStoreGetWebhookActionFunc describes the behavior when the
+// GetWebhookAction method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreGetWebhookActionFunc struct {
+	defaultHook func(context.Context, int64) (*WebhookAction, error)
+	hooks       []func(context.Context, int64) (*WebhookAction, error)
+	history     []CodeMonitorStoreGetWebhookActionFuncCall
+	mutex       sync.Mutex
+}
+
+// GetWebhookAction delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) GetWebhookAction(v0 context.Context, v1 int64) (*WebhookAction, error) {
+	r0, r1 := m.GetWebhookActionFunc.nextHook()(v0, v1)
+	m.GetWebhookActionFunc.appendCall(CodeMonitorStoreGetWebhookActionFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetWebhookAction
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreGetWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64) (*WebhookAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetWebhookAction method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreGetWebhookActionFunc) PushHook(hook func(context.Context, int64) (*WebhookAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreGetWebhookActionFunc) SetDefaultReturn(r0 *WebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (*WebhookAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreGetWebhookActionFunc) PushReturn(r0 *WebhookAction, r1 error) {
+	f.PushHook(func(context.Context, int64) (*WebhookAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreGetWebhookActionFunc) nextHook() func(context.Context, int64) (*WebhookAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreGetWebhookActionFunc) appendCall(r0 CodeMonitorStoreGetWebhookActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreGetWebhookActionFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreGetWebhookActionFunc) History() []CodeMonitorStoreGetWebhookActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreGetWebhookActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreGetWebhookActionFuncCall is an object that describes an
+// invocation of method GetWebhookAction on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreGetWebhookActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *WebhookAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreGetWebhookActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreGetWebhookActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeMonitorStoreHandleFunc describes the behavior when the Handle method
+// of the parent MockCodeMonitorStore instance is invoked.
+type CodeMonitorStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []CodeMonitorStoreHandleFuncCall
+	mutex       sync.Mutex
+}
+
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
 func (m *MockCodeMonitorStore) Handle() basestore.TransactableHandle {
 	r0 := m.HandleFunc.nextHook()()
 	m.HandleFunc.appendCall(CodeMonitorStoreHandleFuncCall{r0})
@@ -4682,6 +5417,118 @@ func (c CodeMonitorStoreHasAnyLastSearchedFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreListActionDeliveryAttemptsFunc describes the behavior
+// when the ListActionDeliveryAttempts method of the parent
+// MockCodeMonitorStore instance is invoked.
+type CodeMonitorStoreListActionDeliveryAttemptsFunc struct {
+	defaultHook func(context.Context, int32) ([]*ActionDeliveryAttempt, error)
+	hooks       []func(context.Context, int32) ([]*ActionDeliveryAttempt, error)
+	history     []CodeMonitorStoreListActionDeliveryAttemptsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListActionDeliveryAttempts delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) ListActionDeliveryAttempts(v0 context.Context, v1 int32) ([]*ActionDeliveryAttempt, error) {
+	r0, r1 := m.ListActionDeliveryAttemptsFunc.nextHook()(v0, v1)
+	m.ListActionDeliveryAttemptsFunc.appendCall(CodeMonitorStoreListActionDeliveryAttemptsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListActionDeliveryAttempts method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreListActionDeliveryAttemptsFunc) SetDefaultHook(hook func(context.Context, int32) ([]*ActionDeliveryAttempt, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListActionDeliveryAttempts method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreListActionDeliveryAttemptsFunc) PushHook(hook func(context.Context, int32) ([]*ActionDeliveryAttempt, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreListActionDeliveryAttemptsFunc) SetDefaultReturn(r0 []*ActionDeliveryAttempt, r1 error) {
+	f.SetDefaultHook(func(context.Context, int32) ([]*ActionDeliveryAttempt, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreListActionDeliveryAttemptsFunc) PushReturn(r0 []*ActionDeliveryAttempt, r1 error) {
+	f.PushHook(func(context.Context, int32) ([]*ActionDeliveryAttempt, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreListActionDeliveryAttemptsFunc) nextHook() func(context.Context, int32) ([]*ActionDeliveryAttempt, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreListActionDeliveryAttemptsFunc) appendCall(r0 CodeMonitorStoreListActionDeliveryAttemptsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreListActionDeliveryAttemptsFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreListActionDeliveryAttemptsFunc) History() []CodeMonitorStoreListActionDeliveryAttemptsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreListActionDeliveryAttemptsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreListActionDeliveryAttemptsFuncCall is an object that
+// describes an invocation of method ListActionDeliveryAttempts on an
+// instance of MockCodeMonitorStore.
+type CodeMonitorStoreListActionDeliveryAttemptsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int32
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*ActionDeliveryAttempt
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreListActionDeliveryAttemptsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreListActionDeliveryAttemptsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreListActionJobsFunc describes the behavior when the
 // ListActionJobs method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -4903,6 +5750,118 @@ func (c CodeMonitorStoreListEmailActionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreListLastSearchedContentMatchesFunc describes the behavior
+// when the ListLastSearchedContentMatches method of the parent
+// MockCodeMonitorStore instance is invoked.
+type CodeMonitorStoreListLastSearchedContentMatchesFunc struct {
+	defaultHook func(context.Context, int64) (map[api.RepoID][]string, error)
+	hooks       []func(context.Context, int64) (map[api.RepoID][]string, error)
+	history     []CodeMonitorStoreListLastSearchedContentMatchesFuncCall
+	mutex       sync.Mutex
+}
+
+// ListLastSearchedContentMatches delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) ListLastSearchedContentMatches(v0 context.Context, v1 int64) (map[api.RepoID][]string, error) {
+	r0, r1 := m.ListLastSearchedContentMatchesFunc.nextHook()(v0, v1)
+	m.ListLastSearchedContentMatchesFunc.appendCall(CodeMonitorStoreListLastSearchedContentMatchesFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListLastSearchedContentMatches method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreListLastSearchedContentMatchesFunc) SetDefaultHook(hook func(context.Context, int64) (map[api.RepoID][]string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListLastSearchedContentMatches method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreListLastSearchedContentMatchesFunc) PushHook(hook func(context.Context, int64) (map[api.RepoID][]string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreListLastSearchedContentMatchesFunc) SetDefaultReturn(r0 map[api.RepoID][]string, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (map[api.RepoID][]string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreListLastSearchedContentMatchesFunc) PushReturn(r0 map[api.RepoID][]string, r1 error) {
+	f.PushHook(func(context.Context, int64) (map[api.RepoID][]string, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreListLastSearchedContentMatchesFunc) nextHook() func(context.Context, int64) (map[api.RepoID][]string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreListLastSearchedContentMatchesFunc) appendCall(r0 CodeMonitorStoreListLastSearchedContentMatchesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreListLastSearchedContentMatchesFuncCall objects describing
+// the invocations of this function.
+func (f *CodeMonitorStoreListLastSearchedContentMatchesFunc) History() []CodeMonitorStoreListLastSearchedContentMatchesFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreListLastSearchedContentMatchesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreListLastSearchedContentMatchesFuncCall is an object that
+// describes an invocation of method ListLastSearchedContentMatches on an
+// instance of MockCodeMonitorStore.
+type CodeMonitorStoreListLastSearchedContentMatchesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 map[api.RepoID][]string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreListLastSearchedContentMatchesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreListLastSearchedContentMatchesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreListMonitorsFunc describes the behavior when the
 // ListMonitors method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -5174,7 +6133,118 @@ func (f *CodeMonitorStoreListRecipientsFunc) PushReturn(r0 []*Recipient, r1 erro
 	})
 }
 
-func (f *CodeMonitorStoreListRecipientsFunc) nextHook() func(context.Context, ListRecipientsOpts) ([]*Recipient, error) {
+func (f *CodeMonitorStoreListRecipientsFunc) nextHook() func(context.Context, ListRecipientsOpts) ([]*Recipient, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreListRecipientsFunc) appendCall(r0 CodeMonitorStoreListRecipientsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreListRecipientsFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreListRecipientsFunc) History() []CodeMonitorStoreListRecipientsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreListRecipientsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreListRecipientsFuncCall is an object that describes an
+// invocation of method ListRecipients on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreListRecipientsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 ListRecipientsOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*Recipient
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreListRecipientsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreListRecipientsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeMonitorStoreListSlackWebhookActionsFunc describes the behavior when
+// the ListSlackWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreListSlackWebhookActionsFunc struct {
+	defaultHook func(context.Context, ListActionsOpts) ([]*SlackWebhookAction, error)
+	hooks       []func(context.Context, ListActionsOpts) ([]*SlackWebhookAction, error)
+	history     []CodeMonitorStoreListSlackWebhookActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListSlackWebhookActions delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) ListSlackWebhookActions(v0 context.Context, v1 ListActionsOpts) ([]*SlackWebhookAction, error) {
+	r0, r1 := m.ListSlackWebhookActionsFunc.nextHook()(v0, v1)
+	m.ListSlackWebhookActionsFunc.appendCall(CodeMonitorStoreListSlackWebhookActionsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListSlackWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreListSlackWebhookActionsFunc) SetDefaultHook(hook func(context.Context, ListActionsOpts) ([]*SlackWebhookAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListSlackWebhookActions method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreListSlackWebhookActionsFunc) PushHook(hook func(context.Context, ListActionsOpts) ([]*SlackWebhookAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreListSlackWebhookActionsFunc) SetDefaultReturn(r0 []*SlackWebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, ListActionsOpts) ([]*SlackWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreListSlackWebhookActionsFunc) PushReturn(r0 []*SlackWebhookAction, r1 error) {
+	f.PushHook(func(context.Context, ListActionsOpts) ([]*SlackWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreListSlackWebhookActionsFunc) nextHook() func(context.Context, ListActionsOpts) ([]*SlackWebhookAction, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -5187,36 +6257,37 @@ func (f *CodeMonitorStoreListRecipientsFunc) nextHook() func(context.Context, Li
 	return hook
 }
 
-func (f *CodeMonitorStoreListRecipientsFunc) appendCall(r0 CodeMonitorStoreListRecipientsFuncCall) {
+func (f *CodeMonitorStoreListSlackWebhookActionsFunc) appendCall(r0 CodeMonitorStoreListSlackWebhookActionsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of CodeMonitorStoreListRecipientsFuncCall
-// objects describing the invocations of this function.
-func (f *CodeMonitorStoreListRecipientsFunc) History() []CodeMonitorStoreListRecipientsFuncCall {
+// History returns a sequence of
+// CodeMonitorStoreListSlackWebhookActionsFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreListSlackWebhookActionsFunc) History() []CodeMonitorStoreListSlackWebhookActionsFuncCall {
 	f.mutex.Lock()
-	history := make([]CodeMonitorStoreListRecipientsFuncCall, len(f.history))
+	history := make([]CodeMonitorStoreListSlackWebhookActionsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// CodeMonitorStoreListRecipientsFuncCall is an object that describes an
-// invocation of method ListRecipients on an instance of
-// MockCodeMonitorStore.
-type CodeMonitorStoreListRecipientsFuncCall struct {
+// CodeMonitorStoreListSlackWebhookActionsFuncCall is an object that
+// describes an invocation of method ListSlackWebhookActions on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreListSlackWebhookActionsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 ListRecipientsOpts
+	Arg1 ListActionsOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*Recipient
+	Result0 []*SlackWebhookAction
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -5224,47 +6295,47 @@ type CodeMonitorStoreListRecipientsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c CodeMonitorStoreListRecipientsFuncCall) Args() []interface{} {
+func (c CodeMonitorStoreListSlackWebhookActionsFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c CodeMonitorStoreListRecipientsFuncCall) Results() []interface{} {
+func (c CodeMonitorStoreListSlackWebhookActionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// CodeMonitorStoreListSlackWebhookActionsFunc describes the behavior when
-// the ListSlackWebhookActions method of the parent MockCodeMonitorStore
+// CodeMonitorStoreListTeamsWebhookActionsFunc describes the behavior when
+// the ListTeamsWebhookActions method of the parent MockCodeMonitorStore
 // instance is invoked.
-type CodeMonitorStoreListSlackWebhookActionsFunc struct {
-	defaultHook func(context.Context, ListActionsOpts) ([]*SlackWebhookAction, error)
-	hooks       []func(context.Context, ListActionsOpts) ([]*SlackWebhookAction, error)
-	history     []CodeMonitorStoreListSlackWebhookActionsFuncCall
+type CodeMonitorStoreListTeamsWebhookActionsFunc struct {
+	defaultHook func(context.Context, ListActionsOpts) ([]*TeamsWebhookAction, error)
+	hooks       []func(context.Context, ListActionsOpts) ([]*TeamsWebhookAction, error)
+	history     []CodeMonitorStoreListTeamsWebhookActionsFuncCall
 	mutex       sync.Mutex
 }
 
-// ListSlackWebhookActions delegates to the next hook function in the queue
+// ListTeamsWebhookActions delegates to the next hook function in the queue
 // and stores the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) ListSlackWebhookActions(v0 context.Context, v1 ListActionsOpts) ([]*SlackWebhookAction, error) {
-	r0, r1 := m.ListSlackWebhookActionsFunc.nextHook()(v0, v1)
-	m.ListSlackWebhookActionsFunc.appendCall(CodeMonitorStoreListSlackWebhookActionsFuncCall{v0, v1, r0, r1})
+func (m *MockCodeMonitorStore) ListTeamsWebhookActions(v0 context.Context, v1 ListActionsOpts) ([]*TeamsWebhookAction, error) {
+	r0, r1 := m.ListTeamsWebhookActionsFunc.nextHook()(v0, v1)
+	m.ListTeamsWebhookActionsFunc.appendCall(CodeMonitorStoreListTeamsWebhookActionsFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
-// ListSlackWebhookActions method of the parent MockCodeMonitorStore
+// ListTeamsWebhookActions method of the parent MockCodeMonitorStore
 // instance is invoked and the hook queue is empty.
-func (f *CodeMonitorStoreListSlackWebhookActionsFunc) SetDefaultHook(hook func(context.Context, ListActionsOpts) ([]*SlackWebhookAction, error)) {
+func (f *CodeMonitorStoreListTeamsWebhookActionsFunc) SetDefaultHook(hook func(context.Context, ListActionsOpts) ([]*TeamsWebhookAction, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListSlackWebhookActions method of the parent MockCodeMonitorStore
+// ListTeamsWebhookActions method of the parent MockCodeMonitorStore
 // instance invokes the hook at the front of the queue and discards it.
 // After the queue is empty, the default hook function is invoked for any
 // future action.
-func (f *CodeMonitorStoreListSlackWebhookActionsFunc) PushHook(hook func(context.Context, ListActionsOpts) ([]*SlackWebhookAction, error)) {
+func (f *CodeMonitorStoreListTeamsWebhookActionsFunc) PushHook(hook func(context.Context, ListActionsOpts) ([]*TeamsWebhookAction, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -5272,20 +6343,20 @@ func (f *CodeMonitorStoreListSlackWebhookActionsFunc) PushHook(hook func(context
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *CodeMonitorStoreListSlackWebhookActionsFunc) SetDefaultReturn(r0 []*SlackWebhookAction, r1 error) {
-	f.SetDefaultHook(func(context.Context, ListActionsOpts) ([]*SlackWebhookAction, error) {
+func (f *CodeMonitorStoreListTeamsWebhookActionsFunc) SetDefaultReturn(r0 []*TeamsWebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, ListActionsOpts) ([]*TeamsWebhookAction, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *CodeMonitorStoreListSlackWebhookActionsFunc) PushReturn(r0 []*SlackWebhookAction, r1 error) {
-	f.PushHook(func(context.Context, ListActionsOpts) ([]*SlackWebhookAction, error) {
+func (f *CodeMonitorStoreListTeamsWebhookActionsFunc) PushReturn(r0 []*TeamsWebhookAction, r1 error) {
+	f.PushHook(func(context.Context, ListActionsOpts) ([]*TeamsWebhookAction, error) {
 		return r0, r1
 	})
 }
 
-func (f *CodeMonitorStoreListSlackWebhookActionsFunc) nextHook() func(context.Context, ListActionsOpts) ([]*SlackWebhookAction, error) {
+func (f *CodeMonitorStoreListTeamsWebhookActionsFunc) nextHook() func(context.Context, ListActionsOpts) ([]*TeamsWebhookAction, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -5298,28 +6369,28 @@ func (f *CodeMonitorStoreListSlackWebhookActionsFunc) nextHook() func(context.Co
 	return hook
 }
 
-func (f *CodeMonitorStoreListSlackWebhookActionsFunc) appendCall(r0 CodeMonitorStoreListSlackWebhookActionsFuncCall) {
+func (f *CodeMonitorStoreListTeamsWebhookActionsFunc) appendCall(r0 CodeMonitorStoreListTeamsWebhookActionsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// CodeMonitorStoreListSlackWebhookActionsFuncCall objects describing the
+// CodeMonitorStoreListTeamsWebhookActionsFuncCall objects describing the
 // invocations of this function.
-func (f *CodeMonitorStoreListSlackWebhookActionsFunc) History() []CodeMonitorStoreListSlackWebhookActionsFuncCall {
+func (f *CodeMonitorStoreListTeamsWebhookActionsFunc) History() []CodeMonitorStoreListTeamsWebhookActionsFuncCall {
 	f.mutex.Lock()
-	history := make([]CodeMonitorStoreListSlackWebhookActionsFuncCall, len(f.history))
+	history := make([]CodeMonitorStoreListTeamsWebhookActionsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// CodeMonitorStoreListSlackWebhookActionsFuncCall is an object that
-// describes an invocation of method ListSlackWebhookActions on an instance
+// CodeMonitorStoreListTeamsWebhookActionsFuncCall is an object that
+// describes an invocation of method ListTeamsWebhookActions on an instance
 // of MockCodeMonitorStore.
-type CodeMonitorStoreListSlackWebhookActionsFuncCall struct {
+type CodeMonitorStoreListTeamsWebhookActionsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -5328,7 +6399,7 @@ type CodeMonitorStoreListSlackWebhookActionsFuncCall struct {
 	Arg1 ListActionsOpts
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*SlackWebhookAction
+	Result0 []*TeamsWebhookAction
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -5336,13 +6407,13 @@ type CodeMonitorStoreListSlackWebhookActionsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c CodeMonitorStoreListSlackWebhookActionsFuncCall) Args() []interface{} {
+func (c CodeMonitorStoreListTeamsWebhookActionsFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c CodeMonitorStoreListSlackWebhookActionsFuncCall) Results() []interface{} {
+func (c CodeMonitorStoreListTeamsWebhookActionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
@@ -6459,6 +7530,127 @@ func (c CodeMonitorStoreUpdateSlackWebhookActionFuncCall) Results() []interface{
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreUpdateTeamsWebhookActionFunc describes the behavior when
+// the UpdateTeamsWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreUpdateTeamsWebhookActionFunc struct {
+	defaultHook func(context.Context, int64, bool, bool, string) (*TeamsWebhookAction, error)
+	hooks       []func(context.Context, int64, bool, bool, string) (*TeamsWebhookAction, error)
+	history     []CodeMonitorStoreUpdateTeamsWebhookActionFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateTeamsWebhookAction delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) UpdateTeamsWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string) (*TeamsWebhookAction, error) {
+	r0, r1 := m.UpdateTeamsWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.UpdateTeamsWebhookActionFunc.appendCall(CodeMonitorStoreUpdateTeamsWebhookActionFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// UpdateTeamsWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreUpdateTeamsWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string) (*TeamsWebhookAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateTeamsWebhookAction method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreUpdateTeamsWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string) (*TeamsWebhookAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreUpdateTeamsWebhookActionFunc) SetDefaultReturn(r0 *TeamsWebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, bool, bool, string) (*TeamsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreUpdateTeamsWebhookActionFunc) PushReturn(r0 *TeamsWebhookAction, r1 error) {
+	f.PushHook(func(context.Context, int64, bool, bool, string) (*TeamsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreUpdateTeamsWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string) (*TeamsWebhookAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreUpdateTeamsWebhookActionFunc) appendCall(r0 CodeMonitorStoreUpdateTeamsWebhookActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreUpdateTeamsWebhookActionFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreUpdateTeamsWebhookActionFunc) History() []CodeMonitorStoreUpdateTeamsWebhookActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreUpdateTeamsWebhookActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreUpdateTeamsWebhookActionFuncCall is an object that
+// describes an invocation of method UpdateTeamsWebhookAction on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreUpdateTeamsWebhookActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 bool
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 bool
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *TeamsWebhookAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreUpdateTeamsWebhookActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreUpdateTeamsWebhookActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreUpdateTriggerJobWithResultsFunc describes the behavior
 // when the UpdateTriggerJobWithResults method of the parent
 // MockCodeMonitorStore instance is invoked.
@@ -6578,24 +7770,24 @@ func (c CodeMonitorStoreUpdateTriggerJobWithResultsFuncCall) Results() []interfa
 // UpdateWebhookAction method of the parent MockCodeMonitorStore instance is
 // invoked.
 type CodeMonitorStoreUpdateWebhookActionFunc struct {
-	defaultHook func(context.Context, int64, bool, bool, string) (*WebhookAction, error)
-	hooks       []func(context.Context, int64, bool, bool, string) (*WebhookAction, error)
+	defaultHook func(context.Context, int64, *WebhookActionArgs) (*WebhookAction, error)
+	hooks       []func(context.Context, int64, *WebhookActionArgs) (*WebhookAction, error)
 	history     []CodeMonitorStoreUpdateWebhookActionFuncCall
 	mutex       sync.Mutex
 }
 
 // UpdateWebhookAction delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) UpdateWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 string) (*WebhookAction, error) {
-	r0, r1 := m.UpdateWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.UpdateWebhookActionFunc.appendCall(CodeMonitorStoreUpdateWebhookActionFuncCall{v0, v1, v2, v3, v4, r0, r1})
+func (m *MockCodeMonitorStore) UpdateWebhookAction(v0 context.Context, v1 int64, v2 *WebhookActionArgs) (*WebhookAction, error) {
+	r0, r1 := m.UpdateWebhookActionFunc.nextHook()(v0, v1, v2)
+	m.UpdateWebhookActionFunc.appendCall(CodeMonitorStoreUpdateWebhookActionFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the UpdateWebhookAction
 // method of the parent MockCodeMonitorStore instance is invoked and the
 // hook queue is empty.
-func (f *CodeMonitorStoreUpdateWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, string) (*WebhookAction, error)) {
+func (f *CodeMonitorStoreUpdateWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, *WebhookActionArgs) (*WebhookAction, error)) {
 	f.defaultHook = hook
 }
 
@@ -6604,7 +7796,7 @@ func (f *CodeMonitorStoreUpdateWebhookActionFunc) SetDefaultHook(hook func(conte
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *CodeMonitorStoreUpdateWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, string) (*WebhookAction, error)) {
+func (f *CodeMonitorStoreUpdateWebhookActionFunc) PushHook(hook func(context.Context, int64, *WebhookActionArgs) (*WebhookAction, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -6613,19 +7805,19 @@ func (f *CodeMonitorStoreUpdateWebhookActionFunc) PushHook(hook func(context.Con
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
 func (f *CodeMonitorStoreUpdateWebhookActionFunc) SetDefaultReturn(r0 *WebhookAction, r1 error) {
-	f.SetDefaultHook(func(context.Context, int64, bool, bool, string) (*WebhookAction, error) {
+	f.SetDefaultHook(func(context.Context, int64, *WebhookActionArgs) (*WebhookAction, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
 func (f *CodeMonitorStoreUpdateWebhookActionFunc) PushReturn(r0 *WebhookAction, r1 error) {
-	f.PushHook(func(context.Context, int64, bool, bool, string) (*WebhookAction, error) {
+	f.PushHook(func(context.Context, int64, *WebhookActionArgs) (*WebhookAction, error) {
 		return r0, r1
 	})
 }
 
-func (f *CodeMonitorStoreUpdateWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, string) (*WebhookAction, error) {
+func (f *CodeMonitorStoreUpdateWebhookActionFunc) nextHook() func(context.Context, int64, *WebhookActionArgs) (*WebhookAction, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -6667,13 +7859,7 @@ type CodeMonitorStoreUpdateWebhookActionFuncCall struct {
 	Arg1 int64
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 bool
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 bool
-	// Arg4 is the value of the 5th argument passed to this method
-	// invocation.
-	Arg4 string
+	Arg2 *WebhookActionArgs
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 *WebhookAction
@@ -6685,7 +7871,7 @@ type CodeMonitorStoreUpdateWebhookActionFuncCall struct {
 // Args returns an interface slice containing the arguments of this
 // invocation.
 func (c CodeMonitorStoreUpdateWebhookActionFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
@@ -6732,20 +7918,134 @@ func (f *CodeMonitorStoreUpsertLastSearchedFunc) PushHook(hook func(context.Cont
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *CodeMonitorStoreUpsertLastSearchedFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int64, api.RepoID, []string) error {
+func (f *CodeMonitorStoreUpsertLastSearchedFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, api.RepoID, []string) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreUpsertLastSearchedFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64, api.RepoID, []string) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreUpsertLastSearchedFunc) nextHook() func(context.Context, int64, api.RepoID, []string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreUpsertLastSearchedFunc) appendCall(r0 CodeMonitorStoreUpsertLastSearchedFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreUpsertLastSearchedFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreUpsertLastSearchedFunc) History() []CodeMonitorStoreUpsertLastSearchedFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreUpsertLastSearchedFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreUpsertLastSearchedFuncCall is an object that describes an
+// invocation of method UpsertLastSearched on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreUpsertLastSearchedFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.RepoID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 []string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreUpsertLastSearchedFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreUpsertLastSearchedFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// CodeMonitorStoreUpsertLastSearchedContentMatchesFunc describes the
+// behavior when the UpsertLastSearchedContentMatches method of the parent
+// MockCodeMonitorStore instance is invoked.
+type CodeMonitorStoreUpsertLastSearchedContentMatchesFunc struct {
+	defaultHook func(context.Context, int64, api.RepoID, []string, []string) error
+	hooks       []func(context.Context, int64, api.RepoID, []string, []string) error
+	history     []CodeMonitorStoreUpsertLastSearchedContentMatchesFuncCall
+	mutex       sync.Mutex
+}
+
+// UpsertLastSearchedContentMatches delegates to the next hook function in
+// the queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) UpsertLastSearchedContentMatches(v0 context.Context, v1 int64, v2 api.RepoID, v3 []string, v4 []string) error {
+	r0 := m.UpsertLastSearchedContentMatchesFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.UpsertLastSearchedContentMatchesFunc.appendCall(CodeMonitorStoreUpsertLastSearchedContentMatchesFuncCall{v0, v1, v2, v3, v4, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// UpsertLastSearchedContentMatches method of the parent
+// MockCodeMonitorStore instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreUpsertLastSearchedContentMatchesFunc) SetDefaultHook(hook func(context.Context, int64, api.RepoID, []string, []string) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpsertLastSearchedContentMatches method of the parent
+// MockCodeMonitorStore instance invokes the hook at the front of the queue
+// and discards it. After the queue is empty, the default hook function is
+// invoked for any future action.
+func (f *CodeMonitorStoreUpsertLastSearchedContentMatchesFunc) PushHook(hook func(context.Context, int64, api.RepoID, []string, []string) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreUpsertLastSearchedContentMatchesFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, api.RepoID, []string, []string) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *CodeMonitorStoreUpsertLastSearchedFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int64, api.RepoID, []string) error {
+func (f *CodeMonitorStoreUpsertLastSearchedContentMatchesFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64, api.RepoID, []string, []string) error {
 		return r0
 	})
 }
 
-func (f *CodeMonitorStoreUpsertLastSearchedFunc) nextHook() func(context.Context, int64, api.RepoID, []string) error {
+func (f *CodeMonitorStoreUpsertLastSearchedContentMatchesFunc) nextHook() func(context.Context, int64, api.RepoID, []string, []string) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -6758,27 +8058,28 @@ func (f *CodeMonitorStoreUpsertLastSearchedFunc) nextHook() func(context.Context
 	return hook
 }
 
-func (f *CodeMonitorStoreUpsertLastSearchedFunc) appendCall(r0 CodeMonitorStoreUpsertLastSearchedFuncCall) {
+func (f *CodeMonitorStoreUpsertLastSearchedContentMatchesFunc) appendCall(r0 CodeMonitorStoreUpsertLastSearchedContentMatchesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of CodeMonitorStoreUpsertLastSearchedFuncCall
-// objects describing the invocations of this function.
-func (f *CodeMonitorStoreUpsertLastSearchedFunc) History() []CodeMonitorStoreUpsertLastSearchedFuncCall {
+// History returns a sequence of
+// CodeMonitorStoreUpsertLastSearchedContentMatchesFuncCall objects
+// describing the invocations of this function.
+func (f *CodeMonitorStoreUpsertLastSearchedContentMatchesFunc) History() []CodeMonitorStoreUpsertLastSearchedContentMatchesFuncCall {
 	f.mutex.Lock()
-	history := make([]CodeMonitorStoreUpsertLastSearchedFuncCall, len(f.history))
+	history := make([]CodeMonitorStoreUpsertLastSearchedContentMatchesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// CodeMonitorStoreUpsertLastSearchedFuncCall is an object that describes an
-// invocation of method UpsertLastSearched on an instance of
-// MockCodeMonitorStore.
-type CodeMonitorStoreUpsertLastSearchedFuncCall struct {
+// CodeMonitorStoreUpsertLastSearchedContentMatchesFuncCall is an object
+// that describes an invocation of method UpsertLastSearchedContentMatches
+// on an instance of MockCodeMonitorStore.
+type CodeMonitorStoreUpsertLastSearchedContentMatchesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -6791,6 +8092,9 @@ type CodeMonitorStoreUpsertLastSearchedFuncCall struct {
 	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
 	Arg3 []string
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 []string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -6798,13 +8102,13 @@ type CodeMonitorStoreUpsertLastSearchedFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c CodeMonitorStoreUpsertLastSearchedFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c CodeMonitorStoreUpsertLastSearchedContentMatchesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c CodeMonitorStoreUpsertLastSearchedFuncCall) Results() []interface{} {
+func (c CodeMonitorStoreUpsertLastSearchedContentMatchesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
@@ -6880,6 +8184,9 @@ type MockEnterpriseDB struct {
 	// OrgsFunc is an instance of a mock function object controlling the
 	// behavior of the method Orgs.
 	OrgsFunc *EnterpriseDBOrgsFunc
+	// PermissionsFunc is an instance of a mock function object controlling
+	// the behavior of the method Permissions.
+	PermissionsFunc *EnterpriseDBPermissionsFunc
 	// PermsFunc is an instance of a mock function object controlling the
 	// behavior of the method Perms.
 	PermsFunc *EnterpriseDBPermsFunc
@@ -6898,9 +8205,15 @@ type MockEnterpriseDB struct {
 	// RepoStatisticsFunc is an instance of a mock function object
 	// controlling the behavior of the method RepoStatistics.
 	RepoStatisticsFunc *EnterpriseDBRepoStatisticsFunc
+	// RepoUpdateScheduleFunc is an instance of a mock function object
+	// controlling the behavior of the method RepoUpdateSchedule.
+	RepoUpdateScheduleFunc *EnterpriseDBRepoUpdateScheduleFunc
 	// ReposFunc is an instance of a mock function object controlling the
 	// behavior of the method Repos.
 	ReposFunc *EnterpriseDBReposFunc
+	// RolesFunc is an instance of a mock function object controlling the
+	// behavior of the method Roles.
+	RolesFunc *EnterpriseDBRolesFunc
 	// SavedSearchesFunc is an instance of a mock function object
 	// controlling the behavior of the method SavedSearches.
 	SavedSearchesFunc *EnterpriseDBSavedSearchesFunc
@@ -7062,6 +8375,11 @@ func NewMockEnterpriseDB() *MockEnterpriseDB {
 				return
 			},
 		},
+		PermissionsFunc: &EnterpriseDBPermissionsFunc{
+			defaultHook: func() (r0 database.PermissionStore) {
+				return
+			},
+		},
 		PermsFunc: &EnterpriseDBPermsFunc{
 			defaultHook: func() (r0 PermsStore) {
 				return
@@ -7092,11 +8410,21 @@ func NewMockEnterpriseDB() *MockEnterpriseDB {
 				return
 			},
 		},
+		RepoUpdateScheduleFunc: &EnterpriseDBRepoUpdateScheduleFunc{
+			defaultHook: func() (r0 database.RepoUpdateScheduleStore) {
+				return
+			},
+		},
 		ReposFunc: &EnterpriseDBReposFunc{
 			defaultHook: func() (r0 database.RepoStore) {
 				return
 			},
 		},
+		RolesFunc: &EnterpriseDBRolesFunc{
+			defaultHook: func() (r0 database.RoleStore) {
+				return
+			},
+		},
 		SavedSearchesFunc: &EnterpriseDBSavedSearchesFunc{
 			defaultHook: func() (r0 database.SavedSearchStore) {
 				return
@@ -7289,6 +8617,11 @@ func NewStrictMockEnterpriseDB() *MockEnterpriseDB {
 				panic("unexpected invocation of MockEnterpriseDB.Orgs")
 			},
 		},
+		PermissionsFunc: &EnterpriseDBPermissionsFunc{
+			defaultHook: func() database.PermissionStore {
+				panic("unexpected invocation of MockEnterpriseDB.Permissions")
+			},
+		},
 		PermsFunc: &EnterpriseDBPermsFunc{
 			defaultHook: func() PermsStore {
 				panic("unexpected invocation of MockEnterpriseDB.Perms")
@@ -7319,11 +8652,21 @@ func NewStrictMockEnterpriseDB() *MockEnterpriseDB {
 				panic("unexpected invocation of MockEnterpriseDB.RepoStatistics")
 			},
 		},
+		RepoUpdateScheduleFunc: &EnterpriseDBRepoUpdateScheduleFunc{
+			defaultHook: func() database.RepoUpdateScheduleStore {
+				panic("unexpected invocation of MockEnterpriseDB.RepoUpdateSchedule")
+			},
+		},
 		ReposFunc: &EnterpriseDBReposFunc{
 			defaultHook: func() database.RepoStore {
 				panic("unexpected invocation of MockEnterpriseDB.Repos")
 			},
 		},
+		RolesFunc: &EnterpriseDBRolesFunc{
+			defaultHook: func() database.RoleStore {
+				panic("unexpected invocation of MockEnterpriseDB.Roles")
+			},
+		},
 		SavedSearchesFunc: &EnterpriseDBSavedSearchesFunc{
 			defaultHook: func() database.SavedSearchStore {
 				panic("unexpected invocation of MockEnterpriseDB.SavedSearches")
@@ -7473,6 +8816,9 @@ func NewMockEnterpriseDBFrom(i EnterpriseDB) *MockEnterpriseDB {
 		OrgsFunc: &EnterpriseDBOrgsFunc{
 			defaultHook: i.Orgs,
 		},
+		PermissionsFunc: &EnterpriseDBPermissionsFunc{
+			defaultHook: i.Permissions,
+		},
 		PermsFunc: &EnterpriseDBPermsFunc{
 			defaultHook: i.Perms,
 		},
@@ -7491,9 +8837,15 @@ func NewMockEnterpriseDBFrom(i EnterpriseDB) *MockEnterpriseDB {
 		RepoStatisticsFunc: &EnterpriseDBRepoStatisticsFunc{
 			defaultHook: i.RepoStatistics,
 		},
+		RepoUpdateScheduleFunc: &EnterpriseDBRepoUpdateScheduleFunc{
+			defaultHook: i.RepoUpdateSchedule,
+		},
 		ReposFunc: &EnterpriseDBReposFunc{
 			defaultHook: i.Repos,
 		},
+		RolesFunc: &EnterpriseDBRolesFunc{
+			defaultHook: i.Roles,
+		},
 		SavedSearchesFunc: &EnterpriseDBSavedSearchesFunc{
 			defaultHook: i.SavedSearches,
 		},
@@ -9753,6 +11105,105 @@ func (c EnterpriseDBOrgsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// EnterpriseDBPermissionsFunc describes the behavior when the Permissions
+// method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBPermissionsFunc struct {
+	defaultHook func() database.PermissionStore
+	hooks       []func() database.PermissionStore
+	history     []EnterpriseDBPermissionsFuncCall
+	mutex       sync.Mutex
+}
+
+// Permissions delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) Permissions() database.PermissionStore {
+	r0 := m.PermissionsFunc.nextHook()()
+	m.PermissionsFunc.appendCall(EnterpriseDBPermissionsFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Permissions method
+// of the parent MockEnterpriseDB instance is invoked and the hook queue is
+// empty.
+func (f *EnterpriseDBPermissionsFunc) SetDefaultHook(hook func() database.PermissionStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Permissions method of the parent MockEnterpriseDB instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *EnterpriseDBPermissionsFunc) PushHook(hook func() database.PermissionStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *EnterpriseDBPermissionsFunc) SetDefaultReturn(r0 database.PermissionStore) {
+	f.SetDefaultHook(func() database.PermissionStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *EnterpriseDBPermissionsFunc) PushReturn(r0 database.PermissionStore) {
+	f.PushHook(func() database.PermissionStore {
+		return r0
+	})
+}
+
+func (f *EnterpriseDBPermissionsFunc) nextHook() func() database.PermissionStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnterpriseDBPermissionsFunc) appendCall(r0 EnterpriseDBPermissionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of EnterpriseDBPermissionsFuncCall objects
+// describing the invocations of this function.
+func (f *EnterpriseDBPermissionsFunc) History() []EnterpriseDBPermissionsFuncCall {
+	f.mutex.Lock()
+	history := make([]EnterpriseDBPermissionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnterpriseDBPermissionsFuncCall is an object that describes an invocation
+// of method Permissions on an instance of MockEnterpriseDB.
+type EnterpriseDBPermissionsFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.PermissionStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnterpriseDBPermissionsFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnterpriseDBPermissionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // EnterpriseDBPermsFunc describes the behavior when the Perms method of the
 // parent MockEnterpriseDB instance is invoked.
 type EnterpriseDBPermsFunc struct {
@@ -10382,6 +11833,107 @@ func (c EnterpriseDBRepoStatisticsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// EnterpriseDBRepoUpdateScheduleFunc describes the behavior when the
+// RepoUpdateSchedule method of the parent MockEnterpriseDB instance is
+// invoked.
+type EnterpriseDBRepoUpdateScheduleFunc struct {
+	defaultHook func() database.RepoUpdateScheduleStore
+	hooks       []func() database.RepoUpdateScheduleStore
+	history     []EnterpriseDBRepoUpdateScheduleFuncCall
+	mutex       sync.Mutex
+}
+
+// RepoUpdateSchedule delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) RepoUpdateSchedule() database.RepoUpdateScheduleStore {
+	r0 := m.RepoUpdateScheduleFunc.nextHook()()
+	m.RepoUpdateScheduleFunc.appendCall(EnterpriseDBRepoUpdateScheduleFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the RepoUpdateSchedule
+// method of the parent MockEnterpriseDB instance is invoked and the hook
+// queue is empty.
+func (f *EnterpriseDBRepoUpdateScheduleFunc) SetDefaultHook(hook func() database.RepoUpdateScheduleStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RepoUpdateSchedule method of the parent MockEnterpriseDB instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *EnterpriseDBRepoUpdateScheduleFunc) PushHook(hook func() database.RepoUpdateScheduleStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *EnterpriseDBRepoUpdateScheduleFunc) SetDefaultReturn(r0 database.RepoUpdateScheduleStore) {
+	f.SetDefaultHook(func() database.RepoUpdateScheduleStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *EnterpriseDBRepoUpdateScheduleFunc) PushReturn(r0 database.RepoUpdateScheduleStore) {
+	f.PushHook(func() database.RepoUpdateScheduleStore {
+		return r0
+	})
+}
+
+func (f *EnterpriseDBRepoUpdateScheduleFunc) nextHook() func() database.RepoUpdateScheduleStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnterpriseDBRepoUpdateScheduleFunc) appendCall(r0 EnterpriseDBRepoUpdateScheduleFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of EnterpriseDBRepoUpdateScheduleFuncCall
+// objects describing the invocations of this function.
+func (f *EnterpriseDBRepoUpdateScheduleFunc) History() []EnterpriseDBRepoUpdateScheduleFuncCall {
+	f.mutex.Lock()
+	history := make([]EnterpriseDBRepoUpdateScheduleFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnterpriseDBRepoUpdateScheduleFuncCall is an object that describes an
+// invocation of method RepoUpdateSchedule on an instance of
+// MockEnterpriseDB.
+type EnterpriseDBRepoUpdateScheduleFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.RepoUpdateScheduleStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnterpriseDBRepoUpdateScheduleFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnterpriseDBRepoUpdateScheduleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // EnterpriseDBReposFunc describes the behavior when the Repos method of the
 // parent MockEnterpriseDB instance is invoked.
 type EnterpriseDBReposFunc struct {
@@ -10480,6 +12032,104 @@ func (c EnterpriseDBReposFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// EnterpriseDBRolesFunc describes the behavior when the Roles method of the
+// parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBRolesFunc struct {
+	defaultHook func() database.RoleStore
+	hooks       []func() database.RoleStore
+	history     []EnterpriseDBRolesFuncCall
+	mutex       sync.Mutex
+}
+
+// Roles delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockEnterpriseDB) Roles() database.RoleStore {
+	r0 := m.RolesFunc.nextHook()()
+	m.RolesFunc.appendCall(EnterpriseDBRolesFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Roles method of the
+// parent MockEnterpriseDB instance is invoked and the hook queue is empty.
+func (f *EnterpriseDBRolesFunc) SetDefaultHook(hook func() database.RoleStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Roles method of the parent MockEnterpriseDB instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *EnterpriseDBRolesFunc) PushHook(hook func() database.RoleStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *EnterpriseDBRolesFunc) SetDefaultReturn(r0 database.RoleStore) {
+	f.SetDefaultHook(func() database.RoleStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *EnterpriseDBRolesFunc) PushReturn(r0 database.RoleStore) {
+	f.PushHook(func() database.RoleStore {
+		return r0
+	})
+}
+
+func (f *EnterpriseDBRolesFunc) nextHook() func() database.RoleStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnterpriseDBRolesFunc) appendCall(r0 EnterpriseDBRolesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of EnterpriseDBRolesFuncCall objects
+// describing the invocations of this function.
+func (f *EnterpriseDBRolesFunc) History() []EnterpriseDBRolesFuncCall {
+	f.mutex.Lock()
+	history := make([]EnterpriseDBRolesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnterpriseDBRolesFuncCall is an object that describes an invocation of
+// method Roles on an instance of MockEnterpriseDB.
+type EnterpriseDBRolesFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.RoleStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnterpriseDBRolesFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnterpriseDBRolesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // EnterpriseDBSavedSearchesFunc describes the behavior when the
 // SavedSearches method of the parent MockEnterpriseDB instance is invoked.
 type EnterpriseDBSavedSearchesFunc struct {
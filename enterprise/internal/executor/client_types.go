@@ -13,6 +13,10 @@ type Job struct {
 	// that different queues can share identifiers.
 	ID int `json:"id"`
 
+	// Queue is the name of the queue the job was dequeued from. This is only
+	// set for jobs dequeued through a multi-queue dequeue request.
+	Queue string `json:"queue,omitempty"`
+
 	// RepositoryName is the name of the repository to be cloned into the
 	// workspace prior to job execution.
 	RepositoryName string `json:"repositoryName"`
@@ -65,6 +69,7 @@ func (j *Job) UnmarshalJSON(bytes []byte) error {
 		return err
 	}
 	j.ID = int(v["id"].(float64))
+	j.Queue = toString(v["queue"])
 	j.RepositoryName = toString(v["repositoryName"])
 	j.RepositoryDirectory = toString(v["repositoryDirectory"])
 	j.Commit = toString(v["commit"])
@@ -235,6 +240,10 @@ type DequeueRequest struct {
 	NumCPUs      int    `json:"numCPUs,omitempty"`
 	Memory       string `json:"memory,omitempty"`
 	DiskSpace    string `json:"diskSpace,omitempty"`
+
+	// Queues is the list of queues to dequeue from, in order of preference. It is
+	// only read by the multi-queue dequeue route.
+	Queues []string `json:"queues,omitempty"`
 }
 
 type AddExecutionLogEntryRequest struct {
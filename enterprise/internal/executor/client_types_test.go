@@ -24,6 +24,7 @@ func TestJob_UnmarshalJSON(t *testing.T) {
 			name: "4.1",
 			input: `{
 	"id": 1,
+	"queue": "batches",
 	"repositoryName": "my-repo",
 	"repositoryDirectory": "foo/bar",
 	"commit": "xyz",
@@ -57,6 +58,7 @@ func TestJob_UnmarshalJSON(t *testing.T) {
 }`,
 			expected: executor.Job{
 				ID:                  1,
+				Queue:               "batches",
 				RepositoryName:      "my-repo",
 				RepositoryDirectory: "foo/bar",
 				Commit:              "xyz",
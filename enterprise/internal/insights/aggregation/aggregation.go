@@ -8,10 +8,13 @@ import (
 
 	"github.com/go-enry/go-enry/v2"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/compute"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/query/querybuilder"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/comby"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming/api"
@@ -34,14 +37,14 @@ type SearchResultsAggregator interface {
 type AggregationTabulator func(*AggregationMatchResult, error)
 type OnMatches func(matches []result.Match)
 
-type AggregationCountFunc func(result.Match) (map[MatchKey]int, error)
+type AggregationCountFunc func(context.Context, result.Match) (map[MatchKey]int, error)
 type MatchKey struct {
 	Repo   string
 	RepoID int32
 	Group  string
 }
 
-func countRepo(r result.Match) (map[MatchKey]int, error) {
+func countRepo(_ context.Context, r result.Match) (map[MatchKey]int, error) {
 	if r.RepoName().Name != "" {
 		return map[MatchKey]int{{
 			RepoID: int32(r.RepoName().ID),
@@ -52,7 +55,7 @@ func countRepo(r result.Match) (map[MatchKey]int, error) {
 	return nil, nil
 }
 
-func countLang(r result.Match) (map[MatchKey]int, error) {
+func countLang(_ context.Context, r result.Match) (map[MatchKey]int, error) {
 	var lang string
 	switch match := r.(type) {
 	case *result.FileMatch:
@@ -69,7 +72,7 @@ func countLang(r result.Match) (map[MatchKey]int, error) {
 	return nil, nil
 }
 
-func countPath(r result.Match) (map[MatchKey]int, error) {
+func countPath(_ context.Context, r result.Match) (map[MatchKey]int, error) {
 	var path string
 	switch match := r.(type) {
 	case *result.FileMatch:
@@ -86,7 +89,7 @@ func countPath(r result.Match) (map[MatchKey]int, error) {
 	return nil, nil
 }
 
-func countAuthor(r result.Match) (map[MatchKey]int, error) {
+func countAuthor(_ context.Context, r result.Match) (map[MatchKey]int, error) {
 	var author string
 	switch match := r.(type) {
 	case *result.CommitMatch:
@@ -103,7 +106,18 @@ func countAuthor(r result.Match) (map[MatchKey]int, error) {
 	return nil, nil
 }
 
-func countCaptureGroupsFunc(querystring string) (AggregationCountFunc, error) {
+func countCaptureGroupsFunc(querystring, patternType string) (AggregationCountFunc, error) {
+	if patternType == "" {
+		patternType = "regexp"
+	}
+	searchType, err := querybuilder.DetectSearchType(querystring, patternType)
+	if err != nil {
+		return nil, errors.Wrap(err, "DetectSearchType")
+	}
+	if searchType == query.SearchTypeStructural {
+		return countStructuralCaptureGroupsFunc(querystring)
+	}
+
 	pattern, err := getCasedPattern(querystring)
 	if err != nil {
 		return nil, errors.Wrap(err, "getCasedPattern")
@@ -113,7 +127,7 @@ func countCaptureGroupsFunc(querystring string) (AggregationCountFunc, error) {
 		return nil, errors.Wrap(err, "Could not compile regexp")
 	}
 
-	return func(r result.Match) (map[MatchKey]int, error) {
+	return func(_ context.Context, r result.Match) (map[MatchKey]int, error) {
 		content := matchContent(r)
 		if len(content) != 0 {
 			matches := map[MatchKey]int{}
@@ -136,6 +150,44 @@ func countCaptureGroupsFunc(querystring string) (AggregationCountFunc, error) {
 	}, nil
 }
 
+// countStructuralCaptureGroupsFunc counts the values of the first named hole
+// of a structural search pattern, like the first capture group of a regexp.
+func countStructuralCaptureGroupsFunc(querystring string) (AggregationCountFunc, error) {
+	pattern, languages, err := getStructuralPattern(querystring)
+	if err != nil {
+		return nil, err
+	}
+	holes := comby.Holes(pattern)
+	if len(holes) == 0 {
+		return nil, errors.New("structural search pattern does not contain any named holes")
+	}
+	hole := holes[0]
+
+	return func(ctx context.Context, r result.Match) (map[MatchKey]int, error) {
+		fm, ok := r.(*result.FileMatch)
+		if !ok || len(fm.ChunkMatches) == 0 {
+			return nil, nil
+		}
+		structuralMatches, err := compute.StructuralMatches(ctx, fm, pattern, languages)
+		if err != nil {
+			return nil, errors.Wrap(err, "StructuralMatches")
+		}
+		matches := map[MatchKey]int{}
+		for _, m := range structuralMatches {
+			data, ok := m.Environment[hole]
+			if !ok {
+				continue
+			}
+			key := MatchKey{Repo: string(r.RepoName().Name), RepoID: int32(r.RepoName().ID), Group: data.Value}
+			if len(key.Group) > 100 {
+				key.Group = key.Group[:100]
+			}
+			matches[key]++
+		}
+		return matches, nil
+	}, nil
+}
+
 func matchContent(event result.Match) []string {
 	switch match := event.(type) {
 	case *result.FileMatch:
@@ -174,7 +226,7 @@ func GetCountFuncForMode(query, patternType string, mode types.SearchAggregation
 	}
 
 	if mode == types.CAPTURE_GROUP_AGGREGATION_MODE {
-		captureGroupsCount, err := countCaptureGroupsFunc(query)
+		captureGroupsCount, err := countCaptureGroupsFunc(query, patternType)
 		if err != nil {
 			return nil, err
 		}
@@ -241,7 +293,7 @@ func (r *searchAggregationResults) Send(event streaming.SearchEvent) {
 			r.tabulator(nil, err)
 			return
 		default:
-			groups, err := r.countFunc(match)
+			groups, err := r.countFunc(r.ctx, match)
 			for groupKey, count := range groups {
 				// delegate error handling to the passed in tabulator
 				if err != nil {
@@ -288,3 +340,23 @@ func getCasedPattern(querystring string) (MatchPattern, error) {
 	}
 	return casedPattern, nil
 }
+
+// getStructuralPattern pulls the structural search pattern and the languages
+// that select its comby matcher out of the querystring.
+func getStructuralPattern(querystring string) (string, []string, error) {
+	plan, err := querybuilder.ParseQuery(querystring, "structural")
+	if err != nil {
+		return "", nil, errors.Wrap(err, "ParseQuery")
+	}
+	if len(plan) != 1 {
+		return "", nil, errors.New("Pipeline generated plan with multiple steps.")
+	}
+	basic := plan[0]
+
+	pattern, err := extractPattern(&basic)
+	if err != nil {
+		return "", nil, err
+	}
+	languages, _ := basic.IncludeExcludeValues(query.FieldLang)
+	return pattern.Value, languages, nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/comby"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
@@ -641,3 +642,32 @@ func TestAggregationCancelation(t *testing.T) {
 		})
 	}
 }
+
+func TestStructuralCaptureGroupAggregation(t *testing.T) {
+	t.Run("requires named holes", func(t *testing.T) {
+		_, err := GetCountFuncForMode("log.Fatal(:[_]) patterntype:structural", "", types.CAPTURE_GROUP_AGGREGATION_MODE)
+		if err == nil {
+			t.Fatal("expected an error for a structural pattern without named holes")
+		}
+	})
+
+	t.Run("counts first named hole", func(t *testing.T) {
+		if !comby.Exists() {
+			t.Skip("comby is not installed on the PATH. Try running 'bash <(curl -sL get.comby.dev)'.")
+		}
+		aggregator := testAggregator{results: make(map[string]int)}
+		countFunc, err := GetCountFuncForMode("log.Fatal(:[x], :[y])", "structural", types.CAPTURE_GROUP_AGGREGATION_MODE)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sra := newTestSearchResultsAggregator(context.Background(), aggregator.AddResult, countFunc)
+		sra.Send(streaming.SearchEvent{
+			Results: []result.Match{
+				contentMatch("myRepo", "main.go", 1, "log.Fatal(err, 1)", "log.Fatal(err, 2)"),
+				contentMatch("myRepo", "cmd.go", 1, "log.Fatal(ctx.Err(), 3)"),
+				repoMatch("myRepo", 1),
+			},
+		})
+		autogold.Want("structural capture groups", map[string]int{"ctx.Err()": 1, "err": 2}).Equal(t, aggregator.results)
+	})
+}
@@ -504,7 +504,7 @@ func Test_addAuthorFilter(t *testing.T) {
 		{
 			input:  "myquery repo:myrepo type:file author:claus",
 			author: "santa",
-			want:   autogold.Want("invalid adding to file search - should error", "your query contains the field 'author', which requires type:commit or type:diff in the query"),
+			want:   autogold.Want("invalid adding to file search - should return input", BasicQuery("repo:myrepo type:file author:claus myquery")),
 		},
 		{
 			input:  "myquery repo:myrepo type:repo",
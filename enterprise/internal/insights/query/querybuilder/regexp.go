@@ -3,6 +3,7 @@ package querybuilder
 import (
 	"strings"
 
+	"github.com/sourcegraph/sourcegraph/internal/comby"
 	searchquery "github.com/sourcegraph/sourcegraph/internal/search/query"
 
 	"github.com/grafana/regexp"
@@ -162,7 +163,7 @@ func (r *regexpReplacer) HasCaptureGroups() bool {
 
 var (
 	MultiplePatternErr        = errors.New("pattern replacement does not support queries with multiple patterns")
-	UnsupportedPatternTypeErr = errors.New("pattern replacement is only supported for regexp patterns")
+	UnsupportedPatternTypeErr = errors.New("pattern replacement is only supported for regexp and structural patterns")
 )
 
 func NewPatternReplacer(query BasicQuery, searchType searchquery.SearchType) (PatternReplacer, error) {
@@ -190,7 +191,9 @@ func NewPatternReplacer(query BasicQuery, searchType searchquery.SearchType) (Pa
 
 	needsSlashEscape := true
 	pattern := patterns[0]
-	if !pattern.Annotation.Labels.IsSet(searchquery.Regexp) {
+	if pattern.Annotation.Labels.IsSet(searchquery.Structural) {
+		return &structuralReplacer{original: plan, pattern: pattern.Value, holes: comby.Holes(pattern.Value)}, nil
+	} else if !pattern.Annotation.Labels.IsSet(searchquery.Regexp) {
 		return nil, UnsupportedPatternTypeErr
 	} else if !ptn.MatchString(pattern.Value) {
 		// because regexp annotated patterns implicitly escapes slashes in the regular expression we need to translate the pattern into
@@ -233,6 +233,18 @@ func TestReplace_Valid(t *testing.T) {
 			want:        autogold.Want("ensure queries from type standard slashes are escaped properly", BasicQuery(`/<title>(?:findme)<\/title>/`)),
 			searchType:  query.SearchTypeStandard,
 		},
+		{
+			query:       "log.Fatal(:[x], :[y])",
+			replacement: "err",
+			want:        autogold.Want("structural replaces first named hole", BasicQuery("log.Fatal(err, :[y])")),
+			searchType:  query.SearchTypeStructural,
+		},
+		{
+			query:       "foo(:[_], :[[x]]) patterntype:structural",
+			replacement: "bar",
+			want:        autogold.Want("structural ignores anonymous holes", BasicQuery("patterntype:structural foo(:[_], bar)")),
+			searchType:  query.SearchTypeStructural,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.want.Name(), func(t *testing.T) {
@@ -0,0 +1,36 @@
+package querybuilder
+
+import (
+	"github.com/sourcegraph/sourcegraph/internal/comby"
+	searchquery "github.com/sourcegraph/sourcegraph/internal/search/query"
+)
+
+// structuralReplacer replaces the first named hole of a structural search
+// pattern with a literal value, the structural analogue of replacing the
+// first capture group of a regexp pattern.
+type structuralReplacer struct {
+	original searchquery.Plan
+	pattern  string
+	holes    []string
+}
+
+func (r *structuralReplacer) Replace(replacement string) (BasicQuery, error) {
+	pattern := replacement
+	if len(r.holes) > 0 {
+		pattern = comby.SubstituteHole(r.pattern, r.holes[0], replacement)
+	}
+
+	modified := searchquery.MapPattern(r.original.ToQ(), func(patternValue string, negated bool, annotation searchquery.Annotation) searchquery.Node {
+		return searchquery.Pattern{
+			Value:      pattern,
+			Negated:    negated,
+			Annotation: annotation,
+		}
+	})
+
+	return BasicQuery(searchquery.StringHuman(modified)), nil
+}
+
+func (r *structuralReplacer) HasCaptureGroups() bool {
+	return len(r.holes) > 0
+}
@@ -11,9 +11,9 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/scheduler"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
-	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/auth"
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/rbac"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -22,8 +22,7 @@ var _ graphqlbackend.InsightSeriesMetadataResolver = &insightSeriesMetadataResol
 var _ graphqlbackend.InsightSeriesQueryStatusResolver = &insightSeriesQueryStatusResolver{}
 
 func (r *Resolver) UpdateInsightSeries(ctx context.Context, args *graphqlbackend.UpdateInsightSeriesArgs) (graphqlbackend.InsightSeriesMetadataPayloadResolver, error) {
-	actr := actor.FromContext(ctx)
-	if err := auth.CheckUserIsSiteAdmin(ctx, r.postgresDB, actr.UID); err != nil {
+	if err := auth.CheckCurrentUserHasPermission(ctx, r.postgresDB, rbac.CodeInsightsAdmin); err != nil {
 		return nil, err
 	}
 
@@ -45,8 +44,7 @@ func (r *Resolver) UpdateInsightSeries(ctx context.Context, args *graphqlbackend
 }
 
 func (r *Resolver) InsightSeriesQueryStatus(ctx context.Context) ([]graphqlbackend.InsightSeriesQueryStatusResolver, error) {
-	actr := actor.FromContext(ctx)
-	if err := auth.CheckUserIsSiteAdmin(ctx, r.postgresDB, actr.UID); err != nil {
+	if err := auth.CheckCurrentUserHasPermission(ctx, r.postgresDB, rbac.CodeInsightsAdmin); err != nil {
 		return nil, err
 	}
 
@@ -81,8 +79,7 @@ func (r *Resolver) InsightSeriesQueryStatus(ctx context.Context) ([]graphqlbacke
 }
 
 func (r *Resolver) InsightViewDebug(ctx context.Context, args graphqlbackend.InsightViewDebugArgs) (graphqlbackend.InsightViewDebugResolver, error) {
-	actr := actor.FromContext(ctx)
-	if err := auth.CheckUserIsSiteAdmin(ctx, r.postgresDB, actr.UID); err != nil {
+	if err := auth.CheckCurrentUserHasPermission(ctx, r.postgresDB, rbac.CodeInsightsAdmin); err != nil {
 		return nil, err
 	}
 	var viewId string
@@ -91,7 +88,7 @@ func (r *Resolver) InsightViewDebug(ctx context.Context, args graphqlbackend.Ins
 		return nil, errors.Wrap(err, "error unmarshalling the insight view id")
 	}
 
-	// 🚨 SECURITY: This debug resolver is restricted to admins only so looking up the series does not check for the users authorization
+	// 🚨 SECURITY: This debug resolver is restricted to code insights admins only so looking up the series does not check for the users authorization
 	viewSeries, err := r.insightStore.Get(ctx, store.InsightQueryArgs{UniqueID: viewId, WithoutAuthorization: true})
 	if err != nil {
 		return nil, err
@@ -441,12 +441,12 @@ func canAggregateByCaptureGroup(searchQuery, patternType string) (bool, *notAvai
 	if err != nil {
 		return false, &notAvailableReason{reason: cgInvalidQueryMsg, reasonType: types.INVALID_AGGREGATION_MODE_FOR_QUERY}, err
 	}
-	if !(searchType == query.SearchTypeRegex || searchType == query.SearchTypeStandard || searchType == query.SearchTypeLucky) {
+	if !(searchType == query.SearchTypeRegex || searchType == query.SearchTypeStandard || searchType == query.SearchTypeLucky || searchType == query.SearchTypeStructural) {
 		return false, &notAvailableReason{reason: cgInvalidQueryMsg, reasonType: types.INVALID_AGGREGATION_MODE_FOR_QUERY}, nil
 	}
 
-	// A query should contain at least a regexp pattern and capture group to allow capture group aggregation.
-	// Only the first capture group will be used for aggregation.
+	// A query should contain at least a regexp pattern and capture group, or a structural pattern and named hole,
+	// to allow capture group aggregation. Only the first capture group or hole will be used for aggregation.
 	replacer, err := querybuilder.NewPatternReplacer(querybuilder.BasicQuery(searchQuery), searchType)
 	if errors.Is(err, querybuilder.UnsupportedPatternTypeErr) {
 		return false, &notAvailableReason{reason: cgInvalidQueryMsg, reasonType: types.INVALID_AGGREGATION_MODE_FOR_QUERY}, nil
@@ -304,6 +304,19 @@ func Test_canAggregateByCaptureGroup(t *testing.T) {
 			reason:       fmt.Sprintf(cgUnsupportedSelectFmt, "select", "commit"),
 			canAggregate: false,
 		},
+		{
+			name:         "can aggregate for structural query with named hole",
+			query:        "log.Fatal(:[x])",
+			patternType:  "structural",
+			canAggregate: true,
+		},
+		{
+			name:         "cannot aggregate for structural query without named holes",
+			query:        "log.Fatal(:[_]) patterntype:structural",
+			patternType:  "standard",
+			reason:       cgInvalidQueryMsg,
+			canAggregate: false,
+		},
 	}
 	suite := canAggregateBySuite{
 		canAggregateByFunc: canAggregateByCaptureGroup,
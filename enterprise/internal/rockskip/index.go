@@ -46,6 +46,20 @@ func (s *Service) Index(ctx context.Context, repo, givenCommit string) (err erro
 		return errors.Wrapf(err, "failed to get repo id for %s", repo)
 	}
 
+	// Symbols indexed before their kind, language and parent were stored can't be updated
+	// incrementally, so index the repo again from scratch.
+	tasklog.Start("check symbols without kind")
+	withoutKind, err := hasSymbolsWithoutKind(ctx, conn, repoId)
+	if err != nil {
+		return err
+	}
+	if withoutKind {
+		tasklog.Start("delete symbols without kind")
+		if err := deleteRepoSymbols(ctx, conn, repoId); err != nil {
+			return errors.Wrapf(err, "failed to delete symbols without kind for %s", repo)
+		}
+	}
+
 	missingCount := 0
 	tasklog.Start("RevList")
 	err = s.git.RevList(ctx, repo, givenCommit, func(commitHash string) (shouldContinue bool, err error) {
@@ -134,12 +148,12 @@ func (s *Service) Index(ctx context.Context, repo, givenCommit string) (err erro
 			}
 		}
 
-		symbolsFromDeletedFiles := map[string]*goset.Set[string]{}
+		symbolsFromDeletedFiles := map[string]*goset.Set[SymbolKey]{}
 		{
 			// Fill from the cache.
 			for _, path := range deletedPaths {
 				if symbols, ok := pathSymbolsCache.Get(path); ok {
-					symbolsFromDeletedFiles[path] = symbols.(*goset.Set[string])
+					symbolsFromDeletedFiles[path] = symbols.(*goset.Set[SymbolKey])
 				}
 			}
 
@@ -161,7 +175,7 @@ func (s *Service) Index(ctx context.Context, repo, givenCommit string) (err erro
 			}
 		}
 
-		symbolsFromAddedFiles := map[string]*goset.Set[string]{}
+		symbolsFromAddedFiles := map[string]*goset.Set[SymbolKey]{}
 		{
 			tasklog.Start("ArchiveEach")
 			err = archiveEach(ctx, s.fetcher, repo, entry.Commit, addedPaths, func(path string, contents []byte) error {
@@ -173,9 +187,9 @@ func (s *Service) Index(ctx context.Context, repo, givenCommit string) (err erro
 					return errors.Wrap(err, "parse")
 				}
 
-				symbolsFromAddedFiles[path] = goset.NewSet[string]()
+				symbolsFromAddedFiles[path] = goset.NewSet[SymbolKey]()
 				for _, symbol := range symbols {
-					symbolsFromAddedFiles[path].Add(symbol.Name)
+					symbolsFromAddedFiles[path].Add(newSymbolKey(symbol))
 				}
 
 				// Cache the symbols we just parsed.
@@ -191,16 +205,16 @@ func (s *Service) Index(ctx context.Context, repo, givenCommit string) (err erro
 		}
 
 		// Compute the symmetric difference of symbols between the added and deleted paths.
-		deletedSymbols := map[string]*goset.Set[string]{}
-		addedSymbols := map[string]*goset.Set[string]{}
+		deletedSymbols := map[string]*goset.Set[SymbolKey]{}
+		addedSymbols := map[string]*goset.Set[SymbolKey]{}
 		for _, pathStatus := range entry.PathStatuses {
 			deleted := symbolsFromDeletedFiles[pathStatus.Path]
 			if deleted == nil {
-				deleted = goset.NewSet[string]()
+				deleted = goset.NewSet[SymbolKey]()
 			}
 			added := symbolsFromAddedFiles[pathStatus.Path]
 			if added == nil {
-				added = goset.NewSet[string]()
+				added = goset.NewSet[SymbolKey]()
 			}
 			switch pathStatus.Status {
 			case gitdomain.DeletedAMD:
@@ -235,7 +249,7 @@ func (s *Service) Index(ctx context.Context, repo, givenCommit string) (err erro
 						// determined by the file itself:
 						//
 						// https://github.com/universal-ctags/ctags/pull/3300
-						log15.Error("Could not find symbol that was supposedly deleted", "repo", repo, "commit", commit, "path", path, "symbol", symbol)
+						log15.Error("Could not find symbol that was supposedly deleted", "repo", repo, "commit", commit, "path", path, "symbol", symbol.Name, "kind", symbol.Kind, "parent", symbol.Parent)
 						continue
 					}
 				}
@@ -280,11 +294,11 @@ func (s *Service) Index(ctx context.Context, repo, givenCommit string) (err erro
 	return nil
 }
 
-func BatchInsertSymbols(ctx context.Context, tasklog *TaskLog, tx *sql.Tx, repoId, commit int, symbolCache *lru.Cache, symbols map[string]*goset.Set[string]) error {
+func BatchInsertSymbols(ctx context.Context, tasklog *TaskLog, tx *sql.Tx, repoId, commit int, symbolCache *lru.Cache, symbols map[string]*goset.Set[SymbolKey]) error {
 	callback := func(inserter *batch.Inserter) error {
 		for path, pathSymbols := range symbols {
 			for _, symbol := range pathSymbols.Items() {
-				if err := inserter.Insert(ctx, pg.Array([]int{commit}), pg.Array([]int{}), repoId, path, symbol); err != nil {
+				if err := inserter.Insert(ctx, pg.Array([]int{commit}), pg.Array([]int{}), repoId, path, symbol.Name, symbol.Kind, symbol.Language, symbol.Parent); err != nil {
 					return err
 				}
 			}
@@ -295,9 +309,9 @@ func BatchInsertSymbols(ctx context.Context, tasklog *TaskLog, tx *sql.Tx, repoI
 
 	returningScanner := func(rows dbutil.Scanner) error {
 		var path string
-		var symbol string
+		var symbol SymbolKey
 		var id int
-		if err := rows.Scan(&path, &symbol, &id); err != nil {
+		if err := rows.Scan(&path, &symbol.Name, &symbol.Kind, &symbol.Language, &symbol.Parent, &id); err != nil {
 			return err
 		}
 		symbolCache.Add(pathSymbol{path: path, symbol: symbol}, id)
@@ -309,9 +323,9 @@ func BatchInsertSymbols(ctx context.Context, tasklog *TaskLog, tx *sql.Tx, repoI
 		tx,
 		"rockskip_symbols",
 		batch.MaxNumPostgresParameters,
-		[]string{"added", "deleted", "repo_id", "path", "name"},
+		[]string{"added", "deleted", "repo_id", "path", "name", "kind", "language", "parent"},
 		"",
-		[]string{"path", "name", "id"},
+		[]string{"path", "name", "kind", "language", "parent", "id"},
 		returningScanner,
 		callback,
 	)
@@ -329,5 +343,5 @@ type indexRequest struct {
 
 type pathSymbol struct {
 	path   string
-	symbol string
+	symbol SymbolKey
 }
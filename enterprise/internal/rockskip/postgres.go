@@ -8,6 +8,7 @@ import (
 	"github.com/amit7itz/goset"
 	pg "github.com/lib/pq"
 	"github.com/segmentio/fasthash/fnv1"
+	"github.com/sourcegraph/go-ctags"
 
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
@@ -60,7 +61,19 @@ func InsertCommit(ctx context.Context, db dbutil.DB, repoId int, commitHash stri
 	return id, errors.Wrap(err, "InsertCommit")
 }
 
-func GetSymbol(ctx context.Context, db dbutil.DB, repoId int, path string, name string, hops []CommitId) (id int, found bool, err error) {
+// SymbolKey identifies a symbol within a file.
+type SymbolKey struct {
+	Name     string
+	Kind     string
+	Language string
+	Parent   string
+}
+
+func newSymbolKey(entry *ctags.Entry) SymbolKey {
+	return SymbolKey{Name: entry.Name, Kind: entry.Kind, Language: entry.Language, Parent: entry.Parent}
+}
+
+func GetSymbol(ctx context.Context, db dbutil.DB, repoId int, path string, symbol SymbolKey, hops []CommitId) (id int, found bool, err error) {
 	err = db.QueryRowContext(ctx, `
 		SELECT id
 		FROM rockskip_symbols
@@ -68,9 +81,12 @@ func GetSymbol(ctx context.Context, db dbutil.DB, repoId int, path string, name
 			repo_id = $1 AND
 			path = $2 AND
 			name = $3 AND
-		    $4 && added AND
-			NOT $4 && deleted
-	`, repoId, path, name, pg.Array(hops)).Scan(&id)
+			kind = $4 AND
+			language = $5 AND
+			parent = $6 AND
+		    $7 && added AND
+			NOT $7 && deleted
+	`, repoId, path, symbol.Name, symbol.Kind, symbol.Language, symbol.Parent, pg.Array(hops)).Scan(&id)
 	if err == sql.ErrNoRows {
 		return 0, false, nil
 	} else if err != nil {
@@ -79,12 +95,12 @@ func GetSymbol(ctx context.Context, db dbutil.DB, repoId int, path string, name
 	return id, true, nil
 }
 
-func GetSymbolsInFiles(ctx context.Context, db dbutil.DB, repoId int, paths []string, hops []CommitId) (map[string]*goset.Set[string], error) {
-	pathToSymbols := map[string]*goset.Set[string]{}
+func GetSymbolsInFiles(ctx context.Context, db dbutil.DB, repoId int, paths []string, hops []CommitId) (map[string]*goset.Set[SymbolKey], error) {
+	pathToSymbols := map[string]*goset.Set[SymbolKey]{}
 
 	for _, chunk := range chunksOf(paths, 1000) {
 		rows, err := db.QueryContext(ctx, `
-			SELECT name, path
+			SELECT name, kind, language, parent, path
 			FROM rockskip_symbols
 			WHERE
 				repo_id = $1 AND
@@ -96,15 +112,15 @@ func GetSymbolsInFiles(ctx context.Context, db dbutil.DB, repoId int, paths []st
 			return nil, errors.Newf("GetSymbolsInFiles: %s", err)
 		}
 		for rows.Next() {
-			var name string
+			var symbol SymbolKey
 			var path string
-			if err := rows.Scan(&name, &path); err != nil {
+			if err := rows.Scan(&symbol.Name, &symbol.Kind, &symbol.Language, &symbol.Parent, &path); err != nil {
 				return nil, errors.Newf("GetSymbolsInFiles: %s", err)
 			}
 			if pathToSymbols[path] == nil {
-				pathToSymbols[path] = goset.NewSet[string]()
+				pathToSymbols[path] = goset.NewSet[SymbolKey]()
 			}
-			pathToSymbols[path].Add(name)
+			pathToSymbols[path].Add(symbol)
 		}
 		err = rows.Close()
 		if err != nil {
@@ -125,12 +141,12 @@ func UpdateSymbolHops(ctx context.Context, db dbutil.DB, id int, status StatusAD
 	return errors.Wrap(err, "UpdateSymbolHops")
 }
 
-func InsertSymbol(ctx context.Context, db dbutil.DB, hop CommitId, repoId int, path string, name string) (id int, err error) {
+func InsertSymbol(ctx context.Context, db dbutil.DB, hop CommitId, repoId int, path string, symbol SymbolKey) (id int, err error) {
 	err = db.QueryRowContext(ctx, `
-		INSERT INTO rockskip_symbols (added, deleted, repo_id, path, name)
-		                      VALUES ($1   , $2     , $3     , $4  , $5  )
+		INSERT INTO rockskip_symbols (added, deleted, repo_id, path, name, kind, language, parent)
+		                      VALUES ($1   , $2     , $3     , $4  , $5  , $6  , $7      , $8    )
 		RETURNING id
-	`, pg.Array([]int{hop}), pg.Array([]int{}), repoId, path, name).Scan(&id)
+	`, pg.Array([]int{hop}), pg.Array([]int{}), repoId, path, symbol.Name, symbol.Kind, symbol.Language, symbol.Parent).Scan(&id)
 	return id, errors.Wrap(err, "InsertSymbol")
 }
 
@@ -246,6 +262,38 @@ func tryDeleteOldestRepo(ctx context.Context, db *sql.Conn, maxRepos int, thread
 	return true, nil
 }
 
+// hasSymbolsWithoutKind returns true if the repo has symbols that were indexed before the kind,
+// language and parent of symbols were stored.
+func hasSymbolsWithoutKind(ctx context.Context, db dbutil.DB, repoId int) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1
+			FROM rockskip_symbols
+			WHERE repo_id = $1 AND kind IS NULL
+		)
+	`, repoId).Scan(&exists)
+	return exists, errors.Wrap(err, "hasSymbolsWithoutKind")
+}
+
+// deleteRepoSymbols deletes the indexed commits and symbols of the repo, but keeps the repo
+// itself so that it's indexed again from scratch.
+func deleteRepoSymbols(ctx context.Context, db *sql.Conn, repoId int) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM rockskip_ancestry WHERE repo_id = $1;", repoId); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM rockskip_symbols WHERE repo_id = $1;", repoId); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
 func PrintInternals(ctx context.Context, db dbutil.DB) error {
 	fmt.Println("Commit ancestry:")
 	fmt.Println()
@@ -277,7 +325,7 @@ func PrintInternals(ctx context.Context, db dbutil.DB) error {
 	fmt.Println()
 
 	rows, err = db.QueryContext(ctx, `
-		SELECT id, path, name, added, deleted
+		SELECT id, path, name, COALESCE(kind, ''), COALESCE(parent, ''), added, deleted
 		FROM rockskip_symbols
 		ORDER BY id ASC
 	`)
@@ -288,13 +336,13 @@ func PrintInternals(ctx context.Context, db dbutil.DB) error {
 	for rows.Next() {
 		var id int
 		var path string
-		var name string
+		var name, kind, parent string
 		var added, deleted []int64
-		err = rows.Scan(&id, &path, &name, pg.Array(&added), pg.Array(&deleted))
+		err = rows.Scan(&id, &path, &name, &kind, &parent, pg.Array(&added), pg.Array(&deleted))
 		if err != nil {
 			return errors.Wrap(err, "PrintInternals: Scan")
 		}
-		fmt.Printf("  id %d path %-10s symbol %s\n", id, path, name)
+		fmt.Printf("  id %d path %-10s symbol %s kind %s parent %s\n", id, path, name, kind, parent)
 		for _, a := range added {
 			hash, _, _, _, err := GetCommitById(ctx, db, int(a))
 			if err != nil {
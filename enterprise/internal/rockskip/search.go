@@ -15,6 +15,7 @@ import (
 	"github.com/keegancsmith/sqlf"
 	pg "github.com/lib/pq"
 	"github.com/segmentio/fasthash/fnv1"
+	"github.com/sourcegraph/go-ctags"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
@@ -80,7 +81,18 @@ func (s *Service) Search(ctx context.Context, args search.SymbolsParameters) (_
 	commit, _, present, err := GetCommitByHash(ctx, s.db, repoId, commitHash)
 	if err != nil {
 		return nil, err
-	} else if !present {
+	}
+	if present {
+		// Symbols indexed before their kind and container were stored don't match kind or
+		// container filters, so treat the commit as missing to index the repo again.
+		threadStatus.Tasklog.Start("check symbols without kind")
+		withoutKind, err := hasSymbolsWithoutKind(ctx, s.db, repoId)
+		if err != nil {
+			return nil, err
+		}
+		present = !withoutKind
+	}
+	if !present {
 		// Try to send an index request.
 		done, err := s.emitIndexRequest(repoCommit{repo: repo, commit: commitHash})
 		if err != nil {
@@ -167,6 +179,33 @@ func mkIsMatch(args search.SymbolsParameters) (func(string) bool, error) {
 	}
 }
 
+// mkIsKindAndContainerMatch returns a predicate that checks a parsed symbol
+// against the kinds and container pattern of args. Paths are selected by
+// symbol, so the other symbols in those files need to be filtered again.
+func mkIsKindAndContainerMatch(args search.SymbolsParameters) (func(*ctags.Entry) bool, error) {
+	kinds := goset.NewSet(lowerAll(args.IncludeKinds)...)
+
+	var container *regexp.Regexp
+	if args.ContainerPattern != "" {
+		expr := args.ContainerPattern
+		if !args.IsCaseSensitive {
+			expr = "(?i:" + expr + ")"
+		}
+		var err error
+		container, err = regexp.Compile(expr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return func(symbol *ctags.Entry) bool {
+		if kinds.Len() > 0 && !kinds.Contains(strings.ToLower(symbol.Kind)) {
+			return false
+		}
+		return container == nil || container.MatchString(symbol.Parent)
+	}, nil
+}
+
 func (s *Service) emitIndexRequest(rc repoCommit) (chan struct{}, error) {
 	key := fmt.Sprintf("%s@%s", rc.repo, rc.commit)
 
@@ -254,6 +293,11 @@ func (s *Service) querySymbols(ctx context.Context, args search.SymbolsParameter
 		return nil, err
 	}
 
+	isKindAndContainerMatch, err := mkIsKindAndContainerMatch(args)
+	if err != nil {
+		return nil, err
+	}
+
 	paths := goset.NewSet[string]()
 	for rows.Next() {
 		var path string
@@ -287,7 +331,7 @@ func (s *Service) querySymbols(ctx context.Context, args search.SymbolsParameter
 		lines := strings.Split(string(contents), "\n")
 
 		for _, symbol := range allSymbols {
-			if isMatch(symbol.Name) {
+			if isMatch(symbol.Name) && isKindAndContainerMatch(symbol) {
 				if symbol.Line < 1 || symbol.Line > len(lines) {
 					log15.Warn("ctags returned an invalid line number", "path", path, "line", symbol.Line, "len(lines)", len(lines), "symbol", symbol.Name)
 					continue
@@ -437,6 +481,14 @@ func convertSearchArgsToSqlQuery(args search.SymbolsParameters) *sqlf.Query {
 	// ExcludePattern
 	conjunctOrNils = append(conjunctOrNils, negate(regexMatch(pathConditions, args.ExcludePattern, args.IsCaseSensitive)))
 
+	// IncludeKinds
+	if len(args.IncludeKinds) > 0 {
+		conjunctOrNils = append(conjunctOrNils, sqlf.Sprintf("%s && singleton(lower(kind))", pg.Array(lowerAll(args.IncludeKinds))))
+	}
+
+	// ContainerPattern
+	conjunctOrNils = append(conjunctOrNils, regexMatch(parentConditions, args.ContainerPattern, args.IsCaseSensitive))
+
 	// Drop nils
 	conjuncts := []*sqlf.Query{}
 	for _, condition := range conjunctOrNils {
@@ -502,6 +554,19 @@ var pathConditions = Conditions{
 	},
 }
 
+var parentConditions = Conditions{
+	regex:  func(v string) *sqlf.Query { return sqlf.Sprintf("parent ~ %s", v) },
+	regexI: func(v string) *sqlf.Query { return sqlf.Sprintf("parent ~* %s", v) },
+	exact:  func(v string) *sqlf.Query { return sqlf.Sprintf("ARRAY[%s] && singleton(parent)", v) },
+	exactI: func(v string) *sqlf.Query {
+		return sqlf.Sprintf("ARRAY[%s] && singleton(lower(parent))", strings.ToLower(v))
+	},
+	prefix:   nil,
+	prefixI:  nil,
+	fileExt:  nil,
+	fileExtI: nil,
+}
+
 func lowerAll(strs []string) []string {
 	lowers := []string{}
 	for _, s := range strs {
@@ -4,6 +4,11 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/keegancsmith/sqlf"
+	pg "github.com/lib/pq"
+	"github.com/sourcegraph/go-ctags"
+
+	"github.com/sourcegraph/sourcegraph/internal/search"
 )
 
 func TestIsFileExtensionMatch(t *testing.T) {
@@ -68,3 +73,63 @@ func TestIsLiteralPrefix(t *testing.T) {
 		}
 	}
 }
+
+func TestIsKindAndContainerMatch(t *testing.T) {
+	method := &ctags.Entry{Name: "Search", Kind: "method", Parent: "Service"}
+	function := &ctags.Entry{Name: "NewService", Kind: "function"}
+
+	tests := []struct {
+		args search.SymbolsParameters
+		want []bool
+	}{
+		{
+			args: search.SymbolsParameters{},
+			want: []bool{true, true},
+		},
+		{
+			args: search.SymbolsParameters{IncludeKinds: []string{"Function"}},
+			want: []bool{false, true},
+		},
+		{
+			args: search.SymbolsParameters{ContainerPattern: "^service$"},
+			want: []bool{true, false},
+		},
+		{
+			args: search.SymbolsParameters{ContainerPattern: "^service$", IsCaseSensitive: true},
+			want: []bool{false, false},
+		},
+		{
+			args: search.SymbolsParameters{IncludeKinds: []string{"function"}, ContainerPattern: "Service"},
+			want: []bool{false, false},
+		},
+	}
+
+	for _, test := range tests {
+		isMatch, err := mkIsKindAndContainerMatch(test.args)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got := []bool{isMatch(method), isMatch(function)}
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("mkIsKindAndContainerMatch(%+v) mismatch (-want +got):\n%s", test.args, diff)
+		}
+	}
+}
+
+func TestConvertSearchArgsToSqlQuery(t *testing.T) {
+	q := convertSearchArgsToSqlQuery(search.SymbolsParameters{
+		Query:            "^Search$",
+		IncludeKinds:     []string{"Method"},
+		ContainerPattern: "Serv",
+		IsCaseSensitive:  true,
+	})
+
+	want := "ARRAY[$1] && singleton(name) AND $2 && singleton(lower(kind)) AND parent ~ $3"
+	if got := q.Query(sqlf.PostgresBindVar); got != want {
+		t.Errorf("unexpected query. want=%q got=%q", want, got)
+	}
+	if diff := cmp.Diff([]any{"Search", pg.Array([]string{"method"}), "Serv"}, q.Args()); diff != "" {
+		t.Errorf("unexpected args (-want +got):\n%s", diff)
+	}
+}
@@ -188,6 +188,22 @@ func TestIndex(t *testing.T) {
 
 	rm("a.txt")
 	commit("rm a.txt")
+
+	// Symbols indexed before their kind and parent were stored are indexed again.
+	_, err = db.Exec("UPDATE rockskip_symbols SET kind = NULL, language = NULL, parent = NULL")
+	fatalIfError(err, "UPDATE rockskip_symbols")
+
+	add("d.txt", "sym3\n")
+	commit("add a file after the upgrade")
+
+	var repoId int
+	err = db.QueryRow("SELECT id FROM rockskip_repos WHERE repo = 'somerepo'").Scan(&repoId)
+	fatalIfError(err, "SELECT rockskip_repos")
+	withoutKind, err := hasSymbolsWithoutKind(context.Background(), db, repoId)
+	fatalIfError(err, "hasSymbolsWithoutKind")
+	if withoutKind {
+		t.Fatal("expected the repo to be indexed again")
+	}
 }
 
 type SubprocessGit struct {
@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/rbac"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// MissingPermissionError is returned when a user is neither a site admin nor
+// holds a role granting the required permission.
+type MissingPermissionError struct {
+	Permission rbac.Permission
+}
+
+func (e *MissingPermissionError) Error() string {
+	return fmt.Sprintf("must be site admin or have the %s permission", e.Permission)
+}
+
+func (e *MissingPermissionError) Unauthorized() bool { return true }
+
+// CheckCurrentUserHasPermission returns an error if the current user is NOT a
+// site admin and has NOT been granted the given permission through any of
+// their roles.
+func CheckCurrentUserHasPermission(ctx context.Context, db database.DB, perm rbac.Permission) error {
+	if actor.FromContext(ctx).IsInternal() {
+		return nil
+	}
+	user, err := CurrentUser(ctx, db)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return ErrNotAuthenticated
+	}
+	return checkUserHasPermission(ctx, db, user, perm)
+}
+
+// CheckUserHasPermission returns an error if the user is NOT a site admin and
+// has NOT been granted the given permission through any of their roles.
+func CheckUserHasPermission(ctx context.Context, db database.DB, userID int32, perm rbac.Permission) error {
+	if actor.FromContext(ctx).IsInternal() {
+		return nil
+	}
+	user, err := db.Users().GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return ErrNotAuthenticated
+	}
+	return checkUserHasPermission(ctx, db, user, perm)
+}
+
+func checkUserHasPermission(ctx context.Context, db database.DB, user *types.User, perm rbac.Permission) error {
+	if user.SiteAdmin {
+		return nil
+	}
+	ok, err := db.Permissions().HasPermission(ctx, user.ID, perm)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return &MissingPermissionError{Permission: perm}
+	}
+	return nil
+}
+
+// CheckPermissionOrSameUser returns an error if the user is NEITHER (1) a
+// site admin or holding the given permission NOR (2) the user specified by
+// subjectUserID.
+//
+// It is the counterpart of CheckSiteAdminOrSameUser for actions that site
+// admins can delegate to other users by granting them a permission.
+//
+// Returns an error without the name of the given user.
+func CheckPermissionOrSameUser(ctx context.Context, db database.DB, subjectUserID int32, perm rbac.Permission) error {
+	a := actor.FromContext(ctx)
+	if a.IsInternal() || (a.IsAuthenticated() && a.UID == subjectUserID) {
+		return nil
+	}
+	permErr := CheckCurrentUserHasPermission(ctx, db, perm)
+	if permErr == nil {
+		return nil
+	}
+	_, err := db.Users().GetByID(ctx, subjectUserID)
+	if err != nil {
+		return &InsufficientAuthorizationError{fmt.Sprintf("must be authenticated as an admin (%s)", permErr.Error())}
+	}
+	return &InsufficientAuthorizationError{fmt.Sprintf("must be authenticated as the authorized user or as an admin (%s)", permErr.Error())}
+}
@@ -0,0 +1,265 @@
+// Package codeowners parses CODEOWNERS files and resolves the owners of paths
+// in a repository.
+//
+// Both the GitHub and the GitLab flavours of the format are supported. GitHub
+// files are a flat list of rules where the last matching rule wins. GitLab
+// additionally groups rules into sections (`[Section]` or `^[Optional
+// section]`), where every section is evaluated independently and the owners of
+// all matching sections are combined. Sections may declare default owners that
+// apply to rules in that section which do not list owners themselves.
+package codeowners
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"github.com/grafana/regexp"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Rule is a single pattern entry of a CODEOWNERS file.
+type Rule struct {
+	// Pattern is the gitignore-style pattern as it appears in the file.
+	Pattern string
+
+	// Owners are the owners listed for the pattern, in file order. An empty
+	// list means the matched paths are explicitly unowned.
+	Owners []string
+
+	// Section is the lower-cased name of the GitLab section the rule belongs
+	// to. It is empty for rules outside a section and for GitHub files.
+	Section string
+
+	// LineNumber is the 1-based line number of the rule in the file.
+	LineNumber int
+
+	re *regexp.Regexp
+}
+
+// Match returns true if the rule pattern matches the given repository path.
+func (r *Rule) Match(path string) bool {
+	return r.re.MatchString(strings.TrimPrefix(path, "/"))
+}
+
+// Ruleset is a parsed CODEOWNERS file.
+type Ruleset struct {
+	Rules []*Rule
+}
+
+// Parse parses the contents of a CODEOWNERS file.
+func Parse(r io.Reader) (*Ruleset, error) {
+	var (
+		rs      Ruleset
+		section string
+		lineNum int
+
+		// sectionDefaults are the default owners per section. A section can
+		// be declared multiple times, in which case a header without owners
+		// keeps the defaults of the earlier declaration.
+		sectionDefaults = map[string][]string{}
+	)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(stripComment(scanner.Text()))
+		if line == "" {
+			continue
+		}
+
+		if name, owners, ok := parseSectionHeader(line); ok {
+			section = strings.ToLower(name)
+			if len(owners) > 0 {
+				sectionDefaults[section] = owners
+			}
+			continue
+		}
+
+		fields := splitFields(line)
+		pattern, owners := fields[0], fields[1:]
+		if len(owners) == 0 {
+			owners = sectionDefaults[section]
+		}
+
+		re, err := compilePattern(pattern)
+		if err != nil {
+			return nil, errors.Wrapf(err, "line %d: invalid pattern %q", lineNum, pattern)
+		}
+
+		rs.Rules = append(rs.Rules, &Rule{
+			Pattern:    pattern,
+			Owners:     owners,
+			Section:    section,
+			LineNumber: lineNum,
+			re:         re,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return &rs, nil
+}
+
+// Match returns the rules that determine the ownership of the given path: the
+// last matching rule of every section, in the order in which the sections
+// first appear in the file.
+func (rs *Ruleset) Match(path string) []*Rule {
+	var (
+		sections []string
+		matched  = map[string]*Rule{}
+	)
+	for _, rule := range rs.Rules {
+		if !rule.Match(path) {
+			continue
+		}
+		if _, ok := matched[rule.Section]; !ok {
+			sections = append(sections, rule.Section)
+		}
+		matched[rule.Section] = rule
+	}
+
+	rules := make([]*Rule, 0, len(sections))
+	for _, section := range sections {
+		rules = append(rules, matched[section])
+	}
+	return rules
+}
+
+// Owners returns the deduplicated owners of the given path. It returns nil if
+// the path is unowned.
+func (rs *Ruleset) Owners(path string) []string {
+	var owners []string
+	seen := map[string]struct{}{}
+	for _, rule := range rs.Match(path) {
+		for _, owner := range rule.Owners {
+			key := NormalizeOwner(owner)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			owners = append(owners, owner)
+		}
+	}
+	return owners
+}
+
+// NormalizeOwner returns the canonical form of an owner reference that is
+// used for comparisons: the leading "@" is removed and the result is
+// lower-cased, so that "@Org/Team" and "org/team" refer to the same owner.
+func NormalizeOwner(owner string) string {
+	return strings.ToLower(strings.TrimPrefix(owner, "@"))
+}
+
+// stripComment removes a trailing comment from a line. A "#" that is escaped
+// with a backslash does not start a comment.
+func stripComment(line string) string {
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '#':
+			return line[:i]
+		}
+	}
+	return line
+}
+
+// splitFields splits a rule line on unescaped whitespace.
+func splitFields(line string) []string {
+	var (
+		fields []string
+		cur    strings.Builder
+	)
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case c == '\\' && i+1 < len(line) && line[i+1] == ' ':
+			cur.WriteString(`\ `)
+			i++
+		case c == ' ' || c == '\t':
+			if cur.Len() > 0 {
+				fields = append(fields, cur.String())
+				cur.Reset()
+			}
+		default:
+			cur.WriteByte(c)
+		}
+	}
+	if cur.Len() > 0 {
+		fields = append(fields, cur.String())
+	}
+	return fields
+}
+
+var sectionHeaderPattern = regexp.MustCompile(`^\^?\[([^\]]+)\](?:\[\d+\])?(.*)$`)
+
+// parseSectionHeader parses a GitLab section header such as
+// `^[Documentation][2] @docs-team`.
+func parseSectionHeader(line string) (name string, owners []string, ok bool) {
+	match := sectionHeaderPattern.FindStringSubmatch(line)
+	if match == nil {
+		return "", nil, false
+	}
+	return strings.TrimSpace(match[1]), strings.Fields(match[2]), true
+}
+
+// compilePattern translates a gitignore-style CODEOWNERS pattern into a
+// regular expression matching repository paths (without leading slash).
+//
+// A pattern that matches a directory also matches everything below it, with
+// the exception of patterns ending in "/*", which only match the direct
+// children of a directory. A pattern without a slash (other than a trailing
+// one) matches at any depth, all other patterns are relative to the
+// repository root.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	p := pattern
+	anchored := strings.HasPrefix(p, "/")
+	p = strings.TrimPrefix(p, "/")
+	dirOnly := strings.HasSuffix(p, "/")
+	p = strings.TrimSuffix(p, "/")
+	if p == "" {
+		return nil, errors.New("empty pattern")
+	}
+	if strings.Contains(p, "/") {
+		anchored = true
+	}
+
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored {
+		b.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(p); i++ {
+		segmentStart := i == 0 || p[i-1] == '/'
+		switch c := p[i]; {
+		case c == '\\' && i+1 < len(p):
+			i++
+			b.WriteString(regexp.QuoteMeta(p[i : i+1]))
+		case segmentStart && strings.HasPrefix(p[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case segmentStart && p[i:] == "**":
+			b.WriteString(".*")
+			i++
+		case c == '*':
+			b.WriteString("[^/]*")
+		case c == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(p[i : i+1]))
+		}
+	}
+	switch {
+	case dirOnly:
+		b.WriteString("/.*$")
+	case strings.HasSuffix(p, "/*") && !strings.HasSuffix(p, `\*`):
+		b.WriteString("$")
+	default:
+		b.WriteString("(?:/.*)?$")
+	}
+
+	return regexp.Compile(b.String())
+}
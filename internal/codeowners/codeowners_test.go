@@ -0,0 +1,173 @@
+package codeowners
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse_GitHub(t *testing.T) {
+	rs, err := Parse(strings.NewReader(`
+# Default owners for everything in the repo.
+*       @global-owner1 @global-owner2
+
+# Order is important; the last matching pattern takes the most precedence.
+*.js    @js-owner #This is an inline comment.
+*.go    docs@example.com
+
+/build/logs/ @doctocat
+docs/*  docs@example.com
+apps/   @octocat
+/scripts/ @doctocat @octocat
+**/logs @octocat
+/apps/github
+\#file_with_pound.rb @ruby-owner
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{"README.md", []string{"@global-owner1", "@global-owner2"}},
+		{"client/index.js", []string{"@js-owner"}},
+		{"cmd/main.go", []string{"docs@example.com"}},
+		{"build/logs/today.log", []string{"@octocat"}},
+		{"build/logs", []string{"@octocat"}},
+		{"docs/getting-started.md", []string{"docs@example.com"}},
+		{"docs/build-app/troubleshooting.md", []string{"@global-owner1", "@global-owner2"}},
+		{"apps/main.c", []string{"@octocat"}},
+		{"nested/apps/main.c", []string{"@octocat"}},
+		{"apps/github/main.c", nil},
+		{"scripts/build.sh", []string{"@doctocat", "@octocat"}},
+		{"deeply/nested/logs/app.log", []string{"@octocat"}},
+		{"#file_with_pound.rb", []string{"@ruby-owner"}},
+		{"/client/index.js", []string{"@js-owner"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, rs.Owners(tc.path)); diff != "" {
+				t.Errorf("unexpected owners (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParse_GitLabSections(t *testing.T) {
+	rs, err := Parse(strings.NewReader(`
+* @admins
+
+[Documentation] @docs-team
+docs/
+README.md @readme-owner
+
+^[Go][2] @go-team
+*.go
+internal/vendored/*.go @vendor-owner
+
+[documentation]
+*.md
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{"Makefile", []string{"@admins"}},
+		{"docs/index.md", []string{"@admins", "@docs-team"}},
+		{"README.md", []string{"@admins", "@docs-team"}},
+		{"cmd/main.go", []string{"@admins", "@go-team"}},
+		{"internal/vendored/lib.go", []string{"@admins", "@vendor-owner"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, rs.Owners(tc.path)); diff != "" {
+				t.Errorf("unexpected owners (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	rules := rs.Match("README.md")
+	if len(rules) != 2 {
+		t.Fatalf("expected 2 matching rules, got %d", len(rules))
+	}
+	if have, want := rules[1].LineNumber, 13; have != want {
+		t.Errorf("expected rule from line %d to win, got line %d", want, have)
+	}
+}
+
+func TestCompilePattern(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		matches  []string
+		excludes []string
+	}{
+		{
+			pattern:  "*.go",
+			matches:  []string{"main.go", "a/b/main.go"},
+			excludes: []string{"main.golang", "go"},
+		},
+		{
+			pattern:  "/docs/*",
+			matches:  []string{"docs/a.md"},
+			excludes: []string{"docs/sub/a.md", "a/docs/a.md"},
+		},
+		{
+			pattern:  "docs/**/*.md",
+			matches:  []string{"docs/a.md", "docs/sub/dir/a.md"},
+			excludes: []string{"other/docs/a.md", "docs/a.txt"},
+		},
+		{
+			pattern:  "logs/",
+			matches:  []string{"logs/a", "a/logs/b"},
+			excludes: []string{"logs", "a/logs"},
+		},
+		{
+			pattern:  "src/**",
+			matches:  []string{"src/a", "src/a/b"},
+			excludes: []string{"a/src/b"},
+		},
+		{
+			pattern:  "file?.txt",
+			matches:  []string{"file1.txt"},
+			excludes: []string{"file10.txt", "file/.txt"},
+		},
+		{
+			pattern:  `path\ with\ spaces/`,
+			matches:  []string{"path with spaces/a"},
+			excludes: []string{"path/a"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.pattern, func(t *testing.T) {
+			re, err := compilePattern(tc.pattern)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, path := range tc.matches {
+				if !re.MatchString(path) {
+					t.Errorf("expected %q to match %q", tc.pattern, path)
+				}
+			}
+			for _, path := range tc.excludes {
+				if re.MatchString(path) {
+					t.Errorf("expected %q not to match %q", tc.pattern, path)
+				}
+			}
+		})
+	}
+}
+
+func TestNormalizeOwner(t *testing.T) {
+	for _, owner := range []string{"@Sourcegraph/Search", "sourcegraph/search", "@sourcegraph/search"} {
+		if have, want := NormalizeOwner(owner), "sourcegraph/search"; have != want {
+			t.Errorf("NormalizeOwner(%q) = %q, want %q", owner, have, want)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package codeowners
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"sync"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// filePaths are the locations in which we look for a CODEOWNERS file, in the
+// order of precedence used by GitHub, followed by the GitLab specific location.
+var filePaths = []string{
+	".github/CODEOWNERS",
+	"CODEOWNERS",
+	"docs/CODEOWNERS",
+	".gitlab/CODEOWNERS",
+}
+
+// Resolver resolves the owners of paths at a given commit by reading the
+// CODEOWNERS file of the repository through gitserver. Parsed rulesets are
+// cached per repository and commit for the lifetime of the resolver, so a
+// resolver is meant to be scoped to a single search or request.
+type Resolver struct {
+	client  gitserver.Client
+	checker authz.SubRepoPermissionChecker
+
+	mu       sync.Mutex
+	rulesets map[rulesetKey]*rulesetEntry
+}
+
+type rulesetKey struct {
+	repo   api.RepoName
+	commit api.CommitID
+}
+
+type rulesetEntry struct {
+	once    sync.Once
+	ruleset *Ruleset
+	err     error
+}
+
+// NewResolver returns a resolver that reads CODEOWNERS files with the given
+// gitserver client.
+func NewResolver(client gitserver.Client) *Resolver {
+	return &Resolver{
+		client:   client,
+		checker:  authz.DefaultSubRepoPermsChecker,
+		rulesets: map[rulesetKey]*rulesetEntry{},
+	}
+}
+
+// Ruleset returns the parsed CODEOWNERS file of the repository at the given
+// commit. It returns nil and no error if the repository has no CODEOWNERS
+// file.
+func (r *Resolver) Ruleset(ctx context.Context, repo api.RepoName, commit api.CommitID) (*Ruleset, error) {
+	key := rulesetKey{repo: repo, commit: commit}
+
+	r.mu.Lock()
+	entry, ok := r.rulesets[key]
+	if !ok {
+		entry = &rulesetEntry{}
+		r.rulesets[key] = entry
+	}
+	r.mu.Unlock()
+
+	entry.once.Do(func() {
+		entry.ruleset, entry.err = r.load(ctx, repo, commit)
+	})
+	return entry.ruleset, entry.err
+}
+
+// Owners returns the owners of path in the repository at the given commit.
+func (r *Resolver) Owners(ctx context.Context, repo api.RepoName, commit api.CommitID, path string) ([]string, error) {
+	rs, err := r.Ruleset(ctx, repo, commit)
+	if err != nil || rs == nil {
+		return nil, err
+	}
+	return rs.Owners(path), nil
+}
+
+func (r *Resolver) load(ctx context.Context, repo api.RepoName, commit api.CommitID) (*Ruleset, error) {
+	for _, path := range filePaths {
+		content, err := r.client.ReadFile(ctx, repo, commit, path, r.checker)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, errors.Wrapf(err, "reading %s", path)
+		}
+
+		rs, err := Parse(bytes.NewReader(content))
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing %s", path)
+		}
+		return rs, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,55 @@
+package codeowners
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+)
+
+func TestResolver(t *testing.T) {
+	client := gitserver.NewMockClient()
+	client.ReadFileFunc.SetDefaultHook(func(_ context.Context, repo api.RepoName, commit api.CommitID, name string, _ authz.SubRepoPermissionChecker) ([]byte, error) {
+		switch {
+		case repo == "github.com/sourcegraph/owned" && name == ".github/CODEOWNERS":
+			return []byte("*.go @sourcegraph/backend\n/client/ @sourcegraph/frontend\n"), nil
+		case repo == "gitlab.com/sourcegraph/owned" && name == ".gitlab/CODEOWNERS":
+			return []byte("[Docs] @docs\n*.md\n"), nil
+		}
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	})
+
+	ctx := context.Background()
+	r := NewResolver(client)
+
+	tests := []struct {
+		repo api.RepoName
+		path string
+		want []string
+	}{
+		{"github.com/sourcegraph/owned", "cmd/main.go", []string{"@sourcegraph/backend"}},
+		{"github.com/sourcegraph/owned", "client/web/index.ts", []string{"@sourcegraph/frontend"}},
+		{"github.com/sourcegraph/owned", "README.md", nil},
+		{"gitlab.com/sourcegraph/owned", "doc/index.md", []string{"@docs"}},
+		{"github.com/sourcegraph/unowned", "cmd/main.go", nil},
+	}
+	for _, tc := range tests {
+		owners, err := r.Owners(ctx, tc.repo, "deadbeef", tc.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(tc.want, owners); diff != "" {
+			t.Errorf("unexpected owners for %s:%s (-want +got):\n%s", tc.repo, tc.path, diff)
+		}
+	}
+
+	// Every candidate location is read at most once per repository and commit.
+	if have, want := len(client.ReadFileFunc.History()), 1+4+4; have != want {
+		t.Errorf("unexpected number of ReadFile calls. want=%d have=%d", want, have)
+	}
+}
@@ -251,3 +251,23 @@ func runWithoutPipes(ctx context.Context, args Args, b *bytes.Buffer) (err error
 
 	return nil
 }
+
+func TestMatcher(t *testing.T) {
+	cases := []struct {
+		languages []string
+		path      string
+		want      string
+	}{
+		{languages: nil, path: "main.go", want: ".go"},
+		{languages: nil, path: "README", want: ".generic"},
+		{languages: nil, path: "image.png", want: ".generic"},
+		{languages: []string{"Python"}, path: "main.go", want: ".py"},
+		{languages: []string{"cobol"}, path: "main.go", want: ".generic"},
+	}
+
+	for _, tc := range cases {
+		if got := Matcher(tc.languages, tc.path); got != tc.want {
+			t.Errorf("Matcher(%v, %q) = %q, want %q", tc.languages, tc.path, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package comby
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+)
+
+var isValidMatcher = lazyregexp.New(`\.(s|sh|bib|c|cs|css|dart|clj|elm|erl|ex|f|fsx|go|html|hs|java|js|json|jl|kt|tex|lisp|nim|md|ml|org|pas|php|py|re|rb|rs|rst|scala|sql|swift|tex|txt|ts)$`)
+
+// MatcherForExtension returns the key for specifying -matcher in comby for the
+// given file extension, falling back to the generic matcher for extensions
+// comby doesn't support.
+func MatcherForExtension(extension string) string {
+	if isValidMatcher.MatchString(extension) {
+		return extension
+	}
+	return ".generic"
+}
+
+// MatcherForLanguage looks up a key for specifying -matcher in comby. Comby accepts
+// a representative file extension to set a language, so this lookup does not
+// need to consider all possible file extensions for a language. There is a generic
+// fallback language, so this lookup does not need to be exhaustive either.
+func MatcherForLanguage(language string) string {
+	switch strings.ToLower(language) {
+	case "assembly", "asm":
+		return ".s"
+	case "bash":
+		return ".sh"
+	case "c":
+		return ".c"
+	case "c#, csharp":
+		return ".cs"
+	case "css":
+		return ".css"
+	case "dart":
+		return ".dart"
+	case "clojure":
+		return ".clj"
+	case "elm":
+		return ".elm"
+	case "erlang":
+		return ".erl"
+	case "elixir":
+		return ".ex"
+	case "fortran":
+		return ".f"
+	case "f#", "fsharp":
+		return ".fsx"
+	case "go":
+		return ".go"
+	case "html":
+		return ".html"
+	case "haskell":
+		return ".hs"
+	case "java":
+		return ".java"
+	case "javascript":
+		return ".js"
+	case "json":
+		return ".json"
+	case "julia":
+		return ".jl"
+	case "kotlin":
+		return ".kt"
+	case "laTeX":
+		return ".tex"
+	case "lisp":
+		return ".lisp"
+	case "nim":
+		return ".nim"
+	case "ocaml":
+		return ".ml"
+	case "pascal":
+		return ".pas"
+	case "php":
+		return ".php"
+	case "python":
+		return ".py"
+	case "reason":
+		return ".re"
+	case "ruby":
+		return ".rb"
+	case "rust":
+		return ".rs"
+	case "scala":
+		return ".scala"
+	case "sql":
+		return ".sql"
+	case "swift":
+		return ".swift"
+	case "text":
+		return ".txt"
+	case "typescript", "ts":
+		return ".ts"
+	case "xml":
+		return ".xml"
+	}
+	return ".generic"
+}
+
+// Matcher returns the key for specifying -matcher in comby when matching
+// against the file at the given path. It derives either from an explicit
+// language, or the extension of the path.
+func Matcher(languages []string, path string) string {
+	if len(languages) > 0 {
+		// Pick the first language, there is no support for applying
+		// multiple language matchers in a single search.
+		return MatcherForLanguage(languages[0])
+	}
+	return MatcherForExtension(filepath.Ext(path))
+}
@@ -145,6 +145,55 @@ func parseTemplate(buf []byte) []Term {
 	return result
 }
 
+var holeName = lazyregexp.New(`^:\[\[?[ ]*(\w*)`)
+
+// Holes returns the names of the named holes in a comby pattern, in the order
+// in which they first appear. Anonymous holes like :[_] or :[~regexp] are
+// omitted.
+//
+// Example:
+// "log.Fatal(:[x], :[[y]], :[_])" -> ["x", "y"]
+func Holes(pattern string) []string {
+	var names []string
+	seen := map[string]struct{}{}
+	for _, term := range parseTemplate([]byte(pattern)) {
+		hole, ok := term.(Hole)
+		if !ok {
+			continue
+		}
+		m := holeName.FindStringSubmatch(string(hole))
+		if m == nil || m[1] == "" || m[1] == "_" {
+			continue
+		}
+		if _, ok := seen[m[1]]; ok {
+			continue
+		}
+		seen[m[1]] = struct{}{}
+		names = append(names, m[1])
+	}
+	return names
+}
+
+// SubstituteHole replaces every occurrence of the named hole in a comby
+// pattern with the literal value, so that the pattern only matches where the
+// hole would have matched value.
+//
+// Example:
+// SubstituteHole("log.Fatal(:[x], :[y])", "x", "err") -> "log.Fatal(err, :[y])"
+func SubstituteHole(pattern, name, value string) string {
+	var b strings.Builder
+	for _, term := range parseTemplate([]byte(pattern)) {
+		if hole, ok := term.(Hole); ok {
+			if m := holeName.FindStringSubmatch(string(hole)); m != nil && m[1] == name {
+				b.WriteString(value)
+				continue
+			}
+		}
+		b.WriteString(term.String())
+	}
+	return b.String()
+}
+
 var onMatchWhitespace = lazyregexp.New(`[\s]+`)
 
 // StructuralPatToRegexpQuery converts a comby pattern to an approximate regular
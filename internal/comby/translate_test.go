@@ -119,3 +119,44 @@ func TestStructuralPatToRegexpQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestHoles(t *testing.T) {
+	cases := []struct {
+		Pattern string
+		Want    []string
+	}{
+		{Pattern: "no holes", Want: nil},
+		{Pattern: "log.Fatal(:[x])", Want: []string{"x"}},
+		{Pattern: `1. :[1] 2. :[[2]] 3. :[3.] 4. :[4\n] 5. :[ ] 6. :[ 6] done.`, Want: []string{"1", "2", "3", "4", "6"}},
+		{Pattern: "foo(:[_], :[~[0-9]+], :[arg~[a-z]+])", Want: []string{"arg"}},
+		{Pattern: ":[x] == :[x] && :[y]", Want: []string{"x", "y"}},
+	}
+	for _, tt := range cases {
+		t.Run(tt.Pattern, func(t *testing.T) {
+			if diff := cmp.Diff(tt.Want, Holes(tt.Pattern)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestSubstituteHole(t *testing.T) {
+	cases := []struct {
+		Pattern string
+		Name    string
+		Value   string
+		Want    string
+	}{
+		{Pattern: "log.Fatal(:[x], :[y])", Name: "x", Value: "err", Want: "log.Fatal(err, :[y])"},
+		{Pattern: ":[[x]] == :[x] && :[_]", Name: "x", Value: "a", Want: "a == a && :[_]"},
+		{Pattern: "foo(:[arg~[a-z]+])", Name: "arg", Value: "bar", Want: "foo(bar)"},
+		{Pattern: "foo(:[y])", Name: "x", Value: "bar", Want: "foo(:[y])"},
+	}
+	for _, tt := range cases {
+		t.Run(tt.Pattern, func(t *testing.T) {
+			if got := SubstituteHole(tt.Pattern, tt.Name, tt.Value); got != tt.Want {
+				t.Errorf("got %q, want %q", got, tt.Want)
+			}
+		})
+	}
+}
@@ -78,8 +78,16 @@ type Range struct {
 
 // Match represents a range of matched characters and the matched content
 type Match struct {
-	Range   Range  `json:"range"`
-	Matched string `json:"matched"`
+	Range       Range         `json:"range"`
+	Environment []Environment `json:"environment"`
+	Matched     string        `json:"matched"`
+}
+
+// Environment is the content bound to a hole of the match template
+type Environment struct {
+	Variable string `json:"variable"`
+	Value    string `json:"value"`
+	Range    Range  `json:"range"`
 }
 
 type ChunkMatch struct {
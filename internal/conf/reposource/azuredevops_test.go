@@ -0,0 +1,20 @@
+package reposource
+
+import "testing"
+
+func TestAzureDevOpsRepoName(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    string
+	}{
+		{"", "dev.azure.com/myorg/myproject/myrepo"},
+		{"{host}/{org}/{project}/{repo}", "dev.azure.com/myorg/myproject/myrepo"},
+		{"ado/{project}/{repo}", "ado/myproject/myrepo"},
+	}
+
+	for _, test := range tests {
+		if have := AzureDevOpsRepoName(test.pattern, "dev.azure.com", "myorg", "myproject", "myrepo"); string(have) != test.want {
+			t.Errorf("pattern %q: expected %q but got %q", test.pattern, test.want, have)
+		}
+	}
+}
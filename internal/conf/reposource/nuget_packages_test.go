@@ -0,0 +1,66 @@
+package reposource
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+func TestParseNuGetVersionedPackage(t *testing.T) {
+	table := []struct {
+		dependency string
+		name       PackageName
+		version    string
+		valid      bool
+	}{
+		{"Newtonsoft.Json@13.0.1", "Newtonsoft.Json", "13.0.1", true},
+		{"Newtonsoft.Json", "Newtonsoft.Json", "", true},
+		{"System.Text.Json@7.0.0-rc.2.22472.3", "System.Text.Json", "7.0.0-rc.2.22472.3", true},
+		{"Serilog.Sinks_File-Extras@1.0.0", "Serilog.Sinks_File-Extras", "1.0.0", true},
+		{"Newtonsoft..Json@13.0.1", "", "", false},
+		{"Newtonsoft/Json@13.0.1", "", "", false},
+		{"@13.0.1", "", "", false},
+	}
+	for _, entry := range table {
+		dep, err := ParseNuGetVersionedPackage(entry.dependency)
+		if !entry.valid {
+			assert.Error(t, err, entry.dependency)
+			continue
+		}
+		require.NoError(t, err, entry.dependency)
+		assert.Equal(t, entry.name, dep.Name)
+		assert.Equal(t, entry.version, dep.Version)
+	}
+}
+
+func TestParseNuGetPackageFromRepoName(t *testing.T) {
+	dep, err := ParseNuGetPackageFromRepoName("nuget/Newtonsoft.Json")
+	require.NoError(t, err)
+	assert.Equal(t, PackageName("Newtonsoft.Json"), dep.PackageSyntax())
+	assert.Equal(t, api.RepoName("nuget/Newtonsoft.Json"), dep.RepoName())
+
+	_, err = ParseNuGetPackageFromRepoName("npm/Newtonsoft.Json")
+	assert.Error(t, err)
+}
+
+func TestNuGetDependency_Less(t *testing.T) {
+	dependencies := []*NuGetVersionedPackage{
+		NewNuGetVersionedPackage("Newtonsoft.Json", "12.0.3"),
+		NewNuGetVersionedPackage("Serilog", "2.12.0"),
+		NewNuGetVersionedPackage("Newtonsoft.Json", "13.0.1"),
+	}
+	sort.Slice(dependencies, func(i, j int) bool {
+		return dependencies[i].Less(dependencies[j])
+	})
+
+	expected := []*NuGetVersionedPackage{
+		NewNuGetVersionedPackage("Serilog", "2.12.0"),
+		NewNuGetVersionedPackage("Newtonsoft.Json", "13.0.1"),
+		NewNuGetVersionedPackage("Newtonsoft.Json", "12.0.3"),
+	}
+	assert.Equal(t, expected, dependencies)
+}
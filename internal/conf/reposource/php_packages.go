@@ -0,0 +1,101 @@
+package reposource
+
+import (
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const phpPackagesPrefix = "packagist/"
+
+// Composer package names are of the form vendor/package and lowercase, see
+// https://getcomposer.org/doc/04-schema.md#name.
+var phpPackageNameRegex = lazyregexp.New(`^[a-z0-9]([_.\-]?[a-z0-9]+)*/[a-z0-9](([_.]|-{1,2})?[a-z0-9]+)*$`)
+
+type PhpVersionedPackage struct {
+	Name    PackageName
+	Version string
+}
+
+func NewPhpVersionedPackage(name PackageName, version string) *PhpVersionedPackage {
+	return &PhpVersionedPackage{
+		Name:    name,
+		Version: version,
+	}
+}
+
+// ParsePhpVersionedPackage parses a string in a '<vendor>/<package>(@version>)?' format
+// into a PhpVersionedPackage.
+func ParsePhpVersionedPackage(dependency string) (*PhpVersionedPackage, error) {
+	var dep PhpVersionedPackage
+	if i := strings.LastIndex(dependency, "@"); i == -1 {
+		dep.Name = PackageName(strings.TrimSpace(dependency))
+	} else {
+		dep.Name = PackageName(strings.TrimSpace(dependency[:i]))
+		dep.Version = strings.TrimSpace(dependency[i+1:])
+	}
+
+	// Composer treats package names case-insensitively, but only publishes them in lowercase.
+	dep.Name = PackageName(strings.ToLower(string(dep.Name)))
+	if !phpPackageNameRegex.MatchString(string(dep.Name)) {
+		return nil, errors.Errorf("expected PHP package in vendor/package format but found %q", dep.Name)
+	}
+
+	return &dep, nil
+}
+
+func ParsePhpPackageFromName(name PackageName) (*PhpVersionedPackage, error) {
+	return ParsePhpVersionedPackage(string(name))
+}
+
+// ParsePhpPackageFromRepoName is a convenience function to parse a repo name in a
+// 'packagist/<vendor>/<package>(@<version>)?' format into a PhpVersionedPackage.
+func ParsePhpPackageFromRepoName(name api.RepoName) (*PhpVersionedPackage, error) {
+	dependency := strings.TrimPrefix(string(name), phpPackagesPrefix)
+	if len(dependency) == len(name) {
+		return nil, errors.Newf("invalid PHP dependency repo name, missing %s prefix '%s'", phpPackagesPrefix, name)
+	}
+	return ParsePhpVersionedPackage(dependency)
+}
+
+func (p *PhpVersionedPackage) Scheme() string {
+	return "scip-php"
+}
+
+func (p *PhpVersionedPackage) PackageSyntax() PackageName {
+	return p.Name
+}
+
+func (p *PhpVersionedPackage) VersionedPackageSyntax() string {
+	if p.Version == "" {
+		return string(p.Name)
+	}
+	return string(p.Name) + "@" + p.Version
+}
+
+func (p *PhpVersionedPackage) PackageVersion() string {
+	return p.Version
+}
+
+func (p *PhpVersionedPackage) Description() string { return "" }
+
+func (p *PhpVersionedPackage) RepoName() api.RepoName {
+	return api.RepoName(phpPackagesPrefix + p.Name)
+}
+
+func (p *PhpVersionedPackage) GitTagFromVersion() string {
+	version := strings.TrimPrefix(p.Version, "v")
+	return "v" + version
+}
+
+func (p *PhpVersionedPackage) Less(other VersionedPackage) bool {
+	o := other.(*PhpVersionedPackage)
+
+	if p.Name == o.Name {
+		return versionGreaterThan(p.Version, o.Version)
+	}
+
+	return p.Name > o.Name
+}
@@ -0,0 +1,67 @@
+package reposource
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+func TestParsePhpVersionedPackage(t *testing.T) {
+	table := []struct {
+		dependency string
+		name       PackageName
+		version    string
+		valid      bool
+	}{
+		{"monolog/monolog@3.2.0", "monolog/monolog", "3.2.0", true},
+		{"monolog/monolog", "monolog/monolog", "", true},
+		{"Symfony/Console@v6.2.1", "symfony/console", "v6.2.1", true},
+		{"phpunit/php-code-coverage@9.2.19", "phpunit/php-code-coverage", "9.2.19", true},
+		{"doctrine/dbal@3.5.1", "doctrine/dbal", "3.5.1", true},
+		{"monolog@3.2.0", "", "", false},
+		{"monolog/monolog/extra@3.2.0", "", "", false},
+		{"/monolog@3.2.0", "", "", false},
+	}
+	for _, entry := range table {
+		dep, err := ParsePhpVersionedPackage(entry.dependency)
+		if !entry.valid {
+			assert.Error(t, err, entry.dependency)
+			continue
+		}
+		require.NoError(t, err, entry.dependency)
+		assert.Equal(t, entry.name, dep.Name)
+		assert.Equal(t, entry.version, dep.Version)
+	}
+}
+
+func TestParsePhpPackageFromRepoName(t *testing.T) {
+	dep, err := ParsePhpPackageFromRepoName("packagist/monolog/monolog")
+	require.NoError(t, err)
+	assert.Equal(t, PackageName("monolog/monolog"), dep.PackageSyntax())
+	assert.Equal(t, api.RepoName("packagist/monolog/monolog"), dep.RepoName())
+
+	_, err = ParsePhpPackageFromRepoName("monolog/monolog")
+	assert.Error(t, err)
+}
+
+func TestPhpDependency_Less(t *testing.T) {
+	dependencies := []*PhpVersionedPackage{
+		NewPhpVersionedPackage("monolog/monolog", "2.8.0"),
+		NewPhpVersionedPackage("symfony/console", "6.2.1"),
+		NewPhpVersionedPackage("monolog/monolog", "3.2.0"),
+	}
+	sort.Slice(dependencies, func(i, j int) bool {
+		return dependencies[i].Less(dependencies[j])
+	})
+
+	expected := []*PhpVersionedPackage{
+		NewPhpVersionedPackage("symfony/console", "6.2.1"),
+		NewPhpVersionedPackage("monolog/monolog", "3.2.0"),
+		NewPhpVersionedPackage("monolog/monolog", "2.8.0"),
+	}
+	assert.Equal(t, expected, dependencies)
+}
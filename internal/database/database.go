@@ -36,9 +36,12 @@ type DB interface {
 	OrgMembers() OrgMemberStore
 	Orgs() OrgStore
 	OrgStats() OrgStatsStore
+	Permissions() PermissionStore
 	Phabricator() PhabricatorStore
 	Repos() RepoStore
 	RepoKVPs() RepoKVPStore
+	RepoUpdateSchedule() RepoUpdateScheduleStore
+	Roles() RoleStore
 	SavedSearches() SavedSearchStore
 	SearchContexts() SearchContextsStore
 	Settings() SettingsStore
@@ -166,6 +169,10 @@ func (d *db) OrgStats() OrgStatsStore {
 	return OrgStatsWith(d.Store)
 }
 
+func (d *db) Permissions() PermissionStore {
+	return PermissionsWith(d.Store)
+}
+
 func (d *db) Phabricator() PhabricatorStore {
 	return PhabricatorWith(d.Store)
 }
@@ -178,6 +185,10 @@ func (d *db) RepoKVPs() RepoKVPStore {
 	return &repoKVPStore{d.Store}
 }
 
+func (d *db) Roles() RoleStore {
+	return RolesWith(d.logger.Scoped("RoleStore", ""), d.Store)
+}
+
 func (d *db) SavedSearches() SavedSearchStore {
 	return SavedSearchesWith(d.Store)
 }
@@ -242,6 +253,10 @@ func (d *db) ExecutorSecretAccessLogs() ExecutorSecretAccessLogStore {
 	return ExecutorSecretAccessLogsWith(d.Store)
 }
 
+func (d *db) RepoUpdateSchedule() RepoUpdateScheduleStore {
+	return RepoUpdateScheduleWith(d.Store)
+}
+
 func (d *db) ZoektRepos() ZoektReposStore {
 	return ZoektReposWith(d.Store)
 }
@@ -89,6 +89,84 @@ func (s *RecordEncrypter) EncryptBatch(ctx context.Context, config EncryptionCon
 	return len(encryptedValues), nil
 }
 
+// ReencryptBatch re-encrypts records that were encrypted with a previous version
+// of the configured key with its current version. Only rotatable keys are able
+// to decrypt values encrypted with a previous version, so this no-ops for any
+// other key.
+func (s *RecordEncrypter) ReencryptBatch(ctx context.Context, config EncryptionConfig) (count int, err error) {
+	key := config.Key()
+	if key == nil || !encryption.IsRotatable(key) {
+		return 0, nil
+	}
+
+	version, err := key.Version(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := s.Transact(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	values, err := config.Scan(tx.Query(ctx, sqlf.Sprintf(
+		reencryptBatchQuery,
+		fields(config),
+		quote(config.TableName),
+		quote(config.KeyIDFieldName),
+		version.JSON(),
+		quote(config.KeyIDFieldName),
+		quote(config.KeyIDFieldName),
+		version.Type,
+		quote(config.KeyIDFieldName),
+		version.Name,
+		quote(config.IDFieldName),
+		config.Limit,
+	)))
+	if err != nil {
+		return 0, err
+	}
+
+	decryptedValues, err := decryptValues(ctx, key, values)
+	if err != nil {
+		return 0, err
+	}
+	encryptedValues, err := encryptValues(ctx, key, decryptedValues)
+	if err != nil {
+		return 0, err
+	}
+
+	for id, ev := range encryptedValues {
+		if err := tx.Exec(ctx, sqlf.Sprintf(
+			"UPDATE %s SET %s WHERE %s = %s",
+			quote(config.TableName),
+			updatePairs(config, ev),
+			quote(config.IDFieldName),
+			id,
+		)); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(encryptedValues), nil
+}
+
+// reencryptBatchQuery selects the records encrypted with another version of the
+// key of the given type and name. Key identifiers are the JSON encoded version
+// of the key; the CASE expression guards the cast of other identifiers.
+const reencryptBatchQuery = `
+SELECT %s FROM %s
+WHERE
+	%s != %s AND
+	CASE WHEN %s LIKE '{%%' THEN
+		(%s::jsonb->>'Type') = %s AND (%s::jsonb->>'Name') = %s
+	ELSE false END
+ORDER BY %s ASC
+LIMIT %s
+FOR UPDATE SKIP LOCKED
+`
+
 func (s *RecordEncrypter) DecryptBatch(ctx context.Context, config EncryptionConfig) (count int, err error) {
 	tx, err := s.Transact(ctx)
 	if err != nil {
@@ -27,6 +27,7 @@ var EncryptionConfigs = []EncryptionConfig{
 	batchChangesSiteCredentialsEncryptionConfig,
 	webhooklogsEncryptionConfig,
 	executorSecretsEncryptionConfig,
+	codeMonitorWebhooksEncryptionConfig,
 }
 
 var externalServicesEncryptionConfig = EncryptionConfig{
@@ -92,6 +93,16 @@ var executorSecretsEncryptionConfig = EncryptionConfig{
 	Limit:               5,
 }
 
+var codeMonitorWebhooksEncryptionConfig = EncryptionConfig{
+	TableName:           "cm_webhooks",
+	IDFieldName:         "id",
+	KeyIDFieldName:      "encryption_key_id",
+	EncryptedFieldNames: []string{"headers"},
+	Scan:                basestore.NewMapScanner(scanEncryptedString),
+	Key:                 func() encryption.Key { return keyring.Default().CodeMonitorWebhookKey },
+	Limit:               100,
+}
+
 func scanEncryptedString(scanner dbutil.Scanner) (id int, e Encrypted, err error) {
 	e.Values = make([]string, 1)
 	err = scanner.Scan(&id, &e.KeyID, &e.Values[0])
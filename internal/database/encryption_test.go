@@ -137,6 +137,101 @@ func TestRecordEncrypter(t *testing.T) {
 	}
 }
 
+func TestRecordEncrypterReencrypt(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(logger, t))
+	key := &rotatableBase64Key{version: "1"}
+	encrypter := NewRecordEncrypter(db)
+
+	if err := encrypter.Exec(ctx, sqlf.Sprintf("CREATE TABLE test_encryptable (id int, encryption_key_id text, data text)")); err != nil {
+		t.Fatalf("failed to create test table: %s", err)
+	}
+	for i := 0; i < 10; i++ {
+		if err := encrypter.Exec(ctx, sqlf.Sprintf("INSERT INTO test_encryptable VALUES (%s, '', %s)", i+1, fmt.Sprintf("data-%d", i))); err != nil {
+			t.Fatalf("failed to insert test data: %s", err)
+		}
+	}
+	// A record encrypted with another key must not be re-encrypted.
+	otherKeyID := testEncryptionKeyID(&base64Key{})
+	if err := encrypter.Exec(ctx, sqlf.Sprintf("INSERT INTO test_encryptable VALUES (11, %s, 'other')", otherKeyID)); err != nil {
+		t.Fatalf("failed to insert test data: %s", err)
+	}
+
+	config := EncryptionConfig{
+		TableName:           "test_encryptable",
+		IDFieldName:         "id",
+		KeyIDFieldName:      "encryption_key_id",
+		EncryptedFieldNames: []string{"data"},
+		Scan:                basestore.NewMapScanner(scanEncryptedString),
+		Key:                 func() encryption.Key { return key },
+		Limit:               5,
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := encrypter.EncryptBatch(ctx, config); err != nil {
+			t.Fatalf("unexpected error encrypting batch: %s", err)
+		}
+	}
+	if count, err := encrypter.ReencryptBatch(ctx, config); err != nil {
+		t.Fatalf("unexpected error re-encrypting batch: %s", err)
+	} else if count != 0 {
+		t.Errorf("unexpected count. want=%d have=%d", 0, count)
+	}
+
+	// Rotate the key
+	key.version = "2"
+	for i := 0; i < 2; i++ {
+		count, err := encrypter.ReencryptBatch(ctx, config)
+		if err != nil {
+			t.Fatalf("unexpected error re-encrypting batch: %s", err)
+		}
+		if count != 5 {
+			t.Errorf("unexpected count. want=%d have=%d", 5, count)
+		}
+	}
+	if count, err := encrypter.ReencryptBatch(ctx, config); err != nil {
+		t.Fatalf("unexpected error re-encrypting batch: %s", err)
+	} else if count != 0 {
+		t.Errorf("unexpected count. want=%d have=%d", 0, count)
+	}
+
+	encryptionKeyIDs, err := basestore.ScanStrings(encrypter.Query(ctx, sqlf.Sprintf("SELECT encryption_key_id FROM test_encryptable ORDER BY id")))
+	if err != nil {
+		t.Fatalf("failed to query encryption keys: %s", err)
+	}
+	for i, keyID := range encryptionKeyIDs {
+		want := testEncryptionKeyID(key)
+		if i == 10 {
+			want = otherKeyID
+		}
+		if keyID != want {
+			t.Errorf("unexpected key identifier. want=%q have=%q", want, keyID)
+		}
+	}
+}
+
+type rotatableBase64Key struct {
+	base64Key
+	version string
+}
+
+func (k *rotatableBase64Key) Version(ctx context.Context) (encryption.KeyVersion, error) {
+	return encryption.KeyVersion{
+		Type:    "rotatable-base64",
+		Name:    "base64",
+		Version: k.version,
+	}, nil
+}
+
+func (k *rotatableBase64Key) Rotatable() bool {
+	return true
+}
+
 type base64Key struct{}
 
 func (k *base64Key) Version(ctx context.Context) (encryption.KeyVersion, error) {
@@ -37,6 +37,10 @@ type EventLogStore interface {
 	// AggregatedSearchEvents calculates SearchAggregatedEvent for each every unique event type related to search.
 	AggregatedSearchEvents(ctx context.Context, now time.Time) ([]types.SearchAggregatedEvent, error)
 
+	// AggregatedSmartSearchRuleEvents calculates SmartSearchRuleAggregatedEvent for each Smart Search rule
+	// from events logged since the given time.
+	AggregatedSmartSearchRuleEvents(ctx context.Context, since time.Time) ([]types.SmartSearchRuleAggregatedEvent, error)
+
 	BulkInsert(ctx context.Context, events []*Event) error
 
 	// CodeIntelligenceCrossRepositoryWAUs returns the WAU (current week) with any (precise or search-based) cross-repository code intelligence event.
@@ -1357,6 +1361,48 @@ func (l *eventLogStore) aggregatedSearchEvents(ctx context.Context, queryString
 	return events, nil
 }
 
+const (
+	// SmartSearchQueriesShownEvent is logged when Smart Search proposed queries are shown to a user.
+	// Its argument lists the description of the rule that generated each query, once per query.
+	SmartSearchQueriesShownEvent = "SmartSearchQueriesShown"
+
+	// SmartSearchQueryClickedEvent is logged when a user clicks a query proposed by Smart Search.
+	// Its argument lists the description of each rule that generated the query.
+	SmartSearchQueryClickedEvent = "SmartSearchQueryClicked"
+)
+
+func (l *eventLogStore) AggregatedSmartSearchRuleEvents(ctx context.Context, since time.Time) (events []types.SmartSearchRuleAggregatedEvent, err error) {
+	rows, err := l.Query(ctx, sqlf.Sprintf(aggregatedSmartSearchRuleEventsQuery, SmartSearchQueriesShownEvent, SmartSearchQueryClickedEvent, SmartSearchQueriesShownEvent, SmartSearchQueryClickedEvent, since))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	for rows.Next() {
+		var event types.SmartSearchRuleAggregatedEvent
+		if err := rows.Scan(&event.Rule, &event.Impressions, &event.Clicks); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+var aggregatedSmartSearchRuleEventsQuery = `
+SELECT
+  rule,
+  COUNT(*) FILTER (WHERE name = %s) AS impressions,
+  COUNT(*) FILTER (WHERE name = %s) AS clicks
+FROM event_logs
+CROSS JOIN LATERAL jsonb_array_elements_text(
+  CASE WHEN jsonb_typeof(argument->'rules') = 'array' THEN argument->'rules' ELSE '[]'::jsonb END
+) AS rule
+WHERE name IN (%s, %s) AND timestamp >= %s
+GROUP BY rule
+ORDER BY rule
+`
+
 var searchLatencyEventNames = []string{
 	"'search.latencies.literal'",
 	"'search.latencies.regexp'",
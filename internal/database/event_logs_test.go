@@ -1280,6 +1280,49 @@ func TestEventLogs_AggregatedSearchEvents(t *testing.T) {
 	}
 }
 
+func TestEventLogs_AggregatedSmartSearchRuleEvents(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	logger := logtest.Scoped(t)
+	t.Parallel()
+	db := NewDB(logger, dbtest.NewDB(logger, t))
+	ctx := context.Background()
+
+	now := time.Unix(1589581800, 0).UTC()
+
+	events := []*Event{
+		{Name: SmartSearchQueriesShownEvent, Argument: json.RawMessage(`{"rules": ["unquote patterns", "AND patterns together", "unquote patterns"]}`), Timestamp: now},
+		{Name: SmartSearchQueriesShownEvent, Argument: json.RawMessage(`{"rules": ["AND patterns together"]}`), Timestamp: now.Add(-time.Hour)},
+		{Name: SmartSearchQueryClickedEvent, Argument: json.RawMessage(`{"rules": ["AND patterns together"]}`), Timestamp: now},
+		// Ignored: logged before the window, without a rules array, or with another name.
+		{Name: SmartSearchQueriesShownEvent, Argument: json.RawMessage(`{"rules": ["unquote patterns"]}`), Timestamp: now.Add(-time.Hour * 24 * 40)},
+		{Name: SmartSearchQueryClickedEvent, Argument: json.RawMessage(`{"rules": "unquote patterns"}`), Timestamp: now},
+		{Name: "SearchResultClicked", Argument: json.RawMessage(`{"rules": ["unquote patterns"]}`), Timestamp: now},
+	}
+	for _, e := range events {
+		e.UserID = 1
+		e.URL = "http://sourcegraph.com"
+		e.Source = "test"
+		if err := db.EventLogs().Insert(ctx, e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	have, err := db.EventLogs().AggregatedSmartSearchRuleEvents(ctx, now.Add(-time.Hour*24*30))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []types.SmartSearchRuleAggregatedEvent{
+		{Rule: "AND patterns together", Impressions: 2, Clicks: 1},
+		{Rule: "unquote patterns", Impressions: 2, Clicks: 0},
+	}
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
 func TestEventLogs_ListAll(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -3,12 +3,15 @@ package database
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 
 	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	ff "github.com/sourcegraph/sourcegraph/internal/featureflag"
+	"github.com/sourcegraph/sourcegraph/internal/requestclient"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -35,6 +38,7 @@ type FeatureFlagStore interface {
 	GetOrgOverridesForUser(ctx context.Context, userID int32) ([]*ff.Override, error)
 	GetOrgOverrideForFlag(ctx context.Context, orgID int32, flagName string) (*ff.Override, error)
 	GetUserFlags(context.Context, int32) (map[string]bool, error)
+	GetUserFlagEvaluation(ctx context.Context, userID int32, flagName string) (*ff.Evaluation, error)
 	GetAnonymousUserFlags(ctx context.Context, anonymousUID string) (map[string]bool, error)
 	GetGlobalFeatureFlags(context.Context) (map[string]bool, error)
 	GetOrgFeatureFlag(ctx context.Context, orgID int32, flagName string) (bool, error)
@@ -63,17 +67,20 @@ func (f *featureFlagStore) CreateFeatureFlag(ctx context.Context, flag *ff.Featu
 			flag_name,
 			flag_type,
 			bool_value,
-			rollout
+			rollout,
+			rules
 		) VALUES (
 			%s,
 			%s,
 			%s,
+			%s,
 			%s
 		) RETURNING
 			flag_name,
 			flag_type,
 			bool_value,
 			rollout,
+			rules,
 			created_at,
 			updated_at,
 			deleted_at
@@ -95,12 +102,18 @@ func (f *featureFlagStore) CreateFeatureFlag(ctx context.Context, flag *ff.Featu
 		return nil, errors.New("feature flag must have exactly one type")
 	}
 
+	rules, err := marshalFeatureFlagRules(flag.Rules)
+	if err != nil {
+		return nil, err
+	}
+
 	row := f.QueryRow(ctx, sqlf.Sprintf(
 		newFeatureFlagFmtStr,
 		flag.Name,
 		flagType,
 		boolVal,
-		rollout))
+		rollout,
+		rules))
 	return scanFeatureFlag(row)
 }
 
@@ -110,13 +123,15 @@ func (f *featureFlagStore) UpdateFeatureFlag(ctx context.Context, flag *ff.Featu
 		SET
 			flag_type = %s,
 			bool_value = %s,
-			rollout = %s
+			rollout = %s,
+			rules = %s
 		WHERE flag_name = %s
 		RETURNING
 			flag_name,
 			flag_type,
 			bool_value,
 			rollout,
+			rules,
 			created_at,
 			updated_at,
 			deleted_at
@@ -138,16 +153,39 @@ func (f *featureFlagStore) UpdateFeatureFlag(ctx context.Context, flag *ff.Featu
 		return nil, errors.New("feature flag must have exactly one type")
 	}
 
+	rules, err := marshalFeatureFlagRules(flag.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	// Users may evaluate the flag differently now, so clear the values we have
+	// cached for them.
+	clearRedisCache(flag.Name)
 	row := f.QueryRow(ctx, sqlf.Sprintf(
 		updateFeatureFlagFmtStr,
 		flagType,
 		boolVal,
 		rollout,
+		rules,
 		flag.Name,
 	))
 	return scanFeatureFlag(row)
 }
 
+// marshalFeatureFlagRules validates rules and returns their JSON encoding for
+// the rules column.
+func marshalFeatureFlagRules(rules []*ff.Rule) ([]byte, error) {
+	for i, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return nil, errors.Wrapf(err, "invalid rule %d", i)
+		}
+	}
+	if rules == nil {
+		rules = []*ff.Rule{}
+	}
+	return json.Marshal(rules)
+}
+
 func (f *featureFlagStore) DeleteFeatureFlag(ctx context.Context, name string) error {
 	const deleteFeatureFlagFmtStr = `
 		UPDATE feature_flags
@@ -192,12 +230,14 @@ func scanFeatureFlag(scanner rowScanner) (*ff.FeatureFlag, error) {
 		flagType string
 		boolVal  *bool
 		rollout  *int32
+		rules    []byte
 	)
 	err := scanner.Scan(
 		&res.Name,
 		&flagType,
 		&boolVal,
 		&rollout,
+		&rules,
 		&res.CreatedAt,
 		&res.UpdatedAt,
 		&res.DeletedAt,
@@ -225,6 +265,13 @@ func scanFeatureFlag(scanner rowScanner) (*ff.FeatureFlag, error) {
 		return nil, ErrInvalidColumnState
 	}
 
+	if err := json.Unmarshal(rules, &res.Rules); err != nil {
+		return nil, err
+	}
+	if len(res.Rules) == 0 {
+		res.Rules = nil
+	}
+
 	return &res, nil
 }
 
@@ -235,6 +282,7 @@ func (f *featureFlagStore) GetFeatureFlag(ctx context.Context, flagName string)
 			flag_type,
 			bool_value,
 			rollout,
+			rules,
 			created_at,
 			updated_at,
 			deleted_at
@@ -254,6 +302,7 @@ func (f *featureFlagStore) GetFeatureFlags(ctx context.Context) ([]*ff.FeatureFl
 			flag_type,
 			bool_value,
 			rollout,
+			rules,
 			created_at,
 			updated_at,
 			deleted_at
@@ -480,6 +529,34 @@ func scanFeatureFlagOverride(scanner rowScanner) (*ff.Override, error) {
 // be the primary entrypoint for getting the user flags since it handles retrieving all the flags,
 // the org overrides, and the user overrides, and merges them in priority order.
 func (f *featureFlagStore) GetUserFlags(ctx context.Context, userID int32) (map[string]bool, error) {
+	evaluations, err := f.getUserFlagEvaluations(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]bool, len(evaluations))
+	for name, evaluation := range evaluations {
+		res[name] = evaluation.Value
+	}
+	return res, nil
+}
+
+// GetUserFlagEvaluation returns the calculated value of the feature flag for the given userID,
+// together with the reason for the value. If the feature flag does not exist, nil is returned.
+func (f *featureFlagStore) GetUserFlagEvaluation(ctx context.Context, userID int32, flagName string) (*ff.Evaluation, error) {
+	evaluations, err := f.getUserFlagEvaluations(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	evaluation, ok := evaluations[flagName]
+	if !ok {
+		return nil, nil
+	}
+	return &evaluation, nil
+}
+
+func (f *featureFlagStore) getUserFlagEvaluations(ctx context.Context, userID int32) (map[string]ff.Evaluation, error) {
 	g, ctx := errgroup.WithContext(ctx)
 
 	var flags []*ff.FeatureFlag
@@ -489,6 +566,13 @@ func (f *featureFlagStore) GetUserFlags(ctx context.Context, userID int32) (map[
 		return err
 	})
 
+	var ec *ff.EvaluationContext
+	g.Go(func() error {
+		res, err := f.getEvaluationContext(ctx, userID)
+		ec = res
+		return err
+	})
+
 	var orgOverrides []*ff.Override
 	g.Go(func() error {
 		res, err := f.GetOrgOverridesForUser(ctx, userID)
@@ -507,25 +591,67 @@ func (f *featureFlagStore) GetUserFlags(ctx context.Context, userID int32) (map[
 		return nil, err
 	}
 
-	res := make(map[string]bool, len(flags))
+	res := make(map[string]ff.Evaluation, len(flags))
 
 	for _, ff := range flags {
-		res[ff.Name] = ff.EvaluateForUser(userID)
+		res[ff.Name] = ff.Evaluate(ec)
 	}
 
 	// Org overrides are higher priority than default
 	for _, oo := range orgOverrides {
-		res[oo.FlagName] = oo.Value
+		res[oo.FlagName] = ff.Evaluation{Value: oo.Value, Reason: ff.ReasonOrgOverride, OrgID: *oo.OrgID}
 	}
 
 	// User overrides are higher priority than org overrides
 	for _, uo := range userOverrides {
-		res[uo.FlagName] = uo.Value
+		res[uo.FlagName] = ff.Evaluation{Value: uo.Value, Reason: ff.ReasonUserOverride}
 	}
 
 	return res, nil
 }
 
+// getEvaluationContext returns the attributes of the given user which rules are matched against,
+// together with the type of the request client in ctx.
+func (f *featureFlagStore) getEvaluationContext(ctx context.Context, userID int32) (*ff.EvaluationContext, error) {
+	const getEvaluationContextFmtStr = `
+		SELECT
+			users.site_admin,
+			users.created_at,
+			ARRAY(
+				SELECT org_id
+				FROM org_members
+				WHERE org_members.user_id = users.id
+			),
+			ARRAY(
+				SELECT DISTINCT LOWER(SPLIT_PART(email, '@', 2))
+				FROM user_emails
+				WHERE user_emails.user_id = users.id
+					AND verified_at IS NOT NULL
+			)
+		FROM users
+		WHERE id = %s
+			AND deleted_at IS NULL;
+	`
+
+	ec := &ff.EvaluationContext{UserID: userID}
+	if client := requestclient.FromContext(ctx); client != nil {
+		ec.ClientType = client.Type
+	}
+
+	row := f.QueryRow(ctx, sqlf.Sprintf(getEvaluationContextFmtStr, userID))
+	err := row.Scan(
+		&ec.SiteAdmin,
+		&ec.CreatedAt,
+		pq.Array(&ec.OrgIDs),
+		pq.Array(&ec.EmailDomains),
+	)
+	if err == sql.ErrNoRows {
+		// The user is gone, only rules not targeting user attributes can match.
+		return ec, nil
+	}
+	return ec, err
+}
+
 // GetAnonymousUserFlags returns the calculated values for feature flags for the given anonymousUID
 func (f *featureFlagStore) GetAnonymousUserFlags(ctx context.Context, anonymousUID string) (map[string]bool, error) {
 	flags, err := f.GetFeatureFlags(ctx)
@@ -533,9 +659,14 @@ func (f *featureFlagStore) GetAnonymousUserFlags(ctx context.Context, anonymousU
 		return nil, err
 	}
 
+	var clientType requestclient.ClientType
+	if client := requestclient.FromContext(ctx); client != nil {
+		clientType = client.Type
+	}
+
 	res := make(map[string]bool, len(flags))
 	for _, ff := range flags {
-		res[ff.Name] = ff.EvaluateForAnonymousUser(anonymousUID)
+		res[ff.Name] = ff.EvaluateForAnonymousUser(anonymousUID, clientType)
 	}
 
 	return res, nil
@@ -14,6 +14,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
 	ff "github.com/sourcegraph/sourcegraph/internal/featureflag"
+	"github.com/sourcegraph/sourcegraph/internal/requestclient"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 )
 
@@ -86,10 +87,20 @@ func testNewFeatureFlagRoundtrip(t *testing.T) {
 			flag:      &ff.FeatureFlag{Name: "err_too_low_rollout", Rollout: &ff.FeatureFlagRollout{Rollout: -1}},
 			assertErr: errorContains(`violates check constraint "feature_flags_rollout_check"`),
 		},
+		{
+			flag: &ff.FeatureFlag{Name: "bool_rules", Bool: &ff.FeatureFlagBool{Value: false}, Rules: []*ff.Rule{
+				{EmailDomains: []string{"sourcegraph.com"}, Value: true},
+				{OrgIDs: []int32{1}, ClientTypes: []requestclient.ClientType{requestclient.ClientTypeWeb}, Value: true},
+			}},
+		},
 		{
 			flag:      &ff.FeatureFlag{Name: "err_no_types"},
 			assertErr: errorContains(`feature flag must have exactly one type`),
 		},
+		{
+			flag:      &ff.FeatureFlag{Name: "err_rule_without_conditions", Bool: &ff.FeatureFlagBool{Value: false}, Rules: []*ff.Rule{{Value: true}}},
+			assertErr: errorContains(`rule must have at least one condition`),
+		},
 	}
 
 	for _, tc := range cases {
@@ -106,6 +117,7 @@ func testNewFeatureFlagRoundtrip(t *testing.T) {
 			require.Equal(t, tc.flag.Name, res.Name)
 			require.Equal(t, tc.flag.Bool, res.Bool)
 			require.Equal(t, tc.flag.Rollout, res.Rollout)
+			require.Equal(t, tc.flag.Rules, res.Rules)
 		})
 	}
 }
@@ -505,6 +517,95 @@ func testUserFlags(t *testing.T) {
 		require.Equal(t, expected, got)
 	})
 
+	t.Run("rules", func(t *testing.T) {
+		t.Cleanup(cleanup(t, db))
+		o1 := mkOrg("o1")
+		u1 := mkUser("u1", o1.ID)
+		u2, err := users.Create(ctx, NewUser{Username: "u2", Password: "p", Email: "u2@SourceGraph.com", EmailIsVerified: true})
+		require.NoError(t, err)
+		u3, err := users.Create(ctx, NewUser{Username: "u3", Password: "p", Email: "u3@sourcegraph.com", EmailVerificationCode: "c"})
+		require.NoError(t, err)
+
+		_, err = flagStore.CreateFeatureFlag(ctx, &ff.FeatureFlag{Name: "f1", Bool: &ff.FeatureFlagBool{Value: false}, Rules: []*ff.Rule{
+			{OrgIDs: []int32{o1.ID}, Value: true},
+			{EmailDomains: []string{"sourcegraph.com"}, Value: true},
+		}})
+		require.NoError(t, err)
+		_, err = flagStore.CreateFeatureFlag(ctx, &ff.FeatureFlag{Name: "f2", Rollout: &ff.FeatureFlagRollout{Rollout: 10000}, Rules: []*ff.Rule{
+			{ClientTypes: []requestclient.ClientType{requestclient.ClientTypeAPI}, Value: false},
+		}})
+		require.NoError(t, err)
+
+		got, err := flagStore.GetUserFlags(ctx, u1.ID)
+		require.NoError(t, err)
+		require.Equal(t, map[string]bool{"f1": true, "f2": true}, got)
+
+		got, err = flagStore.GetUserFlags(ctx, u2.ID)
+		require.NoError(t, err)
+		require.Equal(t, map[string]bool{"f1": true, "f2": true}, got)
+
+		// Unverified emails don't match
+		got, err = flagStore.GetUserFlags(ctx, u3.ID)
+		require.NoError(t, err)
+		require.Equal(t, map[string]bool{"f1": false, "f2": true}, got)
+
+		apiCtx := requestclient.WithClient(ctx, &requestclient.Client{Type: requestclient.ClientTypeAPI})
+		got, err = flagStore.GetUserFlags(apiCtx, u3.ID)
+		require.NoError(t, err)
+		require.Equal(t, map[string]bool{"f1": false, "f2": false}, got)
+	})
+
+	t.Run("evaluation reasons", func(t *testing.T) {
+		t.Cleanup(cleanup(t, db))
+		o1 := mkOrg("o1")
+		u1 := mkUser("u", o1.ID)
+		_, err := flagStore.CreateFeatureFlag(ctx, &ff.FeatureFlag{Name: "rule", Bool: &ff.FeatureFlagBool{Value: false}, Rules: []*ff.Rule{
+			{OrgIDs: []int32{o1.ID + 1}, Value: false},
+			{OrgIDs: []int32{o1.ID}, Value: true},
+		}})
+		require.NoError(t, err)
+		mkFFBool("bool", true)
+		mkFFBoolVar("rollout", 10000)
+		mkFFBool("org", false)
+		mkOrgOverride(o1.ID, "org", true)
+		mkFFBool("user", false)
+		mkOrgOverride(o1.ID, "user", false)
+		mkUserOverride(u1.ID, "user", true)
+
+		for name, want := range map[string]*ff.Evaluation{
+			"rule":    {Value: true, Reason: ff.ReasonRule, RuleIndex: 1},
+			"bool":    {Value: true, Reason: ff.ReasonBool},
+			"rollout": {Value: true, Reason: ff.ReasonRollout},
+			"org":     {Value: true, Reason: ff.ReasonOrgOverride, OrgID: o1.ID},
+			"user":    {Value: true, Reason: ff.ReasonUserOverride},
+			"missing": nil,
+		} {
+			got, err := flagStore.GetUserFlagEvaluation(ctx, u1.ID, name)
+			require.NoError(t, err)
+			require.Equal(t, want, got, name)
+		}
+	})
+
+	t.Run("update flag clears cache", func(t *testing.T) {
+		t.Cleanup(cleanup(t, db))
+		f1 := mkFFBool("f1", true)
+
+		called := false
+		oldClearRedisCache := clearRedisCache
+		clearRedisCache = func(flagName string) {
+			if flagName == f1.Name {
+				called = true
+			}
+		}
+		t.Cleanup(func() { clearRedisCache = oldClearRedisCache })
+
+		f1.Rules = []*ff.Rule{{ClientTypes: []requestclient.ClientType{requestclient.ClientTypeWeb}, Value: false}}
+		res, err := flagStore.UpdateFeatureFlag(ctx, f1)
+		require.NoError(t, err)
+		require.True(t, called)
+		require.Equal(t, f1.Rules, res.Rules)
+	})
+
 	t.Run("delete flag with override", func(t *testing.T) {
 		t.Cleanup(cleanup(t, db))
 		o1 := mkOrg("o1")
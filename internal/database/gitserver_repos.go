@@ -60,6 +60,22 @@ type GitserverRepoStore interface {
 	ListReposWithoutSize(ctx context.Context) (map[api.RepoName]api.RepoID, error)
 	// UpdateRepoSizes sets repo sizes according to input map. Key is repoID, value is repo_size_bytes.
 	UpdateRepoSizes(ctx context.Context, shardID string, repos map[api.RepoID]int64) (int, error)
+	// QueueRebalance replaces all planned moves of repos between gitserver shards
+	// with the given moves, which are queued for the rebalancing worker. Calling it
+	// without moves cancels the current rebalancing.
+	QueueRebalance(ctx context.Context, moves ...*GitserverRepoMove) error
+	// ClaimRebalance marks up to limit queued moves as migrating and returns them.
+	// Moves that have been migrating since before staleBefore are claimed again,
+	// since the worker that claimed them most likely went away.
+	ClaimRebalance(ctx context.Context, limit int, staleBefore time.Time) ([]*GitserverRepoMove, error)
+	// SetRebalanceState records the outcome of a migrating move. Moves that are no
+	// longer migrating, because the rebalancing was replaced or cancelled in the
+	// meantime, are left untouched.
+	SetRebalanceState(ctx context.Context, id api.RepoID, state GitserverRebalanceState, error string) error
+	// ListRebalanceMoves lists the planned moves of repos, ordered by repo ID.
+	ListRebalanceMoves(ctx context.Context, opts ListGitserverRepoMovesOptions) ([]*GitserverRepoMove, error)
+	// GetRebalanceStatus returns the number of planned moves in each state.
+	GetRebalanceStatus(ctx context.Context) (GitserverRebalanceStatus, error)
 }
 
 var _ GitserverRepoStore = (*gitserverRepoStore)(nil)
@@ -621,6 +637,258 @@ AND
 	tmp.repo_size_bytes IS DISTINCT FROM gr.repo_size_bytes
 `
 
+// GitserverRebalanceState is the state of the move of a repo from one gitserver
+// shard to another (gitserver_repos.rebalance_state).
+type GitserverRebalanceState string
+
+const (
+	GitserverRebalanceQueued    GitserverRebalanceState = "queued"
+	GitserverRebalanceMigrating GitserverRebalanceState = "migrating"
+	GitserverRebalanceCompleted GitserverRebalanceState = "completed"
+	GitserverRebalanceErrored   GitserverRebalanceState = "errored"
+)
+
+// GitserverRepoMove is the planned move of a repo from one gitserver shard to
+// another.
+type GitserverRepoMove struct {
+	RepoID   api.RepoID
+	RepoName api.RepoName
+	// From is the address of the gitserver the repo is cloned from.
+	From string
+	// To is the address of the gitserver the repo is cloned to.
+	To        string
+	State     GitserverRebalanceState
+	Error     string
+	UpdatedAt time.Time
+}
+
+// GitserverRebalanceStatus is the number of planned moves of repos between
+// gitserver shards in each state.
+type GitserverRebalanceStatus struct {
+	Queued    int
+	Migrating int
+	Completed int
+	Errored   int
+}
+
+// Total returns the number of planned moves.
+func (s GitserverRebalanceStatus) Total() int {
+	return s.Queued + s.Migrating + s.Completed + s.Errored
+}
+
+type ListGitserverRepoMovesOptions struct {
+	// States only lists moves in the given states. All planned moves are listed if
+	// it is empty.
+	States []GitserverRebalanceState
+	*LimitOffset
+}
+
+func (s *gitserverRepoStore) QueueRebalance(ctx context.Context, moves ...*GitserverRepoMove) (err error) {
+	tx, err := s.Store.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	if err := tx.Exec(ctx, sqlf.Sprintf(clearRebalanceQuery)); err != nil {
+		return errors.Wrap(err, "clearing planned moves")
+	}
+
+	// NOTE: We have three args per row, so rows*3 should be less than maximum
+	// Postgres allows.
+	const batchSize = batch.MaxNumPostgresParameters / 3
+	for len(moves) > 0 {
+		n := batchSize
+		if n > len(moves) {
+			n = len(moves)
+		}
+
+		values := make([]*sqlf.Query, 0, n)
+		for _, m := range moves[:n] {
+			values = append(values, sqlf.Sprintf("(%s::integer, %s::text, %s::text)", m.RepoID, m.From, m.To))
+		}
+		if err := tx.Exec(ctx, sqlf.Sprintf(queueRebalanceQueryFmtstr, GitserverRebalanceQueued, sqlf.Join(values, ","))); err != nil {
+			return errors.Wrap(err, "queueing moves")
+		}
+
+		moves = moves[n:]
+	}
+
+	return nil
+}
+
+const clearRebalanceQuery = `
+UPDATE gitserver_repos
+SET
+	rebalance_state = '',
+	rebalance_from = '',
+	rebalance_to = '',
+	rebalance_error = NULL,
+	rebalance_updated_at = NOW()
+WHERE rebalance_state <> ''
+`
+
+const queueRebalanceQueryFmtstr = `
+UPDATE gitserver_repos AS gr
+SET
+	rebalance_state = %s,
+	rebalance_from = tmp.rebalance_from,
+	rebalance_to = tmp.rebalance_to,
+	rebalance_error = NULL,
+	rebalance_updated_at = NOW()
+FROM (VALUES
+-- (<repo_id>, <rebalance_from>, <rebalance_to>),
+	%s
+) AS tmp(repo_id, rebalance_from, rebalance_to)
+WHERE
+	tmp.repo_id = gr.repo_id
+`
+
+func (s *gitserverRepoStore) ClaimRebalance(ctx context.Context, limit int, staleBefore time.Time) ([]*GitserverRepoMove, error) {
+	return scanGitserverRepoMoves(s.Query(ctx, sqlf.Sprintf(
+		claimRebalanceQuery,
+		GitserverRebalanceQueued,
+		GitserverRebalanceMigrating,
+		staleBefore,
+		limit,
+		GitserverRebalanceMigrating,
+	)))
+}
+
+const claimRebalanceQuery = `
+WITH candidates AS (
+	SELECT repo_id
+	FROM gitserver_repos
+	WHERE
+		rebalance_state = %s
+		OR (rebalance_state = %s AND rebalance_updated_at < %s)
+	ORDER BY repo_id
+	LIMIT %s
+	FOR UPDATE SKIP LOCKED
+)
+UPDATE gitserver_repos AS gr
+SET
+	rebalance_state = %s,
+	rebalance_error = NULL,
+	rebalance_updated_at = NOW()
+FROM candidates, repo
+WHERE
+	gr.repo_id = candidates.repo_id
+	AND repo.id = gr.repo_id
+RETURNING
+	gr.repo_id,
+	repo.name,
+	gr.rebalance_from,
+	gr.rebalance_to,
+	gr.rebalance_state,
+	gr.rebalance_error,
+	gr.rebalance_updated_at
+`
+
+func (s *gitserverRepoStore) SetRebalanceState(ctx context.Context, id api.RepoID, state GitserverRebalanceState, error string) error {
+	err := s.Exec(ctx, sqlf.Sprintf(`
+UPDATE gitserver_repos
+SET
+	rebalance_state = %s,
+	rebalance_error = %s,
+	rebalance_updated_at = NOW()
+WHERE
+	repo_id = %s
+	AND
+	rebalance_state = %s
+`, state, dbutil.NewNullString(sanitizeToUTF8(error)), id, GitserverRebalanceMigrating))
+	if err != nil {
+		return errors.Wrap(err, "setting rebalance state")
+	}
+
+	return nil
+}
+
+func (s *gitserverRepoStore) ListRebalanceMoves(ctx context.Context, opts ListGitserverRepoMovesOptions) ([]*GitserverRepoMove, error) {
+	pred := sqlf.Sprintf("gr.rebalance_state <> ''")
+	if len(opts.States) > 0 {
+		pred = sqlf.Sprintf("gr.rebalance_state = ANY (%s)", pq.Array(opts.States))
+	}
+
+	return scanGitserverRepoMoves(s.Query(ctx, sqlf.Sprintf(listRebalanceMovesQuery, pred, opts.LimitOffset.SQL())))
+}
+
+const listRebalanceMovesQuery = `
+SELECT
+	gr.repo_id,
+	repo.name,
+	gr.rebalance_from,
+	gr.rebalance_to,
+	gr.rebalance_state,
+	gr.rebalance_error,
+	gr.rebalance_updated_at
+FROM gitserver_repos gr
+JOIN repo ON repo.id = gr.repo_id
+WHERE %s
+ORDER BY gr.repo_id ASC
+%s
+`
+
+func scanGitserverRepoMoves(rows *sql.Rows, queryErr error) (_ []*GitserverRepoMove, err error) {
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var moves []*GitserverRepoMove
+	for rows.Next() {
+		var m GitserverRepoMove
+		if err := rows.Scan(
+			&m.RepoID,
+			&m.RepoName,
+			&m.From,
+			&m.To,
+			&m.State,
+			&dbutil.NullString{S: &m.Error},
+			&dbutil.NullTime{Time: &m.UpdatedAt},
+		); err != nil {
+			return nil, errors.Wrap(err, "scanning GitserverRepoMove")
+		}
+		moves = append(moves, &m)
+	}
+
+	return moves, nil
+}
+
+func (s *gitserverRepoStore) GetRebalanceStatus(ctx context.Context) (status GitserverRebalanceStatus, err error) {
+	rows, err := s.Query(ctx, sqlf.Sprintf(`
+SELECT rebalance_state, COUNT(*)
+FROM gitserver_repos
+WHERE rebalance_state <> ''
+GROUP BY rebalance_state
+`))
+	if err != nil {
+		return status, errors.Wrap(err, "counting planned moves")
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	for rows.Next() {
+		var state GitserverRebalanceState
+		var count int
+		if err := rows.Scan(&state, &count); err != nil {
+			return status, errors.Wrap(err, "scanning row")
+		}
+
+		switch state {
+		case GitserverRebalanceQueued:
+			status.Queued = count
+		case GitserverRebalanceMigrating:
+			status.Migrating = count
+		case GitserverRebalanceCompleted:
+			status.Completed = count
+		case GitserverRebalanceErrored:
+			status.Errored = count
+		}
+	}
+
+	return status, nil
+}
+
 // sanitizeToUTF8 will remove any null character terminated string. The null character can be
 // represented in one of the following ways in Go:
 //
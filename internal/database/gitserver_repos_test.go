@@ -956,6 +956,109 @@ func TestGitserverUpdateRepoSizes(t *testing.T) {
 	}
 }
 
+func TestGitserverRepoRebalance(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(logger, t))
+	ctx := context.Background()
+	store := db.GitserverRepos()
+
+	repo1, _ := createTestRepo(ctx, t, db, &createTestRepoPayload{Name: "github.com/sourcegraph/repo1"})
+	repo2, _ := createTestRepo(ctx, t, db, &createTestRepoPayload{Name: "github.com/sourcegraph/repo2"})
+	repo3, _ := createTestRepo(ctx, t, db, &createTestRepoPayload{Name: "github.com/sourcegraph/repo3"})
+
+	ignoreUpdatedAt := cmpopts.IgnoreFields(GitserverRepoMove{}, "UpdatedAt")
+
+	if err := store.QueueRebalance(ctx,
+		&GitserverRepoMove{RepoID: repo1.ID, From: "gitserver-0", To: "gitserver-1"},
+		&GitserverRepoMove{RepoID: repo2.ID, From: "gitserver-0", To: "gitserver-2"},
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	claimed, err := store.ClaimRebalance(ctx, 1, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*GitserverRepoMove{
+		{RepoID: repo1.ID, RepoName: repo1.Name, From: "gitserver-0", To: "gitserver-1", State: GitserverRebalanceMigrating},
+	}
+	if diff := cmp.Diff(want, claimed, ignoreUpdatedAt); diff != "" {
+		t.Fatal(diff)
+	}
+
+	// Moves that have been migrating for too long are claimed again.
+	claimed, err = store.ClaimRebalance(ctx, 10, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have, want := len(claimed), 2; have != want {
+		t.Fatalf("wrong number of claimed moves. have=%d, want=%d", have, want)
+	}
+
+	if err := store.SetRebalanceState(ctx, repo1.ID, GitserverRebalanceCompleted, ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.SetRebalanceState(ctx, repo2.ID, GitserverRebalanceErrored, "oops\x00"); err != nil {
+		t.Fatal(err)
+	}
+	// Only migrating moves are updated.
+	if err := store.SetRebalanceState(ctx, repo3.ID, GitserverRebalanceCompleted, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	status, err := store.GetRebalanceStatus(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(GitserverRebalanceStatus{Completed: 1, Errored: 1}, status); diff != "" {
+		t.Fatal(diff)
+	}
+
+	moves, err := store.ListRebalanceMoves(ctx, ListGitserverRepoMovesOptions{
+		States: []GitserverRebalanceState{GitserverRebalanceErrored},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []*GitserverRepoMove{
+		{RepoID: repo2.ID, RepoName: repo2.Name, From: "gitserver-0", To: "gitserver-2", State: GitserverRebalanceErrored, Error: "oops"},
+	}
+	if diff := cmp.Diff(want, moves, ignoreUpdatedAt); diff != "" {
+		t.Fatal(diff)
+	}
+
+	// Queueing new moves replaces the previous ones.
+	if err := store.QueueRebalance(ctx, &GitserverRepoMove{RepoID: repo3.ID, From: "gitserver-1", To: "gitserver-0"}); err != nil {
+		t.Fatal(err)
+	}
+	moves, err = store.ListRebalanceMoves(ctx, ListGitserverRepoMovesOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []*GitserverRepoMove{
+		{RepoID: repo3.ID, RepoName: repo3.Name, From: "gitserver-1", To: "gitserver-0", State: GitserverRebalanceQueued},
+	}
+	if diff := cmp.Diff(want, moves, ignoreUpdatedAt); diff != "" {
+		t.Fatal(diff)
+	}
+
+	// Queueing no moves cancels the rebalancing.
+	if err := store.QueueRebalance(ctx); err != nil {
+		t.Fatal(err)
+	}
+	status, err = store.GetRebalanceStatus(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have, want := status.Total(), 0; have != want {
+		t.Fatalf("wrong number of planned moves. have=%d, want=%d", have, want)
+	}
+}
+
 func createTestRepo(ctx context.Context, t *testing.T, db DB, payload *createTestRepoPayload) (*types.Repo, *types.GitserverRepo) {
 	t.Helper()
 
@@ -27,6 +27,13 @@ type OutputFactory func() *output.Output
 type RunnerFactory func(ctx context.Context, schemaNames []string) (Runner, error)
 type RunnerFactoryWithSchemas func(ctx context.Context, schemaNames []string, schemas []*schemas.Schema) (Runner, error)
 
+// RunnerFactoryWithDSNs creates a runner over the given schemas that connects to the given
+// databases instead of the databases configured for the instance.
+type RunnerFactoryWithDSNs func(ctx context.Context, dsnsBySchemaName map[string]string, schemas []*schemas.Schema) (Runner, error)
+
+// DSNsFactory returns the connection strings of the databases configured for the given schemas.
+type DSNsFactory func(schemaNames []string) (map[string]string, error)
+
 type runnerShim struct {
 	*runner.Runner
 }
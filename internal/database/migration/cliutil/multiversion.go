@@ -119,16 +119,7 @@ func runMigration(
 	expectedSchemaFactories []ExpectedSchemaFactory,
 	out *output.Output,
 ) error {
-	var runnerSchemas []*schemas.Schema
-	for _, schemaName := range schemas.SchemaNames {
-		runnerSchemas = append(runnerSchemas, &schemas.Schema{
-			Name:                schemaName,
-			MigrationsTableName: schemas.MigrationsTableName(schemaName),
-			Definitions:         plan.stitchedDefinitionsBySchemaName[schemaName],
-		})
-	}
-
-	r, err := runnerFactory(ctx, schemas.SchemaNames, runnerSchemas)
+	r, err := runnerFactory(ctx, schemas.SchemaNames, stitchedSchemas(plan))
 	if err != nil {
 		return err
 	}
@@ -181,17 +172,8 @@ func runMigration(
 				operationType = runner.MigrationOperationTypeTargetedDown
 			}
 
-			operations := make([]runner.MigrationOperation, 0, len(step.schemaMigrationLeafIDsBySchemaName))
-			for schemaName, leafMigrationIDs := range step.schemaMigrationLeafIDsBySchemaName {
-				operations = append(operations, runner.MigrationOperation{
-					SchemaName:     schemaName,
-					Type:           operationType,
-					TargetVersions: leafMigrationIDs,
-				})
-			}
-
 			if err := r.Run(ctx, runner.Options{
-				Operations:     operations,
+				Operations:     targetedOperations(step, operationType),
 				PrivilegedMode: privilegedMode,
 				MatchPrivilegedHash: func(hash string) bool {
 					for _, candidate := range privilegedHashes {
@@ -245,6 +227,34 @@ func runMigration(
 	return nil
 }
 
+// stitchedSchemas returns the schemas of the runner performing the given migration plan.
+func stitchedSchemas(plan migrationPlan) []*schemas.Schema {
+	runnerSchemas := make([]*schemas.Schema, 0, len(schemas.SchemaNames))
+	for _, schemaName := range schemas.SchemaNames {
+		runnerSchemas = append(runnerSchemas, &schemas.Schema{
+			Name:                schemaName,
+			MigrationsTableName: schemas.MigrationsTableName(schemaName),
+			Definitions:         plan.stitchedDefinitionsBySchemaName[schemaName],
+		})
+	}
+
+	return runnerSchemas
+}
+
+// targetedOperations returns the operations that migrate each schema to the leaves of the given step.
+func targetedOperations(step migrationStep, operationType runner.MigrationOperationType) []runner.MigrationOperation {
+	operations := make([]runner.MigrationOperation, 0, len(step.schemaMigrationLeafIDsBySchemaName))
+	for schemaName, leafMigrationIDs := range step.schemaMigrationLeafIDsBySchemaName {
+		operations = append(operations, runner.MigrationOperation{
+			SchemaName:     schemaName,
+			Type:           operationType,
+			TargetVersions: leafMigrationIDs,
+		})
+	}
+
+	return operations
+}
+
 // filterStitchedMigrationsForTags returns a copy of the pre-compiled stitchedMap with references
 // to tags outside of the given set removed. This allows a migrator instance that knows the migration
 // path from X -> Y to also know the path from any partial migration X <= W -> Z <= Y.
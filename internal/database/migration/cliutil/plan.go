@@ -0,0 +1,527 @@
+package cliutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/definition"
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/schemas"
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/shared"
+	"github.com/sourcegraph/sourcegraph/internal/oobmigration"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/output"
+)
+
+func Plan(
+	commandName string,
+	factory RunnerFactory,
+	dsnsFactory DSNsFactory,
+	scratchRunnerFactory RunnerFactoryWithDSNs,
+	outFactory OutputFactory,
+	expectedSchemaFactories ...ExpectedSchemaFactory,
+) *cli.Command {
+	fromFlag := &cli.StringFlag{
+		Name:     "from",
+		Usage:    "The source (current) instance version. Must be of the form `{Major}.{Minor}` or `v{Major}.{Minor}`.",
+		Required: true,
+	}
+	toFlag := &cli.StringFlag{
+		Name:     "to",
+		Usage:    "The target instance version. Must be of the form `{Major}.{Minor}` or `v{Major}.{Minor}`.",
+		Required: true,
+	}
+	schemaFilesFlag := &cli.StringSliceFlag{
+		Name:  "schema-file",
+		Usage: "A `{schema}={path}` pair of a schema description file (as written by the describe command) to check for drift instead of the live database. Multiple schema files (for distinct schemas) may be supplied.",
+		Value: nil,
+	}
+	skipVersionCheckFlag := &cli.BoolFlag{
+		Name:     "skip-version-check",
+		Usage:    "Skip validation of the instance's current version.",
+		Required: false,
+	}
+	skipDriftCheckFlag := &cli.BoolFlag{
+		Name:     "skip-drift-check",
+		Usage:    "Skip comparison of the instance's current schema against the expected version's schema.",
+		Required: false,
+	}
+	skipRehearsalFlag := &cli.BoolFlag{
+		Name:     "skip-rehearsal",
+		Usage:    "Skip applying the planned schema migrations to scratch copies of the current schemas.",
+		Required: false,
+	}
+	jsonFileFlag := &cli.StringFlag{
+		Name:     "json-file",
+		Usage:    "The file to write the plan to as JSON.",
+		Required: false,
+	}
+
+	action := makeAction(outFactory, func(ctx context.Context, cmd *cli.Context, out *output.Output) error {
+		from, ok := oobmigration.NewVersionFromString(fromFlag.Get(cmd))
+		if !ok {
+			return errors.New("bad format for -from")
+		}
+		to, ok := oobmigration.NewVersionFromString(toFlag.Get(cmd))
+		if !ok {
+			return errors.New("bad format for -to")
+		}
+		if oobmigration.CompareVersions(from, to) != oobmigration.VersionOrderBefore {
+			return errors.Newf("invalid range (from=%s >= to=%s)", from, to)
+		}
+		schemaFiles, err := parseSchemaFiles(schemaFilesFlag.Get(cmd))
+		if err != nil {
+			return flagHelp(out, "%s", err)
+		}
+
+		report, err := planUpgrade(from, to)
+		if err != nil {
+			return err
+		}
+
+		// Only connect to the database if the plan needs to read from it. No migrations
+		// are applied to the live databases; supplying -schema-file for every schema
+		// together with -skip-version-check and -skip-rehearsal plans the upgrade without
+		// connecting at all.
+		var r Runner
+		getRunner := func() (_ Runner, err error) {
+			if r == nil {
+				r, err = setupRunner(ctx, factory, schemas.SchemaNames...)
+			}
+			return r, err
+		}
+
+		if !skipVersionCheckFlag.Get(cmd) {
+			r, err := getRunner()
+			if err != nil {
+				return err
+			}
+			if err := report.checkVersion(ctx, r); err != nil {
+				return err
+			}
+		}
+
+		if !skipDriftCheckFlag.Get(cmd) {
+			if err := report.checkDrift(ctx, getRunner, schemaFiles, expectedSchemaFactories); err != nil {
+				return err
+			}
+		}
+
+		if !skipRehearsalFlag.Get(cmd) {
+			r, err := getRunner()
+			if err != nil {
+				return err
+			}
+			if err := report.rehearse(ctx, r, report.plan, dsnsFactory, scratchRunnerFactory, out); err != nil {
+				return errors.Wrap(err, "failed to rehearse the upgrade (re-invoke with -skip-rehearsal to skip this step)")
+			}
+		}
+
+		report.write(out)
+
+		if filename := jsonFileFlag.Get(cmd); filename != "" {
+			contents, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(filename, append(contents, '\n'), os.ModePerm); err != nil {
+				return err
+			}
+			out.WriteLine(output.Linef(output.EmojiSuccess, output.StyleSuccess, "Plan written to %s", filename))
+		}
+
+		if report.Blocked {
+			return errors.New("upgrade is blocked")
+		}
+		return nil
+	})
+
+	return &cli.Command{
+		Name:        "plan",
+		Usage:       "Print the migration plan of a multi-version upgrade without performing it",
+		Description: ConstructLongHelp(),
+		Action:      action,
+		Flags: []cli.Flag{
+			fromFlag,
+			toFlag,
+			schemaFilesFlag,
+			skipVersionCheckFlag,
+			skipDriftCheckFlag,
+			skipRehearsalFlag,
+			jsonFileFlag,
+		},
+	}
+}
+
+// upgradePlanReport describes the migrations performed by an upgrade between two
+// versions, and the reasons that would prevent it from starting or completing.
+type upgradePlanReport struct {
+	From           string                       `json:"from"`
+	To             string                       `json:"to"`
+	CurrentVersion string                       `json:"currentVersion,omitempty"`
+	Interrupts     []upgradePlanInterruptReport `json:"interrupts"`
+	Steps          []upgradePlanStepReport      `json:"steps"`
+	Drift          []upgradePlanDriftReport     `json:"drift"`
+	Rehearsal      *upgradePlanRehearsalReport  `json:"rehearsal"`
+	Blockers       []string                     `json:"blockers"`
+	Blocked        bool                         `json:"blocked"`
+	from           oobmigration.Version
+	plan           migrationPlan
+}
+
+type upgradePlanInterruptReport struct {
+	Version      string `json:"version"`
+	MigrationIDs []int  `json:"migrationIDs"`
+}
+
+type upgradePlanStepReport struct {
+	Version             string                          `json:"version"`
+	SchemaMigrations    []upgradePlanSchemaReport       `json:"schemaMigrations"`
+	OutOfBandMigrations []upgradePlanOutOfBandMigration `json:"outOfBandMigrations"`
+}
+
+type upgradePlanSchemaReport struct {
+	Schema     string                       `json:"schema"`
+	Migrations []upgradePlanSchemaMigration `json:"migrations"`
+}
+
+type upgradePlanSchemaMigration struct {
+	ID                        int    `json:"id"`
+	Name                      string `json:"name"`
+	Privileged                bool   `json:"privileged"`
+	NonIdempotent             bool   `json:"nonIdempotent"`
+	IsCreateIndexConcurrently bool   `json:"isCreateIndexConcurrently"`
+}
+
+type upgradePlanOutOfBandMigration struct {
+	ID             int    `json:"id"`
+	Team           string `json:"team"`
+	Component      string `json:"component"`
+	Description    string `json:"description"`
+	Introduced     string `json:"introduced"`
+	Deprecated     string `json:"deprecated,omitempty"`
+	NonDestructive bool   `json:"nonDestructive"`
+	IsEnterprise   bool   `json:"isEnterprise"`
+}
+
+type upgradePlanDriftReport struct {
+	Schema string `json:"schema"`
+	// Source is the schema description file, or empty if the live database was described.
+	Source string `json:"source,omitempty"`
+	Drift  bool   `json:"drift"`
+	// Empty is true if the schema has not been created yet, in which case drift does not
+	// block the upgrade.
+	Empty bool `json:"empty"`
+	// Details is the output of the drift command for this schema.
+	Details string `json:"details,omitempty"`
+}
+
+// planUpgrade returns the schema and out-of-band migrations performed by an upgrade from
+// the given version to the given version, in order. The plan is computed with the same
+// interrupts as the upgrade command.
+func planUpgrade(from, to oobmigration.Version) (*upgradePlanReport, error) {
+	versionRange, err := oobmigration.UpgradeRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+	interrupts, err := oobmigration.ScheduleMigrationInterrupts(from, to)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := planMigration(from, to, versionRange, interrupts)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &upgradePlanReport{
+		From:       from.String(),
+		To:         to.String(),
+		Interrupts: make([]upgradePlanInterruptReport, 0, len(interrupts)),
+		Steps:      make([]upgradePlanStepReport, 0, len(plan.steps)),
+		Drift:      []upgradePlanDriftReport{},
+		Blockers:   []string{},
+		from:       from,
+		plan:       plan,
+	}
+	for _, interrupt := range interrupts {
+		report.Interrupts = append(report.Interrupts, upgradePlanInterruptReport{
+			Version:      interrupt.Version.String(),
+			MigrationIDs: interrupt.MigrationIDs,
+		})
+	}
+
+	// Track the leaves applied by the previous step so that each step only lists the schema
+	// migrations it applies itself.
+	previousLeafIDsBySchemaName := make(map[string][]int, len(schemas.SchemaNames))
+	for _, schemaName := range schemas.SchemaNames {
+		previousLeafIDsBySchemaName[schemaName] = shared.StitchedMigationsBySchemaName[schemaName].BoundsByRev[from.GitTag()].LeafIDs
+	}
+
+	for _, step := range plan.steps {
+		stepReport := upgradePlanStepReport{
+			Version:             step.instanceVersion.String(),
+			SchemaMigrations:    make([]upgradePlanSchemaReport, 0, len(schemas.SchemaNames)),
+			OutOfBandMigrations: make([]upgradePlanOutOfBandMigration, 0, len(step.outOfBandMigrationIDs)),
+		}
+
+		for _, schemaName := range schemas.SchemaNames {
+			leafIDs, ok := step.schemaMigrationLeafIDsBySchemaName[schemaName]
+			if !ok {
+				continue
+			}
+			migrations, err := migrationsBetween(plan.stitchedDefinitionsBySchemaName[schemaName], previousLeafIDsBySchemaName[schemaName], leafIDs)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to plan %s migrations to %s", schemaName, step.instanceVersion)
+			}
+			previousLeafIDsBySchemaName[schemaName] = leafIDs
+
+			stepReport.SchemaMigrations = append(stepReport.SchemaMigrations, upgradePlanSchemaReport{
+				Schema:     schemaName,
+				Migrations: migrations,
+			})
+		}
+
+		outOfBandMigrations, err := oobmigration.DefinedMigrations(step.outOfBandMigrationIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, migration := range outOfBandMigrations {
+			var deprecated string
+			if migration.Deprecated != nil {
+				deprecated = migration.Deprecated.String()
+			}
+
+			stepReport.OutOfBandMigrations = append(stepReport.OutOfBandMigrations, upgradePlanOutOfBandMigration{
+				ID:             migration.ID,
+				Team:           migration.Team,
+				Component:      migration.Component,
+				Description:    migration.Description,
+				Introduced:     migration.Introduced.String(),
+				Deprecated:     deprecated,
+				NonDestructive: migration.NonDestructive,
+				IsEnterprise:   migration.IsEnterprise,
+			})
+		}
+
+		report.Steps = append(report.Steps, stepReport)
+	}
+
+	return report, nil
+}
+
+// migrationsBetween returns the definitions that need to be applied (in order) to migrate a
+// schema with the given applied leaves to the given target leaves.
+func migrationsBetween(definitions *definition.Definitions, appliedLeafIDs, targetLeafIDs []int) ([]upgradePlanSchemaMigration, error) {
+	var appliedIDs []int
+	if len(appliedLeafIDs) > 0 {
+		applied, err := definitions.Up(nil, appliedLeafIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, definition := range applied {
+			appliedIDs = append(appliedIDs, definition.ID)
+		}
+	}
+
+	pending, err := definitions.Up(appliedIDs, targetLeafIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := make([]upgradePlanSchemaMigration, 0, len(pending))
+	for _, definition := range pending {
+		migrations = append(migrations, upgradePlanSchemaMigration{
+			ID:                        definition.ID,
+			Name:                      definition.Name,
+			Privileged:                definition.Privileged,
+			NonIdempotent:             definition.NonIdempotent,
+			IsCreateIndexConcurrently: definition.IsCreateIndexConcurrently,
+		})
+	}
+
+	return migrations, nil
+}
+
+// checkVersion records a blocker if the version of the instance is not the source version
+// of the plan.
+func (report *upgradePlanReport) checkVersion(ctx context.Context, r Runner) error {
+	version, _, ok, err := getServiceVersion(ctx, r)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		report.addBlocker(fmt.Sprintf("version assertion failed: unknown version != %q", report.From))
+		return nil
+	}
+
+	report.CurrentVersion = version.String()
+	if oobmigration.CompareVersions(version, report.from) != oobmigration.VersionOrderEqual {
+		report.addBlocker(fmt.Sprintf("version assertion failed: %q != %q", version, report.From))
+	}
+	return nil
+}
+
+// checkDrift compares each schema against the expected schema at the source version of the
+// plan, and records a blocker for each non-empty schema that has drifted. Schemas with a
+// description file are not read from the database.
+func (report *upgradePlanReport) checkDrift(
+	ctx context.Context,
+	getRunner func() (Runner, error),
+	schemaFiles map[string]string,
+	expectedSchemaFactories []ExpectedSchemaFactory,
+) error {
+	for _, schemaName := range schemas.SchemaNames {
+		driftReport := upgradePlanDriftReport{Schema: schemaName}
+
+		var schema schemas.SchemaDescription
+		if filename, ok := schemaFiles[schemaName]; ok {
+			description, err := readSchemaFromFile(ctx, filename)
+			if err != nil {
+				return errors.Wrapf(err, "failed to read schema description of %s", schemaName)
+			}
+			schema = description
+			driftReport.Source = filename
+			driftReport.Empty = len(schema.Tables) == 0
+		} else {
+			r, err := getRunner()
+			if err != nil {
+				return err
+			}
+			store, err := r.Store(ctx, schemaName)
+			if err != nil {
+				return err
+			}
+			descriptions, err := store.Describe(ctx)
+			if err != nil {
+				return err
+			}
+			schema = descriptions["public"]
+			if driftReport.Empty, err = isEmptySchema(ctx, r, schemaName); err != nil {
+				return err
+			}
+		}
+
+		var buf bytes.Buffer
+		detailsOutput := output.NewOutput(&buf, output.OutputOpts{})
+
+		version := report.from.GitTag()
+		expectedSchema, err := fetchExpectedSchema(ctx, schemaName, version, detailsOutput, expectedSchemaFactories)
+		if err != nil {
+			return err
+		}
+		buf.Reset()
+
+		if err := compareSchemaDescriptions(detailsOutput, schemaName, version, canonicalize(schema), canonicalize(expectedSchema)); err != nil {
+			driftReport.Drift = true
+			driftReport.Details = buf.String()
+
+			if !driftReport.Empty {
+				report.addBlocker(fmt.Sprintf("schema drift detected for %s", schemaName))
+			}
+		}
+
+		report.Drift = append(report.Drift, driftReport)
+	}
+
+	return nil
+}
+
+func (report *upgradePlanReport) addBlocker(blocker string) {
+	report.Blockers = append(report.Blockers, blocker)
+	report.Blocked = true
+}
+
+// write prints a summary of the plan. Privileged schema migrations are listed individually,
+// as they may need to be applied manually.
+func (report *upgradePlanReport) write(out *output.Output) {
+	out.WriteLine(output.Linef(
+		output.EmojiInfo,
+		output.StyleReset,
+		"Upgrade plan from v%s to v%s (%d steps, %d interrupts to complete out-of-band migrations)",
+		report.From,
+		report.To,
+		len(report.Steps),
+		len(report.Interrupts),
+	))
+
+	for i, step := range report.Steps {
+		out.WriteLine(output.Linef(output.EmojiFingerPointRight, output.StyleBold, "Step %d of %d: migrate to v%s", i+1, len(report.Steps), step.Version))
+
+		for _, schemaReport := range step.SchemaMigrations {
+			var privileged []string
+			for _, migration := range schemaReport.Migrations {
+				if migration.Privileged {
+					privileged = append(privileged, fmt.Sprintf("%d (%s)", migration.ID, migration.Name))
+				}
+			}
+
+			line := fmt.Sprintf("  %s: %d schema migrations", schemaReport.Schema, len(schemaReport.Migrations))
+			if len(privileged) > 0 {
+				line += fmt.Sprintf(", privileged: %s", strings.Join(privileged, ", "))
+			}
+			out.WriteLine(output.Line("", output.StyleReset, line))
+		}
+
+		if len(step.OutOfBandMigrations) > 0 {
+			out.WriteLine(output.Line("", output.StyleReset, "  Out-of-band migrations that must complete:"))
+			for _, migration := range step.OutOfBandMigrations {
+				out.WriteLine(output.Linef("", output.StyleReset, "    - %d %s: %s (%s)", migration.ID, migration.Component, migration.Description, migration.Team))
+			}
+		}
+	}
+
+	drift := false
+	for _, driftReport := range report.Drift {
+		switch {
+		case !driftReport.Drift:
+			out.WriteLine(output.Linef(output.EmojiSuccess, output.StyleSuccess, "No drift detected for %s", driftReport.Schema))
+		case driftReport.Empty:
+			out.WriteLine(output.Linef(output.EmojiInfo, output.StyleReset, "Schema %s has not been created yet, ignoring drift", driftReport.Schema))
+		default:
+			drift = true
+			out.WriteLine(output.Linef(output.EmojiFailure, output.StyleFailure, "Schema drift detected for %s", driftReport.Schema))
+			out.Write(driftReport.Details)
+		}
+	}
+
+	if rehearsal := report.Rehearsal; rehearsal != nil {
+		if rehearsal.Error == "" {
+			out.WriteLine(output.Line(output.EmojiSuccess, output.StyleSuccess, "Schema migrations applied to a copy of the schema"))
+		} else {
+			out.WriteLine(output.Linef(output.EmojiFailure, output.StyleFailure, "Schema migrations to v%s failed against a copy of the schema: %s", rehearsal.FailedVersion, rehearsal.Error))
+		}
+	}
+
+	for _, blocker := range report.Blockers {
+		out.WriteLine(output.Linef(output.EmojiFailure, output.StyleFailure, "Upgrade blocked: %s", blocker))
+	}
+	if drift {
+		out.WriteLine(output.Line(
+			output.EmojiLightbulb,
+			output.StyleItalic,
+			"See https://docs.sourcegraph.com/admin/how-to/manual_database_migrations#drift for instructions on repairing drift.",
+		))
+	}
+}
+
+// parseSchemaFiles parses `{schema}={path}` pairs into a map from schema name to path.
+func parseSchemaFiles(pairs []string) (map[string]string, error) {
+	schemaFiles := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		schemaName, filename, ok := strings.Cut(pair, "=")
+		if !ok || filename == "" {
+			return nil, errors.Newf("invalid schema file %q (must be of the form {schema}={path})", pair)
+		}
+		if _, err := getSchemaJSONFilename(schemaName); err != nil {
+			return nil, err
+		}
+		schemaFiles[schemaName] = filename
+	}
+
+	return schemaFiles, nil
+}
@@ -0,0 +1,187 @@
+package cliutil
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/runner"
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/schemas"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/output"
+)
+
+// upgradePlanRehearsalReport describes the result of applying the schema migrations of a plan
+// to scratch copies of the current database schemas.
+type upgradePlanRehearsalReport struct {
+	// Databases are the names of the (since dropped) scratch databases by schema name.
+	Databases map[string]string `json:"databases"`
+	// FailedVersion is the version of the step whose schema migrations failed, if any.
+	FailedVersion string `json:"failedVersion,omitempty"`
+	// Error is the error returned by the failed schema migrations, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// rehearse copies the schema of each database, along with its migration logs, into a scratch
+// database on the same server with pg_dump and psql. It then applies the schema migrations of
+// each step of the plan to the copies in order, and drops the copies. Failing schema migrations
+// are recorded as a blocker; errors that prevent the rehearsal from running are returned.
+//
+// The scratch databases hold no data, so out-of-band migrations are not rehearsed.
+func (report *upgradePlanReport) rehearse(
+	ctx context.Context,
+	r Runner,
+	plan migrationPlan,
+	dsnsFactory DSNsFactory,
+	scratchRunnerFactory RunnerFactoryWithDSNs,
+	out *output.Output,
+) (err error) {
+	dsns, err := dsnsFactory(schemas.SchemaNames)
+	if err != nil {
+		return err
+	}
+
+	// Connections to the live databases are only used to create and drop the scratch databases
+	dbs := make(map[string]*sql.DB, len(schemas.SchemaNames))
+	for _, schemaName := range schemas.SchemaNames {
+		db, err := extractDB(ctx, r, schemaName)
+		if err != nil {
+			return err
+		}
+		dbs[schemaName] = db
+	}
+
+	rehearsal := &upgradePlanRehearsalReport{Databases: make(map[string]string, len(schemas.SchemaNames))}
+	defer func() {
+		for schemaName, name := range rehearsal.Databases {
+			if dropErr := dropScratchDatabase(dbs[schemaName], name); dropErr != nil {
+				err = errors.Append(err, errors.Wrapf(dropErr, "failed to drop scratch database %q", name))
+			}
+		}
+	}()
+
+	pending := out.Pending(output.Line("", output.StylePending, "Copying schemas into scratch databases..."))
+	scratchDSNs := make(map[string]string, len(schemas.SchemaNames))
+	for _, schemaName := range schemas.SchemaNames {
+		name := fmt.Sprintf("migrator_plan_%s_%d", schemaName, time.Now().Unix())
+		scratchDSN, err := replaceDatabaseInDSN(dsns[schemaName], name)
+		if err != nil {
+			pending.Destroy()
+			return errors.Wrapf(err, "failed to determine scratch database for %s", schemaName)
+		}
+
+		if _, err := dbs[schemaName].ExecContext(ctx, "CREATE DATABASE "+pq.QuoteIdentifier(name)); err != nil {
+			pending.Destroy()
+			return errors.Wrapf(err, "failed to create scratch database for %s", schemaName)
+		}
+		rehearsal.Databases[schemaName] = name
+		scratchDSNs[schemaName] = scratchDSN
+
+		if err := copySchema(ctx, dsns[schemaName], scratchDSN, schemas.MigrationsTableName(schemaName)); err != nil {
+			pending.Destroy()
+			return errors.Wrapf(err, "failed to copy %s schema", schemaName)
+		}
+	}
+	pending.Complete(output.Line(output.EmojiSuccess, output.StyleSuccess, "Copied schemas into scratch databases"))
+
+	scratchRunner, err := scratchRunnerFactory(ctx, scratchDSNs, stitchedSchemas(plan))
+	if err != nil {
+		return err
+	}
+
+	for i, step := range plan.steps {
+		out.WriteLine(output.Linef(
+			output.EmojiFingerPointRight,
+			output.StyleReset,
+			"Rehearsing schema migrations to v%s (step %d of %d)",
+			step.instanceVersion,
+			i+1,
+			len(plan.steps),
+		))
+
+		if err := scratchRunner.Run(ctx, runner.Options{
+			Operations:             targetedOperations(step, runner.MigrationOperationTypeTargetedUp),
+			PrivilegedMode:         runner.ApplyPrivilegedMigrations,
+			IgnoreSingleDirtyLog:   true,
+			IgnoreSinglePendingLog: true,
+		}); err != nil {
+			rehearsal.FailedVersion = step.instanceVersion.String()
+			rehearsal.Error = err.Error()
+			report.addBlocker(fmt.Sprintf("schema migrations to v%s failed against a copy of the schema", step.instanceVersion))
+			break
+		}
+	}
+
+	report.Rehearsal = rehearsal
+	return nil
+}
+
+// copySchema restores the schema of the source database into the target database, along with
+// the migration logs from which the runner determines the applied migrations.
+func copySchema(ctx context.Context, sourceDSN, targetDSN, migrationsTableName string) error {
+	schemaDump, err := pgDump(ctx, sourceDSN, "--schema-only", "--no-owner", "--no-privileges")
+	if err != nil {
+		return err
+	}
+	dataDump, err := pgDump(ctx, sourceDSN, "--data-only", "--table=migration_logs", "--table=migration_logs_id_seq", "--table="+migrationsTableName)
+	if err != nil {
+		return err
+	}
+
+	return psql(ctx, targetDSN, append(schemaDump, dataDump...))
+}
+
+func pgDump(ctx context.Context, dsn string, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "pg_dump", append(args, "--dbname="+dsn)...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Wrapf(err, "pg_dump: %s", strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.Bytes(), nil
+}
+
+func psql(ctx context.Context, dsn string, input []byte) error {
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "psql", "--no-psqlrc", "--quiet", "--set=ON_ERROR_STOP=1", "--dbname="+dsn)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return errors.Wrapf(err, "psql: %s", strings.TrimSpace(stderr.String()))
+	}
+
+	return nil
+}
+
+// dropScratchDatabase terminates the remaining connections to the given scratch database (held
+// by the scratch runner) and drops it. This runs regardless of the cancellation of the plan.
+func dropScratchDatabase(db *sql.DB, name string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	if _, err := db.ExecContext(ctx, `SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE datname = $1 AND pid <> pg_backend_pid()`, name); err != nil {
+		return err
+	}
+	_, err := db.ExecContext(ctx, "DROP DATABASE IF EXISTS "+pq.QuoteIdentifier(name))
+	return err
+}
+
+// replaceDatabaseInDSN returns the given URL connection string with its database replaced.
+func replaceDatabaseInDSN(dsn, databaseName string) (string, error) {
+	u, err := url.Parse(dsn)
+	if err != nil || (u.Scheme != "postgres" && u.Scheme != "postgresql") {
+		return "", errors.New("only postgres:// connection strings are supported")
+	}
+	u.Path = "/" + databaseName
+
+	return u.String(), nil
+}
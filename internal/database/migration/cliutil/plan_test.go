@@ -0,0 +1,330 @@
+package cliutil
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/schemas"
+	"github.com/sourcegraph/sourcegraph/internal/database/migration/shared"
+	"github.com/sourcegraph/sourcegraph/internal/oobmigration"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestPlanUpgrade(t *testing.T) {
+	for _, testCase := range []struct {
+		from, to oobmigration.Version
+	}{
+		{oobmigration.NewVersion(3, 20), oobmigration.NewVersion(4, 1)},
+		{oobmigration.NewVersion(3, 29), oobmigration.NewVersion(3, 35)},
+		{oobmigration.NewVersion(3, 40), oobmigration.NewVersion(4, 0)},
+		{oobmigration.NewVersion(4, 0), oobmigration.NewVersion(4, 1)},
+	} {
+		t.Run(testCase.from.String()+"->"+testCase.to.String(), func(t *testing.T) {
+			report, err := planUpgrade(testCase.from, testCase.to)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			interrupts, err := oobmigration.ScheduleMigrationInterrupts(testCase.from, testCase.to)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			t.Run("interrupts", func(t *testing.T) {
+				expectedInterrupts := make([]upgradePlanInterruptReport, 0, len(interrupts))
+				for _, interrupt := range interrupts {
+					expectedInterrupts = append(expectedInterrupts, upgradePlanInterruptReport{
+						Version:      interrupt.Version.String(),
+						MigrationIDs: interrupt.MigrationIDs,
+					})
+				}
+				if diff := cmp.Diff(expectedInterrupts, report.Interrupts); diff != "" {
+					t.Errorf("unexpected interrupts (-want +got):\n%s", diff)
+				}
+
+				// Each interrupt stops the upgrade at its own step, and the last step reaches the
+				// target version with no out-of-band migrations left to complete.
+				if len(report.Steps) != len(interrupts)+1 {
+					t.Fatalf("unexpected number of steps. want=%d have=%d", len(interrupts)+1, len(report.Steps))
+				}
+				for i, interrupt := range interrupts {
+					step := report.Steps[i]
+					if step.Version != interrupt.Version.String() {
+						t.Errorf("unexpected version of step %d. want=%s have=%s", i, interrupt.Version, step.Version)
+					}
+
+					var ids []int
+					for _, migration := range step.OutOfBandMigrations {
+						ids = append(ids, migration.ID)
+					}
+					if diff := cmp.Diff(interrupt.MigrationIDs, ids); diff != "" {
+						t.Errorf("unexpected out-of-band migrations of step %d (-want +got):\n%s", i, diff)
+					}
+				}
+
+				lastStep := report.Steps[len(report.Steps)-1]
+				if lastStep.Version != testCase.to.String() {
+					t.Errorf("unexpected version of last step. want=%s have=%s", testCase.to, lastStep.Version)
+				}
+				if len(lastStep.OutOfBandMigrations) != 0 {
+					t.Errorf("unexpected out-of-band migrations in last step: %v", lastStep.OutOfBandMigrations)
+				}
+			})
+
+			t.Run("ordering", func(t *testing.T) {
+				for i := 1; i < len(report.Steps); i++ {
+					previous, _ := oobmigration.NewVersionFromString(report.Steps[i-1].Version)
+					current, _ := oobmigration.NewVersionFromString(report.Steps[i].Version)
+					if oobmigration.CompareVersions(previous, current) != oobmigration.VersionOrderBefore {
+						t.Errorf("steps out of order: v%s precedes v%s", previous, current)
+					}
+				}
+
+				for _, schemaName := range schemas.SchemaNames {
+					definitions := report.plan.stitchedDefinitionsBySchemaName[schemaName]
+					stitched := shared.StitchedMigationsBySchemaName[schemaName]
+
+					// Every migration must be applied after its parents, and exactly once
+					applied := map[int]struct{}{}
+					if fromLeafIDs := stitched.BoundsByRev[testCase.from.GitTag()].LeafIDs; len(fromLeafIDs) > 0 {
+						definitionsAtFrom, err := definitions.Up(nil, fromLeafIDs)
+						if err != nil {
+							t.Fatalf("unexpected error: %s", err)
+						}
+						for _, definition := range definitionsAtFrom {
+							applied[definition.ID] = struct{}{}
+						}
+					}
+					appliedAtFrom := len(applied)
+
+					for _, step := range report.Steps {
+						for _, schemaReport := range step.SchemaMigrations {
+							if schemaReport.Schema != schemaName {
+								continue
+							}
+
+							for _, migration := range schemaReport.Migrations {
+								if _, ok := applied[migration.ID]; ok {
+									t.Fatalf("%s migration %d is applied more than once", schemaName, migration.ID)
+								}
+
+								definition, ok := definitions.GetByID(migration.ID)
+								if !ok {
+									t.Fatalf("unknown %s migration %d", schemaName, migration.ID)
+								}
+								for _, parent := range definition.Parents {
+									if _, ok := applied[parent]; !ok {
+										t.Fatalf("%s migration %d is applied before its parent %d", schemaName, migration.ID, parent)
+									}
+								}
+
+								applied[migration.ID] = struct{}{}
+							}
+						}
+					}
+
+					// The plan must reach the leaves of the target version
+					definitionsAtTo, err := definitions.Up(nil, stitched.BoundsByRev[testCase.to.GitTag()].LeafIDs)
+					if err != nil {
+						t.Fatalf("unexpected error: %s", err)
+					}
+					for _, definition := range definitionsAtTo {
+						if _, ok := applied[definition.ID]; !ok {
+							t.Errorf("%s migration %d is not applied by the plan", schemaName, definition.ID)
+						}
+					}
+					if have, want := len(applied)-appliedAtFrom, len(definitionsAtTo)-appliedAtFrom; have != want {
+						t.Errorf("unexpected number of %s migrations. want=%d have=%d", schemaName, want, have)
+					}
+				}
+			})
+		})
+	}
+}
+
+func TestUpgradePlanReportJSON(t *testing.T) {
+	report := &upgradePlanReport{
+		From:           "3.43",
+		To:             "4.0",
+		CurrentVersion: "3.43",
+		Interrupts: []upgradePlanInterruptReport{
+			{Version: "3.43", MigrationIDs: []int{13}},
+		},
+		Steps: []upgradePlanStepReport{
+			{
+				Version: "3.43",
+				SchemaMigrations: []upgradePlanSchemaReport{
+					{Schema: "frontend", Migrations: []upgradePlanSchemaMigration{
+						{ID: 1660710812, Name: "add column", Privileged: true},
+					}},
+				},
+				OutOfBandMigrations: []upgradePlanOutOfBandMigration{
+					{ID: 13, Team: "code-intelligence", Component: "codeintel-db.lsif_data_documents", Description: "Populate num_diagnostics", Introduced: "3.43", NonDestructive: true},
+				},
+			},
+			{
+				Version:             "4.0",
+				SchemaMigrations:    []upgradePlanSchemaReport{},
+				OutOfBandMigrations: []upgradePlanOutOfBandMigration{},
+			},
+		},
+		Drift: []upgradePlanDriftReport{
+			{Schema: "frontend", Source: "frontend.json", Drift: true, Details: "diff"},
+		},
+		Rehearsal: &upgradePlanRehearsalReport{
+			Databases:     map[string]string{"frontend": "migrator_plan_frontend_1"},
+			FailedVersion: "4.0",
+			Error:         "relation already exists",
+		},
+		Blockers: []string{"schema drift detected for frontend"},
+		Blocked:  true,
+		from:     oobmigration.NewVersion(3, 43),
+	}
+
+	contents, err := json.Marshal(report)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var have any
+	if err := json.Unmarshal(contents, &have); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var want any
+	if err := json.Unmarshal([]byte(`{
+		"from": "3.43",
+		"to": "4.0",
+		"currentVersion": "3.43",
+		"interrupts": [{"version": "3.43", "migrationIDs": [13]}],
+		"steps": [
+			{
+				"version": "3.43",
+				"schemaMigrations": [
+					{"schema": "frontend", "migrations": [
+						{"id": 1660710812, "name": "add column", "privileged": true, "nonIdempotent": false, "isCreateIndexConcurrently": false}
+					]}
+				],
+				"outOfBandMigrations": [
+					{"id": 13, "team": "code-intelligence", "component": "codeintel-db.lsif_data_documents", "description": "Populate num_diagnostics", "introduced": "3.43", "nonDestructive": true, "isEnterprise": false}
+				]
+			},
+			{"version": "4.0", "schemaMigrations": [], "outOfBandMigrations": []}
+		],
+		"drift": [{"schema": "frontend", "source": "frontend.json", "drift": true, "empty": false, "details": "diff"}],
+		"rehearsal": {"databases": {"frontend": "migrator_plan_frontend_1"}, "failedVersion": "4.0", "error": "relation already exists"},
+		"blockers": ["schema drift detected for frontend"],
+		"blocked": true
+	}`), &want); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Errorf("unexpected JSON (-want +got):\n%s", diff)
+	}
+}
+
+func TestUpgradePlanReportCheckDrift(t *testing.T) {
+	expected := schemas.SchemaDescription{
+		Tables: []schemas.TableDescription{
+			{Name: "repo", Columns: []schemas.ColumnDescription{{Name: "id", Index: 1, TypeName: "integer"}}},
+		},
+	}
+	drifted := schemas.SchemaDescription{
+		Tables: []schemas.TableDescription{
+			{Name: "repo", Columns: []schemas.ColumnDescription{{Name: "id", Index: 1, TypeName: "bigint"}}},
+		},
+	}
+
+	dir := t.TempDir()
+	writeDescription := func(name string, description schemas.SchemaDescription) string {
+		contents, err := json.Marshal(description)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		filename := filepath.Join(dir, name+".json")
+		if err := os.WriteFile(filename, contents, 0644); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		return filename
+	}
+	expectedSchemaFactories := []ExpectedSchemaFactory{NewExplicitFileSchemaFactory(writeDescription("expected", expected))}
+
+	for _, testCase := range []struct {
+		name             string
+		schema           schemas.SchemaDescription
+		expectedDrift    bool
+		expectedEmpty    bool
+		expectedBlockers []string
+	}{
+		{
+			name:             "matching schema",
+			schema:           expected,
+			expectedBlockers: []string{},
+		},
+		{
+			name:          "drifted schema",
+			schema:        drifted,
+			expectedDrift: true,
+			expectedBlockers: []string{
+				"schema drift detected for frontend",
+				"schema drift detected for codeintel",
+				"schema drift detected for codeinsights",
+			},
+		},
+		{
+			name:             "empty schema",
+			schema:           schemas.SchemaDescription{},
+			expectedDrift:    true,
+			expectedEmpty:    true,
+			expectedBlockers: []string{},
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			schemaFiles := map[string]string{}
+			for _, schemaName := range schemas.SchemaNames {
+				schemaFiles[schemaName] = writeDescription(schemaName, testCase.schema)
+			}
+
+			report := &upgradePlanReport{Blockers: []string{}, from: oobmigration.NewVersion(4, 0)}
+			getRunner := func() (Runner, error) {
+				return nil, errors.New("unexpected database connection")
+			}
+			if err := report.checkDrift(context.Background(), getRunner, schemaFiles, expectedSchemaFactories); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.expectedBlockers, report.Blockers); diff != "" {
+				t.Errorf("unexpected blockers (-want +got):\n%s", diff)
+			}
+			if report.Blocked != (len(testCase.expectedBlockers) > 0) {
+				t.Errorf("unexpected blocked state. want=%v have=%v", len(testCase.expectedBlockers) > 0, report.Blocked)
+			}
+
+			if len(report.Drift) != len(schemas.SchemaNames) {
+				t.Fatalf("unexpected number of drift reports. want=%d have=%d", len(schemas.SchemaNames), len(report.Drift))
+			}
+			for i, driftReport := range report.Drift {
+				if driftReport.Schema != schemas.SchemaNames[i] {
+					t.Errorf("unexpected schema. want=%s have=%s", schemas.SchemaNames[i], driftReport.Schema)
+				}
+				if driftReport.Source != schemaFiles[driftReport.Schema] {
+					t.Errorf("unexpected source. want=%s have=%s", schemaFiles[driftReport.Schema], driftReport.Source)
+				}
+				if driftReport.Drift != testCase.expectedDrift {
+					t.Errorf("unexpected drift of %s. want=%v have=%v", driftReport.Schema, testCase.expectedDrift, driftReport.Drift)
+				}
+				if driftReport.Empty != testCase.expectedEmpty {
+					t.Errorf("unexpected emptiness of %s. want=%v have=%v", driftReport.Schema, testCase.expectedEmpty, driftReport.Empty)
+				}
+				if driftReport.Drift && driftReport.Details == "" {
+					t.Errorf("expected drift details for %s", driftReport.Schema)
+				}
+			}
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	extsvc "github.com/sourcegraph/sourcegraph/internal/extsvc"
 	auth "github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
 	featureflag "github.com/sourcegraph/sourcegraph/internal/featureflag"
+	rbac "github.com/sourcegraph/sourcegraph/internal/rbac"
 	temporarysettings "github.com/sourcegraph/sourcegraph/internal/temporarysettings"
 	types "github.com/sourcegraph/sourcegraph/internal/types"
 	schema "github.com/sourcegraph/sourcegraph/schema"
@@ -3684,6 +3685,9 @@ type MockDB struct {
 	// OrgsFunc is an instance of a mock function object controlling the
 	// behavior of the method Orgs.
 	OrgsFunc *DBOrgsFunc
+	// PermissionsFunc is an instance of a mock function object controlling
+	// the behavior of the method Permissions.
+	PermissionsFunc *DBPermissionsFunc
 	// PhabricatorFunc is an instance of a mock function object controlling
 	// the behavior of the method Phabricator.
 	PhabricatorFunc *DBPhabricatorFunc
@@ -3699,9 +3703,15 @@ type MockDB struct {
 	// RepoStatisticsFunc is an instance of a mock function object
 	// controlling the behavior of the method RepoStatistics.
 	RepoStatisticsFunc *DBRepoStatisticsFunc
+	// RepoUpdateScheduleFunc is an instance of a mock function object
+	// controlling the behavior of the method RepoUpdateSchedule.
+	RepoUpdateScheduleFunc *DBRepoUpdateScheduleFunc
 	// ReposFunc is an instance of a mock function object controlling the
 	// behavior of the method Repos.
 	ReposFunc *DBReposFunc
+	// RolesFunc is an instance of a mock function object controlling the
+	// behavior of the method Roles.
+	RolesFunc *DBRolesFunc
 	// SavedSearchesFunc is an instance of a mock function object
 	// controlling the behavior of the method SavedSearches.
 	SavedSearchesFunc *DBSavedSearchesFunc
@@ -3858,6 +3868,11 @@ func NewMockDB() *MockDB {
 				return
 			},
 		},
+		PermissionsFunc: &DBPermissionsFunc{
+			defaultHook: func() (r0 PermissionStore) {
+				return
+			},
+		},
 		PhabricatorFunc: &DBPhabricatorFunc{
 			defaultHook: func() (r0 PhabricatorStore) {
 				return
@@ -3883,11 +3898,21 @@ func NewMockDB() *MockDB {
 				return
 			},
 		},
+		RepoUpdateScheduleFunc: &DBRepoUpdateScheduleFunc{
+			defaultHook: func() (r0 RepoUpdateScheduleStore) {
+				return
+			},
+		},
 		ReposFunc: &DBReposFunc{
 			defaultHook: func() (r0 RepoStore) {
 				return
 			},
 		},
+		RolesFunc: &DBRolesFunc{
+			defaultHook: func() (r0 RoleStore) {
+				return
+			},
+		},
 		SavedSearchesFunc: &DBSavedSearchesFunc{
 			defaultHook: func() (r0 SavedSearchStore) {
 				return
@@ -4075,6 +4100,11 @@ func NewStrictMockDB() *MockDB {
 				panic("unexpected invocation of MockDB.Orgs")
 			},
 		},
+		PermissionsFunc: &DBPermissionsFunc{
+			defaultHook: func() PermissionStore {
+				panic("unexpected invocation of MockDB.Permissions")
+			},
+		},
 		PhabricatorFunc: &DBPhabricatorFunc{
 			defaultHook: func() PhabricatorStore {
 				panic("unexpected invocation of MockDB.Phabricator")
@@ -4100,11 +4130,21 @@ func NewStrictMockDB() *MockDB {
 				panic("unexpected invocation of MockDB.RepoStatistics")
 			},
 		},
+		RepoUpdateScheduleFunc: &DBRepoUpdateScheduleFunc{
+			defaultHook: func() RepoUpdateScheduleStore {
+				panic("unexpected invocation of MockDB.RepoUpdateSchedule")
+			},
+		},
 		ReposFunc: &DBReposFunc{
 			defaultHook: func() RepoStore {
 				panic("unexpected invocation of MockDB.Repos")
 			},
 		},
+		RolesFunc: &DBRolesFunc{
+			defaultHook: func() RoleStore {
+				panic("unexpected invocation of MockDB.Roles")
+			},
+		},
 		SavedSearchesFunc: &DBSavedSearchesFunc{
 			defaultHook: func() SavedSearchStore {
 				panic("unexpected invocation of MockDB.SavedSearches")
@@ -4250,6 +4290,9 @@ func NewMockDBFrom(i DB) *MockDB {
 		OrgsFunc: &DBOrgsFunc{
 			defaultHook: i.Orgs,
 		},
+		PermissionsFunc: &DBPermissionsFunc{
+			defaultHook: i.Permissions,
+		},
 		PhabricatorFunc: &DBPhabricatorFunc{
 			defaultHook: i.Phabricator,
 		},
@@ -4265,9 +4308,15 @@ func NewMockDBFrom(i DB) *MockDB {
 		RepoStatisticsFunc: &DBRepoStatisticsFunc{
 			defaultHook: i.RepoStatistics,
 		},
+		RepoUpdateScheduleFunc: &DBRepoUpdateScheduleFunc{
+			defaultHook: i.RepoUpdateSchedule,
+		},
 		ReposFunc: &DBReposFunc{
 			defaultHook: i.Repos,
 		},
+		RolesFunc: &DBRolesFunc{
+			defaultHook: i.Roles,
+		},
 		SavedSearchesFunc: &DBSavedSearchesFunc{
 			defaultHook: i.SavedSearches,
 		},
@@ -6408,6 +6457,104 @@ func (c DBOrgsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// DBPermissionsFunc describes the behavior when the Permissions method of
+// the parent MockDB instance is invoked.
+type DBPermissionsFunc struct {
+	defaultHook func() PermissionStore
+	hooks       []func() PermissionStore
+	history     []DBPermissionsFuncCall
+	mutex       sync.Mutex
+}
+
+// Permissions delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockDB) Permissions() PermissionStore {
+	r0 := m.PermissionsFunc.nextHook()()
+	m.PermissionsFunc.appendCall(DBPermissionsFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Permissions method
+// of the parent MockDB instance is invoked and the hook queue is empty.
+func (f *DBPermissionsFunc) SetDefaultHook(hook func() PermissionStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Permissions method of the parent MockDB instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *DBPermissionsFunc) PushHook(hook func() PermissionStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *DBPermissionsFunc) SetDefaultReturn(r0 PermissionStore) {
+	f.SetDefaultHook(func() PermissionStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *DBPermissionsFunc) PushReturn(r0 PermissionStore) {
+	f.PushHook(func() PermissionStore {
+		return r0
+	})
+}
+
+func (f *DBPermissionsFunc) nextHook() func() PermissionStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBPermissionsFunc) appendCall(r0 DBPermissionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBPermissionsFuncCall objects describing
+// the invocations of this function.
+func (f *DBPermissionsFunc) History() []DBPermissionsFuncCall {
+	f.mutex.Lock()
+	history := make([]DBPermissionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBPermissionsFuncCall is an object that describes an invocation of method
+// Permissions on an instance of MockDB.
+type DBPermissionsFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 PermissionStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBPermissionsFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBPermissionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // DBPhabricatorFunc describes the behavior when the Phabricator method of
 // the parent MockDB instance is invoked.
 type DBPhabricatorFunc struct {
@@ -6935,6 +7082,105 @@ func (c DBRepoStatisticsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// DBRepoUpdateScheduleFunc describes the behavior when the
+// RepoUpdateSchedule method of the parent MockDB instance is invoked.
+type DBRepoUpdateScheduleFunc struct {
+	defaultHook func() RepoUpdateScheduleStore
+	hooks       []func() RepoUpdateScheduleStore
+	history     []DBRepoUpdateScheduleFuncCall
+	mutex       sync.Mutex
+}
+
+// RepoUpdateSchedule delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockDB) RepoUpdateSchedule() RepoUpdateScheduleStore {
+	r0 := m.RepoUpdateScheduleFunc.nextHook()()
+	m.RepoUpdateScheduleFunc.appendCall(DBRepoUpdateScheduleFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the RepoUpdateSchedule
+// method of the parent MockDB instance is invoked and the hook queue is
+// empty.
+func (f *DBRepoUpdateScheduleFunc) SetDefaultHook(hook func() RepoUpdateScheduleStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RepoUpdateSchedule method of the parent MockDB instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *DBRepoUpdateScheduleFunc) PushHook(hook func() RepoUpdateScheduleStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *DBRepoUpdateScheduleFunc) SetDefaultReturn(r0 RepoUpdateScheduleStore) {
+	f.SetDefaultHook(func() RepoUpdateScheduleStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *DBRepoUpdateScheduleFunc) PushReturn(r0 RepoUpdateScheduleStore) {
+	f.PushHook(func() RepoUpdateScheduleStore {
+		return r0
+	})
+}
+
+func (f *DBRepoUpdateScheduleFunc) nextHook() func() RepoUpdateScheduleStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBRepoUpdateScheduleFunc) appendCall(r0 DBRepoUpdateScheduleFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBRepoUpdateScheduleFuncCall objects
+// describing the invocations of this function.
+func (f *DBRepoUpdateScheduleFunc) History() []DBRepoUpdateScheduleFuncCall {
+	f.mutex.Lock()
+	history := make([]DBRepoUpdateScheduleFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBRepoUpdateScheduleFuncCall is an object that describes an invocation of
+// method RepoUpdateSchedule on an instance of MockDB.
+type DBRepoUpdateScheduleFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 RepoUpdateScheduleStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBRepoUpdateScheduleFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBRepoUpdateScheduleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // DBReposFunc describes the behavior when the Repos method of the parent
 // MockDB instance is invoked.
 type DBReposFunc struct {
@@ -7033,6 +7279,104 @@ func (c DBReposFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// DBRolesFunc describes the behavior when the Roles method of the parent
+// MockDB instance is invoked.
+type DBRolesFunc struct {
+	defaultHook func() RoleStore
+	hooks       []func() RoleStore
+	history     []DBRolesFuncCall
+	mutex       sync.Mutex
+}
+
+// Roles delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockDB) Roles() RoleStore {
+	r0 := m.RolesFunc.nextHook()()
+	m.RolesFunc.appendCall(DBRolesFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Roles method of the
+// parent MockDB instance is invoked and the hook queue is empty.
+func (f *DBRolesFunc) SetDefaultHook(hook func() RoleStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Roles method of the parent MockDB instance invokes the hook at the front
+// of the queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *DBRolesFunc) PushHook(hook func() RoleStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *DBRolesFunc) SetDefaultReturn(r0 RoleStore) {
+	f.SetDefaultHook(func() RoleStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *DBRolesFunc) PushReturn(r0 RoleStore) {
+	f.PushHook(func() RoleStore {
+		return r0
+	})
+}
+
+func (f *DBRolesFunc) nextHook() func() RoleStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBRolesFunc) appendCall(r0 DBRolesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBRolesFuncCall objects describing the
+// invocations of this function.
+func (f *DBRolesFunc) History() []DBRolesFuncCall {
+	f.mutex.Lock()
+	history := make([]DBRolesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBRolesFuncCall is an object that describes an invocation of method Roles
+// on an instance of MockDB.
+type DBRolesFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 RoleStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBRolesFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBRolesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // DBSavedSearchesFunc describes the behavior when the SavedSearches method
 // of the parent MockDB instance is invoked.
 type DBSavedSearchesFunc struct {
@@ -8539,6 +8883,10 @@ type MockEventLogStore struct {
 	// AggregatedSearchEventsFunc is an instance of a mock function object
 	// controlling the behavior of the method AggregatedSearchEvents.
 	AggregatedSearchEventsFunc *EventLogStoreAggregatedSearchEventsFunc
+	// AggregatedSmartSearchRuleEventsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// AggregatedSmartSearchRuleEvents.
+	AggregatedSmartSearchRuleEventsFunc *EventLogStoreAggregatedSmartSearchRuleEventsFunc
 	// BulkInsertFunc is an instance of a mock function object controlling
 	// the behavior of the method BulkInsert.
 	BulkInsertFunc *EventLogStoreBulkInsertFunc
@@ -8677,6 +9025,11 @@ func NewMockEventLogStore() *MockEventLogStore {
 				return
 			},
 		},
+		AggregatedSmartSearchRuleEventsFunc: &EventLogStoreAggregatedSmartSearchRuleEventsFunc{
+			defaultHook: func(context.Context, time.Time) (r0 []types.SmartSearchRuleAggregatedEvent, r1 error) {
+				return
+			},
+		},
 		BulkInsertFunc: &EventLogStoreBulkInsertFunc{
 			defaultHook: func(context.Context, []*Event) (r0 error) {
 				return
@@ -8869,6 +9222,11 @@ func NewStrictMockEventLogStore() *MockEventLogStore {
 				panic("unexpected invocation of MockEventLogStore.AggregatedSearchEvents")
 			},
 		},
+		AggregatedSmartSearchRuleEventsFunc: &EventLogStoreAggregatedSmartSearchRuleEventsFunc{
+			defaultHook: func(context.Context, time.Time) ([]types.SmartSearchRuleAggregatedEvent, error) {
+				panic("unexpected invocation of MockEventLogStore.AggregatedSmartSearchRuleEvents")
+			},
+		},
 		BulkInsertFunc: &EventLogStoreBulkInsertFunc{
 			defaultHook: func(context.Context, []*Event) error {
 				panic("unexpected invocation of MockEventLogStore.BulkInsert")
@@ -9056,6 +9414,9 @@ func NewMockEventLogStoreFrom(i EventLogStore) *MockEventLogStore {
 		AggregatedSearchEventsFunc: &EventLogStoreAggregatedSearchEventsFunc{
 			defaultHook: i.AggregatedSearchEvents,
 		},
+		AggregatedSmartSearchRuleEventsFunc: &EventLogStoreAggregatedSmartSearchRuleEventsFunc{
+			defaultHook: i.AggregatedSmartSearchRuleEvents,
+		},
 		BulkInsertFunc: &EventLogStoreBulkInsertFunc{
 			defaultHook: i.BulkInsert,
 		},
@@ -9492,6 +9853,118 @@ func (c EventLogStoreAggregatedSearchEventsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// EventLogStoreAggregatedSmartSearchRuleEventsFunc describes the behavior
+// when the AggregatedSmartSearchRuleEvents method of the parent
+// MockEventLogStore instance is invoked.
+type EventLogStoreAggregatedSmartSearchRuleEventsFunc struct {
+	defaultHook func(context.Context, time.Time) ([]types.SmartSearchRuleAggregatedEvent, error)
+	hooks       []func(context.Context, time.Time) ([]types.SmartSearchRuleAggregatedEvent, error)
+	history     []EventLogStoreAggregatedSmartSearchRuleEventsFuncCall
+	mutex       sync.Mutex
+}
+
+// AggregatedSmartSearchRuleEvents delegates to the next hook function in
+// the queue and stores the parameter and result values of this invocation.
+func (m *MockEventLogStore) AggregatedSmartSearchRuleEvents(v0 context.Context, v1 time.Time) ([]types.SmartSearchRuleAggregatedEvent, error) {
+	r0, r1 := m.AggregatedSmartSearchRuleEventsFunc.nextHook()(v0, v1)
+	m.AggregatedSmartSearchRuleEventsFunc.appendCall(EventLogStoreAggregatedSmartSearchRuleEventsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// AggregatedSmartSearchRuleEvents method of the parent MockEventLogStore
+// instance is invoked and the hook queue is empty.
+func (f *EventLogStoreAggregatedSmartSearchRuleEventsFunc) SetDefaultHook(hook func(context.Context, time.Time) ([]types.SmartSearchRuleAggregatedEvent, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// AggregatedSmartSearchRuleEvents method of the parent MockEventLogStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *EventLogStoreAggregatedSmartSearchRuleEventsFunc) PushHook(hook func(context.Context, time.Time) ([]types.SmartSearchRuleAggregatedEvent, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *EventLogStoreAggregatedSmartSearchRuleEventsFunc) SetDefaultReturn(r0 []types.SmartSearchRuleAggregatedEvent, r1 error) {
+	f.SetDefaultHook(func(context.Context, time.Time) ([]types.SmartSearchRuleAggregatedEvent, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *EventLogStoreAggregatedSmartSearchRuleEventsFunc) PushReturn(r0 []types.SmartSearchRuleAggregatedEvent, r1 error) {
+	f.PushHook(func(context.Context, time.Time) ([]types.SmartSearchRuleAggregatedEvent, error) {
+		return r0, r1
+	})
+}
+
+func (f *EventLogStoreAggregatedSmartSearchRuleEventsFunc) nextHook() func(context.Context, time.Time) ([]types.SmartSearchRuleAggregatedEvent, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EventLogStoreAggregatedSmartSearchRuleEventsFunc) appendCall(r0 EventLogStoreAggregatedSmartSearchRuleEventsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// EventLogStoreAggregatedSmartSearchRuleEventsFuncCall objects describing
+// the invocations of this function.
+func (f *EventLogStoreAggregatedSmartSearchRuleEventsFunc) History() []EventLogStoreAggregatedSmartSearchRuleEventsFuncCall {
+	f.mutex.Lock()
+	history := make([]EventLogStoreAggregatedSmartSearchRuleEventsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EventLogStoreAggregatedSmartSearchRuleEventsFuncCall is an object that
+// describes an invocation of method AggregatedSmartSearchRuleEvents on an
+// instance of MockEventLogStore.
+type EventLogStoreAggregatedSmartSearchRuleEventsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 time.Time
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []types.SmartSearchRuleAggregatedEvent
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EventLogStoreAggregatedSmartSearchRuleEventsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EventLogStoreAggregatedSmartSearchRuleEventsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // EventLogStoreBulkInsertFunc describes the behavior when the BulkInsert
 // method of the parent MockEventLogStore instance is invoked.
 type EventLogStoreBulkInsertFunc struct {
@@ -19725,6 +20198,9 @@ type MockFeatureFlagStore struct {
 	// GetOverridesForFlagFunc is an instance of a mock function object
 	// controlling the behavior of the method GetOverridesForFlag.
 	GetOverridesForFlagFunc *FeatureFlagStoreGetOverridesForFlagFunc
+	// GetUserFlagEvaluationFunc is an instance of a mock function object
+	// controlling the behavior of the method GetUserFlagEvaluation.
+	GetUserFlagEvaluationFunc *FeatureFlagStoreGetUserFlagEvaluationFunc
 	// GetUserFlagsFunc is an instance of a mock function object controlling
 	// the behavior of the method GetUserFlags.
 	GetUserFlagsFunc *FeatureFlagStoreGetUserFlagsFunc
@@ -19823,6 +20299,11 @@ func NewMockFeatureFlagStore() *MockFeatureFlagStore {
 				return
 			},
 		},
+		GetUserFlagEvaluationFunc: &FeatureFlagStoreGetUserFlagEvaluationFunc{
+			defaultHook: func(context.Context, int32, string) (r0 *featureflag.Evaluation, r1 error) {
+				return
+			},
+		},
 		GetUserFlagsFunc: &FeatureFlagStoreGetUserFlagsFunc{
 			defaultHook: func(context.Context, int32) (r0 map[string]bool, r1 error) {
 				return
@@ -19935,6 +20416,11 @@ func NewStrictMockFeatureFlagStore() *MockFeatureFlagStore {
 				panic("unexpected invocation of MockFeatureFlagStore.GetOverridesForFlag")
 			},
 		},
+		GetUserFlagEvaluationFunc: &FeatureFlagStoreGetUserFlagEvaluationFunc{
+			defaultHook: func(context.Context, int32, string) (*featureflag.Evaluation, error) {
+				panic("unexpected invocation of MockFeatureFlagStore.GetUserFlagEvaluation")
+			},
+		},
 		GetUserFlagsFunc: &FeatureFlagStoreGetUserFlagsFunc{
 			defaultHook: func(context.Context, int32) (map[string]bool, error) {
 				panic("unexpected invocation of MockFeatureFlagStore.GetUserFlags")
@@ -20020,6 +20506,9 @@ func NewMockFeatureFlagStoreFrom(i FeatureFlagStore) *MockFeatureFlagStore {
 		GetOverridesForFlagFunc: &FeatureFlagStoreGetOverridesForFlagFunc{
 			defaultHook: i.GetOverridesForFlag,
 		},
+		GetUserFlagEvaluationFunc: &FeatureFlagStoreGetUserFlagEvaluationFunc{
+			defaultHook: i.GetUserFlagEvaluation,
+		},
 		GetUserFlagsFunc: &FeatureFlagStoreGetUserFlagsFunc{
 			defaultHook: i.GetUserFlags,
 		},
@@ -21601,6 +22090,121 @@ func (c FeatureFlagStoreGetOverridesForFlagFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// FeatureFlagStoreGetUserFlagEvaluationFunc describes the behavior when the
+// GetUserFlagEvaluation method of the parent MockFeatureFlagStore instance
+// is invoked.
+type FeatureFlagStoreGetUserFlagEvaluationFunc struct {
+	defaultHook func(context.Context, int32, string) (*featureflag.Evaluation, error)
+	hooks       []func(context.Context, int32, string) (*featureflag.Evaluation, error)
+	history     []FeatureFlagStoreGetUserFlagEvaluationFuncCall
+	mutex       sync.Mutex
+}
+
+// GetUserFlagEvaluation delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockFeatureFlagStore) GetUserFlagEvaluation(v0 context.Context, v1 int32, v2 string) (*featureflag.Evaluation, error) {
+	r0, r1 := m.GetUserFlagEvaluationFunc.nextHook()(v0, v1, v2)
+	m.GetUserFlagEvaluationFunc.appendCall(FeatureFlagStoreGetUserFlagEvaluationFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetUserFlagEvaluation method of the parent MockFeatureFlagStore instance
+// is invoked and the hook queue is empty.
+func (f *FeatureFlagStoreGetUserFlagEvaluationFunc) SetDefaultHook(hook func(context.Context, int32, string) (*featureflag.Evaluation, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetUserFlagEvaluation method of the parent MockFeatureFlagStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *FeatureFlagStoreGetUserFlagEvaluationFunc) PushHook(hook func(context.Context, int32, string) (*featureflag.Evaluation, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *FeatureFlagStoreGetUserFlagEvaluationFunc) SetDefaultReturn(r0 *featureflag.Evaluation, r1 error) {
+	f.SetDefaultHook(func(context.Context, int32, string) (*featureflag.Evaluation, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *FeatureFlagStoreGetUserFlagEvaluationFunc) PushReturn(r0 *featureflag.Evaluation, r1 error) {
+	f.PushHook(func(context.Context, int32, string) (*featureflag.Evaluation, error) {
+		return r0, r1
+	})
+}
+
+func (f *FeatureFlagStoreGetUserFlagEvaluationFunc) nextHook() func(context.Context, int32, string) (*featureflag.Evaluation, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *FeatureFlagStoreGetUserFlagEvaluationFunc) appendCall(r0 FeatureFlagStoreGetUserFlagEvaluationFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// FeatureFlagStoreGetUserFlagEvaluationFuncCall objects describing the
+// invocations of this function.
+func (f *FeatureFlagStoreGetUserFlagEvaluationFunc) History() []FeatureFlagStoreGetUserFlagEvaluationFuncCall {
+	f.mutex.Lock()
+	history := make([]FeatureFlagStoreGetUserFlagEvaluationFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// FeatureFlagStoreGetUserFlagEvaluationFuncCall is an object that describes
+// an invocation of method GetUserFlagEvaluation on an instance of
+// MockFeatureFlagStore.
+type FeatureFlagStoreGetUserFlagEvaluationFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int32
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *featureflag.Evaluation
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c FeatureFlagStoreGetUserFlagEvaluationFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c FeatureFlagStoreGetUserFlagEvaluationFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // FeatureFlagStoreGetUserFlagsFunc describes the behavior when the
 // GetUserFlags method of the parent MockFeatureFlagStore instance is
 // invoked.
@@ -22763,6 +23367,9 @@ func (c GitserverLocalCloneStoreWithFuncCall) Results() []interface{} {
 // github.com/sourcegraph/sourcegraph/internal/database) used for unit
 // testing.
 type MockGitserverRepoStore struct {
+	// ClaimRebalanceFunc is an instance of a mock function object
+	// controlling the behavior of the method ClaimRebalance.
+	ClaimRebalanceFunc *GitserverRepoStoreClaimRebalanceFunc
 	// GetByIDFunc is an instance of a mock function object controlling the
 	// behavior of the method GetByID.
 	GetByIDFunc *GitserverRepoStoreGetByIDFunc
@@ -22772,6 +23379,9 @@ type MockGitserverRepoStore struct {
 	// GetByNamesFunc is an instance of a mock function object controlling
 	// the behavior of the method GetByNames.
 	GetByNamesFunc *GitserverRepoStoreGetByNamesFunc
+	// GetRebalanceStatusFunc is an instance of a mock function object
+	// controlling the behavior of the method GetRebalanceStatus.
+	GetRebalanceStatusFunc *GitserverRepoStoreGetRebalanceStatusFunc
 	// HandleFunc is an instance of a mock function object controlling the
 	// behavior of the method Handle.
 	HandleFunc *GitserverRepoStoreHandleFunc
@@ -22786,9 +23396,15 @@ type MockGitserverRepoStore struct {
 	// object controlling the behavior of the method
 	// IterateWithNonemptyLastError.
 	IterateWithNonemptyLastErrorFunc *GitserverRepoStoreIterateWithNonemptyLastErrorFunc
+	// ListRebalanceMovesFunc is an instance of a mock function object
+	// controlling the behavior of the method ListRebalanceMoves.
+	ListRebalanceMovesFunc *GitserverRepoStoreListRebalanceMovesFunc
 	// ListReposWithoutSizeFunc is an instance of a mock function object
 	// controlling the behavior of the method ListReposWithoutSize.
 	ListReposWithoutSizeFunc *GitserverRepoStoreListReposWithoutSizeFunc
+	// QueueRebalanceFunc is an instance of a mock function object
+	// controlling the behavior of the method QueueRebalance.
+	QueueRebalanceFunc *GitserverRepoStoreQueueRebalanceFunc
 	// SetCloneStatusFunc is an instance of a mock function object
 	// controlling the behavior of the method SetCloneStatus.
 	SetCloneStatusFunc *GitserverRepoStoreSetCloneStatusFunc
@@ -22798,6 +23414,9 @@ type MockGitserverRepoStore struct {
 	// SetLastFetchedFunc is an instance of a mock function object
 	// controlling the behavior of the method SetLastFetched.
 	SetLastFetchedFunc *GitserverRepoStoreSetLastFetchedFunc
+	// SetRebalanceStateFunc is an instance of a mock function object
+	// controlling the behavior of the method SetRebalanceState.
+	SetRebalanceStateFunc *GitserverRepoStoreSetRebalanceStateFunc
 	// SetRepoSizeFunc is an instance of a mock function object controlling
 	// the behavior of the method SetRepoSize.
 	SetRepoSizeFunc *GitserverRepoStoreSetRepoSizeFunc
@@ -22821,6 +23440,11 @@ type MockGitserverRepoStore struct {
 // overwritten.
 func NewMockGitserverRepoStore() *MockGitserverRepoStore {
 	return &MockGitserverRepoStore{
+		ClaimRebalanceFunc: &GitserverRepoStoreClaimRebalanceFunc{
+			defaultHook: func(context.Context, int, time.Time) (r0 []*GitserverRepoMove, r1 error) {
+				return
+			},
+		},
 		GetByIDFunc: &GitserverRepoStoreGetByIDFunc{
 			defaultHook: func(context.Context, api.RepoID) (r0 *types.GitserverRepo, r1 error) {
 				return
@@ -22836,6 +23460,11 @@ func NewMockGitserverRepoStore() *MockGitserverRepoStore {
 				return
 			},
 		},
+		GetRebalanceStatusFunc: &GitserverRepoStoreGetRebalanceStatusFunc{
+			defaultHook: func(context.Context) (r0 GitserverRebalanceStatus, r1 error) {
+				return
+			},
+		},
 		HandleFunc: &GitserverRepoStoreHandleFunc{
 			defaultHook: func() (r0 basestore.TransactableHandle) {
 				return
@@ -22856,11 +23485,21 @@ func NewMockGitserverRepoStore() *MockGitserverRepoStore {
 				return
 			},
 		},
+		ListRebalanceMovesFunc: &GitserverRepoStoreListRebalanceMovesFunc{
+			defaultHook: func(context.Context, ListGitserverRepoMovesOptions) (r0 []*GitserverRepoMove, r1 error) {
+				return
+			},
+		},
 		ListReposWithoutSizeFunc: &GitserverRepoStoreListReposWithoutSizeFunc{
 			defaultHook: func(context.Context) (r0 map[api.RepoName]api.RepoID, r1 error) {
 				return
 			},
 		},
+		QueueRebalanceFunc: &GitserverRepoStoreQueueRebalanceFunc{
+			defaultHook: func(context.Context, ...*GitserverRepoMove) (r0 error) {
+				return
+			},
+		},
 		SetCloneStatusFunc: &GitserverRepoStoreSetCloneStatusFunc{
 			defaultHook: func(context.Context, api.RepoName, types.CloneStatus, string) (r0 error) {
 				return
@@ -22876,6 +23515,11 @@ func NewMockGitserverRepoStore() *MockGitserverRepoStore {
 				return
 			},
 		},
+		SetRebalanceStateFunc: &GitserverRepoStoreSetRebalanceStateFunc{
+			defaultHook: func(context.Context, api.RepoID, GitserverRebalanceState, string) (r0 error) {
+				return
+			},
+		},
 		SetRepoSizeFunc: &GitserverRepoStoreSetRepoSizeFunc{
 			defaultHook: func(context.Context, api.RepoName, int64, string) (r0 error) {
 				return
@@ -22909,6 +23553,11 @@ func NewMockGitserverRepoStore() *MockGitserverRepoStore {
 // overwritten.
 func NewStrictMockGitserverRepoStore() *MockGitserverRepoStore {
 	return &MockGitserverRepoStore{
+		ClaimRebalanceFunc: &GitserverRepoStoreClaimRebalanceFunc{
+			defaultHook: func(context.Context, int, time.Time) ([]*GitserverRepoMove, error) {
+				panic("unexpected invocation of MockGitserverRepoStore.ClaimRebalance")
+			},
+		},
 		GetByIDFunc: &GitserverRepoStoreGetByIDFunc{
 			defaultHook: func(context.Context, api.RepoID) (*types.GitserverRepo, error) {
 				panic("unexpected invocation of MockGitserverRepoStore.GetByID")
@@ -22924,6 +23573,11 @@ func NewStrictMockGitserverRepoStore() *MockGitserverRepoStore {
 				panic("unexpected invocation of MockGitserverRepoStore.GetByNames")
 			},
 		},
+		GetRebalanceStatusFunc: &GitserverRepoStoreGetRebalanceStatusFunc{
+			defaultHook: func(context.Context) (GitserverRebalanceStatus, error) {
+				panic("unexpected invocation of MockGitserverRepoStore.GetRebalanceStatus")
+			},
+		},
 		HandleFunc: &GitserverRepoStoreHandleFunc{
 			defaultHook: func() basestore.TransactableHandle {
 				panic("unexpected invocation of MockGitserverRepoStore.Handle")
@@ -22944,11 +23598,21 @@ func NewStrictMockGitserverRepoStore() *MockGitserverRepoStore {
 				panic("unexpected invocation of MockGitserverRepoStore.IterateWithNonemptyLastError")
 			},
 		},
+		ListRebalanceMovesFunc: &GitserverRepoStoreListRebalanceMovesFunc{
+			defaultHook: func(context.Context, ListGitserverRepoMovesOptions) ([]*GitserverRepoMove, error) {
+				panic("unexpected invocation of MockGitserverRepoStore.ListRebalanceMoves")
+			},
+		},
 		ListReposWithoutSizeFunc: &GitserverRepoStoreListReposWithoutSizeFunc{
 			defaultHook: func(context.Context) (map[api.RepoName]api.RepoID, error) {
 				panic("unexpected invocation of MockGitserverRepoStore.ListReposWithoutSize")
 			},
 		},
+		QueueRebalanceFunc: &GitserverRepoStoreQueueRebalanceFunc{
+			defaultHook: func(context.Context, ...*GitserverRepoMove) error {
+				panic("unexpected invocation of MockGitserverRepoStore.QueueRebalance")
+			},
+		},
 		SetCloneStatusFunc: &GitserverRepoStoreSetCloneStatusFunc{
 			defaultHook: func(context.Context, api.RepoName, types.CloneStatus, string) error {
 				panic("unexpected invocation of MockGitserverRepoStore.SetCloneStatus")
@@ -22964,6 +23628,11 @@ func NewStrictMockGitserverRepoStore() *MockGitserverRepoStore {
 				panic("unexpected invocation of MockGitserverRepoStore.SetLastFetched")
 			},
 		},
+		SetRebalanceStateFunc: &GitserverRepoStoreSetRebalanceStateFunc{
+			defaultHook: func(context.Context, api.RepoID, GitserverRebalanceState, string) error {
+				panic("unexpected invocation of MockGitserverRepoStore.SetRebalanceState")
+			},
+		},
 		SetRepoSizeFunc: &GitserverRepoStoreSetRepoSizeFunc{
 			defaultHook: func(context.Context, api.RepoName, int64, string) error {
 				panic("unexpected invocation of MockGitserverRepoStore.SetRepoSize")
@@ -22997,6 +23666,9 @@ func NewStrictMockGitserverRepoStore() *MockGitserverRepoStore {
 // implementation, unless overwritten.
 func NewMockGitserverRepoStoreFrom(i GitserverRepoStore) *MockGitserverRepoStore {
 	return &MockGitserverRepoStore{
+		ClaimRebalanceFunc: &GitserverRepoStoreClaimRebalanceFunc{
+			defaultHook: i.ClaimRebalance,
+		},
 		GetByIDFunc: &GitserverRepoStoreGetByIDFunc{
 			defaultHook: i.GetByID,
 		},
@@ -23006,6 +23678,9 @@ func NewMockGitserverRepoStoreFrom(i GitserverRepoStore) *MockGitserverRepoStore
 		GetByNamesFunc: &GitserverRepoStoreGetByNamesFunc{
 			defaultHook: i.GetByNames,
 		},
+		GetRebalanceStatusFunc: &GitserverRepoStoreGetRebalanceStatusFunc{
+			defaultHook: i.GetRebalanceStatus,
+		},
 		HandleFunc: &GitserverRepoStoreHandleFunc{
 			defaultHook: i.Handle,
 		},
@@ -23018,9 +23693,15 @@ func NewMockGitserverRepoStoreFrom(i GitserverRepoStore) *MockGitserverRepoStore
 		IterateWithNonemptyLastErrorFunc: &GitserverRepoStoreIterateWithNonemptyLastErrorFunc{
 			defaultHook: i.IterateWithNonemptyLastError,
 		},
+		ListRebalanceMovesFunc: &GitserverRepoStoreListRebalanceMovesFunc{
+			defaultHook: i.ListRebalanceMoves,
+		},
 		ListReposWithoutSizeFunc: &GitserverRepoStoreListReposWithoutSizeFunc{
 			defaultHook: i.ListReposWithoutSize,
 		},
+		QueueRebalanceFunc: &GitserverRepoStoreQueueRebalanceFunc{
+			defaultHook: i.QueueRebalance,
+		},
 		SetCloneStatusFunc: &GitserverRepoStoreSetCloneStatusFunc{
 			defaultHook: i.SetCloneStatus,
 		},
@@ -23030,6 +23711,9 @@ func NewMockGitserverRepoStoreFrom(i GitserverRepoStore) *MockGitserverRepoStore
 		SetLastFetchedFunc: &GitserverRepoStoreSetLastFetchedFunc{
 			defaultHook: i.SetLastFetched,
 		},
+		SetRebalanceStateFunc: &GitserverRepoStoreSetRebalanceStateFunc{
+			defaultHook: i.SetRebalanceState,
+		},
 		SetRepoSizeFunc: &GitserverRepoStoreSetRepoSizeFunc{
 			defaultHook: i.SetRepoSize,
 		},
@@ -23048,6 +23732,120 @@ func NewMockGitserverRepoStoreFrom(i GitserverRepoStore) *MockGitserverRepoStore
 	}
 }
 
+// GitserverRepoStoreClaimRebalanceFunc describes the behavior when the
+// ClaimRebalance method of the parent MockGitserverRepoStore instance is
+// invoked.
+type GitserverRepoStoreClaimRebalanceFunc struct {
+	defaultHook func(context.Context, int, time.Time) ([]*GitserverRepoMove, error)
+	hooks       []func(context.Context, int, time.Time) ([]*GitserverRepoMove, error)
+	history     []GitserverRepoStoreClaimRebalanceFuncCall
+	mutex       sync.Mutex
+}
+
+// ClaimRebalance delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGitserverRepoStore) ClaimRebalance(v0 context.Context, v1 int, v2 time.Time) ([]*GitserverRepoMove, error) {
+	r0, r1 := m.ClaimRebalanceFunc.nextHook()(v0, v1, v2)
+	m.ClaimRebalanceFunc.appendCall(GitserverRepoStoreClaimRebalanceFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ClaimRebalance
+// method of the parent MockGitserverRepoStore instance is invoked and the
+// hook queue is empty.
+func (f *GitserverRepoStoreClaimRebalanceFunc) SetDefaultHook(hook func(context.Context, int, time.Time) ([]*GitserverRepoMove, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ClaimRebalance method of the parent MockGitserverRepoStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitserverRepoStoreClaimRebalanceFunc) PushHook(hook func(context.Context, int, time.Time) ([]*GitserverRepoMove, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverRepoStoreClaimRebalanceFunc) SetDefaultReturn(r0 []*GitserverRepoMove, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, time.Time) ([]*GitserverRepoMove, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverRepoStoreClaimRebalanceFunc) PushReturn(r0 []*GitserverRepoMove, r1 error) {
+	f.PushHook(func(context.Context, int, time.Time) ([]*GitserverRepoMove, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverRepoStoreClaimRebalanceFunc) nextHook() func(context.Context, int, time.Time) ([]*GitserverRepoMove, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverRepoStoreClaimRebalanceFunc) appendCall(r0 GitserverRepoStoreClaimRebalanceFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverRepoStoreClaimRebalanceFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverRepoStoreClaimRebalanceFunc) History() []GitserverRepoStoreClaimRebalanceFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverRepoStoreClaimRebalanceFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverRepoStoreClaimRebalanceFuncCall is an object that describes an
+// invocation of method ClaimRebalance on an instance of
+// MockGitserverRepoStore.
+type GitserverRepoStoreClaimRebalanceFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 time.Time
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*GitserverRepoMove
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverRepoStoreClaimRebalanceFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverRepoStoreClaimRebalanceFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // GitserverRepoStoreGetByIDFunc describes the behavior when the GetByID
 // method of the parent MockGitserverRepoStore instance is invoked.
 type GitserverRepoStoreGetByIDFunc struct {
@@ -23380,6 +24178,115 @@ func (c GitserverRepoStoreGetByNamesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// GitserverRepoStoreGetRebalanceStatusFunc describes the behavior when the
+// GetRebalanceStatus method of the parent MockGitserverRepoStore instance
+// is invoked.
+type GitserverRepoStoreGetRebalanceStatusFunc struct {
+	defaultHook func(context.Context) (GitserverRebalanceStatus, error)
+	hooks       []func(context.Context) (GitserverRebalanceStatus, error)
+	history     []GitserverRepoStoreGetRebalanceStatusFuncCall
+	mutex       sync.Mutex
+}
+
+// GetRebalanceStatus delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGitserverRepoStore) GetRebalanceStatus(v0 context.Context) (GitserverRebalanceStatus, error) {
+	r0, r1 := m.GetRebalanceStatusFunc.nextHook()(v0)
+	m.GetRebalanceStatusFunc.appendCall(GitserverRepoStoreGetRebalanceStatusFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetRebalanceStatus
+// method of the parent MockGitserverRepoStore instance is invoked and the
+// hook queue is empty.
+func (f *GitserverRepoStoreGetRebalanceStatusFunc) SetDefaultHook(hook func(context.Context) (GitserverRebalanceStatus, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetRebalanceStatus method of the parent MockGitserverRepoStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitserverRepoStoreGetRebalanceStatusFunc) PushHook(hook func(context.Context) (GitserverRebalanceStatus, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverRepoStoreGetRebalanceStatusFunc) SetDefaultReturn(r0 GitserverRebalanceStatus, r1 error) {
+	f.SetDefaultHook(func(context.Context) (GitserverRebalanceStatus, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverRepoStoreGetRebalanceStatusFunc) PushReturn(r0 GitserverRebalanceStatus, r1 error) {
+	f.PushHook(func(context.Context) (GitserverRebalanceStatus, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverRepoStoreGetRebalanceStatusFunc) nextHook() func(context.Context) (GitserverRebalanceStatus, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverRepoStoreGetRebalanceStatusFunc) appendCall(r0 GitserverRepoStoreGetRebalanceStatusFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GitserverRepoStoreGetRebalanceStatusFuncCall objects describing the
+// invocations of this function.
+func (f *GitserverRepoStoreGetRebalanceStatusFunc) History() []GitserverRepoStoreGetRebalanceStatusFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverRepoStoreGetRebalanceStatusFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverRepoStoreGetRebalanceStatusFuncCall is an object that describes
+// an invocation of method GetRebalanceStatus on an instance of
+// MockGitserverRepoStore.
+type GitserverRepoStoreGetRebalanceStatusFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 GitserverRebalanceStatus
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverRepoStoreGetRebalanceStatusFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverRepoStoreGetRebalanceStatusFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // GitserverRepoStoreHandleFunc describes the behavior when the Handle
 // method of the parent MockGitserverRepoStore instance is invoked.
 type GitserverRepoStoreHandleFunc struct {
@@ -23815,6 +24722,118 @@ func (c GitserverRepoStoreIterateWithNonemptyLastErrorFuncCall) Results() []inte
 	return []interface{}{c.Result0}
 }
 
+// GitserverRepoStoreListRebalanceMovesFunc describes the behavior when the
+// ListRebalanceMoves method of the parent MockGitserverRepoStore instance
+// is invoked.
+type GitserverRepoStoreListRebalanceMovesFunc struct {
+	defaultHook func(context.Context, ListGitserverRepoMovesOptions) ([]*GitserverRepoMove, error)
+	hooks       []func(context.Context, ListGitserverRepoMovesOptions) ([]*GitserverRepoMove, error)
+	history     []GitserverRepoStoreListRebalanceMovesFuncCall
+	mutex       sync.Mutex
+}
+
+// ListRebalanceMoves delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGitserverRepoStore) ListRebalanceMoves(v0 context.Context, v1 ListGitserverRepoMovesOptions) ([]*GitserverRepoMove, error) {
+	r0, r1 := m.ListRebalanceMovesFunc.nextHook()(v0, v1)
+	m.ListRebalanceMovesFunc.appendCall(GitserverRepoStoreListRebalanceMovesFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListRebalanceMoves
+// method of the parent MockGitserverRepoStore instance is invoked and the
+// hook queue is empty.
+func (f *GitserverRepoStoreListRebalanceMovesFunc) SetDefaultHook(hook func(context.Context, ListGitserverRepoMovesOptions) ([]*GitserverRepoMove, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListRebalanceMoves method of the parent MockGitserverRepoStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitserverRepoStoreListRebalanceMovesFunc) PushHook(hook func(context.Context, ListGitserverRepoMovesOptions) ([]*GitserverRepoMove, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverRepoStoreListRebalanceMovesFunc) SetDefaultReturn(r0 []*GitserverRepoMove, r1 error) {
+	f.SetDefaultHook(func(context.Context, ListGitserverRepoMovesOptions) ([]*GitserverRepoMove, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverRepoStoreListRebalanceMovesFunc) PushReturn(r0 []*GitserverRepoMove, r1 error) {
+	f.PushHook(func(context.Context, ListGitserverRepoMovesOptions) ([]*GitserverRepoMove, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverRepoStoreListRebalanceMovesFunc) nextHook() func(context.Context, ListGitserverRepoMovesOptions) ([]*GitserverRepoMove, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverRepoStoreListRebalanceMovesFunc) appendCall(r0 GitserverRepoStoreListRebalanceMovesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// GitserverRepoStoreListRebalanceMovesFuncCall objects describing the
+// invocations of this function.
+func (f *GitserverRepoStoreListRebalanceMovesFunc) History() []GitserverRepoStoreListRebalanceMovesFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverRepoStoreListRebalanceMovesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverRepoStoreListRebalanceMovesFuncCall is an object that describes
+// an invocation of method ListRebalanceMoves on an instance of
+// MockGitserverRepoStore.
+type GitserverRepoStoreListRebalanceMovesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 ListGitserverRepoMovesOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*GitserverRepoMove
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverRepoStoreListRebalanceMovesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverRepoStoreListRebalanceMovesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // GitserverRepoStoreListReposWithoutSizeFunc describes the behavior when
 // the ListReposWithoutSize method of the parent MockGitserverRepoStore
 // instance is invoked.
@@ -23924,6 +24943,121 @@ func (c GitserverRepoStoreListReposWithoutSizeFuncCall) Results() []interface{}
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// GitserverRepoStoreQueueRebalanceFunc describes the behavior when the
+// QueueRebalance method of the parent MockGitserverRepoStore instance is
+// invoked.
+type GitserverRepoStoreQueueRebalanceFunc struct {
+	defaultHook func(context.Context, ...*GitserverRepoMove) error
+	hooks       []func(context.Context, ...*GitserverRepoMove) error
+	history     []GitserverRepoStoreQueueRebalanceFuncCall
+	mutex       sync.Mutex
+}
+
+// QueueRebalance delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGitserverRepoStore) QueueRebalance(v0 context.Context, v1 ...*GitserverRepoMove) error {
+	r0 := m.QueueRebalanceFunc.nextHook()(v0, v1...)
+	m.QueueRebalanceFunc.appendCall(GitserverRepoStoreQueueRebalanceFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the QueueRebalance
+// method of the parent MockGitserverRepoStore instance is invoked and the
+// hook queue is empty.
+func (f *GitserverRepoStoreQueueRebalanceFunc) SetDefaultHook(hook func(context.Context, ...*GitserverRepoMove) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// QueueRebalance method of the parent MockGitserverRepoStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitserverRepoStoreQueueRebalanceFunc) PushHook(hook func(context.Context, ...*GitserverRepoMove) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverRepoStoreQueueRebalanceFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, ...*GitserverRepoMove) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverRepoStoreQueueRebalanceFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, ...*GitserverRepoMove) error {
+		return r0
+	})
+}
+
+func (f *GitserverRepoStoreQueueRebalanceFunc) nextHook() func(context.Context, ...*GitserverRepoMove) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverRepoStoreQueueRebalanceFunc) appendCall(r0 GitserverRepoStoreQueueRebalanceFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverRepoStoreQueueRebalanceFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverRepoStoreQueueRebalanceFunc) History() []GitserverRepoStoreQueueRebalanceFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverRepoStoreQueueRebalanceFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverRepoStoreQueueRebalanceFuncCall is an object that describes an
+// invocation of method QueueRebalance on an instance of
+// MockGitserverRepoStore.
+type GitserverRepoStoreQueueRebalanceFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []*GitserverRepoMove
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c GitserverRepoStoreQueueRebalanceFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverRepoStoreQueueRebalanceFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // GitserverRepoStoreSetCloneStatusFunc describes the behavior when the
 // SetCloneStatus method of the parent MockGitserverRepoStore instance is
 // invoked.
@@ -24262,6 +25396,120 @@ func (c GitserverRepoStoreSetLastFetchedFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// GitserverRepoStoreSetRebalanceStateFunc describes the behavior when the
+// SetRebalanceState method of the parent MockGitserverRepoStore instance is
+// invoked.
+type GitserverRepoStoreSetRebalanceStateFunc struct {
+	defaultHook func(context.Context, api.RepoID, GitserverRebalanceState, string) error
+	hooks       []func(context.Context, api.RepoID, GitserverRebalanceState, string) error
+	history     []GitserverRepoStoreSetRebalanceStateFuncCall
+	mutex       sync.Mutex
+}
+
+// SetRebalanceState delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGitserverRepoStore) SetRebalanceState(v0 context.Context, v1 api.RepoID, v2 GitserverRebalanceState, v3 string) error {
+	r0 := m.SetRebalanceStateFunc.nextHook()(v0, v1, v2, v3)
+	m.SetRebalanceStateFunc.appendCall(GitserverRepoStoreSetRebalanceStateFuncCall{v0, v1, v2, v3, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the SetRebalanceState
+// method of the parent MockGitserverRepoStore instance is invoked and the
+// hook queue is empty.
+func (f *GitserverRepoStoreSetRebalanceStateFunc) SetDefaultHook(hook func(context.Context, api.RepoID, GitserverRebalanceState, string) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SetRebalanceState method of the parent MockGitserverRepoStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitserverRepoStoreSetRebalanceStateFunc) PushHook(hook func(context.Context, api.RepoID, GitserverRebalanceState, string) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverRepoStoreSetRebalanceStateFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID, GitserverRebalanceState, string) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverRepoStoreSetRebalanceStateFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoID, GitserverRebalanceState, string) error {
+		return r0
+	})
+}
+
+func (f *GitserverRepoStoreSetRebalanceStateFunc) nextHook() func(context.Context, api.RepoID, GitserverRebalanceState, string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverRepoStoreSetRebalanceStateFunc) appendCall(r0 GitserverRepoStoreSetRebalanceStateFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverRepoStoreSetRebalanceStateFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverRepoStoreSetRebalanceStateFunc) History() []GitserverRepoStoreSetRebalanceStateFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverRepoStoreSetRebalanceStateFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverRepoStoreSetRebalanceStateFuncCall is an object that describes
+// an invocation of method SetRebalanceState on an instance of
+// MockGitserverRepoStore.
+type GitserverRepoStoreSetRebalanceStateFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 GitserverRebalanceState
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverRepoStoreSetRebalanceStateFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverRepoStoreSetRebalanceStateFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // GitserverRepoStoreSetRepoSizeFunc describes the behavior when the
 // SetRepoSize method of the parent MockGitserverRepoStore instance is
 // invoked.
@@ -31004,177 +32252,161 @@ func (c OrgStoreWithFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// MockPhabricatorStore is a mock implementation of the PhabricatorStore
+// MockPermissionStore is a mock implementation of the PermissionStore
 // interface (from the package
 // github.com/sourcegraph/sourcegraph/internal/database) used for unit
 // testing.
-type MockPhabricatorStore struct {
-	// CreateFunc is an instance of a mock function object controlling the
-	// behavior of the method Create.
-	CreateFunc *PhabricatorStoreCreateFunc
-	// CreateIfNotExistsFunc is an instance of a mock function object
-	// controlling the behavior of the method CreateIfNotExists.
-	CreateIfNotExistsFunc *PhabricatorStoreCreateIfNotExistsFunc
-	// CreateOrUpdateFunc is an instance of a mock function object
-	// controlling the behavior of the method CreateOrUpdate.
-	CreateOrUpdateFunc *PhabricatorStoreCreateOrUpdateFunc
-	// GetByNameFunc is an instance of a mock function object controlling
-	// the behavior of the method GetByName.
-	GetByNameFunc *PhabricatorStoreGetByNameFunc
+type MockPermissionStore struct {
+	// CountFunc is an instance of a mock function object controlling the
+	// behavior of the method Count.
+	CountFunc *PermissionStoreCountFunc
+	// GetByIDFunc is an instance of a mock function object controlling the
+	// behavior of the method GetByID.
+	GetByIDFunc *PermissionStoreGetByIDFunc
 	// HandleFunc is an instance of a mock function object controlling the
 	// behavior of the method Handle.
-	HandleFunc *PhabricatorStoreHandleFunc
-	// TransactFunc is an instance of a mock function object controlling the
-	// behavior of the method Transact.
-	TransactFunc *PhabricatorStoreTransactFunc
+	HandleFunc *PermissionStoreHandleFunc
+	// HasPermissionFunc is an instance of a mock function object
+	// controlling the behavior of the method HasPermission.
+	HasPermissionFunc *PermissionStoreHasPermissionFunc
+	// ListFunc is an instance of a mock function object controlling the
+	// behavior of the method List.
+	ListFunc *PermissionStoreListFunc
 	// WithFunc is an instance of a mock function object controlling the
 	// behavior of the method With.
-	WithFunc *PhabricatorStoreWithFunc
+	WithFunc *PermissionStoreWithFunc
 }
 
-// NewMockPhabricatorStore creates a new mock of the PhabricatorStore
+// NewMockPermissionStore creates a new mock of the PermissionStore
 // interface. All methods return zero values for all results, unless
 // overwritten.
-func NewMockPhabricatorStore() *MockPhabricatorStore {
-	return &MockPhabricatorStore{
-		CreateFunc: &PhabricatorStoreCreateFunc{
-			defaultHook: func(context.Context, string, api.RepoName, string) (r0 *types.PhabricatorRepo, r1 error) {
-				return
-			},
-		},
-		CreateIfNotExistsFunc: &PhabricatorStoreCreateIfNotExistsFunc{
-			defaultHook: func(context.Context, string, api.RepoName, string) (r0 *types.PhabricatorRepo, r1 error) {
+func NewMockPermissionStore() *MockPermissionStore {
+	return &MockPermissionStore{
+		CountFunc: &PermissionStoreCountFunc{
+			defaultHook: func(context.Context, PermissionListOptions) (r0 int, r1 error) {
 				return
 			},
 		},
-		CreateOrUpdateFunc: &PhabricatorStoreCreateOrUpdateFunc{
-			defaultHook: func(context.Context, string, api.RepoName, string) (r0 *types.PhabricatorRepo, r1 error) {
+		GetByIDFunc: &PermissionStoreGetByIDFunc{
+			defaultHook: func(context.Context, int32) (r0 *types.Permission, r1 error) {
 				return
 			},
 		},
-		GetByNameFunc: &PhabricatorStoreGetByNameFunc{
-			defaultHook: func(context.Context, api.RepoName) (r0 *types.PhabricatorRepo, r1 error) {
+		HandleFunc: &PermissionStoreHandleFunc{
+			defaultHook: func() (r0 basestore.TransactableHandle) {
 				return
 			},
 		},
-		HandleFunc: &PhabricatorStoreHandleFunc{
-			defaultHook: func() (r0 basestore.TransactableHandle) {
+		HasPermissionFunc: &PermissionStoreHasPermissionFunc{
+			defaultHook: func(context.Context, int32, rbac.Permission) (r0 bool, r1 error) {
 				return
 			},
 		},
-		TransactFunc: &PhabricatorStoreTransactFunc{
-			defaultHook: func(context.Context) (r0 PhabricatorStore, r1 error) {
+		ListFunc: &PermissionStoreListFunc{
+			defaultHook: func(context.Context, PermissionListOptions) (r0 []*types.Permission, r1 error) {
 				return
 			},
 		},
-		WithFunc: &PhabricatorStoreWithFunc{
-			defaultHook: func(basestore.ShareableStore) (r0 PhabricatorStore) {
+		WithFunc: &PermissionStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) (r0 PermissionStore) {
 				return
 			},
 		},
 	}
 }
 
-// NewStrictMockPhabricatorStore creates a new mock of the PhabricatorStore
+// NewStrictMockPermissionStore creates a new mock of the PermissionStore
 // interface. All methods panic on invocation, unless overwritten.
-func NewStrictMockPhabricatorStore() *MockPhabricatorStore {
-	return &MockPhabricatorStore{
-		CreateFunc: &PhabricatorStoreCreateFunc{
-			defaultHook: func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error) {
-				panic("unexpected invocation of MockPhabricatorStore.Create")
-			},
-		},
-		CreateIfNotExistsFunc: &PhabricatorStoreCreateIfNotExistsFunc{
-			defaultHook: func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error) {
-				panic("unexpected invocation of MockPhabricatorStore.CreateIfNotExists")
+func NewStrictMockPermissionStore() *MockPermissionStore {
+	return &MockPermissionStore{
+		CountFunc: &PermissionStoreCountFunc{
+			defaultHook: func(context.Context, PermissionListOptions) (int, error) {
+				panic("unexpected invocation of MockPermissionStore.Count")
 			},
 		},
-		CreateOrUpdateFunc: &PhabricatorStoreCreateOrUpdateFunc{
-			defaultHook: func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error) {
-				panic("unexpected invocation of MockPhabricatorStore.CreateOrUpdate")
+		GetByIDFunc: &PermissionStoreGetByIDFunc{
+			defaultHook: func(context.Context, int32) (*types.Permission, error) {
+				panic("unexpected invocation of MockPermissionStore.GetByID")
 			},
 		},
-		GetByNameFunc: &PhabricatorStoreGetByNameFunc{
-			defaultHook: func(context.Context, api.RepoName) (*types.PhabricatorRepo, error) {
-				panic("unexpected invocation of MockPhabricatorStore.GetByName")
+		HandleFunc: &PermissionStoreHandleFunc{
+			defaultHook: func() basestore.TransactableHandle {
+				panic("unexpected invocation of MockPermissionStore.Handle")
 			},
 		},
-		HandleFunc: &PhabricatorStoreHandleFunc{
-			defaultHook: func() basestore.TransactableHandle {
-				panic("unexpected invocation of MockPhabricatorStore.Handle")
+		HasPermissionFunc: &PermissionStoreHasPermissionFunc{
+			defaultHook: func(context.Context, int32, rbac.Permission) (bool, error) {
+				panic("unexpected invocation of MockPermissionStore.HasPermission")
 			},
 		},
-		TransactFunc: &PhabricatorStoreTransactFunc{
-			defaultHook: func(context.Context) (PhabricatorStore, error) {
-				panic("unexpected invocation of MockPhabricatorStore.Transact")
+		ListFunc: &PermissionStoreListFunc{
+			defaultHook: func(context.Context, PermissionListOptions) ([]*types.Permission, error) {
+				panic("unexpected invocation of MockPermissionStore.List")
 			},
 		},
-		WithFunc: &PhabricatorStoreWithFunc{
-			defaultHook: func(basestore.ShareableStore) PhabricatorStore {
-				panic("unexpected invocation of MockPhabricatorStore.With")
+		WithFunc: &PermissionStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) PermissionStore {
+				panic("unexpected invocation of MockPermissionStore.With")
 			},
 		},
 	}
 }
 
-// NewMockPhabricatorStoreFrom creates a new mock of the
-// MockPhabricatorStore interface. All methods delegate to the given
-// implementation, unless overwritten.
-func NewMockPhabricatorStoreFrom(i PhabricatorStore) *MockPhabricatorStore {
-	return &MockPhabricatorStore{
-		CreateFunc: &PhabricatorStoreCreateFunc{
-			defaultHook: i.Create,
-		},
-		CreateIfNotExistsFunc: &PhabricatorStoreCreateIfNotExistsFunc{
-			defaultHook: i.CreateIfNotExists,
-		},
-		CreateOrUpdateFunc: &PhabricatorStoreCreateOrUpdateFunc{
-			defaultHook: i.CreateOrUpdate,
+// NewMockPermissionStoreFrom creates a new mock of the MockPermissionStore
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockPermissionStoreFrom(i PermissionStore) *MockPermissionStore {
+	return &MockPermissionStore{
+		CountFunc: &PermissionStoreCountFunc{
+			defaultHook: i.Count,
 		},
-		GetByNameFunc: &PhabricatorStoreGetByNameFunc{
-			defaultHook: i.GetByName,
+		GetByIDFunc: &PermissionStoreGetByIDFunc{
+			defaultHook: i.GetByID,
 		},
-		HandleFunc: &PhabricatorStoreHandleFunc{
+		HandleFunc: &PermissionStoreHandleFunc{
 			defaultHook: i.Handle,
 		},
-		TransactFunc: &PhabricatorStoreTransactFunc{
-			defaultHook: i.Transact,
+		HasPermissionFunc: &PermissionStoreHasPermissionFunc{
+			defaultHook: i.HasPermission,
 		},
-		WithFunc: &PhabricatorStoreWithFunc{
+		ListFunc: &PermissionStoreListFunc{
+			defaultHook: i.List,
+		},
+		WithFunc: &PermissionStoreWithFunc{
 			defaultHook: i.With,
 		},
 	}
 }
 
-// PhabricatorStoreCreateFunc describes the behavior when the Create method
-// of the parent MockPhabricatorStore instance is invoked.
-type PhabricatorStoreCreateFunc struct {
-	defaultHook func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error)
-	hooks       []func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error)
-	history     []PhabricatorStoreCreateFuncCall
+// PermissionStoreCountFunc describes the behavior when the Count method of
+// the parent MockPermissionStore instance is invoked.
+type PermissionStoreCountFunc struct {
+	defaultHook func(context.Context, PermissionListOptions) (int, error)
+	hooks       []func(context.Context, PermissionListOptions) (int, error)
+	history     []PermissionStoreCountFuncCall
 	mutex       sync.Mutex
 }
 
-// Create delegates to the next hook function in the queue and stores the
+// Count delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockPhabricatorStore) Create(v0 context.Context, v1 string, v2 api.RepoName, v3 string) (*types.PhabricatorRepo, error) {
-	r0, r1 := m.CreateFunc.nextHook()(v0, v1, v2, v3)
-	m.CreateFunc.appendCall(PhabricatorStoreCreateFuncCall{v0, v1, v2, v3, r0, r1})
+func (m *MockPermissionStore) Count(v0 context.Context, v1 PermissionListOptions) (int, error) {
+	r0, r1 := m.CountFunc.nextHook()(v0, v1)
+	m.CountFunc.appendCall(PermissionStoreCountFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Create method of the
-// parent MockPhabricatorStore instance is invoked and the hook queue is
+// SetDefaultHook sets function that is called when the Count method of the
+// parent MockPermissionStore instance is invoked and the hook queue is
 // empty.
-func (f *PhabricatorStoreCreateFunc) SetDefaultHook(hook func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error)) {
+func (f *PermissionStoreCountFunc) SetDefaultHook(hook func(context.Context, PermissionListOptions) (int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Create method of the parent MockPhabricatorStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *PhabricatorStoreCreateFunc) PushHook(hook func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error)) {
+// Count method of the parent MockPermissionStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *PermissionStoreCountFunc) PushHook(hook func(context.Context, PermissionListOptions) (int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -31182,20 +32414,20 @@ func (f *PhabricatorStoreCreateFunc) PushHook(hook func(context.Context, string,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *PhabricatorStoreCreateFunc) SetDefaultReturn(r0 *types.PhabricatorRepo, r1 error) {
-	f.SetDefaultHook(func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error) {
+func (f *PermissionStoreCountFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, PermissionListOptions) (int, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *PhabricatorStoreCreateFunc) PushReturn(r0 *types.PhabricatorRepo, r1 error) {
-	f.PushHook(func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error) {
+func (f *PermissionStoreCountFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, PermissionListOptions) (int, error) {
 		return r0, r1
 	})
 }
 
-func (f *PhabricatorStoreCreateFunc) nextHook() func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error) {
+func (f *PermissionStoreCountFunc) nextHook() func(context.Context, PermissionListOptions) (int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -31208,41 +32440,35 @@ func (f *PhabricatorStoreCreateFunc) nextHook() func(context.Context, string, ap
 	return hook
 }
 
-func (f *PhabricatorStoreCreateFunc) appendCall(r0 PhabricatorStoreCreateFuncCall) {
+func (f *PermissionStoreCountFunc) appendCall(r0 PermissionStoreCountFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of PhabricatorStoreCreateFuncCall objects
+// History returns a sequence of PermissionStoreCountFuncCall objects
 // describing the invocations of this function.
-func (f *PhabricatorStoreCreateFunc) History() []PhabricatorStoreCreateFuncCall {
+func (f *PermissionStoreCountFunc) History() []PermissionStoreCountFuncCall {
 	f.mutex.Lock()
-	history := make([]PhabricatorStoreCreateFuncCall, len(f.history))
+	history := make([]PermissionStoreCountFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// PhabricatorStoreCreateFuncCall is an object that describes an invocation
-// of method Create on an instance of MockPhabricatorStore.
-type PhabricatorStoreCreateFuncCall struct {
+// PermissionStoreCountFuncCall is an object that describes an invocation of
+// method Count on an instance of MockPermissionStore.
+type PermissionStoreCountFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 string
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 api.RepoName
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 string
+	Arg1 PermissionListOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.PhabricatorRepo
+	Result0 int
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -31250,47 +32476,45 @@ type PhabricatorStoreCreateFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c PhabricatorStoreCreateFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c PermissionStoreCountFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c PhabricatorStoreCreateFuncCall) Results() []interface{} {
+func (c PermissionStoreCountFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// PhabricatorStoreCreateIfNotExistsFunc describes the behavior when the
-// CreateIfNotExists method of the parent MockPhabricatorStore instance is
-// invoked.
-type PhabricatorStoreCreateIfNotExistsFunc struct {
-	defaultHook func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error)
-	hooks       []func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error)
-	history     []PhabricatorStoreCreateIfNotExistsFuncCall
+// PermissionStoreGetByIDFunc describes the behavior when the GetByID method
+// of the parent MockPermissionStore instance is invoked.
+type PermissionStoreGetByIDFunc struct {
+	defaultHook func(context.Context, int32) (*types.Permission, error)
+	hooks       []func(context.Context, int32) (*types.Permission, error)
+	history     []PermissionStoreGetByIDFuncCall
 	mutex       sync.Mutex
 }
 
-// CreateIfNotExists delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockPhabricatorStore) CreateIfNotExists(v0 context.Context, v1 string, v2 api.RepoName, v3 string) (*types.PhabricatorRepo, error) {
-	r0, r1 := m.CreateIfNotExistsFunc.nextHook()(v0, v1, v2, v3)
-	m.CreateIfNotExistsFunc.appendCall(PhabricatorStoreCreateIfNotExistsFuncCall{v0, v1, v2, v3, r0, r1})
+// GetByID delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockPermissionStore) GetByID(v0 context.Context, v1 int32) (*types.Permission, error) {
+	r0, r1 := m.GetByIDFunc.nextHook()(v0, v1)
+	m.GetByIDFunc.appendCall(PermissionStoreGetByIDFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the CreateIfNotExists
-// method of the parent MockPhabricatorStore instance is invoked and the
-// hook queue is empty.
-func (f *PhabricatorStoreCreateIfNotExistsFunc) SetDefaultHook(hook func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error)) {
+// SetDefaultHook sets function that is called when the GetByID method of
+// the parent MockPermissionStore instance is invoked and the hook queue is
+// empty.
+func (f *PermissionStoreGetByIDFunc) SetDefaultHook(hook func(context.Context, int32) (*types.Permission, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CreateIfNotExists method of the parent MockPhabricatorStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *PhabricatorStoreCreateIfNotExistsFunc) PushHook(hook func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error)) {
+// GetByID method of the parent MockPermissionStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *PermissionStoreGetByIDFunc) PushHook(hook func(context.Context, int32) (*types.Permission, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -31298,20 +32522,20 @@ func (f *PhabricatorStoreCreateIfNotExistsFunc) PushHook(hook func(context.Conte
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *PhabricatorStoreCreateIfNotExistsFunc) SetDefaultReturn(r0 *types.PhabricatorRepo, r1 error) {
-	f.SetDefaultHook(func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error) {
+func (f *PermissionStoreGetByIDFunc) SetDefaultReturn(r0 *types.Permission, r1 error) {
+	f.SetDefaultHook(func(context.Context, int32) (*types.Permission, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *PhabricatorStoreCreateIfNotExistsFunc) PushReturn(r0 *types.PhabricatorRepo, r1 error) {
-	f.PushHook(func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error) {
+func (f *PermissionStoreGetByIDFunc) PushReturn(r0 *types.Permission, r1 error) {
+	f.PushHook(func(context.Context, int32) (*types.Permission, error) {
 		return r0, r1
 	})
 }
 
-func (f *PhabricatorStoreCreateIfNotExistsFunc) nextHook() func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error) {
+func (f *PermissionStoreGetByIDFunc) nextHook() func(context.Context, int32) (*types.Permission, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -31324,42 +32548,35 @@ func (f *PhabricatorStoreCreateIfNotExistsFunc) nextHook() func(context.Context,
 	return hook
 }
 
-func (f *PhabricatorStoreCreateIfNotExistsFunc) appendCall(r0 PhabricatorStoreCreateIfNotExistsFuncCall) {
+func (f *PermissionStoreGetByIDFunc) appendCall(r0 PermissionStoreGetByIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of PhabricatorStoreCreateIfNotExistsFuncCall
-// objects describing the invocations of this function.
-func (f *PhabricatorStoreCreateIfNotExistsFunc) History() []PhabricatorStoreCreateIfNotExistsFuncCall {
+// History returns a sequence of PermissionStoreGetByIDFuncCall objects
+// describing the invocations of this function.
+func (f *PermissionStoreGetByIDFunc) History() []PermissionStoreGetByIDFuncCall {
 	f.mutex.Lock()
-	history := make([]PhabricatorStoreCreateIfNotExistsFuncCall, len(f.history))
+	history := make([]PermissionStoreGetByIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// PhabricatorStoreCreateIfNotExistsFuncCall is an object that describes an
-// invocation of method CreateIfNotExists on an instance of
-// MockPhabricatorStore.
-type PhabricatorStoreCreateIfNotExistsFuncCall struct {
+// PermissionStoreGetByIDFuncCall is an object that describes an invocation
+// of method GetByID on an instance of MockPermissionStore.
+type PermissionStoreGetByIDFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 string
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 api.RepoName
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 string
+	Arg1 int32
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.PhabricatorRepo
+	Result0 *types.Permission
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -31367,46 +32584,45 @@ type PhabricatorStoreCreateIfNotExistsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c PhabricatorStoreCreateIfNotExistsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c PermissionStoreGetByIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c PhabricatorStoreCreateIfNotExistsFuncCall) Results() []interface{} {
+func (c PermissionStoreGetByIDFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// PhabricatorStoreCreateOrUpdateFunc describes the behavior when the
-// CreateOrUpdate method of the parent MockPhabricatorStore instance is
-// invoked.
-type PhabricatorStoreCreateOrUpdateFunc struct {
-	defaultHook func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error)
-	hooks       []func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error)
-	history     []PhabricatorStoreCreateOrUpdateFuncCall
+// PermissionStoreHandleFunc describes the behavior when the Handle method
+// of the parent MockPermissionStore instance is invoked.
+type PermissionStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []PermissionStoreHandleFuncCall
 	mutex       sync.Mutex
 }
 
-// CreateOrUpdate delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockPhabricatorStore) CreateOrUpdate(v0 context.Context, v1 string, v2 api.RepoName, v3 string) (*types.PhabricatorRepo, error) {
-	r0, r1 := m.CreateOrUpdateFunc.nextHook()(v0, v1, v2, v3)
-	m.CreateOrUpdateFunc.appendCall(PhabricatorStoreCreateOrUpdateFuncCall{v0, v1, v2, v3, r0, r1})
-	return r0, r1
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockPermissionStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(PermissionStoreHandleFuncCall{r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the CreateOrUpdate
-// method of the parent MockPhabricatorStore instance is invoked and the
-// hook queue is empty.
-func (f *PhabricatorStoreCreateOrUpdateFunc) SetDefaultHook(hook func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error)) {
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockPermissionStore instance is invoked and the hook queue is
+// empty.
+func (f *PermissionStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CreateOrUpdate method of the parent MockPhabricatorStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *PhabricatorStoreCreateOrUpdateFunc) PushHook(hook func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error)) {
+// Handle method of the parent MockPermissionStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *PermissionStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -31414,20 +32630,20 @@ func (f *PhabricatorStoreCreateOrUpdateFunc) PushHook(hook func(context.Context,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *PhabricatorStoreCreateOrUpdateFunc) SetDefaultReturn(r0 *types.PhabricatorRepo, r1 error) {
-	f.SetDefaultHook(func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error) {
-		return r0, r1
+func (f *PermissionStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *PhabricatorStoreCreateOrUpdateFunc) PushReturn(r0 *types.PhabricatorRepo, r1 error) {
-	f.PushHook(func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error) {
-		return r0, r1
+func (f *PermissionStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
+		return r0
 	})
 }
 
-func (f *PhabricatorStoreCreateOrUpdateFunc) nextHook() func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error) {
+func (f *PermissionStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -31440,42 +32656,138 @@ func (f *PhabricatorStoreCreateOrUpdateFunc) nextHook() func(context.Context, st
 	return hook
 }
 
-func (f *PhabricatorStoreCreateOrUpdateFunc) appendCall(r0 PhabricatorStoreCreateOrUpdateFuncCall) {
+func (f *PermissionStoreHandleFunc) appendCall(r0 PermissionStoreHandleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of PhabricatorStoreCreateOrUpdateFuncCall
-// objects describing the invocations of this function.
-func (f *PhabricatorStoreCreateOrUpdateFunc) History() []PhabricatorStoreCreateOrUpdateFuncCall {
+// History returns a sequence of PermissionStoreHandleFuncCall objects
+// describing the invocations of this function.
+func (f *PermissionStoreHandleFunc) History() []PermissionStoreHandleFuncCall {
 	f.mutex.Lock()
-	history := make([]PhabricatorStoreCreateOrUpdateFuncCall, len(f.history))
+	history := make([]PermissionStoreHandleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// PhabricatorStoreCreateOrUpdateFuncCall is an object that describes an
-// invocation of method CreateOrUpdate on an instance of
-// MockPhabricatorStore.
-type PhabricatorStoreCreateOrUpdateFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
+// PermissionStoreHandleFuncCall is an object that describes an invocation
+// of method Handle on an instance of MockPermissionStore.
+type PermissionStoreHandleFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Arg1 string
-	// Arg2 is the value of the 3rd argument passed to this method
+	Result0 basestore.TransactableHandle
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c PermissionStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c PermissionStoreHandleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// PermissionStoreHasPermissionFunc describes the behavior when the
+// HasPermission method of the parent MockPermissionStore instance is
+// invoked.
+type PermissionStoreHasPermissionFunc struct {
+	defaultHook func(context.Context, int32, rbac.Permission) (bool, error)
+	hooks       []func(context.Context, int32, rbac.Permission) (bool, error)
+	history     []PermissionStoreHasPermissionFuncCall
+	mutex       sync.Mutex
+}
+
+// HasPermission delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockPermissionStore) HasPermission(v0 context.Context, v1 int32, v2 rbac.Permission) (bool, error) {
+	r0, r1 := m.HasPermissionFunc.nextHook()(v0, v1, v2)
+	m.HasPermissionFunc.appendCall(PermissionStoreHasPermissionFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the HasPermission method
+// of the parent MockPermissionStore instance is invoked and the hook queue
+// is empty.
+func (f *PermissionStoreHasPermissionFunc) SetDefaultHook(hook func(context.Context, int32, rbac.Permission) (bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// HasPermission method of the parent MockPermissionStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *PermissionStoreHasPermissionFunc) PushHook(hook func(context.Context, int32, rbac.Permission) (bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *PermissionStoreHasPermissionFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, int32, rbac.Permission) (bool, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *PermissionStoreHasPermissionFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, int32, rbac.Permission) (bool, error) {
+		return r0, r1
+	})
+}
+
+func (f *PermissionStoreHasPermissionFunc) nextHook() func(context.Context, int32, rbac.Permission) (bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *PermissionStoreHasPermissionFunc) appendCall(r0 PermissionStoreHasPermissionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of PermissionStoreHasPermissionFuncCall
+// objects describing the invocations of this function.
+func (f *PermissionStoreHasPermissionFunc) History() []PermissionStoreHasPermissionFuncCall {
+	f.mutex.Lock()
+	history := make([]PermissionStoreHasPermissionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// PermissionStoreHasPermissionFuncCall is an object that describes an
+// invocation of method HasPermission on an instance of MockPermissionStore.
+type PermissionStoreHasPermissionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg2 api.RepoName
-	// Arg3 is the value of the 4th argument passed to this method
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg3 string
+	Arg1 int32
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 rbac.Permission
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.PhabricatorRepo
+	Result0 bool
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -31483,45 +32795,45 @@ type PhabricatorStoreCreateOrUpdateFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c PhabricatorStoreCreateOrUpdateFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c PermissionStoreHasPermissionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c PhabricatorStoreCreateOrUpdateFuncCall) Results() []interface{} {
+func (c PermissionStoreHasPermissionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// PhabricatorStoreGetByNameFunc describes the behavior when the GetByName
-// method of the parent MockPhabricatorStore instance is invoked.
-type PhabricatorStoreGetByNameFunc struct {
-	defaultHook func(context.Context, api.RepoName) (*types.PhabricatorRepo, error)
-	hooks       []func(context.Context, api.RepoName) (*types.PhabricatorRepo, error)
-	history     []PhabricatorStoreGetByNameFuncCall
+// PermissionStoreListFunc describes the behavior when the List method of
+// the parent MockPermissionStore instance is invoked.
+type PermissionStoreListFunc struct {
+	defaultHook func(context.Context, PermissionListOptions) ([]*types.Permission, error)
+	hooks       []func(context.Context, PermissionListOptions) ([]*types.Permission, error)
+	history     []PermissionStoreListFuncCall
 	mutex       sync.Mutex
 }
 
-// GetByName delegates to the next hook function in the queue and stores the
+// List delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockPhabricatorStore) GetByName(v0 context.Context, v1 api.RepoName) (*types.PhabricatorRepo, error) {
-	r0, r1 := m.GetByNameFunc.nextHook()(v0, v1)
-	m.GetByNameFunc.appendCall(PhabricatorStoreGetByNameFuncCall{v0, v1, r0, r1})
+func (m *MockPermissionStore) List(v0 context.Context, v1 PermissionListOptions) ([]*types.Permission, error) {
+	r0, r1 := m.ListFunc.nextHook()(v0, v1)
+	m.ListFunc.appendCall(PermissionStoreListFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetByName method of
-// the parent MockPhabricatorStore instance is invoked and the hook queue is
+// SetDefaultHook sets function that is called when the List method of the
+// parent MockPermissionStore instance is invoked and the hook queue is
 // empty.
-func (f *PhabricatorStoreGetByNameFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*types.PhabricatorRepo, error)) {
+func (f *PermissionStoreListFunc) SetDefaultHook(hook func(context.Context, PermissionListOptions) ([]*types.Permission, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetByName method of the parent MockPhabricatorStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *PhabricatorStoreGetByNameFunc) PushHook(hook func(context.Context, api.RepoName) (*types.PhabricatorRepo, error)) {
+// List method of the parent MockPermissionStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *PermissionStoreListFunc) PushHook(hook func(context.Context, PermissionListOptions) ([]*types.Permission, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -31529,20 +32841,20 @@ func (f *PhabricatorStoreGetByNameFunc) PushHook(hook func(context.Context, api.
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *PhabricatorStoreGetByNameFunc) SetDefaultReturn(r0 *types.PhabricatorRepo, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName) (*types.PhabricatorRepo, error) {
+func (f *PermissionStoreListFunc) SetDefaultReturn(r0 []*types.Permission, r1 error) {
+	f.SetDefaultHook(func(context.Context, PermissionListOptions) ([]*types.Permission, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *PhabricatorStoreGetByNameFunc) PushReturn(r0 *types.PhabricatorRepo, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName) (*types.PhabricatorRepo, error) {
+func (f *PermissionStoreListFunc) PushReturn(r0 []*types.Permission, r1 error) {
+	f.PushHook(func(context.Context, PermissionListOptions) ([]*types.Permission, error) {
 		return r0, r1
 	})
 }
 
-func (f *PhabricatorStoreGetByNameFunc) nextHook() func(context.Context, api.RepoName) (*types.PhabricatorRepo, error) {
+func (f *PermissionStoreListFunc) nextHook() func(context.Context, PermissionListOptions) ([]*types.Permission, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -31555,35 +32867,35 @@ func (f *PhabricatorStoreGetByNameFunc) nextHook() func(context.Context, api.Rep
 	return hook
 }
 
-func (f *PhabricatorStoreGetByNameFunc) appendCall(r0 PhabricatorStoreGetByNameFuncCall) {
+func (f *PermissionStoreListFunc) appendCall(r0 PermissionStoreListFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of PhabricatorStoreGetByNameFuncCall objects
+// History returns a sequence of PermissionStoreListFuncCall objects
 // describing the invocations of this function.
-func (f *PhabricatorStoreGetByNameFunc) History() []PhabricatorStoreGetByNameFuncCall {
+func (f *PermissionStoreListFunc) History() []PermissionStoreListFuncCall {
 	f.mutex.Lock()
-	history := make([]PhabricatorStoreGetByNameFuncCall, len(f.history))
+	history := make([]PermissionStoreListFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// PhabricatorStoreGetByNameFuncCall is an object that describes an
-// invocation of method GetByName on an instance of MockPhabricatorStore.
-type PhabricatorStoreGetByNameFuncCall struct {
+// PermissionStoreListFuncCall is an object that describes an invocation of
+// method List on an instance of MockPermissionStore.
+type PermissionStoreListFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 api.RepoName
+	Arg1 PermissionListOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.PhabricatorRepo
+	Result0 []*types.Permission
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -31591,45 +32903,45 @@ type PhabricatorStoreGetByNameFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c PhabricatorStoreGetByNameFuncCall) Args() []interface{} {
+func (c PermissionStoreListFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c PhabricatorStoreGetByNameFuncCall) Results() []interface{} {
+func (c PermissionStoreListFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// PhabricatorStoreHandleFunc describes the behavior when the Handle method
-// of the parent MockPhabricatorStore instance is invoked.
-type PhabricatorStoreHandleFunc struct {
-	defaultHook func() basestore.TransactableHandle
-	hooks       []func() basestore.TransactableHandle
-	history     []PhabricatorStoreHandleFuncCall
+// PermissionStoreWithFunc describes the behavior when the With method of
+// the parent MockPermissionStore instance is invoked.
+type PermissionStoreWithFunc struct {
+	defaultHook func(basestore.ShareableStore) PermissionStore
+	hooks       []func(basestore.ShareableStore) PermissionStore
+	history     []PermissionStoreWithFuncCall
 	mutex       sync.Mutex
 }
 
-// Handle delegates to the next hook function in the queue and stores the
+// With delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockPhabricatorStore) Handle() basestore.TransactableHandle {
-	r0 := m.HandleFunc.nextHook()()
-	m.HandleFunc.appendCall(PhabricatorStoreHandleFuncCall{r0})
+func (m *MockPermissionStore) With(v0 basestore.ShareableStore) PermissionStore {
+	r0 := m.WithFunc.nextHook()(v0)
+	m.WithFunc.appendCall(PermissionStoreWithFuncCall{v0, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Handle method of the
-// parent MockPhabricatorStore instance is invoked and the hook queue is
+// SetDefaultHook sets function that is called when the With method of the
+// parent MockPermissionStore instance is invoked and the hook queue is
 // empty.
-func (f *PhabricatorStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+func (f *PermissionStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) PermissionStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Handle method of the parent MockPhabricatorStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *PhabricatorStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+// With method of the parent MockPermissionStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *PermissionStoreWithFunc) PushHook(hook func(basestore.ShareableStore) PermissionStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -31637,20 +32949,20 @@ func (f *PhabricatorStoreHandleFunc) PushHook(hook func() basestore.Transactable
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *PhabricatorStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
-	f.SetDefaultHook(func() basestore.TransactableHandle {
+func (f *PermissionStoreWithFunc) SetDefaultReturn(r0 PermissionStore) {
+	f.SetDefaultHook(func(basestore.ShareableStore) PermissionStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *PhabricatorStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
-	f.PushHook(func() basestore.TransactableHandle {
+func (f *PermissionStoreWithFunc) PushReturn(r0 PermissionStore) {
+	f.PushHook(func(basestore.ShareableStore) PermissionStore {
 		return r0
 	})
 }
 
-func (f *PhabricatorStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+func (f *PermissionStoreWithFunc) nextHook() func(basestore.ShareableStore) PermissionStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -31663,72 +32975,217 @@ func (f *PhabricatorStoreHandleFunc) nextHook() func() basestore.TransactableHan
 	return hook
 }
 
-func (f *PhabricatorStoreHandleFunc) appendCall(r0 PhabricatorStoreHandleFuncCall) {
+func (f *PermissionStoreWithFunc) appendCall(r0 PermissionStoreWithFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of PhabricatorStoreHandleFuncCall objects
+// History returns a sequence of PermissionStoreWithFuncCall objects
 // describing the invocations of this function.
-func (f *PhabricatorStoreHandleFunc) History() []PhabricatorStoreHandleFuncCall {
+func (f *PermissionStoreWithFunc) History() []PermissionStoreWithFuncCall {
 	f.mutex.Lock()
-	history := make([]PhabricatorStoreHandleFuncCall, len(f.history))
+	history := make([]PermissionStoreWithFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// PhabricatorStoreHandleFuncCall is an object that describes an invocation
-// of method Handle on an instance of MockPhabricatorStore.
-type PhabricatorStoreHandleFuncCall struct {
+// PermissionStoreWithFuncCall is an object that describes an invocation of
+// method With on an instance of MockPermissionStore.
+type PermissionStoreWithFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 basestore.ShareableStore
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 basestore.TransactableHandle
+	Result0 PermissionStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c PhabricatorStoreHandleFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c PermissionStoreWithFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c PhabricatorStoreHandleFuncCall) Results() []interface{} {
+func (c PermissionStoreWithFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// PhabricatorStoreTransactFunc describes the behavior when the Transact
-// method of the parent MockPhabricatorStore instance is invoked.
-type PhabricatorStoreTransactFunc struct {
-	defaultHook func(context.Context) (PhabricatorStore, error)
-	hooks       []func(context.Context) (PhabricatorStore, error)
-	history     []PhabricatorStoreTransactFuncCall
+// MockPhabricatorStore is a mock implementation of the PhabricatorStore
+// interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/database) used for unit
+// testing.
+type MockPhabricatorStore struct {
+	// CreateFunc is an instance of a mock function object controlling the
+	// behavior of the method Create.
+	CreateFunc *PhabricatorStoreCreateFunc
+	// CreateIfNotExistsFunc is an instance of a mock function object
+	// controlling the behavior of the method CreateIfNotExists.
+	CreateIfNotExistsFunc *PhabricatorStoreCreateIfNotExistsFunc
+	// CreateOrUpdateFunc is an instance of a mock function object
+	// controlling the behavior of the method CreateOrUpdate.
+	CreateOrUpdateFunc *PhabricatorStoreCreateOrUpdateFunc
+	// GetByNameFunc is an instance of a mock function object controlling
+	// the behavior of the method GetByName.
+	GetByNameFunc *PhabricatorStoreGetByNameFunc
+	// HandleFunc is an instance of a mock function object controlling the
+	// behavior of the method Handle.
+	HandleFunc *PhabricatorStoreHandleFunc
+	// TransactFunc is an instance of a mock function object controlling the
+	// behavior of the method Transact.
+	TransactFunc *PhabricatorStoreTransactFunc
+	// WithFunc is an instance of a mock function object controlling the
+	// behavior of the method With.
+	WithFunc *PhabricatorStoreWithFunc
+}
+
+// NewMockPhabricatorStore creates a new mock of the PhabricatorStore
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockPhabricatorStore() *MockPhabricatorStore {
+	return &MockPhabricatorStore{
+		CreateFunc: &PhabricatorStoreCreateFunc{
+			defaultHook: func(context.Context, string, api.RepoName, string) (r0 *types.PhabricatorRepo, r1 error) {
+				return
+			},
+		},
+		CreateIfNotExistsFunc: &PhabricatorStoreCreateIfNotExistsFunc{
+			defaultHook: func(context.Context, string, api.RepoName, string) (r0 *types.PhabricatorRepo, r1 error) {
+				return
+			},
+		},
+		CreateOrUpdateFunc: &PhabricatorStoreCreateOrUpdateFunc{
+			defaultHook: func(context.Context, string, api.RepoName, string) (r0 *types.PhabricatorRepo, r1 error) {
+				return
+			},
+		},
+		GetByNameFunc: &PhabricatorStoreGetByNameFunc{
+			defaultHook: func(context.Context, api.RepoName) (r0 *types.PhabricatorRepo, r1 error) {
+				return
+			},
+		},
+		HandleFunc: &PhabricatorStoreHandleFunc{
+			defaultHook: func() (r0 basestore.TransactableHandle) {
+				return
+			},
+		},
+		TransactFunc: &PhabricatorStoreTransactFunc{
+			defaultHook: func(context.Context) (r0 PhabricatorStore, r1 error) {
+				return
+			},
+		},
+		WithFunc: &PhabricatorStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) (r0 PhabricatorStore) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockPhabricatorStore creates a new mock of the PhabricatorStore
+// interface. All methods panic on invocation, unless overwritten.
+func NewStrictMockPhabricatorStore() *MockPhabricatorStore {
+	return &MockPhabricatorStore{
+		CreateFunc: &PhabricatorStoreCreateFunc{
+			defaultHook: func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error) {
+				panic("unexpected invocation of MockPhabricatorStore.Create")
+			},
+		},
+		CreateIfNotExistsFunc: &PhabricatorStoreCreateIfNotExistsFunc{
+			defaultHook: func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error) {
+				panic("unexpected invocation of MockPhabricatorStore.CreateIfNotExists")
+			},
+		},
+		CreateOrUpdateFunc: &PhabricatorStoreCreateOrUpdateFunc{
+			defaultHook: func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error) {
+				panic("unexpected invocation of MockPhabricatorStore.CreateOrUpdate")
+			},
+		},
+		GetByNameFunc: &PhabricatorStoreGetByNameFunc{
+			defaultHook: func(context.Context, api.RepoName) (*types.PhabricatorRepo, error) {
+				panic("unexpected invocation of MockPhabricatorStore.GetByName")
+			},
+		},
+		HandleFunc: &PhabricatorStoreHandleFunc{
+			defaultHook: func() basestore.TransactableHandle {
+				panic("unexpected invocation of MockPhabricatorStore.Handle")
+			},
+		},
+		TransactFunc: &PhabricatorStoreTransactFunc{
+			defaultHook: func(context.Context) (PhabricatorStore, error) {
+				panic("unexpected invocation of MockPhabricatorStore.Transact")
+			},
+		},
+		WithFunc: &PhabricatorStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) PhabricatorStore {
+				panic("unexpected invocation of MockPhabricatorStore.With")
+			},
+		},
+	}
+}
+
+// NewMockPhabricatorStoreFrom creates a new mock of the
+// MockPhabricatorStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockPhabricatorStoreFrom(i PhabricatorStore) *MockPhabricatorStore {
+	return &MockPhabricatorStore{
+		CreateFunc: &PhabricatorStoreCreateFunc{
+			defaultHook: i.Create,
+		},
+		CreateIfNotExistsFunc: &PhabricatorStoreCreateIfNotExistsFunc{
+			defaultHook: i.CreateIfNotExists,
+		},
+		CreateOrUpdateFunc: &PhabricatorStoreCreateOrUpdateFunc{
+			defaultHook: i.CreateOrUpdate,
+		},
+		GetByNameFunc: &PhabricatorStoreGetByNameFunc{
+			defaultHook: i.GetByName,
+		},
+		HandleFunc: &PhabricatorStoreHandleFunc{
+			defaultHook: i.Handle,
+		},
+		TransactFunc: &PhabricatorStoreTransactFunc{
+			defaultHook: i.Transact,
+		},
+		WithFunc: &PhabricatorStoreWithFunc{
+			defaultHook: i.With,
+		},
+	}
+}
+
+// PhabricatorStoreCreateFunc describes the behavior when the Create method
+// of the parent MockPhabricatorStore instance is invoked.
+type PhabricatorStoreCreateFunc struct {
+	defaultHook func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error)
+	hooks       []func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error)
+	history     []PhabricatorStoreCreateFuncCall
 	mutex       sync.Mutex
 }
 
-// Transact delegates to the next hook function in the queue and stores the
+// Create delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockPhabricatorStore) Transact(v0 context.Context) (PhabricatorStore, error) {
-	r0, r1 := m.TransactFunc.nextHook()(v0)
-	m.TransactFunc.appendCall(PhabricatorStoreTransactFuncCall{v0, r0, r1})
+func (m *MockPhabricatorStore) Create(v0 context.Context, v1 string, v2 api.RepoName, v3 string) (*types.PhabricatorRepo, error) {
+	r0, r1 := m.CreateFunc.nextHook()(v0, v1, v2, v3)
+	m.CreateFunc.appendCall(PhabricatorStoreCreateFuncCall{v0, v1, v2, v3, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Transact method of
-// the parent MockPhabricatorStore instance is invoked and the hook queue is
+// SetDefaultHook sets function that is called when the Create method of the
+// parent MockPhabricatorStore instance is invoked and the hook queue is
 // empty.
-func (f *PhabricatorStoreTransactFunc) SetDefaultHook(hook func(context.Context) (PhabricatorStore, error)) {
+func (f *PhabricatorStoreCreateFunc) SetDefaultHook(hook func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Transact method of the parent MockPhabricatorStore instance invokes the
+// Create method of the parent MockPhabricatorStore instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *PhabricatorStoreTransactFunc) PushHook(hook func(context.Context) (PhabricatorStore, error)) {
+func (f *PhabricatorStoreCreateFunc) PushHook(hook func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -31736,20 +33193,20 @@ func (f *PhabricatorStoreTransactFunc) PushHook(hook func(context.Context) (Phab
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *PhabricatorStoreTransactFunc) SetDefaultReturn(r0 PhabricatorStore, r1 error) {
-	f.SetDefaultHook(func(context.Context) (PhabricatorStore, error) {
+func (f *PhabricatorStoreCreateFunc) SetDefaultReturn(r0 *types.PhabricatorRepo, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *PhabricatorStoreTransactFunc) PushReturn(r0 PhabricatorStore, r1 error) {
-	f.PushHook(func(context.Context) (PhabricatorStore, error) {
+func (f *PhabricatorStoreCreateFunc) PushReturn(r0 *types.PhabricatorRepo, r1 error) {
+	f.PushHook(func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error) {
 		return r0, r1
 	})
 }
 
-func (f *PhabricatorStoreTransactFunc) nextHook() func(context.Context) (PhabricatorStore, error) {
+func (f *PhabricatorStoreCreateFunc) nextHook() func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -31762,32 +33219,41 @@ func (f *PhabricatorStoreTransactFunc) nextHook() func(context.Context) (Phabric
 	return hook
 }
 
-func (f *PhabricatorStoreTransactFunc) appendCall(r0 PhabricatorStoreTransactFuncCall) {
+func (f *PhabricatorStoreCreateFunc) appendCall(r0 PhabricatorStoreCreateFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of PhabricatorStoreTransactFuncCall objects
+// History returns a sequence of PhabricatorStoreCreateFuncCall objects
 // describing the invocations of this function.
-func (f *PhabricatorStoreTransactFunc) History() []PhabricatorStoreTransactFuncCall {
+func (f *PhabricatorStoreCreateFunc) History() []PhabricatorStoreCreateFuncCall {
 	f.mutex.Lock()
-	history := make([]PhabricatorStoreTransactFuncCall, len(f.history))
+	history := make([]PhabricatorStoreCreateFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// PhabricatorStoreTransactFuncCall is an object that describes an
-// invocation of method Transact on an instance of MockPhabricatorStore.
-type PhabricatorStoreTransactFuncCall struct {
+// PhabricatorStoreCreateFuncCall is an object that describes an invocation
+// of method Create on an instance of MockPhabricatorStore.
+type PhabricatorStoreCreateFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.RepoName
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 PhabricatorStore
+	Result0 *types.PhabricatorRepo
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -31795,45 +33261,47 @@ type PhabricatorStoreTransactFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c PhabricatorStoreTransactFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c PhabricatorStoreCreateFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c PhabricatorStoreTransactFuncCall) Results() []interface{} {
+func (c PhabricatorStoreCreateFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// PhabricatorStoreWithFunc describes the behavior when the With method of
-// the parent MockPhabricatorStore instance is invoked.
-type PhabricatorStoreWithFunc struct {
-	defaultHook func(basestore.ShareableStore) PhabricatorStore
-	hooks       []func(basestore.ShareableStore) PhabricatorStore
-	history     []PhabricatorStoreWithFuncCall
+// PhabricatorStoreCreateIfNotExistsFunc describes the behavior when the
+// CreateIfNotExists method of the parent MockPhabricatorStore instance is
+// invoked.
+type PhabricatorStoreCreateIfNotExistsFunc struct {
+	defaultHook func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error)
+	hooks       []func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error)
+	history     []PhabricatorStoreCreateIfNotExistsFuncCall
 	mutex       sync.Mutex
 }
 
-// With delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockPhabricatorStore) With(v0 basestore.ShareableStore) PhabricatorStore {
-	r0 := m.WithFunc.nextHook()(v0)
-	m.WithFunc.appendCall(PhabricatorStoreWithFuncCall{v0, r0})
-	return r0
+// CreateIfNotExists delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockPhabricatorStore) CreateIfNotExists(v0 context.Context, v1 string, v2 api.RepoName, v3 string) (*types.PhabricatorRepo, error) {
+	r0, r1 := m.CreateIfNotExistsFunc.nextHook()(v0, v1, v2, v3)
+	m.CreateIfNotExistsFunc.appendCall(PhabricatorStoreCreateIfNotExistsFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the With method of the
-// parent MockPhabricatorStore instance is invoked and the hook queue is
-// empty.
-func (f *PhabricatorStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) PhabricatorStore) {
+// SetDefaultHook sets function that is called when the CreateIfNotExists
+// method of the parent MockPhabricatorStore instance is invoked and the
+// hook queue is empty.
+func (f *PhabricatorStoreCreateIfNotExistsFunc) SetDefaultHook(hook func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// With method of the parent MockPhabricatorStore instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *PhabricatorStoreWithFunc) PushHook(hook func(basestore.ShareableStore) PhabricatorStore) {
+// CreateIfNotExists method of the parent MockPhabricatorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *PhabricatorStoreCreateIfNotExistsFunc) PushHook(hook func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -31841,20 +33309,20 @@ func (f *PhabricatorStoreWithFunc) PushHook(hook func(basestore.ShareableStore)
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *PhabricatorStoreWithFunc) SetDefaultReturn(r0 PhabricatorStore) {
-	f.SetDefaultHook(func(basestore.ShareableStore) PhabricatorStore {
-		return r0
+func (f *PhabricatorStoreCreateIfNotExistsFunc) SetDefaultReturn(r0 *types.PhabricatorRepo, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *PhabricatorStoreWithFunc) PushReturn(r0 PhabricatorStore) {
-	f.PushHook(func(basestore.ShareableStore) PhabricatorStore {
-		return r0
+func (f *PhabricatorStoreCreateIfNotExistsFunc) PushReturn(r0 *types.PhabricatorRepo, r1 error) {
+	f.PushHook(func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error) {
+		return r0, r1
 	})
 }
 
-func (f *PhabricatorStoreWithFunc) nextHook() func(basestore.ShareableStore) PhabricatorStore {
+func (f *PhabricatorStoreCreateIfNotExistsFunc) nextHook() func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -31867,423 +33335,4139 @@ func (f *PhabricatorStoreWithFunc) nextHook() func(basestore.ShareableStore) Pha
 	return hook
 }
 
-func (f *PhabricatorStoreWithFunc) appendCall(r0 PhabricatorStoreWithFuncCall) {
+func (f *PhabricatorStoreCreateIfNotExistsFunc) appendCall(r0 PhabricatorStoreCreateIfNotExistsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of PhabricatorStoreWithFuncCall objects
-// describing the invocations of this function.
-func (f *PhabricatorStoreWithFunc) History() []PhabricatorStoreWithFuncCall {
+// History returns a sequence of PhabricatorStoreCreateIfNotExistsFuncCall
+// objects describing the invocations of this function.
+func (f *PhabricatorStoreCreateIfNotExistsFunc) History() []PhabricatorStoreCreateIfNotExistsFuncCall {
 	f.mutex.Lock()
-	history := make([]PhabricatorStoreWithFuncCall, len(f.history))
+	history := make([]PhabricatorStoreCreateIfNotExistsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// PhabricatorStoreWithFuncCall is an object that describes an invocation of
-// method With on an instance of MockPhabricatorStore.
-type PhabricatorStoreWithFuncCall struct {
+// PhabricatorStoreCreateIfNotExistsFuncCall is an object that describes an
+// invocation of method CreateIfNotExists on an instance of
+// MockPhabricatorStore.
+type PhabricatorStoreCreateIfNotExistsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 basestore.ShareableStore
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.RepoName
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 PhabricatorStore
+	Result0 *types.PhabricatorRepo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c PhabricatorStoreWithFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c PhabricatorStoreCreateIfNotExistsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c PhabricatorStoreWithFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c PhabricatorStoreCreateIfNotExistsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// MockRepoStore is a mock implementation of the RepoStore interface (from
-// the package github.com/sourcegraph/sourcegraph/internal/database) used
-// for unit testing.
-type MockRepoStore struct {
-	// CountFunc is an instance of a mock function object controlling the
-	// behavior of the method Count.
-	CountFunc *RepoStoreCountFunc
-	// CreateFunc is an instance of a mock function object controlling the
-	// behavior of the method Create.
-	CreateFunc *RepoStoreCreateFunc
-	// DeleteFunc is an instance of a mock function object controlling the
-	// behavior of the method Delete.
-	DeleteFunc *RepoStoreDeleteFunc
-	// DoneFunc is an instance of a mock function object controlling the
-	// behavior of the method Done.
-	DoneFunc *RepoStoreDoneFunc
-	// GetFunc is an instance of a mock function object controlling the
-	// behavior of the method Get.
-	GetFunc *RepoStoreGetFunc
-	// GetByHashedNameFunc is an instance of a mock function object
-	// controlling the behavior of the method GetByHashedName.
-	GetByHashedNameFunc *RepoStoreGetByHashedNameFunc
-	// GetByIDsFunc is an instance of a mock function object controlling the
-	// behavior of the method GetByIDs.
-	GetByIDsFunc *RepoStoreGetByIDsFunc
-	// GetByNameFunc is an instance of a mock function object controlling
-	// the behavior of the method GetByName.
-	GetByNameFunc *RepoStoreGetByNameFunc
-	// GetFirstRepoNameByCloneURLFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// GetFirstRepoNameByCloneURL.
-	GetFirstRepoNameByCloneURLFunc *RepoStoreGetFirstRepoNameByCloneURLFunc
-	// GetRepoDescriptionsByIDsFunc is an instance of a mock function object
-	// controlling the behavior of the method GetRepoDescriptionsByIDs.
-	GetRepoDescriptionsByIDsFunc *RepoStoreGetRepoDescriptionsByIDsFunc
-	// GetReposSetByIDsFunc is an instance of a mock function object
-	// controlling the behavior of the method GetReposSetByIDs.
+// PhabricatorStoreCreateOrUpdateFunc describes the behavior when the
+// CreateOrUpdate method of the parent MockPhabricatorStore instance is
+// invoked.
+type PhabricatorStoreCreateOrUpdateFunc struct {
+	defaultHook func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error)
+	hooks       []func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error)
+	history     []PhabricatorStoreCreateOrUpdateFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateOrUpdate delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockPhabricatorStore) CreateOrUpdate(v0 context.Context, v1 string, v2 api.RepoName, v3 string) (*types.PhabricatorRepo, error) {
+	r0, r1 := m.CreateOrUpdateFunc.nextHook()(v0, v1, v2, v3)
+	m.CreateOrUpdateFunc.appendCall(PhabricatorStoreCreateOrUpdateFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CreateOrUpdate
+// method of the parent MockPhabricatorStore instance is invoked and the
+// hook queue is empty.
+func (f *PhabricatorStoreCreateOrUpdateFunc) SetDefaultHook(hook func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateOrUpdate method of the parent MockPhabricatorStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *PhabricatorStoreCreateOrUpdateFunc) PushHook(hook func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *PhabricatorStoreCreateOrUpdateFunc) SetDefaultReturn(r0 *types.PhabricatorRepo, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *PhabricatorStoreCreateOrUpdateFunc) PushReturn(r0 *types.PhabricatorRepo, r1 error) {
+	f.PushHook(func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error) {
+		return r0, r1
+	})
+}
+
+func (f *PhabricatorStoreCreateOrUpdateFunc) nextHook() func(context.Context, string, api.RepoName, string) (*types.PhabricatorRepo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *PhabricatorStoreCreateOrUpdateFunc) appendCall(r0 PhabricatorStoreCreateOrUpdateFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of PhabricatorStoreCreateOrUpdateFuncCall
+// objects describing the invocations of this function.
+func (f *PhabricatorStoreCreateOrUpdateFunc) History() []PhabricatorStoreCreateOrUpdateFuncCall {
+	f.mutex.Lock()
+	history := make([]PhabricatorStoreCreateOrUpdateFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// PhabricatorStoreCreateOrUpdateFuncCall is an object that describes an
+// invocation of method CreateOrUpdate on an instance of
+// MockPhabricatorStore.
+type PhabricatorStoreCreateOrUpdateFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.RepoName
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *types.PhabricatorRepo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c PhabricatorStoreCreateOrUpdateFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c PhabricatorStoreCreateOrUpdateFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// PhabricatorStoreGetByNameFunc describes the behavior when the GetByName
+// method of the parent MockPhabricatorStore instance is invoked.
+type PhabricatorStoreGetByNameFunc struct {
+	defaultHook func(context.Context, api.RepoName) (*types.PhabricatorRepo, error)
+	hooks       []func(context.Context, api.RepoName) (*types.PhabricatorRepo, error)
+	history     []PhabricatorStoreGetByNameFuncCall
+	mutex       sync.Mutex
+}
+
+// GetByName delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockPhabricatorStore) GetByName(v0 context.Context, v1 api.RepoName) (*types.PhabricatorRepo, error) {
+	r0, r1 := m.GetByNameFunc.nextHook()(v0, v1)
+	m.GetByNameFunc.appendCall(PhabricatorStoreGetByNameFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetByName method of
+// the parent MockPhabricatorStore instance is invoked and the hook queue is
+// empty.
+func (f *PhabricatorStoreGetByNameFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*types.PhabricatorRepo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetByName method of the parent MockPhabricatorStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *PhabricatorStoreGetByNameFunc) PushHook(hook func(context.Context, api.RepoName) (*types.PhabricatorRepo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *PhabricatorStoreGetByNameFunc) SetDefaultReturn(r0 *types.PhabricatorRepo, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) (*types.PhabricatorRepo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *PhabricatorStoreGetByNameFunc) PushReturn(r0 *types.PhabricatorRepo, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) (*types.PhabricatorRepo, error) {
+		return r0, r1
+	})
+}
+
+func (f *PhabricatorStoreGetByNameFunc) nextHook() func(context.Context, api.RepoName) (*types.PhabricatorRepo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *PhabricatorStoreGetByNameFunc) appendCall(r0 PhabricatorStoreGetByNameFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of PhabricatorStoreGetByNameFuncCall objects
+// describing the invocations of this function.
+func (f *PhabricatorStoreGetByNameFunc) History() []PhabricatorStoreGetByNameFuncCall {
+	f.mutex.Lock()
+	history := make([]PhabricatorStoreGetByNameFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// PhabricatorStoreGetByNameFuncCall is an object that describes an
+// invocation of method GetByName on an instance of MockPhabricatorStore.
+type PhabricatorStoreGetByNameFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *types.PhabricatorRepo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c PhabricatorStoreGetByNameFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c PhabricatorStoreGetByNameFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// PhabricatorStoreHandleFunc describes the behavior when the Handle method
+// of the parent MockPhabricatorStore instance is invoked.
+type PhabricatorStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []PhabricatorStoreHandleFuncCall
+	mutex       sync.Mutex
+}
+
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockPhabricatorStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(PhabricatorStoreHandleFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockPhabricatorStore instance is invoked and the hook queue is
+// empty.
+func (f *PhabricatorStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Handle method of the parent MockPhabricatorStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *PhabricatorStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *PhabricatorStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *PhabricatorStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+func (f *PhabricatorStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *PhabricatorStoreHandleFunc) appendCall(r0 PhabricatorStoreHandleFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of PhabricatorStoreHandleFuncCall objects
+// describing the invocations of this function.
+func (f *PhabricatorStoreHandleFunc) History() []PhabricatorStoreHandleFuncCall {
+	f.mutex.Lock()
+	history := make([]PhabricatorStoreHandleFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// PhabricatorStoreHandleFuncCall is an object that describes an invocation
+// of method Handle on an instance of MockPhabricatorStore.
+type PhabricatorStoreHandleFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 basestore.TransactableHandle
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c PhabricatorStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c PhabricatorStoreHandleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// PhabricatorStoreTransactFunc describes the behavior when the Transact
+// method of the parent MockPhabricatorStore instance is invoked.
+type PhabricatorStoreTransactFunc struct {
+	defaultHook func(context.Context) (PhabricatorStore, error)
+	hooks       []func(context.Context) (PhabricatorStore, error)
+	history     []PhabricatorStoreTransactFuncCall
+	mutex       sync.Mutex
+}
+
+// Transact delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockPhabricatorStore) Transact(v0 context.Context) (PhabricatorStore, error) {
+	r0, r1 := m.TransactFunc.nextHook()(v0)
+	m.TransactFunc.appendCall(PhabricatorStoreTransactFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Transact method of
+// the parent MockPhabricatorStore instance is invoked and the hook queue is
+// empty.
+func (f *PhabricatorStoreTransactFunc) SetDefaultHook(hook func(context.Context) (PhabricatorStore, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Transact method of the parent MockPhabricatorStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *PhabricatorStoreTransactFunc) PushHook(hook func(context.Context) (PhabricatorStore, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *PhabricatorStoreTransactFunc) SetDefaultReturn(r0 PhabricatorStore, r1 error) {
+	f.SetDefaultHook(func(context.Context) (PhabricatorStore, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *PhabricatorStoreTransactFunc) PushReturn(r0 PhabricatorStore, r1 error) {
+	f.PushHook(func(context.Context) (PhabricatorStore, error) {
+		return r0, r1
+	})
+}
+
+func (f *PhabricatorStoreTransactFunc) nextHook() func(context.Context) (PhabricatorStore, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *PhabricatorStoreTransactFunc) appendCall(r0 PhabricatorStoreTransactFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of PhabricatorStoreTransactFuncCall objects
+// describing the invocations of this function.
+func (f *PhabricatorStoreTransactFunc) History() []PhabricatorStoreTransactFuncCall {
+	f.mutex.Lock()
+	history := make([]PhabricatorStoreTransactFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// PhabricatorStoreTransactFuncCall is an object that describes an
+// invocation of method Transact on an instance of MockPhabricatorStore.
+type PhabricatorStoreTransactFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 PhabricatorStore
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c PhabricatorStoreTransactFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c PhabricatorStoreTransactFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// PhabricatorStoreWithFunc describes the behavior when the With method of
+// the parent MockPhabricatorStore instance is invoked.
+type PhabricatorStoreWithFunc struct {
+	defaultHook func(basestore.ShareableStore) PhabricatorStore
+	hooks       []func(basestore.ShareableStore) PhabricatorStore
+	history     []PhabricatorStoreWithFuncCall
+	mutex       sync.Mutex
+}
+
+// With delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockPhabricatorStore) With(v0 basestore.ShareableStore) PhabricatorStore {
+	r0 := m.WithFunc.nextHook()(v0)
+	m.WithFunc.appendCall(PhabricatorStoreWithFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the With method of the
+// parent MockPhabricatorStore instance is invoked and the hook queue is
+// empty.
+func (f *PhabricatorStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) PhabricatorStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// With method of the parent MockPhabricatorStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *PhabricatorStoreWithFunc) PushHook(hook func(basestore.ShareableStore) PhabricatorStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *PhabricatorStoreWithFunc) SetDefaultReturn(r0 PhabricatorStore) {
+	f.SetDefaultHook(func(basestore.ShareableStore) PhabricatorStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *PhabricatorStoreWithFunc) PushReturn(r0 PhabricatorStore) {
+	f.PushHook(func(basestore.ShareableStore) PhabricatorStore {
+		return r0
+	})
+}
+
+func (f *PhabricatorStoreWithFunc) nextHook() func(basestore.ShareableStore) PhabricatorStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *PhabricatorStoreWithFunc) appendCall(r0 PhabricatorStoreWithFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of PhabricatorStoreWithFuncCall objects
+// describing the invocations of this function.
+func (f *PhabricatorStoreWithFunc) History() []PhabricatorStoreWithFuncCall {
+	f.mutex.Lock()
+	history := make([]PhabricatorStoreWithFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// PhabricatorStoreWithFuncCall is an object that describes an invocation of
+// method With on an instance of MockPhabricatorStore.
+type PhabricatorStoreWithFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 basestore.ShareableStore
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 PhabricatorStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c PhabricatorStoreWithFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c PhabricatorStoreWithFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// MockRepoStore is a mock implementation of the RepoStore interface (from
+// the package github.com/sourcegraph/sourcegraph/internal/database) used
+// for unit testing.
+type MockRepoStore struct {
+	// CountFunc is an instance of a mock function object controlling the
+	// behavior of the method Count.
+	CountFunc *RepoStoreCountFunc
+	// CreateFunc is an instance of a mock function object controlling the
+	// behavior of the method Create.
+	CreateFunc *RepoStoreCreateFunc
+	// DeleteFunc is an instance of a mock function object controlling the
+	// behavior of the method Delete.
+	DeleteFunc *RepoStoreDeleteFunc
+	// DoneFunc is an instance of a mock function object controlling the
+	// behavior of the method Done.
+	DoneFunc *RepoStoreDoneFunc
+	// GetFunc is an instance of a mock function object controlling the
+	// behavior of the method Get.
+	GetFunc *RepoStoreGetFunc
+	// GetByHashedNameFunc is an instance of a mock function object
+	// controlling the behavior of the method GetByHashedName.
+	GetByHashedNameFunc *RepoStoreGetByHashedNameFunc
+	// GetByIDsFunc is an instance of a mock function object controlling the
+	// behavior of the method GetByIDs.
+	GetByIDsFunc *RepoStoreGetByIDsFunc
+	// GetByNameFunc is an instance of a mock function object controlling
+	// the behavior of the method GetByName.
+	GetByNameFunc *RepoStoreGetByNameFunc
+	// GetFirstRepoNameByCloneURLFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// GetFirstRepoNameByCloneURL.
+	GetFirstRepoNameByCloneURLFunc *RepoStoreGetFirstRepoNameByCloneURLFunc
+	// GetRepoDescriptionsByIDsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetRepoDescriptionsByIDs.
+	GetRepoDescriptionsByIDsFunc *RepoStoreGetRepoDescriptionsByIDsFunc
+	// GetReposSetByIDsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetReposSetByIDs.
 	GetReposSetByIDsFunc *RepoStoreGetReposSetByIDsFunc
 	// HandleFunc is an instance of a mock function object controlling the
 	// behavior of the method Handle.
-	HandleFunc *RepoStoreHandleFunc
+	HandleFunc *RepoStoreHandleFunc
+	// ListFunc is an instance of a mock function object controlling the
+	// behavior of the method List.
+	ListFunc *RepoStoreListFunc
+	// ListMinimalReposFunc is an instance of a mock function object
+	// controlling the behavior of the method ListMinimalRepos.
+	ListMinimalReposFunc *RepoStoreListMinimalReposFunc
+	// ListSourcegraphDotComIndexableReposFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// ListSourcegraphDotComIndexableRepos.
+	ListSourcegraphDotComIndexableReposFunc *RepoStoreListSourcegraphDotComIndexableReposFunc
+	// MetadataFunc is an instance of a mock function object controlling the
+	// behavior of the method Metadata.
+	MetadataFunc *RepoStoreMetadataFunc
+	// QueryFunc is an instance of a mock function object controlling the
+	// behavior of the method Query.
+	QueryFunc *RepoStoreQueryFunc
+	// StreamMinimalReposFunc is an instance of a mock function object
+	// controlling the behavior of the method StreamMinimalRepos.
+	StreamMinimalReposFunc *RepoStoreStreamMinimalReposFunc
+	// TransactFunc is an instance of a mock function object controlling the
+	// behavior of the method Transact.
+	TransactFunc *RepoStoreTransactFunc
+	// WithFunc is an instance of a mock function object controlling the
+	// behavior of the method With.
+	WithFunc *RepoStoreWithFunc
+}
+
+// NewMockRepoStore creates a new mock of the RepoStore interface. All
+// methods return zero values for all results, unless overwritten.
+func NewMockRepoStore() *MockRepoStore {
+	return &MockRepoStore{
+		CountFunc: &RepoStoreCountFunc{
+			defaultHook: func(context.Context, ReposListOptions) (r0 int, r1 error) {
+				return
+			},
+		},
+		CreateFunc: &RepoStoreCreateFunc{
+			defaultHook: func(context.Context, ...*types.Repo) (r0 error) {
+				return
+			},
+		},
+		DeleteFunc: &RepoStoreDeleteFunc{
+			defaultHook: func(context.Context, ...api.RepoID) (r0 error) {
+				return
+			},
+		},
+		DoneFunc: &RepoStoreDoneFunc{
+			defaultHook: func(error) (r0 error) {
+				return
+			},
+		},
+		GetFunc: &RepoStoreGetFunc{
+			defaultHook: func(context.Context, api.RepoID) (r0 *types.Repo, r1 error) {
+				return
+			},
+		},
+		GetByHashedNameFunc: &RepoStoreGetByHashedNameFunc{
+			defaultHook: func(context.Context, api.RepoHashedName) (r0 *types.Repo, r1 error) {
+				return
+			},
+		},
+		GetByIDsFunc: &RepoStoreGetByIDsFunc{
+			defaultHook: func(context.Context, ...api.RepoID) (r0 []*types.Repo, r1 error) {
+				return
+			},
+		},
+		GetByNameFunc: &RepoStoreGetByNameFunc{
+			defaultHook: func(context.Context, api.RepoName) (r0 *types.Repo, r1 error) {
+				return
+			},
+		},
+		GetFirstRepoNameByCloneURLFunc: &RepoStoreGetFirstRepoNameByCloneURLFunc{
+			defaultHook: func(context.Context, string) (r0 api.RepoName, r1 error) {
+				return
+			},
+		},
+		GetRepoDescriptionsByIDsFunc: &RepoStoreGetRepoDescriptionsByIDsFunc{
+			defaultHook: func(context.Context, ...api.RepoID) (r0 map[api.RepoID]string, r1 error) {
+				return
+			},
+		},
+		GetReposSetByIDsFunc: &RepoStoreGetReposSetByIDsFunc{
+			defaultHook: func(context.Context, ...api.RepoID) (r0 map[api.RepoID]*types.Repo, r1 error) {
+				return
+			},
+		},
+		HandleFunc: &RepoStoreHandleFunc{
+			defaultHook: func() (r0 basestore.TransactableHandle) {
+				return
+			},
+		},
+		ListFunc: &RepoStoreListFunc{
+			defaultHook: func(context.Context, ReposListOptions) (r0 []*types.Repo, r1 error) {
+				return
+			},
+		},
+		ListMinimalReposFunc: &RepoStoreListMinimalReposFunc{
+			defaultHook: func(context.Context, ReposListOptions) (r0 []types.MinimalRepo, r1 error) {
+				return
+			},
+		},
+		ListSourcegraphDotComIndexableReposFunc: &RepoStoreListSourcegraphDotComIndexableReposFunc{
+			defaultHook: func(context.Context, ListSourcegraphDotComIndexableReposOptions) (r0 []types.MinimalRepo, r1 error) {
+				return
+			},
+		},
+		MetadataFunc: &RepoStoreMetadataFunc{
+			defaultHook: func(context.Context, ...api.RepoID) (r0 []*types.SearchedRepo, r1 error) {
+				return
+			},
+		},
+		QueryFunc: &RepoStoreQueryFunc{
+			defaultHook: func(context.Context, *sqlf.Query) (r0 *sql.Rows, r1 error) {
+				return
+			},
+		},
+		StreamMinimalReposFunc: &RepoStoreStreamMinimalReposFunc{
+			defaultHook: func(context.Context, ReposListOptions, func(*types.MinimalRepo)) (r0 error) {
+				return
+			},
+		},
+		TransactFunc: &RepoStoreTransactFunc{
+			defaultHook: func(context.Context) (r0 RepoStore, r1 error) {
+				return
+			},
+		},
+		WithFunc: &RepoStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) (r0 RepoStore) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockRepoStore creates a new mock of the RepoStore interface. All
+// methods panic on invocation, unless overwritten.
+func NewStrictMockRepoStore() *MockRepoStore {
+	return &MockRepoStore{
+		CountFunc: &RepoStoreCountFunc{
+			defaultHook: func(context.Context, ReposListOptions) (int, error) {
+				panic("unexpected invocation of MockRepoStore.Count")
+			},
+		},
+		CreateFunc: &RepoStoreCreateFunc{
+			defaultHook: func(context.Context, ...*types.Repo) error {
+				panic("unexpected invocation of MockRepoStore.Create")
+			},
+		},
+		DeleteFunc: &RepoStoreDeleteFunc{
+			defaultHook: func(context.Context, ...api.RepoID) error {
+				panic("unexpected invocation of MockRepoStore.Delete")
+			},
+		},
+		DoneFunc: &RepoStoreDoneFunc{
+			defaultHook: func(error) error {
+				panic("unexpected invocation of MockRepoStore.Done")
+			},
+		},
+		GetFunc: &RepoStoreGetFunc{
+			defaultHook: func(context.Context, api.RepoID) (*types.Repo, error) {
+				panic("unexpected invocation of MockRepoStore.Get")
+			},
+		},
+		GetByHashedNameFunc: &RepoStoreGetByHashedNameFunc{
+			defaultHook: func(context.Context, api.RepoHashedName) (*types.Repo, error) {
+				panic("unexpected invocation of MockRepoStore.GetByHashedName")
+			},
+		},
+		GetByIDsFunc: &RepoStoreGetByIDsFunc{
+			defaultHook: func(context.Context, ...api.RepoID) ([]*types.Repo, error) {
+				panic("unexpected invocation of MockRepoStore.GetByIDs")
+			},
+		},
+		GetByNameFunc: &RepoStoreGetByNameFunc{
+			defaultHook: func(context.Context, api.RepoName) (*types.Repo, error) {
+				panic("unexpected invocation of MockRepoStore.GetByName")
+			},
+		},
+		GetFirstRepoNameByCloneURLFunc: &RepoStoreGetFirstRepoNameByCloneURLFunc{
+			defaultHook: func(context.Context, string) (api.RepoName, error) {
+				panic("unexpected invocation of MockRepoStore.GetFirstRepoNameByCloneURL")
+			},
+		},
+		GetRepoDescriptionsByIDsFunc: &RepoStoreGetRepoDescriptionsByIDsFunc{
+			defaultHook: func(context.Context, ...api.RepoID) (map[api.RepoID]string, error) {
+				panic("unexpected invocation of MockRepoStore.GetRepoDescriptionsByIDs")
+			},
+		},
+		GetReposSetByIDsFunc: &RepoStoreGetReposSetByIDsFunc{
+			defaultHook: func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
+				panic("unexpected invocation of MockRepoStore.GetReposSetByIDs")
+			},
+		},
+		HandleFunc: &RepoStoreHandleFunc{
+			defaultHook: func() basestore.TransactableHandle {
+				panic("unexpected invocation of MockRepoStore.Handle")
+			},
+		},
+		ListFunc: &RepoStoreListFunc{
+			defaultHook: func(context.Context, ReposListOptions) ([]*types.Repo, error) {
+				panic("unexpected invocation of MockRepoStore.List")
+			},
+		},
+		ListMinimalReposFunc: &RepoStoreListMinimalReposFunc{
+			defaultHook: func(context.Context, ReposListOptions) ([]types.MinimalRepo, error) {
+				panic("unexpected invocation of MockRepoStore.ListMinimalRepos")
+			},
+		},
+		ListSourcegraphDotComIndexableReposFunc: &RepoStoreListSourcegraphDotComIndexableReposFunc{
+			defaultHook: func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
+				panic("unexpected invocation of MockRepoStore.ListSourcegraphDotComIndexableRepos")
+			},
+		},
+		MetadataFunc: &RepoStoreMetadataFunc{
+			defaultHook: func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error) {
+				panic("unexpected invocation of MockRepoStore.Metadata")
+			},
+		},
+		QueryFunc: &RepoStoreQueryFunc{
+			defaultHook: func(context.Context, *sqlf.Query) (*sql.Rows, error) {
+				panic("unexpected invocation of MockRepoStore.Query")
+			},
+		},
+		StreamMinimalReposFunc: &RepoStoreStreamMinimalReposFunc{
+			defaultHook: func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error {
+				panic("unexpected invocation of MockRepoStore.StreamMinimalRepos")
+			},
+		},
+		TransactFunc: &RepoStoreTransactFunc{
+			defaultHook: func(context.Context) (RepoStore, error) {
+				panic("unexpected invocation of MockRepoStore.Transact")
+			},
+		},
+		WithFunc: &RepoStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) RepoStore {
+				panic("unexpected invocation of MockRepoStore.With")
+			},
+		},
+	}
+}
+
+// NewMockRepoStoreFrom creates a new mock of the MockRepoStore interface.
+// All methods delegate to the given implementation, unless overwritten.
+func NewMockRepoStoreFrom(i RepoStore) *MockRepoStore {
+	return &MockRepoStore{
+		CountFunc: &RepoStoreCountFunc{
+			defaultHook: i.Count,
+		},
+		CreateFunc: &RepoStoreCreateFunc{
+			defaultHook: i.Create,
+		},
+		DeleteFunc: &RepoStoreDeleteFunc{
+			defaultHook: i.Delete,
+		},
+		DoneFunc: &RepoStoreDoneFunc{
+			defaultHook: i.Done,
+		},
+		GetFunc: &RepoStoreGetFunc{
+			defaultHook: i.Get,
+		},
+		GetByHashedNameFunc: &RepoStoreGetByHashedNameFunc{
+			defaultHook: i.GetByHashedName,
+		},
+		GetByIDsFunc: &RepoStoreGetByIDsFunc{
+			defaultHook: i.GetByIDs,
+		},
+		GetByNameFunc: &RepoStoreGetByNameFunc{
+			defaultHook: i.GetByName,
+		},
+		GetFirstRepoNameByCloneURLFunc: &RepoStoreGetFirstRepoNameByCloneURLFunc{
+			defaultHook: i.GetFirstRepoNameByCloneURL,
+		},
+		GetRepoDescriptionsByIDsFunc: &RepoStoreGetRepoDescriptionsByIDsFunc{
+			defaultHook: i.GetRepoDescriptionsByIDs,
+		},
+		GetReposSetByIDsFunc: &RepoStoreGetReposSetByIDsFunc{
+			defaultHook: i.GetReposSetByIDs,
+		},
+		HandleFunc: &RepoStoreHandleFunc{
+			defaultHook: i.Handle,
+		},
+		ListFunc: &RepoStoreListFunc{
+			defaultHook: i.List,
+		},
+		ListMinimalReposFunc: &RepoStoreListMinimalReposFunc{
+			defaultHook: i.ListMinimalRepos,
+		},
+		ListSourcegraphDotComIndexableReposFunc: &RepoStoreListSourcegraphDotComIndexableReposFunc{
+			defaultHook: i.ListSourcegraphDotComIndexableRepos,
+		},
+		MetadataFunc: &RepoStoreMetadataFunc{
+			defaultHook: i.Metadata,
+		},
+		QueryFunc: &RepoStoreQueryFunc{
+			defaultHook: i.Query,
+		},
+		StreamMinimalReposFunc: &RepoStoreStreamMinimalReposFunc{
+			defaultHook: i.StreamMinimalRepos,
+		},
+		TransactFunc: &RepoStoreTransactFunc{
+			defaultHook: i.Transact,
+		},
+		WithFunc: &RepoStoreWithFunc{
+			defaultHook: i.With,
+		},
+	}
+}
+
+// RepoStoreCountFunc describes the behavior when the Count method of the
+// parent MockRepoStore instance is invoked.
+type RepoStoreCountFunc struct {
+	defaultHook func(context.Context, ReposListOptions) (int, error)
+	hooks       []func(context.Context, ReposListOptions) (int, error)
+	history     []RepoStoreCountFuncCall
+	mutex       sync.Mutex
+}
+
+// Count delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) Count(v0 context.Context, v1 ReposListOptions) (int, error) {
+	r0, r1 := m.CountFunc.nextHook()(v0, v1)
+	m.CountFunc.appendCall(RepoStoreCountFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Count method of the
+// parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreCountFunc) SetDefaultHook(hook func(context.Context, ReposListOptions) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Count method of the parent MockRepoStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *RepoStoreCountFunc) PushHook(hook func(context.Context, ReposListOptions) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreCountFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, ReposListOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreCountFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, ReposListOptions) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreCountFunc) nextHook() func(context.Context, ReposListOptions) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreCountFunc) appendCall(r0 RepoStoreCountFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreCountFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStoreCountFunc) History() []RepoStoreCountFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreCountFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreCountFuncCall is an object that describes an invocation of
+// method Count on an instance of MockRepoStore.
+type RepoStoreCountFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 ReposListOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreCountFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreCountFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStoreCreateFunc describes the behavior when the Create method of the
+// parent MockRepoStore instance is invoked.
+type RepoStoreCreateFunc struct {
+	defaultHook func(context.Context, ...*types.Repo) error
+	hooks       []func(context.Context, ...*types.Repo) error
+	history     []RepoStoreCreateFuncCall
+	mutex       sync.Mutex
+}
+
+// Create delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) Create(v0 context.Context, v1 ...*types.Repo) error {
+	r0 := m.CreateFunc.nextHook()(v0, v1...)
+	m.CreateFunc.appendCall(RepoStoreCreateFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Create method of the
+// parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreCreateFunc) SetDefaultHook(hook func(context.Context, ...*types.Repo) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Create method of the parent MockRepoStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RepoStoreCreateFunc) PushHook(hook func(context.Context, ...*types.Repo) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreCreateFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, ...*types.Repo) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreCreateFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, ...*types.Repo) error {
+		return r0
+	})
+}
+
+func (f *RepoStoreCreateFunc) nextHook() func(context.Context, ...*types.Repo) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreCreateFunc) appendCall(r0 RepoStoreCreateFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreCreateFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStoreCreateFunc) History() []RepoStoreCreateFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreCreateFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreCreateFuncCall is an object that describes an invocation of
+// method Create on an instance of MockRepoStore.
+type RepoStoreCreateFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []*types.Repo
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c RepoStoreCreateFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreCreateFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RepoStoreDeleteFunc describes the behavior when the Delete method of the
+// parent MockRepoStore instance is invoked.
+type RepoStoreDeleteFunc struct {
+	defaultHook func(context.Context, ...api.RepoID) error
+	hooks       []func(context.Context, ...api.RepoID) error
+	history     []RepoStoreDeleteFuncCall
+	mutex       sync.Mutex
+}
+
+// Delete delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) Delete(v0 context.Context, v1 ...api.RepoID) error {
+	r0 := m.DeleteFunc.nextHook()(v0, v1...)
+	m.DeleteFunc.appendCall(RepoStoreDeleteFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Delete method of the
+// parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreDeleteFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Delete method of the parent MockRepoStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RepoStoreDeleteFunc) PushHook(hook func(context.Context, ...api.RepoID) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreDeleteFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, ...api.RepoID) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreDeleteFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, ...api.RepoID) error {
+		return r0
+	})
+}
+
+func (f *RepoStoreDeleteFunc) nextHook() func(context.Context, ...api.RepoID) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreDeleteFunc) appendCall(r0 RepoStoreDeleteFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreDeleteFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStoreDeleteFunc) History() []RepoStoreDeleteFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreDeleteFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreDeleteFuncCall is an object that describes an invocation of
+// method Delete on an instance of MockRepoStore.
+type RepoStoreDeleteFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c RepoStoreDeleteFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreDeleteFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RepoStoreDoneFunc describes the behavior when the Done method of the
+// parent MockRepoStore instance is invoked.
+type RepoStoreDoneFunc struct {
+	defaultHook func(error) error
+	hooks       []func(error) error
+	history     []RepoStoreDoneFuncCall
+	mutex       sync.Mutex
+}
+
+// Done delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) Done(v0 error) error {
+	r0 := m.DoneFunc.nextHook()(v0)
+	m.DoneFunc.appendCall(RepoStoreDoneFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Done method of the
+// parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreDoneFunc) SetDefaultHook(hook func(error) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Done method of the parent MockRepoStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *RepoStoreDoneFunc) PushHook(hook func(error) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreDoneFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(error) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreDoneFunc) PushReturn(r0 error) {
+	f.PushHook(func(error) error {
+		return r0
+	})
+}
+
+func (f *RepoStoreDoneFunc) nextHook() func(error) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreDoneFunc) appendCall(r0 RepoStoreDoneFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreDoneFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStoreDoneFunc) History() []RepoStoreDoneFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreDoneFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreDoneFuncCall is an object that describes an invocation of method
+// Done on an instance of MockRepoStore.
+type RepoStoreDoneFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 error
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreDoneFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreDoneFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RepoStoreGetFunc describes the behavior when the Get method of the parent
+// MockRepoStore instance is invoked.
+type RepoStoreGetFunc struct {
+	defaultHook func(context.Context, api.RepoID) (*types.Repo, error)
+	hooks       []func(context.Context, api.RepoID) (*types.Repo, error)
+	history     []RepoStoreGetFuncCall
+	mutex       sync.Mutex
+}
+
+// Get delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) Get(v0 context.Context, v1 api.RepoID) (*types.Repo, error) {
+	r0, r1 := m.GetFunc.nextHook()(v0, v1)
+	m.GetFunc.appendCall(RepoStoreGetFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Get method of the
+// parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreGetFunc) SetDefaultHook(hook func(context.Context, api.RepoID) (*types.Repo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Get method of the parent MockRepoStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *RepoStoreGetFunc) PushHook(hook func(context.Context, api.RepoID) (*types.Repo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreGetFunc) SetDefaultReturn(r0 *types.Repo, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID) (*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreGetFunc) PushReturn(r0 *types.Repo, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID) (*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreGetFunc) nextHook() func(context.Context, api.RepoID) (*types.Repo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreGetFunc) appendCall(r0 RepoStoreGetFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreGetFuncCall objects describing the
+// invocations of this function.
+func (f *RepoStoreGetFunc) History() []RepoStoreGetFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreGetFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreGetFuncCall is an object that describes an invocation of method
+// Get on an instance of MockRepoStore.
+type RepoStoreGetFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *types.Repo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreGetFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreGetFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStoreGetByHashedNameFunc describes the behavior when the
+// GetByHashedName method of the parent MockRepoStore instance is invoked.
+type RepoStoreGetByHashedNameFunc struct {
+	defaultHook func(context.Context, api.RepoHashedName) (*types.Repo, error)
+	hooks       []func(context.Context, api.RepoHashedName) (*types.Repo, error)
+	history     []RepoStoreGetByHashedNameFuncCall
+	mutex       sync.Mutex
+}
+
+// GetByHashedName delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRepoStore) GetByHashedName(v0 context.Context, v1 api.RepoHashedName) (*types.Repo, error) {
+	r0, r1 := m.GetByHashedNameFunc.nextHook()(v0, v1)
+	m.GetByHashedNameFunc.appendCall(RepoStoreGetByHashedNameFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetByHashedName
+// method of the parent MockRepoStore instance is invoked and the hook queue
+// is empty.
+func (f *RepoStoreGetByHashedNameFunc) SetDefaultHook(hook func(context.Context, api.RepoHashedName) (*types.Repo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetByHashedName method of the parent MockRepoStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RepoStoreGetByHashedNameFunc) PushHook(hook func(context.Context, api.RepoHashedName) (*types.Repo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreGetByHashedNameFunc) SetDefaultReturn(r0 *types.Repo, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoHashedName) (*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreGetByHashedNameFunc) PushReturn(r0 *types.Repo, r1 error) {
+	f.PushHook(func(context.Context, api.RepoHashedName) (*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreGetByHashedNameFunc) nextHook() func(context.Context, api.RepoHashedName) (*types.Repo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreGetByHashedNameFunc) appendCall(r0 RepoStoreGetByHashedNameFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreGetByHashedNameFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStoreGetByHashedNameFunc) History() []RepoStoreGetByHashedNameFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreGetByHashedNameFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreGetByHashedNameFuncCall is an object that describes an
+// invocation of method GetByHashedName on an instance of MockRepoStore.
+type RepoStoreGetByHashedNameFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoHashedName
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *types.Repo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreGetByHashedNameFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreGetByHashedNameFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStoreGetByIDsFunc describes the behavior when the GetByIDs method of
+// the parent MockRepoStore instance is invoked.
+type RepoStoreGetByIDsFunc struct {
+	defaultHook func(context.Context, ...api.RepoID) ([]*types.Repo, error)
+	hooks       []func(context.Context, ...api.RepoID) ([]*types.Repo, error)
+	history     []RepoStoreGetByIDsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetByIDs delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) GetByIDs(v0 context.Context, v1 ...api.RepoID) ([]*types.Repo, error) {
+	r0, r1 := m.GetByIDsFunc.nextHook()(v0, v1...)
+	m.GetByIDsFunc.appendCall(RepoStoreGetByIDsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetByIDs method of
+// the parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreGetByIDsFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) ([]*types.Repo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetByIDs method of the parent MockRepoStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RepoStoreGetByIDsFunc) PushHook(hook func(context.Context, ...api.RepoID) ([]*types.Repo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreGetByIDsFunc) SetDefaultReturn(r0 []*types.Repo, r1 error) {
+	f.SetDefaultHook(func(context.Context, ...api.RepoID) ([]*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreGetByIDsFunc) PushReturn(r0 []*types.Repo, r1 error) {
+	f.PushHook(func(context.Context, ...api.RepoID) ([]*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreGetByIDsFunc) nextHook() func(context.Context, ...api.RepoID) ([]*types.Repo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreGetByIDsFunc) appendCall(r0 RepoStoreGetByIDsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreGetByIDsFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStoreGetByIDsFunc) History() []RepoStoreGetByIDsFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreGetByIDsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreGetByIDsFuncCall is an object that describes an invocation of
+// method GetByIDs on an instance of MockRepoStore.
+type RepoStoreGetByIDsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*types.Repo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c RepoStoreGetByIDsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreGetByIDsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStoreGetByNameFunc describes the behavior when the GetByName method
+// of the parent MockRepoStore instance is invoked.
+type RepoStoreGetByNameFunc struct {
+	defaultHook func(context.Context, api.RepoName) (*types.Repo, error)
+	hooks       []func(context.Context, api.RepoName) (*types.Repo, error)
+	history     []RepoStoreGetByNameFuncCall
+	mutex       sync.Mutex
+}
+
+// GetByName delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) GetByName(v0 context.Context, v1 api.RepoName) (*types.Repo, error) {
+	r0, r1 := m.GetByNameFunc.nextHook()(v0, v1)
+	m.GetByNameFunc.appendCall(RepoStoreGetByNameFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetByName method of
+// the parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreGetByNameFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*types.Repo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetByName method of the parent MockRepoStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RepoStoreGetByNameFunc) PushHook(hook func(context.Context, api.RepoName) (*types.Repo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreGetByNameFunc) SetDefaultReturn(r0 *types.Repo, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) (*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreGetByNameFunc) PushReturn(r0 *types.Repo, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) (*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreGetByNameFunc) nextHook() func(context.Context, api.RepoName) (*types.Repo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreGetByNameFunc) appendCall(r0 RepoStoreGetByNameFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreGetByNameFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStoreGetByNameFunc) History() []RepoStoreGetByNameFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreGetByNameFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreGetByNameFuncCall is an object that describes an invocation of
+// method GetByName on an instance of MockRepoStore.
+type RepoStoreGetByNameFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *types.Repo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreGetByNameFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreGetByNameFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStoreGetFirstRepoNameByCloneURLFunc describes the behavior when the
+// GetFirstRepoNameByCloneURL method of the parent MockRepoStore instance is
+// invoked.
+type RepoStoreGetFirstRepoNameByCloneURLFunc struct {
+	defaultHook func(context.Context, string) (api.RepoName, error)
+	hooks       []func(context.Context, string) (api.RepoName, error)
+	history     []RepoStoreGetFirstRepoNameByCloneURLFuncCall
+	mutex       sync.Mutex
+}
+
+// GetFirstRepoNameByCloneURL delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockRepoStore) GetFirstRepoNameByCloneURL(v0 context.Context, v1 string) (api.RepoName, error) {
+	r0, r1 := m.GetFirstRepoNameByCloneURLFunc.nextHook()(v0, v1)
+	m.GetFirstRepoNameByCloneURLFunc.appendCall(RepoStoreGetFirstRepoNameByCloneURLFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetFirstRepoNameByCloneURL method of the parent MockRepoStore instance is
+// invoked and the hook queue is empty.
+func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) SetDefaultHook(hook func(context.Context, string) (api.RepoName, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetFirstRepoNameByCloneURL method of the parent MockRepoStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) PushHook(hook func(context.Context, string) (api.RepoName, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) SetDefaultReturn(r0 api.RepoName, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (api.RepoName, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) PushReturn(r0 api.RepoName, r1 error) {
+	f.PushHook(func(context.Context, string) (api.RepoName, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) nextHook() func(context.Context, string) (api.RepoName, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) appendCall(r0 RepoStoreGetFirstRepoNameByCloneURLFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreGetFirstRepoNameByCloneURLFuncCall
+// objects describing the invocations of this function.
+func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) History() []RepoStoreGetFirstRepoNameByCloneURLFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreGetFirstRepoNameByCloneURLFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreGetFirstRepoNameByCloneURLFuncCall is an object that describes
+// an invocation of method GetFirstRepoNameByCloneURL on an instance of
+// MockRepoStore.
+type RepoStoreGetFirstRepoNameByCloneURLFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 api.RepoName
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreGetFirstRepoNameByCloneURLFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreGetFirstRepoNameByCloneURLFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStoreGetRepoDescriptionsByIDsFunc describes the behavior when the
+// GetRepoDescriptionsByIDs method of the parent MockRepoStore instance is
+// invoked.
+type RepoStoreGetRepoDescriptionsByIDsFunc struct {
+	defaultHook func(context.Context, ...api.RepoID) (map[api.RepoID]string, error)
+	hooks       []func(context.Context, ...api.RepoID) (map[api.RepoID]string, error)
+	history     []RepoStoreGetRepoDescriptionsByIDsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetRepoDescriptionsByIDs delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockRepoStore) GetRepoDescriptionsByIDs(v0 context.Context, v1 ...api.RepoID) (map[api.RepoID]string, error) {
+	r0, r1 := m.GetRepoDescriptionsByIDsFunc.nextHook()(v0, v1...)
+	m.GetRepoDescriptionsByIDsFunc.appendCall(RepoStoreGetRepoDescriptionsByIDsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetRepoDescriptionsByIDs method of the parent MockRepoStore instance is
+// invoked and the hook queue is empty.
+func (f *RepoStoreGetRepoDescriptionsByIDsFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) (map[api.RepoID]string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetRepoDescriptionsByIDs method of the parent MockRepoStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RepoStoreGetRepoDescriptionsByIDsFunc) PushHook(hook func(context.Context, ...api.RepoID) (map[api.RepoID]string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreGetRepoDescriptionsByIDsFunc) SetDefaultReturn(r0 map[api.RepoID]string, r1 error) {
+	f.SetDefaultHook(func(context.Context, ...api.RepoID) (map[api.RepoID]string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreGetRepoDescriptionsByIDsFunc) PushReturn(r0 map[api.RepoID]string, r1 error) {
+	f.PushHook(func(context.Context, ...api.RepoID) (map[api.RepoID]string, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreGetRepoDescriptionsByIDsFunc) nextHook() func(context.Context, ...api.RepoID) (map[api.RepoID]string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreGetRepoDescriptionsByIDsFunc) appendCall(r0 RepoStoreGetRepoDescriptionsByIDsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreGetRepoDescriptionsByIDsFuncCall
+// objects describing the invocations of this function.
+func (f *RepoStoreGetRepoDescriptionsByIDsFunc) History() []RepoStoreGetRepoDescriptionsByIDsFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreGetRepoDescriptionsByIDsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreGetRepoDescriptionsByIDsFuncCall is an object that describes an
+// invocation of method GetRepoDescriptionsByIDs on an instance of
+// MockRepoStore.
+type RepoStoreGetRepoDescriptionsByIDsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 map[api.RepoID]string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c RepoStoreGetRepoDescriptionsByIDsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreGetRepoDescriptionsByIDsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStoreGetReposSetByIDsFunc describes the behavior when the
+// GetReposSetByIDs method of the parent MockRepoStore instance is invoked.
+type RepoStoreGetReposSetByIDsFunc struct {
+	defaultHook func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error)
+	hooks       []func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error)
+	history     []RepoStoreGetReposSetByIDsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetReposSetByIDs delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRepoStore) GetReposSetByIDs(v0 context.Context, v1 ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
+	r0, r1 := m.GetReposSetByIDsFunc.nextHook()(v0, v1...)
+	m.GetReposSetByIDsFunc.appendCall(RepoStoreGetReposSetByIDsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetReposSetByIDs
+// method of the parent MockRepoStore instance is invoked and the hook queue
+// is empty.
+func (f *RepoStoreGetReposSetByIDsFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetReposSetByIDs method of the parent MockRepoStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RepoStoreGetReposSetByIDsFunc) PushHook(hook func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreGetReposSetByIDsFunc) SetDefaultReturn(r0 map[api.RepoID]*types.Repo, r1 error) {
+	f.SetDefaultHook(func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreGetReposSetByIDsFunc) PushReturn(r0 map[api.RepoID]*types.Repo, r1 error) {
+	f.PushHook(func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreGetReposSetByIDsFunc) nextHook() func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreGetReposSetByIDsFunc) appendCall(r0 RepoStoreGetReposSetByIDsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreGetReposSetByIDsFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStoreGetReposSetByIDsFunc) History() []RepoStoreGetReposSetByIDsFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreGetReposSetByIDsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreGetReposSetByIDsFuncCall is an object that describes an
+// invocation of method GetReposSetByIDs on an instance of MockRepoStore.
+type RepoStoreGetReposSetByIDsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 map[api.RepoID]*types.Repo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c RepoStoreGetReposSetByIDsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreGetReposSetByIDsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStoreHandleFunc describes the behavior when the Handle method of the
+// parent MockRepoStore instance is invoked.
+type RepoStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []RepoStoreHandleFuncCall
+	mutex       sync.Mutex
+}
+
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(RepoStoreHandleFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Handle method of the parent MockRepoStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RepoStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+func (f *RepoStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreHandleFunc) appendCall(r0 RepoStoreHandleFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreHandleFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStoreHandleFunc) History() []RepoStoreHandleFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreHandleFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreHandleFuncCall is an object that describes an invocation of
+// method Handle on an instance of MockRepoStore.
+type RepoStoreHandleFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 basestore.TransactableHandle
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreHandleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RepoStoreListFunc describes the behavior when the List method of the
+// parent MockRepoStore instance is invoked.
+type RepoStoreListFunc struct {
+	defaultHook func(context.Context, ReposListOptions) ([]*types.Repo, error)
+	hooks       []func(context.Context, ReposListOptions) ([]*types.Repo, error)
+	history     []RepoStoreListFuncCall
+	mutex       sync.Mutex
+}
+
+// List delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) List(v0 context.Context, v1 ReposListOptions) ([]*types.Repo, error) {
+	r0, r1 := m.ListFunc.nextHook()(v0, v1)
+	m.ListFunc.appendCall(RepoStoreListFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the List method of the
+// parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreListFunc) SetDefaultHook(hook func(context.Context, ReposListOptions) ([]*types.Repo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// List method of the parent MockRepoStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *RepoStoreListFunc) PushHook(hook func(context.Context, ReposListOptions) ([]*types.Repo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreListFunc) SetDefaultReturn(r0 []*types.Repo, r1 error) {
+	f.SetDefaultHook(func(context.Context, ReposListOptions) ([]*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreListFunc) PushReturn(r0 []*types.Repo, r1 error) {
+	f.PushHook(func(context.Context, ReposListOptions) ([]*types.Repo, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreListFunc) nextHook() func(context.Context, ReposListOptions) ([]*types.Repo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreListFunc) appendCall(r0 RepoStoreListFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreListFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStoreListFunc) History() []RepoStoreListFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreListFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreListFuncCall is an object that describes an invocation of method
+// List on an instance of MockRepoStore.
+type RepoStoreListFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 ReposListOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*types.Repo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreListFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreListFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStoreListMinimalReposFunc describes the behavior when the
+// ListMinimalRepos method of the parent MockRepoStore instance is invoked.
+type RepoStoreListMinimalReposFunc struct {
+	defaultHook func(context.Context, ReposListOptions) ([]types.MinimalRepo, error)
+	hooks       []func(context.Context, ReposListOptions) ([]types.MinimalRepo, error)
+	history     []RepoStoreListMinimalReposFuncCall
+	mutex       sync.Mutex
+}
+
+// ListMinimalRepos delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRepoStore) ListMinimalRepos(v0 context.Context, v1 ReposListOptions) ([]types.MinimalRepo, error) {
+	r0, r1 := m.ListMinimalReposFunc.nextHook()(v0, v1)
+	m.ListMinimalReposFunc.appendCall(RepoStoreListMinimalReposFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListMinimalRepos
+// method of the parent MockRepoStore instance is invoked and the hook queue
+// is empty.
+func (f *RepoStoreListMinimalReposFunc) SetDefaultHook(hook func(context.Context, ReposListOptions) ([]types.MinimalRepo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListMinimalRepos method of the parent MockRepoStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RepoStoreListMinimalReposFunc) PushHook(hook func(context.Context, ReposListOptions) ([]types.MinimalRepo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreListMinimalReposFunc) SetDefaultReturn(r0 []types.MinimalRepo, r1 error) {
+	f.SetDefaultHook(func(context.Context, ReposListOptions) ([]types.MinimalRepo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreListMinimalReposFunc) PushReturn(r0 []types.MinimalRepo, r1 error) {
+	f.PushHook(func(context.Context, ReposListOptions) ([]types.MinimalRepo, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreListMinimalReposFunc) nextHook() func(context.Context, ReposListOptions) ([]types.MinimalRepo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreListMinimalReposFunc) appendCall(r0 RepoStoreListMinimalReposFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreListMinimalReposFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStoreListMinimalReposFunc) History() []RepoStoreListMinimalReposFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreListMinimalReposFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreListMinimalReposFuncCall is an object that describes an
+// invocation of method ListMinimalRepos on an instance of MockRepoStore.
+type RepoStoreListMinimalReposFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 ReposListOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []types.MinimalRepo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreListMinimalReposFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreListMinimalReposFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStoreListSourcegraphDotComIndexableReposFunc describes the behavior
+// when the ListSourcegraphDotComIndexableRepos method of the parent
+// MockRepoStore instance is invoked.
+type RepoStoreListSourcegraphDotComIndexableReposFunc struct {
+	defaultHook func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error)
+	hooks       []func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error)
+	history     []RepoStoreListSourcegraphDotComIndexableReposFuncCall
+	mutex       sync.Mutex
+}
+
+// ListSourcegraphDotComIndexableRepos delegates to the next hook function
+// in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockRepoStore) ListSourcegraphDotComIndexableRepos(v0 context.Context, v1 ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
+	r0, r1 := m.ListSourcegraphDotComIndexableReposFunc.nextHook()(v0, v1)
+	m.ListSourcegraphDotComIndexableReposFunc.appendCall(RepoStoreListSourcegraphDotComIndexableReposFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListSourcegraphDotComIndexableRepos method of the parent MockRepoStore
+// instance is invoked and the hook queue is empty.
+func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) SetDefaultHook(hook func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListSourcegraphDotComIndexableRepos method of the parent MockRepoStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) PushHook(hook func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) SetDefaultReturn(r0 []types.MinimalRepo, r1 error) {
+	f.SetDefaultHook(func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) PushReturn(r0 []types.MinimalRepo, r1 error) {
+	f.PushHook(func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) nextHook() func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) appendCall(r0 RepoStoreListSourcegraphDotComIndexableReposFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// RepoStoreListSourcegraphDotComIndexableReposFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) History() []RepoStoreListSourcegraphDotComIndexableReposFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreListSourcegraphDotComIndexableReposFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreListSourcegraphDotComIndexableReposFuncCall is an object that
+// describes an invocation of method ListSourcegraphDotComIndexableRepos on
+// an instance of MockRepoStore.
+type RepoStoreListSourcegraphDotComIndexableReposFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 ListSourcegraphDotComIndexableReposOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []types.MinimalRepo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreListSourcegraphDotComIndexableReposFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreListSourcegraphDotComIndexableReposFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStoreMetadataFunc describes the behavior when the Metadata method of
+// the parent MockRepoStore instance is invoked.
+type RepoStoreMetadataFunc struct {
+	defaultHook func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error)
+	hooks       []func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error)
+	history     []RepoStoreMetadataFuncCall
+	mutex       sync.Mutex
+}
+
+// Metadata delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) Metadata(v0 context.Context, v1 ...api.RepoID) ([]*types.SearchedRepo, error) {
+	r0, r1 := m.MetadataFunc.nextHook()(v0, v1...)
+	m.MetadataFunc.appendCall(RepoStoreMetadataFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Metadata method of
+// the parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreMetadataFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Metadata method of the parent MockRepoStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RepoStoreMetadataFunc) PushHook(hook func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreMetadataFunc) SetDefaultReturn(r0 []*types.SearchedRepo, r1 error) {
+	f.SetDefaultHook(func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreMetadataFunc) PushReturn(r0 []*types.SearchedRepo, r1 error) {
+	f.PushHook(func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreMetadataFunc) nextHook() func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreMetadataFunc) appendCall(r0 RepoStoreMetadataFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreMetadataFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStoreMetadataFunc) History() []RepoStoreMetadataFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreMetadataFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreMetadataFuncCall is an object that describes an invocation of
+// method Metadata on an instance of MockRepoStore.
+type RepoStoreMetadataFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*types.SearchedRepo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c RepoStoreMetadataFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreMetadataFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStoreQueryFunc describes the behavior when the Query method of the
+// parent MockRepoStore instance is invoked.
+type RepoStoreQueryFunc struct {
+	defaultHook func(context.Context, *sqlf.Query) (*sql.Rows, error)
+	hooks       []func(context.Context, *sqlf.Query) (*sql.Rows, error)
+	history     []RepoStoreQueryFuncCall
+	mutex       sync.Mutex
+}
+
+// Query delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) Query(v0 context.Context, v1 *sqlf.Query) (*sql.Rows, error) {
+	r0, r1 := m.QueryFunc.nextHook()(v0, v1)
+	m.QueryFunc.appendCall(RepoStoreQueryFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Query method of the
+// parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreQueryFunc) SetDefaultHook(hook func(context.Context, *sqlf.Query) (*sql.Rows, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Query method of the parent MockRepoStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *RepoStoreQueryFunc) PushHook(hook func(context.Context, *sqlf.Query) (*sql.Rows, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreQueryFunc) SetDefaultReturn(r0 *sql.Rows, r1 error) {
+	f.SetDefaultHook(func(context.Context, *sqlf.Query) (*sql.Rows, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreQueryFunc) PushReturn(r0 *sql.Rows, r1 error) {
+	f.PushHook(func(context.Context, *sqlf.Query) (*sql.Rows, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreQueryFunc) nextHook() func(context.Context, *sqlf.Query) (*sql.Rows, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreQueryFunc) appendCall(r0 RepoStoreQueryFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreQueryFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStoreQueryFunc) History() []RepoStoreQueryFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreQueryFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreQueryFuncCall is an object that describes an invocation of
+// method Query on an instance of MockRepoStore.
+type RepoStoreQueryFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *sqlf.Query
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *sql.Rows
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreQueryFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreQueryFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStoreStreamMinimalReposFunc describes the behavior when the
+// StreamMinimalRepos method of the parent MockRepoStore instance is
+// invoked.
+type RepoStoreStreamMinimalReposFunc struct {
+	defaultHook func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error
+	hooks       []func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error
+	history     []RepoStoreStreamMinimalReposFuncCall
+	mutex       sync.Mutex
+}
+
+// StreamMinimalRepos delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRepoStore) StreamMinimalRepos(v0 context.Context, v1 ReposListOptions, v2 func(*types.MinimalRepo)) error {
+	r0 := m.StreamMinimalReposFunc.nextHook()(v0, v1, v2)
+	m.StreamMinimalReposFunc.appendCall(RepoStoreStreamMinimalReposFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the StreamMinimalRepos
+// method of the parent MockRepoStore instance is invoked and the hook queue
+// is empty.
+func (f *RepoStoreStreamMinimalReposFunc) SetDefaultHook(hook func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// StreamMinimalRepos method of the parent MockRepoStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *RepoStoreStreamMinimalReposFunc) PushHook(hook func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreStreamMinimalReposFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreStreamMinimalReposFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error {
+		return r0
+	})
+}
+
+func (f *RepoStoreStreamMinimalReposFunc) nextHook() func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreStreamMinimalReposFunc) appendCall(r0 RepoStoreStreamMinimalReposFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreStreamMinimalReposFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStoreStreamMinimalReposFunc) History() []RepoStoreStreamMinimalReposFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreStreamMinimalReposFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreStreamMinimalReposFuncCall is an object that describes an
+// invocation of method StreamMinimalRepos on an instance of MockRepoStore.
+type RepoStoreStreamMinimalReposFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 ReposListOptions
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 func(*types.MinimalRepo)
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreStreamMinimalReposFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreStreamMinimalReposFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RepoStoreTransactFunc describes the behavior when the Transact method of
+// the parent MockRepoStore instance is invoked.
+type RepoStoreTransactFunc struct {
+	defaultHook func(context.Context) (RepoStore, error)
+	hooks       []func(context.Context) (RepoStore, error)
+	history     []RepoStoreTransactFuncCall
+	mutex       sync.Mutex
+}
+
+// Transact delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) Transact(v0 context.Context) (RepoStore, error) {
+	r0, r1 := m.TransactFunc.nextHook()(v0)
+	m.TransactFunc.appendCall(RepoStoreTransactFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Transact method of
+// the parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreTransactFunc) SetDefaultHook(hook func(context.Context) (RepoStore, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Transact method of the parent MockRepoStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RepoStoreTransactFunc) PushHook(hook func(context.Context) (RepoStore, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreTransactFunc) SetDefaultReturn(r0 RepoStore, r1 error) {
+	f.SetDefaultHook(func(context.Context) (RepoStore, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreTransactFunc) PushReturn(r0 RepoStore, r1 error) {
+	f.PushHook(func(context.Context) (RepoStore, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoStoreTransactFunc) nextHook() func(context.Context) (RepoStore, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreTransactFunc) appendCall(r0 RepoStoreTransactFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreTransactFuncCall objects
+// describing the invocations of this function.
+func (f *RepoStoreTransactFunc) History() []RepoStoreTransactFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreTransactFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreTransactFuncCall is an object that describes an invocation of
+// method Transact on an instance of MockRepoStore.
+type RepoStoreTransactFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 RepoStore
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreTransactFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreTransactFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoStoreWithFunc describes the behavior when the With method of the
+// parent MockRepoStore instance is invoked.
+type RepoStoreWithFunc struct {
+	defaultHook func(basestore.ShareableStore) RepoStore
+	hooks       []func(basestore.ShareableStore) RepoStore
+	history     []RepoStoreWithFuncCall
+	mutex       sync.Mutex
+}
+
+// With delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoStore) With(v0 basestore.ShareableStore) RepoStore {
+	r0 := m.WithFunc.nextHook()(v0)
+	m.WithFunc.appendCall(RepoStoreWithFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the With method of the
+// parent MockRepoStore instance is invoked and the hook queue is empty.
+func (f *RepoStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) RepoStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// With method of the parent MockRepoStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *RepoStoreWithFunc) PushHook(hook func(basestore.ShareableStore) RepoStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoStoreWithFunc) SetDefaultReturn(r0 RepoStore) {
+	f.SetDefaultHook(func(basestore.ShareableStore) RepoStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoStoreWithFunc) PushReturn(r0 RepoStore) {
+	f.PushHook(func(basestore.ShareableStore) RepoStore {
+		return r0
+	})
+}
+
+func (f *RepoStoreWithFunc) nextHook() func(basestore.ShareableStore) RepoStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoStoreWithFunc) appendCall(r0 RepoStoreWithFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoStoreWithFuncCall objects describing
+// the invocations of this function.
+func (f *RepoStoreWithFunc) History() []RepoStoreWithFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoStoreWithFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoStoreWithFuncCall is an object that describes an invocation of method
+// With on an instance of MockRepoStore.
+type RepoStoreWithFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 basestore.ShareableStore
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 RepoStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoStoreWithFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoStoreWithFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// MockRepoUpdateScheduleStore is a mock implementation of the
+// RepoUpdateScheduleStore interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/database) used for unit
+// testing.
+type MockRepoUpdateScheduleStore struct {
+	// ClaimFunc is an instance of a mock function object controlling the
+	// behavior of the method Claim.
+	ClaimFunc *RepoUpdateScheduleStoreClaimFunc
+	// CountFunc is an instance of a mock function object controlling the
+	// behavior of the method Count.
+	CountFunc *RepoUpdateScheduleStoreCountFunc
+	// CountDueBeforeFunc is an instance of a mock function object
+	// controlling the behavior of the method CountDueBefore.
+	CountDueBeforeFunc *RepoUpdateScheduleStoreCountDueBeforeFunc
+	// DeleteFunc is an instance of a mock function object controlling the
+	// behavior of the method Delete.
+	DeleteFunc *RepoUpdateScheduleStoreDeleteFunc
+	// GetByRepoIDFunc is an instance of a mock function object controlling
+	// the behavior of the method GetByRepoID.
+	GetByRepoIDFunc *RepoUpdateScheduleStoreGetByRepoIDFunc
+	// HandleFunc is an instance of a mock function object controlling the
+	// behavior of the method Handle.
+	HandleFunc *RepoUpdateScheduleStoreHandleFunc
+	// InsertFunc is an instance of a mock function object controlling the
+	// behavior of the method Insert.
+	InsertFunc *RepoUpdateScheduleStoreInsertFunc
 	// ListFunc is an instance of a mock function object controlling the
 	// behavior of the method List.
-	ListFunc *RepoStoreListFunc
-	// ListMinimalReposFunc is an instance of a mock function object
-	// controlling the behavior of the method ListMinimalRepos.
-	ListMinimalReposFunc *RepoStoreListMinimalReposFunc
-	// ListSourcegraphDotComIndexableReposFunc is an instance of a mock
-	// function object controlling the behavior of the method
-	// ListSourcegraphDotComIndexableRepos.
-	ListSourcegraphDotComIndexableReposFunc *RepoStoreListSourcegraphDotComIndexableReposFunc
-	// MetadataFunc is an instance of a mock function object controlling the
-	// behavior of the method Metadata.
-	MetadataFunc *RepoStoreMetadataFunc
-	// QueryFunc is an instance of a mock function object controlling the
-	// behavior of the method Query.
-	QueryFunc *RepoStoreQueryFunc
-	// StreamMinimalReposFunc is an instance of a mock function object
-	// controlling the behavior of the method StreamMinimalRepos.
-	StreamMinimalReposFunc *RepoStoreStreamMinimalReposFunc
-	// TransactFunc is an instance of a mock function object controlling the
-	// behavior of the method Transact.
-	TransactFunc *RepoStoreTransactFunc
+	ListFunc *RepoUpdateScheduleStoreListFunc
+	// ListByRepoIDsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListByRepoIDs.
+	ListByRepoIDsFunc *RepoUpdateScheduleStoreListByRepoIDsFunc
+	// PrioritiseFunc is an instance of a mock function object controlling
+	// the behavior of the method Prioritise.
+	PrioritiseFunc *RepoUpdateScheduleStorePrioritiseFunc
+	// UpsertFunc is an instance of a mock function object controlling the
+	// behavior of the method Upsert.
+	UpsertFunc *RepoUpdateScheduleStoreUpsertFunc
 	// WithFunc is an instance of a mock function object controlling the
 	// behavior of the method With.
-	WithFunc *RepoStoreWithFunc
+	WithFunc *RepoUpdateScheduleStoreWithFunc
+}
+
+// NewMockRepoUpdateScheduleStore creates a new mock of the
+// RepoUpdateScheduleStore interface. All methods return zero values for all
+// results, unless overwritten.
+func NewMockRepoUpdateScheduleStore() *MockRepoUpdateScheduleStore {
+	return &MockRepoUpdateScheduleStore{
+		ClaimFunc: &RepoUpdateScheduleStoreClaimFunc{
+			defaultHook: func(context.Context, time.Time, ...api.RepoID) (r0 []*RepoUpdateSchedule, r1 error) {
+				return
+			},
+		},
+		CountFunc: &RepoUpdateScheduleStoreCountFunc{
+			defaultHook: func(context.Context) (r0 int, r1 error) {
+				return
+			},
+		},
+		CountDueBeforeFunc: &RepoUpdateScheduleStoreCountDueBeforeFunc{
+			defaultHook: func(context.Context, time.Time) (r0 int, r1 error) {
+				return
+			},
+		},
+		DeleteFunc: &RepoUpdateScheduleStoreDeleteFunc{
+			defaultHook: func(context.Context, ...api.RepoID) (r0 error) {
+				return
+			},
+		},
+		GetByRepoIDFunc: &RepoUpdateScheduleStoreGetByRepoIDFunc{
+			defaultHook: func(context.Context, api.RepoID) (r0 *RepoUpdateSchedule, r1 error) {
+				return
+			},
+		},
+		HandleFunc: &RepoUpdateScheduleStoreHandleFunc{
+			defaultHook: func() (r0 basestore.TransactableHandle) {
+				return
+			},
+		},
+		InsertFunc: &RepoUpdateScheduleStoreInsertFunc{
+			defaultHook: func(context.Context, time.Duration, time.Time, ...api.RepoID) (r0 error) {
+				return
+			},
+		},
+		ListFunc: &RepoUpdateScheduleStoreListFunc{
+			defaultHook: func(context.Context) (r0 []*RepoUpdateSchedule, r1 error) {
+				return
+			},
+		},
+		ListByRepoIDsFunc: &RepoUpdateScheduleStoreListByRepoIDsFunc{
+			defaultHook: func(context.Context, []api.RepoID) (r0 []*RepoUpdateSchedule, r1 error) {
+				return
+			},
+		},
+		PrioritiseFunc: &RepoUpdateScheduleStorePrioritiseFunc{
+			defaultHook: func(context.Context, time.Duration, time.Time, ...api.RepoID) (r0 error) {
+				return
+			},
+		},
+		UpsertFunc: &RepoUpdateScheduleStoreUpsertFunc{
+			defaultHook: func(context.Context, *RepoUpdateSchedule) (r0 error) {
+				return
+			},
+		},
+		WithFunc: &RepoUpdateScheduleStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) (r0 RepoUpdateScheduleStore) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockRepoUpdateScheduleStore creates a new mock of the
+// RepoUpdateScheduleStore interface. All methods panic on invocation,
+// unless overwritten.
+func NewStrictMockRepoUpdateScheduleStore() *MockRepoUpdateScheduleStore {
+	return &MockRepoUpdateScheduleStore{
+		ClaimFunc: &RepoUpdateScheduleStoreClaimFunc{
+			defaultHook: func(context.Context, time.Time, ...api.RepoID) ([]*RepoUpdateSchedule, error) {
+				panic("unexpected invocation of MockRepoUpdateScheduleStore.Claim")
+			},
+		},
+		CountFunc: &RepoUpdateScheduleStoreCountFunc{
+			defaultHook: func(context.Context) (int, error) {
+				panic("unexpected invocation of MockRepoUpdateScheduleStore.Count")
+			},
+		},
+		CountDueBeforeFunc: &RepoUpdateScheduleStoreCountDueBeforeFunc{
+			defaultHook: func(context.Context, time.Time) (int, error) {
+				panic("unexpected invocation of MockRepoUpdateScheduleStore.CountDueBefore")
+			},
+		},
+		DeleteFunc: &RepoUpdateScheduleStoreDeleteFunc{
+			defaultHook: func(context.Context, ...api.RepoID) error {
+				panic("unexpected invocation of MockRepoUpdateScheduleStore.Delete")
+			},
+		},
+		GetByRepoIDFunc: &RepoUpdateScheduleStoreGetByRepoIDFunc{
+			defaultHook: func(context.Context, api.RepoID) (*RepoUpdateSchedule, error) {
+				panic("unexpected invocation of MockRepoUpdateScheduleStore.GetByRepoID")
+			},
+		},
+		HandleFunc: &RepoUpdateScheduleStoreHandleFunc{
+			defaultHook: func() basestore.TransactableHandle {
+				panic("unexpected invocation of MockRepoUpdateScheduleStore.Handle")
+			},
+		},
+		InsertFunc: &RepoUpdateScheduleStoreInsertFunc{
+			defaultHook: func(context.Context, time.Duration, time.Time, ...api.RepoID) error {
+				panic("unexpected invocation of MockRepoUpdateScheduleStore.Insert")
+			},
+		},
+		ListFunc: &RepoUpdateScheduleStoreListFunc{
+			defaultHook: func(context.Context) ([]*RepoUpdateSchedule, error) {
+				panic("unexpected invocation of MockRepoUpdateScheduleStore.List")
+			},
+		},
+		ListByRepoIDsFunc: &RepoUpdateScheduleStoreListByRepoIDsFunc{
+			defaultHook: func(context.Context, []api.RepoID) ([]*RepoUpdateSchedule, error) {
+				panic("unexpected invocation of MockRepoUpdateScheduleStore.ListByRepoIDs")
+			},
+		},
+		PrioritiseFunc: &RepoUpdateScheduleStorePrioritiseFunc{
+			defaultHook: func(context.Context, time.Duration, time.Time, ...api.RepoID) error {
+				panic("unexpected invocation of MockRepoUpdateScheduleStore.Prioritise")
+			},
+		},
+		UpsertFunc: &RepoUpdateScheduleStoreUpsertFunc{
+			defaultHook: func(context.Context, *RepoUpdateSchedule) error {
+				panic("unexpected invocation of MockRepoUpdateScheduleStore.Upsert")
+			},
+		},
+		WithFunc: &RepoUpdateScheduleStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) RepoUpdateScheduleStore {
+				panic("unexpected invocation of MockRepoUpdateScheduleStore.With")
+			},
+		},
+	}
+}
+
+// NewMockRepoUpdateScheduleStoreFrom creates a new mock of the
+// MockRepoUpdateScheduleStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockRepoUpdateScheduleStoreFrom(i RepoUpdateScheduleStore) *MockRepoUpdateScheduleStore {
+	return &MockRepoUpdateScheduleStore{
+		ClaimFunc: &RepoUpdateScheduleStoreClaimFunc{
+			defaultHook: i.Claim,
+		},
+		CountFunc: &RepoUpdateScheduleStoreCountFunc{
+			defaultHook: i.Count,
+		},
+		CountDueBeforeFunc: &RepoUpdateScheduleStoreCountDueBeforeFunc{
+			defaultHook: i.CountDueBefore,
+		},
+		DeleteFunc: &RepoUpdateScheduleStoreDeleteFunc{
+			defaultHook: i.Delete,
+		},
+		GetByRepoIDFunc: &RepoUpdateScheduleStoreGetByRepoIDFunc{
+			defaultHook: i.GetByRepoID,
+		},
+		HandleFunc: &RepoUpdateScheduleStoreHandleFunc{
+			defaultHook: i.Handle,
+		},
+		InsertFunc: &RepoUpdateScheduleStoreInsertFunc{
+			defaultHook: i.Insert,
+		},
+		ListFunc: &RepoUpdateScheduleStoreListFunc{
+			defaultHook: i.List,
+		},
+		ListByRepoIDsFunc: &RepoUpdateScheduleStoreListByRepoIDsFunc{
+			defaultHook: i.ListByRepoIDs,
+		},
+		PrioritiseFunc: &RepoUpdateScheduleStorePrioritiseFunc{
+			defaultHook: i.Prioritise,
+		},
+		UpsertFunc: &RepoUpdateScheduleStoreUpsertFunc{
+			defaultHook: i.Upsert,
+		},
+		WithFunc: &RepoUpdateScheduleStoreWithFunc{
+			defaultHook: i.With,
+		},
+	}
+}
+
+// RepoUpdateScheduleStoreClaimFunc describes the behavior when the Claim
+// method of the parent MockRepoUpdateScheduleStore instance is invoked.
+type RepoUpdateScheduleStoreClaimFunc struct {
+	defaultHook func(context.Context, time.Time, ...api.RepoID) ([]*RepoUpdateSchedule, error)
+	hooks       []func(context.Context, time.Time, ...api.RepoID) ([]*RepoUpdateSchedule, error)
+	history     []RepoUpdateScheduleStoreClaimFuncCall
+	mutex       sync.Mutex
+}
+
+// Claim delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoUpdateScheduleStore) Claim(v0 context.Context, v1 time.Time, v2 ...api.RepoID) ([]*RepoUpdateSchedule, error) {
+	r0, r1 := m.ClaimFunc.nextHook()(v0, v1, v2...)
+	m.ClaimFunc.appendCall(RepoUpdateScheduleStoreClaimFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Claim method of the
+// parent MockRepoUpdateScheduleStore instance is invoked and the hook queue
+// is empty.
+func (f *RepoUpdateScheduleStoreClaimFunc) SetDefaultHook(hook func(context.Context, time.Time, ...api.RepoID) ([]*RepoUpdateSchedule, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Claim method of the parent MockRepoUpdateScheduleStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *RepoUpdateScheduleStoreClaimFunc) PushHook(hook func(context.Context, time.Time, ...api.RepoID) ([]*RepoUpdateSchedule, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoUpdateScheduleStoreClaimFunc) SetDefaultReturn(r0 []*RepoUpdateSchedule, r1 error) {
+	f.SetDefaultHook(func(context.Context, time.Time, ...api.RepoID) ([]*RepoUpdateSchedule, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoUpdateScheduleStoreClaimFunc) PushReturn(r0 []*RepoUpdateSchedule, r1 error) {
+	f.PushHook(func(context.Context, time.Time, ...api.RepoID) ([]*RepoUpdateSchedule, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoUpdateScheduleStoreClaimFunc) nextHook() func(context.Context, time.Time, ...api.RepoID) ([]*RepoUpdateSchedule, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoUpdateScheduleStoreClaimFunc) appendCall(r0 RepoUpdateScheduleStoreClaimFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoUpdateScheduleStoreClaimFuncCall
+// objects describing the invocations of this function.
+func (f *RepoUpdateScheduleStoreClaimFunc) History() []RepoUpdateScheduleStoreClaimFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoUpdateScheduleStoreClaimFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoUpdateScheduleStoreClaimFuncCall is an object that describes an
+// invocation of method Claim on an instance of MockRepoUpdateScheduleStore.
+type RepoUpdateScheduleStoreClaimFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 time.Time
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*RepoUpdateSchedule
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c RepoUpdateScheduleStoreClaimFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoUpdateScheduleStoreClaimFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoUpdateScheduleStoreCountFunc describes the behavior when the Count
+// method of the parent MockRepoUpdateScheduleStore instance is invoked.
+type RepoUpdateScheduleStoreCountFunc struct {
+	defaultHook func(context.Context) (int, error)
+	hooks       []func(context.Context) (int, error)
+	history     []RepoUpdateScheduleStoreCountFuncCall
+	mutex       sync.Mutex
+}
+
+// Count delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoUpdateScheduleStore) Count(v0 context.Context) (int, error) {
+	r0, r1 := m.CountFunc.nextHook()(v0)
+	m.CountFunc.appendCall(RepoUpdateScheduleStoreCountFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Count method of the
+// parent MockRepoUpdateScheduleStore instance is invoked and the hook queue
+// is empty.
+func (f *RepoUpdateScheduleStoreCountFunc) SetDefaultHook(hook func(context.Context) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Count method of the parent MockRepoUpdateScheduleStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *RepoUpdateScheduleStoreCountFunc) PushHook(hook func(context.Context) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoUpdateScheduleStoreCountFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoUpdateScheduleStoreCountFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoUpdateScheduleStoreCountFunc) nextHook() func(context.Context) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoUpdateScheduleStoreCountFunc) appendCall(r0 RepoUpdateScheduleStoreCountFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoUpdateScheduleStoreCountFuncCall
+// objects describing the invocations of this function.
+func (f *RepoUpdateScheduleStoreCountFunc) History() []RepoUpdateScheduleStoreCountFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoUpdateScheduleStoreCountFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoUpdateScheduleStoreCountFuncCall is an object that describes an
+// invocation of method Count on an instance of MockRepoUpdateScheduleStore.
+type RepoUpdateScheduleStoreCountFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoUpdateScheduleStoreCountFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoUpdateScheduleStoreCountFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoUpdateScheduleStoreCountDueBeforeFunc describes the behavior when the
+// CountDueBefore method of the parent MockRepoUpdateScheduleStore instance
+// is invoked.
+type RepoUpdateScheduleStoreCountDueBeforeFunc struct {
+	defaultHook func(context.Context, time.Time) (int, error)
+	hooks       []func(context.Context, time.Time) (int, error)
+	history     []RepoUpdateScheduleStoreCountDueBeforeFuncCall
+	mutex       sync.Mutex
+}
+
+// CountDueBefore delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRepoUpdateScheduleStore) CountDueBefore(v0 context.Context, v1 time.Time) (int, error) {
+	r0, r1 := m.CountDueBeforeFunc.nextHook()(v0, v1)
+	m.CountDueBeforeFunc.appendCall(RepoUpdateScheduleStoreCountDueBeforeFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CountDueBefore
+// method of the parent MockRepoUpdateScheduleStore instance is invoked and
+// the hook queue is empty.
+func (f *RepoUpdateScheduleStoreCountDueBeforeFunc) SetDefaultHook(hook func(context.Context, time.Time) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CountDueBefore method of the parent MockRepoUpdateScheduleStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RepoUpdateScheduleStoreCountDueBeforeFunc) PushHook(hook func(context.Context, time.Time) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoUpdateScheduleStoreCountDueBeforeFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, time.Time) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoUpdateScheduleStoreCountDueBeforeFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, time.Time) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoUpdateScheduleStoreCountDueBeforeFunc) nextHook() func(context.Context, time.Time) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoUpdateScheduleStoreCountDueBeforeFunc) appendCall(r0 RepoUpdateScheduleStoreCountDueBeforeFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
 }
 
-// NewMockRepoStore creates a new mock of the RepoStore interface. All
-// methods return zero values for all results, unless overwritten.
-func NewMockRepoStore() *MockRepoStore {
-	return &MockRepoStore{
-		CountFunc: &RepoStoreCountFunc{
-			defaultHook: func(context.Context, ReposListOptions) (r0 int, r1 error) {
-				return
-			},
-		},
-		CreateFunc: &RepoStoreCreateFunc{
-			defaultHook: func(context.Context, ...*types.Repo) (r0 error) {
-				return
-			},
-		},
-		DeleteFunc: &RepoStoreDeleteFunc{
-			defaultHook: func(context.Context, ...api.RepoID) (r0 error) {
-				return
-			},
-		},
-		DoneFunc: &RepoStoreDoneFunc{
-			defaultHook: func(error) (r0 error) {
-				return
-			},
-		},
-		GetFunc: &RepoStoreGetFunc{
-			defaultHook: func(context.Context, api.RepoID) (r0 *types.Repo, r1 error) {
-				return
-			},
-		},
-		GetByHashedNameFunc: &RepoStoreGetByHashedNameFunc{
-			defaultHook: func(context.Context, api.RepoHashedName) (r0 *types.Repo, r1 error) {
-				return
-			},
-		},
-		GetByIDsFunc: &RepoStoreGetByIDsFunc{
-			defaultHook: func(context.Context, ...api.RepoID) (r0 []*types.Repo, r1 error) {
-				return
-			},
-		},
-		GetByNameFunc: &RepoStoreGetByNameFunc{
-			defaultHook: func(context.Context, api.RepoName) (r0 *types.Repo, r1 error) {
-				return
-			},
-		},
-		GetFirstRepoNameByCloneURLFunc: &RepoStoreGetFirstRepoNameByCloneURLFunc{
-			defaultHook: func(context.Context, string) (r0 api.RepoName, r1 error) {
-				return
-			},
-		},
-		GetRepoDescriptionsByIDsFunc: &RepoStoreGetRepoDescriptionsByIDsFunc{
-			defaultHook: func(context.Context, ...api.RepoID) (r0 map[api.RepoID]string, r1 error) {
-				return
-			},
-		},
-		GetReposSetByIDsFunc: &RepoStoreGetReposSetByIDsFunc{
-			defaultHook: func(context.Context, ...api.RepoID) (r0 map[api.RepoID]*types.Repo, r1 error) {
-				return
-			},
-		},
-		HandleFunc: &RepoStoreHandleFunc{
-			defaultHook: func() (r0 basestore.TransactableHandle) {
-				return
-			},
-		},
-		ListFunc: &RepoStoreListFunc{
-			defaultHook: func(context.Context, ReposListOptions) (r0 []*types.Repo, r1 error) {
-				return
-			},
-		},
-		ListMinimalReposFunc: &RepoStoreListMinimalReposFunc{
-			defaultHook: func(context.Context, ReposListOptions) (r0 []types.MinimalRepo, r1 error) {
-				return
-			},
-		},
-		ListSourcegraphDotComIndexableReposFunc: &RepoStoreListSourcegraphDotComIndexableReposFunc{
-			defaultHook: func(context.Context, ListSourcegraphDotComIndexableReposOptions) (r0 []types.MinimalRepo, r1 error) {
-				return
-			},
-		},
-		MetadataFunc: &RepoStoreMetadataFunc{
-			defaultHook: func(context.Context, ...api.RepoID) (r0 []*types.SearchedRepo, r1 error) {
-				return
-			},
-		},
-		QueryFunc: &RepoStoreQueryFunc{
-			defaultHook: func(context.Context, *sqlf.Query) (r0 *sql.Rows, r1 error) {
-				return
-			},
-		},
-		StreamMinimalReposFunc: &RepoStoreStreamMinimalReposFunc{
-			defaultHook: func(context.Context, ReposListOptions, func(*types.MinimalRepo)) (r0 error) {
-				return
-			},
-		},
-		TransactFunc: &RepoStoreTransactFunc{
-			defaultHook: func(context.Context) (r0 RepoStore, r1 error) {
-				return
-			},
-		},
-		WithFunc: &RepoStoreWithFunc{
-			defaultHook: func(basestore.ShareableStore) (r0 RepoStore) {
-				return
-			},
-		},
+// History returns a sequence of
+// RepoUpdateScheduleStoreCountDueBeforeFuncCall objects describing the
+// invocations of this function.
+func (f *RepoUpdateScheduleStoreCountDueBeforeFunc) History() []RepoUpdateScheduleStoreCountDueBeforeFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoUpdateScheduleStoreCountDueBeforeFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoUpdateScheduleStoreCountDueBeforeFuncCall is an object that describes
+// an invocation of method CountDueBefore on an instance of
+// MockRepoUpdateScheduleStore.
+type RepoUpdateScheduleStoreCountDueBeforeFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 time.Time
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoUpdateScheduleStoreCountDueBeforeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoUpdateScheduleStoreCountDueBeforeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoUpdateScheduleStoreDeleteFunc describes the behavior when the Delete
+// method of the parent MockRepoUpdateScheduleStore instance is invoked.
+type RepoUpdateScheduleStoreDeleteFunc struct {
+	defaultHook func(context.Context, ...api.RepoID) error
+	hooks       []func(context.Context, ...api.RepoID) error
+	history     []RepoUpdateScheduleStoreDeleteFuncCall
+	mutex       sync.Mutex
+}
+
+// Delete delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoUpdateScheduleStore) Delete(v0 context.Context, v1 ...api.RepoID) error {
+	r0 := m.DeleteFunc.nextHook()(v0, v1...)
+	m.DeleteFunc.appendCall(RepoUpdateScheduleStoreDeleteFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Delete method of the
+// parent MockRepoUpdateScheduleStore instance is invoked and the hook queue
+// is empty.
+func (f *RepoUpdateScheduleStoreDeleteFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Delete method of the parent MockRepoUpdateScheduleStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *RepoUpdateScheduleStoreDeleteFunc) PushHook(hook func(context.Context, ...api.RepoID) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoUpdateScheduleStoreDeleteFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, ...api.RepoID) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoUpdateScheduleStoreDeleteFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, ...api.RepoID) error {
+		return r0
+	})
+}
+
+func (f *RepoUpdateScheduleStoreDeleteFunc) nextHook() func(context.Context, ...api.RepoID) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
 	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
 }
 
-// NewStrictMockRepoStore creates a new mock of the RepoStore interface. All
-// methods panic on invocation, unless overwritten.
-func NewStrictMockRepoStore() *MockRepoStore {
-	return &MockRepoStore{
-		CountFunc: &RepoStoreCountFunc{
-			defaultHook: func(context.Context, ReposListOptions) (int, error) {
-				panic("unexpected invocation of MockRepoStore.Count")
-			},
-		},
-		CreateFunc: &RepoStoreCreateFunc{
-			defaultHook: func(context.Context, ...*types.Repo) error {
-				panic("unexpected invocation of MockRepoStore.Create")
-			},
-		},
-		DeleteFunc: &RepoStoreDeleteFunc{
-			defaultHook: func(context.Context, ...api.RepoID) error {
-				panic("unexpected invocation of MockRepoStore.Delete")
-			},
-		},
-		DoneFunc: &RepoStoreDoneFunc{
-			defaultHook: func(error) error {
-				panic("unexpected invocation of MockRepoStore.Done")
-			},
-		},
-		GetFunc: &RepoStoreGetFunc{
-			defaultHook: func(context.Context, api.RepoID) (*types.Repo, error) {
-				panic("unexpected invocation of MockRepoStore.Get")
-			},
-		},
-		GetByHashedNameFunc: &RepoStoreGetByHashedNameFunc{
-			defaultHook: func(context.Context, api.RepoHashedName) (*types.Repo, error) {
-				panic("unexpected invocation of MockRepoStore.GetByHashedName")
-			},
-		},
-		GetByIDsFunc: &RepoStoreGetByIDsFunc{
-			defaultHook: func(context.Context, ...api.RepoID) ([]*types.Repo, error) {
-				panic("unexpected invocation of MockRepoStore.GetByIDs")
-			},
-		},
-		GetByNameFunc: &RepoStoreGetByNameFunc{
-			defaultHook: func(context.Context, api.RepoName) (*types.Repo, error) {
-				panic("unexpected invocation of MockRepoStore.GetByName")
-			},
-		},
-		GetFirstRepoNameByCloneURLFunc: &RepoStoreGetFirstRepoNameByCloneURLFunc{
-			defaultHook: func(context.Context, string) (api.RepoName, error) {
-				panic("unexpected invocation of MockRepoStore.GetFirstRepoNameByCloneURL")
-			},
-		},
-		GetRepoDescriptionsByIDsFunc: &RepoStoreGetRepoDescriptionsByIDsFunc{
-			defaultHook: func(context.Context, ...api.RepoID) (map[api.RepoID]string, error) {
-				panic("unexpected invocation of MockRepoStore.GetRepoDescriptionsByIDs")
-			},
-		},
-		GetReposSetByIDsFunc: &RepoStoreGetReposSetByIDsFunc{
-			defaultHook: func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
-				panic("unexpected invocation of MockRepoStore.GetReposSetByIDs")
-			},
-		},
-		HandleFunc: &RepoStoreHandleFunc{
-			defaultHook: func() basestore.TransactableHandle {
-				panic("unexpected invocation of MockRepoStore.Handle")
-			},
-		},
-		ListFunc: &RepoStoreListFunc{
-			defaultHook: func(context.Context, ReposListOptions) ([]*types.Repo, error) {
-				panic("unexpected invocation of MockRepoStore.List")
-			},
-		},
-		ListMinimalReposFunc: &RepoStoreListMinimalReposFunc{
-			defaultHook: func(context.Context, ReposListOptions) ([]types.MinimalRepo, error) {
-				panic("unexpected invocation of MockRepoStore.ListMinimalRepos")
-			},
-		},
-		ListSourcegraphDotComIndexableReposFunc: &RepoStoreListSourcegraphDotComIndexableReposFunc{
-			defaultHook: func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
-				panic("unexpected invocation of MockRepoStore.ListSourcegraphDotComIndexableRepos")
-			},
-		},
-		MetadataFunc: &RepoStoreMetadataFunc{
-			defaultHook: func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error) {
-				panic("unexpected invocation of MockRepoStore.Metadata")
-			},
-		},
-		QueryFunc: &RepoStoreQueryFunc{
-			defaultHook: func(context.Context, *sqlf.Query) (*sql.Rows, error) {
-				panic("unexpected invocation of MockRepoStore.Query")
-			},
-		},
-		StreamMinimalReposFunc: &RepoStoreStreamMinimalReposFunc{
-			defaultHook: func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error {
-				panic("unexpected invocation of MockRepoStore.StreamMinimalRepos")
-			},
-		},
-		TransactFunc: &RepoStoreTransactFunc{
-			defaultHook: func(context.Context) (RepoStore, error) {
-				panic("unexpected invocation of MockRepoStore.Transact")
-			},
-		},
-		WithFunc: &RepoStoreWithFunc{
-			defaultHook: func(basestore.ShareableStore) RepoStore {
-				panic("unexpected invocation of MockRepoStore.With")
-			},
-		},
+func (f *RepoUpdateScheduleStoreDeleteFunc) appendCall(r0 RepoUpdateScheduleStoreDeleteFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoUpdateScheduleStoreDeleteFuncCall
+// objects describing the invocations of this function.
+func (f *RepoUpdateScheduleStoreDeleteFunc) History() []RepoUpdateScheduleStoreDeleteFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoUpdateScheduleStoreDeleteFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoUpdateScheduleStoreDeleteFuncCall is an object that describes an
+// invocation of method Delete on an instance of
+// MockRepoUpdateScheduleStore.
+type RepoUpdateScheduleStoreDeleteFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c RepoUpdateScheduleStoreDeleteFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoUpdateScheduleStoreDeleteFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RepoUpdateScheduleStoreGetByRepoIDFunc describes the behavior when the
+// GetByRepoID method of the parent MockRepoUpdateScheduleStore instance is
+// invoked.
+type RepoUpdateScheduleStoreGetByRepoIDFunc struct {
+	defaultHook func(context.Context, api.RepoID) (*RepoUpdateSchedule, error)
+	hooks       []func(context.Context, api.RepoID) (*RepoUpdateSchedule, error)
+	history     []RepoUpdateScheduleStoreGetByRepoIDFuncCall
+	mutex       sync.Mutex
+}
+
+// GetByRepoID delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockRepoUpdateScheduleStore) GetByRepoID(v0 context.Context, v1 api.RepoID) (*RepoUpdateSchedule, error) {
+	r0, r1 := m.GetByRepoIDFunc.nextHook()(v0, v1)
+	m.GetByRepoIDFunc.appendCall(RepoUpdateScheduleStoreGetByRepoIDFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetByRepoID method
+// of the parent MockRepoUpdateScheduleStore instance is invoked and the
+// hook queue is empty.
+func (f *RepoUpdateScheduleStoreGetByRepoIDFunc) SetDefaultHook(hook func(context.Context, api.RepoID) (*RepoUpdateSchedule, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetByRepoID method of the parent MockRepoUpdateScheduleStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RepoUpdateScheduleStoreGetByRepoIDFunc) PushHook(hook func(context.Context, api.RepoID) (*RepoUpdateSchedule, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoUpdateScheduleStoreGetByRepoIDFunc) SetDefaultReturn(r0 *RepoUpdateSchedule, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID) (*RepoUpdateSchedule, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoUpdateScheduleStoreGetByRepoIDFunc) PushReturn(r0 *RepoUpdateSchedule, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID) (*RepoUpdateSchedule, error) {
+		return r0, r1
+	})
+}
+
+func (f *RepoUpdateScheduleStoreGetByRepoIDFunc) nextHook() func(context.Context, api.RepoID) (*RepoUpdateSchedule, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoUpdateScheduleStoreGetByRepoIDFunc) appendCall(r0 RepoUpdateScheduleStoreGetByRepoIDFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoUpdateScheduleStoreGetByRepoIDFuncCall
+// objects describing the invocations of this function.
+func (f *RepoUpdateScheduleStoreGetByRepoIDFunc) History() []RepoUpdateScheduleStoreGetByRepoIDFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoUpdateScheduleStoreGetByRepoIDFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoUpdateScheduleStoreGetByRepoIDFuncCall is an object that describes an
+// invocation of method GetByRepoID on an instance of
+// MockRepoUpdateScheduleStore.
+type RepoUpdateScheduleStoreGetByRepoIDFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *RepoUpdateSchedule
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoUpdateScheduleStoreGetByRepoIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoUpdateScheduleStoreGetByRepoIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// RepoUpdateScheduleStoreHandleFunc describes the behavior when the Handle
+// method of the parent MockRepoUpdateScheduleStore instance is invoked.
+type RepoUpdateScheduleStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []RepoUpdateScheduleStoreHandleFuncCall
+	mutex       sync.Mutex
+}
+
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoUpdateScheduleStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(RepoUpdateScheduleStoreHandleFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockRepoUpdateScheduleStore instance is invoked and the hook queue
+// is empty.
+func (f *RepoUpdateScheduleStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Handle method of the parent MockRepoUpdateScheduleStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *RepoUpdateScheduleStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoUpdateScheduleStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoUpdateScheduleStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+func (f *RepoUpdateScheduleStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoUpdateScheduleStoreHandleFunc) appendCall(r0 RepoUpdateScheduleStoreHandleFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoUpdateScheduleStoreHandleFuncCall
+// objects describing the invocations of this function.
+func (f *RepoUpdateScheduleStoreHandleFunc) History() []RepoUpdateScheduleStoreHandleFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoUpdateScheduleStoreHandleFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoUpdateScheduleStoreHandleFuncCall is an object that describes an
+// invocation of method Handle on an instance of
+// MockRepoUpdateScheduleStore.
+type RepoUpdateScheduleStoreHandleFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 basestore.TransactableHandle
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoUpdateScheduleStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoUpdateScheduleStoreHandleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RepoUpdateScheduleStoreInsertFunc describes the behavior when the Insert
+// method of the parent MockRepoUpdateScheduleStore instance is invoked.
+type RepoUpdateScheduleStoreInsertFunc struct {
+	defaultHook func(context.Context, time.Duration, time.Time, ...api.RepoID) error
+	hooks       []func(context.Context, time.Duration, time.Time, ...api.RepoID) error
+	history     []RepoUpdateScheduleStoreInsertFuncCall
+	mutex       sync.Mutex
+}
+
+// Insert delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRepoUpdateScheduleStore) Insert(v0 context.Context, v1 time.Duration, v2 time.Time, v3 ...api.RepoID) error {
+	r0 := m.InsertFunc.nextHook()(v0, v1, v2, v3...)
+	m.InsertFunc.appendCall(RepoUpdateScheduleStoreInsertFuncCall{v0, v1, v2, v3, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Insert method of the
+// parent MockRepoUpdateScheduleStore instance is invoked and the hook queue
+// is empty.
+func (f *RepoUpdateScheduleStoreInsertFunc) SetDefaultHook(hook func(context.Context, time.Duration, time.Time, ...api.RepoID) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Insert method of the parent MockRepoUpdateScheduleStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *RepoUpdateScheduleStoreInsertFunc) PushHook(hook func(context.Context, time.Duration, time.Time, ...api.RepoID) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoUpdateScheduleStoreInsertFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, time.Duration, time.Time, ...api.RepoID) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoUpdateScheduleStoreInsertFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, time.Duration, time.Time, ...api.RepoID) error {
+		return r0
+	})
+}
+
+func (f *RepoUpdateScheduleStoreInsertFunc) nextHook() func(context.Context, time.Duration, time.Time, ...api.RepoID) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
 	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
 }
 
-// NewMockRepoStoreFrom creates a new mock of the MockRepoStore interface.
-// All methods delegate to the given implementation, unless overwritten.
-func NewMockRepoStoreFrom(i RepoStore) *MockRepoStore {
-	return &MockRepoStore{
-		CountFunc: &RepoStoreCountFunc{
-			defaultHook: i.Count,
-		},
-		CreateFunc: &RepoStoreCreateFunc{
-			defaultHook: i.Create,
-		},
-		DeleteFunc: &RepoStoreDeleteFunc{
-			defaultHook: i.Delete,
-		},
-		DoneFunc: &RepoStoreDoneFunc{
-			defaultHook: i.Done,
-		},
-		GetFunc: &RepoStoreGetFunc{
-			defaultHook: i.Get,
-		},
-		GetByHashedNameFunc: &RepoStoreGetByHashedNameFunc{
-			defaultHook: i.GetByHashedName,
-		},
-		GetByIDsFunc: &RepoStoreGetByIDsFunc{
-			defaultHook: i.GetByIDs,
-		},
-		GetByNameFunc: &RepoStoreGetByNameFunc{
-			defaultHook: i.GetByName,
-		},
-		GetFirstRepoNameByCloneURLFunc: &RepoStoreGetFirstRepoNameByCloneURLFunc{
-			defaultHook: i.GetFirstRepoNameByCloneURL,
-		},
-		GetRepoDescriptionsByIDsFunc: &RepoStoreGetRepoDescriptionsByIDsFunc{
-			defaultHook: i.GetRepoDescriptionsByIDs,
-		},
-		GetReposSetByIDsFunc: &RepoStoreGetReposSetByIDsFunc{
-			defaultHook: i.GetReposSetByIDs,
-		},
-		HandleFunc: &RepoStoreHandleFunc{
-			defaultHook: i.Handle,
-		},
-		ListFunc: &RepoStoreListFunc{
-			defaultHook: i.List,
-		},
-		ListMinimalReposFunc: &RepoStoreListMinimalReposFunc{
-			defaultHook: i.ListMinimalRepos,
-		},
-		ListSourcegraphDotComIndexableReposFunc: &RepoStoreListSourcegraphDotComIndexableReposFunc{
-			defaultHook: i.ListSourcegraphDotComIndexableRepos,
-		},
-		MetadataFunc: &RepoStoreMetadataFunc{
-			defaultHook: i.Metadata,
-		},
-		QueryFunc: &RepoStoreQueryFunc{
-			defaultHook: i.Query,
-		},
-		StreamMinimalReposFunc: &RepoStoreStreamMinimalReposFunc{
-			defaultHook: i.StreamMinimalRepos,
-		},
-		TransactFunc: &RepoStoreTransactFunc{
-			defaultHook: i.Transact,
-		},
-		WithFunc: &RepoStoreWithFunc{
-			defaultHook: i.With,
-		},
+func (f *RepoUpdateScheduleStoreInsertFunc) appendCall(r0 RepoUpdateScheduleStoreInsertFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of RepoUpdateScheduleStoreInsertFuncCall
+// objects describing the invocations of this function.
+func (f *RepoUpdateScheduleStoreInsertFunc) History() []RepoUpdateScheduleStoreInsertFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoUpdateScheduleStoreInsertFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoUpdateScheduleStoreInsertFuncCall is an object that describes an
+// invocation of method Insert on an instance of
+// MockRepoUpdateScheduleStore.
+type RepoUpdateScheduleStoreInsertFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 time.Duration
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 time.Time
+	// Arg3 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg3 []api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c RepoUpdateScheduleStoreInsertFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg3 {
+		trailing = append(trailing, val)
 	}
+
+	return append([]interface{}{c.Arg0, c.Arg1, c.Arg2}, trailing...)
 }
 
-// RepoStoreCountFunc describes the behavior when the Count method of the
-// parent MockRepoStore instance is invoked.
-type RepoStoreCountFunc struct {
-	defaultHook func(context.Context, ReposListOptions) (int, error)
-	hooks       []func(context.Context, ReposListOptions) (int, error)
-	history     []RepoStoreCountFuncCall
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoUpdateScheduleStoreInsertFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// RepoUpdateScheduleStoreListFunc describes the behavior when the List
+// method of the parent MockRepoUpdateScheduleStore instance is invoked.
+type RepoUpdateScheduleStoreListFunc struct {
+	defaultHook func(context.Context) ([]*RepoUpdateSchedule, error)
+	hooks       []func(context.Context) ([]*RepoUpdateSchedule, error)
+	history     []RepoUpdateScheduleStoreListFuncCall
 	mutex       sync.Mutex
 }
 
-// Count delegates to the next hook function in the queue and stores the
+// List delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRepoStore) Count(v0 context.Context, v1 ReposListOptions) (int, error) {
-	r0, r1 := m.CountFunc.nextHook()(v0, v1)
-	m.CountFunc.appendCall(RepoStoreCountFuncCall{v0, v1, r0, r1})
+func (m *MockRepoUpdateScheduleStore) List(v0 context.Context) ([]*RepoUpdateSchedule, error) {
+	r0, r1 := m.ListFunc.nextHook()(v0)
+	m.ListFunc.appendCall(RepoUpdateScheduleStoreListFuncCall{v0, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Count method of the
-// parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreCountFunc) SetDefaultHook(hook func(context.Context, ReposListOptions) (int, error)) {
+// SetDefaultHook sets function that is called when the List method of the
+// parent MockRepoUpdateScheduleStore instance is invoked and the hook queue
+// is empty.
+func (f *RepoUpdateScheduleStoreListFunc) SetDefaultHook(hook func(context.Context) ([]*RepoUpdateSchedule, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Count method of the parent MockRepoStore instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *RepoStoreCountFunc) PushHook(hook func(context.Context, ReposListOptions) (int, error)) {
+// List method of the parent MockRepoUpdateScheduleStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *RepoUpdateScheduleStoreListFunc) PushHook(hook func(context.Context) ([]*RepoUpdateSchedule, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -32291,20 +37475,20 @@ func (f *RepoStoreCountFunc) PushHook(hook func(context.Context, ReposListOption
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreCountFunc) SetDefaultReturn(r0 int, r1 error) {
-	f.SetDefaultHook(func(context.Context, ReposListOptions) (int, error) {
+func (f *RepoUpdateScheduleStoreListFunc) SetDefaultReturn(r0 []*RepoUpdateSchedule, r1 error) {
+	f.SetDefaultHook(func(context.Context) ([]*RepoUpdateSchedule, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreCountFunc) PushReturn(r0 int, r1 error) {
-	f.PushHook(func(context.Context, ReposListOptions) (int, error) {
+func (f *RepoUpdateScheduleStoreListFunc) PushReturn(r0 []*RepoUpdateSchedule, r1 error) {
+	f.PushHook(func(context.Context) ([]*RepoUpdateSchedule, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStoreCountFunc) nextHook() func(context.Context, ReposListOptions) (int, error) {
+func (f *RepoUpdateScheduleStoreListFunc) nextHook() func(context.Context) ([]*RepoUpdateSchedule, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -32317,35 +37501,32 @@ func (f *RepoStoreCountFunc) nextHook() func(context.Context, ReposListOptions)
 	return hook
 }
 
-func (f *RepoStoreCountFunc) appendCall(r0 RepoStoreCountFuncCall) {
+func (f *RepoUpdateScheduleStoreListFunc) appendCall(r0 RepoUpdateScheduleStoreListFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreCountFuncCall objects describing
-// the invocations of this function.
-func (f *RepoStoreCountFunc) History() []RepoStoreCountFuncCall {
+// History returns a sequence of RepoUpdateScheduleStoreListFuncCall objects
+// describing the invocations of this function.
+func (f *RepoUpdateScheduleStoreListFunc) History() []RepoUpdateScheduleStoreListFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreCountFuncCall, len(f.history))
+	history := make([]RepoUpdateScheduleStoreListFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreCountFuncCall is an object that describes an invocation of
-// method Count on an instance of MockRepoStore.
-type RepoStoreCountFuncCall struct {
+// RepoUpdateScheduleStoreListFuncCall is an object that describes an
+// invocation of method List on an instance of MockRepoUpdateScheduleStore.
+type RepoUpdateScheduleStoreListFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 ReposListOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 int
+	Result0 []*RepoUpdateSchedule
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -32353,44 +37534,47 @@ type RepoStoreCountFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreCountFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c RepoUpdateScheduleStoreListFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreCountFuncCall) Results() []interface{} {
+func (c RepoUpdateScheduleStoreListFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreCreateFunc describes the behavior when the Create method of the
-// parent MockRepoStore instance is invoked.
-type RepoStoreCreateFunc struct {
-	defaultHook func(context.Context, ...*types.Repo) error
-	hooks       []func(context.Context, ...*types.Repo) error
-	history     []RepoStoreCreateFuncCall
+// RepoUpdateScheduleStoreListByRepoIDsFunc describes the behavior when the
+// ListByRepoIDs method of the parent MockRepoUpdateScheduleStore instance
+// is invoked.
+type RepoUpdateScheduleStoreListByRepoIDsFunc struct {
+	defaultHook func(context.Context, []api.RepoID) ([]*RepoUpdateSchedule, error)
+	hooks       []func(context.Context, []api.RepoID) ([]*RepoUpdateSchedule, error)
+	history     []RepoUpdateScheduleStoreListByRepoIDsFuncCall
 	mutex       sync.Mutex
 }
 
-// Create delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStore) Create(v0 context.Context, v1 ...*types.Repo) error {
-	r0 := m.CreateFunc.nextHook()(v0, v1...)
-	m.CreateFunc.appendCall(RepoStoreCreateFuncCall{v0, v1, r0})
-	return r0
+// ListByRepoIDs delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockRepoUpdateScheduleStore) ListByRepoIDs(v0 context.Context, v1 []api.RepoID) ([]*RepoUpdateSchedule, error) {
+	r0, r1 := m.ListByRepoIDsFunc.nextHook()(v0, v1)
+	m.ListByRepoIDsFunc.appendCall(RepoUpdateScheduleStoreListByRepoIDsFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Create method of the
-// parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreCreateFunc) SetDefaultHook(hook func(context.Context, ...*types.Repo) error) {
+// SetDefaultHook sets function that is called when the ListByRepoIDs method
+// of the parent MockRepoUpdateScheduleStore instance is invoked and the
+// hook queue is empty.
+func (f *RepoUpdateScheduleStoreListByRepoIDsFunc) SetDefaultHook(hook func(context.Context, []api.RepoID) ([]*RepoUpdateSchedule, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Create method of the parent MockRepoStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *RepoStoreCreateFunc) PushHook(hook func(context.Context, ...*types.Repo) error) {
+// ListByRepoIDs method of the parent MockRepoUpdateScheduleStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RepoUpdateScheduleStoreListByRepoIDsFunc) PushHook(hook func(context.Context, []api.RepoID) ([]*RepoUpdateSchedule, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -32398,20 +37582,20 @@ func (f *RepoStoreCreateFunc) PushHook(hook func(context.Context, ...*types.Repo
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreCreateFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, ...*types.Repo) error {
-		return r0
+func (f *RepoUpdateScheduleStoreListByRepoIDsFunc) SetDefaultReturn(r0 []*RepoUpdateSchedule, r1 error) {
+	f.SetDefaultHook(func(context.Context, []api.RepoID) ([]*RepoUpdateSchedule, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreCreateFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, ...*types.Repo) error {
-		return r0
+func (f *RepoUpdateScheduleStoreListByRepoIDsFunc) PushReturn(r0 []*RepoUpdateSchedule, r1 error) {
+	f.PushHook(func(context.Context, []api.RepoID) ([]*RepoUpdateSchedule, error) {
+		return r0, r1
 	})
 }
 
-func (f *RepoStoreCreateFunc) nextHook() func(context.Context, ...*types.Repo) error {
+func (f *RepoUpdateScheduleStoreListByRepoIDsFunc) nextHook() func(context.Context, []api.RepoID) ([]*RepoUpdateSchedule, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -32424,84 +37608,85 @@ func (f *RepoStoreCreateFunc) nextHook() func(context.Context, ...*types.Repo) e
 	return hook
 }
 
-func (f *RepoStoreCreateFunc) appendCall(r0 RepoStoreCreateFuncCall) {
+func (f *RepoUpdateScheduleStoreListByRepoIDsFunc) appendCall(r0 RepoUpdateScheduleStoreListByRepoIDsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreCreateFuncCall objects describing
-// the invocations of this function.
-func (f *RepoStoreCreateFunc) History() []RepoStoreCreateFuncCall {
+// History returns a sequence of
+// RepoUpdateScheduleStoreListByRepoIDsFuncCall objects describing the
+// invocations of this function.
+func (f *RepoUpdateScheduleStoreListByRepoIDsFunc) History() []RepoUpdateScheduleStoreListByRepoIDsFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreCreateFuncCall, len(f.history))
+	history := make([]RepoUpdateScheduleStoreListByRepoIDsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreCreateFuncCall is an object that describes an invocation of
-// method Create on an instance of MockRepoStore.
-type RepoStoreCreateFuncCall struct {
+// RepoUpdateScheduleStoreListByRepoIDsFuncCall is an object that describes
+// an invocation of method ListByRepoIDs on an instance of
+// MockRepoUpdateScheduleStore.
+type RepoUpdateScheduleStoreListByRepoIDsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is a slice containing the values of the variadic arguments
-	// passed to this method invocation.
-	Arg1 []*types.Repo
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 []api.RepoID
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 []*RepoUpdateSchedule
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation. The variadic slice argument is flattened in this array such
-// that one positional argument and three variadic arguments would result in
-// a slice of four, not two.
-func (c RepoStoreCreateFuncCall) Args() []interface{} {
-	trailing := []interface{}{}
-	for _, val := range c.Arg1 {
-		trailing = append(trailing, val)
-	}
-
-	return append([]interface{}{c.Arg0}, trailing...)
+// invocation.
+func (c RepoUpdateScheduleStoreListByRepoIDsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreCreateFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c RepoUpdateScheduleStoreListByRepoIDsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreDeleteFunc describes the behavior when the Delete method of the
-// parent MockRepoStore instance is invoked.
-type RepoStoreDeleteFunc struct {
-	defaultHook func(context.Context, ...api.RepoID) error
-	hooks       []func(context.Context, ...api.RepoID) error
-	history     []RepoStoreDeleteFuncCall
+// RepoUpdateScheduleStorePrioritiseFunc describes the behavior when the
+// Prioritise method of the parent MockRepoUpdateScheduleStore instance is
+// invoked.
+type RepoUpdateScheduleStorePrioritiseFunc struct {
+	defaultHook func(context.Context, time.Duration, time.Time, ...api.RepoID) error
+	hooks       []func(context.Context, time.Duration, time.Time, ...api.RepoID) error
+	history     []RepoUpdateScheduleStorePrioritiseFuncCall
 	mutex       sync.Mutex
 }
 
-// Delete delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStore) Delete(v0 context.Context, v1 ...api.RepoID) error {
-	r0 := m.DeleteFunc.nextHook()(v0, v1...)
-	m.DeleteFunc.appendCall(RepoStoreDeleteFuncCall{v0, v1, r0})
+// Prioritise delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockRepoUpdateScheduleStore) Prioritise(v0 context.Context, v1 time.Duration, v2 time.Time, v3 ...api.RepoID) error {
+	r0 := m.PrioritiseFunc.nextHook()(v0, v1, v2, v3...)
+	m.PrioritiseFunc.appendCall(RepoUpdateScheduleStorePrioritiseFuncCall{v0, v1, v2, v3, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Delete method of the
-// parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreDeleteFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) error) {
+// SetDefaultHook sets function that is called when the Prioritise method of
+// the parent MockRepoUpdateScheduleStore instance is invoked and the hook
+// queue is empty.
+func (f *RepoUpdateScheduleStorePrioritiseFunc) SetDefaultHook(hook func(context.Context, time.Duration, time.Time, ...api.RepoID) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Delete method of the parent MockRepoStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *RepoStoreDeleteFunc) PushHook(hook func(context.Context, ...api.RepoID) error) {
+// Prioritise method of the parent MockRepoUpdateScheduleStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RepoUpdateScheduleStorePrioritiseFunc) PushHook(hook func(context.Context, time.Duration, time.Time, ...api.RepoID) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -32509,20 +37694,20 @@ func (f *RepoStoreDeleteFunc) PushHook(hook func(context.Context, ...api.RepoID)
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreDeleteFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, ...api.RepoID) error {
+func (f *RepoUpdateScheduleStorePrioritiseFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, time.Duration, time.Time, ...api.RepoID) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreDeleteFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, ...api.RepoID) error {
+func (f *RepoUpdateScheduleStorePrioritiseFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, time.Duration, time.Time, ...api.RepoID) error {
 		return r0
 	})
 }
 
-func (f *RepoStoreDeleteFunc) nextHook() func(context.Context, ...api.RepoID) error {
+func (f *RepoUpdateScheduleStorePrioritiseFunc) nextHook() func(context.Context, time.Duration, time.Time, ...api.RepoID) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -32535,32 +37720,39 @@ func (f *RepoStoreDeleteFunc) nextHook() func(context.Context, ...api.RepoID) er
 	return hook
 }
 
-func (f *RepoStoreDeleteFunc) appendCall(r0 RepoStoreDeleteFuncCall) {
+func (f *RepoUpdateScheduleStorePrioritiseFunc) appendCall(r0 RepoUpdateScheduleStorePrioritiseFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreDeleteFuncCall objects describing
-// the invocations of this function.
-func (f *RepoStoreDeleteFunc) History() []RepoStoreDeleteFuncCall {
+// History returns a sequence of RepoUpdateScheduleStorePrioritiseFuncCall
+// objects describing the invocations of this function.
+func (f *RepoUpdateScheduleStorePrioritiseFunc) History() []RepoUpdateScheduleStorePrioritiseFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreDeleteFuncCall, len(f.history))
+	history := make([]RepoUpdateScheduleStorePrioritiseFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreDeleteFuncCall is an object that describes an invocation of
-// method Delete on an instance of MockRepoStore.
-type RepoStoreDeleteFuncCall struct {
+// RepoUpdateScheduleStorePrioritiseFuncCall is an object that describes an
+// invocation of method Prioritise on an instance of
+// MockRepoUpdateScheduleStore.
+type RepoUpdateScheduleStorePrioritiseFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is a slice containing the values of the variadic arguments
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 time.Duration
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 time.Time
+	// Arg3 is a slice containing the values of the variadic arguments
 	// passed to this method invocation.
-	Arg1 []api.RepoID
+	Arg3 []api.RepoID
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -32570,49 +37762,50 @@ type RepoStoreDeleteFuncCall struct {
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c RepoStoreDeleteFuncCall) Args() []interface{} {
+func (c RepoUpdateScheduleStorePrioritiseFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
-	for _, val := range c.Arg1 {
+	for _, val := range c.Arg3 {
 		trailing = append(trailing, val)
 	}
 
-	return append([]interface{}{c.Arg0}, trailing...)
+	return append([]interface{}{c.Arg0, c.Arg1, c.Arg2}, trailing...)
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreDeleteFuncCall) Results() []interface{} {
+func (c RepoUpdateScheduleStorePrioritiseFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RepoStoreDoneFunc describes the behavior when the Done method of the
-// parent MockRepoStore instance is invoked.
-type RepoStoreDoneFunc struct {
-	defaultHook func(error) error
-	hooks       []func(error) error
-	history     []RepoStoreDoneFuncCall
+// RepoUpdateScheduleStoreUpsertFunc describes the behavior when the Upsert
+// method of the parent MockRepoUpdateScheduleStore instance is invoked.
+type RepoUpdateScheduleStoreUpsertFunc struct {
+	defaultHook func(context.Context, *RepoUpdateSchedule) error
+	hooks       []func(context.Context, *RepoUpdateSchedule) error
+	history     []RepoUpdateScheduleStoreUpsertFuncCall
 	mutex       sync.Mutex
 }
 
-// Done delegates to the next hook function in the queue and stores the
+// Upsert delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRepoStore) Done(v0 error) error {
-	r0 := m.DoneFunc.nextHook()(v0)
-	m.DoneFunc.appendCall(RepoStoreDoneFuncCall{v0, r0})
+func (m *MockRepoUpdateScheduleStore) Upsert(v0 context.Context, v1 *RepoUpdateSchedule) error {
+	r0 := m.UpsertFunc.nextHook()(v0, v1)
+	m.UpsertFunc.appendCall(RepoUpdateScheduleStoreUpsertFuncCall{v0, v1, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Done method of the
-// parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreDoneFunc) SetDefaultHook(hook func(error) error) {
+// SetDefaultHook sets function that is called when the Upsert method of the
+// parent MockRepoUpdateScheduleStore instance is invoked and the hook queue
+// is empty.
+func (f *RepoUpdateScheduleStoreUpsertFunc) SetDefaultHook(hook func(context.Context, *RepoUpdateSchedule) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Done method of the parent MockRepoStore instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *RepoStoreDoneFunc) PushHook(hook func(error) error) {
+// Upsert method of the parent MockRepoUpdateScheduleStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *RepoUpdateScheduleStoreUpsertFunc) PushHook(hook func(context.Context, *RepoUpdateSchedule) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -32620,20 +37813,20 @@ func (f *RepoStoreDoneFunc) PushHook(hook func(error) error) {
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreDoneFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(error) error {
+func (f *RepoUpdateScheduleStoreUpsertFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, *RepoUpdateSchedule) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreDoneFunc) PushReturn(r0 error) {
-	f.PushHook(func(error) error {
+func (f *RepoUpdateScheduleStoreUpsertFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, *RepoUpdateSchedule) error {
 		return r0
 	})
 }
 
-func (f *RepoStoreDoneFunc) nextHook() func(error) error {
+func (f *RepoUpdateScheduleStoreUpsertFunc) nextHook() func(context.Context, *RepoUpdateSchedule) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -32646,29 +37839,33 @@ func (f *RepoStoreDoneFunc) nextHook() func(error) error {
 	return hook
 }
 
-func (f *RepoStoreDoneFunc) appendCall(r0 RepoStoreDoneFuncCall) {
+func (f *RepoUpdateScheduleStoreUpsertFunc) appendCall(r0 RepoUpdateScheduleStoreUpsertFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreDoneFuncCall objects describing
-// the invocations of this function.
-func (f *RepoStoreDoneFunc) History() []RepoStoreDoneFuncCall {
+// History returns a sequence of RepoUpdateScheduleStoreUpsertFuncCall
+// objects describing the invocations of this function.
+func (f *RepoUpdateScheduleStoreUpsertFunc) History() []RepoUpdateScheduleStoreUpsertFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreDoneFuncCall, len(f.history))
+	history := make([]RepoUpdateScheduleStoreUpsertFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreDoneFuncCall is an object that describes an invocation of method
-// Done on an instance of MockRepoStore.
-type RepoStoreDoneFuncCall struct {
+// RepoUpdateScheduleStoreUpsertFuncCall is an object that describes an
+// invocation of method Upsert on an instance of
+// MockRepoUpdateScheduleStore.
+type RepoUpdateScheduleStoreUpsertFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 error
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *RepoUpdateSchedule
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -32676,44 +37873,45 @@ type RepoStoreDoneFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreDoneFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c RepoUpdateScheduleStoreUpsertFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreDoneFuncCall) Results() []interface{} {
+func (c RepoUpdateScheduleStoreUpsertFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RepoStoreGetFunc describes the behavior when the Get method of the parent
-// MockRepoStore instance is invoked.
-type RepoStoreGetFunc struct {
-	defaultHook func(context.Context, api.RepoID) (*types.Repo, error)
-	hooks       []func(context.Context, api.RepoID) (*types.Repo, error)
-	history     []RepoStoreGetFuncCall
+// RepoUpdateScheduleStoreWithFunc describes the behavior when the With
+// method of the parent MockRepoUpdateScheduleStore instance is invoked.
+type RepoUpdateScheduleStoreWithFunc struct {
+	defaultHook func(basestore.ShareableStore) RepoUpdateScheduleStore
+	hooks       []func(basestore.ShareableStore) RepoUpdateScheduleStore
+	history     []RepoUpdateScheduleStoreWithFuncCall
 	mutex       sync.Mutex
 }
 
-// Get delegates to the next hook function in the queue and stores the
+// With delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRepoStore) Get(v0 context.Context, v1 api.RepoID) (*types.Repo, error) {
-	r0, r1 := m.GetFunc.nextHook()(v0, v1)
-	m.GetFunc.appendCall(RepoStoreGetFuncCall{v0, v1, r0, r1})
-	return r0, r1
+func (m *MockRepoUpdateScheduleStore) With(v0 basestore.ShareableStore) RepoUpdateScheduleStore {
+	r0 := m.WithFunc.nextHook()(v0)
+	m.WithFunc.appendCall(RepoUpdateScheduleStoreWithFuncCall{v0, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the Get method of the
-// parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreGetFunc) SetDefaultHook(hook func(context.Context, api.RepoID) (*types.Repo, error)) {
+// SetDefaultHook sets function that is called when the With method of the
+// parent MockRepoUpdateScheduleStore instance is invoked and the hook queue
+// is empty.
+func (f *RepoUpdateScheduleStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) RepoUpdateScheduleStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Get method of the parent MockRepoStore instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *RepoStoreGetFunc) PushHook(hook func(context.Context, api.RepoID) (*types.Repo, error)) {
+// With method of the parent MockRepoUpdateScheduleStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *RepoUpdateScheduleStoreWithFunc) PushHook(hook func(basestore.ShareableStore) RepoUpdateScheduleStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -32721,20 +37919,20 @@ func (f *RepoStoreGetFunc) PushHook(hook func(context.Context, api.RepoID) (*typ
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreGetFunc) SetDefaultReturn(r0 *types.Repo, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoID) (*types.Repo, error) {
-		return r0, r1
+func (f *RepoUpdateScheduleStoreWithFunc) SetDefaultReturn(r0 RepoUpdateScheduleStore) {
+	f.SetDefaultHook(func(basestore.ShareableStore) RepoUpdateScheduleStore {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreGetFunc) PushReturn(r0 *types.Repo, r1 error) {
-	f.PushHook(func(context.Context, api.RepoID) (*types.Repo, error) {
-		return r0, r1
+func (f *RepoUpdateScheduleStoreWithFunc) PushReturn(r0 RepoUpdateScheduleStore) {
+	f.PushHook(func(basestore.ShareableStore) RepoUpdateScheduleStore {
+		return r0
 	})
 }
 
-func (f *RepoStoreGetFunc) nextHook() func(context.Context, api.RepoID) (*types.Repo, error) {
+func (f *RepoUpdateScheduleStoreWithFunc) nextHook() func(basestore.ShareableStore) RepoUpdateScheduleStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -32747,81 +37945,342 @@ func (f *RepoStoreGetFunc) nextHook() func(context.Context, api.RepoID) (*types.
 	return hook
 }
 
-func (f *RepoStoreGetFunc) appendCall(r0 RepoStoreGetFuncCall) {
+func (f *RepoUpdateScheduleStoreWithFunc) appendCall(r0 RepoUpdateScheduleStoreWithFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreGetFuncCall objects describing the
-// invocations of this function.
-func (f *RepoStoreGetFunc) History() []RepoStoreGetFuncCall {
+// History returns a sequence of RepoUpdateScheduleStoreWithFuncCall objects
+// describing the invocations of this function.
+func (f *RepoUpdateScheduleStoreWithFunc) History() []RepoUpdateScheduleStoreWithFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreGetFuncCall, len(f.history))
+	history := make([]RepoUpdateScheduleStoreWithFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreGetFuncCall is an object that describes an invocation of method
-// Get on an instance of MockRepoStore.
-type RepoStoreGetFuncCall struct {
+// RepoUpdateScheduleStoreWithFuncCall is an object that describes an
+// invocation of method With on an instance of MockRepoUpdateScheduleStore.
+type RepoUpdateScheduleStoreWithFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 api.RepoID
+	Arg0 basestore.ShareableStore
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.Repo
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 RepoUpdateScheduleStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreGetFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c RepoUpdateScheduleStoreWithFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoUpdateScheduleStoreWithFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// MockRoleStore is a mock implementation of the RoleStore interface (from
+// the package github.com/sourcegraph/sourcegraph/internal/database) used
+// for unit testing.
+type MockRoleStore struct {
+	// AssignToOrgFunc is an instance of a mock function object controlling
+	// the behavior of the method AssignToOrg.
+	AssignToOrgFunc *RoleStoreAssignToOrgFunc
+	// AssignToUserFunc is an instance of a mock function object controlling
+	// the behavior of the method AssignToUser.
+	AssignToUserFunc *RoleStoreAssignToUserFunc
+	// CountFunc is an instance of a mock function object controlling the
+	// behavior of the method Count.
+	CountFunc *RoleStoreCountFunc
+	// CreateFunc is an instance of a mock function object controlling the
+	// behavior of the method Create.
+	CreateFunc *RoleStoreCreateFunc
+	// DeleteFunc is an instance of a mock function object controlling the
+	// behavior of the method Delete.
+	DeleteFunc *RoleStoreDeleteFunc
+	// DoneFunc is an instance of a mock function object controlling the
+	// behavior of the method Done.
+	DoneFunc *RoleStoreDoneFunc
+	// GetByIDFunc is an instance of a mock function object controlling the
+	// behavior of the method GetByID.
+	GetByIDFunc *RoleStoreGetByIDFunc
+	// GetByNameFunc is an instance of a mock function object controlling
+	// the behavior of the method GetByName.
+	GetByNameFunc *RoleStoreGetByNameFunc
+	// HandleFunc is an instance of a mock function object controlling the
+	// behavior of the method Handle.
+	HandleFunc *RoleStoreHandleFunc
+	// ListFunc is an instance of a mock function object controlling the
+	// behavior of the method List.
+	ListFunc *RoleStoreListFunc
+	// RevokeFromOrgFunc is an instance of a mock function object
+	// controlling the behavior of the method RevokeFromOrg.
+	RevokeFromOrgFunc *RoleStoreRevokeFromOrgFunc
+	// RevokeFromUserFunc is an instance of a mock function object
+	// controlling the behavior of the method RevokeFromUser.
+	RevokeFromUserFunc *RoleStoreRevokeFromUserFunc
+	// SetPermissionsFunc is an instance of a mock function object
+	// controlling the behavior of the method SetPermissions.
+	SetPermissionsFunc *RoleStoreSetPermissionsFunc
+	// TransactFunc is an instance of a mock function object controlling the
+	// behavior of the method Transact.
+	TransactFunc *RoleStoreTransactFunc
+	// WithFunc is an instance of a mock function object controlling the
+	// behavior of the method With.
+	WithFunc *RoleStoreWithFunc
+}
+
+// NewMockRoleStore creates a new mock of the RoleStore interface. All
+// methods return zero values for all results, unless overwritten.
+func NewMockRoleStore() *MockRoleStore {
+	return &MockRoleStore{
+		AssignToOrgFunc: &RoleStoreAssignToOrgFunc{
+			defaultHook: func(context.Context, int32, int32) (r0 error) {
+				return
+			},
+		},
+		AssignToUserFunc: &RoleStoreAssignToUserFunc{
+			defaultHook: func(context.Context, int32, int32) (r0 error) {
+				return
+			},
+		},
+		CountFunc: &RoleStoreCountFunc{
+			defaultHook: func(context.Context, RolesListOptions) (r0 int, r1 error) {
+				return
+			},
+		},
+		CreateFunc: &RoleStoreCreateFunc{
+			defaultHook: func(context.Context, string) (r0 *types.Role, r1 error) {
+				return
+			},
+		},
+		DeleteFunc: &RoleStoreDeleteFunc{
+			defaultHook: func(context.Context, int32) (r0 error) {
+				return
+			},
+		},
+		DoneFunc: &RoleStoreDoneFunc{
+			defaultHook: func(error) (r0 error) {
+				return
+			},
+		},
+		GetByIDFunc: &RoleStoreGetByIDFunc{
+			defaultHook: func(context.Context, int32) (r0 *types.Role, r1 error) {
+				return
+			},
+		},
+		GetByNameFunc: &RoleStoreGetByNameFunc{
+			defaultHook: func(context.Context, string) (r0 *types.Role, r1 error) {
+				return
+			},
+		},
+		HandleFunc: &RoleStoreHandleFunc{
+			defaultHook: func() (r0 basestore.TransactableHandle) {
+				return
+			},
+		},
+		ListFunc: &RoleStoreListFunc{
+			defaultHook: func(context.Context, RolesListOptions) (r0 []*types.Role, r1 error) {
+				return
+			},
+		},
+		RevokeFromOrgFunc: &RoleStoreRevokeFromOrgFunc{
+			defaultHook: func(context.Context, int32, int32) (r0 error) {
+				return
+			},
+		},
+		RevokeFromUserFunc: &RoleStoreRevokeFromUserFunc{
+			defaultHook: func(context.Context, int32, int32) (r0 error) {
+				return
+			},
+		},
+		SetPermissionsFunc: &RoleStoreSetPermissionsFunc{
+			defaultHook: func(context.Context, int32, []int32) (r0 error) {
+				return
+			},
+		},
+		TransactFunc: &RoleStoreTransactFunc{
+			defaultHook: func(context.Context) (r0 RoleStore, r1 error) {
+				return
+			},
+		},
+		WithFunc: &RoleStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) (r0 RoleStore) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockRoleStore creates a new mock of the RoleStore interface. All
+// methods panic on invocation, unless overwritten.
+func NewStrictMockRoleStore() *MockRoleStore {
+	return &MockRoleStore{
+		AssignToOrgFunc: &RoleStoreAssignToOrgFunc{
+			defaultHook: func(context.Context, int32, int32) error {
+				panic("unexpected invocation of MockRoleStore.AssignToOrg")
+			},
+		},
+		AssignToUserFunc: &RoleStoreAssignToUserFunc{
+			defaultHook: func(context.Context, int32, int32) error {
+				panic("unexpected invocation of MockRoleStore.AssignToUser")
+			},
+		},
+		CountFunc: &RoleStoreCountFunc{
+			defaultHook: func(context.Context, RolesListOptions) (int, error) {
+				panic("unexpected invocation of MockRoleStore.Count")
+			},
+		},
+		CreateFunc: &RoleStoreCreateFunc{
+			defaultHook: func(context.Context, string) (*types.Role, error) {
+				panic("unexpected invocation of MockRoleStore.Create")
+			},
+		},
+		DeleteFunc: &RoleStoreDeleteFunc{
+			defaultHook: func(context.Context, int32) error {
+				panic("unexpected invocation of MockRoleStore.Delete")
+			},
+		},
+		DoneFunc: &RoleStoreDoneFunc{
+			defaultHook: func(error) error {
+				panic("unexpected invocation of MockRoleStore.Done")
+			},
+		},
+		GetByIDFunc: &RoleStoreGetByIDFunc{
+			defaultHook: func(context.Context, int32) (*types.Role, error) {
+				panic("unexpected invocation of MockRoleStore.GetByID")
+			},
+		},
+		GetByNameFunc: &RoleStoreGetByNameFunc{
+			defaultHook: func(context.Context, string) (*types.Role, error) {
+				panic("unexpected invocation of MockRoleStore.GetByName")
+			},
+		},
+		HandleFunc: &RoleStoreHandleFunc{
+			defaultHook: func() basestore.TransactableHandle {
+				panic("unexpected invocation of MockRoleStore.Handle")
+			},
+		},
+		ListFunc: &RoleStoreListFunc{
+			defaultHook: func(context.Context, RolesListOptions) ([]*types.Role, error) {
+				panic("unexpected invocation of MockRoleStore.List")
+			},
+		},
+		RevokeFromOrgFunc: &RoleStoreRevokeFromOrgFunc{
+			defaultHook: func(context.Context, int32, int32) error {
+				panic("unexpected invocation of MockRoleStore.RevokeFromOrg")
+			},
+		},
+		RevokeFromUserFunc: &RoleStoreRevokeFromUserFunc{
+			defaultHook: func(context.Context, int32, int32) error {
+				panic("unexpected invocation of MockRoleStore.RevokeFromUser")
+			},
+		},
+		SetPermissionsFunc: &RoleStoreSetPermissionsFunc{
+			defaultHook: func(context.Context, int32, []int32) error {
+				panic("unexpected invocation of MockRoleStore.SetPermissions")
+			},
+		},
+		TransactFunc: &RoleStoreTransactFunc{
+			defaultHook: func(context.Context) (RoleStore, error) {
+				panic("unexpected invocation of MockRoleStore.Transact")
+			},
+		},
+		WithFunc: &RoleStoreWithFunc{
+			defaultHook: func(basestore.ShareableStore) RoleStore {
+				panic("unexpected invocation of MockRoleStore.With")
+			},
+		},
+	}
 }
 
-// Results returns an interface slice containing the results of this
-// invocation.
-func (c RepoStoreGetFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+// NewMockRoleStoreFrom creates a new mock of the MockRoleStore interface.
+// All methods delegate to the given implementation, unless overwritten.
+func NewMockRoleStoreFrom(i RoleStore) *MockRoleStore {
+	return &MockRoleStore{
+		AssignToOrgFunc: &RoleStoreAssignToOrgFunc{
+			defaultHook: i.AssignToOrg,
+		},
+		AssignToUserFunc: &RoleStoreAssignToUserFunc{
+			defaultHook: i.AssignToUser,
+		},
+		CountFunc: &RoleStoreCountFunc{
+			defaultHook: i.Count,
+		},
+		CreateFunc: &RoleStoreCreateFunc{
+			defaultHook: i.Create,
+		},
+		DeleteFunc: &RoleStoreDeleteFunc{
+			defaultHook: i.Delete,
+		},
+		DoneFunc: &RoleStoreDoneFunc{
+			defaultHook: i.Done,
+		},
+		GetByIDFunc: &RoleStoreGetByIDFunc{
+			defaultHook: i.GetByID,
+		},
+		GetByNameFunc: &RoleStoreGetByNameFunc{
+			defaultHook: i.GetByName,
+		},
+		HandleFunc: &RoleStoreHandleFunc{
+			defaultHook: i.Handle,
+		},
+		ListFunc: &RoleStoreListFunc{
+			defaultHook: i.List,
+		},
+		RevokeFromOrgFunc: &RoleStoreRevokeFromOrgFunc{
+			defaultHook: i.RevokeFromOrg,
+		},
+		RevokeFromUserFunc: &RoleStoreRevokeFromUserFunc{
+			defaultHook: i.RevokeFromUser,
+		},
+		SetPermissionsFunc: &RoleStoreSetPermissionsFunc{
+			defaultHook: i.SetPermissions,
+		},
+		TransactFunc: &RoleStoreTransactFunc{
+			defaultHook: i.Transact,
+		},
+		WithFunc: &RoleStoreWithFunc{
+			defaultHook: i.With,
+		},
+	}
 }
 
-// RepoStoreGetByHashedNameFunc describes the behavior when the
-// GetByHashedName method of the parent MockRepoStore instance is invoked.
-type RepoStoreGetByHashedNameFunc struct {
-	defaultHook func(context.Context, api.RepoHashedName) (*types.Repo, error)
-	hooks       []func(context.Context, api.RepoHashedName) (*types.Repo, error)
-	history     []RepoStoreGetByHashedNameFuncCall
+// RoleStoreAssignToOrgFunc describes the behavior when the AssignToOrg
+// method of the parent MockRoleStore instance is invoked.
+type RoleStoreAssignToOrgFunc struct {
+	defaultHook func(context.Context, int32, int32) error
+	hooks       []func(context.Context, int32, int32) error
+	history     []RoleStoreAssignToOrgFuncCall
 	mutex       sync.Mutex
 }
 
-// GetByHashedName delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockRepoStore) GetByHashedName(v0 context.Context, v1 api.RepoHashedName) (*types.Repo, error) {
-	r0, r1 := m.GetByHashedNameFunc.nextHook()(v0, v1)
-	m.GetByHashedNameFunc.appendCall(RepoStoreGetByHashedNameFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// AssignToOrg delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockRoleStore) AssignToOrg(v0 context.Context, v1 int32, v2 int32) error {
+	r0 := m.AssignToOrgFunc.nextHook()(v0, v1, v2)
+	m.AssignToOrgFunc.appendCall(RoleStoreAssignToOrgFuncCall{v0, v1, v2, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the GetByHashedName
-// method of the parent MockRepoStore instance is invoked and the hook queue
-// is empty.
-func (f *RepoStoreGetByHashedNameFunc) SetDefaultHook(hook func(context.Context, api.RepoHashedName) (*types.Repo, error)) {
+// SetDefaultHook sets function that is called when the AssignToOrg method
+// of the parent MockRoleStore instance is invoked and the hook queue is
+// empty.
+func (f *RoleStoreAssignToOrgFunc) SetDefaultHook(hook func(context.Context, int32, int32) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetByHashedName method of the parent MockRepoStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *RepoStoreGetByHashedNameFunc) PushHook(hook func(context.Context, api.RepoHashedName) (*types.Repo, error)) {
+// AssignToOrg method of the parent MockRoleStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RoleStoreAssignToOrgFunc) PushHook(hook func(context.Context, int32, int32) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -32829,20 +38288,20 @@ func (f *RepoStoreGetByHashedNameFunc) PushHook(hook func(context.Context, api.R
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreGetByHashedNameFunc) SetDefaultReturn(r0 *types.Repo, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoHashedName) (*types.Repo, error) {
-		return r0, r1
+func (f *RoleStoreAssignToOrgFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32, int32) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreGetByHashedNameFunc) PushReturn(r0 *types.Repo, r1 error) {
-	f.PushHook(func(context.Context, api.RepoHashedName) (*types.Repo, error) {
-		return r0, r1
+func (f *RoleStoreAssignToOrgFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32, int32) error {
+		return r0
 	})
 }
 
-func (f *RepoStoreGetByHashedNameFunc) nextHook() func(context.Context, api.RepoHashedName) (*types.Repo, error) {
+func (f *RoleStoreAssignToOrgFunc) nextHook() func(context.Context, int32, int32) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -32855,80 +38314,81 @@ func (f *RepoStoreGetByHashedNameFunc) nextHook() func(context.Context, api.Repo
 	return hook
 }
 
-func (f *RepoStoreGetByHashedNameFunc) appendCall(r0 RepoStoreGetByHashedNameFuncCall) {
+func (f *RoleStoreAssignToOrgFunc) appendCall(r0 RoleStoreAssignToOrgFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreGetByHashedNameFuncCall objects
+// History returns a sequence of RoleStoreAssignToOrgFuncCall objects
 // describing the invocations of this function.
-func (f *RepoStoreGetByHashedNameFunc) History() []RepoStoreGetByHashedNameFuncCall {
+func (f *RoleStoreAssignToOrgFunc) History() []RoleStoreAssignToOrgFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreGetByHashedNameFuncCall, len(f.history))
+	history := make([]RoleStoreAssignToOrgFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreGetByHashedNameFuncCall is an object that describes an
-// invocation of method GetByHashedName on an instance of MockRepoStore.
-type RepoStoreGetByHashedNameFuncCall struct {
+// RoleStoreAssignToOrgFuncCall is an object that describes an invocation of
+// method AssignToOrg on an instance of MockRoleStore.
+type RoleStoreAssignToOrgFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 api.RepoHashedName
-	// Result0 is the value of the 1st result returned from this method
+	Arg1 int32
+	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Result0 *types.Repo
-	// Result1 is the value of the 2nd result returned from this method
+	Arg2 int32
+	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreGetByHashedNameFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c RoleStoreAssignToOrgFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreGetByHashedNameFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c RoleStoreAssignToOrgFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// RepoStoreGetByIDsFunc describes the behavior when the GetByIDs method of
-// the parent MockRepoStore instance is invoked.
-type RepoStoreGetByIDsFunc struct {
-	defaultHook func(context.Context, ...api.RepoID) ([]*types.Repo, error)
-	hooks       []func(context.Context, ...api.RepoID) ([]*types.Repo, error)
-	history     []RepoStoreGetByIDsFuncCall
+// RoleStoreAssignToUserFunc describes the behavior when the AssignToUser
+// method of the parent MockRoleStore instance is invoked.
+type RoleStoreAssignToUserFunc struct {
+	defaultHook func(context.Context, int32, int32) error
+	hooks       []func(context.Context, int32, int32) error
+	history     []RoleStoreAssignToUserFuncCall
 	mutex       sync.Mutex
 }
 
-// GetByIDs delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStore) GetByIDs(v0 context.Context, v1 ...api.RepoID) ([]*types.Repo, error) {
-	r0, r1 := m.GetByIDsFunc.nextHook()(v0, v1...)
-	m.GetByIDsFunc.appendCall(RepoStoreGetByIDsFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// AssignToUser delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockRoleStore) AssignToUser(v0 context.Context, v1 int32, v2 int32) error {
+	r0 := m.AssignToUserFunc.nextHook()(v0, v1, v2)
+	m.AssignToUserFunc.appendCall(RoleStoreAssignToUserFuncCall{v0, v1, v2, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the GetByIDs method of
-// the parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreGetByIDsFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) ([]*types.Repo, error)) {
+// SetDefaultHook sets function that is called when the AssignToUser method
+// of the parent MockRoleStore instance is invoked and the hook queue is
+// empty.
+func (f *RoleStoreAssignToUserFunc) SetDefaultHook(hook func(context.Context, int32, int32) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetByIDs method of the parent MockRepoStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
+// AssignToUser method of the parent MockRoleStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *RepoStoreGetByIDsFunc) PushHook(hook func(context.Context, ...api.RepoID) ([]*types.Repo, error)) {
+func (f *RoleStoreAssignToUserFunc) PushHook(hook func(context.Context, int32, int32) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -32936,20 +38396,20 @@ func (f *RepoStoreGetByIDsFunc) PushHook(hook func(context.Context, ...api.RepoI
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreGetByIDsFunc) SetDefaultReturn(r0 []*types.Repo, r1 error) {
-	f.SetDefaultHook(func(context.Context, ...api.RepoID) ([]*types.Repo, error) {
-		return r0, r1
+func (f *RoleStoreAssignToUserFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32, int32) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreGetByIDsFunc) PushReturn(r0 []*types.Repo, r1 error) {
-	f.PushHook(func(context.Context, ...api.RepoID) ([]*types.Repo, error) {
-		return r0, r1
+func (f *RoleStoreAssignToUserFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32, int32) error {
+		return r0
 	})
 }
 
-func (f *RepoStoreGetByIDsFunc) nextHook() func(context.Context, ...api.RepoID) ([]*types.Repo, error) {
+func (f *RoleStoreAssignToUserFunc) nextHook() func(context.Context, int32, int32) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -32962,87 +38422,80 @@ func (f *RepoStoreGetByIDsFunc) nextHook() func(context.Context, ...api.RepoID)
 	return hook
 }
 
-func (f *RepoStoreGetByIDsFunc) appendCall(r0 RepoStoreGetByIDsFuncCall) {
+func (f *RoleStoreAssignToUserFunc) appendCall(r0 RoleStoreAssignToUserFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreGetByIDsFuncCall objects
+// History returns a sequence of RoleStoreAssignToUserFuncCall objects
 // describing the invocations of this function.
-func (f *RepoStoreGetByIDsFunc) History() []RepoStoreGetByIDsFuncCall {
+func (f *RoleStoreAssignToUserFunc) History() []RoleStoreAssignToUserFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreGetByIDsFuncCall, len(f.history))
+	history := make([]RoleStoreAssignToUserFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreGetByIDsFuncCall is an object that describes an invocation of
-// method GetByIDs on an instance of MockRepoStore.
-type RepoStoreGetByIDsFuncCall struct {
+// RoleStoreAssignToUserFuncCall is an object that describes an invocation
+// of method AssignToUser on an instance of MockRoleStore.
+type RoleStoreAssignToUserFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is a slice containing the values of the variadic arguments
-	// passed to this method invocation.
-	Arg1 []api.RepoID
-	// Result0 is the value of the 1st result returned from this method
+	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Result0 []*types.Repo
-	// Result1 is the value of the 2nd result returned from this method
+	Arg1 int32
+	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Result1 error
+	Arg2 int32
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation. The variadic slice argument is flattened in this array such
-// that one positional argument and three variadic arguments would result in
-// a slice of four, not two.
-func (c RepoStoreGetByIDsFuncCall) Args() []interface{} {
-	trailing := []interface{}{}
-	for _, val := range c.Arg1 {
-		trailing = append(trailing, val)
-	}
-
-	return append([]interface{}{c.Arg0}, trailing...)
+// invocation.
+func (c RoleStoreAssignToUserFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreGetByIDsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c RoleStoreAssignToUserFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// RepoStoreGetByNameFunc describes the behavior when the GetByName method
-// of the parent MockRepoStore instance is invoked.
-type RepoStoreGetByNameFunc struct {
-	defaultHook func(context.Context, api.RepoName) (*types.Repo, error)
-	hooks       []func(context.Context, api.RepoName) (*types.Repo, error)
-	history     []RepoStoreGetByNameFuncCall
+// RoleStoreCountFunc describes the behavior when the Count method of the
+// parent MockRoleStore instance is invoked.
+type RoleStoreCountFunc struct {
+	defaultHook func(context.Context, RolesListOptions) (int, error)
+	hooks       []func(context.Context, RolesListOptions) (int, error)
+	history     []RoleStoreCountFuncCall
 	mutex       sync.Mutex
 }
 
-// GetByName delegates to the next hook function in the queue and stores the
+// Count delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRepoStore) GetByName(v0 context.Context, v1 api.RepoName) (*types.Repo, error) {
-	r0, r1 := m.GetByNameFunc.nextHook()(v0, v1)
-	m.GetByNameFunc.appendCall(RepoStoreGetByNameFuncCall{v0, v1, r0, r1})
+func (m *MockRoleStore) Count(v0 context.Context, v1 RolesListOptions) (int, error) {
+	r0, r1 := m.CountFunc.nextHook()(v0, v1)
+	m.CountFunc.appendCall(RoleStoreCountFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetByName method of
-// the parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreGetByNameFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*types.Repo, error)) {
+// SetDefaultHook sets function that is called when the Count method of the
+// parent MockRoleStore instance is invoked and the hook queue is empty.
+func (f *RoleStoreCountFunc) SetDefaultHook(hook func(context.Context, RolesListOptions) (int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetByName method of the parent MockRepoStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *RepoStoreGetByNameFunc) PushHook(hook func(context.Context, api.RepoName) (*types.Repo, error)) {
+// Count method of the parent MockRoleStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *RoleStoreCountFunc) PushHook(hook func(context.Context, RolesListOptions) (int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -33050,20 +38503,20 @@ func (f *RepoStoreGetByNameFunc) PushHook(hook func(context.Context, api.RepoNam
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreGetByNameFunc) SetDefaultReturn(r0 *types.Repo, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName) (*types.Repo, error) {
+func (f *RoleStoreCountFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, RolesListOptions) (int, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreGetByNameFunc) PushReturn(r0 *types.Repo, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName) (*types.Repo, error) {
+func (f *RoleStoreCountFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, RolesListOptions) (int, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStoreGetByNameFunc) nextHook() func(context.Context, api.RepoName) (*types.Repo, error) {
+func (f *RoleStoreCountFunc) nextHook() func(context.Context, RolesListOptions) (int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -33076,35 +38529,35 @@ func (f *RepoStoreGetByNameFunc) nextHook() func(context.Context, api.RepoName)
 	return hook
 }
 
-func (f *RepoStoreGetByNameFunc) appendCall(r0 RepoStoreGetByNameFuncCall) {
+func (f *RoleStoreCountFunc) appendCall(r0 RoleStoreCountFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreGetByNameFuncCall objects
-// describing the invocations of this function.
-func (f *RepoStoreGetByNameFunc) History() []RepoStoreGetByNameFuncCall {
+// History returns a sequence of RoleStoreCountFuncCall objects describing
+// the invocations of this function.
+func (f *RoleStoreCountFunc) History() []RoleStoreCountFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreGetByNameFuncCall, len(f.history))
+	history := make([]RoleStoreCountFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreGetByNameFuncCall is an object that describes an invocation of
-// method GetByName on an instance of MockRepoStore.
-type RepoStoreGetByNameFuncCall struct {
+// RoleStoreCountFuncCall is an object that describes an invocation of
+// method Count on an instance of MockRoleStore.
+type RoleStoreCountFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 api.RepoName
+	Arg1 RolesListOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *types.Repo
+	Result0 int
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -33112,47 +38565,44 @@ type RepoStoreGetByNameFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreGetByNameFuncCall) Args() []interface{} {
+func (c RoleStoreCountFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreGetByNameFuncCall) Results() []interface{} {
+func (c RoleStoreCountFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreGetFirstRepoNameByCloneURLFunc describes the behavior when the
-// GetFirstRepoNameByCloneURL method of the parent MockRepoStore instance is
-// invoked.
-type RepoStoreGetFirstRepoNameByCloneURLFunc struct {
-	defaultHook func(context.Context, string) (api.RepoName, error)
-	hooks       []func(context.Context, string) (api.RepoName, error)
-	history     []RepoStoreGetFirstRepoNameByCloneURLFuncCall
+// RoleStoreCreateFunc describes the behavior when the Create method of the
+// parent MockRoleStore instance is invoked.
+type RoleStoreCreateFunc struct {
+	defaultHook func(context.Context, string) (*types.Role, error)
+	hooks       []func(context.Context, string) (*types.Role, error)
+	history     []RoleStoreCreateFuncCall
 	mutex       sync.Mutex
 }
 
-// GetFirstRepoNameByCloneURL delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockRepoStore) GetFirstRepoNameByCloneURL(v0 context.Context, v1 string) (api.RepoName, error) {
-	r0, r1 := m.GetFirstRepoNameByCloneURLFunc.nextHook()(v0, v1)
-	m.GetFirstRepoNameByCloneURLFunc.appendCall(RepoStoreGetFirstRepoNameByCloneURLFuncCall{v0, v1, r0, r1})
+// Create delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRoleStore) Create(v0 context.Context, v1 string) (*types.Role, error) {
+	r0, r1 := m.CreateFunc.nextHook()(v0, v1)
+	m.CreateFunc.appendCall(RoleStoreCreateFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// GetFirstRepoNameByCloneURL method of the parent MockRepoStore instance is
-// invoked and the hook queue is empty.
-func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) SetDefaultHook(hook func(context.Context, string) (api.RepoName, error)) {
+// SetDefaultHook sets function that is called when the Create method of the
+// parent MockRoleStore instance is invoked and the hook queue is empty.
+func (f *RoleStoreCreateFunc) SetDefaultHook(hook func(context.Context, string) (*types.Role, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetFirstRepoNameByCloneURL method of the parent MockRepoStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) PushHook(hook func(context.Context, string) (api.RepoName, error)) {
+// Create method of the parent MockRoleStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RoleStoreCreateFunc) PushHook(hook func(context.Context, string) (*types.Role, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -33160,20 +38610,20 @@ func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) PushHook(hook func(context.Con
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) SetDefaultReturn(r0 api.RepoName, r1 error) {
-	f.SetDefaultHook(func(context.Context, string) (api.RepoName, error) {
+func (f *RoleStoreCreateFunc) SetDefaultReturn(r0 *types.Role, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (*types.Role, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) PushReturn(r0 api.RepoName, r1 error) {
-	f.PushHook(func(context.Context, string) (api.RepoName, error) {
+func (f *RoleStoreCreateFunc) PushReturn(r0 *types.Role, r1 error) {
+	f.PushHook(func(context.Context, string) (*types.Role, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) nextHook() func(context.Context, string) (api.RepoName, error) {
+func (f *RoleStoreCreateFunc) nextHook() func(context.Context, string) (*types.Role, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -33186,27 +38636,26 @@ func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) nextHook() func(context.Contex
 	return hook
 }
 
-func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) appendCall(r0 RepoStoreGetFirstRepoNameByCloneURLFuncCall) {
+func (f *RoleStoreCreateFunc) appendCall(r0 RoleStoreCreateFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreGetFirstRepoNameByCloneURLFuncCall
-// objects describing the invocations of this function.
-func (f *RepoStoreGetFirstRepoNameByCloneURLFunc) History() []RepoStoreGetFirstRepoNameByCloneURLFuncCall {
+// History returns a sequence of RoleStoreCreateFuncCall objects describing
+// the invocations of this function.
+func (f *RoleStoreCreateFunc) History() []RoleStoreCreateFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreGetFirstRepoNameByCloneURLFuncCall, len(f.history))
+	history := make([]RoleStoreCreateFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreGetFirstRepoNameByCloneURLFuncCall is an object that describes
-// an invocation of method GetFirstRepoNameByCloneURL on an instance of
-// MockRepoStore.
-type RepoStoreGetFirstRepoNameByCloneURLFuncCall struct {
+// RoleStoreCreateFuncCall is an object that describes an invocation of
+// method Create on an instance of MockRoleStore.
+type RoleStoreCreateFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -33215,7 +38664,7 @@ type RepoStoreGetFirstRepoNameByCloneURLFuncCall struct {
 	Arg1 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 api.RepoName
+	Result0 *types.Role
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -33223,47 +38672,44 @@ type RepoStoreGetFirstRepoNameByCloneURLFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreGetFirstRepoNameByCloneURLFuncCall) Args() []interface{} {
+func (c RoleStoreCreateFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreGetFirstRepoNameByCloneURLFuncCall) Results() []interface{} {
+func (c RoleStoreCreateFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreGetRepoDescriptionsByIDsFunc describes the behavior when the
-// GetRepoDescriptionsByIDs method of the parent MockRepoStore instance is
-// invoked.
-type RepoStoreGetRepoDescriptionsByIDsFunc struct {
-	defaultHook func(context.Context, ...api.RepoID) (map[api.RepoID]string, error)
-	hooks       []func(context.Context, ...api.RepoID) (map[api.RepoID]string, error)
-	history     []RepoStoreGetRepoDescriptionsByIDsFuncCall
+// RoleStoreDeleteFunc describes the behavior when the Delete method of the
+// parent MockRoleStore instance is invoked.
+type RoleStoreDeleteFunc struct {
+	defaultHook func(context.Context, int32) error
+	hooks       []func(context.Context, int32) error
+	history     []RoleStoreDeleteFuncCall
 	mutex       sync.Mutex
 }
 
-// GetRepoDescriptionsByIDs delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockRepoStore) GetRepoDescriptionsByIDs(v0 context.Context, v1 ...api.RepoID) (map[api.RepoID]string, error) {
-	r0, r1 := m.GetRepoDescriptionsByIDsFunc.nextHook()(v0, v1...)
-	m.GetRepoDescriptionsByIDsFunc.appendCall(RepoStoreGetRepoDescriptionsByIDsFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// Delete delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRoleStore) Delete(v0 context.Context, v1 int32) error {
+	r0 := m.DeleteFunc.nextHook()(v0, v1)
+	m.DeleteFunc.appendCall(RoleStoreDeleteFuncCall{v0, v1, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the
-// GetRepoDescriptionsByIDs method of the parent MockRepoStore instance is
-// invoked and the hook queue is empty.
-func (f *RepoStoreGetRepoDescriptionsByIDsFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) (map[api.RepoID]string, error)) {
+// SetDefaultHook sets function that is called when the Delete method of the
+// parent MockRoleStore instance is invoked and the hook queue is empty.
+func (f *RoleStoreDeleteFunc) SetDefaultHook(hook func(context.Context, int32) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetRepoDescriptionsByIDs method of the parent MockRepoStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *RepoStoreGetRepoDescriptionsByIDsFunc) PushHook(hook func(context.Context, ...api.RepoID) (map[api.RepoID]string, error)) {
+// Delete method of the parent MockRoleStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RoleStoreDeleteFunc) PushHook(hook func(context.Context, int32) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -33271,20 +38717,20 @@ func (f *RepoStoreGetRepoDescriptionsByIDsFunc) PushHook(hook func(context.Conte
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreGetRepoDescriptionsByIDsFunc) SetDefaultReturn(r0 map[api.RepoID]string, r1 error) {
-	f.SetDefaultHook(func(context.Context, ...api.RepoID) (map[api.RepoID]string, error) {
-		return r0, r1
+func (f *RoleStoreDeleteFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreGetRepoDescriptionsByIDsFunc) PushReturn(r0 map[api.RepoID]string, r1 error) {
-	f.PushHook(func(context.Context, ...api.RepoID) (map[api.RepoID]string, error) {
-		return r0, r1
+func (f *RoleStoreDeleteFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32) error {
+		return r0
 	})
 }
 
-func (f *RepoStoreGetRepoDescriptionsByIDsFunc) nextHook() func(context.Context, ...api.RepoID) (map[api.RepoID]string, error) {
+func (f *RoleStoreDeleteFunc) nextHook() func(context.Context, int32) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -33297,89 +38743,77 @@ func (f *RepoStoreGetRepoDescriptionsByIDsFunc) nextHook() func(context.Context,
 	return hook
 }
 
-func (f *RepoStoreGetRepoDescriptionsByIDsFunc) appendCall(r0 RepoStoreGetRepoDescriptionsByIDsFuncCall) {
+func (f *RoleStoreDeleteFunc) appendCall(r0 RoleStoreDeleteFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreGetRepoDescriptionsByIDsFuncCall
-// objects describing the invocations of this function.
-func (f *RepoStoreGetRepoDescriptionsByIDsFunc) History() []RepoStoreGetRepoDescriptionsByIDsFuncCall {
+// History returns a sequence of RoleStoreDeleteFuncCall objects describing
+// the invocations of this function.
+func (f *RoleStoreDeleteFunc) History() []RoleStoreDeleteFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreGetRepoDescriptionsByIDsFuncCall, len(f.history))
+	history := make([]RoleStoreDeleteFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreGetRepoDescriptionsByIDsFuncCall is an object that describes an
-// invocation of method GetRepoDescriptionsByIDs on an instance of
-// MockRepoStore.
-type RepoStoreGetRepoDescriptionsByIDsFuncCall struct {
+// RoleStoreDeleteFuncCall is an object that describes an invocation of
+// method Delete on an instance of MockRoleStore.
+type RoleStoreDeleteFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is a slice containing the values of the variadic arguments
-	// passed to this method invocation.
-	Arg1 []api.RepoID
-	// Result0 is the value of the 1st result returned from this method
+	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Result0 map[api.RepoID]string
-	// Result1 is the value of the 2nd result returned from this method
+	Arg1 int32
+	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation. The variadic slice argument is flattened in this array such
-// that one positional argument and three variadic arguments would result in
-// a slice of four, not two.
-func (c RepoStoreGetRepoDescriptionsByIDsFuncCall) Args() []interface{} {
-	trailing := []interface{}{}
-	for _, val := range c.Arg1 {
-		trailing = append(trailing, val)
-	}
-
-	return append([]interface{}{c.Arg0}, trailing...)
+// invocation.
+func (c RoleStoreDeleteFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreGetRepoDescriptionsByIDsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c RoleStoreDeleteFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// RepoStoreGetReposSetByIDsFunc describes the behavior when the
-// GetReposSetByIDs method of the parent MockRepoStore instance is invoked.
-type RepoStoreGetReposSetByIDsFunc struct {
-	defaultHook func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error)
-	hooks       []func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error)
-	history     []RepoStoreGetReposSetByIDsFuncCall
+// RoleStoreDoneFunc describes the behavior when the Done method of the
+// parent MockRoleStore instance is invoked.
+type RoleStoreDoneFunc struct {
+	defaultHook func(error) error
+	hooks       []func(error) error
+	history     []RoleStoreDoneFuncCall
 	mutex       sync.Mutex
 }
 
-// GetReposSetByIDs delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockRepoStore) GetReposSetByIDs(v0 context.Context, v1 ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
-	r0, r1 := m.GetReposSetByIDsFunc.nextHook()(v0, v1...)
-	m.GetReposSetByIDsFunc.appendCall(RepoStoreGetReposSetByIDsFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// Done delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRoleStore) Done(v0 error) error {
+	r0 := m.DoneFunc.nextHook()(v0)
+	m.DoneFunc.appendCall(RoleStoreDoneFuncCall{v0, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the GetReposSetByIDs
-// method of the parent MockRepoStore instance is invoked and the hook queue
-// is empty.
-func (f *RepoStoreGetReposSetByIDsFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error)) {
+// SetDefaultHook sets function that is called when the Done method of the
+// parent MockRoleStore instance is invoked and the hook queue is empty.
+func (f *RoleStoreDoneFunc) SetDefaultHook(hook func(error) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetReposSetByIDs method of the parent MockRepoStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *RepoStoreGetReposSetByIDsFunc) PushHook(hook func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error)) {
+// Done method of the parent MockRoleStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *RoleStoreDoneFunc) PushHook(hook func(error) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -33387,20 +38821,20 @@ func (f *RepoStoreGetReposSetByIDsFunc) PushHook(hook func(context.Context, ...a
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreGetReposSetByIDsFunc) SetDefaultReturn(r0 map[api.RepoID]*types.Repo, r1 error) {
-	f.SetDefaultHook(func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
-		return r0, r1
+func (f *RoleStoreDoneFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(error) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreGetReposSetByIDsFunc) PushReturn(r0 map[api.RepoID]*types.Repo, r1 error) {
-	f.PushHook(func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
-		return r0, r1
+func (f *RoleStoreDoneFunc) PushReturn(r0 error) {
+	f.PushHook(func(error) error {
+		return r0
 	})
 }
 
-func (f *RepoStoreGetReposSetByIDsFunc) nextHook() func(context.Context, ...api.RepoID) (map[api.RepoID]*types.Repo, error) {
+func (f *RoleStoreDoneFunc) nextHook() func(error) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -33413,87 +38847,74 @@ func (f *RepoStoreGetReposSetByIDsFunc) nextHook() func(context.Context, ...api.
 	return hook
 }
 
-func (f *RepoStoreGetReposSetByIDsFunc) appendCall(r0 RepoStoreGetReposSetByIDsFuncCall) {
+func (f *RoleStoreDoneFunc) appendCall(r0 RoleStoreDoneFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreGetReposSetByIDsFuncCall objects
-// describing the invocations of this function.
-func (f *RepoStoreGetReposSetByIDsFunc) History() []RepoStoreGetReposSetByIDsFuncCall {
+// History returns a sequence of RoleStoreDoneFuncCall objects describing
+// the invocations of this function.
+func (f *RoleStoreDoneFunc) History() []RoleStoreDoneFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreGetReposSetByIDsFuncCall, len(f.history))
+	history := make([]RoleStoreDoneFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreGetReposSetByIDsFuncCall is an object that describes an
-// invocation of method GetReposSetByIDs on an instance of MockRepoStore.
-type RepoStoreGetReposSetByIDsFuncCall struct {
+// RoleStoreDoneFuncCall is an object that describes an invocation of method
+// Done on an instance of MockRoleStore.
+type RoleStoreDoneFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is a slice containing the values of the variadic arguments
-	// passed to this method invocation.
-	Arg1 []api.RepoID
+	Arg0 error
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 map[api.RepoID]*types.Repo
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation. The variadic slice argument is flattened in this array such
-// that one positional argument and three variadic arguments would result in
-// a slice of four, not two.
-func (c RepoStoreGetReposSetByIDsFuncCall) Args() []interface{} {
-	trailing := []interface{}{}
-	for _, val := range c.Arg1 {
-		trailing = append(trailing, val)
-	}
-
-	return append([]interface{}{c.Arg0}, trailing...)
+// invocation.
+func (c RoleStoreDoneFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreGetReposSetByIDsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c RoleStoreDoneFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// RepoStoreHandleFunc describes the behavior when the Handle method of the
-// parent MockRepoStore instance is invoked.
-type RepoStoreHandleFunc struct {
-	defaultHook func() basestore.TransactableHandle
-	hooks       []func() basestore.TransactableHandle
-	history     []RepoStoreHandleFuncCall
+// RoleStoreGetByIDFunc describes the behavior when the GetByID method of
+// the parent MockRoleStore instance is invoked.
+type RoleStoreGetByIDFunc struct {
+	defaultHook func(context.Context, int32) (*types.Role, error)
+	hooks       []func(context.Context, int32) (*types.Role, error)
+	history     []RoleStoreGetByIDFuncCall
 	mutex       sync.Mutex
 }
 
-// Handle delegates to the next hook function in the queue and stores the
+// GetByID delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRepoStore) Handle() basestore.TransactableHandle {
-	r0 := m.HandleFunc.nextHook()()
-	m.HandleFunc.appendCall(RepoStoreHandleFuncCall{r0})
-	return r0
+func (m *MockRoleStore) GetByID(v0 context.Context, v1 int32) (*types.Role, error) {
+	r0, r1 := m.GetByIDFunc.nextHook()(v0, v1)
+	m.GetByIDFunc.appendCall(RoleStoreGetByIDFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Handle method of the
-// parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+// SetDefaultHook sets function that is called when the GetByID method of
+// the parent MockRoleStore instance is invoked and the hook queue is empty.
+func (f *RoleStoreGetByIDFunc) SetDefaultHook(hook func(context.Context, int32) (*types.Role, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Handle method of the parent MockRepoStore instance invokes the hook at
+// GetByID method of the parent MockRoleStore instance invokes the hook at
 // the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *RepoStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+func (f *RoleStoreGetByIDFunc) PushHook(hook func(context.Context, int32) (*types.Role, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -33501,20 +38922,20 @@ func (f *RepoStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle)
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
-	f.SetDefaultHook(func() basestore.TransactableHandle {
-		return r0
+func (f *RoleStoreGetByIDFunc) SetDefaultReturn(r0 *types.Role, r1 error) {
+	f.SetDefaultHook(func(context.Context, int32) (*types.Role, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
-	f.PushHook(func() basestore.TransactableHandle {
-		return r0
+func (f *RoleStoreGetByIDFunc) PushReturn(r0 *types.Role, r1 error) {
+	f.PushHook(func(context.Context, int32) (*types.Role, error) {
+		return r0, r1
 	})
 }
 
-func (f *RepoStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+func (f *RoleStoreGetByIDFunc) nextHook() func(context.Context, int32) (*types.Role, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -33527,71 +38948,80 @@ func (f *RepoStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
 	return hook
 }
 
-func (f *RepoStoreHandleFunc) appendCall(r0 RepoStoreHandleFuncCall) {
+func (f *RoleStoreGetByIDFunc) appendCall(r0 RoleStoreGetByIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreHandleFuncCall objects describing
+// History returns a sequence of RoleStoreGetByIDFuncCall objects describing
 // the invocations of this function.
-func (f *RepoStoreHandleFunc) History() []RepoStoreHandleFuncCall {
+func (f *RoleStoreGetByIDFunc) History() []RoleStoreGetByIDFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreHandleFuncCall, len(f.history))
+	history := make([]RoleStoreGetByIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreHandleFuncCall is an object that describes an invocation of
-// method Handle on an instance of MockRepoStore.
-type RepoStoreHandleFuncCall struct {
+// RoleStoreGetByIDFuncCall is an object that describes an invocation of
+// method GetByID on an instance of MockRoleStore.
+type RoleStoreGetByIDFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int32
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 basestore.TransactableHandle
+	Result0 *types.Role
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreHandleFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c RoleStoreGetByIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreHandleFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c RoleStoreGetByIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreListFunc describes the behavior when the List method of the
-// parent MockRepoStore instance is invoked.
-type RepoStoreListFunc struct {
-	defaultHook func(context.Context, ReposListOptions) ([]*types.Repo, error)
-	hooks       []func(context.Context, ReposListOptions) ([]*types.Repo, error)
-	history     []RepoStoreListFuncCall
+// RoleStoreGetByNameFunc describes the behavior when the GetByName method
+// of the parent MockRoleStore instance is invoked.
+type RoleStoreGetByNameFunc struct {
+	defaultHook func(context.Context, string) (*types.Role, error)
+	hooks       []func(context.Context, string) (*types.Role, error)
+	history     []RoleStoreGetByNameFuncCall
 	mutex       sync.Mutex
 }
 
-// List delegates to the next hook function in the queue and stores the
+// GetByName delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRepoStore) List(v0 context.Context, v1 ReposListOptions) ([]*types.Repo, error) {
-	r0, r1 := m.ListFunc.nextHook()(v0, v1)
-	m.ListFunc.appendCall(RepoStoreListFuncCall{v0, v1, r0, r1})
+func (m *MockRoleStore) GetByName(v0 context.Context, v1 string) (*types.Role, error) {
+	r0, r1 := m.GetByNameFunc.nextHook()(v0, v1)
+	m.GetByNameFunc.appendCall(RoleStoreGetByNameFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the List method of the
-// parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreListFunc) SetDefaultHook(hook func(context.Context, ReposListOptions) ([]*types.Repo, error)) {
+// SetDefaultHook sets function that is called when the GetByName method of
+// the parent MockRoleStore instance is invoked and the hook queue is empty.
+func (f *RoleStoreGetByNameFunc) SetDefaultHook(hook func(context.Context, string) (*types.Role, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// List method of the parent MockRepoStore instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *RepoStoreListFunc) PushHook(hook func(context.Context, ReposListOptions) ([]*types.Repo, error)) {
+// GetByName method of the parent MockRoleStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RoleStoreGetByNameFunc) PushHook(hook func(context.Context, string) (*types.Role, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -33599,20 +39029,20 @@ func (f *RepoStoreListFunc) PushHook(hook func(context.Context, ReposListOptions
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreListFunc) SetDefaultReturn(r0 []*types.Repo, r1 error) {
-	f.SetDefaultHook(func(context.Context, ReposListOptions) ([]*types.Repo, error) {
+func (f *RoleStoreGetByNameFunc) SetDefaultReturn(r0 *types.Role, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (*types.Role, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreListFunc) PushReturn(r0 []*types.Repo, r1 error) {
-	f.PushHook(func(context.Context, ReposListOptions) ([]*types.Repo, error) {
+func (f *RoleStoreGetByNameFunc) PushReturn(r0 *types.Role, r1 error) {
+	f.PushHook(func(context.Context, string) (*types.Role, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStoreListFunc) nextHook() func(context.Context, ReposListOptions) ([]*types.Repo, error) {
+func (f *RoleStoreGetByNameFunc) nextHook() func(context.Context, string) (*types.Role, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -33625,35 +39055,35 @@ func (f *RepoStoreListFunc) nextHook() func(context.Context, ReposListOptions) (
 	return hook
 }
 
-func (f *RepoStoreListFunc) appendCall(r0 RepoStoreListFuncCall) {
+func (f *RoleStoreGetByNameFunc) appendCall(r0 RoleStoreGetByNameFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreListFuncCall objects describing
-// the invocations of this function.
-func (f *RepoStoreListFunc) History() []RepoStoreListFuncCall {
+// History returns a sequence of RoleStoreGetByNameFuncCall objects
+// describing the invocations of this function.
+func (f *RoleStoreGetByNameFunc) History() []RoleStoreGetByNameFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreListFuncCall, len(f.history))
+	history := make([]RoleStoreGetByNameFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreListFuncCall is an object that describes an invocation of method
-// List on an instance of MockRepoStore.
-type RepoStoreListFuncCall struct {
+// RoleStoreGetByNameFuncCall is an object that describes an invocation of
+// method GetByName on an instance of MockRoleStore.
+type RoleStoreGetByNameFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 ReposListOptions
+	Arg1 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []*types.Repo
+	Result0 *types.Role
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -33661,45 +39091,44 @@ type RepoStoreListFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreListFuncCall) Args() []interface{} {
+func (c RoleStoreGetByNameFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreListFuncCall) Results() []interface{} {
+func (c RoleStoreGetByNameFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreListMinimalReposFunc describes the behavior when the
-// ListMinimalRepos method of the parent MockRepoStore instance is invoked.
-type RepoStoreListMinimalReposFunc struct {
-	defaultHook func(context.Context, ReposListOptions) ([]types.MinimalRepo, error)
-	hooks       []func(context.Context, ReposListOptions) ([]types.MinimalRepo, error)
-	history     []RepoStoreListMinimalReposFuncCall
-	mutex       sync.Mutex
-}
-
-// ListMinimalRepos delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockRepoStore) ListMinimalRepos(v0 context.Context, v1 ReposListOptions) ([]types.MinimalRepo, error) {
-	r0, r1 := m.ListMinimalReposFunc.nextHook()(v0, v1)
-	m.ListMinimalReposFunc.appendCall(RepoStoreListMinimalReposFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// RoleStoreHandleFunc describes the behavior when the Handle method of the
+// parent MockRoleStore instance is invoked.
+type RoleStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []RoleStoreHandleFuncCall
+	mutex       sync.Mutex
 }
 
-// SetDefaultHook sets function that is called when the ListMinimalRepos
-// method of the parent MockRepoStore instance is invoked and the hook queue
-// is empty.
-func (f *RepoStoreListMinimalReposFunc) SetDefaultHook(hook func(context.Context, ReposListOptions) ([]types.MinimalRepo, error)) {
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRoleStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(RoleStoreHandleFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockRoleStore instance is invoked and the hook queue is empty.
+func (f *RoleStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListMinimalRepos method of the parent MockRepoStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *RepoStoreListMinimalReposFunc) PushHook(hook func(context.Context, ReposListOptions) ([]types.MinimalRepo, error)) {
+// Handle method of the parent MockRoleStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *RoleStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -33707,20 +39136,20 @@ func (f *RepoStoreListMinimalReposFunc) PushHook(hook func(context.Context, Repo
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreListMinimalReposFunc) SetDefaultReturn(r0 []types.MinimalRepo, r1 error) {
-	f.SetDefaultHook(func(context.Context, ReposListOptions) ([]types.MinimalRepo, error) {
-		return r0, r1
+func (f *RoleStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreListMinimalReposFunc) PushReturn(r0 []types.MinimalRepo, r1 error) {
-	f.PushHook(func(context.Context, ReposListOptions) ([]types.MinimalRepo, error) {
-		return r0, r1
+func (f *RoleStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
+		return r0
 	})
 }
 
-func (f *RepoStoreListMinimalReposFunc) nextHook() func(context.Context, ReposListOptions) ([]types.MinimalRepo, error) {
+func (f *RoleStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -33733,84 +39162,71 @@ func (f *RepoStoreListMinimalReposFunc) nextHook() func(context.Context, ReposLi
 	return hook
 }
 
-func (f *RepoStoreListMinimalReposFunc) appendCall(r0 RepoStoreListMinimalReposFuncCall) {
+func (f *RoleStoreHandleFunc) appendCall(r0 RoleStoreHandleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreListMinimalReposFuncCall objects
-// describing the invocations of this function.
-func (f *RepoStoreListMinimalReposFunc) History() []RepoStoreListMinimalReposFuncCall {
+// History returns a sequence of RoleStoreHandleFuncCall objects describing
+// the invocations of this function.
+func (f *RoleStoreHandleFunc) History() []RoleStoreHandleFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreListMinimalReposFuncCall, len(f.history))
+	history := make([]RoleStoreHandleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreListMinimalReposFuncCall is an object that describes an
-// invocation of method ListMinimalRepos on an instance of MockRepoStore.
-type RepoStoreListMinimalReposFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 ReposListOptions
+// RoleStoreHandleFuncCall is an object that describes an invocation of
+// method Handle on an instance of MockRoleStore.
+type RoleStoreHandleFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []types.MinimalRepo
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 basestore.TransactableHandle
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreListMinimalReposFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c RoleStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreListMinimalReposFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c RoleStoreHandleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// RepoStoreListSourcegraphDotComIndexableReposFunc describes the behavior
-// when the ListSourcegraphDotComIndexableRepos method of the parent
-// MockRepoStore instance is invoked.
-type RepoStoreListSourcegraphDotComIndexableReposFunc struct {
-	defaultHook func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error)
-	hooks       []func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error)
-	history     []RepoStoreListSourcegraphDotComIndexableReposFuncCall
+// RoleStoreListFunc describes the behavior when the List method of the
+// parent MockRoleStore instance is invoked.
+type RoleStoreListFunc struct {
+	defaultHook func(context.Context, RolesListOptions) ([]*types.Role, error)
+	hooks       []func(context.Context, RolesListOptions) ([]*types.Role, error)
+	history     []RoleStoreListFuncCall
 	mutex       sync.Mutex
 }
 
-// ListSourcegraphDotComIndexableRepos delegates to the next hook function
-// in the queue and stores the parameter and result values of this
-// invocation.
-func (m *MockRepoStore) ListSourcegraphDotComIndexableRepos(v0 context.Context, v1 ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
-	r0, r1 := m.ListSourcegraphDotComIndexableReposFunc.nextHook()(v0, v1)
-	m.ListSourcegraphDotComIndexableReposFunc.appendCall(RepoStoreListSourcegraphDotComIndexableReposFuncCall{v0, v1, r0, r1})
+// List delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockRoleStore) List(v0 context.Context, v1 RolesListOptions) ([]*types.Role, error) {
+	r0, r1 := m.ListFunc.nextHook()(v0, v1)
+	m.ListFunc.appendCall(RoleStoreListFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// ListSourcegraphDotComIndexableRepos method of the parent MockRepoStore
-// instance is invoked and the hook queue is empty.
-func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) SetDefaultHook(hook func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error)) {
+// SetDefaultHook sets function that is called when the List method of the
+// parent MockRoleStore instance is invoked and the hook queue is empty.
+func (f *RoleStoreListFunc) SetDefaultHook(hook func(context.Context, RolesListOptions) ([]*types.Role, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListSourcegraphDotComIndexableRepos method of the parent MockRepoStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) PushHook(hook func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error)) {
+// List method of the parent MockRoleStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *RoleStoreListFunc) PushHook(hook func(context.Context, RolesListOptions) ([]*types.Role, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -33818,20 +39234,20 @@ func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) PushHook(hook func(co
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) SetDefaultReturn(r0 []types.MinimalRepo, r1 error) {
-	f.SetDefaultHook(func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
+func (f *RoleStoreListFunc) SetDefaultReturn(r0 []*types.Role, r1 error) {
+	f.SetDefaultHook(func(context.Context, RolesListOptions) ([]*types.Role, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) PushReturn(r0 []types.MinimalRepo, r1 error) {
-	f.PushHook(func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
+func (f *RoleStoreListFunc) PushReturn(r0 []*types.Role, r1 error) {
+	f.PushHook(func(context.Context, RolesListOptions) ([]*types.Role, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) nextHook() func(context.Context, ListSourcegraphDotComIndexableReposOptions) ([]types.MinimalRepo, error) {
+func (f *RoleStoreListFunc) nextHook() func(context.Context, RolesListOptions) ([]*types.Role, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -33844,37 +39260,35 @@ func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) nextHook() func(conte
 	return hook
 }
 
-func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) appendCall(r0 RepoStoreListSourcegraphDotComIndexableReposFuncCall) {
+func (f *RoleStoreListFunc) appendCall(r0 RoleStoreListFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// RepoStoreListSourcegraphDotComIndexableReposFuncCall objects describing
+// History returns a sequence of RoleStoreListFuncCall objects describing
 // the invocations of this function.
-func (f *RepoStoreListSourcegraphDotComIndexableReposFunc) History() []RepoStoreListSourcegraphDotComIndexableReposFuncCall {
+func (f *RoleStoreListFunc) History() []RoleStoreListFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreListSourcegraphDotComIndexableReposFuncCall, len(f.history))
+	history := make([]RoleStoreListFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreListSourcegraphDotComIndexableReposFuncCall is an object that
-// describes an invocation of method ListSourcegraphDotComIndexableRepos on
-// an instance of MockRepoStore.
-type RepoStoreListSourcegraphDotComIndexableReposFuncCall struct {
+// RoleStoreListFuncCall is an object that describes an invocation of method
+// List on an instance of MockRoleStore.
+type RoleStoreListFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 ListSourcegraphDotComIndexableReposOptions
+	Arg1 RolesListOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []types.MinimalRepo
+	Result0 []*types.Role
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -33882,44 +39296,45 @@ type RepoStoreListSourcegraphDotComIndexableReposFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreListSourcegraphDotComIndexableReposFuncCall) Args() []interface{} {
+func (c RoleStoreListFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreListSourcegraphDotComIndexableReposFuncCall) Results() []interface{} {
+func (c RoleStoreListFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreMetadataFunc describes the behavior when the Metadata method of
-// the parent MockRepoStore instance is invoked.
-type RepoStoreMetadataFunc struct {
-	defaultHook func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error)
-	hooks       []func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error)
-	history     []RepoStoreMetadataFuncCall
+// RoleStoreRevokeFromOrgFunc describes the behavior when the RevokeFromOrg
+// method of the parent MockRoleStore instance is invoked.
+type RoleStoreRevokeFromOrgFunc struct {
+	defaultHook func(context.Context, int32, int32) error
+	hooks       []func(context.Context, int32, int32) error
+	history     []RoleStoreRevokeFromOrgFuncCall
 	mutex       sync.Mutex
 }
 
-// Metadata delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStore) Metadata(v0 context.Context, v1 ...api.RepoID) ([]*types.SearchedRepo, error) {
-	r0, r1 := m.MetadataFunc.nextHook()(v0, v1...)
-	m.MetadataFunc.appendCall(RepoStoreMetadataFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// RevokeFromOrg delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockRoleStore) RevokeFromOrg(v0 context.Context, v1 int32, v2 int32) error {
+	r0 := m.RevokeFromOrgFunc.nextHook()(v0, v1, v2)
+	m.RevokeFromOrgFunc.appendCall(RoleStoreRevokeFromOrgFuncCall{v0, v1, v2, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the Metadata method of
-// the parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreMetadataFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error)) {
+// SetDefaultHook sets function that is called when the RevokeFromOrg method
+// of the parent MockRoleStore instance is invoked and the hook queue is
+// empty.
+func (f *RoleStoreRevokeFromOrgFunc) SetDefaultHook(hook func(context.Context, int32, int32) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Metadata method of the parent MockRepoStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *RepoStoreMetadataFunc) PushHook(hook func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error)) {
+// RevokeFromOrg method of the parent MockRoleStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RoleStoreRevokeFromOrgFunc) PushHook(hook func(context.Context, int32, int32) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -33927,20 +39342,20 @@ func (f *RepoStoreMetadataFunc) PushHook(hook func(context.Context, ...api.RepoI
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreMetadataFunc) SetDefaultReturn(r0 []*types.SearchedRepo, r1 error) {
-	f.SetDefaultHook(func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error) {
-		return r0, r1
+func (f *RoleStoreRevokeFromOrgFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32, int32) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreMetadataFunc) PushReturn(r0 []*types.SearchedRepo, r1 error) {
-	f.PushHook(func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error) {
-		return r0, r1
+func (f *RoleStoreRevokeFromOrgFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32, int32) error {
+		return r0
 	})
 }
 
-func (f *RepoStoreMetadataFunc) nextHook() func(context.Context, ...api.RepoID) ([]*types.SearchedRepo, error) {
+func (f *RoleStoreRevokeFromOrgFunc) nextHook() func(context.Context, int32, int32) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -33953,87 +39368,81 @@ func (f *RepoStoreMetadataFunc) nextHook() func(context.Context, ...api.RepoID)
 	return hook
 }
 
-func (f *RepoStoreMetadataFunc) appendCall(r0 RepoStoreMetadataFuncCall) {
+func (f *RoleStoreRevokeFromOrgFunc) appendCall(r0 RoleStoreRevokeFromOrgFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreMetadataFuncCall objects
+// History returns a sequence of RoleStoreRevokeFromOrgFuncCall objects
 // describing the invocations of this function.
-func (f *RepoStoreMetadataFunc) History() []RepoStoreMetadataFuncCall {
+func (f *RoleStoreRevokeFromOrgFunc) History() []RoleStoreRevokeFromOrgFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreMetadataFuncCall, len(f.history))
+	history := make([]RoleStoreRevokeFromOrgFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreMetadataFuncCall is an object that describes an invocation of
-// method Metadata on an instance of MockRepoStore.
-type RepoStoreMetadataFuncCall struct {
+// RoleStoreRevokeFromOrgFuncCall is an object that describes an invocation
+// of method RevokeFromOrg on an instance of MockRoleStore.
+type RoleStoreRevokeFromOrgFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is a slice containing the values of the variadic arguments
-	// passed to this method invocation.
-	Arg1 []api.RepoID
-	// Result0 is the value of the 1st result returned from this method
+	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Result0 []*types.SearchedRepo
-	// Result1 is the value of the 2nd result returned from this method
+	Arg1 int32
+	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Result1 error
+	Arg2 int32
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation. The variadic slice argument is flattened in this array such
-// that one positional argument and three variadic arguments would result in
-// a slice of four, not two.
-func (c RepoStoreMetadataFuncCall) Args() []interface{} {
-	trailing := []interface{}{}
-	for _, val := range c.Arg1 {
-		trailing = append(trailing, val)
-	}
-
-	return append([]interface{}{c.Arg0}, trailing...)
+// invocation.
+func (c RoleStoreRevokeFromOrgFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreMetadataFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c RoleStoreRevokeFromOrgFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// RepoStoreQueryFunc describes the behavior when the Query method of the
-// parent MockRepoStore instance is invoked.
-type RepoStoreQueryFunc struct {
-	defaultHook func(context.Context, *sqlf.Query) (*sql.Rows, error)
-	hooks       []func(context.Context, *sqlf.Query) (*sql.Rows, error)
-	history     []RepoStoreQueryFuncCall
+// RoleStoreRevokeFromUserFunc describes the behavior when the
+// RevokeFromUser method of the parent MockRoleStore instance is invoked.
+type RoleStoreRevokeFromUserFunc struct {
+	defaultHook func(context.Context, int32, int32) error
+	hooks       []func(context.Context, int32, int32) error
+	history     []RoleStoreRevokeFromUserFuncCall
 	mutex       sync.Mutex
 }
 
-// Query delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockRepoStore) Query(v0 context.Context, v1 *sqlf.Query) (*sql.Rows, error) {
-	r0, r1 := m.QueryFunc.nextHook()(v0, v1)
-	m.QueryFunc.appendCall(RepoStoreQueryFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// RevokeFromUser delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRoleStore) RevokeFromUser(v0 context.Context, v1 int32, v2 int32) error {
+	r0 := m.RevokeFromUserFunc.nextHook()(v0, v1, v2)
+	m.RevokeFromUserFunc.appendCall(RoleStoreRevokeFromUserFuncCall{v0, v1, v2, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the Query method of the
-// parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreQueryFunc) SetDefaultHook(hook func(context.Context, *sqlf.Query) (*sql.Rows, error)) {
+// SetDefaultHook sets function that is called when the RevokeFromUser
+// method of the parent MockRoleStore instance is invoked and the hook queue
+// is empty.
+func (f *RoleStoreRevokeFromUserFunc) SetDefaultHook(hook func(context.Context, int32, int32) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Query method of the parent MockRepoStore instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *RepoStoreQueryFunc) PushHook(hook func(context.Context, *sqlf.Query) (*sql.Rows, error)) {
+// RevokeFromUser method of the parent MockRoleStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RoleStoreRevokeFromUserFunc) PushHook(hook func(context.Context, int32, int32) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -34041,20 +39450,20 @@ func (f *RepoStoreQueryFunc) PushHook(hook func(context.Context, *sqlf.Query) (*
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreQueryFunc) SetDefaultReturn(r0 *sql.Rows, r1 error) {
-	f.SetDefaultHook(func(context.Context, *sqlf.Query) (*sql.Rows, error) {
-		return r0, r1
+func (f *RoleStoreRevokeFromUserFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32, int32) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreQueryFunc) PushReturn(r0 *sql.Rows, r1 error) {
-	f.PushHook(func(context.Context, *sqlf.Query) (*sql.Rows, error) {
-		return r0, r1
+func (f *RoleStoreRevokeFromUserFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32, int32) error {
+		return r0
 	})
 }
 
-func (f *RepoStoreQueryFunc) nextHook() func(context.Context, *sqlf.Query) (*sql.Rows, error) {
+func (f *RoleStoreRevokeFromUserFunc) nextHook() func(context.Context, int32, int32) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -34067,82 +39476,81 @@ func (f *RepoStoreQueryFunc) nextHook() func(context.Context, *sqlf.Query) (*sql
 	return hook
 }
 
-func (f *RepoStoreQueryFunc) appendCall(r0 RepoStoreQueryFuncCall) {
+func (f *RoleStoreRevokeFromUserFunc) appendCall(r0 RoleStoreRevokeFromUserFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreQueryFuncCall objects describing
-// the invocations of this function.
-func (f *RepoStoreQueryFunc) History() []RepoStoreQueryFuncCall {
+// History returns a sequence of RoleStoreRevokeFromUserFuncCall objects
+// describing the invocations of this function.
+func (f *RoleStoreRevokeFromUserFunc) History() []RoleStoreRevokeFromUserFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreQueryFuncCall, len(f.history))
+	history := make([]RoleStoreRevokeFromUserFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreQueryFuncCall is an object that describes an invocation of
-// method Query on an instance of MockRepoStore.
-type RepoStoreQueryFuncCall struct {
+// RoleStoreRevokeFromUserFuncCall is an object that describes an invocation
+// of method RevokeFromUser on an instance of MockRoleStore.
+type RoleStoreRevokeFromUserFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *sqlf.Query
-	// Result0 is the value of the 1st result returned from this method
+	Arg1 int32
+	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Result0 *sql.Rows
-	// Result1 is the value of the 2nd result returned from this method
+	Arg2 int32
+	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreQueryFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c RoleStoreRevokeFromUserFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreQueryFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c RoleStoreRevokeFromUserFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// RepoStoreStreamMinimalReposFunc describes the behavior when the
-// StreamMinimalRepos method of the parent MockRepoStore instance is
-// invoked.
-type RepoStoreStreamMinimalReposFunc struct {
-	defaultHook func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error
-	hooks       []func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error
-	history     []RepoStoreStreamMinimalReposFuncCall
+// RoleStoreSetPermissionsFunc describes the behavior when the
+// SetPermissions method of the parent MockRoleStore instance is invoked.
+type RoleStoreSetPermissionsFunc struct {
+	defaultHook func(context.Context, int32, []int32) error
+	hooks       []func(context.Context, int32, []int32) error
+	history     []RoleStoreSetPermissionsFuncCall
 	mutex       sync.Mutex
 }
 
-// StreamMinimalRepos delegates to the next hook function in the queue and
+// SetPermissions delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockRepoStore) StreamMinimalRepos(v0 context.Context, v1 ReposListOptions, v2 func(*types.MinimalRepo)) error {
-	r0 := m.StreamMinimalReposFunc.nextHook()(v0, v1, v2)
-	m.StreamMinimalReposFunc.appendCall(RepoStoreStreamMinimalReposFuncCall{v0, v1, v2, r0})
+func (m *MockRoleStore) SetPermissions(v0 context.Context, v1 int32, v2 []int32) error {
+	r0 := m.SetPermissionsFunc.nextHook()(v0, v1, v2)
+	m.SetPermissionsFunc.appendCall(RoleStoreSetPermissionsFuncCall{v0, v1, v2, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the StreamMinimalRepos
-// method of the parent MockRepoStore instance is invoked and the hook queue
+// SetDefaultHook sets function that is called when the SetPermissions
+// method of the parent MockRoleStore instance is invoked and the hook queue
 // is empty.
-func (f *RepoStoreStreamMinimalReposFunc) SetDefaultHook(hook func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error) {
+func (f *RoleStoreSetPermissionsFunc) SetDefaultHook(hook func(context.Context, int32, []int32) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// StreamMinimalRepos method of the parent MockRepoStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *RepoStoreStreamMinimalReposFunc) PushHook(hook func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error) {
+// SetPermissions method of the parent MockRoleStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *RoleStoreSetPermissionsFunc) PushHook(hook func(context.Context, int32, []int32) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -34150,20 +39558,20 @@ func (f *RepoStoreStreamMinimalReposFunc) PushHook(hook func(context.Context, Re
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreStreamMinimalReposFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error {
+func (f *RoleStoreSetPermissionsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32, []int32) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreStreamMinimalReposFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error {
+func (f *RoleStoreSetPermissionsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32, []int32) error {
 		return r0
 	})
 }
 
-func (f *RepoStoreStreamMinimalReposFunc) nextHook() func(context.Context, ReposListOptions, func(*types.MinimalRepo)) error {
+func (f *RoleStoreSetPermissionsFunc) nextHook() func(context.Context, int32, []int32) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -34176,35 +39584,35 @@ func (f *RepoStoreStreamMinimalReposFunc) nextHook() func(context.Context, Repos
 	return hook
 }
 
-func (f *RepoStoreStreamMinimalReposFunc) appendCall(r0 RepoStoreStreamMinimalReposFuncCall) {
+func (f *RoleStoreSetPermissionsFunc) appendCall(r0 RoleStoreSetPermissionsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreStreamMinimalReposFuncCall objects
+// History returns a sequence of RoleStoreSetPermissionsFuncCall objects
 // describing the invocations of this function.
-func (f *RepoStoreStreamMinimalReposFunc) History() []RepoStoreStreamMinimalReposFuncCall {
+func (f *RoleStoreSetPermissionsFunc) History() []RoleStoreSetPermissionsFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreStreamMinimalReposFuncCall, len(f.history))
+	history := make([]RoleStoreSetPermissionsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreStreamMinimalReposFuncCall is an object that describes an
-// invocation of method StreamMinimalRepos on an instance of MockRepoStore.
-type RepoStoreStreamMinimalReposFuncCall struct {
+// RoleStoreSetPermissionsFuncCall is an object that describes an invocation
+// of method SetPermissions on an instance of MockRoleStore.
+type RoleStoreSetPermissionsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 ReposListOptions
+	Arg1 int32
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 func(*types.MinimalRepo)
+	Arg2 []int32
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -34212,44 +39620,44 @@ type RepoStoreStreamMinimalReposFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreStreamMinimalReposFuncCall) Args() []interface{} {
+func (c RoleStoreSetPermissionsFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreStreamMinimalReposFuncCall) Results() []interface{} {
+func (c RoleStoreSetPermissionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// RepoStoreTransactFunc describes the behavior when the Transact method of
-// the parent MockRepoStore instance is invoked.
-type RepoStoreTransactFunc struct {
-	defaultHook func(context.Context) (RepoStore, error)
-	hooks       []func(context.Context) (RepoStore, error)
-	history     []RepoStoreTransactFuncCall
+// RoleStoreTransactFunc describes the behavior when the Transact method of
+// the parent MockRoleStore instance is invoked.
+type RoleStoreTransactFunc struct {
+	defaultHook func(context.Context) (RoleStore, error)
+	hooks       []func(context.Context) (RoleStore, error)
+	history     []RoleStoreTransactFuncCall
 	mutex       sync.Mutex
 }
 
 // Transact delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRepoStore) Transact(v0 context.Context) (RepoStore, error) {
+func (m *MockRoleStore) Transact(v0 context.Context) (RoleStore, error) {
 	r0, r1 := m.TransactFunc.nextHook()(v0)
-	m.TransactFunc.appendCall(RepoStoreTransactFuncCall{v0, r0, r1})
+	m.TransactFunc.appendCall(RoleStoreTransactFuncCall{v0, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the Transact method of
-// the parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreTransactFunc) SetDefaultHook(hook func(context.Context) (RepoStore, error)) {
+// the parent MockRoleStore instance is invoked and the hook queue is empty.
+func (f *RoleStoreTransactFunc) SetDefaultHook(hook func(context.Context) (RoleStore, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Transact method of the parent MockRepoStore instance invokes the hook at
+// Transact method of the parent MockRoleStore instance invokes the hook at
 // the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *RepoStoreTransactFunc) PushHook(hook func(context.Context) (RepoStore, error)) {
+func (f *RoleStoreTransactFunc) PushHook(hook func(context.Context) (RoleStore, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -34257,20 +39665,20 @@ func (f *RepoStoreTransactFunc) PushHook(hook func(context.Context) (RepoStore,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreTransactFunc) SetDefaultReturn(r0 RepoStore, r1 error) {
-	f.SetDefaultHook(func(context.Context) (RepoStore, error) {
+func (f *RoleStoreTransactFunc) SetDefaultReturn(r0 RoleStore, r1 error) {
+	f.SetDefaultHook(func(context.Context) (RoleStore, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreTransactFunc) PushReturn(r0 RepoStore, r1 error) {
-	f.PushHook(func(context.Context) (RepoStore, error) {
+func (f *RoleStoreTransactFunc) PushReturn(r0 RoleStore, r1 error) {
+	f.PushHook(func(context.Context) (RoleStore, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoStoreTransactFunc) nextHook() func(context.Context) (RepoStore, error) {
+func (f *RoleStoreTransactFunc) nextHook() func(context.Context) (RoleStore, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -34283,32 +39691,32 @@ func (f *RepoStoreTransactFunc) nextHook() func(context.Context) (RepoStore, err
 	return hook
 }
 
-func (f *RepoStoreTransactFunc) appendCall(r0 RepoStoreTransactFuncCall) {
+func (f *RoleStoreTransactFunc) appendCall(r0 RoleStoreTransactFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreTransactFuncCall objects
+// History returns a sequence of RoleStoreTransactFuncCall objects
 // describing the invocations of this function.
-func (f *RepoStoreTransactFunc) History() []RepoStoreTransactFuncCall {
+func (f *RoleStoreTransactFunc) History() []RoleStoreTransactFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreTransactFuncCall, len(f.history))
+	history := make([]RoleStoreTransactFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreTransactFuncCall is an object that describes an invocation of
-// method Transact on an instance of MockRepoStore.
-type RepoStoreTransactFuncCall struct {
+// RoleStoreTransactFuncCall is an object that describes an invocation of
+// method Transact on an instance of MockRoleStore.
+type RoleStoreTransactFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 RepoStore
+	Result0 RoleStore
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -34316,44 +39724,44 @@ type RepoStoreTransactFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreTransactFuncCall) Args() []interface{} {
+func (c RoleStoreTransactFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreTransactFuncCall) Results() []interface{} {
+func (c RoleStoreTransactFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// RepoStoreWithFunc describes the behavior when the With method of the
-// parent MockRepoStore instance is invoked.
-type RepoStoreWithFunc struct {
-	defaultHook func(basestore.ShareableStore) RepoStore
-	hooks       []func(basestore.ShareableStore) RepoStore
-	history     []RepoStoreWithFuncCall
+// RoleStoreWithFunc describes the behavior when the With method of the
+// parent MockRoleStore instance is invoked.
+type RoleStoreWithFunc struct {
+	defaultHook func(basestore.ShareableStore) RoleStore
+	hooks       []func(basestore.ShareableStore) RoleStore
+	history     []RoleStoreWithFuncCall
 	mutex       sync.Mutex
 }
 
 // With delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockRepoStore) With(v0 basestore.ShareableStore) RepoStore {
+func (m *MockRoleStore) With(v0 basestore.ShareableStore) RoleStore {
 	r0 := m.WithFunc.nextHook()(v0)
-	m.WithFunc.appendCall(RepoStoreWithFuncCall{v0, r0})
+	m.WithFunc.appendCall(RoleStoreWithFuncCall{v0, r0})
 	return r0
 }
 
 // SetDefaultHook sets function that is called when the With method of the
-// parent MockRepoStore instance is invoked and the hook queue is empty.
-func (f *RepoStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) RepoStore) {
+// parent MockRoleStore instance is invoked and the hook queue is empty.
+func (f *RoleStoreWithFunc) SetDefaultHook(hook func(basestore.ShareableStore) RoleStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// With method of the parent MockRepoStore instance invokes the hook at the
+// With method of the parent MockRoleStore instance invokes the hook at the
 // front of the queue and discards it. After the queue is empty, the default
 // hook function is invoked for any future action.
-func (f *RepoStoreWithFunc) PushHook(hook func(basestore.ShareableStore) RepoStore) {
+func (f *RoleStoreWithFunc) PushHook(hook func(basestore.ShareableStore) RoleStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -34361,20 +39769,20 @@ func (f *RepoStoreWithFunc) PushHook(hook func(basestore.ShareableStore) RepoSto
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *RepoStoreWithFunc) SetDefaultReturn(r0 RepoStore) {
-	f.SetDefaultHook(func(basestore.ShareableStore) RepoStore {
+func (f *RoleStoreWithFunc) SetDefaultReturn(r0 RoleStore) {
+	f.SetDefaultHook(func(basestore.ShareableStore) RoleStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *RepoStoreWithFunc) PushReturn(r0 RepoStore) {
-	f.PushHook(func(basestore.ShareableStore) RepoStore {
+func (f *RoleStoreWithFunc) PushReturn(r0 RoleStore) {
+	f.PushHook(func(basestore.ShareableStore) RoleStore {
 		return r0
 	})
 }
 
-func (f *RepoStoreWithFunc) nextHook() func(basestore.ShareableStore) RepoStore {
+func (f *RoleStoreWithFunc) nextHook() func(basestore.ShareableStore) RoleStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -34387,43 +39795,43 @@ func (f *RepoStoreWithFunc) nextHook() func(basestore.ShareableStore) RepoStore
 	return hook
 }
 
-func (f *RepoStoreWithFunc) appendCall(r0 RepoStoreWithFuncCall) {
+func (f *RoleStoreWithFunc) appendCall(r0 RoleStoreWithFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoStoreWithFuncCall objects describing
+// History returns a sequence of RoleStoreWithFuncCall objects describing
 // the invocations of this function.
-func (f *RepoStoreWithFunc) History() []RepoStoreWithFuncCall {
+func (f *RoleStoreWithFunc) History() []RoleStoreWithFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoStoreWithFuncCall, len(f.history))
+	history := make([]RoleStoreWithFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoStoreWithFuncCall is an object that describes an invocation of method
-// With on an instance of MockRepoStore.
-type RepoStoreWithFuncCall struct {
+// RoleStoreWithFuncCall is an object that describes an invocation of method
+// With on an instance of MockRoleStore.
+type RoleStoreWithFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 basestore.ShareableStore
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 RepoStore
+	Result0 RoleStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoStoreWithFuncCall) Args() []interface{} {
+func (c RoleStoreWithFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoStoreWithFuncCall) Results() []interface{} {
+func (c RoleStoreWithFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
@@ -34450,6 +39858,9 @@ type MockSavedSearchStore struct {
 	// ListAllFunc is an instance of a mock function object controlling the
 	// behavior of the method ListAll.
 	ListAllFunc *SavedSearchStoreListAllFunc
+	// ListDueForNotificationFunc is an instance of a mock function object
+	// controlling the behavior of the method ListDueForNotification.
+	ListDueForNotificationFunc *SavedSearchStoreListDueForNotificationFunc
 	// ListSavedSearchesByOrgIDFunc is an instance of a mock function object
 	// controlling the behavior of the method ListSavedSearchesByOrgID.
 	ListSavedSearchesByOrgIDFunc *SavedSearchStoreListSavedSearchesByOrgIDFunc
@@ -34463,6 +39874,9 @@ type MockSavedSearchStore struct {
 	// UpdateFunc is an instance of a mock function object controlling the
 	// behavior of the method Update.
 	UpdateFunc *SavedSearchStoreUpdateFunc
+	// UpdateNotificationStateFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdateNotificationState.
+	UpdateNotificationStateFunc *SavedSearchStoreUpdateNotificationStateFunc
 	// WithFunc is an instance of a mock function object controlling the
 	// behavior of the method With.
 	WithFunc *SavedSearchStoreWithFunc
@@ -34503,6 +39917,11 @@ func NewMockSavedSearchStore() *MockSavedSearchStore {
 				return
 			},
 		},
+		ListDueForNotificationFunc: &SavedSearchStoreListDueForNotificationFunc{
+			defaultHook: func(context.Context, time.Time, int) (r0 []*types.SavedSearchNotificationState, r1 error) {
+				return
+			},
+		},
 		ListSavedSearchesByOrgIDFunc: &SavedSearchStoreListSavedSearchesByOrgIDFunc{
 			defaultHook: func(context.Context, int32) (r0 []*types.SavedSearch, r1 error) {
 				return
@@ -34523,6 +39942,11 @@ func NewMockSavedSearchStore() *MockSavedSearchStore {
 				return
 			},
 		},
+		UpdateNotificationStateFunc: &SavedSearchStoreUpdateNotificationStateFunc{
+			defaultHook: func(context.Context, int32, string, []string) (r0 error) {
+				return
+			},
+		},
 		WithFunc: &SavedSearchStoreWithFunc{
 			defaultHook: func(basestore.ShareableStore) (r0 SavedSearchStore) {
 				return
@@ -34565,6 +39989,11 @@ func NewStrictMockSavedSearchStore() *MockSavedSearchStore {
 				panic("unexpected invocation of MockSavedSearchStore.ListAll")
 			},
 		},
+		ListDueForNotificationFunc: &SavedSearchStoreListDueForNotificationFunc{
+			defaultHook: func(context.Context, time.Time, int) ([]*types.SavedSearchNotificationState, error) {
+				panic("unexpected invocation of MockSavedSearchStore.ListDueForNotification")
+			},
+		},
 		ListSavedSearchesByOrgIDFunc: &SavedSearchStoreListSavedSearchesByOrgIDFunc{
 			defaultHook: func(context.Context, int32) ([]*types.SavedSearch, error) {
 				panic("unexpected invocation of MockSavedSearchStore.ListSavedSearchesByOrgID")
@@ -34585,6 +40014,11 @@ func NewStrictMockSavedSearchStore() *MockSavedSearchStore {
 				panic("unexpected invocation of MockSavedSearchStore.Update")
 			},
 		},
+		UpdateNotificationStateFunc: &SavedSearchStoreUpdateNotificationStateFunc{
+			defaultHook: func(context.Context, int32, string, []string) error {
+				panic("unexpected invocation of MockSavedSearchStore.UpdateNotificationState")
+			},
+		},
 		WithFunc: &SavedSearchStoreWithFunc{
 			defaultHook: func(basestore.ShareableStore) SavedSearchStore {
 				panic("unexpected invocation of MockSavedSearchStore.With")
@@ -34616,6 +40050,9 @@ func NewMockSavedSearchStoreFrom(i SavedSearchStore) *MockSavedSearchStore {
 		ListAllFunc: &SavedSearchStoreListAllFunc{
 			defaultHook: i.ListAll,
 		},
+		ListDueForNotificationFunc: &SavedSearchStoreListDueForNotificationFunc{
+			defaultHook: i.ListDueForNotification,
+		},
 		ListSavedSearchesByOrgIDFunc: &SavedSearchStoreListSavedSearchesByOrgIDFunc{
 			defaultHook: i.ListSavedSearchesByOrgID,
 		},
@@ -34628,6 +40065,9 @@ func NewMockSavedSearchStoreFrom(i SavedSearchStore) *MockSavedSearchStore {
 		UpdateFunc: &SavedSearchStoreUpdateFunc{
 			defaultHook: i.Update,
 		},
+		UpdateNotificationStateFunc: &SavedSearchStoreUpdateNotificationStateFunc{
+			defaultHook: i.UpdateNotificationState,
+		},
 		WithFunc: &SavedSearchStoreWithFunc{
 			defaultHook: i.With,
 		},
@@ -35264,6 +40704,121 @@ func (c SavedSearchStoreListAllFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// SavedSearchStoreListDueForNotificationFunc describes the behavior when
+// the ListDueForNotification method of the parent MockSavedSearchStore
+// instance is invoked.
+type SavedSearchStoreListDueForNotificationFunc struct {
+	defaultHook func(context.Context, time.Time, int) ([]*types.SavedSearchNotificationState, error)
+	hooks       []func(context.Context, time.Time, int) ([]*types.SavedSearchNotificationState, error)
+	history     []SavedSearchStoreListDueForNotificationFuncCall
+	mutex       sync.Mutex
+}
+
+// ListDueForNotification delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockSavedSearchStore) ListDueForNotification(v0 context.Context, v1 time.Time, v2 int) ([]*types.SavedSearchNotificationState, error) {
+	r0, r1 := m.ListDueForNotificationFunc.nextHook()(v0, v1, v2)
+	m.ListDueForNotificationFunc.appendCall(SavedSearchStoreListDueForNotificationFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListDueForNotification method of the parent MockSavedSearchStore instance
+// is invoked and the hook queue is empty.
+func (f *SavedSearchStoreListDueForNotificationFunc) SetDefaultHook(hook func(context.Context, time.Time, int) ([]*types.SavedSearchNotificationState, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListDueForNotification method of the parent MockSavedSearchStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SavedSearchStoreListDueForNotificationFunc) PushHook(hook func(context.Context, time.Time, int) ([]*types.SavedSearchNotificationState, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *SavedSearchStoreListDueForNotificationFunc) SetDefaultReturn(r0 []*types.SavedSearchNotificationState, r1 error) {
+	f.SetDefaultHook(func(context.Context, time.Time, int) ([]*types.SavedSearchNotificationState, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *SavedSearchStoreListDueForNotificationFunc) PushReturn(r0 []*types.SavedSearchNotificationState, r1 error) {
+	f.PushHook(func(context.Context, time.Time, int) ([]*types.SavedSearchNotificationState, error) {
+		return r0, r1
+	})
+}
+
+func (f *SavedSearchStoreListDueForNotificationFunc) nextHook() func(context.Context, time.Time, int) ([]*types.SavedSearchNotificationState, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SavedSearchStoreListDueForNotificationFunc) appendCall(r0 SavedSearchStoreListDueForNotificationFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// SavedSearchStoreListDueForNotificationFuncCall objects describing the
+// invocations of this function.
+func (f *SavedSearchStoreListDueForNotificationFunc) History() []SavedSearchStoreListDueForNotificationFuncCall {
+	f.mutex.Lock()
+	history := make([]SavedSearchStoreListDueForNotificationFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SavedSearchStoreListDueForNotificationFuncCall is an object that
+// describes an invocation of method ListDueForNotification on an instance
+// of MockSavedSearchStore.
+type SavedSearchStoreListDueForNotificationFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 time.Time
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*types.SavedSearchNotificationState
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c SavedSearchStoreListDueForNotificationFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SavedSearchStoreListDueForNotificationFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // SavedSearchStoreListSavedSearchesByOrgIDFunc describes the behavior when
 // the ListSavedSearchesByOrgID method of the parent MockSavedSearchStore
 // instance is invoked.
@@ -35701,6 +41256,121 @@ func (c SavedSearchStoreUpdateFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// SavedSearchStoreUpdateNotificationStateFunc describes the behavior when
+// the UpdateNotificationState method of the parent MockSavedSearchStore
+// instance is invoked.
+type SavedSearchStoreUpdateNotificationStateFunc struct {
+	defaultHook func(context.Context, int32, string, []string) error
+	hooks       []func(context.Context, int32, string, []string) error
+	history     []SavedSearchStoreUpdateNotificationStateFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateNotificationState delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockSavedSearchStore) UpdateNotificationState(v0 context.Context, v1 int32, v2 string, v3 []string) error {
+	r0 := m.UpdateNotificationStateFunc.nextHook()(v0, v1, v2, v3)
+	m.UpdateNotificationStateFunc.appendCall(SavedSearchStoreUpdateNotificationStateFuncCall{v0, v1, v2, v3, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// UpdateNotificationState method of the parent MockSavedSearchStore
+// instance is invoked and the hook queue is empty.
+func (f *SavedSearchStoreUpdateNotificationStateFunc) SetDefaultHook(hook func(context.Context, int32, string, []string) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateNotificationState method of the parent MockSavedSearchStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *SavedSearchStoreUpdateNotificationStateFunc) PushHook(hook func(context.Context, int32, string, []string) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *SavedSearchStoreUpdateNotificationStateFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32, string, []string) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *SavedSearchStoreUpdateNotificationStateFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32, string, []string) error {
+		return r0
+	})
+}
+
+func (f *SavedSearchStoreUpdateNotificationStateFunc) nextHook() func(context.Context, int32, string, []string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SavedSearchStoreUpdateNotificationStateFunc) appendCall(r0 SavedSearchStoreUpdateNotificationStateFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// SavedSearchStoreUpdateNotificationStateFuncCall objects describing the
+// invocations of this function.
+func (f *SavedSearchStoreUpdateNotificationStateFunc) History() []SavedSearchStoreUpdateNotificationStateFuncCall {
+	f.mutex.Lock()
+	history := make([]SavedSearchStoreUpdateNotificationStateFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SavedSearchStoreUpdateNotificationStateFuncCall is an object that
+// describes an invocation of method UpdateNotificationState on an instance
+// of MockSavedSearchStore.
+type SavedSearchStoreUpdateNotificationStateFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int32
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 []string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c SavedSearchStoreUpdateNotificationStateFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SavedSearchStoreUpdateNotificationStateFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // SavedSearchStoreWithFunc describes the behavior when the With method of
 // the parent MockSavedSearchStore instance is invoked.
 type SavedSearchStoreWithFunc struct {
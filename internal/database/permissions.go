@@ -0,0 +1,179 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/rbac"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// PermissionStore provides access to the permissions that can be granted to
+// roles. The set of permissions is defined by the instance and seeded by
+// migrations, so the store is read-only.
+type PermissionStore interface {
+	basestore.ShareableStore
+	With(basestore.ShareableStore) PermissionStore
+
+	// GetByID returns the permission with the given ID.
+	GetByID(ctx context.Context, id int32) (*types.Permission, error)
+	// List returns the permissions matching the given options.
+	List(ctx context.Context, opts PermissionListOptions) ([]*types.Permission, error)
+	// Count returns the number of permissions matching the given options.
+	Count(ctx context.Context, opts PermissionListOptions) (int, error)
+	// HasPermission returns whether the given user has been granted the given
+	// permission through any of their roles. This includes the built-in roles
+	// held implicitly by all users and by site admins, the roles assigned to
+	// the user directly, and the roles assigned to the organizations the user
+	// is a member of.
+	HasPermission(ctx context.Context, userID int32, perm rbac.Permission) (bool, error)
+}
+
+// PermissionListOptions contains options for listing permissions.
+type PermissionListOptions struct {
+	// RoleID, if set, only returns the permissions granted to the role.
+	RoleID int32
+	*LimitOffset
+}
+
+// PermissionNotFoundErr is returned when a permission cannot be found.
+type PermissionNotFoundErr struct {
+	ID int32
+}
+
+func (e *PermissionNotFoundErr) Error() string {
+	return fmt.Sprintf("permission with ID %d not found", e.ID)
+}
+
+func (e *PermissionNotFoundErr) NotFound() bool {
+	return true
+}
+
+type permissionStore struct {
+	*basestore.Store
+}
+
+var _ PermissionStore = &permissionStore{}
+
+// PermissionsWith instantiates and returns a new PermissionStore using the
+// other store handle.
+func PermissionsWith(other basestore.ShareableStore) PermissionStore {
+	return &permissionStore{Store: basestore.NewWithHandle(other.Handle())}
+}
+
+func (s *permissionStore) With(other basestore.ShareableStore) PermissionStore {
+	return &permissionStore{Store: s.Store.With(other)}
+}
+
+var permissionColumns = []*sqlf.Query{
+	sqlf.Sprintf("permissions.id"),
+	sqlf.Sprintf("permissions.namespace"),
+	sqlf.Sprintf("permissions.action"),
+	sqlf.Sprintf("permissions.created_at"),
+}
+
+const permissionListQueryFmtstr = `
+SELECT %s FROM permissions
+WHERE %s
+`
+
+func (s *permissionStore) GetByID(ctx context.Context, id int32) (*types.Permission, error) {
+	q := sqlf.Sprintf(permissionListQueryFmtstr, sqlf.Join(permissionColumns, ", "), sqlf.Sprintf("id = %s", id))
+	perm, err := scanPermission(s.QueryRow(ctx, q))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &PermissionNotFoundErr{ID: id}
+		}
+		return nil, errors.Wrap(err, "scanning permission")
+	}
+	return perm, nil
+}
+
+func (s *permissionStore) List(ctx context.Context, opts PermissionListOptions) ([]*types.Permission, error) {
+	q := sqlf.Sprintf(permissionListQueryFmtstr, sqlf.Join(permissionColumns, ", "), permissionListConds(opts))
+	q = sqlf.Sprintf("%s ORDER BY permissions.namespace ASC, permissions.action ASC %s", q, opts.LimitOffset.SQL())
+
+	rows, err := s.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var perms []*types.Permission
+	for rows.Next() {
+		perm, err := scanPermission(rows)
+		if err != nil {
+			return nil, err
+		}
+		perms = append(perms, perm)
+	}
+	return perms, rows.Err()
+}
+
+func (s *permissionStore) Count(ctx context.Context, opts PermissionListOptions) (int, error) {
+	q := sqlf.Sprintf(permissionListQueryFmtstr, sqlf.Sprintf("COUNT(*)"), permissionListConds(opts))
+	count, _, err := basestore.ScanFirstInt(s.Query(ctx, q))
+	return count, err
+}
+
+func permissionListConds(opts PermissionListOptions) *sqlf.Query {
+	conds := []*sqlf.Query{sqlf.Sprintf("TRUE")}
+	if opts.RoleID != 0 {
+		conds = append(conds, sqlf.Sprintf("EXISTS (SELECT 1 FROM role_permissions WHERE role_permissions.permission_id = permissions.id AND role_permissions.role_id = %s)", opts.RoleID))
+	}
+	return sqlf.Join(conds, "AND")
+}
+
+const hasPermissionQueryFmtstr = `
+SELECT EXISTS (
+	SELECT 1
+	FROM users u
+	JOIN role_permissions rp ON TRUE
+	JOIN roles r ON r.id = rp.role_id
+	JOIN permissions p ON p.id = rp.permission_id
+	WHERE
+		u.id = %s
+		AND u.deleted_at IS NULL
+		AND p.namespace = %s
+		AND p.action = %s
+		AND (
+			(r.system AND r.name = %s)
+			OR (r.system AND r.name = %s AND u.site_admin)
+			OR EXISTS (SELECT 1 FROM user_roles ur WHERE ur.role_id = r.id AND ur.user_id = u.id)
+			OR EXISTS (
+				SELECT 1
+				FROM org_roles orr
+				JOIN org_members om ON om.org_id = orr.org_id
+				JOIN orgs o ON o.id = orr.org_id
+				WHERE orr.role_id = r.id AND om.user_id = u.id AND o.deleted_at IS NULL
+			)
+		)
+)
+`
+
+func (s *permissionStore) HasPermission(ctx context.Context, userID int32, perm rbac.Permission) (bool, error) {
+	q := sqlf.Sprintf(
+		hasPermissionQueryFmtstr,
+		userID,
+		string(perm.Namespace),
+		string(perm.Action),
+		rbac.UserRole,
+		rbac.SiteAdministratorRole,
+	)
+	has, _, err := basestore.ScanFirstBool(s.Query(ctx, q))
+	return has, err
+}
+
+func scanPermission(sc dbutil.Scanner) (*types.Permission, error) {
+	var perm types.Permission
+	if err := sc.Scan(&perm.ID, &perm.Namespace, &perm.Action, &perm.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &perm, nil
+}
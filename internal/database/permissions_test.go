@@ -0,0 +1,133 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/rbac"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestPermissionStore_List(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	t.Parallel()
+
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(logger, t))
+	ctx := context.Background()
+
+	perms, err := db.Permissions().List(ctx, PermissionListOptions{})
+	require.NoError(t, err)
+
+	var have []string
+	for _, p := range perms {
+		have = append(have, p.DisplayName())
+	}
+	var want []string
+	for _, p := range rbac.Permissions {
+		want = append(want, p.String())
+	}
+	assert.ElementsMatch(t, want, have)
+
+	perm, err := db.Permissions().GetByID(ctx, perms[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, perms[0], perm)
+
+	_, err = db.Permissions().GetByID(ctx, 9999)
+	assert.True(t, errcode.IsNotFound(err))
+}
+
+func TestPermissionStore_HasPermission(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	t.Parallel()
+
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(logger, t))
+	ctx := actor.WithInternalActor(context.Background())
+
+	// The first user is a site admin.
+	admin, err := db.Users().Create(ctx, NewUser{Username: "admin"})
+	require.NoError(t, err)
+	direct, err := db.Users().Create(ctx, NewUser{Username: "direct"})
+	require.NoError(t, err)
+	member, err := db.Users().Create(ctx, NewUser{Username: "member"})
+	require.NoError(t, err)
+	other, err := db.Users().Create(ctx, NewUser{Username: "other"})
+	require.NoError(t, err)
+
+	org, err := db.Orgs().Create(ctx, "the-org", nil)
+	require.NoError(t, err)
+	_, err = db.OrgMembers().Create(ctx, org.ID, member.ID)
+	require.NoError(t, err)
+
+	permissionID := func(perm rbac.Permission) int32 {
+		perms, err := db.Permissions().List(ctx, PermissionListOptions{})
+		require.NoError(t, err)
+		for _, p := range perms {
+			if p.DisplayName() == perm.String() {
+				return p.ID
+			}
+		}
+		t.Fatalf("permission %s not found", perm)
+		return 0
+	}
+	roleID := func(name string) int32 {
+		role, err := db.Roles().GetByName(ctx, name)
+		require.NoError(t, err)
+		return role.ID
+	}
+
+	role, err := db.Roles().Create(ctx, "PLATFORM")
+	require.NoError(t, err)
+	require.NoError(t, db.Roles().SetPermissions(ctx, role.ID, []int32{permissionID(rbac.BatchChangesAdmin)}))
+	require.NoError(t, db.Roles().AssignToUser(ctx, role.ID, direct.ID))
+	require.NoError(t, db.Roles().AssignToOrg(ctx, role.ID, org.ID))
+	require.NoError(t, db.Roles().SetPermissions(ctx, roleID(rbac.SiteAdministratorRole), []int32{permissionID(rbac.CodeInsightsAdmin)}))
+
+	assertHasPermission := func(t *testing.T, user *types.User, perm rbac.Permission, want bool) {
+		t.Helper()
+		have, err := db.Permissions().HasPermission(ctx, user.ID, perm)
+		require.NoError(t, err)
+		assert.Equal(t, want, have, "user %s, permission %s", user.Username, perm)
+	}
+
+	t.Run("custom roles", func(t *testing.T) {
+		assertHasPermission(t, direct, rbac.BatchChangesAdmin, true)
+		assertHasPermission(t, member, rbac.BatchChangesAdmin, true)
+		assertHasPermission(t, other, rbac.BatchChangesAdmin, false)
+		assertHasPermission(t, admin, rbac.BatchChangesAdmin, false)
+	})
+
+	t.Run("site administrator role", func(t *testing.T) {
+		assertHasPermission(t, admin, rbac.CodeInsightsAdmin, true)
+		assertHasPermission(t, direct, rbac.CodeInsightsAdmin, false)
+	})
+
+	t.Run("user role", func(t *testing.T) {
+		require.NoError(t, db.Roles().SetPermissions(ctx, roleID(rbac.UserRole), []int32{permissionID(rbac.CodeInsightsAdmin)}))
+		t.Cleanup(func() {
+			require.NoError(t, db.Roles().SetPermissions(ctx, roleID(rbac.UserRole), nil))
+		})
+
+		for _, user := range []*types.User{admin, direct, member, other} {
+			assertHasPermission(t, user, rbac.CodeInsightsAdmin, true)
+		}
+	})
+
+	t.Run("revoked", func(t *testing.T) {
+		require.NoError(t, db.Roles().RevokeFromOrg(ctx, role.ID, org.ID))
+		assertHasPermission(t, member, rbac.BatchChangesAdmin, false)
+		assertHasPermission(t, direct, rbac.BatchChangesAdmin, true)
+	})
+}
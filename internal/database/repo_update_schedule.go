@@ -0,0 +1,303 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+// RepoUpdateSchedule is the persisted state of the schedule on which repo-updater
+// periodically asks gitserver to fetch a repository.
+type RepoUpdateSchedule struct {
+	RepoID   api.RepoID
+	RepoName api.RepoName
+
+	// Interval is the current interval between scheduled updates, including any
+	// backoff after failed updates.
+	Interval time.Duration
+	// DueAt is the next time the repository is due to be enqueued for an update.
+	DueAt time.Time
+	// LastError is the error of the last scheduled update, or empty if it succeeded.
+	LastError string
+	// LastUpdatedAt is the time the last scheduled update finished, or the zero
+	// time if the repository has not been updated on schedule yet.
+	LastUpdatedAt time.Time
+}
+
+// RepoUpdateScheduleNotFoundErr is returned when a repository has no update schedule.
+type RepoUpdateScheduleNotFoundErr struct {
+	RepoID api.RepoID
+}
+
+func (e *RepoUpdateScheduleNotFoundErr) Error() string {
+	return fmt.Sprintf("update schedule for repo %d not found", e.RepoID)
+}
+
+func (e *RepoUpdateScheduleNotFoundErr) NotFound() bool {
+	return true
+}
+
+type RepoUpdateScheduleStore interface {
+	basestore.ShareableStore
+
+	With(other basestore.ShareableStore) RepoUpdateScheduleStore
+
+	// List returns the schedules of all repositories that are not deleted, ordered by
+	// the time they are due.
+	List(ctx context.Context) ([]*RepoUpdateSchedule, error)
+
+	// ListByRepoIDs returns the schedules of the given repositories. Repositories
+	// without a schedule are omitted.
+	ListByRepoIDs(ctx context.Context, ids []api.RepoID) ([]*RepoUpdateSchedule, error)
+
+	// GetByRepoID returns the schedule of the given repository, or a
+	// RepoUpdateScheduleNotFoundErr if it has none.
+	GetByRepoID(ctx context.Context, id api.RepoID) (*RepoUpdateSchedule, error)
+
+	// Count returns the number of repositories with a schedule.
+	Count(ctx context.Context) (int, error)
+
+	// CountDueBefore returns the number of repositories which are due before the
+	// given time.
+	CountDueBefore(ctx context.Context, dueAt time.Time) (int, error)
+
+	// Insert creates schedules with the given interval and due time for those of the
+	// given repositories which do not have one yet.
+	Insert(ctx context.Context, interval time.Duration, dueAt time.Time, ids ...api.RepoID) error
+
+	// Prioritise ensures that the given repositories are due no later than dueAt,
+	// creating schedules with the given interval for repositories without one.
+	Prioritise(ctx context.Context, interval time.Duration, dueAt time.Time, ids ...api.RepoID) error
+
+	// Upsert creates or replaces the schedule of a repository.
+	Upsert(ctx context.Context, schedule *RepoUpdateSchedule) error
+
+	// Delete removes the schedules of the given repositories.
+	Delete(ctx context.Context, ids ...api.RepoID) error
+
+	// Claim moves the due time of those of the given repositories which are due at
+	// now forward by their interval, and returns their updated schedules. Rows that
+	// are locked by a concurrent claim from another repo-updater instance are
+	// skipped, so that every due repository is claimed by a single instance.
+	Claim(ctx context.Context, now time.Time, ids ...api.RepoID) ([]*RepoUpdateSchedule, error)
+}
+
+var _ RepoUpdateScheduleStore = (*repoUpdateScheduleStore)(nil)
+
+// repoUpdateScheduleStore is responsible for data stored in the repo_update_schedule table.
+type repoUpdateScheduleStore struct {
+	*basestore.Store
+}
+
+// RepoUpdateScheduleWith instantiates and returns a new repoUpdateScheduleStore using
+// the other store handle.
+func RepoUpdateScheduleWith(other basestore.ShareableStore) RepoUpdateScheduleStore {
+	return &repoUpdateScheduleStore{Store: basestore.NewWithHandle(other.Handle())}
+}
+
+func (s *repoUpdateScheduleStore) With(other basestore.ShareableStore) RepoUpdateScheduleStore {
+	return &repoUpdateScheduleStore{Store: s.Store.With(other)}
+}
+
+const repoUpdateScheduleColumns = `
+	s.repo_id,
+	repo.name,
+	s.interval_seconds,
+	s.due_at,
+	s.last_error,
+	s.last_updated_at
+`
+
+func (s *repoUpdateScheduleStore) List(ctx context.Context) ([]*RepoUpdateSchedule, error) {
+	return scanRepoUpdateSchedules(s.Query(ctx, sqlf.Sprintf(listRepoUpdateSchedulesQueryFmtstr, sqlf.Sprintf("TRUE"))))
+}
+
+func (s *repoUpdateScheduleStore) ListByRepoIDs(ctx context.Context, ids []api.RepoID) ([]*RepoUpdateSchedule, error) {
+	return scanRepoUpdateSchedules(s.Query(ctx, sqlf.Sprintf(listRepoUpdateSchedulesQueryFmtstr, sqlf.Sprintf("s.repo_id = ANY(%s)", pq.Array(ids)))))
+}
+
+const listRepoUpdateSchedulesQueryFmtstr = `
+SELECT` + repoUpdateScheduleColumns + `
+FROM repo_update_schedule s
+JOIN repo ON repo.id = s.repo_id
+WHERE
+	repo.deleted_at IS NULL
+AND
+	%s
+ORDER BY s.due_at, s.repo_id
+`
+
+func (s *repoUpdateScheduleStore) GetByRepoID(ctx context.Context, id api.RepoID) (*RepoUpdateSchedule, error) {
+	schedules, err := s.ListByRepoIDs(ctx, []api.RepoID{id})
+	if err != nil {
+		return nil, err
+	}
+	if len(schedules) == 0 {
+		return nil, &RepoUpdateScheduleNotFoundErr{RepoID: id}
+	}
+
+	return schedules[0], nil
+}
+
+func (s *repoUpdateScheduleStore) Count(ctx context.Context) (int, error) {
+	count, _, err := basestore.ScanFirstInt(s.Query(ctx, sqlf.Sprintf(countRepoUpdateSchedulesQueryFmtstr, sqlf.Sprintf("TRUE"))))
+	return count, err
+}
+
+func (s *repoUpdateScheduleStore) CountDueBefore(ctx context.Context, dueAt time.Time) (int, error) {
+	count, _, err := basestore.ScanFirstInt(s.Query(ctx, sqlf.Sprintf(countRepoUpdateSchedulesQueryFmtstr, sqlf.Sprintf("s.due_at < %s", dueAt))))
+	return count, err
+}
+
+const countRepoUpdateSchedulesQueryFmtstr = `
+SELECT COUNT(*)
+FROM repo_update_schedule s
+JOIN repo ON repo.id = s.repo_id
+WHERE
+	repo.deleted_at IS NULL
+AND
+	%s
+`
+
+func (s *repoUpdateScheduleStore) Insert(ctx context.Context, interval time.Duration, dueAt time.Time, ids ...api.RepoID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return s.Exec(ctx, sqlf.Sprintf(insertRepoUpdateSchedulesQueryFmtstr, intervalSeconds(interval), dueAt, pq.Array(ids)))
+}
+
+const insertRepoUpdateSchedulesQueryFmtstr = `
+INSERT INTO repo_update_schedule (repo_id, interval_seconds, due_at)
+SELECT repo.id, %s::integer, %s::timestamptz
+FROM repo
+WHERE repo.id = ANY(%s)
+ON CONFLICT (repo_id) DO NOTHING
+`
+
+func (s *repoUpdateScheduleStore) Prioritise(ctx context.Context, interval time.Duration, dueAt time.Time, ids ...api.RepoID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return s.Exec(ctx, sqlf.Sprintf(prioritiseRepoUpdateSchedulesQueryFmtstr, intervalSeconds(interval), dueAt, pq.Array(ids)))
+}
+
+const prioritiseRepoUpdateSchedulesQueryFmtstr = `
+INSERT INTO repo_update_schedule (repo_id, interval_seconds, due_at)
+SELECT repo.id, %s::integer, %s::timestamptz
+FROM repo
+WHERE repo.id = ANY(%s)
+ON CONFLICT (repo_id) DO UPDATE SET
+	due_at = LEAST(repo_update_schedule.due_at, EXCLUDED.due_at),
+	updated_at = NOW()
+`
+
+func (s *repoUpdateScheduleStore) Upsert(ctx context.Context, schedule *RepoUpdateSchedule) error {
+	return s.Exec(ctx, sqlf.Sprintf(
+		upsertRepoUpdateScheduleQueryFmtstr,
+		schedule.RepoID,
+		intervalSeconds(schedule.Interval),
+		schedule.DueAt,
+		dbutil.NewNullString(schedule.LastError),
+		dbutil.NullTimeColumn(schedule.LastUpdatedAt),
+	))
+}
+
+const upsertRepoUpdateScheduleQueryFmtstr = `
+INSERT INTO repo_update_schedule (repo_id, interval_seconds, due_at, last_error, last_updated_at)
+VALUES (%s, %s, %s, %s, %s)
+ON CONFLICT (repo_id) DO UPDATE SET
+	interval_seconds = EXCLUDED.interval_seconds,
+	due_at = EXCLUDED.due_at,
+	last_error = EXCLUDED.last_error,
+	last_updated_at = EXCLUDED.last_updated_at,
+	updated_at = NOW()
+`
+
+func (s *repoUpdateScheduleStore) Delete(ctx context.Context, ids ...api.RepoID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return s.Exec(ctx, sqlf.Sprintf(deleteRepoUpdateSchedulesQueryFmtstr, pq.Array(ids)))
+}
+
+const deleteRepoUpdateSchedulesQueryFmtstr = `
+DELETE FROM repo_update_schedule WHERE repo_id = ANY(%s)
+`
+
+func (s *repoUpdateScheduleStore) Claim(ctx context.Context, now time.Time, ids ...api.RepoID) ([]*RepoUpdateSchedule, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	return scanRepoUpdateSchedules(s.Query(ctx, sqlf.Sprintf(claimRepoUpdateSchedulesQueryFmtstr, pq.Array(ids), now, now)))
+}
+
+const claimRepoUpdateSchedulesQueryFmtstr = `
+WITH candidates AS (
+	SELECT repo_id
+	FROM repo_update_schedule
+	WHERE
+		repo_id = ANY(%s)
+	AND
+		due_at <= %s
+	FOR UPDATE SKIP LOCKED
+),
+claimed AS (
+	UPDATE repo_update_schedule
+	SET
+		due_at = %s::timestamptz + repo_update_schedule.interval_seconds * INTERVAL '1 second',
+		updated_at = NOW()
+	FROM candidates
+	WHERE repo_update_schedule.repo_id = candidates.repo_id
+	RETURNING repo_update_schedule.*
+)
+SELECT` + repoUpdateScheduleColumns + `
+FROM claimed s
+JOIN repo ON repo.id = s.repo_id
+ORDER BY s.due_at, s.repo_id
+`
+
+func scanRepoUpdateSchedules(rows *sql.Rows, queryErr error) (_ []*RepoUpdateSchedule, err error) {
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var schedules []*RepoUpdateSchedule
+	for rows.Next() {
+		var schedule RepoUpdateSchedule
+		var seconds int
+		if err := rows.Scan(
+			&schedule.RepoID,
+			&schedule.RepoName,
+			&seconds,
+			&schedule.DueAt,
+			&dbutil.NullString{S: &schedule.LastError},
+			&dbutil.NullTime{Time: &schedule.LastUpdatedAt},
+		); err != nil {
+			return nil, err
+		}
+		schedule.Interval = time.Duration(seconds) * time.Second
+
+		schedules = append(schedules, &schedule)
+	}
+
+	return schedules, nil
+}
+
+// intervalSeconds rounds the given interval to whole seconds, as it is stored in
+// the repo_update_schedule table.
+func intervalSeconds(interval time.Duration) int {
+	return int(interval.Round(time.Second) / time.Second)
+}
@@ -0,0 +1,124 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestRepoUpdateSchedule(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(logger, t))
+	ctx := context.Background()
+	store := db.RepoUpdateSchedule()
+
+	var ids []api.RepoID
+	for _, name := range []api.RepoName{"a", "b", "c"} {
+		repo := &types.Repo{Name: name}
+		require.NoError(t, db.Repos().Create(ctx, repo))
+		ids = append(ids, repo.ID)
+	}
+	a, b, c := ids[0], ids[1], ids[2]
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	t.Run("Insert", func(t *testing.T) {
+		require.NoError(t, store.Insert(ctx, time.Minute, now, a, b))
+		// Inserting again does not override existing schedules.
+		require.NoError(t, store.Insert(ctx, time.Hour, now.Add(time.Hour), a, c))
+
+		schedules, err := store.List(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []*RepoUpdateSchedule{
+			{RepoID: a, RepoName: "a", Interval: time.Minute, DueAt: now},
+			{RepoID: b, RepoName: "b", Interval: time.Minute, DueAt: now},
+			{RepoID: c, RepoName: "c", Interval: time.Hour, DueAt: now.Add(time.Hour)},
+		}, normalizeRepoUpdateSchedules(schedules))
+	})
+
+	t.Run("Prioritise", func(t *testing.T) {
+		require.NoError(t, store.Prioritise(ctx, time.Minute, now.Add(time.Minute), b, c))
+
+		schedules, err := store.ListByRepoIDs(ctx, []api.RepoID{b, c})
+		require.NoError(t, err)
+		require.Equal(t, []*RepoUpdateSchedule{
+			{RepoID: b, RepoName: "b", Interval: time.Minute, DueAt: now},
+			{RepoID: c, RepoName: "c", Interval: time.Hour, DueAt: now.Add(time.Minute)},
+		}, normalizeRepoUpdateSchedules(schedules))
+	})
+
+	t.Run("Upsert", func(t *testing.T) {
+		require.NoError(t, store.Upsert(ctx, &RepoUpdateSchedule{
+			RepoID:        a,
+			Interval:      2 * time.Minute,
+			DueAt:         now.Add(-time.Minute),
+			LastError:     "boom",
+			LastUpdatedAt: now,
+		}))
+
+		schedule, err := store.GetByRepoID(ctx, a)
+		require.NoError(t, err)
+		require.Equal(t, &RepoUpdateSchedule{
+			RepoID:        a,
+			RepoName:      "a",
+			Interval:      2 * time.Minute,
+			DueAt:         now.Add(-time.Minute),
+			LastError:     "boom",
+			LastUpdatedAt: now,
+		}, normalizeRepoUpdateSchedules([]*RepoUpdateSchedule{schedule})[0])
+	})
+
+	t.Run("Count", func(t *testing.T) {
+		count, err := store.Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 3, count)
+
+		count, err = store.CountDueBefore(ctx, now)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+	})
+
+	t.Run("Claim", func(t *testing.T) {
+		// Only a and b are due.
+		claimed, err := store.Claim(ctx, now, a, b, c)
+		require.NoError(t, err)
+		require.Equal(t, []*RepoUpdateSchedule{
+			{RepoID: b, RepoName: "b", Interval: time.Minute, DueAt: now.Add(time.Minute)},
+			{RepoID: a, RepoName: "a", Interval: 2 * time.Minute, DueAt: now.Add(2 * time.Minute), LastError: "boom", LastUpdatedAt: now},
+		}, normalizeRepoUpdateSchedules(claimed))
+
+		// Claimed repos are no longer due.
+		claimed, err = store.Claim(ctx, now, a, b, c)
+		require.NoError(t, err)
+		require.Empty(t, claimed)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		require.NoError(t, store.Delete(ctx, a))
+
+		_, err := store.GetByRepoID(ctx, a)
+		require.True(t, errcode.IsNotFound(err))
+	})
+}
+
+func normalizeRepoUpdateSchedules(schedules []*RepoUpdateSchedule) []*RepoUpdateSchedule {
+	for _, s := range schedules {
+		s.DueAt = s.DueAt.UTC()
+		if !s.LastUpdatedAt.IsZero() {
+			s.LastUpdatedAt = s.LastUpdatedAt.UTC()
+		}
+	}
+	return schedules
+}
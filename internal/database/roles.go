@@ -0,0 +1,330 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/audit"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// RoleStore manages roles and their assignment to users and organizations.
+// All mutations are recorded in the audit log.
+type RoleStore interface {
+	basestore.ShareableStore
+	With(basestore.ShareableStore) RoleStore
+	Transact(context.Context) (RoleStore, error)
+	Done(error) error
+
+	// Create creates a custom role with the given name.
+	Create(ctx context.Context, name string) (*types.Role, error)
+	// GetByID returns the role with the given ID.
+	GetByID(ctx context.Context, id int32) (*types.Role, error)
+	// GetByName returns the role with the given name.
+	GetByName(ctx context.Context, name string) (*types.Role, error)
+	// List returns the roles matching the given options.
+	List(ctx context.Context, opts RolesListOptions) ([]*types.Role, error)
+	// Count returns the number of roles matching the given options.
+	Count(ctx context.Context, opts RolesListOptions) (int, error)
+	// Delete deletes the custom role with the given ID. System roles cannot be
+	// deleted.
+	Delete(ctx context.Context, id int32) error
+	// SetPermissions replaces the permissions granted to the given role.
+	SetPermissions(ctx context.Context, roleID int32, permissionIDs []int32) error
+	// AssignToUser assigns the custom role to the given user. It is a no-op if
+	// the user already has the role.
+	AssignToUser(ctx context.Context, roleID, userID int32) error
+	// RevokeFromUser revokes the role from the given user.
+	RevokeFromUser(ctx context.Context, roleID, userID int32) error
+	// AssignToOrg assigns the custom role to the given organization, granting
+	// it to all of its members. It is a no-op if the organization already has
+	// the role.
+	AssignToOrg(ctx context.Context, roleID, orgID int32) error
+	// RevokeFromOrg revokes the role from the given organization.
+	RevokeFromOrg(ctx context.Context, roleID, orgID int32) error
+}
+
+// RolesListOptions contains options for listing roles.
+type RolesListOptions struct {
+	// UserID, if set, only returns the roles directly assigned to the user.
+	UserID int32
+	// OrgID, if set, only returns the roles assigned to the organization.
+	OrgID int32
+	*LimitOffset
+}
+
+// RoleNotFoundErr is returned when a role cannot be found.
+type RoleNotFoundErr struct {
+	ID   int32
+	Name string
+}
+
+func (e *RoleNotFoundErr) Error() string {
+	if e.Name != "" {
+		return fmt.Sprintf("role with name %q not found", e.Name)
+	}
+	return fmt.Sprintf("role with ID %d not found", e.ID)
+}
+
+func (e *RoleNotFoundErr) NotFound() bool {
+	return true
+}
+
+// ErrSystemRole is returned when attempting to delete or assign a system role.
+var ErrSystemRole = errors.New("system roles cannot be deleted or assigned")
+
+// ErrDuplicateRole is returned when creating a role with a name that is
+// already in use.
+var ErrDuplicateRole = errors.New("a role with this name already exists")
+
+type roleStore struct {
+	*basestore.Store
+	logger log.Logger
+}
+
+var _ RoleStore = &roleStore{}
+
+// RolesWith instantiates and returns a new RoleStore using the other store
+// handle.
+func RolesWith(logger log.Logger, other basestore.ShareableStore) RoleStore {
+	return &roleStore{
+		Store:  basestore.NewWithHandle(other.Handle()),
+		logger: logger,
+	}
+}
+
+func (s *roleStore) With(other basestore.ShareableStore) RoleStore {
+	return &roleStore{Store: s.Store.With(other), logger: s.logger}
+}
+
+func (s *roleStore) Transact(ctx context.Context) (RoleStore, error) {
+	txBase, err := s.Store.Transact(ctx)
+	return &roleStore{Store: txBase, logger: s.logger}, err
+}
+
+var roleColumns = []*sqlf.Query{
+	sqlf.Sprintf("roles.id"),
+	sqlf.Sprintf("roles.name"),
+	sqlf.Sprintf("roles.system"),
+	sqlf.Sprintf("roles.created_at"),
+}
+
+const roleCreateQueryFmtstr = `
+INSERT INTO roles (name)
+VALUES (%s)
+ON CONFLICT (name) DO NOTHING
+RETURNING %s
+`
+
+func (s *roleStore) Create(ctx context.Context, name string) (*types.Role, error) {
+	if name == "" {
+		return nil, errors.New("role name cannot be empty")
+	}
+
+	role, err := scanRole(s.QueryRow(ctx, sqlf.Sprintf(roleCreateQueryFmtstr, name, sqlf.Join(roleColumns, ", "))))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrDuplicateRole
+		}
+		return nil, errors.Wrap(err, "scanning role")
+	}
+
+	s.audit(ctx, "role created", role.ID, log.String("name", role.Name))
+	return role, nil
+}
+
+const roleGetQueryFmtstr = `
+SELECT %s FROM roles
+WHERE %s
+`
+
+func (s *roleStore) GetByID(ctx context.Context, id int32) (*types.Role, error) {
+	role, err := scanRole(s.QueryRow(ctx, sqlf.Sprintf(roleGetQueryFmtstr, sqlf.Join(roleColumns, ", "), sqlf.Sprintf("id = %s", id))))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &RoleNotFoundErr{ID: id}
+		}
+		return nil, errors.Wrap(err, "scanning role")
+	}
+	return role, nil
+}
+
+func (s *roleStore) GetByName(ctx context.Context, name string) (*types.Role, error) {
+	role, err := scanRole(s.QueryRow(ctx, sqlf.Sprintf(roleGetQueryFmtstr, sqlf.Join(roleColumns, ", "), sqlf.Sprintf("name = %s", name))))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &RoleNotFoundErr{Name: name}
+		}
+		return nil, errors.Wrap(err, "scanning role")
+	}
+	return role, nil
+}
+
+const roleListQueryFmtstr = `
+SELECT %s FROM roles
+WHERE %s
+`
+
+func (s *roleStore) List(ctx context.Context, opts RolesListOptions) ([]*types.Role, error) {
+	q := sqlf.Sprintf(roleListQueryFmtstr, sqlf.Join(roleColumns, ", "), rolesListConds(opts))
+	q = sqlf.Sprintf("%s ORDER BY roles.system DESC, roles.name ASC, roles.id ASC %s", q, opts.LimitOffset.SQL())
+
+	rows, err := s.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var roles []*types.Role
+	for rows.Next() {
+		role, err := scanRole(rows)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+	return roles, rows.Err()
+}
+
+func (s *roleStore) Count(ctx context.Context, opts RolesListOptions) (int, error) {
+	q := sqlf.Sprintf(roleListQueryFmtstr, sqlf.Sprintf("COUNT(*)"), rolesListConds(opts))
+	count, _, err := basestore.ScanFirstInt(s.Query(ctx, q))
+	return count, err
+}
+
+func rolesListConds(opts RolesListOptions) *sqlf.Query {
+	conds := []*sqlf.Query{sqlf.Sprintf("TRUE")}
+	if opts.UserID != 0 {
+		conds = append(conds, sqlf.Sprintf("EXISTS (SELECT 1 FROM user_roles WHERE user_roles.role_id = roles.id AND user_roles.user_id = %s)", opts.UserID))
+	}
+	if opts.OrgID != 0 {
+		conds = append(conds, sqlf.Sprintf("EXISTS (SELECT 1 FROM org_roles WHERE org_roles.role_id = roles.id AND org_roles.org_id = %s)", opts.OrgID))
+	}
+	return sqlf.Join(conds, "AND")
+}
+
+func (s *roleStore) Delete(ctx context.Context, id int32) error {
+	role, err := s.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if role.System {
+		return ErrSystemRole
+	}
+
+	if err := s.Exec(ctx, sqlf.Sprintf("DELETE FROM roles WHERE id = %s AND NOT system", id)); err != nil {
+		return errors.Wrap(err, "deleting role")
+	}
+
+	s.audit(ctx, "role deleted", role.ID, log.String("name", role.Name))
+	return nil
+}
+
+const roleSetPermissionsQueryFmtstr = `
+WITH deleted AS (
+	DELETE FROM role_permissions
+	WHERE role_id = %s AND NOT permission_id = ANY(%s)
+)
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT %s, id FROM permissions WHERE id = ANY(%s)
+ON CONFLICT DO NOTHING
+`
+
+func (s *roleStore) SetPermissions(ctx context.Context, roleID int32, permissionIDs []int32) error {
+	if _, err := s.GetByID(ctx, roleID); err != nil {
+		return err
+	}
+
+	if permissionIDs == nil {
+		// A nil slice would be passed as NULL, which doesn't match anything.
+		permissionIDs = []int32{}
+	}
+	ids := pq.Array(permissionIDs)
+	if err := s.Exec(ctx, sqlf.Sprintf(roleSetPermissionsQueryFmtstr, roleID, ids, roleID, ids)); err != nil {
+		return errors.Wrap(err, "setting role permissions")
+	}
+
+	s.audit(ctx, "role permissions set", roleID, log.Int32s("permissionIDs", permissionIDs))
+	return nil
+}
+
+func (s *roleStore) AssignToUser(ctx context.Context, roleID, userID int32) error {
+	if err := s.checkAssignable(ctx, roleID); err != nil {
+		return err
+	}
+
+	if err := s.Exec(ctx, sqlf.Sprintf("INSERT INTO user_roles (user_id, role_id) VALUES (%s, %s) ON CONFLICT DO NOTHING", userID, roleID)); err != nil {
+		return errors.Wrap(err, "assigning role to user")
+	}
+
+	s.audit(ctx, "role assigned to user", roleID, log.Int32("userID", userID))
+	return nil
+}
+
+func (s *roleStore) RevokeFromUser(ctx context.Context, roleID, userID int32) error {
+	if err := s.Exec(ctx, sqlf.Sprintf("DELETE FROM user_roles WHERE user_id = %s AND role_id = %s", userID, roleID)); err != nil {
+		return errors.Wrap(err, "revoking role from user")
+	}
+
+	s.audit(ctx, "role revoked from user", roleID, log.Int32("userID", userID))
+	return nil
+}
+
+func (s *roleStore) AssignToOrg(ctx context.Context, roleID, orgID int32) error {
+	if err := s.checkAssignable(ctx, roleID); err != nil {
+		return err
+	}
+
+	if err := s.Exec(ctx, sqlf.Sprintf("INSERT INTO org_roles (org_id, role_id) VALUES (%s, %s) ON CONFLICT DO NOTHING", orgID, roleID)); err != nil {
+		return errors.Wrap(err, "assigning role to organization")
+	}
+
+	s.audit(ctx, "role assigned to organization", roleID, log.Int32("orgID", orgID))
+	return nil
+}
+
+func (s *roleStore) RevokeFromOrg(ctx context.Context, roleID, orgID int32) error {
+	if err := s.Exec(ctx, sqlf.Sprintf("DELETE FROM org_roles WHERE org_id = %s AND role_id = %s", orgID, roleID)); err != nil {
+		return errors.Wrap(err, "revoking role from organization")
+	}
+
+	s.audit(ctx, "role revoked from organization", roleID, log.Int32("orgID", orgID))
+	return nil
+}
+
+// checkAssignable returns an error if the role doesn't exist or is a system
+// role, which users and organizations hold implicitly.
+func (s *roleStore) checkAssignable(ctx context.Context, roleID int32) error {
+	role, err := s.GetByID(ctx, roleID)
+	if err != nil {
+		return err
+	}
+	if role.System {
+		return ErrSystemRole
+	}
+	return nil
+}
+
+func (s *roleStore) audit(ctx context.Context, action string, roleID int32, fields ...log.Field) {
+	audit.Log(ctx, s.logger, audit.Record{
+		Entity: "role",
+		Action: action,
+		Fields: append([]log.Field{log.Int32("roleID", roleID)}, fields...),
+	})
+}
+
+func scanRole(sc dbutil.Scanner) (*types.Role, error) {
+	var role types.Role
+	if err := sc.Scan(&role.ID, &role.Name, &role.System, &role.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &role, nil
+}
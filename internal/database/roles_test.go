@@ -0,0 +1,139 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/rbac"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestRoleStore(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	t.Parallel()
+
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(logger, t))
+	ctx := actor.WithInternalActor(context.Background())
+	store := db.Roles()
+
+	user, err := db.Users().Create(ctx, NewUser{Username: "alice"})
+	require.NoError(t, err)
+	org, err := db.Orgs().Create(ctx, "the-org", nil)
+	require.NoError(t, err)
+
+	t.Run("system roles", func(t *testing.T) {
+		for _, name := range []string{rbac.UserRole, rbac.SiteAdministratorRole} {
+			role, err := store.GetByName(ctx, name)
+			require.NoError(t, err)
+			assert.True(t, role.System)
+
+			assert.Equal(t, ErrSystemRole, store.Delete(ctx, role.ID))
+			assert.Equal(t, ErrSystemRole, store.AssignToUser(ctx, role.ID, user.ID))
+			assert.Equal(t, ErrSystemRole, store.AssignToOrg(ctx, role.ID, org.ID))
+		}
+	})
+
+	role, err := store.Create(ctx, "PLATFORM")
+	require.NoError(t, err)
+	assert.False(t, role.System)
+
+	t.Run("duplicate name", func(t *testing.T) {
+		_, err := store.Create(ctx, "PLATFORM")
+		assert.Equal(t, ErrDuplicateRole, err)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		have, err := store.GetByID(ctx, role.ID)
+		require.NoError(t, err)
+		assert.Equal(t, role, have)
+
+		_, err = store.GetByID(ctx, 9999)
+		assert.True(t, errcode.IsNotFound(err))
+	})
+
+	t.Run("list", func(t *testing.T) {
+		roles, err := store.List(ctx, RolesListOptions{})
+		require.NoError(t, err)
+		require.Len(t, roles, 3)
+		// System roles are listed first.
+		assert.Equal(t, rbac.SiteAdministratorRole, roles[0].Name)
+		assert.Equal(t, rbac.UserRole, roles[1].Name)
+		assert.Equal(t, "PLATFORM", roles[2].Name)
+
+		count, err := store.Count(ctx, RolesListOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+	})
+
+	t.Run("assign and revoke", func(t *testing.T) {
+		require.NoError(t, store.AssignToUser(ctx, role.ID, user.ID))
+		// Assigning twice is a no-op.
+		require.NoError(t, store.AssignToUser(ctx, role.ID, user.ID))
+		require.NoError(t, store.AssignToOrg(ctx, role.ID, org.ID))
+
+		roles, err := store.List(ctx, RolesListOptions{UserID: user.ID})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"PLATFORM"}, roleNames(roles))
+
+		roles, err = store.List(ctx, RolesListOptions{OrgID: org.ID})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"PLATFORM"}, roleNames(roles))
+
+		require.NoError(t, store.RevokeFromUser(ctx, role.ID, user.ID))
+		require.NoError(t, store.RevokeFromOrg(ctx, role.ID, org.ID))
+
+		count, err := store.Count(ctx, RolesListOptions{UserID: user.ID})
+		require.NoError(t, err)
+		assert.Zero(t, count)
+
+		count, err = store.Count(ctx, RolesListOptions{OrgID: org.ID})
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("set permissions", func(t *testing.T) {
+		perms, err := db.Permissions().List(ctx, PermissionListOptions{})
+		require.NoError(t, err)
+		require.Len(t, perms, len(rbac.Permissions))
+
+		require.NoError(t, store.SetPermissions(ctx, role.ID, []int32{perms[0].ID, perms[1].ID}))
+		granted, err := db.Permissions().List(ctx, PermissionListOptions{RoleID: role.ID})
+		require.NoError(t, err)
+		assert.Equal(t, perms[:2], granted)
+
+		require.NoError(t, store.SetPermissions(ctx, role.ID, []int32{perms[1].ID}))
+		granted, err = db.Permissions().List(ctx, PermissionListOptions{RoleID: role.ID})
+		require.NoError(t, err)
+		assert.Equal(t, perms[1:2], granted)
+
+		require.NoError(t, store.SetPermissions(ctx, role.ID, nil))
+		count, err := db.Permissions().Count(ctx, PermissionListOptions{RoleID: role.ID})
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, store.Delete(ctx, role.ID))
+
+		_, err := store.GetByID(ctx, role.ID)
+		assert.True(t, errcode.IsNotFound(err))
+	})
+}
+
+func roleNames(roles []*types.Role) []string {
+	names := make([]string, 0, len(roles))
+	for _, r := range roles {
+		names = append(names, r.Name)
+	}
+	return names
+}
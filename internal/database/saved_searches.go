@@ -3,12 +3,15 @@ package database
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
 	otlog "github.com/opentracing/opentracing-go/log"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -20,10 +23,12 @@ type SavedSearchStore interface {
 	GetByID(context.Context, int32) (*api.SavedQuerySpecAndConfig, error)
 	IsEmpty(context.Context) (bool, error)
 	ListAll(context.Context) ([]api.SavedQuerySpecAndConfig, error)
+	ListDueForNotification(ctx context.Context, lastRunBefore time.Time, limit int) ([]*types.SavedSearchNotificationState, error)
 	ListSavedSearchesByOrgID(ctx context.Context, orgID int32) ([]*types.SavedSearch, error)
 	ListSavedSearchesByUserID(ctx context.Context, userID int32) ([]*types.SavedSearch, error)
 	Transact(context.Context) (SavedSearchStore, error)
 	Update(context.Context, *types.SavedSearch) (*types.SavedSearch, error)
+	UpdateNotificationState(ctx context.Context, id int32, fingerprint string, resultHashes []string) error
 	With(basestore.ShareableStore) SavedSearchStore
 	basestore.ShareableStore
 }
@@ -257,12 +262,13 @@ func (s *savedSearchStore) Create(ctx context.Context, newSavedSearch *types.Sav
 	}()
 
 	savedQuery = &types.SavedSearch{
-		Description: newSavedSearch.Description,
-		Query:       newSavedSearch.Query,
-		Notify:      newSavedSearch.Notify,
-		NotifySlack: newSavedSearch.NotifySlack,
-		UserID:      newSavedSearch.UserID,
-		OrgID:       newSavedSearch.OrgID,
+		Description:     newSavedSearch.Description,
+		Query:           newSavedSearch.Query,
+		Notify:          newSavedSearch.Notify,
+		NotifySlack:     newSavedSearch.NotifySlack,
+		UserID:          newSavedSearch.UserID,
+		OrgID:           newSavedSearch.OrgID,
+		SlackWebhookURL: newSavedSearch.SlackWebhookURL,
 	}
 
 	err = s.Handle().QueryRowContext(ctx, `INSERT INTO saved_searches(
@@ -271,14 +277,16 @@ func (s *savedSearchStore) Create(ctx context.Context, newSavedSearch *types.Sav
 			notify_owner,
 			notify_slack,
 			user_id,
-			org_id
-		) VALUES($1, $2, $3, $4, $5, $6) RETURNING id`,
+			org_id,
+			slack_webhook_url
+		) VALUES($1, $2, $3, $4, $5, $6, $7) RETURNING id`,
 		newSavedSearch.Description,
 		savedQuery.Query,
 		newSavedSearch.Notify,
 		newSavedSearch.NotifySlack,
 		newSavedSearch.UserID,
 		newSavedSearch.OrgID,
+		newSavedSearch.SlackWebhookURL,
 	).Scan(&savedQuery.ID)
 	if err != nil {
 		return nil, err
@@ -312,6 +320,10 @@ func (s *savedSearchStore) Update(ctx context.Context, savedSearch *types.SavedS
 		sqlf.Sprintf("updated_at=now()"),
 		sqlf.Sprintf("description=%s", savedSearch.Description),
 		sqlf.Sprintf("query=%s", savedSearch.Query),
+		// The results of the last scheduled run are not comparable with
+		// the results of a different query, so they are discarded.
+		sqlf.Sprintf("results_fingerprint=CASE WHEN query=%s THEN results_fingerprint ELSE NULL END", savedSearch.Query),
+		sqlf.Sprintf("result_hashes=CASE WHEN query=%s THEN result_hashes ELSE '{}' END", savedSearch.Query),
 		sqlf.Sprintf("notify_owner=%t", savedSearch.Notify),
 		sqlf.Sprintf("notify_slack=%t", savedSearch.NotifySlack),
 		sqlf.Sprintf("user_id=%v", savedSearch.UserID),
@@ -326,6 +338,87 @@ func (s *savedSearchStore) Update(ctx context.Context, savedSearch *types.SavedS
 	return savedQuery, nil
 }
 
+// ListDueForNotification lists at most limit saved searches with email or
+// Slack notifications enabled which have not been run since lastRunBefore,
+// least recently run first.
+//
+// 🚨 SECURITY: This method does NOT verify the user's identity or that the
+// user is an admin. It is the callers responsibility to run the returned
+// saved searches as their owners.
+func (s *savedSearchStore) ListDueForNotification(ctx context.Context, lastRunBefore time.Time, limit int) (_ []*types.SavedSearchNotificationState, err error) {
+	tr, ctx := trace.New(ctx, "database.SavedSearches.ListDueForNotification", "")
+	defer func() {
+		tr.SetError(err)
+		tr.Finish()
+	}()
+
+	q := sqlf.Sprintf(`SELECT
+		id,
+		description,
+		query,
+		notify_owner,
+		notify_slack,
+		user_id,
+		org_id,
+		slack_webhook_url,
+		last_run_at,
+		results_fingerprint,
+		result_hashes
+		FROM saved_searches
+		WHERE (notify_owner OR notify_slack)
+		AND user_id IS NOT NULL
+		AND (last_run_at IS NULL OR last_run_at < %s)
+		ORDER BY last_run_at ASC NULLS FIRST, id
+		LIMIT %s`, lastRunBefore, limit)
+
+	rows, err := s.Query(ctx, q)
+	if err != nil {
+		return nil, errors.Wrap(err, "QueryContext")
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var states []*types.SavedSearchNotificationState
+	for rows.Next() {
+		var st types.SavedSearchNotificationState
+		if err := rows.Scan(
+			&st.ID,
+			&st.Description,
+			&st.Query,
+			&st.Notify,
+			&st.NotifySlack,
+			&st.UserID,
+			&st.OrgID,
+			&st.SlackWebhookURL,
+			&st.LastRunAt,
+			&dbutil.NullString{S: &st.ResultsFingerprint},
+			pq.Array(&st.ResultHashes),
+		); err != nil {
+			return nil, errors.Wrap(err, "Scan")
+		}
+		states = append(states, &st)
+	}
+	return states, nil
+}
+
+// UpdateNotificationState records the results of a scheduled run of the saved
+// search with the given ID.
+func (s *savedSearchStore) UpdateNotificationState(ctx context.Context, id int32, fingerprint string, resultHashes []string) (err error) {
+	tr, ctx := trace.New(ctx, "database.SavedSearches.UpdateNotificationState", "")
+	defer func() {
+		tr.SetError(err)
+		tr.Finish()
+	}()
+
+	if resultHashes == nil {
+		resultHashes = []string{}
+	}
+	return s.Exec(ctx, sqlf.Sprintf(`UPDATE saved_searches SET
+		last_run_at = now(),
+		results_fingerprint = %s,
+		result_hashes = %s
+		WHERE id = %s`, dbutil.NewNullString(fingerprint), pq.Array(resultHashes), id))
+}
+
 // Delete hard-deletes an existing saved search.
 //
 // 🚨 SECURITY: This method does NOT verify the user's identity or that the
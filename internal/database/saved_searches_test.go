@@ -4,6 +4,7 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -374,3 +375,70 @@ func TestListSavedSearchesByUserID(t *testing.T) {
 		t.Errorf("got %v, want %v", savedSearches, want)
 	}
 }
+
+func TestSavedSearchesNotificationState(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	t.Parallel()
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(logger, t))
+	ctx := context.Background()
+	_, err := db.Users().Create(ctx, NewUser{DisplayName: "test", Email: "test@test.com", Username: "test", Password: "test", EmailVerificationCode: "c2"})
+	if err != nil {
+		t.Fatal("can't create user", err)
+	}
+	userID := int32(1)
+	for _, ss := range []*types.SavedSearch{
+		{Query: "notify", Description: "notify", Notify: true, UserID: &userID},
+		{Query: "silent", Description: "silent", UserID: &userID},
+	} {
+		if _, err := db.SavedSearches().Create(ctx, ss); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	listDue := func() []*types.SavedSearchNotificationState {
+		t.Helper()
+		due, err := db.SavedSearches().ListDueForNotification(ctx, time.Now().Add(-time.Hour), 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return due
+	}
+
+	due := listDue()
+	if len(due) != 1 || due[0].Query != "notify" || due[0].LastRunAt != nil || due[0].ResultsFingerprint != "" {
+		t.Fatalf("unexpected saved searches due for notification: %+v", due)
+	}
+
+	if err := db.SavedSearches().UpdateNotificationState(ctx, due[0].ID, "fingerprint", []string{"a", "b"}); err != nil {
+		t.Fatal(err)
+	}
+	if due := listDue(); len(due) != 0 {
+		t.Fatalf("expected no saved searches due after a run, got %+v", due)
+	}
+
+	due, err = db.SavedSearches().ListDueForNotification(ctx, time.Now().Add(time.Hour), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(due) != 1 || due[0].ResultsFingerprint != "fingerprint" || !reflect.DeepEqual(due[0].ResultHashes, []string{"a", "b"}) {
+		t.Fatalf("unexpected notification state: %+v", due)
+	}
+
+	// Changing the query discards the results of the last run.
+	updated := due[0].SavedSearch
+	updated.Query = "notify2"
+	if _, err := db.SavedSearches().Update(ctx, &updated); err != nil {
+		t.Fatal(err)
+	}
+	due, err = db.SavedSearches().ListDueForNotification(ctx, time.Now().Add(time.Hour), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(due) != 1 || due[0].ResultsFingerprint != "" || len(due[0].ResultHashes) != 0 {
+		t.Fatalf("expected the notification state to be reset, got %+v", due)
+	}
+}
@@ -51,6 +51,11 @@ func (k *Key) Decrypt(ctx context.Context, ciphertext []byte) (*encryption.Secre
 	return &s, nil
 }
 
+// Rotatable returns whether the wrapped key is rotatable.
+func (k *Key) Rotatable() bool {
+	return encryption.IsRotatable(k.Key)
+}
+
 func hash(v []byte) uint64 {
 	h := fnv.New64()
 	h.Write(v)
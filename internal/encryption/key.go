@@ -15,6 +15,24 @@ type Key interface {
 	Version(ctx context.Context) (KeyVersion, error)
 }
 
+// RotatableKey is implemented by keys that can still decrypt values encrypted
+// with a previous version of the key after the key has been rotated. Values
+// encrypted with such keys are re-encrypted with the current key version.
+type RotatableKey interface {
+	Key
+
+	// Rotatable returns whether values encrypted with a previous version of the
+	// key can be decrypted with this key.
+	Rotatable() bool
+}
+
+// IsRotatable returns whether the given key is a RotatableKey that can decrypt
+// values encrypted with a previous version of the key.
+func IsRotatable(k Key) bool {
+	r, ok := k.(RotatableKey)
+	return ok && r.Rotatable()
+}
+
 type KeyVersion struct {
 	// TODO: generate this as an enum from JSONSchema
 	Type    string
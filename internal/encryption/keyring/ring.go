@@ -12,6 +12,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/encryption/cache"
 	"github.com/sourcegraph/sourcegraph/internal/encryption/cloudkms"
 	"github.com/sourcegraph/sourcegraph/internal/encryption/mounted"
+	"github.com/sourcegraph/sourcegraph/internal/encryption/vault"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
@@ -127,6 +128,13 @@ func NewRing(ctx context.Context, keyConfig *schema.EncryptionKeys) (*Ring, erro
 		}
 	}
 
+	if keyConfig.CodeMonitorWebhookKey != nil {
+		r.CodeMonitorWebhookKey, err = NewKey(ctx, keyConfig.CodeMonitorWebhookKey, keyConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &r, nil
 }
 
@@ -137,6 +145,7 @@ type Ring struct {
 	WebhookKey                encryption.Key
 	WebhookLogKey             encryption.Key
 	ExecutorSecretKey         encryption.Key
+	CodeMonitorWebhookKey     encryption.Key
 }
 
 func NewKey(ctx context.Context, k *schema.EncryptionKey, config *schema.EncryptionKeys) (encryption.Key, error) {
@@ -154,6 +163,8 @@ func NewKey(ctx context.Context, k *schema.EncryptionKey, config *schema.Encrypt
 		key, err = awskms.NewKey(ctx, *k.Awskms)
 	case k.Mounted != nil:
 		key, err = mounted.NewKey(ctx, *k.Mounted)
+	case k.Vault != nil:
+		key, err = vault.NewKey(ctx, *k.Vault)
 	case k.Noop != nil:
 		key = &encryption.NoopKey{}
 	default:
@@ -0,0 +1,210 @@
+package vault
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/encryption"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+const defaultMountPath = "transit"
+
+func NewKey(ctx context.Context, k schema.VaultEncryptionKey) (*Key, error) {
+	return newKey(ctx, k, httpcli.ExternalDoer)
+}
+
+func newKey(ctx context.Context, k schema.VaultEncryptionKey, doer httpcli.Doer) (*Key, error) {
+	if (k.TokenEnvVarName == "") == (k.TokenFilepath == "") {
+		// Either the user has set none of TokenEnvVarName or TokenFilepath or both in their config. Either way we return an error.
+		return nil, errors.Errorf(
+			"must use only one of tokenEnvVarName and tokenFilepath, tokenEnvVarName: %q, tokenFilepath: %q",
+			k.TokenEnvVarName, k.TokenFilepath,
+		)
+	}
+
+	address, err := url.Parse(k.Address)
+	if err != nil || address.Scheme == "" || address.Host == "" {
+		return nil, errors.Errorf("invalid Vault address %q", k.Address)
+	}
+
+	mountPath := strings.Trim(k.MountPath, "/")
+	if mountPath == "" {
+		mountPath = defaultMountPath
+	}
+
+	key := &Key{
+		address:         address,
+		mountPath:       mountPath,
+		keyName:         k.KeyName,
+		namespace:       k.Namespace,
+		tokenEnvVarName: k.TokenEnvVarName,
+		tokenFilepath:   k.TokenFilepath,
+		doer:            doer,
+	}
+	// Test client connection.
+	_, err = key.Version(ctx)
+	return key, err
+}
+
+// Key is an encryption.Key implementation that uses the transit secrets engine of
+// HashiCorp Vault, such that the key material never leaves Vault. The ciphertext
+// returned by Vault records the version of the key it was encrypted with, so
+// values encrypted before a key rotation can still be decrypted.
+type Key struct {
+	address         *url.URL
+	mountPath       string
+	keyName         string
+	namespace       string
+	tokenEnvVarName string
+	tokenFilepath   string
+	doer            httpcli.Doer
+}
+
+var _ encryption.RotatableKey = &Key{}
+
+// Rotatable returns true, as Vault can decrypt values encrypted with any version of
+// a transit key that is not below its minimum decryption version.
+func (k *Key) Rotatable() bool {
+	return true
+}
+
+func (k *Key) Version(ctx context.Context) (encryption.KeyVersion, error) {
+	var resp struct {
+		Data struct {
+			LatestVersion int `json:"latest_version"`
+		} `json:"data"`
+	}
+	if err := k.do(ctx, http.MethodGet, "keys", nil, &resp); err != nil {
+		return encryption.KeyVersion{}, errors.Wrap(err, "getting key version")
+	}
+
+	return encryption.KeyVersion{
+		Type:    "vault",
+		Name:    path.Join(k.mountPath, k.keyName),
+		Version: strconv.Itoa(resp.Data.LatestVersion),
+	}, nil
+}
+
+// Encrypt a secret with the latest version of the transit key. The returned
+// ciphertext is the value returned by Vault, which is prefixed with "vault:v<version>:".
+func (k *Key) Encrypt(ctx context.Context, plaintext []byte) ([]byte, error) {
+	req := map[string]string{
+		"plaintext": base64.StdEncoding.EncodeToString(plaintext),
+	}
+	var resp struct {
+		Data struct {
+			Ciphertext string `json:"ciphertext"`
+		} `json:"data"`
+	}
+	if err := k.do(ctx, http.MethodPost, "encrypt", req, &resp); err != nil {
+		return nil, errors.Wrap(err, "encrypting value")
+	}
+
+	return []byte(resp.Data.Ciphertext), nil
+}
+
+// Decrypt a secret, it must have been encrypted with any version of the same
+// transit key.
+func (k *Key) Decrypt(ctx context.Context, ciphertext []byte) (*encryption.Secret, error) {
+	if !bytes.HasPrefix(ciphertext, []byte("vault:")) {
+		return nil, errors.New("malformed ciphertext, are you trying to decrypt something with the wrong key?")
+	}
+
+	req := map[string]string{
+		"ciphertext": string(ciphertext),
+	}
+	var resp struct {
+		Data struct {
+			Plaintext string `json:"plaintext"`
+		} `json:"data"`
+	}
+	if err := k.do(ctx, http.MethodPost, "decrypt", req, &resp); err != nil {
+		return nil, errors.Wrap(err, "decrypting value")
+	}
+
+	plaintext, err := base64.StdEncoding.DecodeString(resp.Data.Plaintext)
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding plaintext")
+	}
+
+	s := encryption.NewSecret(string(plaintext))
+	return &s, nil
+}
+
+// do sends a request to the given operation endpoint of the transit key, e.g.
+// /v1/transit/encrypt/<key name>, and decodes the response into result.
+func (k *Key) do(ctx context.Context, method, operation string, body, result any) error {
+	token, err := k.token()
+	if err != nil {
+		return err
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		buf, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(buf)
+	}
+
+	u := *k.address
+	u.Path = path.Join("/", u.Path, "v1", k.mountPath, operation, k.keyName)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	if k.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", k.namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := k.doer.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var errResp struct {
+			Errors []string `json:"errors"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&errResp)
+		return errors.Errorf("unexpected status code %d from Vault: %s", resp.StatusCode, strings.Join(errResp.Errors, ", "))
+	}
+
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+// token returns the Vault token. A token file is read on every request, so that
+// tokens renewed by a Vault agent are picked up without a restart.
+func (k *Key) token() (string, error) {
+	if k.tokenEnvVarName != "" {
+		token := os.Getenv(k.tokenEnvVarName)
+		if token == "" {
+			return "", errors.Errorf("environment variable %q containing the Vault token is empty", k.tokenEnvVarName)
+		}
+		return token, nil
+	}
+
+	token, err := os.ReadFile(k.tokenFilepath)
+	if err != nil {
+		return "", errors.Errorf("error reading Vault token file for %q: %v", k.keyName, err)
+	}
+	return strings.TrimSpace(string(token)), nil
+}
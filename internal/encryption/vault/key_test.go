@@ -0,0 +1,217 @@
+package vault
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/encryption"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestRoundTrip(t *testing.T) {
+	server := newFakeTransit(t)
+	ctx := context.Background()
+
+	k, err := newKey(ctx, server.config(t), server.Client())
+	require.NoError(t, err)
+
+	plaintext := "all your base are belong to us"
+	ciphertext, err := k.Encrypt(ctx, []byte(plaintext))
+	require.NoError(t, err)
+	assert.NotContains(t, string(ciphertext), plaintext)
+	assert.True(t, strings.HasPrefix(string(ciphertext), "vault:v1:"))
+
+	secret, err := k.Decrypt(ctx, ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, secret.Secret())
+
+	_, err = k.Decrypt(ctx, []byte("not-a-vault-ciphertext"))
+	assert.Error(t, err)
+}
+
+func TestKeyRotation(t *testing.T) {
+	server := newFakeTransit(t)
+	ctx := context.Background()
+
+	k, err := newKey(ctx, server.config(t), server.Client())
+	require.NoError(t, err)
+
+	version, err := k.Version(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, encryption.KeyVersion{Type: "vault", Name: "transit/test-key", Version: "1"}, version)
+
+	ciphertext, err := k.Encrypt(ctx, []byte("before rotation"))
+	require.NoError(t, err)
+
+	server.rotate()
+
+	version, err = k.Version(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "2", version.Version)
+
+	// Values encrypted with a previous version can still be decrypted.
+	secret, err := k.Decrypt(ctx, ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, "before rotation", secret.Secret())
+
+	// New values are encrypted with the latest version.
+	ciphertext, err = k.Encrypt(ctx, []byte("after rotation"))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(ciphertext), "vault:v2:"))
+
+	assert.True(t, encryption.IsRotatable(k))
+}
+
+func TestTokenFile(t *testing.T) {
+	server := newFakeTransit(t)
+	ctx := context.Background()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte(server.token+"\n"), 0600))
+
+	config := server.config(t)
+	config.TokenEnvVarName = ""
+	config.TokenFilepath = tokenFile
+	config.Namespace = "team-a"
+	config.MountPath = "/secrets/transit/"
+	server.mountPath = "secrets/transit"
+
+	k, err := newKey(ctx, config, server.Client())
+	require.NoError(t, err)
+	assert.Equal(t, "team-a", server.lastNamespace)
+
+	// The token is read again after it has been renewed.
+	server.token = "renewed-token"
+	_, err = k.Encrypt(ctx, []byte("secret"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "permission denied")
+
+	require.NoError(t, os.WriteFile(tokenFile, []byte("renewed-token"), 0600))
+	_, err = k.Encrypt(ctx, []byte("secret"))
+	require.NoError(t, err)
+}
+
+func TestInvalidConfig(t *testing.T) {
+	ctx := context.Background()
+
+	for name, config := range map[string]schema.VaultEncryptionKey{
+		"no token":        {Type: "vault", Address: "https://vault.example.com", KeyName: "k"},
+		"both tokens":     {Type: "vault", Address: "https://vault.example.com", KeyName: "k", TokenEnvVarName: "A", TokenFilepath: "/b"},
+		"invalid address": {Type: "vault", Address: "vault.example.com", KeyName: "k", TokenEnvVarName: "A"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := newKey(ctx, config, http.DefaultClient)
+			assert.Error(t, err)
+		})
+	}
+}
+
+// fakeTransit is an in-process stand-in for the transit secrets engine of Vault.
+// Ciphertexts are the base64 encoded plaintext, prefixed with the key version.
+type fakeTransit struct {
+	*httptest.Server
+
+	mu            sync.Mutex
+	token         string
+	mountPath     string
+	keyName       string
+	latestVersion int
+	lastNamespace string
+}
+
+func newFakeTransit(t *testing.T) *fakeTransit {
+	f := &fakeTransit{
+		token:         "test-token",
+		mountPath:     "transit",
+		keyName:       "test-key",
+		latestVersion: 1,
+	}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	t.Cleanup(f.Close)
+	return f
+}
+
+func (f *fakeTransit) config(t *testing.T) schema.VaultEncryptionKey {
+	envVarName := "VAULT_TEST_TOKEN_" + strings.ToUpper(strings.ReplaceAll(t.Name(), "/", "_"))
+	t.Setenv(envVarName, f.token)
+
+	return schema.VaultEncryptionKey{
+		Type:            "vault",
+		Address:         f.URL,
+		KeyName:         f.keyName,
+		TokenEnvVarName: envVarName,
+	}
+}
+
+func (f *fakeTransit) rotate() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.latestVersion++
+}
+
+func (f *fakeTransit) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.lastNamespace = r.Header.Get("X-Vault-Namespace")
+	if r.Header.Get("X-Vault-Token") != f.token {
+		f.fail(w, http.StatusForbidden, "permission denied")
+		return
+	}
+
+	var req map[string]string
+	_ = json.NewDecoder(r.Body).Decode(&req)
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == fmt.Sprintf("/v1/%s/keys/%s", f.mountPath, f.keyName):
+		f.respond(w, map[string]any{"latest_version": f.latestVersion})
+
+	case r.Method == http.MethodPost && r.URL.Path == fmt.Sprintf("/v1/%s/encrypt/%s", f.mountPath, f.keyName):
+		f.respond(w, map[string]any{
+			"ciphertext":  fmt.Sprintf("vault:v%d:%s", f.latestVersion, base64.StdEncoding.EncodeToString([]byte(req["plaintext"]))),
+			"key_version": f.latestVersion,
+		})
+
+	case r.Method == http.MethodPost && r.URL.Path == fmt.Sprintf("/v1/%s/decrypt/%s", f.mountPath, f.keyName):
+		parts := strings.SplitN(req["ciphertext"], ":", 3)
+		if len(parts) != 3 {
+			f.fail(w, http.StatusBadRequest, "invalid ciphertext")
+			return
+		}
+		if version, err := strconv.Atoi(strings.TrimPrefix(parts[1], "v")); err != nil || version > f.latestVersion {
+			f.fail(w, http.StatusBadRequest, "invalid ciphertext: unable to decrypt")
+			return
+		}
+		plaintext, err := base64.StdEncoding.DecodeString(parts[2])
+		if err != nil {
+			f.fail(w, http.StatusBadRequest, "invalid ciphertext")
+			return
+		}
+		f.respond(w, map[string]any{"plaintext": string(plaintext)})
+
+	default:
+		f.fail(w, http.StatusNotFound, "unsupported path")
+	}
+}
+
+func (f *fakeTransit) respond(w http.ResponseWriter, data any) {
+	_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+}
+
+func (f *fakeTransit) fail(w http.ResponseWriter, statusCode int, message string) {
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(map[string]any{"errors": []string{message}})
+}
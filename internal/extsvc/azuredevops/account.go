@@ -0,0 +1,27 @@
+package azuredevops
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/internal/encryption"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+)
+
+// AccountData stores information of an Azure DevOps account.
+type AccountData struct {
+	Email string `json:"email"`
+	// Identities maps the name of each organization the user was found in to
+	// the ID of the user's identity within that organization.
+	Identities map[string]string `json:"identities"`
+}
+
+// GetExternalAccountData extracts account data for the external account.
+func GetExternalAccountData(ctx context.Context, data *extsvc.AccountData) (*AccountData, error) {
+	if data.Data == nil {
+		return nil, nil
+	}
+
+	var d AccountData
+	err := encryption.DecryptJSON(ctx, data.Data, &d)
+	return &d, err
+}
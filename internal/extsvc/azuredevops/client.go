@@ -0,0 +1,274 @@
+//nolint:bodyclose // Body is closed in Client.do, but the response is still returned to provide access to the headers
+package azuredevops
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/opentracing-contrib/go-stdlib/nethttp"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/metrics"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/internal/trace/ot"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// apiVersion is the version of the Azure DevOps REST API that this client
+// targets. It has to be sent along with every request.
+const apiVersion = "7.0"
+
+// AzureDevOpsAPIURL is the URL of Azure DevOps Services, the hosted version of
+// Azure DevOps.
+const AzureDevOpsAPIURL = "https://dev.azure.com/"
+
+// The metric generated here will be named as "src_azuredevops_requests_total".
+var requestCounter = metrics.NewRequestMeter("azuredevops", "Total number of requests sent to the Azure DevOps API.")
+
+// Client access an Azure DevOps instance via the REST API.
+type Client interface {
+	Authenticator() auth.Authenticator
+	WithAuthenticator(a auth.Authenticator) Client
+
+	Ping(ctx context.Context) error
+
+	ListProjects(ctx context.Context, org string) ([]Project, error)
+	ListRepositoriesByProjectOrOrg(ctx context.Context, args ListRepositoriesByProjectOrOrgArgs) ([]Repository, error)
+	GetRepo(ctx context.Context, args OrgProjectRepoArgs) (Repository, error)
+	ForkRepository(ctx context.Context, org string, input ForkRepositoryInput) (Repository, error)
+
+	CreatePullRequest(ctx context.Context, args OrgProjectRepoArgs, input CreatePullRequestInput) (PullRequest, error)
+	ListPullRequests(ctx context.Context, args OrgProjectRepoArgs, criteria ListPullRequestsCriteria) ([]PullRequest, error)
+	GetPullRequest(ctx context.Context, args PullRequestCommonArgs) (PullRequest, error)
+	GetPullRequestStatuses(ctx context.Context, args PullRequestCommonArgs) ([]PullRequestBuildStatus, error)
+	UpdatePullRequest(ctx context.Context, args PullRequestCommonArgs, input PullRequestUpdateInput) (PullRequest, error)
+	AbandonPullRequest(ctx context.Context, args PullRequestCommonArgs) (PullRequest, error)
+	ReactivatePullRequest(ctx context.Context, args PullRequestCommonArgs) (PullRequest, error)
+	CompletePullRequest(ctx context.Context, args PullRequestCommonArgs, input PullRequestCompleteInput) (PullRequest, error)
+	CreatePullRequestCommentThread(ctx context.Context, args PullRequestCommonArgs, input PullRequestCommentInput) (PullRequestCommentResponse, error)
+
+	GetAuthorizedProfile(ctx context.Context) (Profile, error)
+	ListIdentitiesByEmail(ctx context.Context, org, email string) ([]IdentityDetails, error)
+	GetUserEntitlement(ctx context.Context, org, userID string) (UserEntitlement, error)
+}
+
+type client struct {
+	// HTTP Client used to communicate with the API.
+	httpClient httpcli.Doer
+
+	// URL is the base URL of Azure DevOps.
+	URL *url.URL
+
+	// auth is the authentication method used when accessing the server.
+	// Supported are auth.BasicAuth for personal access tokens and
+	// auth.OAuthBearerToken for OAuth tokens.
+	auth auth.Authenticator
+
+	// rateLimit is the self-imposed rate limiter (since Azure DevOps throttles
+	// based on resource usage rather than a fixed number of requests).
+	rateLimit *ratelimit.InstrumentedLimiter
+}
+
+// NewClient returns an authenticated Azure DevOps API client with the provided
+// configuration. If a nil httpClient is provided, httpcli.ExternalDoer will be
+// used.
+func NewClient(urn string, config *schema.AzureDevOpsConnection, httpClient httpcli.Doer) (Client, error) {
+	return newClient(urn, config, httpClient)
+}
+
+func newClient(urn string, config *schema.AzureDevOpsConnection, httpClient httpcli.Doer) (*client, error) {
+	if httpClient == nil {
+		httpClient = httpcli.ExternalDoer
+	}
+
+	httpClient = requestCounter.Doer(httpClient, func(u *url.URL) string {
+		// The API area ("git", "projects", "profile", ...) follows "_apis"
+		// and mostly maps to the type of API request we are making.
+		parts := strings.Split(u.Path, "/")
+		for i, part := range parts {
+			if part == "_apis" && i+1 < len(parts) {
+				return parts[i+1]
+			}
+		}
+		return ""
+	})
+
+	rawURL := config.Url
+	if rawURL == "" {
+		rawURL = AzureDevOpsAPIURL
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &client{
+		httpClient: httpClient,
+		URL:        extsvc.NormalizeBaseURL(u),
+		auth: &auth.BasicAuth{
+			Username: config.Username,
+			Password: config.Token,
+		},
+		// Default limits are defined in extsvc.GetLimitFromConfig
+		rateLimit: ratelimit.DefaultRegistry.Get(urn),
+	}, nil
+}
+
+func (c *client) Authenticator() auth.Authenticator {
+	return c.auth
+}
+
+// WithAuthenticator returns a new Client that uses the same configuration,
+// HTTPClient, and RateLimiter as the current Client, except authenticated with
+// the given authenticator instance.
+func (c *client) WithAuthenticator(a auth.Authenticator) Client {
+	return &client{
+		httpClient: c.httpClient,
+		URL:        c.URL,
+		auth:       a,
+		rateLimit:  c.rateLimit,
+	}
+}
+
+// Ping validates that the current authenticator is valid by requesting the
+// profile of the authenticated user.
+func (c *client) Ping(ctx context.Context) error {
+	_, err := c.GetAuthorizedProfile(ctx)
+	return err
+}
+
+// serviceURL returns the base URL of one of the auxiliary Azure DevOps
+// services (such as "vssps" for identities or "vsaex" for user entitlements),
+// which are served from a subdomain of the main instance.
+func (c *client) serviceURL(service string) *url.URL {
+	u := *c.URL
+	u.Host = service + "." + u.Host
+	return &u
+}
+
+// do sends the request to the API and decodes the JSON response into result,
+// if it is non-nil. The api-version query parameter is added to every request.
+func (c *client) do(ctx context.Context, base *url.URL, method, urlPath string, qs url.Values, body, result any) (*http.Response, error) {
+	if qs == nil {
+		qs = make(url.Values)
+	}
+	qs.Set("api-version", apiVersion)
+
+	u := base.ResolveReference(&url.URL{Path: urlPath, RawQuery: qs.Encode()})
+
+	var bodyReader io.Reader
+	if body != nil {
+		bs, err := json.Marshal(body)
+		if err != nil {
+			return nil, errors.Wrap(err, "marshalling request body")
+		}
+		bodyReader = bytes.NewReader(bs)
+	}
+
+	req, err := http.NewRequest(method, u.String(), bodyReader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	req, ht := nethttp.TraceRequest(ot.GetTracer(ctx),
+		req.WithContext(ctx),
+		nethttp.OperationName("Azure DevOps"),
+		nethttp.ClientTrace(false))
+	defer ht.Finish()
+
+	if err := c.auth.Authenticate(req); err != nil {
+		return nil, err
+	}
+
+	if err := c.rateLimit.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bs, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Azure DevOps redirects unauthenticated requests to a sign-in page
+	// instead of returning a 401, which we map to an authentication error.
+	if resp.StatusCode == http.StatusNonAuthoritativeInfo {
+		return nil, errors.WithStack(&HTTPError{
+			URL:        req.URL,
+			StatusCode: http.StatusUnauthorized,
+			Body:       bs,
+		})
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return nil, errors.WithStack(&HTTPError{
+			URL:        req.URL,
+			StatusCode: resp.StatusCode,
+			Body:       bs,
+		})
+	}
+
+	if result != nil {
+		if err := json.Unmarshal(bs, result); err != nil {
+			return nil, errors.Wrap(err, "decoding response")
+		}
+	}
+
+	return resp, nil
+}
+
+// HTTPError is returned for all non-successful responses of the Azure DevOps
+// API.
+type HTTPError struct {
+	StatusCode int
+	URL        *url.URL
+	Body       []byte
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("Azure DevOps API HTTP error: code=%d url=%q body=%q", e.StatusCode, e.URL, e.Body)
+}
+
+func (e *HTTPError) Unauthorized() bool {
+	return e.StatusCode == http.StatusUnauthorized
+}
+
+func (e *HTTPError) NotFound() bool {
+	return e.StatusCode == http.StatusNotFound
+}
+
+// pullRequestExistsCode is the error code returned when creating a pull
+// request for a source and target branch that already have an active pull
+// request.
+const pullRequestExistsCode = "TF401179"
+
+// PullRequestExists returns true if the request failed because an active pull
+// request for the same source and target branch already exists.
+func (e *HTTPError) PullRequestExists() bool {
+	return e.StatusCode == http.StatusConflict && bytes.Contains(e.Body, []byte(pullRequestExistsCode))
+}
+
+// IsPullRequestExists returns true if err is an HTTPError returned when an
+// active pull request for the same source and target branch already exists.
+func IsPullRequestExists(err error) bool {
+	var e *HTTPError
+	return errors.As(err, &e) && e.PullRequestExists()
+}
@@ -0,0 +1,113 @@
+package azuredevops
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Event types of the Azure DevOps service hooks we can unmarshal. Approvals,
+// votes, pushes to the source branch and status changes are all delivered as
+// PullRequestUpdatedEventType, with the updated pull request as the resource.
+const (
+	PullRequestCreatedEventType   = "git.pullrequest.created"
+	PullRequestUpdatedEventType   = "git.pullrequest.updated"
+	PullRequestMergedEventType    = "git.pullrequest.merged"
+	PullRequestCommentedEventType = "ms.vss-code.git-pullrequest-comment-event"
+)
+
+// ParseWebhookEvent unmarshals a service hook payload into the event type
+// given in its eventType field.
+func ParseWebhookEvent(payload []byte) (any, error) {
+	var base BaseEvent
+	if err := json.Unmarshal(payload, &base); err != nil {
+		return nil, err
+	}
+
+	var target any
+	switch base.EventType {
+	case PullRequestCreatedEventType:
+		target = &PullRequestCreatedEvent{}
+	case PullRequestUpdatedEventType:
+		target = &PullRequestUpdatedEvent{}
+	case PullRequestMergedEventType:
+		target = &PullRequestMergedEvent{}
+	case PullRequestCommentedEventType:
+		target = &PullRequestCommentedEvent{}
+	default:
+		return nil, UnknownWebhookEventType(base.EventType)
+	}
+
+	if err := json.Unmarshal(payload, target); err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// BaseEvent contains the fields common to all service hook payloads.
+type BaseEvent struct {
+	// ID is the unique ID of the event.
+	ID          string       `json:"id"`
+	EventType   string       `json:"eventType"`
+	Message     EventMessage `json:"message"`
+	CreatedDate time.Time    `json:"createdDate"`
+}
+
+type EventMessage struct {
+	Text     string `json:"text"`
+	Markdown string `json:"markdown"`
+}
+
+type PullRequestEvent struct {
+	BaseEvent
+	PullRequest PullRequest `json:"resource"`
+}
+
+type PullRequestCreatedEvent struct {
+	PullRequestEvent
+}
+
+type PullRequestUpdatedEvent struct {
+	PullRequestEvent
+}
+
+type PullRequestMergedEvent struct {
+	PullRequestEvent
+}
+
+type PullRequestCommentedEvent struct {
+	BaseEvent
+	Resource PullRequestCommentedResource `json:"resource"`
+}
+
+type PullRequestCommentedResource struct {
+	Comment     PullRequestComment `json:"comment"`
+	PullRequest PullRequest        `json:"pullRequest"`
+}
+
+// Error types.
+
+type UnknownWebhookEventType string
+
+var _ error = UnknownWebhookEventType("")
+
+func (e UnknownWebhookEventType) Error() string {
+	return "unknown webhook event type: " + string(e)
+}
+
+// Widgetry to ensure all events are keyers. Every service hook notification
+// has a unique ID, which we use as the key.
+
+type keyer interface {
+	Key() string
+}
+
+var (
+	_ keyer = &PullRequestCreatedEvent{}
+	_ keyer = &PullRequestUpdatedEvent{}
+	_ keyer = &PullRequestMergedEvent{}
+	_ keyer = &PullRequestCommentedEvent{}
+)
+
+func (e *BaseEvent) Key() string {
+	return e.ID
+}
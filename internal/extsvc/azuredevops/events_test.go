@@ -0,0 +1,48 @@
+package azuredevops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWebhookEvent(t *testing.T) {
+	for eventType, tc := range map[string]struct {
+		payload  string
+		wantType any
+	}{
+		PullRequestCreatedEventType: {
+			payload:  `{"id":"1","eventType":"git.pullrequest.created","resource":{}}`,
+			wantType: &PullRequestCreatedEvent{},
+		},
+		PullRequestUpdatedEventType: {
+			payload:  `{"id":"1","eventType":"git.pullrequest.updated","resource":{}}`,
+			wantType: &PullRequestUpdatedEvent{},
+		},
+		PullRequestMergedEventType: {
+			payload:  `{"id":"1","eventType":"git.pullrequest.merged","resource":{}}`,
+			wantType: &PullRequestMergedEvent{},
+		},
+		PullRequestCommentedEventType: {
+			payload:  `{"id":"1","eventType":"ms.vss-code.git-pullrequest-comment-event","resource":{"comment":{},"pullRequest":{}}}`,
+			wantType: &PullRequestCommentedEvent{},
+		},
+	} {
+		t.Run(eventType, func(t *testing.T) {
+			have, err := ParseWebhookEvent([]byte(tc.payload))
+			assert.Nil(t, err)
+			assert.IsType(t, tc.wantType, have)
+			assert.Equal(t, "1", have.(keyer).Key())
+		})
+	}
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		_, err := ParseWebhookEvent([]byte("invalid JSON"))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("unknown event type", func(t *testing.T) {
+		_, err := ParseWebhookEvent([]byte(`{"eventType":"build.complete"}`))
+		assert.ErrorAs(t, err, new(UnknownWebhookEventType))
+	})
+}
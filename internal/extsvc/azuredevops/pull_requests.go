@@ -0,0 +1,121 @@
+package azuredevops
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"path"
+)
+
+// CreatePullRequest opens a new pull request in the given repository.
+func (c *client) CreatePullRequest(ctx context.Context, args OrgProjectRepoArgs, input CreatePullRequestInput) (PullRequest, error) {
+	urlPath := path.Join(args.Org, args.Project, "_apis/git/repositories", args.RepoNameOrID, "pullrequests")
+
+	var pr PullRequest
+	if _, err := c.do(ctx, c.URL, http.MethodPost, urlPath, nil, input, &pr); err != nil {
+		return PullRequest{}, err
+	}
+	return pr, nil
+}
+
+// ListPullRequests returns the pull requests of the given repository that
+// match the criteria.
+func (c *client) ListPullRequests(ctx context.Context, args OrgProjectRepoArgs, criteria ListPullRequestsCriteria) ([]PullRequest, error) {
+	urlPath := path.Join(args.Org, args.Project, "_apis/git/repositories", args.RepoNameOrID, "pullrequests")
+
+	qs := make(url.Values)
+	for key, value := range map[string]string{
+		"searchCriteria.status":             string(criteria.Status),
+		"searchCriteria.sourceRefName":      criteria.SourceRefName,
+		"searchCriteria.targetRefName":      criteria.TargetRefName,
+		"searchCriteria.sourceRepositoryId": criteria.SourceRepositoryID,
+	} {
+		if value != "" {
+			qs.Set(key, value)
+		}
+	}
+
+	var resp listResponse[PullRequest]
+	if _, err := c.do(ctx, c.URL, http.MethodGet, urlPath, qs, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Value, nil
+}
+
+// GetPullRequest returns a single pull request.
+func (c *client) GetPullRequest(ctx context.Context, args PullRequestCommonArgs) (PullRequest, error) {
+	var pr PullRequest
+	if _, err := c.do(ctx, c.URL, http.MethodGet, pullRequestPath(args), nil, nil, &pr); err != nil {
+		return PullRequest{}, err
+	}
+	return pr, nil
+}
+
+// GetPullRequestStatuses returns the statuses (such as build results) posted
+// to a pull request.
+func (c *client) GetPullRequestStatuses(ctx context.Context, args PullRequestCommonArgs) ([]PullRequestBuildStatus, error) {
+	var resp listResponse[PullRequestBuildStatus]
+	if _, err := c.do(ctx, c.URL, http.MethodGet, path.Join(pullRequestPath(args), "statuses"), nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Value, nil
+}
+
+// UpdatePullRequest updates the fields of a pull request that are set in the
+// input.
+func (c *client) UpdatePullRequest(ctx context.Context, args PullRequestCommonArgs, input PullRequestUpdateInput) (PullRequest, error) {
+	var pr PullRequest
+	if _, err := c.do(ctx, c.URL, http.MethodPatch, pullRequestPath(args), nil, input, &pr); err != nil {
+		return PullRequest{}, err
+	}
+	return pr, nil
+}
+
+// AbandonPullRequest closes a pull request without merging it.
+func (c *client) AbandonPullRequest(ctx context.Context, args PullRequestCommonArgs) (PullRequest, error) {
+	status := PullRequestStatusAbandoned
+	return c.UpdatePullRequest(ctx, args, PullRequestUpdateInput{Status: &status})
+}
+
+// ReactivatePullRequest reopens an abandoned pull request.
+func (c *client) ReactivatePullRequest(ctx context.Context, args PullRequestCommonArgs) (PullRequest, error) {
+	status := PullRequestStatusActive
+	return c.UpdatePullRequest(ctx, args, PullRequestUpdateInput{Status: &status})
+}
+
+// CompletePullRequest merges a pull request. The commit ID has to match the
+// last merge source commit of the pull request, which guards against merging
+// changes that have been pushed in the meantime.
+func (c *client) CompletePullRequest(ctx context.Context, args PullRequestCommonArgs, input PullRequestCompleteInput) (PullRequest, error) {
+	body := struct {
+		Status                PullRequestStatus  `json:"status"`
+		LastMergeSourceCommit PullRequestCommit  `json:"lastMergeSourceCommit"`
+		CompletionOptions     *CompletionOptions `json:"completionOptions,omitempty"`
+	}{
+		Status:                PullRequestStatusCompleted,
+		LastMergeSourceCommit: PullRequestCommit{CommitID: input.CommitID},
+	}
+	if input.MergeStrategy != nil {
+		body.CompletionOptions = &CompletionOptions{MergeStrategy: *input.MergeStrategy}
+	}
+
+	var pr PullRequest
+	if _, err := c.do(ctx, c.URL, http.MethodPatch, pullRequestPath(args), nil, body, &pr); err != nil {
+		return PullRequest{}, err
+	}
+	return pr, nil
+}
+
+// CreatePullRequestCommentThread starts a new comment thread on a pull
+// request.
+func (c *client) CreatePullRequestCommentThread(ctx context.Context, args PullRequestCommonArgs, input PullRequestCommentInput) (PullRequestCommentResponse, error) {
+	var resp PullRequestCommentResponse
+	if _, err := c.do(ctx, c.URL, http.MethodPost, path.Join(pullRequestPath(args), "threads"), nil, input, &resp); err != nil {
+		return PullRequestCommentResponse{}, err
+	}
+	return resp, nil
+}
+
+func pullRequestPath(args PullRequestCommonArgs) string {
+	return path.Join(args.Org, args.Project, "_apis/git/repositories", args.RepoNameOrID, "pullrequests", args.PullRequestID)
+}
@@ -0,0 +1,181 @@
+package azuredevops
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+)
+
+var testPullRequestArgs = PullRequestCommonArgs{
+	PullRequestID: "1",
+	Org:           "sgtestazure",
+	Project:       "sgtestazure",
+	RepoNameOrID:  "sgtestazure",
+}
+
+func TestClient_CreatePullRequest(t *testing.T) {
+	// WHEN UPDATING: this test requires a branch named branch-00 in the
+	// sgtestazure repository without an open pull request.
+	cli := newTestClient(t)
+	ctx := context.Background()
+
+	args := OrgProjectRepoArgs{
+		Org:          "sgtestazure",
+		Project:      "sgtestazure",
+		RepoNameOrID: "sgtestazure",
+	}
+	input := CreatePullRequestInput{
+		SourceRefName: "refs/heads/branch-00",
+		TargetRefName: "refs/heads/master",
+		Title:         "Sourcegraph test branch-00",
+		Description:   "This is a PR created by the Sourcegraph test suite.",
+	}
+
+	t.Run("created", func(t *testing.T) {
+		pr, err := cli.CreatePullRequest(ctx, args, input)
+		assert.Nil(t, err)
+		assert.Equal(t, PullRequestStatusActive, pr.Status)
+		assertGolden(t, pr)
+	})
+
+	t.Run("already exists", func(t *testing.T) {
+		_, err := cli.CreatePullRequest(ctx, args, input)
+		assert.NotNil(t, err)
+
+		var httpErr *HTTPError
+		assert.ErrorAs(t, err, &httpErr)
+		assert.Equal(t, 409, httpErr.StatusCode)
+		assert.True(t, IsPullRequestExists(err))
+	})
+}
+
+func TestClient_ListPullRequests(t *testing.T) {
+	// WHEN UPDATING: this test requires the open pull request created by
+	// TestClient_CreatePullRequest, and no branch named branch-99.
+	cli := newTestClient(t)
+	ctx := context.Background()
+
+	args := OrgProjectRepoArgs{
+		Org:          "sgtestazure",
+		Project:      "sgtestazure",
+		RepoNameOrID: "sgtestazure",
+	}
+
+	t.Run("found", func(t *testing.T) {
+		prs, err := cli.ListPullRequests(ctx, args, ListPullRequestsCriteria{
+			Status:        PullRequestStatusActive,
+			SourceRefName: "refs/heads/branch-00",
+			TargetRefName: "refs/heads/master",
+		})
+		assert.Nil(t, err)
+		assert.Len(t, prs, 1)
+		assertGolden(t, prs)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		prs, err := cli.ListPullRequests(ctx, args, ListPullRequestsCriteria{
+			Status:        PullRequestStatusActive,
+			SourceRefName: "refs/heads/branch-99",
+			TargetRefName: "refs/heads/master",
+		})
+		assert.Nil(t, err)
+		assert.Empty(t, prs)
+	})
+}
+
+func TestClient_GetPullRequest(t *testing.T) {
+	cli := newTestClient(t)
+	ctx := context.Background()
+
+	t.Run("found", func(t *testing.T) {
+		pr, err := cli.GetPullRequest(ctx, testPullRequestArgs)
+		assert.Nil(t, err)
+		assert.Equal(t, "https://dev.azure.com/sgtestazure/sgtestazure/_git/sgtestazure/pullrequest/1", pr.WebURL())
+		assertGolden(t, pr)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		args := testPullRequestArgs
+		args.PullRequestID = "9999"
+
+		_, err := cli.GetPullRequest(ctx, args)
+		assert.True(t, errcode.IsNotFound(err))
+	})
+}
+
+func TestClient_GetPullRequestStatuses(t *testing.T) {
+	cli := newTestClient(t)
+
+	statuses, err := cli.GetPullRequestStatuses(context.Background(), testPullRequestArgs)
+	assert.Nil(t, err)
+	assert.Len(t, statuses, 2)
+
+	assertGolden(t, statuses)
+}
+
+func TestClient_UpdatePullRequest(t *testing.T) {
+	cli := newTestClient(t)
+
+	title := "Sourcegraph test branch-00 (updated)"
+	description := "This PR was updated by the Sourcegraph test suite."
+	pr, err := cli.UpdatePullRequest(context.Background(), testPullRequestArgs, PullRequestUpdateInput{
+		Title:       &title,
+		Description: &description,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, title, pr.Title)
+
+	assertGolden(t, pr)
+}
+
+func TestClient_AbandonPullRequest(t *testing.T) {
+	cli := newTestClient(t)
+
+	pr, err := cli.AbandonPullRequest(context.Background(), testPullRequestArgs)
+	assert.Nil(t, err)
+	assert.Equal(t, PullRequestStatusAbandoned, pr.Status)
+
+	assertGolden(t, pr)
+}
+
+func TestClient_ReactivatePullRequest(t *testing.T) {
+	cli := newTestClient(t)
+
+	pr, err := cli.ReactivatePullRequest(context.Background(), testPullRequestArgs)
+	assert.Nil(t, err)
+	assert.Equal(t, PullRequestStatusActive, pr.Status)
+
+	assertGolden(t, pr)
+}
+
+func TestClient_CompletePullRequest(t *testing.T) {
+	cli := newTestClient(t)
+
+	squash := PullRequestMergeStrategySquash
+	pr, err := cli.CompletePullRequest(context.Background(), testPullRequestArgs, PullRequestCompleteInput{
+		CommitID:      "0a7c5a8e3f8b5f1d9c2e4b6a8d0f2e4c6a8b0d2e",
+		MergeStrategy: &squash,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, PullRequestStatusCompleted, pr.Status)
+
+	assertGolden(t, pr)
+}
+
+func TestClient_CreatePullRequestCommentThread(t *testing.T) {
+	cli := newTestClient(t)
+
+	thread, err := cli.CreatePullRequestCommentThread(context.Background(), testPullRequestArgs, PullRequestCommentInput{
+		Comments: []PullRequestCommentForInput{{
+			ParentCommentID: 0,
+			Content:         "A comment from the Sourcegraph test suite.",
+			CommentType:     1,
+		}},
+	})
+	assert.Nil(t, err)
+
+	assertGolden(t, thread)
+}
@@ -0,0 +1,72 @@
+package azuredevops
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"path"
+)
+
+// ListProjects returns all projects of the given organization that are
+// visible to the authenticated user.
+func (c *client) ListProjects(ctx context.Context, org string) ([]Project, error) {
+	var (
+		projects          []Project
+		continuationToken string
+	)
+	for {
+		qs := make(url.Values)
+		if continuationToken != "" {
+			qs.Set("continuationToken", continuationToken)
+		}
+
+		var resp listResponse[Project]
+		httpResp, err := c.do(ctx, c.URL, http.MethodGet, path.Join(org, "_apis/projects"), qs, nil, &resp)
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, resp.Value...)
+
+		// The projects API is paginated through a continuation token that
+		// is returned in a response header rather than in the body.
+		continuationToken = httpResp.Header.Get("X-Ms-Continuationtoken")
+		if continuationToken == "" {
+			return projects, nil
+		}
+	}
+}
+
+// ListRepositoriesByProjectOrOrg returns all repositories of the given
+// project ("org/project") or organization ("org").
+func (c *client) ListRepositoriesByProjectOrOrg(ctx context.Context, args ListRepositoriesByProjectOrOrgArgs) ([]Repository, error) {
+	urlPath := path.Join(args.ProjectOrOrgName, "_apis/git/repositories")
+
+	var resp listResponse[Repository]
+	if _, err := c.do(ctx, c.URL, http.MethodGet, urlPath, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Value, nil
+}
+
+// GetRepo returns a single repository.
+func (c *client) GetRepo(ctx context.Context, args OrgProjectRepoArgs) (Repository, error) {
+	urlPath := path.Join(args.Org, args.Project, "_apis/git/repositories", args.RepoNameOrID)
+
+	var repo Repository
+	if _, err := c.do(ctx, c.URL, http.MethodGet, urlPath, nil, nil, &repo); err != nil {
+		return Repository{}, err
+	}
+	return repo, nil
+}
+
+// ForkRepository creates a fork of the parent repository given in the input
+// in the project given in the input.
+func (c *client) ForkRepository(ctx context.Context, org string, input ForkRepositoryInput) (Repository, error) {
+	urlPath := path.Join(org, "_apis/git/repositories")
+
+	var repo Repository
+	if _, err := c.do(ctx, c.URL, http.MethodPost, urlPath, nil, input, &repo); err != nil {
+		return Repository{}, err
+	}
+	return repo, nil
+}
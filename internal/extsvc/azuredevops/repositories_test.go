@@ -0,0 +1,89 @@
+package azuredevops
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+)
+
+func TestClient_ListProjects(t *testing.T) {
+	cli := newTestClient(t)
+
+	projects, err := cli.ListProjects(context.Background(), "sgtestazure")
+	assert.Nil(t, err)
+	// The second project is only returned on the page following the
+	// continuation token.
+	assert.Len(t, projects, 2)
+
+	assertGolden(t, projects)
+}
+
+func TestClient_ListRepositoriesByProjectOrOrg(t *testing.T) {
+	cli := newTestClient(t)
+	ctx := context.Background()
+
+	t.Run("project", func(t *testing.T) {
+		repos, err := cli.ListRepositoriesByProjectOrOrg(ctx, ListRepositoriesByProjectOrOrgArgs{
+			ProjectOrOrgName: "sgtestazure/sgtestazure",
+		})
+		assert.Nil(t, err)
+		assertGolden(t, repos)
+	})
+
+	t.Run("org", func(t *testing.T) {
+		repos, err := cli.ListRepositoriesByProjectOrOrg(ctx, ListRepositoriesByProjectOrOrgArgs{
+			ProjectOrOrgName: "sgtestazure",
+		})
+		assert.Nil(t, err)
+		assertGolden(t, repos)
+	})
+}
+
+func TestClient_GetRepo(t *testing.T) {
+	cli := newTestClient(t)
+	ctx := context.Background()
+
+	t.Run("found", func(t *testing.T) {
+		repo, err := cli.GetRepo(ctx, OrgProjectRepoArgs{
+			Org:          "sgtestazure",
+			Project:      "sgtestazure",
+			RepoNameOrID: "sgtestazure",
+		})
+		assert.Nil(t, err)
+		assertGolden(t, repo)
+
+		nameWithOwner, err := repo.NameWithOwner()
+		assert.Nil(t, err)
+		assert.Equal(t, "sgtestazure/sgtestazure/sgtestazure", nameWithOwner)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := cli.GetRepo(ctx, OrgProjectRepoArgs{
+			Org:          "sgtestazure",
+			Project:      "sgtestazure",
+			RepoNameOrID: "does-not-exist",
+		})
+		assert.True(t, errcode.IsNotFound(err))
+	})
+}
+
+func TestClient_ForkRepository(t *testing.T) {
+	cli := newTestClient(t)
+
+	fork, err := cli.ForkRepository(context.Background(), "sgtestazure", ForkRepositoryInput{
+		Name:    "sgtestazure-fork",
+		Project: ForkRepositoryInputProject{ID: "9f8a2e1c-7a3e-4f5b-9b1e-2c6f3d4a5b60"},
+		ParentRepository: ForkRepositoryInputParentRepository{
+			ID:      "d7c1e4a2-3b5f-4c8d-9e0a-1f2b3c4d5e6f",
+			Project: ForkRepositoryInputProject{ID: "9f8a2e1c-7a3e-4f5b-9b1e-2c6f3d4a5b60"},
+		},
+	})
+	assert.Nil(t, err)
+	assert.True(t, fork.IsFork)
+	assert.Equal(t, "d7c1e4a2-3b5f-4c8d-9e0a-1f2b3c4d5e6f", fork.ParentRepository.ID)
+
+	assertGolden(t, fork)
+}
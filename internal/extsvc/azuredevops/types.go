@@ -0,0 +1,368 @@
+package azuredevops
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// OrgProjectRepoArgs identifies a repository within a project of an
+// organization. Repo can either be the name or the ID of the repository.
+type OrgProjectRepoArgs struct {
+	Org          string
+	Project      string
+	RepoNameOrID string
+}
+
+// PullRequestCommonArgs identifies a pull request of a repository.
+type PullRequestCommonArgs struct {
+	PullRequestID string
+	Org           string
+	Project       string
+	RepoNameOrID  string
+}
+
+// ListRepositoriesByProjectOrOrgArgs defines the scope of a repository
+// listing. If ProjectOrOrgName is of the form "org/project", only the
+// repositories of that project are returned, otherwise all repositories of
+// the organization.
+type ListRepositoriesByProjectOrOrgArgs struct {
+	ProjectOrOrgName string
+}
+
+type Project struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	URL        string `json:"url"`
+	State      string `json:"state"`
+	Visibility string `json:"visibility"`
+}
+
+type Repository struct {
+	ID               string      `json:"id"`
+	Name             string      `json:"name"`
+	CloneURL         string      `json:"remoteUrl"`
+	APIURL           string      `json:"url"`
+	SSHURL           string      `json:"sshUrl"`
+	WebURL           string      `json:"webUrl"`
+	DefaultBranch    string      `json:"defaultBranch,omitempty"`
+	Size             int64       `json:"size"`
+	IsDisabled       bool        `json:"isDisabled"`
+	IsFork           bool        `json:"isFork"`
+	Project          Project     `json:"project"`
+	ParentRepository *Repository `json:"parentRepository,omitempty"`
+}
+
+// GetOrganization returns the name of the organization the repository belongs
+// to, which is the first path component of its API URL.
+func (r *Repository) GetOrganization() (string, error) {
+	u, err := url.Parse(r.APIURL)
+	if err != nil {
+		return "", err
+	}
+
+	org, _, _ := strings.Cut(strings.TrimPrefix(u.Path, "/"), "/")
+	if org == "" {
+		return "", errors.Errorf("unable to determine organization of repository %q from URL %q", r.Name, r.APIURL)
+	}
+	return org, nil
+}
+
+// NameWithOwner returns the "org/project/repo" triplet identifying the
+// repository.
+func (r *Repository) NameWithOwner() (string, error) {
+	org, err := r.GetOrganization()
+	if err != nil {
+		return "", err
+	}
+	return org + "/" + r.Project.Name + "/" + r.Name, nil
+}
+
+// Namespace returns the "org/project" pair the repository belongs to.
+func (r *Repository) Namespace() (string, error) {
+	org, err := r.GetOrganization()
+	if err != nil {
+		return "", err
+	}
+	return org + "/" + r.Project.Name, nil
+}
+
+type ForkRepositoryInput struct {
+	Name             string                              `json:"name"`
+	Project          ForkRepositoryInputProject          `json:"project"`
+	ParentRepository ForkRepositoryInputParentRepository `json:"parentRepository"`
+}
+
+type ForkRepositoryInputProject struct {
+	ID string `json:"id"`
+}
+
+type ForkRepositoryInputParentRepository struct {
+	ID      string                     `json:"id"`
+	Project ForkRepositoryInputProject `json:"project"`
+}
+
+type PullRequestStatus string
+
+const (
+	PullRequestStatusActive    PullRequestStatus = "active"
+	PullRequestStatusAbandoned PullRequestStatus = "abandoned"
+	PullRequestStatusCompleted PullRequestStatus = "completed"
+)
+
+type PullRequestMergeStrategy string
+
+const (
+	PullRequestMergeStrategySquash        PullRequestMergeStrategy = "squash"
+	PullRequestMergeStrategyNoFastForward PullRequestMergeStrategy = "noFastForward"
+	PullRequestMergeStrategyRebase        PullRequestMergeStrategy = "rebase"
+	PullRequestMergeStrategyRebaseMerge   PullRequestMergeStrategy = "rebaseMerge"
+)
+
+// PullRequestVote is the vote a reviewer cast on a pull request.
+type PullRequestVote int
+
+const (
+	PullRequestVoteApproved                PullRequestVote = 10
+	PullRequestVoteApprovedWithSuggestions PullRequestVote = 5
+	PullRequestVoteNoVote                  PullRequestVote = 0
+	PullRequestVoteWaitingForAuthor        PullRequestVote = -5
+	PullRequestVoteRejected                PullRequestVote = -10
+)
+
+type PullRequest struct {
+	ID                    int                `json:"pullRequestId"`
+	CodeReviewID          int                `json:"codeReviewId"`
+	Repository            Repository         `json:"repository"`
+	Status                PullRequestStatus  `json:"status"`
+	CreatedBy             Identity           `json:"createdBy"`
+	CreationDate          time.Time          `json:"creationDate"`
+	ClosedDate            *time.Time         `json:"closedDate,omitempty"`
+	Title                 string             `json:"title"`
+	Description           string             `json:"description"`
+	SourceRefName         string             `json:"sourceRefName"`
+	TargetRefName         string             `json:"targetRefName"`
+	MergeStatus           string             `json:"mergeStatus"`
+	IsDraft               bool               `json:"isDraft"`
+	MergeID               string             `json:"mergeId"`
+	LastMergeSourceCommit *PullRequestCommit `json:"lastMergeSourceCommit,omitempty"`
+	LastMergeTargetCommit *PullRequestCommit `json:"lastMergeTargetCommit,omitempty"`
+	Reviewers             []Reviewer         `json:"reviewers"`
+	Labels                []Label            `json:"labels,omitempty"`
+	URL                   string             `json:"url"`
+	ForkSource            *ForkRef           `json:"forkSource,omitempty"`
+}
+
+// WebURL returns the URL of the pull request in the Azure DevOps web
+// interface.
+func (pr *PullRequest) WebURL() string {
+	return strings.TrimSuffix(pr.Repository.WebURL, "/") + "/pullrequest/" + strconv.Itoa(pr.ID)
+}
+
+type PullRequestCommit struct {
+	CommitID string `json:"commitId"`
+	URL      string `json:"url,omitempty"`
+}
+
+type ForkRef struct {
+	Repository Repository `json:"repository"`
+}
+
+type Reviewer struct {
+	ID          string          `json:"id"`
+	DisplayName string          `json:"displayName"`
+	UniqueName  string          `json:"uniqueName"`
+	Vote        PullRequestVote `json:"vote"`
+	HasDeclined bool            `json:"hasDeclined"`
+	IsRequired  bool            `json:"isRequired"`
+	IsFlagged   bool            `json:"isFlagged"`
+}
+
+type Label struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Active bool   `json:"active"`
+}
+
+type Identity struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	UniqueName  string `json:"uniqueName"`
+	Descriptor  string `json:"descriptor,omitempty"`
+}
+
+type CreatePullRequestInput struct {
+	SourceRefName string        `json:"sourceRefName"`
+	TargetRefName string        `json:"targetRefName"`
+	Title         string        `json:"title"`
+	Description   string        `json:"description"`
+	IsDraft       bool          `json:"isDraft"`
+	ForkSource    *ForkRefInput `json:"forkSource,omitempty"`
+}
+
+// ListPullRequestsCriteria filters the pull requests returned by
+// ListPullRequests. Empty fields don't filter the pull requests.
+type ListPullRequestsCriteria struct {
+	Status        PullRequestStatus
+	SourceRefName string
+	TargetRefName string
+	// SourceRepositoryID is the ID of the repository the source branch is in,
+	// which is a fork for pull requests from forks.
+	SourceRepositoryID string
+}
+
+type ForkRefInput struct {
+	Repository ForkRefInputRepository `json:"repository"`
+}
+
+type ForkRefInputRepository struct {
+	ID string `json:"id"`
+}
+
+// PullRequestUpdateInput is the set of fields that can be updated on a pull
+// request. Fields that are nil are left untouched.
+type PullRequestUpdateInput struct {
+	Title         *string            `json:"title,omitempty"`
+	Description   *string            `json:"description,omitempty"`
+	TargetRefName *string            `json:"targetRefName,omitempty"`
+	IsDraft       *bool              `json:"isDraft,omitempty"`
+	Status        *PullRequestStatus `json:"status,omitempty"`
+}
+
+type PullRequestCompleteInput struct {
+	CommitID      string
+	MergeStrategy *PullRequestMergeStrategy
+}
+
+type CompletionOptions struct {
+	MergeStrategy      PullRequestMergeStrategy `json:"mergeStrategy,omitempty"`
+	DeleteSourceBranch bool                     `json:"deleteSourceBranch,omitempty"`
+}
+
+type PullRequestCommentInput struct {
+	Comments []PullRequestCommentForInput `json:"comments"`
+}
+
+type PullRequestCommentForInput struct {
+	ParentCommentID int    `json:"parentCommentId"`
+	Content         string `json:"content"`
+	CommentType     int    `json:"commentType"`
+}
+
+type PullRequestCommentResponse struct {
+	ID       int                  `json:"id"`
+	Comments []PullRequestComment `json:"comments"`
+}
+
+type PullRequestComment struct {
+	ID              int       `json:"id"`
+	ParentCommentID int       `json:"parentCommentId"`
+	Author          Identity  `json:"author"`
+	Content         string    `json:"content"`
+	PublishedDate   time.Time `json:"publishedDate"`
+	CommentType     string    `json:"commentType"`
+}
+
+type PullRequestBuildStatusState string
+
+const (
+	PullRequestBuildStatusStateSucceeded     PullRequestBuildStatusState = "succeeded"
+	PullRequestBuildStatusStateError         PullRequestBuildStatusState = "error"
+	PullRequestBuildStatusStateFailed        PullRequestBuildStatusState = "failed"
+	PullRequestBuildStatusStatePending       PullRequestBuildStatusState = "pending"
+	PullRequestBuildStatusStateNotApplicable PullRequestBuildStatusState = "notApplicable"
+	PullRequestBuildStatusStateNotSet        PullRequestBuildStatusState = "notSet"
+)
+
+type PullRequestBuildStatus struct {
+	ID           int                           `json:"id"`
+	State        PullRequestBuildStatusState   `json:"state"`
+	Description  string                        `json:"description"`
+	CreationDate time.Time                     `json:"creationDate"`
+	UpdatedDate  time.Time                     `json:"updatedDate"`
+	CreatedBy    Identity                      `json:"createdBy"`
+	Context      PullRequestBuildStatusContext `json:"context"`
+	TargetURL    string                        `json:"targetUrl,omitempty"`
+}
+
+// Key returns a key that uniquely identifies the status within its pull
+// request.
+func (s *PullRequestBuildStatus) Key() string {
+	return strconv.Itoa(s.ID)
+}
+
+type PullRequestBuildStatusContext struct {
+	Name  string `json:"name"`
+	Genre string `json:"genre"`
+}
+
+// IdentityDetails is an identity as returned by the identities API, which
+// exposes additional properties such as the email address.
+type IdentityDetails struct {
+	ID                  string                      `json:"id"`
+	Descriptor          string                      `json:"descriptor"`
+	SubjectDescriptor   string                      `json:"subjectDescriptor"`
+	ProviderDisplayName string                      `json:"providerDisplayName"`
+	IsActive            bool                        `json:"isActive"`
+	Properties          map[string]IdentityProperty `json:"properties"`
+}
+
+// Email returns the email address of the identity, if known.
+func (i IdentityDetails) Email() string {
+	return i.Properties["Mail"].Value
+}
+
+// Account returns the account name of the identity, if known.
+func (i IdentityDetails) Account() string {
+	return i.Properties["Account"].Value
+}
+
+type IdentityProperty struct {
+	Type  string `json:"$type"`
+	Value string `json:"$value"`
+}
+
+type Profile struct {
+	ID           string `json:"id"`
+	DisplayName  string `json:"displayName"`
+	PublicAlias  string `json:"publicAlias"`
+	EmailAddress string `json:"emailAddress"`
+}
+
+// UserEntitlement describes the access level and project memberships of a
+// user in an organization.
+type UserEntitlement struct {
+	ID                  string               `json:"id"`
+	User                GraphUser            `json:"user"`
+	ProjectEntitlements []ProjectEntitlement `json:"projectEntitlements"`
+}
+
+type GraphUser struct {
+	PrincipalName string `json:"principalName"`
+	MailAddress   string `json:"mailAddress"`
+	DisplayName   string `json:"displayName"`
+	Descriptor    string `json:"descriptor"`
+}
+
+type ProjectEntitlement struct {
+	ProjectRef ProjectRef `json:"projectRef"`
+	Group      Group      `json:"group"`
+}
+
+type ProjectRef struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type Group struct {
+	DisplayName string `json:"displayName"`
+	GroupType   string `json:"groupType"`
+}
+
+type listResponse[T any] struct {
+	Count int `json:"count"`
+	Value []T `json:"value"`
+}
@@ -0,0 +1,43 @@
+package azuredevops
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"path"
+)
+
+// GetAuthorizedProfile returns the profile of the authenticated user.
+func (c *client) GetAuthorizedProfile(ctx context.Context) (Profile, error) {
+	// Profiles are not scoped to an organization and are served by the
+	// global profile service.
+	var p Profile
+	if _, err := c.do(ctx, c.serviceURL("vssps"), http.MethodGet, "_apis/profile/profiles/me", nil, nil, &p); err != nil {
+		return Profile{}, err
+	}
+	return p, nil
+}
+
+// ListIdentitiesByEmail returns the identities of the organization whose
+// email address matches the given one.
+func (c *client) ListIdentitiesByEmail(ctx context.Context, org, email string) ([]IdentityDetails, error) {
+	qs := make(url.Values)
+	qs.Set("searchFilter", "MailAddress")
+	qs.Set("filterValue", email)
+
+	var resp listResponse[IdentityDetails]
+	if _, err := c.do(ctx, c.serviceURL("vssps"), http.MethodGet, path.Join(org, "_apis/identities"), qs, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Value, nil
+}
+
+// GetUserEntitlement returns the entitlements, including the project
+// memberships, of a user in the organization.
+func (c *client) GetUserEntitlement(ctx context.Context, org, userID string) (UserEntitlement, error) {
+	var e UserEntitlement
+	if _, err := c.do(ctx, c.serviceURL("vsaex"), http.MethodGet, path.Join(org, "_apis/userentitlements", userID), nil, nil, &e); err != nil {
+		return UserEntitlement{}, err
+	}
+	return e, nil
+}
@@ -0,0 +1,38 @@
+package azuredevops
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_GetAuthorizedProfile(t *testing.T) {
+	cli := newTestClient(t)
+
+	profile, err := cli.GetAuthorizedProfile(context.Background())
+	assert.Nil(t, err)
+
+	assertGolden(t, profile)
+}
+
+func TestClient_ListIdentitiesByEmail(t *testing.T) {
+	cli := newTestClient(t)
+
+	identities, err := cli.ListIdentitiesByEmail(context.Background(), "sgtestazure", "test@sourcegraph.com")
+	assert.Nil(t, err)
+	if assert.Len(t, identities, 1) {
+		assert.Equal(t, "test@sourcegraph.com", identities[0].Email())
+	}
+
+	assertGolden(t, identities)
+}
+
+func TestClient_GetUserEntitlement(t *testing.T) {
+	cli := newTestClient(t)
+
+	entitlement, err := cli.GetUserEntitlement(context.Background(), "sgtestazure", "0c5e9f7e-2f1a-4b3c-8d9e-5f6a7b8c9d0e")
+	assert.Nil(t, err)
+
+	assertGolden(t, entitlement)
+}
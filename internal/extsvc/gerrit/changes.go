@@ -0,0 +1,255 @@
+package gerrit
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ChangeStatus is the status of a change.
+type ChangeStatus string
+
+const (
+	ChangeStatusNew       ChangeStatus = "NEW"
+	ChangeStatusMerged    ChangeStatus = "MERGED"
+	ChangeStatusAbandoned ChangeStatus = "ABANDONED"
+)
+
+const (
+	// CodeReviewLabel is the name of the label reviewers vote on to approve
+	// or reject a change.
+	CodeReviewLabel = "Code-Review"
+	// VerifiedLabel is the name of the label CI systems vote on to report the
+	// result of their checks.
+	VerifiedLabel = "Verified"
+)
+
+// Change is a change as returned by the changes API, see
+// https://gerrit-review.googlesource.com/Documentation/rest-api-changes.html#change-info.
+type Change struct {
+	ID              string               `json:"id"`
+	Project         string               `json:"project"`
+	Branch          string               `json:"branch"`
+	Topic           string               `json:"topic,omitempty"`
+	ChangeID        string               `json:"change_id"`
+	Subject         string               `json:"subject"`
+	Status          ChangeStatus         `json:"status"`
+	Created         Timestamp            `json:"created"`
+	Updated         Timestamp            `json:"updated"`
+	Submitted       *Timestamp           `json:"submitted,omitempty"`
+	Mergeable       bool                 `json:"mergeable,omitempty"`
+	Insertions      int                  `json:"insertions"`
+	Deletions       int                  `json:"deletions"`
+	Number          int                  `json:"_number"`
+	Owner           Account              `json:"owner"`
+	WorkInProgress  bool                 `json:"work_in_progress,omitempty"`
+	Labels          map[string]LabelInfo `json:"labels,omitempty"`
+	CurrentRevision string               `json:"current_revision,omitempty"`
+	Revisions       map[string]Revision  `json:"revisions,omitempty"`
+}
+
+// CurrentRevisionInfo returns the current revision (patch set) of the change,
+// if it was requested.
+func (c *Change) CurrentRevisionInfo() (Revision, bool) {
+	r, ok := c.Revisions[c.CurrentRevision]
+	return r, ok
+}
+
+// LabelInfo describes the votes on a label of a change.
+type LabelInfo struct {
+	Approved     *Account          `json:"approved,omitempty"`
+	Rejected     *Account          `json:"rejected,omitempty"`
+	Recommended  *Account          `json:"recommended,omitempty"`
+	Disliked     *Account          `json:"disliked,omitempty"`
+	Optional     bool              `json:"optional,omitempty"`
+	All          []ApprovalInfo    `json:"all,omitempty"`
+	Values       map[string]string `json:"values,omitempty"`
+	DefaultValue int               `json:"default_value"`
+}
+
+// ApprovalInfo is the vote of a single account on a label.
+type ApprovalInfo struct {
+	Account
+	Value int        `json:"value"`
+	Date  *Timestamp `json:"date,omitempty"`
+}
+
+// Key returns a key that uniquely identifies the vote within its label.
+func (a *ApprovalInfo) Key() string {
+	return strconv.Itoa(int(a.ID))
+}
+
+// Revision is a patch set of a change.
+type Revision struct {
+	Number int     `json:"_number"`
+	Ref    string  `json:"ref"`
+	Commit *Commit `json:"commit,omitempty"`
+}
+
+type Commit struct {
+	Parents []CommitParent `json:"parents"`
+	Subject string         `json:"subject"`
+	Message string         `json:"message"`
+}
+
+type CommitParent struct {
+	Commit  string `json:"commit"`
+	Subject string `json:"subject"`
+}
+
+type MoveChangeInput struct {
+	DestinationBranch string `json:"destination_branch"`
+}
+
+type CommitMessageInput struct {
+	Message string `json:"message"`
+}
+
+type ReviewInput struct {
+	Message string `json:"message"`
+}
+
+// Timestamp is a point in time as represented by the Gerrit REST API, which
+// uses the UTC time zone and nanosecond precision without a zone offset.
+type Timestamp struct {
+	time.Time
+}
+
+const timestampLayout = "2006-01-02 15:04:05.000000000"
+
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(t.UTC().Format(timestampLayout))), nil
+}
+
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	s, err := strconv.Unquote(string(data))
+	if err != nil {
+		return err
+	}
+	parsed, err := time.Parse(timestampLayout, s)
+	if err != nil {
+		return err
+	}
+	t.Time = parsed
+	return nil
+}
+
+// changeOptions are the additional fields we request whenever we retrieve a
+// change, so that we get the votes on its labels and its current patch set.
+var changeOptions = []string{"DETAILED_LABELS", "DETAILED_ACCOUNTS", "CURRENT_REVISION", "CURRENT_COMMIT"}
+
+// GetChange retrieves the change with the given ID, which can either be the
+// Change-Id, the numeric ID, or the "project~branch~Change-Id" triplet.
+func (c *client) GetChange(ctx context.Context, changeID string) (*Change, error) {
+	q := make(url.Values)
+	for _, o := range changeOptions {
+		q.Add("o", o)
+	}
+	req, err := http.NewRequest("GET", changePath(changeID)+"?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var change Change
+	if _, err = c.do(ctx, req, &change); err != nil {
+		return nil, err
+	}
+	return &change, nil
+}
+
+// AbandonChange abandons the change with the given ID.
+func (c *client) AbandonChange(ctx context.Context, changeID string) (*Change, error) {
+	return c.postChangeAction(ctx, changeID, "abandon", nil)
+}
+
+// RestoreChange restores the abandoned change with the given ID.
+func (c *client) RestoreChange(ctx context.Context, changeID string) (*Change, error) {
+	return c.postChangeAction(ctx, changeID, "restore", nil)
+}
+
+// SubmitChange submits the change with the given ID, which merges it into its
+// destination branch using the submit type configured for the project.
+func (c *client) SubmitChange(ctx context.Context, changeID string) (*Change, error) {
+	return c.postChangeAction(ctx, changeID, "submit", nil)
+}
+
+// MoveChange moves the change with the given ID to another destination
+// branch.
+func (c *client) MoveChange(ctx context.Context, changeID string, input MoveChangeInput) (*Change, error) {
+	return c.postChangeAction(ctx, changeID, "move", input)
+}
+
+// SetCommitMessage replaces the commit message of the current patch set of
+// the change with the given ID, creating a new patch set.
+func (c *client) SetCommitMessage(ctx context.Context, changeID string, input CommitMessageInput) error {
+	req, err := newJSONRequest("PUT", changePath(changeID)+"/message", input)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(ctx, req, nil)
+	return err
+}
+
+// SetWIP marks the change with the given ID as work in progress.
+func (c *client) SetWIP(ctx context.Context, changeID string) error {
+	req, err := http.NewRequest("POST", changePath(changeID)+"/wip", nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(ctx, req, nil)
+	return err
+}
+
+// SetReadyForReview marks the change with the given ID as ready for review.
+func (c *client) SetReadyForReview(ctx context.Context, changeID string) error {
+	req, err := http.NewRequest("POST", changePath(changeID)+"/ready", nil)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(ctx, req, nil)
+	return err
+}
+
+// WriteReviewComment posts a review with the given message on the current
+// patch set of the change with the given ID.
+func (c *client) WriteReviewComment(ctx context.Context, changeID string, input ReviewInput) error {
+	req, err := newJSONRequest("POST", changePath(changeID)+"/revisions/current/review", input)
+	if err != nil {
+		return err
+	}
+	_, err = c.do(ctx, req, nil)
+	return err
+}
+
+func (c *client) postChangeAction(ctx context.Context, changeID, action string, input any) (*Change, error) {
+	var (
+		req *http.Request
+		err error
+	)
+	if input != nil {
+		req, err = newJSONRequest("POST", changePath(changeID)+"/"+action, input)
+	} else {
+		req, err = http.NewRequest("POST", changePath(changeID)+"/"+action, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var change Change
+	if _, err = c.do(ctx, req, &change); err != nil {
+		return nil, err
+	}
+	return &change, nil
+}
+
+func changePath(changeID string) string {
+	return "a/changes/" + url.PathEscape(changeID)
+}
+
+// ChangeURL returns the URL of the change in the Gerrit web interface.
+func ChangeURL(baseURL *url.URL, change *Change) string {
+	return strings.TrimSuffix(baseURL.String(), "/") + "/c/" + change.Project + "/+/" + strconv.Itoa(change.Number)
+}
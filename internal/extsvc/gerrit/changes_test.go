@@ -0,0 +1,29 @@
+package gerrit
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimestamp(t *testing.T) {
+	var change Change
+	if err := json.Unmarshal([]byte(`{"created": "2022-11-08 09:58:13.000000000", "updated": "2022-11-09 10:01:02.123000000"}`), &change); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := time.Date(2022, 11, 8, 9, 58, 13, 0, time.UTC); !change.Created.Equal(want) {
+		t.Errorf("unexpected created time: have %s; want %s", change.Created, want)
+	}
+	if want := time.Date(2022, 11, 9, 10, 1, 2, 123000000, time.UTC); !change.Updated.Equal(want) {
+		t.Errorf("unexpected updated time: have %s; want %s", change.Updated, want)
+	}
+
+	data, err := json.Marshal(change.Updated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have, want := string(data), `"2022-11-09 10:01:02.123000000"`; have != want {
+		t.Errorf("unexpected marshalled timestamp: have %s; want %s", have, want)
+	}
+}
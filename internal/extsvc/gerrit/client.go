@@ -2,30 +2,55 @@
 package gerrit
 
 import (
+	"bytes"
 	"context"
-	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
 
 // Client access a Gerrit via the REST API.
-type Client struct {
+type Client interface {
+	Authenticator() auth.Authenticator
+	WithAuthenticator(a auth.Authenticator) Client
+	GetURL() *url.URL
+
+	GetAuthenticatedUserAccount(ctx context.Context) (*Account, error)
+	ListAccountsByEmail(ctx context.Context, email string) (ListAccountsResponse, error)
+	ListAccountsByUsername(ctx context.Context, username string) (ListAccountsResponse, error)
+	GetGroup(ctx context.Context, groupName string) (Group, error)
+	ListProjects(ctx context.Context, opts ListProjectsArgs) (projects *ListProjectsResponse, nextPage bool, err error)
+
+	GetChange(ctx context.Context, changeID string) (*Change, error)
+	AbandonChange(ctx context.Context, changeID string) (*Change, error)
+	RestoreChange(ctx context.Context, changeID string) (*Change, error)
+	SubmitChange(ctx context.Context, changeID string) (*Change, error)
+	MoveChange(ctx context.Context, changeID string, input MoveChangeInput) (*Change, error)
+	SetCommitMessage(ctx context.Context, changeID string, input CommitMessageInput) error
+	SetWIP(ctx context.Context, changeID string) error
+	SetReadyForReview(ctx context.Context, changeID string) error
+	WriteReviewComment(ctx context.Context, changeID string, input ReviewInput) error
+}
+
+// client access a Gerrit via the REST API.
+type client struct {
 	// HTTP Client used to communicate with the API
 	httpClient httpcli.Doer
 
-	// Config is the code host connection config for this client
-	Config *schema.GerritConnection
-
 	// URL is the base URL of Gerrit.
 	URL *url.URL
 
+	// Auth is the authentication method used when accessing the server. Only
+	// auth.BasicAuth is currently supported.
+	Auth auth.Authenticator
+
 	// RateLimit is the self-imposed rate limiter (since Gerrit does not have a concept
 	// of rate limiting in HTTP response headers).
 	rateLimit *ratelimit.InstrumentedLimiter
@@ -34,7 +59,7 @@ type Client struct {
 // NewClient returns an authenticated Gerrit API client with
 // the provided configuration. If a nil httpClient is provided, http.DefaultClient
 // will be used.
-func NewClient(urn string, config *schema.GerritConnection, httpClient httpcli.Doer) (*Client, error) {
+func NewClient(urn string, config *schema.GerritConnection, httpClient httpcli.Doer) (Client, error) {
 	u, err := url.Parse(config.Url)
 	if err != nil {
 		return nil, err
@@ -44,29 +69,73 @@ func NewClient(urn string, config *schema.GerritConnection, httpClient httpcli.D
 		httpClient = httpcli.ExternalDoer
 	}
 
-	return &Client{
+	return &client{
 		httpClient: httpClient,
-		Config:     config,
 		URL:        u,
-		rateLimit:  ratelimit.DefaultRegistry.Get(urn),
+		Auth: &auth.BasicAuth{
+			Username: config.Username,
+			Password: config.Password,
+		},
+		rateLimit: ratelimit.DefaultRegistry.Get(urn),
 	}, nil
 }
 
+func (c *client) Authenticator() auth.Authenticator {
+	return c.Auth
+}
+
+// WithAuthenticator returns a new Client that uses the same configuration,
+// HTTPClient, and RateLimiter as the current Client, except authenticated with
+// the given authenticator instance.
+//
+// Note that using an unsupported Authenticator implementation may result in
+// unexpected behaviour, or (more likely) errors. At present, only BasicAuth is
+// supported.
+func (c *client) WithAuthenticator(a auth.Authenticator) Client {
+	return &client{
+		httpClient: c.httpClient,
+		URL:        c.URL,
+		Auth:       a,
+		rateLimit:  c.rateLimit,
+	}
+}
+
+// GetURL returns the base URL of the Gerrit instance.
+func (c *client) GetURL() *url.URL {
+	return c.URL
+}
+
+// GetAuthenticatedUserAccount returns the account of the currently
+// authenticated user, thereby validating that the current authenticator is
+// valid.
+func (c *client) GetAuthenticatedUserAccount(ctx context.Context) (*Account, error) {
+	req, err := http.NewRequest("GET", "a/accounts/self", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var account Account
+	if _, err = c.do(ctx, req, &account); err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
 type ListAccountsResponse []Account
 
-func (c *Client) ListAccountsByEmail(ctx context.Context, email string) (ListAccountsResponse, error) {
+func (c *client) ListAccountsByEmail(ctx context.Context, email string) (ListAccountsResponse, error) {
 	qsAccounts := make(url.Values)
 	qsAccounts.Set("q", fmt.Sprintf("email:%s", email)) // TODO: what query should we run?
 	return c.listAccounts(ctx, qsAccounts)
 }
 
-func (c *Client) ListAccountsByUsername(ctx context.Context, username string) (ListAccountsResponse, error) {
+func (c *client) ListAccountsByUsername(ctx context.Context, username string) (ListAccountsResponse, error) {
 	qsAccounts := make(url.Values)
 	qsAccounts.Set("q", fmt.Sprintf("username:%s", username)) // TODO: what query should we run?
 	return c.listAccounts(ctx, qsAccounts)
 }
 
-func (c *Client) listAccounts(ctx context.Context, qsAccounts url.Values) (ListAccountsResponse, error) {
+func (c *client) listAccounts(ctx context.Context, qsAccounts url.Values) (ListAccountsResponse, error) {
 	qsAccounts.Set("o", "details")
 
 	urlPath := "a/accounts/"
@@ -85,7 +154,7 @@ func (c *Client) listAccounts(ctx context.Context, qsAccounts url.Values) (ListA
 	return respAllAccts, nil
 }
 
-func (c *Client) GetGroup(ctx context.Context, groupName string) (Group, error) {
+func (c *client) GetGroup(ctx context.Context, groupName string) (Group, error) {
 
 	urlGroup := url.URL{Path: fmt.Sprintf("a/groups/%s", groupName)}
 
@@ -110,7 +179,7 @@ type ListProjectsArgs struct {
 // ListProjectsResponse defines a response struct returned from ListProjects method calls.
 type ListProjectsResponse map[string]*Project
 
-func (c *Client) ListProjects(ctx context.Context, opts ListProjectsArgs) (projects *ListProjectsResponse, nextPage bool, err error) {
+func (c *client) ListProjects(ctx context.Context, opts ListProjectsArgs) (projects *ListProjectsResponse, nextPage bool, err error) {
 
 	// Unfortunately Gerrit APIs are quite limited and don't support pagination well.
 	// Currently, if you want to only get CODE projects and want to know if there is another page
@@ -167,11 +236,17 @@ func (c *Client) ListProjects(ctx context.Context, opts ListProjectsArgs) (proje
 }
 
 // nolint:unparam
-func (c *Client) do(ctx context.Context, req *http.Request, result any) (*http.Response, error) {
+func (c *client) do(ctx context.Context, req *http.Request, result any) (*http.Response, error) {
 	req.URL = c.URL.ResolveReference(req.URL)
 
+	if req.Body != nil {
+		req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	}
+
 	// Add Basic Auth headers for authenticated requests.
-	req.Header.Add("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(c.Config.Username+":"+c.Config.Password)))
+	if err := c.Auth.Authenticate(req); err != nil {
+		return nil, err
+	}
 
 	if err := c.rateLimit.Wait(ctx); err != nil {
 		return nil, err
@@ -199,6 +274,12 @@ func (c *Client) do(ctx context.Context, req *http.Request, result any) (*http.R
 		}
 	}
 
+	// Some endpoints, such as the ones toggling the work-in-progress state of
+	// a change, don't return a body.
+	if result == nil {
+		return resp, nil
+	}
+
 	// The first 4 characters of the Gerrit API responses need to be stripped, see: https://gerrit-review.googlesource.com/Documentation/rest-api.html#output .
 	if len(bs) < 4 {
 		return nil, &httpError{
@@ -210,6 +291,16 @@ func (c *Client) do(ctx context.Context, req *http.Request, result any) (*http.R
 	return resp, json.Unmarshal(bs[4:], result)
 }
 
+// newJSONRequest creates a request with the given input marshalled as its JSON
+// body.
+func newJSONRequest(method, urlPath string, input any) (*http.Request, error) {
+	body, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+	return http.NewRequest(method, urlPath, bytes.NewReader(body))
+}
+
 type Account struct {
 	ID          int32  `json:"_account_id"`
 	Name        string `json:"name"`
@@ -0,0 +1,102 @@
+// Package nuget
+//
+// A client for the package content (flat container) resource of NuGet V3
+// feeds, as described in
+// https://learn.microsoft.com/en-us/nuget/api/package-base-address-resource.
+package nuget
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// DefaultRepositoryURL is the package content resource of nuget.org.
+const DefaultRepositoryURL = "https://api.nuget.org/v3-flatcontainer/"
+
+type Client struct {
+	// The base URL of the package content resource, for example
+	// https://api.nuget.org/v3-flatcontainer/.
+	repositoryURL string
+
+	cli httpcli.Doer
+
+	// Self-imposed rate-limiter.
+	limiter *ratelimit.InstrumentedLimiter
+}
+
+func NewClient(urn string, repositoryURL string, cli httpcli.Doer) *Client {
+	if repositoryURL == "" {
+		repositoryURL = DefaultRepositoryURL
+	}
+
+	return &Client{
+		repositoryURL: repositoryURL,
+		cli:           cli,
+		limiter:       ratelimit.DefaultRegistry.Get(urn),
+	}
+}
+
+// GetPackageContents downloads the .nupkg archive of the given package version.
+// The package content resource expects lowercase package IDs and versions.
+func (c *Client) GetPackageContents(ctx context.Context, dep reposource.VersionedPackage) (body io.ReadCloser, url string, err error) {
+	id := strings.ToLower(string(dep.PackageSyntax()))
+	version := strings.ToLower(dep.PackageVersion())
+	url = fmt.Sprintf("%s/%s/%s/%s.%s.nupkg", strings.TrimSuffix(c.repositoryURL, "/"), id, version, id, version)
+
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, url, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, url, err
+	}
+	req.Header.Add("User-Agent", "sourcegraph-nuget-syncer (sourcegraph.com)")
+
+	body, err = c.do(req)
+	if err != nil {
+		return nil, url, err
+	}
+	return body, url, nil
+}
+
+type Error struct {
+	path    string
+	code    int
+	message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("bad response with status code %d for %s: %s", e.code, e.path, e.message)
+}
+
+func (e *Error) NotFound() bool {
+	return e.code == http.StatusNotFound
+}
+
+func (c *Client) do(req *http.Request) (io.ReadCloser, error) {
+	resp, err := c.cli.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+
+		bs, err := io.ReadAll(resp.Body)
+		if err != nil {
+			bs = []byte(errors.Wrap(err, "failed to read body").Error())
+		}
+		return nil, &Error{path: req.URL.Path, code: resp.StatusCode, message: string(bs)}
+	}
+
+	return resp.Body, nil
+}
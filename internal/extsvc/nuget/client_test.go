@@ -0,0 +1,80 @@
+package nuget
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/httptestutil"
+	"github.com/sourcegraph/sourcegraph/internal/unpack"
+)
+
+var updateRecordings = flag.Bool("update", false, "make nuget.org API calls, record and save data")
+
+func newTestClient(t *testing.T) *Client {
+	t.Helper()
+
+	cassette := filepath.Join("testdata/vcr", strings.ReplaceAll(t.Name(), " ", "-")+".yaml")
+	if _, err := os.Stat(cassette); os.IsNotExist(err) && !*updateRecordings {
+		t.Skipf("no recording at %s, run the test with -update to record one", cassette)
+	}
+
+	recorderFactory, stop := httptestutil.NewRecorderFactory(t, *updateRecordings, t.Name())
+	t.Cleanup(stop)
+
+	doer, err := recorderFactory.Doer()
+	require.Nil(t, err)
+
+	return NewClient("nuget_urn", DefaultRepositoryURL, doer)
+}
+
+func TestGetPackageContents(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	dep, err := reposource.ParseNuGetVersionedPackage("System.Buffers@4.5.1")
+	require.Nil(t, err)
+	readCloser, url, err := client.GetPackageContents(ctx, dep)
+	require.Nil(t, err)
+	defer readCloser.Close()
+	require.Equal(t, "https://api.nuget.org/v3-flatcontainer/system.buffers/4.5.1/system.buffers.4.5.1.nupkg", url)
+
+	pkg, err := io.ReadAll(readCloser)
+	require.Nil(t, err)
+
+	tmpDir := t.TempDir()
+	err = unpack.Zip(bytes.NewReader(pkg), int64(len(pkg)), tmpDir, unpack.Opts{})
+	require.Nil(t, err)
+
+	var files []string
+	err = filepath.WalkDir(tmpDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(tmpDir, path)
+		files = append(files, strings.ToLower(filepath.ToSlash(rel)))
+		return err
+	})
+	require.Nil(t, err)
+
+	require.Contains(t, files, "system.buffers.nuspec")
+	require.Contains(t, files, "lib/netstandard2.0/system.buffers.dll")
+}
+
+func TestGetPackageContents_NotFound(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	_, _, err := client.GetPackageContents(ctx, reposource.NewNuGetVersionedPackage("Does.Not.Exist", "1.0.0"))
+	require.True(t, errcode.IsNotFound(err), "%v", err)
+}
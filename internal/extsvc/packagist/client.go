@@ -0,0 +1,207 @@
+// Package packagist
+//
+// A client for Composer repositories such as Packagist, using the metadata
+// endpoint of the Composer v2 repository API as described in
+// https://packagist.org/apidoc#get-package-metadata-v2.
+//
+// Nomenclature:
+//
+// A "package" is a Composer package of the form vendor/package, like
+// monolog/monolog. Each version of a package has a "dist", an archive of the
+// package's sources, which is usually a zipball of the tagged commit hosted by
+// the package's VCS host.
+package packagist
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// DefaultRepositoryURL is the URL of the Packagist Composer repository.
+const DefaultRepositoryURL = "https://repo.packagist.org/"
+
+type Client struct {
+	// The base URL of the Composer repository, for example https://repo.packagist.org/.
+	repositoryURL string
+
+	cli httpcli.Doer
+
+	// Self-imposed rate-limiter.
+	limiter *ratelimit.InstrumentedLimiter
+}
+
+func NewClient(urn string, repositoryURL string, cli httpcli.Doer) *Client {
+	if repositoryURL == "" {
+		repositoryURL = DefaultRepositoryURL
+	}
+
+	return &Client{
+		repositoryURL: repositoryURL,
+		cli:           cli,
+		limiter:       ratelimit.DefaultRegistry.Get(urn),
+	}
+}
+
+// Version is a version of a package in the metadata of a Composer repository.
+type Version struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Version     string `json:"version"`
+	Dist        *Dist  `json:"dist"`
+}
+
+// Dist is the archive of the sources of a package version.
+type Dist struct {
+	Type      string `json:"type"`
+	URL       string `json:"url"`
+	Reference string `json:"reference"`
+	Shasum    string `json:"shasum"`
+}
+
+// Versions returns the tagged versions of the given package, newest first.
+func (c *Client) Versions(ctx context.Context, name reposource.PackageName) ([]*Version, error) {
+	url := fmt.Sprintf("%s/p2/%s.json", strings.TrimSuffix(c.repositoryURL, "/"), name)
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, errors.Wrap(err, "Packagist")
+	}
+	defer body.Close()
+
+	var metadata struct {
+		Packages map[string][]map[string]json.RawMessage `json:"packages"`
+		Minified string                                  `json:"minified"`
+	}
+	if err := json.NewDecoder(body).Decode(&metadata); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode metadata of package %q", name)
+	}
+
+	entries := metadata.Packages[string(name)]
+	if metadata.Minified != "" {
+		entries = expand(entries)
+	}
+
+	versions := make([]*Version, 0, len(entries))
+	for _, entry := range entries {
+		raw, err := json.Marshal(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		var v Version
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode version of package %q", name)
+		}
+		versions = append(versions, &v)
+	}
+
+	return versions, nil
+}
+
+// Version returns the given version of a package. Versions are matched with and
+// without a leading "v", because tags of PHP packages commonly include it.
+func (c *Client) Version(ctx context.Context, name reposource.PackageName, version string) (*Version, error) {
+	versions, err := c.Versions(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range versions {
+		if strings.TrimPrefix(v.Version, "v") == strings.TrimPrefix(version, "v") {
+			return v, nil
+		}
+	}
+
+	return nil, &Error{path: string(name), code: http.StatusNotFound, message: fmt.Sprintf("version %q not found", version)}
+}
+
+// Download downloads the dist archive at the given URL.
+func (c *Client) Download(ctx context.Context, url string) (io.ReadCloser, error) {
+	return c.get(ctx, url)
+}
+
+// unset is the value used by minified metadata to remove a field inherited from
+// the previous version.
+var unset = []byte(`"__unset"`)
+
+// expand expands versions in the minified metadata format, in which each version
+// only lists the fields that changed compared to the version before it.
+func expand(minified []map[string]json.RawMessage) []map[string]json.RawMessage {
+	expanded := make([]map[string]json.RawMessage, 0, len(minified))
+
+	var previous map[string]json.RawMessage
+	for _, entry := range minified {
+		current := make(map[string]json.RawMessage, len(previous)+len(entry))
+		for k, v := range previous {
+			current[k] = v
+		}
+		for k, v := range entry {
+			if bytes.Equal(v, unset) {
+				delete(current, k)
+			} else {
+				current[k] = v
+			}
+		}
+
+		expanded = append(expanded, current)
+		previous = current
+	}
+
+	return expanded
+}
+
+func (c *Client) get(ctx context.Context, url string) (io.ReadCloser, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("User-Agent", "sourcegraph-packagist-syncer (sourcegraph.com)")
+
+	return c.do(req)
+}
+
+type Error struct {
+	path    string
+	code    int
+	message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("bad response with status code %d for %s: %s", e.code, e.path, e.message)
+}
+
+func (e *Error) NotFound() bool {
+	return e.code == http.StatusNotFound
+}
+
+func (c *Client) do(req *http.Request) (io.ReadCloser, error) {
+	resp, err := c.cli.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+
+		bs, err := io.ReadAll(resp.Body)
+		if err != nil {
+			bs = []byte(errors.Wrap(err, "failed to read body").Error())
+		}
+		return nil, &Error{path: req.URL.Path, code: resp.StatusCode, message: string(bs)}
+	}
+
+	return resp.Body, nil
+}
@@ -0,0 +1,115 @@
+package packagist
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/httptestutil"
+	"github.com/sourcegraph/sourcegraph/internal/unpack"
+)
+
+var updateRecordings = flag.Bool("update", false, "make Packagist API calls, record and save data")
+
+func newTestClient(t *testing.T) *Client {
+	t.Helper()
+
+	cassette := filepath.Join("testdata/vcr", strings.ReplaceAll(t.Name(), " ", "-")+".yaml")
+	if _, err := os.Stat(cassette); os.IsNotExist(err) && !*updateRecordings {
+		t.Skipf("no recording at %s, run the test with -update to record one", cassette)
+	}
+
+	recorderFactory, stop := httptestutil.NewRecorderFactory(t, *updateRecordings, t.Name())
+	t.Cleanup(stop)
+
+	doer, err := recorderFactory.Doer()
+	require.Nil(t, err)
+
+	return NewClient("packagist_urn", DefaultRepositoryURL, doer)
+}
+
+func TestVersions(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	versions, err := client.Versions(ctx, "psr/log")
+	require.Nil(t, err)
+	require.NotEmpty(t, versions)
+
+	// Versions after the first one inherit unchanged fields in the minified metadata.
+	for _, v := range versions {
+		require.Equal(t, "psr/log", v.Name)
+		require.NotNil(t, v.Dist)
+		require.Equal(t, "zip", v.Dist.Type)
+		require.NotEmpty(t, v.Dist.URL)
+	}
+
+	_, err = client.Versions(ctx, "sourcegraph/does-not-exist")
+	require.True(t, errcode.IsNotFound(err), "%v", err)
+}
+
+func TestVersion(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	version, err := client.Version(ctx, "psr/log", "1.0.0")
+	require.Nil(t, err)
+	require.Equal(t, "1.0.0", strings.TrimPrefix(version.Version, "v"))
+
+	version, err = client.Version(ctx, "psr/log", "v1.0.0")
+	require.Nil(t, err)
+	require.Equal(t, "1.0.0", strings.TrimPrefix(version.Version, "v"))
+
+	_, err = client.Version(ctx, "psr/log", "0.0.0-does-not-exist")
+	require.True(t, errcode.IsNotFound(err), "%v", err)
+}
+
+func TestDownload(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	version, err := client.Version(ctx, "psr/log", "1.0.0")
+	require.Nil(t, err)
+
+	readCloser, err := client.Download(ctx, version.Dist.URL)
+	require.Nil(t, err)
+	defer readCloser.Close()
+
+	dist, err := io.ReadAll(readCloser)
+	require.Nil(t, err)
+
+	tmpDir := t.TempDir()
+	err = unpack.Zip(bytes.NewReader(dist), int64(len(dist)), tmpDir, unpack.Opts{})
+	require.Nil(t, err)
+
+	// Dist zipballs contain a single top-level directory.
+	entries, err := os.ReadDir(tmpDir)
+	require.Nil(t, err)
+	require.Len(t, entries, 1)
+
+	_, err = os.Stat(filepath.Join(tmpDir, entries[0].Name(), "composer.json"))
+	require.Nil(t, err)
+}
+
+func TestExpand(t *testing.T) {
+	minified := []map[string]json.RawMessage{
+		{"name": json.RawMessage(`"a/b"`), "version": json.RawMessage(`"2.0.0"`), "funding": json.RawMessage(`[]`)},
+		{"version": json.RawMessage(`"1.0.0"`), "funding": json.RawMessage(`"__unset"`)},
+		{"version": json.RawMessage(`"0.1.0"`)},
+	}
+
+	require.Equal(t, []map[string]json.RawMessage{
+		{"name": json.RawMessage(`"a/b"`), "version": json.RawMessage(`"2.0.0"`), "funding": json.RawMessage(`[]`)},
+		{"name": json.RawMessage(`"a/b"`), "version": json.RawMessage(`"1.0.0"`)},
+		{"name": json.RawMessage(`"a/b"`), "version": json.RawMessage(`"0.1.0"`)},
+	}, expand(minified))
+}
@@ -113,6 +113,7 @@ const (
 	// in preference to the Type values below.
 
 	KindAWSCodeCommit   = "AWSCODECOMMIT"
+	KindAzureDevOps     = "AZUREDEVOPS"
 	KindBitbucketServer = "BITBUCKETSERVER"
 	KindBitbucketCloud  = "BITBUCKETCLOUD"
 	KindGerrit          = "GERRIT"
@@ -126,6 +127,8 @@ const (
 	KindPythonPackages  = "PYTHONPACKAGES"
 	KindRustPackages    = "RUSTPACKAGES"
 	KindRubyPackages    = "RUBYPACKAGES"
+	KindNuGetPackages   = "NUGETPACKAGES"
+	KindPhpPackages     = "PHPPACKAGES"
 	KindNpmPackages     = "NPMPACKAGES"
 	KindPagure          = "PAGURE"
 	KindOther           = "OTHER"
@@ -139,6 +142,10 @@ const (
 	// suffix (e.g., "arn:aws:codecommit:us-west-1:123456789:").
 	TypeAWSCodeCommit = "awscodecommit"
 
+	// TypeAzureDevOps is the (api.ExternalRepoSpec).ServiceType value for Azure DevOps repositories. The
+	// ServiceID value is the base URL to the Azure DevOps instance (https://dev.azure.com).
+	TypeAzureDevOps = "azuredevops"
+
 	// TypeBitbucketServer is the (api.ExternalRepoSpec).ServiceType value for Bitbucket Server projects. The
 	// ServiceID value is the base URL to the Bitbucket Server instance.
 	TypeBitbucketServer = "bitbucketServer"
@@ -191,6 +198,12 @@ const (
 	// TypeRubyPackages is the (api.ExternalRepoSpec).ServiceType value for Ruby packages.
 	TypeRubyPackages = "rubyPackages"
 
+	// TypeNuGetPackages is the (api.ExternalRepoSpec).ServiceType value for NuGet packages.
+	TypeNuGetPackages = "nugetPackages"
+
+	// TypePhpPackages is the (api.ExternalRepoSpec).ServiceType value for PHP packages.
+	TypePhpPackages = "phpPackages"
+
 	// TypeOther is the (api.ExternalRepoSpec).ServiceType value for other projects.
 	TypeOther = "other"
 )
@@ -201,6 +214,8 @@ func KindToType(kind string) string {
 	switch kind {
 	case KindAWSCodeCommit:
 		return TypeAWSCodeCommit
+	case KindAzureDevOps:
+		return TypeAzureDevOps
 	case KindBitbucketServer:
 		return TypeBitbucketServer
 	case KindBitbucketCloud:
@@ -225,6 +240,10 @@ func KindToType(kind string) string {
 		return TypeRustPackages
 	case KindRubyPackages:
 		return TypeRubyPackages
+	case KindNuGetPackages:
+		return TypeNuGetPackages
+	case KindPhpPackages:
+		return TypePhpPackages
 	case KindNpmPackages:
 		return TypeNpmPackages
 	case KindGoPackages:
@@ -244,6 +263,8 @@ func TypeToKind(t string) string {
 	switch t {
 	case TypeAWSCodeCommit:
 		return KindAWSCodeCommit
+	case TypeAzureDevOps:
+		return KindAzureDevOps
 	case TypeBitbucketServer:
 		return KindBitbucketServer
 	case TypeBitbucketCloud:
@@ -270,6 +291,10 @@ func TypeToKind(t string) string {
 		return KindRustPackages
 	case TypeRubyPackages:
 		return KindRubyPackages
+	case TypeNuGetPackages:
+		return KindNuGetPackages
+	case TypePhpPackages:
+		return KindPhpPackages
 	case TypeGoModules:
 		return KindGoPackages
 	case TypePagure:
@@ -291,6 +316,8 @@ var (
 	pythonLower = strings.ToLower(TypePythonPackages)
 	rustLower   = strings.ToLower(TypeRustPackages)
 	rubyLower   = strings.ToLower(TypeRubyPackages)
+	nugetLower  = strings.ToLower(TypeNuGetPackages)
+	phpLower    = strings.ToLower(TypePhpPackages)
 )
 
 // ParseServiceType will return a ServiceType constant after doing a case insensitive match on s.
@@ -299,6 +326,8 @@ func ParseServiceType(s string) (string, bool) {
 	switch strings.ToLower(s) {
 	case TypeAWSCodeCommit:
 		return TypeAWSCodeCommit, true
+	case TypeAzureDevOps:
+		return TypeAzureDevOps, true
 	case bbsLower:
 		return TypeBitbucketServer, true
 	case bbcLower:
@@ -327,6 +356,10 @@ func ParseServiceType(s string) (string, bool) {
 		return TypeRustPackages, true
 	case rubyLower:
 		return TypeRubyPackages, true
+	case nugetLower:
+		return TypeNuGetPackages, true
+	case phpLower:
+		return TypePhpPackages, true
 	case TypePagure:
 		return TypePagure, true
 	case TypeOther:
@@ -342,6 +375,8 @@ func ParseServiceKind(s string) (string, bool) {
 	switch strings.ToUpper(s) {
 	case KindAWSCodeCommit:
 		return KindAWSCodeCommit, true
+	case KindAzureDevOps:
+		return KindAzureDevOps, true
 	case KindBitbucketServer:
 		return KindBitbucketServer, true
 	case KindBitbucketCloud:
@@ -368,6 +403,10 @@ func ParseServiceKind(s string) (string, bool) {
 		return KindRustPackages, true
 	case KindRubyPackages:
 		return KindRubyPackages, true
+	case KindNuGetPackages:
+		return KindNuGetPackages, true
+	case KindPhpPackages:
+		return KindPhpPackages, true
 	case KindPagure:
 		return KindPagure, true
 	case KindOther:
@@ -421,6 +460,8 @@ func getConfigPrototype(kind string) (any, error) {
 	switch strings.ToUpper(kind) {
 	case KindAWSCodeCommit:
 		return &schema.AWSCodeCommitConnection{}, nil
+	case KindAzureDevOps:
+		return &schema.AzureDevOpsConnection{}, nil
 	case KindBitbucketServer:
 		return &schema.BitbucketServerConnection{}, nil
 	case KindBitbucketCloud:
@@ -451,6 +492,10 @@ func getConfigPrototype(kind string) (any, error) {
 		return &schema.RustPackagesConnection{}, nil
 	case KindRubyPackages:
 		return &schema.RubyPackagesConnection{}, nil
+	case KindNuGetPackages:
+		return &schema.NuGetPackagesConnection{}, nil
+	case KindPhpPackages:
+		return &schema.PhpPackagesConnection{}, nil
 	case KindOther:
 		return &schema.OtherExternalServiceConnection{}, nil
 	default:
@@ -471,6 +516,8 @@ func WebhookURL(kind string, externalServiceID int64, cfg any, externalURL strin
 		path = "bitbucket-server-webhooks"
 	case KindGitLab:
 		path = "gitlab-webhooks"
+	case KindAzureDevOps:
+		path = "azuredevops-webhooks"
 	case KindBitbucketCloud:
 		path = "bitbucket-cloud-webhooks"
 
@@ -522,6 +569,8 @@ func extractToken(parsed any, kind string) (string, error) {
 		return c.Token, nil
 	case *schema.PagureConnection:
 		return c.Token, nil
+	case *schema.AzureDevOpsConnection:
+		return c.Token, nil
 	default:
 		return "", errors.Errorf("unable to extract token for service kind %q", kind)
 	}
@@ -589,6 +638,13 @@ func GetLimitFromConfig(kind string, config any) (rate.Limit, error) {
 		if c != nil && c.RateLimit != nil {
 			limit = limitOrInf(c.RateLimit.Enabled, c.RateLimit.RequestsPerHour)
 		}
+	case *schema.AzureDevOpsConnection:
+		// 2/s is the default limit we enforce, Azure DevOps throttles based on
+		// resource consumption rather than a fixed number of requests.
+		limit = rate.Limit(2)
+		if c != nil && c.RateLimit != nil {
+			limit = limitOrInf(c.RateLimit.Enabled, c.RateLimit.RequestsPerHour)
+		}
 	case *schema.PerforceConnection:
 		limit = rate.Limit(5000.0 / 3600.0)
 		if c != nil && c.RateLimit != nil {
@@ -637,6 +693,16 @@ func GetLimitFromConfig(kind string, config any) (rate.Limit, error) {
 		if c != nil && c.RateLimit != nil {
 			limit = limitOrInf(c.RateLimit.Enabled, c.RateLimit.RequestsPerHour)
 		}
+	case *schema.NuGetPackagesConnection:
+		limit = rate.Limit(36000.0 / 3600.0) // Same as the default in nuget-packages.schema.json
+		if c != nil && c.RateLimit != nil {
+			limit = limitOrInf(c.RateLimit.Enabled, c.RateLimit.RequestsPerHour)
+		}
+	case *schema.PhpPackagesConnection:
+		limit = rate.Limit(36000.0 / 3600.0) // Same as the default in php-packages.schema.json
+		if c != nil && c.RateLimit != nil {
+			limit = limitOrInf(c.RateLimit.Enabled, c.RateLimit.RequestsPerHour)
+		}
 	default:
 		return limit, ErrRateLimitUnsupported{codehostKind: kind}
 	}
@@ -732,6 +798,8 @@ func uniqueCodeHostIdentifier(kind string, cfg any) (string, error) {
 		rawURL = c.Url
 	case *schema.BitbucketCloudConnection:
 		rawURL = c.Url
+	case *schema.AzureDevOpsConnection:
+		rawURL = c.Url
 	case *schema.GerritConnection:
 		rawURL = c.Url
 	case *schema.PhabricatorConnection:
@@ -759,6 +827,10 @@ func uniqueCodeHostIdentifier(kind string, cfg any) (string, error) {
 		return KindRustPackages, nil
 	case *schema.RubyPackagesConnection:
 		return KindRubyPackages, nil
+	case *schema.NuGetPackagesConnection:
+		return KindNuGetPackages, nil
+	case *schema.PhpPackagesConnection:
+		return KindPhpPackages, nil
 	case *schema.PagureConnection:
 		rawURL = c.Url
 	default:
@@ -9,6 +9,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/redispool"
+	"github.com/sourcegraph/sourcegraph/internal/requestclient"
 )
 
 var (
@@ -21,7 +22,7 @@ func getEvaluatedFlagSetFromCache(flagsSet *FlagSet) EvaluatedFlagSet {
 	c := pool.Get()
 	defer c.Close()
 
-	visitorID, err := getVisitorIDForActor(flagsSet.actor)
+	visitorID, err := getVisitorIDForActor(flagsSet.actor, flagsSet.clientType)
 
 	if err != nil {
 		return evaluatedFlagSet
@@ -36,13 +37,13 @@ func getEvaluatedFlagSetFromCache(flagsSet *FlagSet) EvaluatedFlagSet {
 	return evaluatedFlagSet
 }
 
-func setEvaluatedFlagToCache(a *actor.Actor, flagName string, value bool) {
+func setEvaluatedFlagToCache(a *actor.Actor, clientType requestclient.ClientType, flagName string, value bool) {
 	c := pool.Get()
 	defer c.Close()
 
 	var visitorID string
 
-	visitorID, err := getVisitorIDForActor(a)
+	visitorID, err := getVisitorIDForActor(a, clientType)
 
 	if err != nil {
 		return
@@ -51,14 +52,23 @@ func setEvaluatedFlagToCache(a *actor.Actor, flagName string, value bool) {
 	c.Do("HSET", getFlagCacheKey(flagName), visitorID, strconv.FormatBool(value))
 }
 
-func getVisitorIDForActor(a *actor.Actor) (string, error) {
+// getVisitorIDForActor returns the field the evaluated flags of the actor are
+// cached under. Rules can target the type of client, so the flags of an actor
+// are cached separately for each type of client.
+func getVisitorIDForActor(a *actor.Actor, clientType requestclient.ClientType) (string, error) {
+	var visitorID string
 	if a.IsAuthenticated() {
-		return fmt.Sprintf("uid_%d", a.UID), nil
+		visitorID = fmt.Sprintf("uid_%d", a.UID)
 	} else if a.AnonymousUID != "" {
-		return "auid_" + a.AnonymousUID, nil
+		visitorID = "auid_" + a.AnonymousUID
 	} else {
 		return "", errors.New("UID/AnonymousUID are empty for the given actor.")
 	}
+
+	if clientType != "" {
+		visitorID += "_" + string(clientType)
+	}
+	return visitorID, nil
 }
 
 func getFlagCacheKey(name string) string {
@@ -4,6 +4,8 @@ import (
 	"encoding/binary"
 	"hash/fnv"
 	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/requestclient"
 )
 
 type FeatureFlag struct {
@@ -14,20 +16,20 @@ type FeatureFlag struct {
 	Bool    *FeatureFlagBool
 	Rollout *FeatureFlagRollout
 
+	// Rules target users by their attributes and the client of their request.
+	// They are evaluated in order and the value of the first matching rule is
+	// used. If no rule matches, the value of Bool or Rollout is used.
+	Rules []*Rule
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt *time.Time
 }
 
-// EvaluateForUser evaluates the feature flag for a userID.
-func (f *FeatureFlag) EvaluateForUser(userID int32) bool {
-	switch {
-	case f.Bool != nil:
-		return f.Bool.Value
-	case f.Rollout != nil:
-		return hashUserAndFlag(userID, f.Name)%10000 < uint32(f.Rollout.Rollout)
-	}
-	panic("one of Bool or Rollout must be set")
+// EvaluateForUser evaluates the feature flag for the user of ec. ec.UserID
+// must be set.
+func (f *FeatureFlag) EvaluateForUser(ec *EvaluationContext) bool {
+	return f.Evaluate(ec).Value
 }
 
 func hashUserAndFlag(userID int32, flagName string) uint32 {
@@ -38,14 +40,9 @@ func hashUserAndFlag(userID int32, flagName string) uint32 {
 }
 
 // EvaluateForAnonymousUser evaluates the feature flag for an anonymous user ID.
-func (f *FeatureFlag) EvaluateForAnonymousUser(anonymousUID string) bool {
-	switch {
-	case f.Bool != nil:
-		return f.Bool.Value
-	case f.Rollout != nil:
-		return hashAnonymousUserAndFlag(anonymousUID, f.Name)%10000 < uint32(f.Rollout.Rollout)
-	}
-	panic("one of Bool or Rollout must be set")
+// Only rules which don't target user attributes can match anonymous users.
+func (f *FeatureFlag) EvaluateForAnonymousUser(anonymousUID string, clientType requestclient.ClientType) bool {
+	return f.Evaluate(&EvaluationContext{AnonymousUID: anonymousUID, ClientType: clientType}).Value
 }
 
 func hashAnonymousUserAndFlag(anonymousUID, flagName string) uint32 {
@@ -55,10 +52,41 @@ func hashAnonymousUserAndFlag(anonymousUID, flagName string) uint32 {
 	return h.Sum32()
 }
 
+// Evaluate evaluates the feature flag for the user of ec, or for its anonymous
+// user if ec.UserID is not set, and returns why the flag evaluated to its
+// value. Overrides are not taken into account.
+func (f *FeatureFlag) Evaluate(ec *EvaluationContext) Evaluation {
+	for i, rule := range f.Rules {
+		if rule.Match(ec) {
+			return Evaluation{Value: rule.Value, Reason: ReasonRule, RuleIndex: i}
+		}
+	}
+
+	switch {
+	case f.Bool != nil:
+		return Evaluation{Value: f.Bool.Value, Reason: ReasonBool}
+	case f.Rollout != nil:
+		var hash uint32
+		if ec.UserID != 0 {
+			hash = hashUserAndFlag(ec.UserID, f.Name)
+		} else {
+			hash = hashAnonymousUserAndFlag(ec.AnonymousUID, f.Name)
+		}
+		return Evaluation{Value: hash%10000 < uint32(f.Rollout.Rollout), Reason: ReasonRollout}
+	}
+	panic("one of Bool or Rollout must be set")
+}
+
 // EvaluateGlobal returns the evaluated feature flag for a global context (no user
 // is associated with the request). If the flag is not evaluatable in the global context
-// (i.e. the flag type is a rollout), then the second parameter will return false.
+// (i.e. the flag type is a rollout or the flag has rules), then the second parameter
+// will return false.
 func (f *FeatureFlag) EvaluateGlobal() (res bool, ok bool) {
+	if len(f.Rules) > 0 {
+		// rules may target the user or client of the request
+		return false, false
+	}
+
 	switch {
 	case f.Bool != nil:
 		return f.Bool.Value, true
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/requestclient"
 )
 
 // Current feature flags requested by backend/frontend for the current actor
@@ -33,8 +34,9 @@ func (f EvaluatedFlagSet) Json() json.RawMessage {
 
 // Feature flags for the current actor
 type FlagSet struct {
-	flags map[string]bool
-	actor *actor.Actor
+	flags      map[string]bool
+	actor      *actor.Actor
+	clientType requestclient.ClientType
 }
 
 // Returns (flagValue, true) if flag exist, otherwise (false, false)
@@ -44,7 +46,7 @@ func (f *FlagSet) GetBool(flag string) (bool, bool) {
 	}
 	v, ok := f.flags[flag]
 	if ok {
-		setEvaluatedFlagToCache(f.actor, flag, v)
+		setEvaluatedFlagToCache(f.actor, f.clientType, flag, v)
 	}
 	return v, ok
 }
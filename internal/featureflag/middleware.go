@@ -6,10 +6,13 @@ import (
 	"sync"
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/requestclient"
 )
 
 type flagContextKey struct{}
 
+// Store evaluates feature flags. Rules targeting the client of a request are
+// evaluated against the requestclient.Client of the context.
 type Store interface {
 	GetUserFlags(context.Context, int32) (map[string]bool, error)
 	GetAnonymousUserFlags(context.Context, string) (map[string]bool, error)
@@ -44,6 +47,9 @@ type flagSetFetcher struct {
 	once sync.Once
 	// Actor is the actor that was used to populate flagSet
 	actor *actor.Actor
+	// clientType is the type of the request client that was used to populate
+	// flagSet, since rules can target it.
+	clientType requestclient.ClientType
 	// flagSet is the once-populated set of flags for the actor at the time of population
 	flagSet *FlagSet
 }
@@ -51,24 +57,26 @@ type flagSetFetcher struct {
 func (f *flagSetFetcher) fetch(ctx context.Context) *FlagSet {
 	f.once.Do(func() {
 		f.actor = actor.FromContext(ctx)
-		f.flagSet = f.fetchForActor(ctx, f.actor)
+		f.clientType = clientTypeFromContext(ctx)
+		f.flagSet = f.fetchForActor(ctx, f.actor, f.clientType)
 	})
 
 	currentActor := actor.FromContext(ctx)
-	if f.actor == currentActor {
-		// If the actor hasn't changed, return the cached flag set
+	currentClientType := clientTypeFromContext(ctx)
+	if f.actor == currentActor && f.clientType == currentClientType {
+		// If the actor and client haven't changed, return the cached flag set
 		return f.flagSet
 	}
 
 	// Otherwise, re-fetch the flag set
-	return f.fetchForActor(ctx, currentActor)
+	return f.fetchForActor(ctx, currentActor, currentClientType)
 }
 
-func (f *flagSetFetcher) fetchForActor(ctx context.Context, a *actor.Actor) *FlagSet {
+func (f *flagSetFetcher) fetchForActor(ctx context.Context, a *actor.Actor, clientType requestclient.ClientType) *FlagSet {
 	if a.IsAuthenticated() {
 		flags, err := f.ffs.GetUserFlags(ctx, a.UID)
 		if err == nil {
-			return &FlagSet{flags: flags, actor: f.actor}
+			return &FlagSet{flags: flags, actor: a, clientType: clientType}
 		}
 		// Continue if err != nil
 	}
@@ -76,17 +84,26 @@ func (f *flagSetFetcher) fetchForActor(ctx context.Context, a *actor.Actor) *Fla
 	if a.AnonymousUID != "" {
 		flags, err := f.ffs.GetAnonymousUserFlags(ctx, a.AnonymousUID)
 		if err == nil {
-			return &FlagSet{flags: flags, actor: f.actor}
+			return &FlagSet{flags: flags, actor: a, clientType: clientType}
 		}
 		// Continue if err != nil
 	}
 
 	flags, err := f.ffs.GetGlobalFeatureFlags(ctx)
 	if err == nil {
-		return &FlagSet{flags: flags, actor: f.actor}
+		return &FlagSet{flags: flags, actor: a, clientType: clientType}
 	}
 
-	return &FlagSet{actor: f.actor}
+	return &FlagSet{actor: a, clientType: clientType}
+}
+
+// clientTypeFromContext returns the type of the request client, which the
+// Store implementations use to evaluate rules targeting client types.
+func clientTypeFromContext(ctx context.Context) requestclient.ClientType {
+	if client := requestclient.FromContext(ctx); client != nil {
+		return client.Type
+	}
+	return ""
 }
 
 // FromContext retrieves the current set of flags from the current
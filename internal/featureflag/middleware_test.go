@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/requestclient"
 )
 
 func TestMiddleware(t *testing.T) {
@@ -165,6 +166,41 @@ func TestContextFlags_GetBoolOr(t *testing.T) {
 	})
 }
 
+func TestContextFlags_ClientType(t *testing.T) {
+	setupRedisTest(t)
+	mockStore := NewMockStore()
+	mockStore.GetUserFlagsFunc.SetDefaultHook(func(ctx context.Context, _ int32) (map[string]bool, error) {
+		// Stand in for a rule targeting the web application
+		return map[string]bool{"web": clientTypeFromContext(ctx) == requestclient.ClientTypeWeb}, nil
+	})
+
+	ctx := actor.WithActor(context.Background(), actor.FromUser(1))
+	ctx = WithFlags(ctx, mockStore)
+
+	webCtx := requestclient.WithClient(ctx, &requestclient.Client{Type: requestclient.ClientTypeWeb})
+	require.True(t, FromContext(webCtx).GetBoolOr("web", false))
+	require.True(t, FromContext(webCtx).GetBoolOr("web", false))
+	mockrequire.CalledN(t, mockStore.GetUserFlagsFunc, 1)
+
+	// With a new client type, the flag fetcher should re-fetch
+	apiCtx := requestclient.WithClient(ctx, &requestclient.Client{Type: requestclient.ClientTypeAPI})
+	require.False(t, FromContext(apiCtx).GetBoolOr("web", true))
+	mockrequire.CalledN(t, mockStore.GetUserFlagsFunc, 2)
+}
+
+func TestGetVisitorIDForActor(t *testing.T) {
+	visitorID, err := getVisitorIDForActor(actor.FromUser(1), "")
+	require.NoError(t, err)
+	require.Equal(t, "uid_1", visitorID)
+
+	visitorID, err = getVisitorIDForActor(actor.FromAnonymousUser("abc"), requestclient.ClientTypeBrowserExtension)
+	require.NoError(t, err)
+	require.Equal(t, "auid_abc_browser-extension", visitorID)
+
+	_, err = getVisitorIDForActor(&actor.Actor{}, requestclient.ClientTypeWeb)
+	require.Error(t, err)
+}
+
 func setupRedisTest(t *testing.T) {
 	cache := map[string][]byte{}
 
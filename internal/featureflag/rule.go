@@ -0,0 +1,146 @@
+package featureflag
+
+import (
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/requestclient"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Rule targets a feature flag value at a set of users. A rule matches if all of
+// its conditions match, conditions which are not set always match.
+type Rule struct {
+	// OrgIDs matches members of any of the organizations.
+	OrgIDs []int32 `json:"orgIDs,omitempty"`
+	// SiteAdmin matches site admins if true, and other users if false.
+	SiteAdmin *bool `json:"siteAdmin,omitempty"`
+	// CreatedAfter matches users created after the given time.
+	CreatedAfter *time.Time `json:"createdAfter,omitempty"`
+	// EmailDomains matches users with a verified email address at any of the
+	// domains.
+	EmailDomains []string `json:"emailDomains,omitempty"`
+	// ClientTypes matches requests sent by any of the types of clients.
+	ClientTypes []requestclient.ClientType `json:"clientTypes,omitempty"`
+
+	// Value is the value of the feature flag for matching users.
+	Value bool `json:"value"`
+}
+
+// Match returns true if the user and request of ec match all conditions of r.
+// Conditions on user attributes never match anonymous users.
+func (r *Rule) Match(ec *EvaluationContext) bool {
+	if r.targetsUser() && ec.UserID == 0 {
+		return false
+	}
+
+	if len(r.OrgIDs) > 0 && !containsAny(r.OrgIDs, ec.OrgIDs) {
+		return false
+	}
+	if r.SiteAdmin != nil && *r.SiteAdmin != ec.SiteAdmin {
+		return false
+	}
+	if r.CreatedAfter != nil && !ec.CreatedAt.After(*r.CreatedAfter) {
+		return false
+	}
+	if len(r.EmailDomains) > 0 && !containsAnyFold(r.EmailDomains, ec.EmailDomains) {
+		return false
+	}
+	if len(r.ClientTypes) > 0 && !containsAny(r.ClientTypes, []requestclient.ClientType{ec.ClientType}) {
+		return false
+	}
+
+	return true
+}
+
+// Validate returns an error if r has no conditions, or if one of them can
+// never match.
+func (r *Rule) Validate() error {
+	if !r.targetsUser() && len(r.ClientTypes) == 0 {
+		return errors.New("rule must have at least one condition")
+	}
+	for _, domain := range r.EmailDomains {
+		if domain == "" || strings.Contains(domain, "@") {
+			return errors.Errorf("invalid email domain %q", domain)
+		}
+	}
+	for _, clientType := range r.ClientTypes {
+		switch clientType {
+		case requestclient.ClientTypeWeb, requestclient.ClientTypeBrowserExtension, requestclient.ClientTypeAPI:
+		default:
+			return errors.Errorf("unknown client type %q", clientType)
+		}
+	}
+	return nil
+}
+
+func (r *Rule) targetsUser() bool {
+	return len(r.OrgIDs) > 0 || r.SiteAdmin != nil || r.CreatedAfter != nil || len(r.EmailDomains) > 0
+}
+
+func containsAny[T comparable](want, have []T) bool {
+	for _, w := range want {
+		for _, h := range have {
+			if w == h {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func containsAnyFold(want, have []string) bool {
+	for _, w := range want {
+		for _, h := range have {
+			if strings.EqualFold(w, h) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// EvaluationContext holds the attributes of a user and their request which
+// feature flags are evaluated against.
+type EvaluationContext struct {
+	// UserID is the ID of the user, or 0 for anonymous users.
+	UserID       int32
+	AnonymousUID string
+
+	// The following attributes are only set for authenticated users.
+	SiteAdmin bool
+	CreatedAt time.Time
+	OrgIDs    []int32
+	// EmailDomains are the domains of the verified email addresses of the user.
+	EmailDomains []string
+
+	// ClientType is the type of client that sent the request, if known.
+	ClientType requestclient.ClientType
+}
+
+// EvaluationReason describes why a feature flag evaluated to its value.
+type EvaluationReason string
+
+const (
+	// ReasonBool is the value of a boolean feature flag.
+	ReasonBool EvaluationReason = "BOOL"
+	// ReasonRollout is the value assigned to the user by a rollout feature flag.
+	ReasonRollout EvaluationReason = "ROLLOUT"
+	// ReasonRule is the value of a rule matching the user.
+	ReasonRule EvaluationReason = "RULE"
+	// ReasonOrgOverride is the value of an override for an org of the user.
+	ReasonOrgOverride EvaluationReason = "ORG_OVERRIDE"
+	// ReasonUserOverride is the value of an override for the user.
+	ReasonUserOverride EvaluationReason = "USER_OVERRIDE"
+)
+
+// Evaluation is the value of a feature flag for a user, together with the
+// reason for it.
+type Evaluation struct {
+	Value  bool
+	Reason EvaluationReason
+	// RuleIndex is the index of the matching rule if Reason is ReasonRule.
+	RuleIndex int
+	// OrgID is the org of the override if Reason is ReasonOrgOverride.
+	OrgID int32
+}
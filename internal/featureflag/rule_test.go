@@ -0,0 +1,101 @@
+package featureflag
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/requestclient"
+)
+
+func TestRuleMatch(t *testing.T) {
+	boolPtr := func(b bool) *bool { return &b }
+	cutoff := time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	user := &EvaluationContext{
+		UserID:       1,
+		SiteAdmin:    true,
+		CreatedAt:    cutoff.Add(time.Hour),
+		OrgIDs:       []int32{1, 2},
+		EmailDomains: []string{"sourcegraph.com"},
+		ClientType:   requestclient.ClientTypeWeb,
+	}
+	anonymous := &EvaluationContext{
+		AnonymousUID: "anon",
+		ClientType:   requestclient.ClientTypeWeb,
+	}
+
+	cases := []struct {
+		name string
+		rule Rule
+		ec   *EvaluationContext
+		want bool
+	}{
+		{name: "org", rule: Rule{OrgIDs: []int32{3, 2}}, ec: user, want: true},
+		{name: "other org", rule: Rule{OrgIDs: []int32{3}}, ec: user, want: false},
+		{name: "site admin", rule: Rule{SiteAdmin: boolPtr(true)}, ec: user, want: true},
+		{name: "not site admin", rule: Rule{SiteAdmin: boolPtr(false)}, ec: user, want: false},
+		{name: "created after", rule: Rule{CreatedAfter: &cutoff}, ec: user, want: true},
+		{name: "created before", rule: Rule{CreatedAfter: timePtr(cutoff.Add(2 * time.Hour))}, ec: user, want: false},
+		{name: "email domain", rule: Rule{EmailDomains: []string{"SourceGraph.com"}}, ec: user, want: true},
+		{name: "other email domain", rule: Rule{EmailDomains: []string{"example.com"}}, ec: user, want: false},
+		{name: "client type", rule: Rule{ClientTypes: []requestclient.ClientType{requestclient.ClientTypeAPI, requestclient.ClientTypeWeb}}, ec: user, want: true},
+		{name: "other client type", rule: Rule{ClientTypes: []requestclient.ClientType{requestclient.ClientTypeAPI}}, ec: user, want: false},
+		{name: "all conditions", rule: Rule{OrgIDs: []int32{1}, SiteAdmin: boolPtr(true), ClientTypes: []requestclient.ClientType{requestclient.ClientTypeWeb}}, ec: user, want: true},
+		{name: "one condition fails", rule: Rule{OrgIDs: []int32{1}, SiteAdmin: boolPtr(false)}, ec: user, want: false},
+		{name: "anonymous client type", rule: Rule{ClientTypes: []requestclient.ClientType{requestclient.ClientTypeWeb}}, ec: anonymous, want: true},
+		{name: "anonymous not site admin", rule: Rule{SiteAdmin: boolPtr(false)}, ec: anonymous, want: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, tc.rule.Match(tc.ec))
+		})
+	}
+}
+
+func TestRuleValidate(t *testing.T) {
+	require.NoError(t, (&Rule{EmailDomains: []string{"example.com"}}).Validate())
+	require.NoError(t, (&Rule{ClientTypes: []requestclient.ClientType{requestclient.ClientTypeBrowserExtension}}).Validate())
+
+	require.Error(t, (&Rule{Value: true}).Validate())
+	require.Error(t, (&Rule{EmailDomains: []string{"foo@example.com"}}).Validate())
+	require.Error(t, (&Rule{ClientTypes: []requestclient.ClientType{"vim"}}).Validate())
+}
+
+func TestEvaluate(t *testing.T) {
+	flag := &FeatureFlag{
+		Name: "test",
+		Bool: &FeatureFlagBool{Value: false},
+		Rules: []*Rule{
+			{ClientTypes: []requestclient.ClientType{requestclient.ClientTypeAPI}, Value: false},
+			{EmailDomains: []string{"sourcegraph.com"}, Value: true},
+		},
+	}
+
+	require.Equal(t,
+		Evaluation{Value: true, Reason: ReasonRule, RuleIndex: 1},
+		flag.Evaluate(&EvaluationContext{UserID: 1, EmailDomains: []string{"sourcegraph.com"}, ClientType: requestclient.ClientTypeWeb}),
+	)
+	require.Equal(t,
+		Evaluation{Value: false, Reason: ReasonRule, RuleIndex: 0},
+		flag.Evaluate(&EvaluationContext{UserID: 1, EmailDomains: []string{"sourcegraph.com"}, ClientType: requestclient.ClientTypeAPI}),
+	)
+	require.Equal(t,
+		Evaluation{Value: false, Reason: ReasonBool},
+		flag.Evaluate(&EvaluationContext{UserID: 1, EmailDomains: []string{"example.com"}}),
+	)
+	require.False(t, flag.EvaluateForAnonymousUser("anon", requestclient.ClientTypeWeb))
+
+	_, ok := flag.EvaluateGlobal()
+	require.False(t, ok, "flags with rules can't be evaluated globally")
+
+	rollout := &FeatureFlag{Name: "test", Rollout: &FeatureFlagRollout{Rollout: 10000}}
+	require.Equal(t, Evaluation{Value: true, Reason: ReasonRollout}, rollout.Evaluate(&EvaluationContext{UserID: 1}))
+	require.True(t, rollout.EvaluateForAnonymousUser("anon", ""))
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -264,6 +264,10 @@ type CreateCommitFromPatchRequest struct {
 	// Push specifies whether the target ref will be pushed to the code host: if
 	// nil, no push will be attempted, if non-nil, a push will be attempted.
 	Push *PushConfig
+	// PushRef is the ref the commit will be pushed to on the code host. If
+	// nil, the commit is pushed to TargetRef. Code hosts such as Gerrit expect
+	// commits to be pushed to magic refs that don't match a local branch.
+	PushRef *string
 	// GitApplyArgs are the arguments that will be passed to `git apply` along
 	// with `--cached`.
 	GitApplyArgs []string
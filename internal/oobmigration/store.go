@@ -196,6 +196,43 @@ var yamlMigrationIDs = func() []int {
 	return ids
 }()
 
+// DefinedMigrations returns the metadata of the out-of-band migrations with the given
+// identifiers as defined in the sibling file oobmigrations.yaml. The progress of the
+// returned migrations is not read from the database and is left unset.
+func DefinedMigrations(ids []int) ([]Migration, error) {
+	migrationsByID := make(map[int]yamlMigration, len(yamlMigrations))
+	for _, migration := range yamlMigrations {
+		migrationsByID[migration.ID] = migration
+	}
+
+	definedMigrations := make([]Migration, 0, len(ids))
+	for _, id := range ids {
+		migration, ok := migrationsByID[id]
+		if !ok {
+			return nil, errors.Newf("unknown out-of-band migration %d", id)
+		}
+
+		var deprecated *Version
+		if migration.DeprecatedVersionMajor != nil && migration.DeprecatedVersionMinor != nil {
+			version := NewVersion(*migration.DeprecatedVersionMajor, *migration.DeprecatedVersionMinor)
+			deprecated = &version
+		}
+
+		definedMigrations = append(definedMigrations, Migration{
+			ID:             migration.ID,
+			Team:           migration.Team,
+			Component:      migration.Component,
+			Description:    migration.Description,
+			Introduced:     NewVersion(migration.IntroducedVersionMajor, migration.IntroducedVersionMinor),
+			Deprecated:     deprecated,
+			NonDestructive: migration.NonDestructive,
+			IsEnterprise:   migration.IsEnterprise,
+		})
+	}
+
+	return definedMigrations, nil
+}
+
 // SynchronizeMetadata upserts the metadata defined in the sibling file oobmigrations.yaml.
 // Existing out-of-band migration metadata that does not match one of the identifiers in the
 // referenced file are not removed, as they have likely been registered by a later version of
@@ -90,6 +90,30 @@ func TestSynchronizeMetadata(t *testing.T) {
 	compareMigrations()
 }
 
+func TestDefinedMigrations(t *testing.T) {
+	ids := []int{yamlMigrations[1].ID, yamlMigrations[0].ID}
+	definedMigrations, err := DefinedMigrations(ids)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var definedIDs []int
+	for _, migration := range definedMigrations {
+		definedIDs = append(definedIDs, migration.ID)
+
+		if migration.Description == "" {
+			t.Errorf("expected description for migration %d", migration.ID)
+		}
+	}
+	if diff := cmp.Diff(ids, definedIDs); diff != "" {
+		t.Errorf("unexpected migration ids (-want +got):\n%s", diff)
+	}
+
+	if _, err := DefinedMigrations([]int{-1}); err == nil {
+		t.Error("expected error for unknown migration")
+	}
+}
+
 func TestSynchronizeMetadataFallback(t *testing.T) {
 	// Note: package globals block test parallelism
 	testEnterprise(t)
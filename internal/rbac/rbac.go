@@ -0,0 +1,74 @@
+// Package rbac contains the building blocks of role-based access control:
+// the permissions that can be granted to roles, and the roles that are built
+// into every instance.
+package rbac
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Namespace groups the permissions belonging to a single feature.
+type Namespace string
+
+const (
+	BatchChangesNamespace Namespace = "BATCH_CHANGES"
+	CodeInsightsNamespace Namespace = "CODE_INSIGHTS"
+)
+
+// Action is an action that can be performed within a namespace.
+type Action string
+
+const (
+	// AdminAction grants full control over all resources of a namespace,
+	// including those owned by other users.
+	AdminAction Action = "ADMIN"
+)
+
+// Permission is a namespaced action that can be granted to a role.
+type Permission struct {
+	Namespace Namespace
+	Action    Action
+}
+
+// String returns the display name of the permission, which is the namespace
+// and the action separated by a "#".
+func (p Permission) String() string {
+	return fmt.Sprintf("%s#%s", p.Namespace, p.Action)
+}
+
+// ParsePermission parses a permission from its display name.
+func ParsePermission(s string) (Permission, error) {
+	namespace, action, ok := strings.Cut(s, "#")
+	if !ok || namespace == "" || action == "" {
+		return Permission{}, errors.Errorf("invalid permission %q", s)
+	}
+	return Permission{Namespace: Namespace(namespace), Action: Action(action)}, nil
+}
+
+var (
+	// BatchChangesAdmin allows administering the batch changes of all users
+	// and organizations.
+	BatchChangesAdmin = Permission{Namespace: BatchChangesNamespace, Action: AdminAction}
+	// CodeInsightsAdmin allows managing the code insights series of the
+	// instance.
+	CodeInsightsAdmin = Permission{Namespace: CodeInsightsNamespace, Action: AdminAction}
+)
+
+// Permissions contains all permissions known to the instance. The migrations
+// creating the permissions table seed it with these permissions.
+var Permissions = []Permission{
+	BatchChangesAdmin,
+	CodeInsightsAdmin,
+}
+
+const (
+	// UserRole is the built-in role every user implicitly has. Permissions
+	// granted to it are granted to every user of the instance.
+	UserRole = "USER"
+	// SiteAdministratorRole is the built-in role every site admin implicitly
+	// has.
+	SiteAdministratorRole = "SITE_ADMINISTRATOR"
+)
@@ -0,0 +1,235 @@
+package repos
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// An AzureDevOpsSource yields repositories from a single Azure DevOps
+// connection configured in Sourcegraph via the external services
+// configuration.
+type AzureDevOpsSource struct {
+	svc       *types.ExternalService
+	config    *schema.AzureDevOpsConnection
+	exclude   excludeFunc
+	cli       azuredevops.Client
+	serviceID string
+	logger    log.Logger
+}
+
+var _ UserSource = &AzureDevOpsSource{}
+
+// NewAzureDevOpsSource returns a new AzureDevOpsSource from the given external
+// service.
+func NewAzureDevOpsSource(ctx context.Context, logger log.Logger, svc *types.ExternalService, cf *httpcli.Factory) (*AzureDevOpsSource, error) {
+	rawConfig, err := svc.Config.Decrypt(ctx)
+	if err != nil {
+		return nil, errors.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	var c schema.AzureDevOpsConnection
+	if err := jsonc.Unmarshal(rawConfig, &c); err != nil {
+		return nil, errors.Wrapf(err, "external service id=%d config error", svc.ID)
+	}
+
+	if cf == nil {
+		cf = httpcli.ExternalClientFactory
+	}
+
+	httpCli, err := cf.Doer()
+	if err != nil {
+		return nil, err
+	}
+
+	cli, err := azuredevops.NewClient(svc.URN(), &c, httpCli)
+	if err != nil {
+		return nil, err
+	}
+
+	var eb excludeBuilder
+	for _, r := range c.Exclude {
+		eb.Exact(r.Name)
+		eb.Exact(r.Id)
+		eb.Pattern(r.Pattern)
+	}
+	exclude, err := eb.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	rawURL := c.Url
+	if rawURL == "" {
+		rawURL = azuredevops.AzureDevOpsAPIURL
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "external service id=%d config error", svc.ID)
+	}
+
+	return &AzureDevOpsSource{
+		svc:       svc,
+		config:    &c,
+		exclude:   exclude,
+		cli:       cli,
+		serviceID: extsvc.NormalizeBaseURL(u).String(),
+		logger:    logger,
+	}, nil
+}
+
+// ListRepos returns all Azure DevOps repositories configured with this
+// AzureDevOpsSource's config.
+func (s *AzureDevOpsSource) ListRepos(ctx context.Context, results chan SourceResult) {
+	seen := make(map[string]bool)
+
+	// Projects are listed before organizations, so that a project that is
+	// also covered by an organization is only requested once per repository.
+	scopes := make([]string, 0, len(s.config.Projects)+len(s.config.Orgs))
+	scopes = append(scopes, s.config.Projects...)
+	scopes = append(scopes, s.config.Orgs...)
+
+	for _, scope := range scopes {
+		repos, err := s.cli.ListRepositoriesByProjectOrOrg(ctx, azuredevops.ListRepositoriesByProjectOrOrgArgs{
+			ProjectOrOrgName: scope,
+		})
+		if err != nil {
+			results <- SourceResult{Source: s, Err: errors.Wrapf(err, "listing repositories of %q", scope)}
+			continue
+		}
+
+		for i := range repos {
+			repo := &repos[i]
+			if seen[repo.ID] || repo.IsDisabled {
+				continue
+			}
+
+			if s.excludes(repo) {
+				continue
+			}
+
+			r, err := s.makeRepo(repo)
+			if err != nil {
+				results <- SourceResult{Source: s, Err: err}
+				continue
+			}
+
+			seen[repo.ID] = true
+			results <- SourceResult{Source: s, Repo: r}
+		}
+	}
+}
+
+// ExternalServices returns a singleton slice containing the external service.
+func (s *AzureDevOpsSource) ExternalServices() types.ExternalServices {
+	return types.ExternalServices{s.svc}
+}
+
+func (s *AzureDevOpsSource) excludes(r *azuredevops.Repository) bool {
+	name, err := r.NameWithOwner()
+	if err != nil {
+		// We can't match the name, but the ID is still usable.
+		return s.exclude(r.ID)
+	}
+	return s.exclude(name) || s.exclude(r.ID)
+}
+
+func (s *AzureDevOpsSource) makeRepo(r *azuredevops.Repository) (*types.Repo, error) {
+	org, err := r.GetOrganization()
+	if err != nil {
+		return nil, err
+	}
+
+	host, err := url.Parse(s.serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	urn := s.svc.URN()
+	return &types.Repo{
+		Name: reposource.AzureDevOpsRepoName(
+			s.config.RepositoryPathPattern,
+			host.Hostname(),
+			org,
+			r.Project.Name,
+			r.Name,
+		),
+		URI: string(reposource.AzureDevOpsRepoName(
+			"",
+			host.Hostname(),
+			org,
+			r.Project.Name,
+			r.Name,
+		)),
+		ExternalRepo: api.ExternalRepoSpec{
+			ID:          r.ID,
+			ServiceType: extsvc.TypeAzureDevOps,
+			ServiceID:   s.serviceID,
+		},
+		Fork:    r.IsFork,
+		Private: r.Project.Visibility != "public",
+		Sources: map[string]*types.SourceInfo{
+			urn: {
+				ID:       urn,
+				CloneURL: s.remoteURL(r),
+			},
+		},
+		Metadata: r,
+	}, nil
+}
+
+// remoteURL returns the repository's Git remote URL
+//
+// note: this used to contain credentials but that is no longer the case
+// if you need to get an authenticated clone url use repos.CloneURL
+func (s *AzureDevOpsSource) remoteURL(repo *azuredevops.Repository) string {
+	if s.config.GitURLType == "ssh" {
+		return repo.SSHURL
+	}
+
+	// Azure DevOps puts the name of the organization in the userinfo of the
+	// remote URL, which we don't want to store.
+	u, err := url.Parse(repo.CloneURL)
+	if err != nil {
+		s.logger.Warn("Error parsing Azure DevOps repository Git remote URL.", log.String("url", repo.CloneURL), log.Error(err))
+		return repo.CloneURL
+	}
+	u.User = nil
+	return u.String()
+}
+
+// WithAuthenticator returns a copy of the original Source configured to use
+// the given authenticator, provided that authenticator type is supported by
+// the code host.
+func (s *AzureDevOpsSource) WithAuthenticator(a auth.Authenticator) (Source, error) {
+	switch a.(type) {
+	case
+		*auth.BasicAuth,
+		*auth.BasicAuthWithSSH:
+		break
+
+	default:
+		return nil, newUnsupportedAuthenticatorError("AzureDevOpsSource", a)
+	}
+
+	sc := *s
+	sc.cli = sc.cli.WithAuthenticator(a)
+
+	return &sc, nil
+}
+
+// ValidateAuthenticator validates the currently set authenticator is usable.
+// Returns an error, when validating the Authenticator yielded an error.
+func (s *AzureDevOpsSource) ValidateAuthenticator(ctx context.Context) error {
+	return s.cli.Ping(ctx)
+}
@@ -0,0 +1,46 @@
+package repos
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/testutil"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestAzureDevOpsSource_ListRepos(t *testing.T) {
+	conf := &schema.AzureDevOpsConnection{
+		Url:      "https://dev.azure.com",
+		Username: "testuser",
+		Token:    "testtoken",
+		Projects: []string{"sgtestazure/sgtestazure"},
+		Orgs:     []string{"sgtestazure"},
+		Exclude: []*schema.ExcludedAzureDevOpsRepo{
+			{Name: "sgtestazure/sgtestazure/sgtestazure-fork"},
+		},
+	}
+	cf, save := newClientFactory(t, t.Name())
+	defer save(t)
+
+	svc := &types.ExternalService{
+		Kind:   extsvc.KindAzureDevOps,
+		Config: extsvc.NewUnencryptedConfig(marshalJSON(t, conf)),
+	}
+
+	ctx := context.Background()
+	src, err := NewAzureDevOpsSource(ctx, logtest.Scoped(t), svc, cf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repos, err := listAll(ctx, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertGolden(t, "testdata/sources/AZUREDEVOPS/"+t.Name(), update(t.Name()), repos)
+}
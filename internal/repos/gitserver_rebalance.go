@@ -0,0 +1,198 @@
+package repos
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// rebalanceUserAgent identifies gitserver address lookups made while planning a
+// rebalancing in the src_gitserver_addr_for_repo_invoked metric.
+const rebalanceUserAgent = "gitserver-rebalance"
+
+// PlanGitserverRebalance queues the moves of all cloned repos that are owned by
+// a different gitserver shard once the gitserver addresses in the site
+// configuration are replaced by target, replacing any moves planned before. It
+// returns the number of queued moves.
+//
+// The moves are run by RunGitserverRebalanceWorker. Site admins should only
+// switch the site configuration to the target addresses once all moves are
+// completed, so that the shards added by the rebalancing already hold their
+// repos when they start serving them.
+func PlanGitserverRebalance(ctx context.Context, db database.DB, target []string) (int, error) {
+	cfg := conf.Get()
+
+	var pinned map[string]string
+	if cfg.ExperimentalFeatures != nil {
+		pinned = cfg.ExperimentalFeatures.GitServerPinnedRepos
+	}
+
+	current := gitserver.GitServerAddresses{
+		Addresses:     cfg.ServiceConnections().GitServers,
+		PinnedServers: pinned,
+	}
+	next := gitserver.GitServerAddresses{
+		Addresses:     target,
+		PinnedServers: pinned,
+	}
+
+	return planGitserverRebalance(ctx, db, current, next)
+}
+
+func planGitserverRebalance(ctx context.Context, db database.DB, current, target gitserver.GitServerAddresses) (int, error) {
+	if len(current.Addresses) == 0 {
+		return 0, errors.New("no gitserver addresses are configured")
+	}
+	if len(target.Addresses) == 0 {
+		return 0, errors.New("target gitserver addresses must not be empty")
+	}
+
+	seen := make(map[string]struct{}, len(target.Addresses))
+	for _, addr := range target.Addresses {
+		if addr == "" {
+			return 0, errors.New("target gitserver addresses must not be empty")
+		}
+		if _, ok := seen[addr]; ok {
+			return 0, errors.Errorf("duplicate target gitserver address %q", addr)
+		}
+		seen[addr] = struct{}{}
+	}
+
+	var moves []*database.GitserverRepoMove
+	var cursor int
+	for {
+		rs, nextCursor, err := db.GitserverRepos().IterateRepoGitserverStatus(ctx, database.IterateRepoGitserverStatusOptions{
+			BatchSize:  10000,
+			NextCursor: cursor,
+		})
+		if err != nil {
+			return 0, err
+		}
+		if len(rs) == 0 {
+			break
+		}
+
+		for _, r := range rs {
+			// Repos that are not cloned yet will be cloned from their code host by
+			// their new shard anyway.
+			if r.GitserverRepo == nil || r.GitserverRepo.CloneStatus != types.CloneStatusCloned {
+				continue
+			}
+
+			from, err := gitserver.AddrForRepo(ctx, rebalanceUserAgent, db, r.Name, current)
+			if err != nil {
+				return 0, errors.Wrapf(err, "getting current gitserver address of %q", r.Name)
+			}
+			to, err := gitserver.AddrForRepo(ctx, rebalanceUserAgent, db, r.Name, target)
+			if err != nil {
+				return 0, errors.Wrapf(err, "getting target gitserver address of %q", r.Name)
+			}
+
+			if from != to {
+				moves = append(moves, &database.GitserverRepoMove{
+					RepoID:   r.ID,
+					RepoName: r.Name,
+					From:     from,
+					To:       to,
+				})
+			}
+		}
+
+		cursor = nextCursor
+	}
+
+	if err := db.GitserverRepos().QueueRebalance(ctx, moves...); err != nil {
+		return 0, err
+	}
+
+	return len(moves), nil
+}
+
+// RunGitserverRebalanceWorker is a worker which moves the repos queued by
+// PlanGitserverRebalance to their new gitserver shard. Each repo is cloned from
+// the shard that currently owns it instead of its code host, and at most
+// concurrency repos are moved at the same time.
+func RunGitserverRebalanceWorker(ctx context.Context, logger log.Logger, db database.DB, concurrency int) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	client := gitserver.NewClient(db)
+	store := db.GitserverRepos()
+
+	for {
+		n, err := rebalanceGitservers(ctx, logger, store, client, concurrency)
+		if err != nil {
+			logger.Error("failed to rebalance gitserver repos", log.Error(err))
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+		// Keep going while there are moves left.
+		if n == 0 || err != nil {
+			randSleep(30*time.Second, 10*time.Second)
+		}
+	}
+}
+
+// rebalanceGitservers claims up to concurrency queued moves and runs them
+// concurrently. It returns the number of moves it ran.
+func rebalanceGitservers(ctx context.Context, logger log.Logger, store database.GitserverRepoStore, client gitserver.Client, concurrency int) (int, error) {
+	// A move that is still migrating after twice the time gitserver allows for a
+	// clone was most likely claimed by a repo-updater that went away.
+	staleBefore := time.Now().Add(-2 * conf.GitLongCommandTimeout())
+
+	moves, err := store.ClaimRebalance(ctx, concurrency, staleBefore)
+	if err != nil {
+		return 0, errors.Wrap(err, "claiming gitserver repo moves")
+	}
+
+	var wg sync.WaitGroup
+	for _, m := range moves {
+		wg.Add(1)
+		go func(m *database.GitserverRepoMove) {
+			defer wg.Done()
+			migrateGitserverRepo(ctx, logger, store, client, m)
+		}(m)
+	}
+	wg.Wait()
+
+	return len(moves), nil
+}
+
+func migrateGitserverRepo(ctx context.Context, logger log.Logger, store database.GitserverRepoStore, client gitserver.Client, m *database.GitserverRepoMove) {
+	migrateCtx, cancel := context.WithTimeout(ctx, conf.GitLongCommandTimeout())
+	defer cancel()
+
+	resp, err := client.RequestRepoMigrate(migrateCtx, m.RepoName, m.From, m.To)
+	if err == nil && resp != nil && resp.Error != "" {
+		err = errors.New(resp.Error)
+	}
+
+	state, message := database.GitserverRebalanceCompleted, ""
+	if err != nil {
+		logger.Warn("failed to move repository to its new gitserver shard",
+			log.String("repo", string(m.RepoName)),
+			log.String("from", m.From),
+			log.String("to", m.To),
+			log.Error(err),
+		)
+		state, message = database.GitserverRebalanceErrored, err.Error()
+		gitserverRebalanceFailed.Inc()
+	} else {
+		gitserverRebalanceSuccess.Inc()
+	}
+
+	if err := store.SetRebalanceState(ctx, m.RepoID, state, message); err != nil {
+		logger.Error("failed to record the state of a gitserver repo move", log.String("repo", string(m.RepoName)), log.Error(err))
+	}
+}
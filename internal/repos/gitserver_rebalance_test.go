@@ -0,0 +1,200 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestPlanGitserverRebalance(t *testing.T) {
+	ctx := context.Background()
+
+	var statuses []types.RepoGitserverStatus
+	for i := 1; i <= 100; i++ {
+		cloneStatus := types.CloneStatusCloned
+		if i%10 == 0 {
+			cloneStatus = types.CloneStatusNotCloned
+		}
+		statuses = append(statuses, types.RepoGitserverStatus{
+			ID:            api.RepoID(i),
+			Name:          api.RepoName(fmt.Sprintf("github.com/sourcegraph/repo-%d", i)),
+			GitserverRepo: &types.GitserverRepo{RepoID: api.RepoID(i), CloneStatus: cloneStatus},
+		})
+	}
+
+	gitserverRepos := database.NewMockGitserverRepoStore()
+	gitserverRepos.IterateRepoGitserverStatusFunc.SetDefaultHook(func(_ context.Context, opts database.IterateRepoGitserverStatusOptions) ([]types.RepoGitserverStatus, int, error) {
+		var rs []types.RepoGitserverStatus
+		for _, s := range statuses {
+			if int(s.ID) > opts.NextCursor && len(rs) < 30 {
+				rs = append(rs, s)
+			}
+		}
+		if len(rs) == 0 {
+			return nil, 0, nil
+		}
+		return rs, int(rs[len(rs)-1].ID), nil
+	})
+
+	var queued []*database.GitserverRepoMove
+	gitserverRepos.QueueRebalanceFunc.SetDefaultHook(func(_ context.Context, moves ...*database.GitserverRepoMove) error {
+		queued = moves
+		return nil
+	})
+
+	db := database.NewMockDB()
+	db.GitserverReposFunc.SetDefaultReturn(gitserverRepos)
+
+	current := gitserver.GitServerAddresses{
+		Addresses:     []string{"gitserver-0", "gitserver-1"},
+		PinnedServers: map[string]string{"github.com/sourcegraph/repo-1": "gitserver-0"},
+	}
+	target := gitserver.GitServerAddresses{
+		Addresses:     []string{"gitserver-0", "gitserver-1", "gitserver-2"},
+		PinnedServers: current.PinnedServers,
+	}
+
+	n, err := planGitserverRebalance(ctx, db, current, target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have, want := n, len(queued); have != want {
+		t.Fatalf("wrong number of planned moves. have=%d, want=%d", have, want)
+	}
+	if len(queued) == 0 {
+		t.Fatal("expected some repos to move")
+	}
+
+	moved := make(map[api.RepoName]*database.GitserverRepoMove, len(queued))
+	for _, m := range queued {
+		moved[m.RepoName] = m
+	}
+
+	for _, s := range statuses {
+		from, err := gitserver.AddrForRepo(ctx, "test", db, s.Name, current)
+		if err != nil {
+			t.Fatal(err)
+		}
+		to, err := gitserver.AddrForRepo(ctx, "test", db, s.Name, target)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		m, ok := moved[s.Name]
+		if s.CloneStatus != types.CloneStatusCloned || from == to {
+			if ok {
+				t.Errorf("unexpected move of %q: %+v", s.Name, m)
+			}
+			continue
+		}
+
+		want := &database.GitserverRepoMove{RepoID: s.ID, RepoName: s.Name, From: from, To: to}
+		if diff := cmp.Diff(want, m); diff != "" {
+			t.Errorf("wrong move of %q (-want +got):\n%s", s.Name, diff)
+		}
+	}
+
+	if _, ok := moved["github.com/sourcegraph/repo-1"]; ok {
+		t.Error("pinned repo should not move")
+	}
+
+	t.Run("invalid target", func(t *testing.T) {
+		for _, addrs := range [][]string{
+			nil,
+			{"gitserver-0", ""},
+			{"gitserver-0", "gitserver-0"},
+		} {
+			if _, err := planGitserverRebalance(ctx, db, current, gitserver.GitServerAddresses{Addresses: addrs}); err == nil {
+				t.Errorf("expected error for target %q", addrs)
+			}
+		}
+	})
+}
+
+func TestRebalanceGitservers(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+
+	moves := []*database.GitserverRepoMove{
+		{RepoID: 1, RepoName: "github.com/sourcegraph/ok", From: "gitserver-0", To: "gitserver-1"},
+		{RepoID: 2, RepoName: "github.com/sourcegraph/clone-error", From: "gitserver-0", To: "gitserver-2"},
+		{RepoID: 3, RepoName: "github.com/sourcegraph/request-error", From: "gitserver-1", To: "gitserver-2"},
+	}
+
+	store := database.NewMockGitserverRepoStore()
+	store.ClaimRebalanceFunc.SetDefaultHook(func(_ context.Context, limit int, _ time.Time) ([]*database.GitserverRepoMove, error) {
+		if have, want := limit, 3; have != want {
+			t.Errorf("wrong claim limit. have=%d, want=%d", have, want)
+		}
+		return moves, nil
+	})
+
+	type result struct {
+		state database.GitserverRebalanceState
+		err   string
+	}
+	var mu sync.Mutex
+	results := map[api.RepoID]result{}
+	store.SetRebalanceStateFunc.SetDefaultHook(func(_ context.Context, id api.RepoID, state database.GitserverRebalanceState, err string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		results[id] = result{state: state, err: err}
+		return nil
+	})
+
+	var migrated []string
+	client := gitserver.NewMockClient()
+	client.RequestRepoMigrateFunc.SetDefaultHook(func(_ context.Context, repo api.RepoName, from, to string) (*protocol.RepoUpdateResponse, error) {
+		mu.Lock()
+		migrated = append(migrated, fmt.Sprintf("%s:%s->%s", repo, from, to))
+		mu.Unlock()
+
+		switch repo {
+		case "github.com/sourcegraph/clone-error":
+			return &protocol.RepoUpdateResponse{Error: "clone failed"}, nil
+		case "github.com/sourcegraph/request-error":
+			return nil, errors.New("connection refused")
+		}
+		return &protocol.RepoUpdateResponse{}, nil
+	})
+
+	n, err := rebalanceGitservers(ctx, logger, store, client, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have, want := n, 3; have != want {
+		t.Fatalf("wrong number of moves. have=%d, want=%d", have, want)
+	}
+
+	sort.Strings(migrated)
+	wantMigrated := []string{
+		"github.com/sourcegraph/clone-error:gitserver-0->gitserver-2",
+		"github.com/sourcegraph/ok:gitserver-0->gitserver-1",
+		"github.com/sourcegraph/request-error:gitserver-1->gitserver-2",
+	}
+	if diff := cmp.Diff(wantMigrated, migrated); diff != "" {
+		t.Fatalf("wrong migrations (-want +got):\n%s", diff)
+	}
+
+	wantResults := map[api.RepoID]result{
+		1: {state: database.GitserverRebalanceCompleted},
+		2: {state: database.GitserverRebalanceErrored, err: "clone failed"},
+		3: {state: database.GitserverRebalanceErrored, err: "connection refused"},
+	}
+	if diff := cmp.Diff(wantResults, results, cmp.AllowUnexported(result{})); diff != "" {
+		t.Fatalf("wrong results (-want +got):\n%s", diff)
+	}
+}
@@ -62,6 +62,16 @@ var (
 		Help: "Incremented each time we try and fail to remove a repository clone.",
 	})
 
+	gitserverRebalanceSuccess = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "src_repoupdater_gitserver_rebalance_success",
+		Help: "Incremented each time we move a repository clone to its new gitserver shard.",
+	})
+
+	gitserverRebalanceFailed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "src_repoupdater_gitserver_rebalance_failed",
+		Help: "Incremented each time we try and fail to move a repository clone to its new gitserver shard.",
+	})
+
 	schedError = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "src_repoupdater_sched_error",
 		Help: "Incremented each time we encounter an error updating a repository.",
@@ -0,0 +1,40 @@
+package repos
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestNuGetPackagesSource_GetRepo(t *testing.T) {
+	ctx := context.Background()
+	svc := types.ExternalService{
+		ID:   1,
+		Kind: extsvc.KindNuGetPackages,
+		Config: extsvc.NewUnencryptedConfig(marshalJSON(t, &schema.NuGetPackagesConnection{
+			Dependencies: []string{"Newtonsoft.Json@13.0.1"},
+		})),
+	}
+
+	src, err := NewNuGetPackagesSource(ctx, &svc, httpcli.NewFactory(nil))
+	require.NoError(t, err)
+
+	repo, err := src.GetRepo(ctx, "nuget/Newtonsoft.Json")
+	require.NoError(t, err)
+	require.Equal(t, api.RepoName("nuget/Newtonsoft.Json"), repo.Name)
+	require.Equal(t, api.ExternalRepoSpec{
+		ID:          "nuget/Newtonsoft.Json",
+		ServiceID:   extsvc.TypeNuGetPackages,
+		ServiceType: extsvc.TypeNuGetPackages,
+	}, repo.ExternalRepo)
+
+	_, err = src.GetRepo(ctx, "npm/Newtonsoft.Json")
+	require.Error(t, err)
+}
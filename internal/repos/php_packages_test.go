@@ -0,0 +1,41 @@
+package repos
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestPhpPackagesSource_GetRepo(t *testing.T) {
+	ctx := context.Background()
+	svc := types.ExternalService{
+		ID:   1,
+		Kind: extsvc.KindPhpPackages,
+		Config: extsvc.NewUnencryptedConfig(marshalJSON(t, &schema.PhpPackagesConnection{
+			Dependencies: []string{"monolog/monolog@3.2.0"},
+		})),
+	}
+
+	src, err := NewPhpPackagesSource(ctx, &svc, httpcli.NewFactory(nil))
+	require.NoError(t, err)
+
+	// Composer package names are case-insensitive.
+	repo, err := src.GetRepo(ctx, "packagist/Monolog/Monolog")
+	require.NoError(t, err)
+	require.Equal(t, api.RepoName("packagist/monolog/monolog"), repo.Name)
+	require.Equal(t, api.ExternalRepoSpec{
+		ID:          "packagist/monolog/monolog",
+		ServiceID:   extsvc.TypePhpPackages,
+		ServiceType: extsvc.TypePhpPackages,
+	}, repo.ExternalRepo)
+
+	_, err = src.GetRepo(ctx, "packagist/monolog")
+	require.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"container/heap"
 	"context"
 	"math/rand"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -15,12 +16,14 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	gitserverprotocol "github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/mutablelimiter"
 	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
 	"github.com/sourcegraph/sourcegraph/internal/repoupdater/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 // schedulerConfig tracks the active scheduler configuration.
@@ -88,6 +91,10 @@ const (
 
 	// maxDelay is the maximum amount of time between scheduled updates for a single repository.
 	maxDelay = 8 * time.Hour
+
+	// storeTimeout bounds the calls to the persisted schedule, so that a slow
+	// database cannot stall the scheduler.
+	storeTimeout = 30 * time.Second
 )
 
 // UpdateScheduler schedules repo update (or clone) requests to gitserver.
@@ -108,6 +115,12 @@ const (
 //
 // A worker continuously dequeues repos and sends updates to gitserver, but its concurrency
 // is limited by the gitMaxConcurrentClones site configuration.
+//
+// The schedule is persisted in the repo_update_schedule table, so that intervals and
+// backoff survive restarts, and the in-memory schedule acts as a cache of it. Multiple
+// repo-updater instances can share the persisted schedule: when a repo is due, an
+// instance claims it with a row lock before enqueuing it, so only one instance enqueues
+// each scheduled update. The update queue itself is local to each instance.
 type UpdateScheduler struct {
 	db          database.DB
 	updateQueue *updateQueue
@@ -143,6 +156,7 @@ func NewUpdateScheduler(logger log.Logger, db database.DB) *UpdateScheduler {
 			wakeup:        make(chan struct{}, notifyChanBuffer),
 			randGenerator: rand.New(rand.NewSource(time.Now().UnixNano())),
 			logger:        updateSchedLogger.Scoped("Schedule", ""),
+			store:         db.RepoUpdateSchedule(),
 		},
 		logger: updateSchedLogger,
 	}
@@ -150,6 +164,11 @@ func NewUpdateScheduler(logger log.Logger, db database.DB) *UpdateScheduler {
 
 // runScheduleLoop starts the loop that schedules updates by enqueuing them into the updateQueue.
 func (s *UpdateScheduler) runScheduleLoop(ctx context.Context) {
+	if err := s.schedule.restore(ctx); err != nil {
+		schedError.WithLabelValues("restoreSchedule").Inc()
+		s.logger.Error("error restoring persisted schedule", log.Error(err))
+	}
+
 	for {
 		select {
 		case <-s.schedule.wakeup:
@@ -158,26 +177,71 @@ func (s *UpdateScheduler) runScheduleLoop(ctx context.Context) {
 			return
 		}
 
-		s.runSchedule()
+		s.runSchedule(ctx)
 		schedLoops.Inc()
 	}
 }
 
-func (s *UpdateScheduler) runSchedule() {
+func (s *UpdateScheduler) runSchedule(ctx context.Context) {
+	now := timeNow()
+
+	// Snapshot the due repos, so that they can be claimed in the persisted schedule
+	// without holding the lock.
+	s.schedule.mu.Lock()
+	due := s.schedule.due(now)
+	if len(due) == 0 {
+		s.schedule.rescheduleTimer()
+		s.schedule.mu.Unlock()
+		return
+	}
+	snapshot := make([]scheduledRepoUpdate, 0, len(due))
+	for _, update := range due {
+		snapshot = append(snapshot, *update)
+	}
+	s.schedule.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, storeTimeout)
+	claimed, skipped, err := s.schedule.claim(ctx, now, snapshot)
+	cancel()
+	if err != nil {
+		// Rather than stalling all updates while the database is unavailable, we
+		// fall back to the in-memory schedule.
+		schedError.WithLabelValues("claimSchedule").Inc()
+		s.logger.Error("error claiming due repos in persisted schedule", log.Error(err))
+	}
+
 	s.schedule.mu.Lock()
 	defer s.schedule.mu.Unlock()
 	defer s.schedule.rescheduleTimer()
 
-	for len(s.schedule.heap) != 0 {
-		repoUpdate := s.schedule.heap[0]
-		if !repoUpdate.Due.Before(timeNow().Add(time.Millisecond)) {
-			break
+	for _, update := range snapshot {
+		repoUpdate := s.schedule.index[update.Repo.ID]
+		if repoUpdate == nil {
+			// The repo was removed from the schedule while claiming it.
+			continue
+		}
+
+		if persisted, ok := skipped[repoUpdate.Repo.ID]; ok {
+			// Another repo-updater instance enqueued this update, so we only catch
+			// up with its schedule.
+			repoUpdate.Interval = persisted.Interval
+			repoUpdate.Due = persisted.DueAt
+			if !repoUpdate.Due.After(now) {
+				repoUpdate.Due = now.Add(repoUpdate.Interval)
+			}
+			heap.Fix(s.schedule, repoUpdate.Index)
+			continue
 		}
 
 		schedAutoFetch.Inc()
 		s.updateQueue.enqueue(repoUpdate.Repo, priorityLow)
-		repoUpdate.Due = timeNow().Add(repoUpdate.Interval)
-		heap.Fix(s.schedule, 0)
+		if persisted, ok := claimed[repoUpdate.Repo.ID]; ok {
+			repoUpdate.Interval = persisted.Interval
+			repoUpdate.Due = persisted.DueAt
+		} else {
+			repoUpdate.Due = now.Add(repoUpdate.Interval)
+		}
+		heap.Fix(s.schedule, repoUpdate.Index)
 	}
 }
 
@@ -229,22 +293,32 @@ func (s *UpdateScheduler) runUpdateLoop(ctx context.Context) {
 					}
 				}
 
+				var lastError string
+				if err != nil {
+					lastError = err.Error()
+				} else if resp != nil {
+					lastError = resp.Error
+				}
+
 				if interval := getCustomInterval(subLogger, conf.Get(), string(repo.Name)); interval > 0 {
-					s.schedule.updateInterval(repo, interval)
+					s.schedule.updateInterval(repo, interval, lastError)
 					return
 				}
 
-				if err != nil || (resp != nil && resp.Error != "") {
+				if lastError != "" {
 					// On error we will double the current interval so that we back off and don't
 					// get stuck with problematic repos with low intervals.
 					if currentInterval, ok := s.schedule.getCurrentInterval(repo); ok {
-						s.schedule.updateInterval(repo, currentInterval*2)
+						s.schedule.updateInterval(repo, currentInterval*2, lastError)
 					}
 				} else if resp != nil && resp.LastFetched != nil && resp.LastChanged != nil {
 					// This is the heuristic that is described in the UpdateScheduler documentation.
 					// Update that documentation if you update this logic.
 					interval := resp.LastFetched.Sub(*resp.LastChanged) / 2
-					s.schedule.updateInterval(repo, interval)
+					s.schedule.updateInterval(repo, interval, lastError)
+				} else {
+					// Keep the current interval, but record that the update succeeded.
+					s.schedule.updateInterval(repo, 0, lastError)
 				}
 			}(ctx, repo, cancel)
 		}
@@ -403,33 +477,23 @@ func (s *UpdateScheduler) UpdateOnce(id api.RepoID, name api.RepoName) {
 	s.updateQueue.enqueue(repo, priorityHigh)
 }
 
-// DebugDump returns the state of the update scheduler for debugging.
+// DebugDump returns the state of the update scheduler for debugging. The schedule is
+// read from the persisted schedule shared by all repo-updater instances, while the
+// update queue is the one of this instance.
 func (s *UpdateScheduler) DebugDump(ctx context.Context) any {
 	data := struct {
 		Name        string
 		UpdateQueue []*repoUpdate
-		Schedule    []*scheduledRepoUpdate
+		Schedule    []*database.RepoUpdateSchedule
 		SyncJobs    []*types.ExternalServiceSyncJob
 	}{
 		Name: "repos",
 	}
 
-	s.schedule.mu.Lock()
-	schedule := schedule{
-		heap: make([]*scheduledRepoUpdate, len(s.schedule.heap)),
-	}
-	for i, update := range s.schedule.heap {
-		// Copy the scheduledRepoUpdate as a value so that
-		// popping off the heap here won't update the index value of the real heap, and
-		// we don't do a racy read on the repo pointer which may change concurrently in the real heap.
-		updateCopy := *update
-		schedule.heap[i] = &updateCopy
-	}
-	s.schedule.mu.Unlock()
-
-	for len(schedule.heap) > 0 {
-		update := heap.Pop(&schedule).(*scheduledRepoUpdate)
-		data.Schedule = append(data.Schedule, update)
+	var err error
+	data.Schedule, err = s.schedule.store.List(ctx)
+	if err != nil {
+		s.logger.Warn("getting persisted schedule for debug page", log.Error(err))
 	}
 
 	s.updateQueue.mu.Lock()
@@ -452,7 +516,6 @@ func (s *UpdateScheduler) DebugDump(ctx context.Context) any {
 		data.UpdateQueue = append(data.UpdateQueue, update)
 	}
 
-	var err error
 	data.SyncJobs, err = s.db.ExternalServices().GetSyncJobs(ctx, database.ExternalServicesGetSyncJobsOptions{})
 	if err != nil {
 		s.logger.Warn("getting external service sync jobs for debug page", log.Error(err))
@@ -461,20 +524,33 @@ func (s *UpdateScheduler) DebugDump(ctx context.Context) any {
 	return &data
 }
 
-// ScheduleInfo returns the current schedule info for a repo.
-func (s *UpdateScheduler) ScheduleInfo(id api.RepoID) *protocol.RepoUpdateSchedulerInfoResult {
+// ScheduleInfo returns the current schedule info for a repo. The schedule state is
+// read from the persisted schedule, while the queue state is the one of this instance.
+func (s *UpdateScheduler) ScheduleInfo(ctx context.Context, id api.RepoID) (*protocol.RepoUpdateSchedulerInfoResult, error) {
 	var result protocol.RepoUpdateSchedulerInfoResult
 
-	s.schedule.mu.Lock()
-	if update := s.schedule.index[id]; update != nil {
+	update, err := s.schedule.store.GetByRepoID(ctx, id)
+	if err != nil && !errcode.IsNotFound(err) {
+		return nil, err
+	}
+	if update != nil {
+		index, err := s.schedule.store.CountDueBefore(ctx, update.DueAt)
+		if err != nil {
+			return nil, err
+		}
+		total, err := s.schedule.store.Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+
 		result.Schedule = &protocol.RepoScheduleState{
-			Index:           update.Index,
-			Total:           len(s.schedule.index),
+			Index:           index,
+			Total:           total,
 			IntervalSeconds: int(update.Interval / time.Second),
-			Due:             update.Due,
+			Due:             update.DueAt,
+			LastError:       update.LastError,
 		}
 	}
-	s.schedule.mu.Unlock()
 
 	s.updateQueue.mu.Lock()
 	if update := s.updateQueue.index[id]; update != nil {
@@ -487,7 +563,7 @@ func (s *UpdateScheduler) ScheduleInfo(id api.RepoID) *protocol.RepoUpdateSchedu
 	}
 	s.updateQueue.mu.Unlock()
 
-	return &result
+	return &result, nil
 }
 
 // updateQueue is a priority queue of repos to update.
@@ -690,6 +766,10 @@ type schedule struct {
 	randGenerator interface {
 		Int63n(n int64) int64
 	}
+
+	// store persists the schedule, so that it survives restarts and can be
+	// shared by multiple repo-updater instances.
+	store database.RepoUpdateScheduleStore
 }
 
 // scheduledRepoUpdate is the update schedule for a single repo.
@@ -707,20 +787,25 @@ func (s *schedule) upsert(repo configuredRepo) (updated bool) {
 	}
 
 	s.mu.Lock()
-	defer s.mu.Unlock()
-
 	if update := s.index[repo.ID]; update != nil {
 		update.Repo = repo
+		s.mu.Unlock()
 		return true
 	}
 
+	due := timeNow().Add(minDelay)
 	heap.Push(s, &scheduledRepoUpdate{
 		Repo:     repo,
 		Interval: minDelay,
-		Due:      timeNow().Add(minDelay),
+		Due:      due,
 	})
 
 	s.rescheduleTimer()
+	s.mu.Unlock()
+
+	s.persist("inserting repo", func(ctx context.Context) error {
+		return s.store.Insert(ctx, minDelay, due, repo.ID)
+	})
 
 	return false
 }
@@ -731,13 +816,14 @@ func (s *schedule) prioritiseUncloned(uncloned []types.MinimalRepo) {
 	notClonedDue := timeNow().Add(minDelay)
 
 	s.mu.Lock()
-	defer s.mu.Unlock()
 
 	// Iterate over all repos in the scheduler. If it isn't in cloned bump it
 	// up the queue. Note: we iterate over index because we will be mutating
 	// heap.
 	rescheduleTimer := false
+	ids := make([]api.RepoID, 0, len(uncloned))
 	for _, repo := range uncloned {
+		ids = append(ids, repo.ID)
 		if repoUpdate := s.index[repo.ID]; repoUpdate == nil {
 			heap.Push(s, &scheduledRepoUpdate{
 				Repo:     configuredRepo{ID: repo.ID, Name: repo.Name},
@@ -756,6 +842,11 @@ func (s *schedule) prioritiseUncloned(uncloned []types.MinimalRepo) {
 	if rescheduleTimer {
 		s.rescheduleTimer()
 	}
+	s.mu.Unlock()
+
+	s.persist("prioritising uncloned repos", func(ctx context.Context) error {
+		return s.store.Prioritise(ctx, minDelay, notClonedDue, ids...)
+	})
 }
 
 // insertNew will insert repos only if they are not known to the scheduler
@@ -777,8 +868,7 @@ func (s *schedule) insertNew(repos []types.MinimalRepo) {
 	rescheduleTimer := false
 
 	s.mu.Lock()
-	defer s.mu.Unlock()
-
+	var inserted []api.RepoID
 	for _, repo := range configuredRepos {
 		if update := s.index[repo.ID]; update != nil {
 			continue
@@ -788,23 +878,39 @@ func (s *schedule) insertNew(repos []types.MinimalRepo) {
 			Interval: minDelay,
 			Due:      due,
 		})
+		inserted = append(inserted, repo.ID)
 		rescheduleTimer = true
 	}
 
 	if rescheduleTimer {
 		s.rescheduleTimer()
 	}
+	s.mu.Unlock()
+
+	if len(inserted) == 0 {
+		return
+	}
+	s.persist("inserting new repos", func(ctx context.Context) error {
+		return s.store.Insert(ctx, minDelay, due, inserted...)
+	})
 }
 
-// updateInterval updates the update interval of a repo in the schedule.
-// It does nothing if the repo is not in the schedule.
-func (s *schedule) updateInterval(repo configuredRepo, interval time.Duration) {
+// updateInterval updates the update interval of a repo in the schedule and records
+// the outcome of its last update. An interval of zero keeps the current interval and
+// due time. It does nothing if the repo is not in the schedule.
+func (s *schedule) updateInterval(repo configuredRepo, interval time.Duration, lastError string) {
 	if repo.ID == 0 {
 		panic("repo.id is zero")
 	}
 
 	s.mu.Lock()
-	if update := s.index[repo.ID]; update != nil {
+	update := s.index[repo.ID]
+	if update == nil {
+		s.mu.Unlock()
+		return
+	}
+
+	if interval != 0 {
 		switch {
 		case interval > maxDelay:
 			update.Interval = maxDelay
@@ -826,7 +932,20 @@ func (s *schedule) updateInterval(repo configuredRepo, interval time.Duration) {
 		heap.Fix(s, update.Index)
 		s.rescheduleTimer()
 	}
+
+	persisted := &database.RepoUpdateSchedule{
+		RepoID:        repo.ID,
+		RepoName:      repo.Name,
+		Interval:      update.Interval,
+		DueAt:         update.Due,
+		LastError:     lastError,
+		LastUpdatedAt: timeNow(),
+	}
 	s.mu.Unlock()
+
+	s.persist("updating repo interval", func(ctx context.Context) error {
+		return s.store.Upsert(ctx, persisted)
+	})
 }
 
 // getCurrentInterval gets the current interval for the supplied repo and a bool
@@ -849,10 +968,9 @@ func (s *schedule) remove(repo configuredRepo) (removed bool) {
 	}
 
 	s.mu.Lock()
-	defer s.mu.Unlock()
-
 	update := s.index[repo.ID]
 	if update == nil {
+		s.mu.Unlock()
 		return false
 	}
 
@@ -860,10 +978,150 @@ func (s *schedule) remove(repo configuredRepo) (removed bool) {
 	if heap.Remove(s, update.Index); reschedule {
 		s.rescheduleTimer()
 	}
+	s.mu.Unlock()
+
+	s.persist("removing repo", func(ctx context.Context) error {
+		return s.store.Delete(ctx, repo.ID)
+	})
 
 	return true
 }
 
+// due returns the repos which are due at now, ordered by their due time.
+// The caller must hold the lock on s.mu.
+func (s *schedule) due(now time.Time) []*scheduledRepoUpdate {
+	// The children of an update in the heap are never due before it, so we only
+	// visit the due updates and their direct children rather than the whole heap.
+	var due []*scheduledRepoUpdate
+	stack := []int{0}
+	for len(stack) > 0 {
+		i := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if i >= len(s.heap) || !s.heap[i].Due.Before(now.Add(time.Millisecond)) {
+			continue
+		}
+		due = append(due, s.heap[i])
+		stack = append(stack, 2*i+1, 2*i+2)
+	}
+
+	sort.SliceStable(due, func(i, j int) bool {
+		if !due[i].Due.Equal(due[j].Due) {
+			return due[i].Due.Before(due[j].Due)
+		}
+		return due[i].Repo.ID < due[j].Repo.ID
+	})
+
+	return due
+}
+
+// claim claims the given due repos in the persisted schedule. It returns the
+// schedules of the repos claimed by this instance, and the schedules of the repos
+// which were claimed by another instance or are not yet due according to the
+// persisted schedule. Repos missing from the persisted schedule are in neither and
+// are inserted into it.
+// The caller must not hold the lock on s.mu, and passes a snapshot of the due repos.
+func (s *schedule) claim(ctx context.Context, now time.Time, due []scheduledRepoUpdate) (claimed, skipped map[api.RepoID]*database.RepoUpdateSchedule, err error) {
+	ids := make([]api.RepoID, 0, len(due))
+	for _, update := range due {
+		ids = append(ids, update.Repo.ID)
+	}
+
+	rows, err := s.store.Claim(ctx, now, ids...)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "claiming due repos")
+	}
+
+	claimed = make(map[api.RepoID]*database.RepoUpdateSchedule, len(rows))
+	for _, row := range rows {
+		claimed[row.RepoID] = row
+	}
+
+	var unclaimed []api.RepoID
+	for _, id := range ids {
+		if _, ok := claimed[id]; !ok {
+			unclaimed = append(unclaimed, id)
+		}
+	}
+	if len(unclaimed) == 0 {
+		return claimed, nil, nil
+	}
+
+	rows, err = s.store.ListByRepoIDs(ctx, unclaimed)
+	if err != nil {
+		return claimed, nil, errors.Wrap(err, "listing unclaimed repos")
+	}
+
+	skipped = make(map[api.RepoID]*database.RepoUpdateSchedule, len(rows))
+	for _, row := range rows {
+		skipped[row.RepoID] = row
+	}
+
+	// Repos which are not in the persisted schedule yet, for example because
+	// persisting them failed earlier, are enqueued by this instance and inserted so
+	// that other instances see their schedule from now on.
+	for _, update := range due {
+		_, isClaimed := claimed[update.Repo.ID]
+		_, isSkipped := skipped[update.Repo.ID]
+		if isClaimed || isSkipped {
+			continue
+		}
+
+		if err := s.store.Upsert(ctx, &database.RepoUpdateSchedule{
+			RepoID:   update.Repo.ID,
+			Interval: update.Interval,
+			DueAt:    now.Add(update.Interval),
+		}); err != nil {
+			return claimed, skipped, errors.Wrap(err, "inserting unscheduled repo")
+		}
+	}
+
+	return claimed, skipped, nil
+}
+
+// restore loads the persisted schedule, overriding the interval and due time of
+// repos which are already in the schedule.
+func (s *schedule) restore(ctx context.Context) error {
+	persisted, err := s.store.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, p := range persisted {
+		if update := s.index[p.RepoID]; update != nil {
+			update.Interval = p.Interval
+			update.Due = p.DueAt
+			heap.Fix(s, update.Index)
+			continue
+		}
+
+		heap.Push(s, &scheduledRepoUpdate{
+			Repo:     configuredRepo{ID: p.RepoID, Name: p.RepoName},
+			Interval: p.Interval,
+			Due:      p.DueAt,
+		})
+	}
+
+	s.rescheduleTimer()
+
+	return nil
+}
+
+// persist runs the given write against the persisted schedule. The in-memory
+// schedule stays authoritative for this instance, so failures are only logged.
+// The caller must not hold the lock on s.mu.
+func (s *schedule) persist(action string, write func(ctx context.Context) error) {
+	ctx, cancel := context.WithTimeout(context.Background(), storeTimeout)
+	defer cancel()
+
+	if err := write(ctx); err != nil {
+		schedError.WithLabelValues("persistSchedule").Inc()
+		s.logger.Error("error persisting schedule", log.String("action", action), log.Error(err))
+	}
+}
+
 // rescheduleTimer schedules the scheduler to wakeup
 // at the time that the next repo is due for an update.
 // The caller must hold the lock on s.mu.
@@ -3,7 +3,9 @@ package repos
 import (
 	"container/heap"
 	"context"
+	"fmt"
 	"reflect"
+	"sort"
 	"testing"
 	"time"
 
@@ -35,6 +37,14 @@ func mockTime(t time.Time) {
 	}
 }
 
+// newMockSchedulerDB returns a mock database whose persisted update schedule
+// is empty and accepts all writes.
+func newMockSchedulerDB() *database.MockDB {
+	db := database.NewMockDB()
+	db.RepoUpdateScheduleFunc.SetDefaultReturn(database.NewMockRepoUpdateScheduleStore())
+	return db
+}
+
 type recording struct {
 	notifications       []chan struct{}
 	timeAfterFuncDelays []time.Duration
@@ -69,7 +79,7 @@ func TestUpdateQueue_enqueue(t *testing.T) {
 	c := configuredRepo{ID: 3, Name: "c"}
 	d := configuredRepo{ID: 4, Name: "d"}
 	e := configuredRepo{ID: 5, Name: "e"}
-	db := database.NewMockDB()
+	db := newMockSchedulerDB()
 
 	type enqueueCall struct {
 		repo     configuredRepo
@@ -444,7 +454,7 @@ func TestUpdateQueue_remove(t *testing.T) {
 			r, stop := startRecording()
 			defer stop()
 
-			s := NewUpdateScheduler(logtest.Scoped(t), database.NewMockDB())
+			s := NewUpdateScheduler(logtest.Scoped(t), newMockSchedulerDB())
 			setupInitialQueue(s, test.initialQueue)
 
 			// Perform the removals.
@@ -516,7 +526,7 @@ func TestUpdateQueue_acquireNext(t *testing.T) {
 			r, stop := startRecording()
 			defer stop()
 
-			s := NewUpdateScheduler(logtest.Scoped(t), database.NewMockDB())
+			s := NewUpdateScheduler(logtest.Scoped(t), newMockSchedulerDB())
 			setupInitialQueue(s, test.initialQueue)
 
 			// Test aquireNext.
@@ -649,7 +659,7 @@ func Test_updateScheduler_UpdateFromDiff(t *testing.T) {
 			_, stop := startRecording()
 			defer stop()
 
-			s := NewUpdateScheduler(logtest.Scoped(t), database.NewMockDB())
+			s := NewUpdateScheduler(logtest.Scoped(t), newMockSchedulerDB())
 			setupInitialSchedule(s, test.initialSchedule)
 			setupInitialQueue(s, test.initialQueue)
 
@@ -795,7 +805,7 @@ func TestSchedule_upsert(t *testing.T) {
 			r, stop := startRecording()
 			defer stop()
 
-			s := NewUpdateScheduler(logtest.Scoped(t), database.NewMockDB())
+			s := NewUpdateScheduler(logtest.Scoped(t), newMockSchedulerDB())
 			setupInitialSchedule(s, test.initialSchedule)
 
 			for _, call := range test.upsertCalls {
@@ -817,7 +827,7 @@ func TestUpdateQueue_PrioritiseUncloned(t *testing.T) {
 	_, stop := startRecording()
 	defer stop()
 
-	s := NewUpdateScheduler(logtest.Scoped(t), database.NewMockDB())
+	s := NewUpdateScheduler(logtest.Scoped(t), newMockSchedulerDB())
 
 	assertFront := func(name api.RepoName) {
 		t.Helper()
@@ -855,7 +865,7 @@ func TestScheduleInsertNew(t *testing.T) {
 	_, stop := startRecording()
 	defer stop()
 
-	s := NewUpdateScheduler(logtest.Scoped(t), database.NewMockDB())
+	s := NewUpdateScheduler(logtest.Scoped(t), newMockSchedulerDB())
 
 	assertFront := func(name api.RepoName) {
 		t.Helper()
@@ -1046,13 +1056,13 @@ func TestSchedule_updateInterval(t *testing.T) {
 			r, stop := startRecording()
 			defer stop()
 
-			s := NewUpdateScheduler(logtest.Scoped(t), database.NewMockDB())
+			s := NewUpdateScheduler(logtest.Scoped(t), newMockSchedulerDB())
 			setupInitialSchedule(s, test.initialSchedule)
 			s.schedule.randGenerator = &mockRandomGenerator{}
 
 			for _, call := range test.updateCalls {
 				mockTime(call.time)
-				s.schedule.updateInterval(call.repo, call.interval)
+				s.schedule.updateInterval(call.repo, call.interval, "")
 			}
 
 			verifySchedule(t, s, test.finalSchedule)
@@ -1145,7 +1155,7 @@ func TestSchedule_remove(t *testing.T) {
 			r, stop := startRecording()
 			defer stop()
 
-			s := NewUpdateScheduler(logtest.Scoped(t), database.NewMockDB())
+			s := NewUpdateScheduler(logtest.Scoped(t), newMockSchedulerDB())
 			setupInitialSchedule(s, test.initialSchedule)
 
 			for _, call := range test.removeCalls {
@@ -1307,11 +1317,11 @@ func TestUpdateScheduler_runSchedule(t *testing.T) {
 			r, stop := startRecording()
 			defer stop()
 
-			s := NewUpdateScheduler(logtest.Scoped(t), database.NewMockDB())
+			s := NewUpdateScheduler(logtest.Scoped(t), newMockSchedulerDB())
 
 			setupInitialSchedule(s, test.initialSchedule)
 
-			s.runSchedule()
+			s.runSchedule(context.Background())
 
 			verifySchedule(t, s, test.finalSchedule)
 			verifyQueue(t, s, test.finalQueue)
@@ -1320,6 +1330,150 @@ func TestUpdateScheduler_runSchedule(t *testing.T) {
 	}
 }
 
+func TestUpdateScheduler_runSchedulePersisted(t *testing.T) {
+	a := configuredRepo{ID: 1, Name: "a"}
+	b := configuredRepo{ID: 2, Name: "b"}
+	c := configuredRepo{ID: 3, Name: "c"}
+
+	_, stop := startRecording()
+	defer stop()
+
+	store := database.NewMockRepoUpdateScheduleStore()
+	store.ClaimFunc.SetDefaultHook(func(_ context.Context, now time.Time, ids ...api.RepoID) ([]*database.RepoUpdateSchedule, error) {
+		if diff := cmp.Diff([]api.RepoID{a.ID, b.ID, c.ID}, ids); diff != "" {
+			t.Errorf("unexpected claimed ids (-want +got):\n%s", diff)
+		}
+		// a is claimed by this instance, b by another one.
+		return []*database.RepoUpdateSchedule{
+			{RepoID: a.ID, RepoName: a.Name, Interval: 2 * time.Minute, DueAt: now.Add(2 * time.Minute)},
+		}, nil
+	})
+	store.ListByRepoIDsFunc.SetDefaultReturn([]*database.RepoUpdateSchedule{
+		{RepoID: b.ID, RepoName: b.Name, Interval: 3 * time.Minute, DueAt: defaultTime.Add(3 * time.Minute)},
+	}, nil)
+
+	db := database.NewMockDB()
+	db.RepoUpdateScheduleFunc.SetDefaultReturn(store)
+
+	s := NewUpdateScheduler(logtest.Scoped(t), db)
+	setupInitialSchedule(s, []*scheduledRepoUpdate{
+		{Repo: a, Interval: time.Minute, Due: defaultTime.Add(-3 * time.Second)},
+		{Repo: b, Interval: time.Minute, Due: defaultTime.Add(-2 * time.Second)},
+		{Repo: c, Interval: time.Minute, Due: defaultTime.Add(-1 * time.Second)},
+	})
+
+	s.runSchedule(context.Background())
+
+	verifySchedule(t, s, []*scheduledRepoUpdate{
+		{Repo: c, Interval: time.Minute, Due: defaultTime.Add(time.Minute)},
+		{Repo: a, Interval: 2 * time.Minute, Due: defaultTime.Add(2 * time.Minute)},
+		{Repo: b, Interval: 3 * time.Minute, Due: defaultTime.Add(3 * time.Minute)},
+	})
+	verifyQueue(t, s, []*repoUpdate{
+		{Repo: a, Priority: priorityLow, Seq: 1},
+		{Repo: c, Priority: priorityLow, Seq: 2},
+	})
+
+	// c is missing from the persisted schedule, so it is inserted.
+	if calls := store.UpsertFunc.History(); len(calls) != 1 || calls[0].Arg1.RepoID != c.ID {
+		t.Fatalf("expected c to be inserted into the persisted schedule, got %v", calls)
+	}
+}
+
+func TestUpdateScheduler_runScheduleClaimWithoutLock(t *testing.T) {
+	a := configuredRepo{ID: 1, Name: "a"}
+	b := configuredRepo{ID: 2, Name: "b"}
+
+	_, stop := startRecording()
+	defer stop()
+
+	var s *UpdateScheduler
+	store := database.NewMockRepoUpdateScheduleStore()
+	store.ClaimFunc.SetDefaultHook(func(_ context.Context, now time.Time, ids ...api.RepoID) ([]*database.RepoUpdateSchedule, error) {
+		// The schedule is not locked while claiming, so it can change meanwhile.
+		s.schedule.remove(b)
+		return []*database.RepoUpdateSchedule{
+			{RepoID: a.ID, RepoName: a.Name, Interval: 2 * time.Minute, DueAt: now.Add(2 * time.Minute)},
+			{RepoID: b.ID, RepoName: b.Name, Interval: 2 * time.Minute, DueAt: now.Add(2 * time.Minute)},
+		}, nil
+	})
+
+	db := database.NewMockDB()
+	db.RepoUpdateScheduleFunc.SetDefaultReturn(store)
+
+	s = NewUpdateScheduler(logtest.Scoped(t), db)
+	setupInitialSchedule(s, []*scheduledRepoUpdate{
+		{Repo: a, Interval: time.Minute, Due: defaultTime.Add(-2 * time.Second)},
+		{Repo: b, Interval: time.Minute, Due: defaultTime.Add(-1 * time.Second)},
+	})
+
+	s.runSchedule(context.Background())
+
+	// b was removed while claiming it, so it is neither rescheduled nor enqueued.
+	verifySchedule(t, s, []*scheduledRepoUpdate{
+		{Repo: a, Interval: 2 * time.Minute, Due: defaultTime.Add(2 * time.Minute)},
+	})
+	verifyQueue(t, s, []*repoUpdate{
+		{Repo: a, Priority: priorityLow, Seq: 1},
+	})
+}
+
+// newLargeSchedule returns a schedule of n repos whose due times are spread
+// over the n seconds around defaultTime in a scrambled order.
+func newLargeSchedule(t testing.TB, n int) *schedule {
+	s := NewUpdateScheduler(logtest.Scoped(t), newMockSchedulerDB()).schedule
+	for i := 0; i < n; i++ {
+		offset := time.Duration((i*7919)%n-n/2) * time.Second
+		heap.Push(s, &scheduledRepoUpdate{
+			Repo:     configuredRepo{ID: api.RepoID(i + 1), Name: api.RepoName(fmt.Sprintf("repo-%d", i))},
+			Interval: time.Minute,
+			Due:      defaultTime.Add(offset),
+		})
+	}
+	return s
+}
+
+func TestSchedule_dueLargeSchedule(t *testing.T) {
+	const n = 100_000
+	s := newLargeSchedule(t, n)
+
+	for _, now := range []time.Time{
+		defaultTime.Add(-time.Duration(n) * time.Second),
+		defaultTime.Add(-time.Duration(n/2-10) * time.Second),
+		defaultTime,
+		defaultTime.Add(time.Duration(n) * time.Second),
+	} {
+		var want []*scheduledRepoUpdate
+		for _, update := range s.heap {
+			if update.Due.Before(now.Add(time.Millisecond)) {
+				want = append(want, update)
+			}
+		}
+		sort.Slice(want, func(i, j int) bool { return want[i].Due.Before(want[j].Due) })
+
+		got := s.due(now)
+		if len(got) != len(want) {
+			t.Fatalf("now %s: got %d due repos, want %d", now, len(got), len(want))
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("now %s: due repo %d is %v, want %v", now, i, got[i].Repo, want[i].Repo)
+			}
+		}
+	}
+}
+
+func BenchmarkSchedule_due(b *testing.B) {
+	s := newLargeSchedule(b, 100_000)
+	// 11 repos are due.
+	now := defaultTime.Add(-time.Duration(100_000/2-10) * time.Second)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.due(now)
+	}
+}
+
 func TestUpdateScheduler_runUpdateLoop(t *testing.T) {
 	a := configuredRepo{ID: 1, Name: "a"}
 	b := configuredRepo{ID: 2, Name: "b"}
@@ -1438,7 +1592,7 @@ func TestUpdateScheduler_runUpdateLoop(t *testing.T) {
 			}
 			defer func() { requestRepoUpdate = nil }()
 
-			s := NewUpdateScheduler(logtest.Scoped(t), database.NewMockDB())
+			s := NewUpdateScheduler(logtest.Scoped(t), newMockSchedulerDB())
 			s.schedule.randGenerator = &mockRandomGenerator{}
 
 			// unbuffer the channel
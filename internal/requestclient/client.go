@@ -12,8 +12,25 @@ type Client struct {
 	IP string
 	// ForwardedFor identifies the originating IP address of a client.
 	ForwardedFor string
+	// Type identifies the kind of client that sent the request, and is one of
+	// the ClientType constants.
+	Type ClientType
 }
 
+// ClientType is the kind of client that sent a request.
+type ClientType string
+
+const (
+	// ClientTypeWeb is the Sourcegraph web application.
+	ClientTypeWeb ClientType = "web"
+	// ClientTypeBrowserExtension is the Sourcegraph browser extension or one of
+	// the native code host integrations.
+	ClientTypeBrowserExtension ClientType = "browser-extension"
+	// ClientTypeAPI is any other client of the API, e.g. src-cli or a script
+	// using an access token.
+	ClientTypeAPI ClientType = "api"
+)
+
 // FromContext retrieves the client IP, if available, from context.
 func FromContext(ctx context.Context) *Client {
 	ip, ok := ctx.Value(clientKey{}).(*Client)
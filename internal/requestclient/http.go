@@ -11,6 +11,12 @@ const (
 	// De-facto standard for identifying original IP address of a client:
 	// https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/X-Forwarded-For
 	headerKeyForwardedFor = "X-Forwarded-For"
+	// Set by our web application and browser extensions, see "What does
+	// X-Requested-With do, anyway?" in
+	// https://github.com/sourcegraph/sourcegraph/pull/27931
+	headerKeyRequestedWith = "X-Requested-With"
+	// Deprecated form of X-Requested-With
+	headerKeySourcegraphClient = "X-Sourcegraph-Client"
 )
 
 // HTTPTransport is a roundtripper that sets client IP information within request context as
@@ -43,7 +49,28 @@ func HTTPMiddleware(next http.Handler) http.Handler {
 		ctxWithClient := WithClient(req.Context(), &Client{
 			IP:           strings.Split(req.RemoteAddr, ":")[0],
 			ForwardedFor: req.Header.Get(headerKeyForwardedFor),
+			Type:         clientType(req),
 		})
 		next.ServeHTTP(rw, req.WithContext(ctxWithClient))
 	})
 }
+
+// clientType infers the type of client that sent req from the
+// X-Requested-With header. The web application sends "Sourcegraph", while the
+// browser extensions and native integrations send "Sourcegraph - <platform>
+// v<version>".
+func clientType(req *http.Request) ClientType {
+	requestedWith := req.Header.Get(headerKeyRequestedWith)
+	if requestedWith == "" {
+		requestedWith = req.Header.Get(headerKeySourcegraphClient)
+	}
+
+	switch {
+	case requestedWith == "Sourcegraph":
+		return ClientTypeWeb
+	case strings.HasPrefix(requestedWith, "Sourcegraph - "):
+		return ClientTypeBrowserExtension
+	default:
+		return ClientTypeAPI
+	}
+}
@@ -288,12 +288,13 @@ func ToFeatures(flagSet *featureflag.FlagSet, logger log.Logger) *search.Feature
 	}
 
 	return &search.Features{
-		ContentBasedLangFilters: flagSet.GetBoolOr("search-content-based-lang-detection", false),
-		HybridSearch:            flagSet.GetBoolOr("search-hybrid", false),
-		AbLuckySearch:           flagSet.GetBoolOr("ab-lucky-search", false),
-		Ranking:                 flagSet.GetBoolOr("search-ranking", false),
-		RankingDampDocRanks:     flagSet.GetBoolOr("search-ranking-damp-doc-ranks", false),
-		Debug:                   flagSet.GetBoolOr("search-debug", false),
+		ContentBasedLangFilters:        flagSet.GetBoolOr("search-content-based-lang-detection", false),
+		HybridSearch:                   flagSet.GetBoolOr("search-hybrid", false),
+		AbLuckySearch:                  flagSet.GetBoolOr("ab-lucky-search", false),
+		SmartSearchLearnedRuleOrdering: flagSet.GetBoolOr("smart-search-learned-rule-ordering", false),
+		Ranking:                        flagSet.GetBoolOr("search-ranking", false),
+		RankingDampDocRanks:            flagSet.GetBoolOr("search-ranking-damp-doc-ranks", false),
+		Debug:                          flagSet.GetBoolOr("search-debug", false),
 	}
 }
 
@@ -0,0 +1,277 @@
+package jobutil
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/groupcache/lru"
+	"github.com/grafana/regexp"
+	otlog "github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/lib/group"
+)
+
+// NewBlameFilterJob creates a job that post-filters the matched lines of file
+// results by git blame, for content searches with author:, before: or after:.
+//
+// A matched range is kept if one of its lines was last changed in a commit
+// whose author matches every author: value and none of the negated ones, and
+// whose author date satisfies before: and after:. File results without any
+// matched ranges left are dropped, as are all other result types.
+func NewBlameFilterJob(b query.Basic, child job.Job) (job.Job, error) {
+	j := &blameFilterJob{child: child}
+
+	var err error
+	b.VisitParameter(query.FieldAuthor, func(value string, negated bool, _ query.Annotation) {
+		if !b.IsCaseSensitive() {
+			value = "(?i:" + value + ")"
+		}
+		re, reErr := regexp.Compile(value)
+		if reErr != nil {
+			err = errors.Append(err, reErr)
+			return
+		}
+		if negated {
+			j.excludeAuthors = append(j.excludeAuthors, re)
+		} else {
+			j.includeAuthors = append(j.includeAuthors, re)
+		}
+	})
+	for _, field := range []string{query.FieldBefore, query.FieldAfter} {
+		field := field
+		b.VisitParameter(field, func(value string, _ bool, _ query.Annotation) {
+			t, dateErr := query.ParseGitDate(value, time.Now)
+			if dateErr != nil {
+				err = errors.Append(err, dateErr)
+				return
+			}
+			if field == query.FieldBefore {
+				j.before = &t
+			} else {
+				j.after = &t
+			}
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return j, nil
+}
+
+type blameFilterJob struct {
+	includeAuthors []*regexp.Regexp
+	excludeAuthors []*regexp.Regexp
+	before         *time.Time
+	after          *time.Time
+	child          job.Job
+}
+
+// blameFilterConcurrency is the number of files of a single event that are
+// blamed at the same time.
+const blameFilterConcurrency = 8
+
+func (j *blameFilterJob) Run(ctx context.Context, clients job.RuntimeClients, stream streaming.Sender) (alert *search.Alert, err error) {
+	_, ctx, stream, finish := job.StartSpan(ctx, stream, j)
+	defer func() { finish(alert, err) }()
+
+	var (
+		mu   sync.Mutex
+		errs error
+	)
+
+	filteredStream := streaming.StreamFunc(func(event streaming.SearchEvent) {
+		keep := make([]bool, len(event.Results))
+		g := group.New().WithErrors().WithMaxConcurrency(blameFilterConcurrency)
+		for i, res := range event.Results {
+			i := i
+			fm, ok := res.(*result.FileMatch)
+			if !ok || len(fm.ChunkMatches) == 0 {
+				continue
+			}
+
+			g.Go(func() error {
+				hunks, err := blameFile(ctx, clients.Gitserver, fm.Repo.Name, fm.CommitID, fm.Path)
+				if err != nil {
+					return err
+				}
+				keep[i] = j.filterFileMatch(fm, hunks)
+				return nil
+			})
+		}
+		if err := g.Wait(); err != nil {
+			mu.Lock()
+			errs = errors.Append(errs, err)
+			mu.Unlock()
+		}
+
+		filtered := event.Results[:0]
+		for i, res := range event.Results {
+			if keep[i] {
+				filtered = append(filtered, res)
+			}
+		}
+		event.Results = filtered
+		stream.Send(event)
+	})
+
+	alert, err = j.child.Run(ctx, clients, filteredStream)
+
+	mu.Lock()
+	defer mu.Unlock()
+	return alert, errors.Append(err, errs)
+}
+
+// filterFileMatch removes the ranges of fm that are not on a line of a
+// matching hunk, and returns whether any ranges are left.
+func (j *blameFilterJob) filterFileMatch(fm *result.FileMatch, hunks []*gitserver.Hunk) bool {
+	// lineMatches[i] is true if the 0-indexed line i is in a matching hunk.
+	lineMatches := map[int]bool{}
+	for _, hunk := range hunks {
+		if !j.matchesHunk(hunk) {
+			continue
+		}
+		for line := hunk.StartLine; line < hunk.EndLine; line++ {
+			lineMatches[line-1] = true
+		}
+	}
+
+	filteredChunks := fm.ChunkMatches[:0]
+	for _, chunk := range fm.ChunkMatches {
+		filteredRanges := chunk.Ranges[:0]
+		for _, rr := range chunk.Ranges {
+			for line := rr.Start.Line; line <= rr.End.Line; line++ {
+				if lineMatches[line] {
+					filteredRanges = append(filteredRanges, rr)
+					break
+				}
+			}
+		}
+		chunk.Ranges = filteredRanges
+		if len(chunk.Ranges) > 0 {
+			filteredChunks = append(filteredChunks, chunk)
+		}
+	}
+	fm.ChunkMatches = filteredChunks
+	return len(fm.ChunkMatches) > 0
+}
+
+func (j *blameFilterJob) matchesHunk(hunk *gitserver.Hunk) bool {
+	matchesAuthor := func(re *regexp.Regexp) bool {
+		return re.MatchString(hunk.Author.Name) || re.MatchString(hunk.Author.Email)
+	}
+	for _, re := range j.includeAuthors {
+		if !matchesAuthor(re) {
+			return false
+		}
+	}
+	for _, re := range j.excludeAuthors {
+		if matchesAuthor(re) {
+			return false
+		}
+	}
+	if j.before != nil && !hunk.Author.Date.Before(*j.before) {
+		return false
+	}
+	if j.after != nil && !hunk.Author.Date.After(*j.after) {
+		return false
+	}
+	return true
+}
+
+func (j *blameFilterJob) Name() string {
+	return "BlameFilterJob"
+}
+
+func (j *blameFilterJob) Fields(v job.Verbosity) (res []otlog.Field) {
+	switch v {
+	case job.VerbosityMax:
+		fallthrough
+	case job.VerbosityBasic:
+		regexpStrings := func(res []*regexp.Regexp) []string {
+			s := make([]string, 0, len(res))
+			for _, re := range res {
+				s = append(s, re.String())
+			}
+			return s
+		}
+		if len(j.includeAuthors) > 0 {
+			res = append(res, trace.Strings("includeAuthors", regexpStrings(j.includeAuthors)))
+		}
+		if len(j.excludeAuthors) > 0 {
+			res = append(res, trace.Strings("excludeAuthors", regexpStrings(j.excludeAuthors)))
+		}
+		if j.before != nil {
+			res = append(res, otlog.String("before", j.before.Format(time.RFC3339)))
+		}
+		if j.after != nil {
+			res = append(res, otlog.String("after", j.after.Format(time.RFC3339)))
+		}
+	}
+	return res
+}
+
+func (j *blameFilterJob) Children() []job.Describer {
+	return []job.Describer{j.child}
+}
+
+func (j *blameFilterJob) MapChildren(fn job.MapFunc) job.Job {
+	cp := *j
+	cp.child = job.Map(j.child, fn)
+	return &cp
+}
+
+// blameHunkCache caches the blame hunks of files. Results are only streamed
+// for resolved commits, so cached hunks never go stale. Blame results depend
+// on the user when sub-repo permissions are enabled, so they are not cached
+// then.
+var (
+	blameHunkCacheMu sync.Mutex
+	blameHunkCache   = lru.New(1000)
+)
+
+type blameHunkCacheKey struct {
+	repo   api.RepoName
+	commit api.CommitID
+	path   string
+}
+
+// blameFile returns the blame hunks of the whole file at path, so that all
+// matches in a file are filtered with a single blame.
+func blameFile(ctx context.Context, client gitserver.Client, repo api.RepoName, commit api.CommitID, path string) ([]*gitserver.Hunk, error) {
+	checker := authz.DefaultSubRepoPermsChecker
+	if authz.SubRepoEnabled(checker) {
+		hunks, err := client.BlameFile(ctx, checker, repo, path, &gitserver.BlameOptions{NewestCommit: commit})
+		return hunks, errors.Wrapf(err, "blaming %s at %s", path, commit)
+	}
+
+	key := blameHunkCacheKey{repo: repo, commit: commit, path: path}
+
+	blameHunkCacheMu.Lock()
+	cached, ok := blameHunkCache.Get(key)
+	blameHunkCacheMu.Unlock()
+	if ok {
+		return cached.([]*gitserver.Hunk), nil
+	}
+
+	hunks, err := client.BlameFile(ctx, checker, repo, path, &gitserver.BlameOptions{NewestCommit: commit})
+	if err != nil {
+		return nil, errors.Wrapf(err, "blaming %s at %s", path, commit)
+	}
+
+	blameHunkCacheMu.Lock()
+	blameHunkCache.Add(key, hunks)
+	blameHunkCacheMu.Unlock()
+	return hunks, nil
+}
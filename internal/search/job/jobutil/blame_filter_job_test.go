@@ -0,0 +1,121 @@
+package jobutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/mockjob"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestBlameFilterJob(t *testing.T) {
+	recently := time.Now().Add(-24 * time.Hour)
+	longAgo := time.Now().Add(-365 * 24 * time.Hour)
+
+	gs := gitserver.NewMockClient()
+	gs.BlameFileFunc.SetDefaultHook(func(_ context.Context, _ authz.SubRepoPermissionChecker, _ api.RepoName, path string, _ *gitserver.BlameOptions) ([]*gitserver.Hunk, error) {
+		return []*gitserver.Hunk{
+			// Lines 1 and 2 (0-indexed 0 and 1)
+			{StartLine: 1, EndLine: 3, Author: gitdomain.Signature{Name: "Alice", Email: "alice@example.com", Date: recently}},
+			// Line 3 (0-indexed 2)
+			{StartLine: 3, EndLine: 4, Author: gitdomain.Signature{Name: "Bob", Email: "bob@example.com", Date: longAgo}},
+		}, nil
+	})
+
+	// fm returns a file match with a single-line match on each of the
+	// 0-indexed lines.
+	fm := func(path string, lines ...int) *result.FileMatch {
+		var chunks result.ChunkMatches
+		for _, line := range lines {
+			chunks = append(chunks, result.ChunkMatch{
+				Content:      "TODO",
+				ContentStart: result.Location{Line: line},
+				Ranges: result.Ranges{{
+					Start: result.Location{Line: line},
+					End:   result.Location{Line: line, Column: 4},
+				}},
+			})
+		}
+		return &result.FileMatch{
+			File: result.File{
+				Repo:     types.MinimalRepo{Name: "github.com/sourcegraph/sourcegraph"},
+				CommitID: "deadbeef",
+				Path:     path,
+			},
+			ChunkMatches: chunks,
+		}
+	}
+	r := func(ms ...result.Match) (res result.Matches) {
+		for _, m := range ms {
+			res = append(res, m)
+		}
+		return res
+	}
+
+	cases := []struct {
+		query  string
+		input  result.Matches
+		output result.Matches
+	}{{
+		query:  "author:alice TODO",
+		input:  r(fm("a.go", 0, 2), fm("b.go", 2), &result.RepoMatch{Name: "github.com/sourcegraph/sourcegraph"}),
+		output: r(fm("a.go", 0)),
+	}, {
+		query:  "author:bob@example.com TODO",
+		input:  r(fm("a.go", 0, 1, 2)),
+		output: r(fm("a.go", 2)),
+	}, {
+		query:  "-author:alice TODO",
+		input:  r(fm("a.go", 0, 1, 2)),
+		output: r(fm("a.go", 2)),
+	}, {
+		query:  "after:\"90 days ago\" TODO",
+		input:  r(fm("a.go", 1, 2)),
+		output: r(fm("a.go", 1)),
+	}, {
+		query:  "author:alice before:\"90 days ago\" TODO",
+		input:  r(fm("a.go", 0, 1, 2)),
+		output: nil,
+	}, {
+		query:  "case:yes author:ALICE TODO",
+		input:  r(fm("a.go", 0)),
+		output: nil,
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.query, func(t *testing.T) {
+			plan, err := query.Pipeline(query.Init(tc.query, query.SearchTypeRegex))
+			require.NoError(t, err)
+
+			childJob := mockjob.NewMockJob()
+			childJob.RunFunc.SetDefaultHook(func(_ context.Context, _ job.RuntimeClients, s streaming.Sender) (*search.Alert, error) {
+				s.Send(streaming.SearchEvent{Results: tc.input})
+				return nil, nil
+			})
+
+			var got result.Matches
+			streamCollector := streaming.StreamFunc(func(event streaming.SearchEvent) {
+				got = append(got, event.Results...)
+			})
+
+			j, err := NewBlameFilterJob(plan[0], childJob)
+			require.NoError(t, err)
+			alert, err := j.Run(context.Background(), job.RuntimeClients{Gitserver: gs}, streamCollector)
+			require.Nil(t, alert)
+			require.NoError(t, err)
+			require.Equal(t, tc.output, got)
+		})
+	}
+}
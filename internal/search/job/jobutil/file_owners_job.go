@@ -0,0 +1,131 @@
+package jobutil
+
+import (
+	"context"
+	"sync"
+
+	otlog "github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeowners"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// NewFileOwnersJob creates a job that annotates streamed file results with
+// the code owners declared in the CODEOWNERS file of their repository.
+//
+// If includeOwners or excludeOwners are non-empty, the job additionally acts
+// as the post-filter for the file:has.owner() predicate: only file results
+// owned by every owner in includeOwners and by none of the owners in
+// excludeOwners are kept. All other result types are dropped in that case.
+func NewFileOwnersJob(includeOwners, excludeOwners []string, child job.Job) job.Job {
+	return &fileOwnersJob{
+		includeOwners: includeOwners,
+		excludeOwners: excludeOwners,
+		child:         child,
+	}
+}
+
+type fileOwnersJob struct {
+	includeOwners []string
+	excludeOwners []string
+	child         job.Job
+}
+
+func (j *fileOwnersJob) Run(ctx context.Context, clients job.RuntimeClients, stream streaming.Sender) (alert *search.Alert, err error) {
+	_, ctx, stream, finish := job.StartSpan(ctx, stream, j)
+	defer func() { finish(alert, err) }()
+
+	var (
+		mu        sync.Mutex
+		errs      error
+		resolver  = codeowners.NewResolver(clients.Gitserver)
+		filtering = len(j.includeOwners) > 0 || len(j.excludeOwners) > 0
+	)
+
+	filteredStream := streaming.StreamFunc(func(event streaming.SearchEvent) {
+		filtered := event.Results[:0]
+		for _, res := range event.Results {
+			fm, ok := res.(*result.FileMatch)
+			if !ok {
+				if !filtering {
+					filtered = append(filtered, res)
+				}
+				continue
+			}
+
+			owners, err := resolver.Owners(ctx, fm.Repo.Name, fm.CommitID, fm.Path)
+			if err != nil {
+				mu.Lock()
+				errs = errors.Append(errs, err)
+				mu.Unlock()
+				continue
+			}
+			fm.Owners = owners
+
+			if j.keep(owners) {
+				filtered = append(filtered, fm)
+			}
+		}
+		event.Results = filtered
+		stream.Send(event)
+	})
+
+	alert, err = j.child.Run(ctx, clients, filteredStream)
+
+	mu.Lock()
+	defer mu.Unlock()
+	return alert, errors.Append(err, errs)
+}
+
+// keep returns true if a file with the given owners satisfies the
+// file:has.owner() predicates of the query.
+func (j *fileOwnersJob) keep(owners []string) bool {
+	normalized := make(map[string]struct{}, len(owners))
+	for _, owner := range owners {
+		normalized[codeowners.NormalizeOwner(owner)] = struct{}{}
+	}
+
+	for _, owner := range j.includeOwners {
+		if _, ok := normalized[codeowners.NormalizeOwner(owner)]; !ok {
+			return false
+		}
+	}
+	for _, owner := range j.excludeOwners {
+		if _, ok := normalized[codeowners.NormalizeOwner(owner)]; ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (j *fileOwnersJob) Name() string {
+	return "FileOwnersJob"
+}
+
+func (j *fileOwnersJob) Fields(v job.Verbosity) (res []otlog.Field) {
+	switch v {
+	case job.VerbosityMax:
+		fallthrough
+	case job.VerbosityBasic:
+		res = append(res,
+			trace.Strings("includeOwners", j.includeOwners),
+			trace.Strings("excludeOwners", j.excludeOwners),
+		)
+	}
+	return res
+}
+
+func (j *fileOwnersJob) Children() []job.Describer {
+	return []job.Describer{j.child}
+}
+
+func (j *fileOwnersJob) MapChildren(fn job.MapFunc) job.Job {
+	cp := *j
+	cp.child = job.Map(j.child, fn)
+	return &cp
+}
@@ -0,0 +1,95 @@
+package jobutil
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/mockjob"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestFileOwnersJob(t *testing.T) {
+	gs := gitserver.NewMockClient()
+	gs.ReadFileFunc.SetDefaultHook(func(_ context.Context, _ api.RepoName, _ api.CommitID, name string, _ authz.SubRepoPermissionChecker) ([]byte, error) {
+		if name == "CODEOWNERS" {
+			return []byte("* @everyone\n*.go @everyone @gophers\n/client/ @Frontend\n"), nil
+		}
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	})
+
+	fm := func(path string) *result.FileMatch {
+		return &result.FileMatch{File: result.File{
+			Repo:     types.MinimalRepo{Name: "github.com/sourcegraph/sourcegraph"},
+			CommitID: "deadbeef",
+			Path:     path,
+		}}
+	}
+	withOwners := func(m *result.FileMatch, owners ...string) *result.FileMatch {
+		m.Owners = owners
+		return m
+	}
+	r := func(ms ...result.Match) (res result.Matches) {
+		for _, m := range ms {
+			res = append(res, m)
+		}
+		return res
+	}
+
+	cases := []struct {
+		name    string
+		include []string
+		exclude []string
+		input   result.Matches
+		output  result.Matches
+	}{{
+		name:   "annotate only",
+		input:  r(fm("main.go"), fm("client/index.ts"), &result.RepoMatch{Name: "github.com/sourcegraph/sourcegraph"}),
+		output: r(withOwners(fm("main.go"), "@everyone", "@gophers"), withOwners(fm("client/index.ts"), "@Frontend"), &result.RepoMatch{Name: "github.com/sourcegraph/sourcegraph"}),
+	}, {
+		name:    "include owner",
+		include: []string{"gophers"},
+		input:   r(fm("main.go"), fm("README.md"), &result.RepoMatch{Name: "github.com/sourcegraph/sourcegraph"}),
+		output:  r(withOwners(fm("main.go"), "@everyone", "@gophers")),
+	}, {
+		name:    "include owner case insensitive",
+		include: []string{"@frontend"},
+		input:   r(fm("main.go"), fm("client/index.ts")),
+		output:  r(withOwners(fm("client/index.ts"), "@Frontend")),
+	}, {
+		name:    "exclude owner",
+		exclude: []string{"@gophers"},
+		input:   r(fm("main.go"), fm("README.md")),
+		output:  r(withOwners(fm("README.md"), "@everyone")),
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			childJob := mockjob.NewMockJob()
+			childJob.RunFunc.SetDefaultHook(func(_ context.Context, _ job.RuntimeClients, s streaming.Sender) (*search.Alert, error) {
+				s.Send(streaming.SearchEvent{Results: tc.input})
+				return nil, nil
+			})
+
+			var got result.Matches
+			streamCollector := streaming.StreamFunc(func(event streaming.SearchEvent) {
+				got = append(got, event.Results...)
+			})
+
+			j := NewFileOwnersJob(tc.include, tc.exclude, childJob)
+			alert, err := j.Run(context.Background(), job.RuntimeClients{Gitserver: gs}, streamCollector)
+			require.Nil(t, alert)
+			require.NoError(t, err)
+			require.Equal(t, tc.output, got)
+		})
+	}
+}
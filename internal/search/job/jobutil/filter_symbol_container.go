@@ -0,0 +1,101 @@
+package jobutil
+
+import (
+	"context"
+
+	"github.com/grafana/regexp"
+	otlog "github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+)
+
+// NewSymbolContainerFilterJob creates a filter job to post-filter symbol
+// results for the symbol.container: filter.
+//
+// Zoekt cannot filter symbols by their container, so indexed symbol searches
+// return candidate symbols matching the pattern. This job drops the candidates
+// whose container doesn't match, and the files left without any symbols.
+func NewSymbolContainerFilterJob(pattern string, caseSensitive bool, child job.Job) job.Job {
+	expr := pattern
+	if !caseSensitive {
+		expr = "(?i:" + expr + ")"
+	}
+
+	return &symbolContainerFilterJob{
+		container: regexp.MustCompile(expr),
+		child:     child,
+	}
+}
+
+type symbolContainerFilterJob struct {
+	// Regex pattern specified by symbol.container:
+	container *regexp.Regexp
+
+	child job.Job
+}
+
+func (j *symbolContainerFilterJob) Run(ctx context.Context, clients job.RuntimeClients, stream streaming.Sender) (alert *search.Alert, err error) {
+	_, ctx, stream, finish := job.StartSpan(ctx, stream, j)
+	defer func() { finish(alert, err) }()
+
+	filteredStream := streaming.StreamFunc(func(event streaming.SearchEvent) {
+		event = j.filterEvent(event)
+		stream.Send(event)
+	})
+
+	return j.child.Run(ctx, clients, filteredStream)
+}
+
+func (j *symbolContainerFilterJob) filterEvent(event streaming.SearchEvent) streaming.SearchEvent {
+	filtered := event.Results[:0]
+	for _, res := range event.Results {
+		fm, ok := res.(*result.FileMatch)
+		if !ok || len(fm.Symbols) == 0 {
+			// Only symbol results are filtered
+			filtered = append(filtered, res)
+			continue
+		}
+
+		filteredSymbols := fm.Symbols[:0]
+		for _, symbol := range fm.Symbols {
+			if j.container.MatchString(symbol.Symbol.Parent) {
+				filteredSymbols = append(filteredSymbols, symbol)
+			}
+		}
+		if len(filteredSymbols) == 0 {
+			// Skip files where we filtered out all the symbols
+			continue
+		}
+		fm.Symbols = filteredSymbols
+		filtered = append(filtered, fm)
+	}
+	event.Results = filtered
+	return event
+}
+
+func (j *symbolContainerFilterJob) MapChildren(f job.MapFunc) job.Job {
+	cp := *j
+	cp.child = job.Map(j.child, f)
+	return &cp
+}
+
+func (j *symbolContainerFilterJob) Children() []job.Describer {
+	return []job.Describer{j.child}
+}
+
+func (j *symbolContainerFilterJob) Fields(v job.Verbosity) (res []otlog.Field) {
+	switch v {
+	case job.VerbosityMax:
+		fallthrough
+	case job.VerbosityBasic:
+		res = append(res, otlog.String("container", j.container.String()))
+	}
+	return res
+}
+
+func (j *symbolContainerFilterJob) Name() string {
+	return "SymbolContainerFilterJob"
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/grafana/regexp"
 
+	"github.com/sourcegraph/sourcegraph/cmd/searcher/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/search"
@@ -43,7 +44,7 @@ func NewPlanJob(inputs *search.Inputs, plan query.Plan) (job.Job, error) {
 		return NewBasicJob(inputs, b)
 	}
 	if inputs.SearchMode == search.SmartSearch || inputs.PatternType == query.SearchTypeLucky || inputs.Features.AbLuckySearch {
-		jobTree = lucky.NewFeelingLuckySearchJob(jobTree, newJob, plan)
+		jobTree = lucky.NewFeelingLuckySearchJob(jobTree, newJob, plan, inputs.Features.SmartSearchLearnedRuleOrdering)
 	} else if inputs.PatternType == query.SearchTypeKeyword && len(plan) == 1 {
 		newJobTree, err := keyword.NewKeywordSearchJob(plan[0], newJob)
 		if err != nil {
@@ -121,13 +122,22 @@ func NewBasicJob(inputs *search.Inputs, b query.Basic) (job.Job, error) {
 		}
 
 		if resultTypes.Has(result.TypeSymbol) {
+			// Zoekt cannot filter symbols by their container, so the
+			// symbols it returns are candidates for symbol.container:
+			withContainerFilter := func(child job.Job) job.Job {
+				if container := b.FindValue(query.FieldSymbolContainer); container != "" {
+					return NewSymbolContainerFilterJob(container, b.IsCaseSensitive(), child)
+				}
+				return child
+			}
+
 			// Create Global Symbol Search jobs.
 			if repoUniverseSearch {
 				job, err := builder.newZoektGlobalSearch(search.SymbolRequest)
 				if err != nil {
 					return nil, err
 				}
-				addJob(job)
+				addJob(withContainerFilter(job))
 			}
 
 			if !skipRepoSubsetSearch && runZoektOverRepos {
@@ -136,7 +146,7 @@ func NewBasicJob(inputs *search.Inputs, b query.Basic) (job.Job, error) {
 					return nil, err
 				}
 				addJob(&repoPagerJob{
-					child:            &reposPartialJob{job},
+					child:            &reposPartialJob{withContainerFilter(job)},
 					repoOpts:         repoOptions,
 					containsRefGlobs: query.ContainsRefGlobs(b.ToParseTree()),
 				})
@@ -181,6 +191,25 @@ func NewBasicJob(inputs *search.Inputs, b query.Basic) (job.Job, error) {
 		}
 	}
 
+	{ // Apply author:, before: and after: post-filter to content searches
+		resultTypes := computeResultTypes(b, inputs.PatternType)
+		if !resultTypes.Has(result.TypeCommit|result.TypeDiff) && hasBlameParameters(b) {
+			blameJob, err := NewBlameFilterJob(b, basicJob)
+			if err != nil {
+				return nil, err
+			}
+			basicJob = blameJob
+		}
+	}
+
+	{ // Apply file:has.owner() post-filter and resolve owners for select:file.owners
+		includeOwners, excludeOwners := b.FileHasOwner()
+		sp, _ := filter.SelectPathFromString(b.FindValue(query.FieldSelect)) // Invariant: select already validated
+		if len(includeOwners) > 0 || len(excludeOwners) > 0 || (sp.Root() == filter.File && len(sp) > 1 && sp[1] == "owners") {
+			basicJob = NewFileOwnersJob(includeOwners, excludeOwners, basicJob)
+		}
+	}
+
 	{ // Apply selectors
 		if v, _ := b.ToParseTree().StringValue(query.FieldSelect); v != "" {
 			sp, _ := filter.SelectPathFromString(v) // Invariant: select already validated
@@ -480,17 +509,25 @@ func getPathRegexpsFromTextPatternInfo(patternInfo *search.TextPatternInfo) (pat
 	}
 
 	if patternInfo.PatternMatchesPath {
-		if patternInfo.IsRegExp {
-			if patternInfo.IsCaseSensitive {
-				pathRegexps = append(pathRegexps, regexp.MustCompile(patternInfo.Pattern))
-			} else {
-				pathRegexps = append(pathRegexps, regexp.MustCompile(`(?i)`+patternInfo.Pattern))
-			}
-		} else {
-			if patternInfo.IsCaseSensitive {
-				pathRegexps = append(pathRegexps, regexp.MustCompile(regexp.QuoteMeta(patternInfo.Pattern)))
+		patterns := []string{patternInfo.Pattern}
+		if patternInfo.Expression != nil {
+			// Negated patterns never highlight a path.
+			patterns = positivePatterns(patternInfo.Expression)
+		}
+
+		for _, pattern := range patterns {
+			if patternInfo.IsRegExp {
+				if patternInfo.IsCaseSensitive {
+					pathRegexps = append(pathRegexps, regexp.MustCompile(pattern))
+				} else {
+					pathRegexps = append(pathRegexps, regexp.MustCompile(`(?i)`+pattern))
+				}
 			} else {
-				pathRegexps = append(pathRegexps, regexp.MustCompile(`(?i)`+regexp.QuoteMeta(patternInfo.Pattern)))
+				if patternInfo.IsCaseSensitive {
+					pathRegexps = append(pathRegexps, regexp.MustCompile(regexp.QuoteMeta(pattern)))
+				} else {
+					pathRegexps = append(pathRegexps, regexp.MustCompile(`(?i)`+regexp.QuoteMeta(pattern)))
+				}
 			}
 		}
 	}
@@ -498,6 +535,22 @@ func getPathRegexpsFromTextPatternInfo(patternInfo *search.TextPatternInfo) (pat
 	return pathRegexps
 }
 
+// positivePatterns returns the patterns of the expression e that are not negated.
+func positivePatterns(e *protocol.PatternExpression) []string {
+	if e.Op == "" {
+		if e.IsNegated {
+			return nil
+		}
+		return []string{e.Pattern}
+	}
+
+	var patterns []string
+	for _, operand := range e.Operands {
+		patterns = append(patterns, positivePatterns(operand)...)
+	}
+	return patterns
+}
+
 func computeFileMatchLimit(b query.Basic, p search.Protocol) int {
 	if count := b.Count(); count != nil {
 		return *count
@@ -599,6 +652,7 @@ func toTextPatternInfo(b query.Basic, resultTypes result.Types, p search.Protoco
 		CombyRule:                    b.FindValue(query.FieldCombyRule),
 		Index:                        b.Index(),
 		Select:                       selector,
+		SymbolContainer:              b.FindValue(query.FieldSymbolContainer),
 	}
 }
 
@@ -622,6 +676,12 @@ func computeResultTypes(b query.Basic, searchType query.SearchType) result.Types
 		}
 	}
 
+	if len(types) == 0 && hasBlameParameters(b) {
+		// author:, before: and after: filter matched lines by git blame,
+		// so only content is searched.
+		return result.TypeFile
+	}
+
 	if len(types) == 0 {
 		return result.TypeFile | result.TypePath | result.TypeRepo
 	}
@@ -634,6 +694,12 @@ func computeResultTypes(b query.Basic, searchType query.SearchType) result.Types
 	return rts
 }
 
+// hasBlameParameters returns true if b contains one of the commit parameters
+// that content searches filter by git blame.
+func hasBlameParameters(b query.Basic) bool {
+	return b.Exists(query.FieldAuthor) || b.Exists(query.FieldBefore) || b.Exists(query.FieldAfter)
+}
+
 func toRepoOptions(b query.Basic, userSettings *schema.Settings) search.RepoOptions {
 	repoFilters, minusRepoFilters := b.Repositories()
 
@@ -912,9 +978,140 @@ func toPatternExpressionJob(inputs *search.Inputs, b query.Basic) (job.Job, erro
 func toFlatJobs(inputs *search.Inputs, b query.Basic) (job.Job, error) {
 	if b.Pattern == nil {
 		return NewFlatJob(inputs, query.Flat{Parameters: b.Parameters, Pattern: nil})
-	} else {
-		return toPatternExpressionJob(inputs, b)
 	}
+
+	expressionJob, err := toPatternExpressionJob(inputs, b)
+	if err != nil {
+		return nil, err
+	}
+
+	// Searcher evaluates a pattern expression in a single pass over the archive
+	// of a repository. So instead of running a searcher job for every pattern and
+	// combining their results, we run a single searcher job for the expression.
+	if searcherJob := newExpressionSearcherJob(inputs, b); searcherJob != nil {
+		expressionJob = job.MapType(expressionJob, func(pager *repoPagerJob) job.Job {
+			if job.HasDescendent[*searcher.TextSearchJob](pager) {
+				return NewNoopJob()
+			}
+			return pager
+		})
+
+		// Drop the remaining expression job if the searcher jobs were all it ran.
+		hasOtherJobs := false
+		job.Visit(expressionJob, func(j job.Describer) {
+			if _, ok := j.(*NoopJob); !ok && len(j.Children()) == 0 {
+				hasOtherJobs = true
+			}
+		})
+		if !hasOtherJobs {
+			return searcherJob, nil
+		}
+		return NewParallelJob(searcherJob, expressionJob), nil
+	}
+
+	return expressionJob, nil
+}
+
+// newExpressionSearcherJob returns a searcher job that evaluates the and/or
+// pattern expression of b, or nil if the expression cannot be evaluated by a
+// single searcher job.
+func newExpressionSearcherJob(inputs *search.Inputs, b query.Basic) job.Job {
+	if _, ok := b.Pattern.(query.Operator); !ok {
+		return nil
+	}
+
+	resultTypes := computeResultTypes(b, inputs.PatternType)
+	if !resultTypes.Has(result.TypeFile | result.TypePath) {
+		return nil
+	}
+
+	var leaves []query.Pattern
+	expression, ok := toPatternExpression(b.Pattern, func(leaf query.Pattern) (string, bool) {
+		leafBasic := b.MapPattern(leaf)
+		if !(leafBasic.IsLiteral() || leafBasic.IsRegexp()) || leaf.Value == "" {
+			return "", false
+		}
+		// Every pattern must produce the same result types as the whole
+		// expression, which is not the case for content: patterns.
+		if computeResultTypes(leafBasic, inputs.PatternType) != resultTypes {
+			return "", false
+		}
+		leaves = append(leaves, leaf)
+		return leafBasic.PatternString(), true
+	})
+	if !ok {
+		return nil
+	}
+
+	repoOptions := toRepoOptions(b, inputs.UserSettings)
+	_, skipRepoSubsetSearch, _ := jobMode(b, repoOptions, resultTypes, inputs.PatternType, inputs.OnSourcegraphDotCom)
+	if skipRepoSubsetSearch {
+		return nil
+	}
+
+	// The options shared by all patterns are the same as those of any single
+	// pattern of the expression.
+	patternInfo := toTextPatternInfo(b.MapPattern(leaves[0]), resultTypes, inputs.Protocol)
+	patternInfo.Pattern = ""
+	patternInfo.IsNegated = false
+	patternInfo.Expression = expression
+
+	// searcher to use full deadline if timeout: set or we are streaming.
+	useFullDeadline := b.GetTimeout() != nil || b.Count() != nil || inputs.Protocol == search.Streaming
+
+	searcherJob := &searcher.TextSearchJob{
+		PatternInfo:     patternInfo,
+		Indexed:         false,
+		UseFullDeadline: useFullDeadline,
+		Features:        *inputs.Features,
+		PathRegexps:     getPathRegexpsFromTextPatternInfo(patternInfo),
+	}
+
+	return &repoPagerJob{
+		child:            &reposPartialJob{searcherJob},
+		repoOpts:         repoOptions,
+		containsRefGlobs: query.ContainsRefGlobs(b.ToParseTree()),
+	}
+}
+
+// toPatternExpression converts a pattern node of a query into the equivalent
+// searcher pattern expression. toPattern returns the searcher pattern of a
+// query pattern, or false if it is not supported.
+func toPatternExpression(node query.Node, toPattern func(query.Pattern) (string, bool)) (*protocol.PatternExpression, bool) {
+	switch n := node.(type) {
+	case query.Operator:
+		var op protocol.PatternOp
+		switch n.Kind {
+		case query.And:
+			op = protocol.PatternOpAnd
+		case query.Or:
+			op = protocol.PatternOpOr
+		default:
+			return nil, false
+		}
+		if len(n.Operands) == 0 {
+			return nil, false
+		}
+
+		operands := make([]*protocol.PatternExpression, 0, len(n.Operands))
+		for _, operand := range n.Operands {
+			e, ok := toPatternExpression(operand, toPattern)
+			if !ok {
+				return nil, false
+			}
+			operands = append(operands, e)
+		}
+		return &protocol.PatternExpression{Op: op, Operands: operands}, true
+
+	case query.Pattern:
+		pattern, ok := toPattern(n)
+		if !ok {
+			return nil, false
+		}
+		return &protocol.PatternExpression{Pattern: pattern, IsNegated: n.Negated}, true
+	}
+
+	return nil, false
 }
 
 // isGlobal returns whether a given set of repo options can be fulfilled
@@ -270,6 +270,29 @@ func TestNewPlanJob(t *testing.T) {
         (REPOSCOMPUTEEXCLUDED
           )
         NoopJob))))`),
+	}, {
+		query:      `type:symbol symbol.container:Foo test`,
+		protocol:   search.Streaming,
+		searchType: query.SearchTypeRegex,
+		want: autogold.Want("symbol with container", `
+(ALERT
+  (query . )
+  (originalQuery . )
+  (patternType . regex)
+  (TIMEOUT
+    (timeout . 20s)
+    (LIMIT
+      (limit . 500)
+      (PARALLEL
+        (SYMBOLCONTAINERFILTER
+          (container . (?i:Foo))
+          (ZOEKTGLOBALSYMBOLSEARCH
+            (query . sym:substr:"test")
+            (type . symbol)
+            ))
+        (REPOSCOMPUTEEXCLUDED
+          )
+        NoopJob))))`),
 	}, {
 		query:      `type:commit test`,
 		protocol:   search.Streaming,
@@ -712,6 +735,137 @@ func TestToEvaluateJob(t *testing.T) {
 `).Equal(t, test("foo", search.Batch))
 }
 
+func TestToFlatJobsPatternExpression(t *testing.T) {
+	test := func(input string) string {
+		searchType := overrideSearchType(input, query.SearchTypeLiteral)
+		plan, err := query.Pipeline(query.Init(input, searchType))
+		require.NoError(t, err)
+		inputs := &search.Inputs{
+			UserSettings: &schema.Settings{},
+			PatternType:  searchType,
+			Protocol:     search.Streaming,
+			Features:     &search.Features{},
+		}
+
+		j, err := toFlatJobs(inputs, plan[0])
+		require.NoError(t, err)
+		return "\n" + printer.SexpVerbose(j, job.VerbosityMax, true) + "\n"
+	}
+
+	autogold.Want("and", `
+(REPOPAGER
+  (containsRefGlobs . false)
+  (repoOpts.repoFilters.0 . foo)
+  (PARTIALREPOS
+    (SEARCHERTEXTSEARCH
+      (useFullDeadline . true)
+      (patternInfo.pattern . )(patternInfo.expression . (and "a" "b"))(patternInfo.isRegexp . true)(patternInfo.fileMatchLimit . 500)
+      (numRepos . 0)
+      (pathRegexps . [])
+      (indexed . false))))
+`).Equal(t, test("repo:foo type:file a and b"))
+	autogold.Want("nested or with negation", `
+(PARALLEL
+  (REPOPAGER
+    (containsRefGlobs . false)
+    (repoOpts.repoFilters.0 . foo)
+    (PARTIALREPOS
+      (SEARCHERTEXTSEARCH
+        (useFullDeadline . true)
+        (patternInfo.pattern . )(patternInfo.expression . (and (or "a" "b") (not "c")))(patternInfo.isRegexp . true)(patternInfo.fileMatchLimit . 500)(patternInfo.patternMatchesPath . true)
+        (numRepos . 0)
+        (pathRegexps . [(?i)a (?i)b])
+        (indexed . false))))
+  (AND
+    (LIMIT
+      (limit . 40000)
+      (OR
+        (PARALLEL
+          NoopJob
+          (REPOSEARCH
+            (repoOpts.repoFilters.0 . foo)(repoOpts.repoFilters.1 . a)
+            (repoNamePatterns . [(?i)foo (?i)a])))
+        (PARALLEL
+          NoopJob
+          (REPOSEARCH
+            (repoOpts.repoFilters.0 . foo)(repoOpts.repoFilters.1 . b)
+            (repoNamePatterns . [(?i)foo (?i)b])))))
+    (LIMIT
+      (limit . 40000)
+      (PARALLEL
+        NoopJob
+        (REPOSEARCH
+          (repoOpts.repoFilters.0 . foo)(repoOpts.repoFilters.1 . c)
+          (repoNamePatterns . [(?i)foo (?i)c]))))))
+`).Equal(t, test("repo:foo (a or b) and not c"))
+	autogold.Want("regexp", `
+(REPOPAGER
+  (containsRefGlobs . false)
+  (repoOpts.repoFilters.0 . foo)
+  (PARTIALREPOS
+    (SEARCHERTEXTSEARCH
+      (useFullDeadline . true)
+      (patternInfo.pattern . )(patternInfo.expression . (and "a.*" "b+"))(patternInfo.isRegexp . true)(patternInfo.fileMatchLimit . 500)(patternInfo.patternMatchesPath . true)
+      (numRepos . 0)
+      (pathRegexps . [(?i)a.* (?i)b+])
+      (indexed . false))))
+`).Equal(t, test("repo:foo type:path a.* and b+ patterntype:regexp"))
+	autogold.Want("content pattern is not an expression", `
+(AND
+  (LIMIT
+    (limit . 40000)
+    (REPOPAGER
+      (containsRefGlobs . false)
+      (repoOpts.repoFilters.0 . foo)
+      (PARTIALREPOS
+        (SEARCHERTEXTSEARCH
+          (useFullDeadline . true)
+          (patternInfo.pattern . a)(patternInfo.isRegexp . true)(patternInfo.fileMatchLimit . 500)
+          (numRepos . 0)
+          (pathRegexps . [])
+          (indexed . false)))))
+  (LIMIT
+    (limit . 40000)
+    (PARALLEL
+      (REPOPAGER
+        (containsRefGlobs . false)
+        (repoOpts.repoFilters.0 . foo)
+        (PARTIALREPOS
+          (SEARCHERTEXTSEARCH
+            (useFullDeadline . true)
+            (patternInfo.pattern . b)(patternInfo.isRegexp . true)(patternInfo.fileMatchLimit . 500)(patternInfo.patternMatchesPath . true)
+            (numRepos . 0)
+            (pathRegexps . [(?i)b])
+            (indexed . false))))
+      (REPOSEARCH
+        (repoOpts.repoFilters.0 . foo)(repoOpts.repoFilters.1 . b)
+        (repoNamePatterns . [(?i)foo (?i)b])))))
+`).Equal(t, test("repo:foo content:a and b"))
+	autogold.Want("symbol", `
+(AND
+  (LIMIT
+    (limit . 40000)
+    (REPOPAGER
+      (containsRefGlobs . false)
+      (repoOpts.repoFilters.0 . foo)
+      (PARTIALREPOS
+        (SEARCHERSYMBOLSEARCH
+          (patternInfo.pattern . a)(patternInfo.isRegexp . true)(patternInfo.fileMatchLimit . 500)
+          (numRepos . 0)
+          (limit . 500)))))
+  (LIMIT
+    (limit . 40000)
+    (REPOPAGER
+      (containsRefGlobs . false)
+      (repoOpts.repoFilters.0 . foo)
+      (PARTIALREPOS
+        (SEARCHERSYMBOLSEARCH
+          (patternInfo.pattern . b)(patternInfo.isRegexp . true)(patternInfo.fileMatchLimit . 500)
+          (numRepos . 0)
+          (limit . 500))))))
+`).Equal(t, test("repo:foo type:symbol a and b"))
+}
+
 func TestToTextPatternInfo(t *testing.T) {
 	cases := []struct {
 		input  string
@@ -31,11 +31,14 @@ type newJob func(query.Basic) (job.Job, error)
 // that apply various rules, transforming the original input plan into various
 // queries that alter its interpretation (e.g., search literally for quotes or
 // not, attempt to search the pattern as a regexp, and so on). There is no
-// random choice when applying rules.
-func NewFeelingLuckySearchJob(initialJob job.Job, newJob newJob, plan query.Plan) *FeelingLuckySearchJob {
+// random choice when applying rules. When learnedRuleOrdering is true, rules
+// are ordered by how often users clicked the queries they generated (see
+// NewRuleStatisticsRefresher).
+func NewFeelingLuckySearchJob(initialJob job.Job, newJob newJob, plan query.Plan, learnedRuleOrdering bool) *FeelingLuckySearchJob {
+	narrow, widen := ruleSets(learnedRuleOrdering)
 	generators := make([]next, 0, len(plan))
 	for _, b := range plan {
-		generators = append(generators, NewGenerator(b, rulesNarrow, rulesWiden))
+		generators = append(generators, NewGenerator(b, narrow, widen))
 	}
 
 	newGeneratedJob := func(autoQ *autoQuery) job.Job {
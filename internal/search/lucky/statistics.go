@@ -0,0 +1,216 @@
+package lucky
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+)
+
+// RuleKind is the kind of rule set a rule belongs to.
+type RuleKind string
+
+const (
+	// RuleKindNarrow rules are expected to make a query more specific.
+	RuleKindNarrow RuleKind = "NARROW"
+
+	// RuleKindWiden rules are expected to make a query more general.
+	RuleKindWiden RuleKind = "WIDEN"
+)
+
+// RuleStatistics counts how often queries generated by a rule were shown to
+// users, and how often users clicked them.
+type RuleStatistics struct {
+	Impressions int
+	Clicks      int
+}
+
+// ClickThroughRate is the fraction of impressions that resulted in a click.
+func (s RuleStatistics) ClickThroughRate() float64 {
+	if s.Impressions == 0 {
+		return 0
+	}
+	return float64(s.Clicks) / float64(s.Impressions)
+}
+
+const (
+	// priorImpressions is the number of impressions at the average
+	// click-through rate of all rules of the same kind that a rule's own
+	// statistics are smoothed with. Rules with few impressions stay close to
+	// the average, and so close to their default position.
+	priorImpressions = 50
+
+	// Rules whose queries were shown at least suppressMinImpressions times
+	// with a click-through rate below suppressMaxClickThroughRate are not
+	// applied at all.
+	suppressMinImpressions      = 200
+	suppressMaxClickThroughRate = 0.005
+
+	// Statistics are computed over the events of the last
+	// ruleStatisticsWindow, and reloaded every ruleStatisticsRefreshInterval.
+	ruleStatisticsWindow          = 30 * 24 * time.Hour
+	ruleStatisticsRefreshInterval = 10 * time.Minute
+)
+
+var (
+	ruleStatisticsMu sync.RWMutex
+	ruleStatistics   map[string]RuleStatistics
+)
+
+// SetRuleStatistics replaces the statistics, keyed by rule description, that
+// rules are ordered by when learned rule ordering is enabled.
+func SetRuleStatistics(stats map[string]RuleStatistics) {
+	ruleStatisticsMu.Lock()
+	defer ruleStatisticsMu.Unlock()
+	ruleStatistics = stats
+}
+
+func currentRuleStatistics() map[string]RuleStatistics {
+	ruleStatisticsMu.RLock()
+	defer ruleStatisticsMu.RUnlock()
+	return ruleStatistics
+}
+
+// NewRuleStatisticsRefresher returns a background routine that periodically
+// loads the statistics of each rule from the Smart Search events in the event
+// logs.
+func NewRuleStatisticsRefresher(db database.DB) goroutine.BackgroundRoutine {
+	return goroutine.NewPeriodicGoroutine(context.Background(), ruleStatisticsRefreshInterval, goroutine.NewHandlerWithErrorMessage(
+		"refresh Smart Search rule statistics",
+		func(ctx context.Context) error {
+			return refreshRuleStatistics(ctx, db, time.Now())
+		},
+	))
+}
+
+func refreshRuleStatistics(ctx context.Context, db database.DB, now time.Time) error {
+	events, err := db.EventLogs().AggregatedSmartSearchRuleEvents(ctx, now.Add(-ruleStatisticsWindow))
+	if err != nil {
+		return err
+	}
+
+	stats := make(map[string]RuleStatistics, len(events))
+	for _, event := range events {
+		stats[event.Rule] = RuleStatistics{
+			Impressions: int(event.Impressions),
+			Clicks:      int(event.Clicks),
+		}
+	}
+	SetRuleStatistics(stats)
+	return nil
+}
+
+// ruleSets returns the narrowing and widening rules to generate queries with.
+// With learned ordering, rules are ordered by how often users clicked the
+// queries they generated, and rules whose queries are almost never clicked are
+// dropped.
+func ruleSets(learnedOrdering bool) (narrow, widen []rule) {
+	stats := currentRuleStatistics()
+	if !learnedOrdering || len(stats) == 0 {
+		return rulesNarrow, rulesWiden
+	}
+	return orderRules(rulesNarrow, stats), orderRules(rulesWiden, stats)
+}
+
+func orderRules(rules []rule, stats map[string]RuleStatistics) []rule {
+	ordered := make([]rule, 0, len(rules))
+	for _, r := range rankRules(rules, stats) {
+		if !r.suppressed {
+			ordered = append(ordered, r.rule)
+		}
+	}
+	return ordered
+}
+
+type rankedRule struct {
+	rule
+	RuleStatistics
+	defaultPosition int
+	score           float64
+	suppressed      bool
+}
+
+// rankRules sorts rules by their click-through rate, smoothed towards the
+// average rate of all given rules. Rules with equal rates keep their default
+// relative order.
+func rankRules(rules []rule, stats map[string]RuleStatistics) []rankedRule {
+	var total RuleStatistics
+	for _, r := range rules {
+		total.Impressions += stats[r.description].Impressions
+		total.Clicks += stats[r.description].Clicks
+	}
+	prior := total.ClickThroughRate()
+
+	ranked := make([]rankedRule, 0, len(rules))
+	for i, r := range rules {
+		s := stats[r.description]
+		ranked = append(ranked, rankedRule{
+			rule:            r,
+			RuleStatistics:  s,
+			defaultPosition: i,
+			score:           (float64(s.Clicks) + priorImpressions*prior) / (float64(s.Impressions) + priorImpressions),
+			suppressed:      s.Impressions >= suppressMinImpressions && s.ClickThroughRate() < suppressMaxClickThroughRate,
+		})
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].score > ranked[j].score
+	})
+	return ranked
+}
+
+// RuleReport describes how effective a rule is, and where learned rule
+// ordering places it.
+type RuleReport struct {
+	Description string
+	Kind        RuleKind
+	RuleStatistics
+
+	// DefaultPosition is the index of the rule among the rules of its kind
+	// in the default order.
+	DefaultPosition int
+
+	// LearnedPosition is the index of the rule among the rules of its kind
+	// when learned rule ordering is enabled, or -1 if the rule is suppressed.
+	LearnedPosition int
+	Suppressed      bool
+}
+
+// RuleReports returns a report for every rule based on the current
+// statistics, narrowing rules first.
+func RuleReports() []RuleReport {
+	stats := currentRuleStatistics()
+
+	var reports []RuleReport
+	for _, set := range []struct {
+		kind  RuleKind
+		rules []rule
+	}{
+		{RuleKindNarrow, rulesNarrow},
+		{RuleKindWiden, rulesWiden},
+	} {
+		ranked := rankRules(set.rules, stats)
+		position := 0
+		kindReports := make([]RuleReport, len(ranked))
+		for _, r := range ranked {
+			learnedPosition := -1
+			if !r.suppressed {
+				learnedPosition = position
+				position++
+			}
+			kindReports[r.defaultPosition] = RuleReport{
+				Description:     r.description,
+				Kind:            set.kind,
+				RuleStatistics:  r.RuleStatistics,
+				DefaultPosition: r.defaultPosition,
+				LearnedPosition: learnedPosition,
+				Suppressed:      r.suppressed,
+			}
+		}
+		reports = append(reports, kindReports...)
+	}
+	return reports
+}
@@ -0,0 +1,82 @@
+package lucky
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderRules(t *testing.T) {
+	rules := []rule{{description: "a"}, {description: "b"}, {description: "c"}, {description: "d"}}
+	descriptions := func(rules []rule) []string {
+		var ds []string
+		for _, r := range rules {
+			ds = append(ds, r.description)
+		}
+		return ds
+	}
+
+	t.Run("no statistics keep the default order", func(t *testing.T) {
+		require.Equal(t, []string{"a", "b", "c", "d"}, descriptions(orderRules(rules, nil)))
+	})
+
+	t.Run("rules are ordered by click-through rate", func(t *testing.T) {
+		stats := map[string]RuleStatistics{
+			"a": {Impressions: 100, Clicks: 2},
+			"b": {Impressions: 100, Clicks: 10},
+			"c": {Impressions: 100, Clicks: 5},
+		}
+		// d has no statistics, so it is ranked at the average rate.
+		require.Equal(t, []string{"b", "d", "c", "a"}, descriptions(orderRules(rules, stats)))
+	})
+
+	t.Run("rules with few impressions stay close to the average", func(t *testing.T) {
+		stats := map[string]RuleStatistics{
+			"a": {Impressions: 100, Clicks: 5},
+			"b": {Impressions: 100, Clicks: 5},
+			"c": {Impressions: 1, Clicks: 1},
+			"d": {Impressions: 100, Clicks: 10},
+		}
+		require.Equal(t, []string{"d", "c", "a", "b"}, descriptions(orderRules(rules, stats)))
+	})
+
+	t.Run("rules that are almost never clicked are suppressed", func(t *testing.T) {
+		stats := map[string]RuleStatistics{
+			"a": {Impressions: 1000, Clicks: 1},
+			"b": {Impressions: 100, Clicks: 0},
+		}
+		// b has too few impressions to be suppressed, but is ranked last.
+		require.Equal(t, []string{"c", "d", "b"}, descriptions(orderRules(rules, stats)))
+	})
+}
+
+func TestRuleSets(t *testing.T) {
+	t.Cleanup(func() { SetRuleStatistics(nil) })
+
+	SetRuleStatistics(map[string]RuleStatistics{
+		"AND patterns together":           {Impressions: 100, Clicks: 20},
+		"patterns as regular expressions": {Impressions: 1000, Clicks: 0},
+	})
+
+	narrow, widen := ruleSets(false)
+	require.Equal(t, rulesNarrow, narrow)
+	require.Equal(t, rulesWiden, widen)
+
+	narrow, widen = ruleSets(true)
+	require.Equal(t, rulesNarrow, narrow)
+	require.Len(t, widen, 1)
+	require.Equal(t, "AND patterns together", widen[0].description)
+
+	reports := RuleReports()
+	require.Len(t, reports, len(rulesNarrow)+len(rulesWiden))
+	regexp := reports[len(rulesNarrow)]
+	require.Equal(t, RuleReport{
+		Description:     "patterns as regular expressions",
+		Kind:            RuleKindWiden,
+		RuleStatistics:  RuleStatistics{Impressions: 1000, Clicks: 0},
+		DefaultPosition: 0,
+		LearnedPosition: -1,
+		Suppressed:      true,
+	}, regexp)
+	require.Equal(t, 0, reports[len(rulesNarrow)+1].LearnedPosition)
+}
@@ -33,6 +33,9 @@ const (
 	FieldTimeout   = "timeout"
 	FieldCombyRule = "rule"
 	FieldSelect    = "select"
+
+	// Symbol search filters.
+	FieldSymbolContainer = "symbol.container"
 )
 
 var allFields = map[string]struct{}{
@@ -71,6 +74,7 @@ var allFields = map[string]struct{}{
 	FieldRev:                empty,
 	"revision":              empty,
 	FieldSelect:             empty,
+	FieldSymbolContainer:    empty,
 }
 
 var aliases = map[string]string{
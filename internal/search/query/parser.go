@@ -601,7 +601,8 @@ func ScanField(buf []byte) (string, bool, int) {
 	success := false
 	for len(buf) > 0 {
 		r = next()
-		if strings.ContainsRune(allowed, r) {
+		// Dots separate the parts of namespaced fields like symbol.container.
+		if strings.ContainsRune(allowed, r) || r == '.' {
 			result = append(result, r)
 			continue
 		}
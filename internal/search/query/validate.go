@@ -292,6 +292,9 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 	case
 		FieldSelect:
 		return satisfies(isSingular, isNotNegated, isValidSelect)
+	case
+		FieldSymbolContainer:
+		return satisfies(isSingular, isNotNegated, isValidRegexp)
 	default:
 		return isUnrecognizedField()
 	}
@@ -334,21 +337,53 @@ func validateRepoRevPair(nodes []Node) error {
 }
 
 // Queries containing commit parameters without type:diff or type:commit are not
-// valid. cf. https://docs.sourcegraph.com/code_search/reference/language#commit-parameter
+// valid, except for author:, before: and after:, which content searches
+// filter matched lines by git blame with. cf.
+// https://docs.sourcegraph.com/code_search/reference/language#commit-parameter
 func validateCommitParameters(nodes []Node) error {
-	var seenCommitParam string
-	var typeCommitExists bool
+	var seenCommitParam, seenBlameParam string
+	var typeCommitExists, typeNonContentExists bool
 	VisitParameter(nodes, func(field, value string, _ bool, _ Annotation) {
-		if field == FieldAuthor || field == FieldBefore || field == FieldAfter || field == FieldMessage {
+		switch field {
+		case FieldMessage:
 			seenCommitParam = field
-		}
-		if field == FieldType && (value == "commit" || value == "diff") {
-			typeCommitExists = true
+		case FieldAuthor, FieldBefore, FieldAfter:
+			seenBlameParam = field
+		case FieldType:
+			if value == "commit" || value == "diff" {
+				typeCommitExists = true
+			} else if value != "file" {
+				typeNonContentExists = true
+			}
 		}
 	})
-	if seenCommitParam != "" && !typeCommitExists {
+	if typeCommitExists {
+		return nil
+	}
+	if seenCommitParam != "" {
 		return errors.Errorf(`your query contains the field '%s', which requires type:commit or type:diff in the query`, seenCommitParam)
 	}
+	if seenBlameParam != "" && typeNonContentExists {
+		return errors.Errorf(`your query contains the field '%s', which requires type:commit, type:diff or type:file in the query`, seenBlameParam)
+	}
+	return nil
+}
+
+// Queries containing symbol parameters without type:symbol are not valid.
+func validateSymbolParameters(nodes []Node) error {
+	var seenSymbolParam string
+	var typeSymbolExists bool
+	VisitParameter(nodes, func(field, value string, _ bool, _ Annotation) {
+		if field == FieldSymbolContainer {
+			seenSymbolParam = field
+		}
+		if field == FieldType && value == "symbol" {
+			typeSymbolExists = true
+		}
+	})
+	if seenSymbolParam != "" && !typeSymbolExists {
+		return errors.Errorf(`your query contains the field '%s', which requires type:symbol in the query`, seenSymbolParam)
+	}
 	return nil
 }
 
@@ -492,6 +527,7 @@ func validate(nodes []Node) error {
 		validateRepoRevPair,
 		validateRepoHasFile,
 		validateCommitParameters,
+		validateSymbolParameters,
 		validateTypeStructural,
 		validateRefGlobs,
 	)
@@ -80,8 +80,12 @@ func TestValidation(t *testing.T) {
 			want:  "invalid syntax. The query contains `rev:` without `repo:`. Add a `repo:` filter and try again",
 		},
 		{
-			input: "repo:foo author:rob@saucegraph.com",
-			want:  `your query contains the field 'author', which requires type:commit or type:diff in the query`,
+			input: "repo:foo message:fix",
+			want:  `your query contains the field 'message', which requires type:commit or type:diff in the query`,
+		},
+		{
+			input: "repo:foo type:repo author:rob@saucegraph.com",
+			want:  `your query contains the field 'author', which requires type:commit, type:diff or type:file in the query`,
 		},
 		{
 			input: "repohasfile:README type:symbol yolo",
@@ -49,6 +49,11 @@ type FileMatch struct {
 
 	LimitHit bool
 
+	// Owners are the code owners of the file as declared by the CODEOWNERS
+	// file of the repository. It is only populated for searches that ask for
+	// ownership, i.e. queries using file:has.owner() or select:file.owners.
+	Owners []string `json:",omitempty"`
+
 	// Debug is optionally set with a debug message explaining the result.
 	//
 	// Note: this is a pointer since usually this is unset. Pointer is 8 bytes
@@ -87,6 +92,12 @@ func (fm *FileMatch) Select(selectPath filter.SelectPath) Match {
 	case filter.File:
 		fm.ChunkMatches = nil
 		fm.Symbols = nil
+		if len(selectPath) > 1 && selectPath[1] == "owners" {
+			if len(fm.Owners) == 0 {
+				return nil // Remove file match if the file is unowned
+			}
+			return fm
+		}
 		if len(selectPath) > 1 && selectPath[1] == "directory" {
 			fm.Path = path.Clean(path.Dir(fm.Path)) + "/" // Add trailing slash for clarity.
 		}
@@ -3,6 +3,7 @@ package result
 import (
 	"fmt"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -235,6 +236,19 @@ func pick(symbols []*SymbolMatch, satisfy func(*SymbolMatch) bool) []*SymbolMatc
 	return result
 }
 
+// SymbolKindsForSelect returns the internal symbol kinds (cf. toSelectKind)
+// that correspond to the symbol selector kind value field, in sorted order.
+func SymbolKindsForSelect(field string) []string {
+	var kinds []string
+	for kind, selectKind := range toSelectKind {
+		if selectKind == field {
+			kinds = append(kinds, kind)
+		}
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
 func SelectSymbolKind(symbols []*SymbolMatch, field string) []*SymbolMatch {
 	return pick(symbols, func(s *SymbolMatch) bool {
 		return field == toSelectKind[strings.ToLower(s.Symbol.Kind)]
@@ -60,6 +60,7 @@ func Search(
 		Branch: branch,
 		PatternInfo: protocol.PatternInfo{
 			Pattern:                      p.Pattern,
+			Expression:                   p.Expression,
 			ExcludePattern:               p.ExcludePattern,
 			IncludePatterns:              p.IncludePatterns,
 			Languages:                    p.Languages,
@@ -15,6 +15,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/filter"
 	"github.com/sourcegraph/sourcegraph/internal/search/job"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
@@ -118,14 +119,23 @@ func searchInRepo(ctx context.Context, db database.DB, repoRevs *search.Reposito
 	}
 	span.SetTag("commit", string(commitID))
 
+	// Push select:symbol.<kind> down to the symbols service, so that the
+	// limit applies to symbols of the selected kind.
+	var includeKinds []string
+	if patternInfo.Select.Root() == filter.Symbol && len(patternInfo.Select) > 1 {
+		includeKinds = result.SymbolKindsForSelect(patternInfo.Select[1])
+	}
+
 	symbols, err := backend.Symbols.ListTags(ctx, search.SymbolsParameters{
-		Repo:            repoRevs.Repo.Name,
-		CommitID:        commitID,
-		Query:           patternInfo.Pattern,
-		IsCaseSensitive: patternInfo.IsCaseSensitive,
-		IsRegExp:        patternInfo.IsRegExp,
-		IncludePatterns: patternInfo.IncludePatterns,
-		ExcludePattern:  patternInfo.ExcludePattern,
+		Repo:             repoRevs.Repo.Name,
+		CommitID:         commitID,
+		Query:            patternInfo.Pattern,
+		IsCaseSensitive:  patternInfo.IsCaseSensitive,
+		IsRegExp:         patternInfo.IsRegExp,
+		IncludePatterns:  patternInfo.IncludePatterns,
+		ExcludePattern:   patternInfo.ExcludePattern,
+		IncludeKinds:     includeKinds,
+		ContainerPattern: patternInfo.SymbolContainer,
 		// Ask for limit + 1 so we can detect whether there are more results than the limit.
 		First: limit + 1,
 	})
@@ -30,6 +30,7 @@ type EventContentMatch struct {
 	Hunks           []DecoratedHunk  `json:"hunks"`
 	LineMatches     []EventLineMatch `json:"lineMatches,omitempty"`
 	ChunkMatches    []ChunkMatch     `json:"chunkMatches,omitempty"`
+	Owners          []string         `json:"owners,omitempty"`
 	Debug           string           `json:"debug,omitempty"`
 }
 
@@ -51,6 +52,7 @@ type EventPathMatch struct {
 	RepoLastFetched *time.Time `json:"repoLastFetched,omitempty"`
 	Branches        []string   `json:"branches,omitempty"`
 	Commit          string     `json:"commit,omitempty"`
+	Owners          []string   `json:"owners,omitempty"`
 }
 
 func (e *EventPathMatch) eventMatch() {}
@@ -8,6 +8,7 @@ import (
 	"github.com/grafana/regexp"
 	otlog "github.com/opentracing/opentracing-go/log"
 
+	"github.com/sourcegraph/sourcegraph/cmd/searcher/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/featureflag"
 	"github.com/sourcegraph/sourcegraph/internal/search/filter"
@@ -102,6 +103,16 @@ type SymbolsParameters struct {
 	// need to match to get included in the result
 	ExcludePattern string
 
+	// IncludeKinds is an optional list of ctags kinds (e.g. "func" or
+	// "method"). When set, only symbols of one of these kinds are returned.
+	// Kinds are compared case-insensitively.
+	IncludeKinds []string
+
+	// ContainerPattern is an optional regex that the container of a symbol
+	// (e.g. the class of a method) needs to match to get included in the
+	// result.
+	ContainerPattern string
+
 	// First indicates that only the first n symbols should be returned.
 	First int
 
@@ -216,6 +227,14 @@ type TextPatternInfo struct {
 	PatternMatchesPath    bool
 
 	Languages []string
+
+	// SymbolContainer is an optional regex that the container of symbol
+	// results needs to match (the symbol.container: filter).
+	SymbolContainer string `json:",omitempty"`
+
+	// Expression, if set, is a boolean expression of patterns that searcher
+	// evaluates instead of Pattern and IsNegated.
+	Expression *protocol.PatternExpression `json:",omitempty"`
 }
 
 func (p *TextPatternInfo) Fields() []otlog.Field {
@@ -226,6 +245,9 @@ func (p *TextPatternInfo) Fields() []otlog.Field {
 
 	add(otlog.String("pattern", p.Pattern))
 
+	if p.Expression != nil {
+		add(otlog.String("expression", p.Expression.String()))
+	}
 	if p.IsNegated {
 		add(otlog.Bool("isNegated", p.IsNegated))
 	}
@@ -266,11 +288,17 @@ func (p *TextPatternInfo) Fields() []otlog.Field {
 	if len(p.Languages) > 0 {
 		add(trace.Strings("languages", p.Languages))
 	}
+	if p.SymbolContainer != "" {
+		add(otlog.String("symbolContainer", p.SymbolContainer))
+	}
 	return res
 }
 
 func (p *TextPatternInfo) String() string {
 	args := []string{fmt.Sprintf("%q", p.Pattern)}
+	if p.Expression != nil {
+		args = []string{p.Expression.String()}
+	}
 	if p.IsRegExp {
 		args = append(args, "re")
 	}
@@ -310,6 +338,9 @@ func (p *TextPatternInfo) String() string {
 	for _, inc := range p.IncludePatterns {
 		args = append(args, fmt.Sprintf("%s:%q", path, inc))
 	}
+	if p.SymbolContainer != "" {
+		args = append(args, fmt.Sprintf("symbol.container:%q", p.SymbolContainer))
+	}
 
 	return fmt.Sprintf("TextPatternInfo{%s}", strings.Join(args, ","))
 }
@@ -340,6 +371,10 @@ type Features struct {
 	// 08/2022. To be removed at latest by 12/2022.
 	AbLuckySearch bool `json:"ab-lucky-search"`
 
+	// SmartSearchLearnedRuleOrdering when true orders the rules Smart Search
+	// applies by how often users clicked the queries they generated.
+	SmartSearchLearnedRuleOrdering bool `json:"smart-search-learned-rule-ordering"`
+
 	// Ranking when true will use a our new #ranking signals and code paths
 	// for ranking results from Zoekt.
 	Ranking bool `json:"ranking"`
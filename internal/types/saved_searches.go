@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 // SavedSearch represents a saved search
 type SavedSearch struct {
 	ID              int32 // the globally unique DB ID
@@ -11,3 +13,12 @@ type SavedSearch struct {
 	OrgID           *int32  // if non-nil, the owner is this organization. UserID/OrgID are mutually exclusive.
 	SlackWebhookURL *string // if non-nil && NotifySlack == true, indicates that this Slack webhook URL should be used instead of the owners default Slack webhook.
 }
+
+// SavedSearchNotificationState is a saved search with notifications enabled,
+// together with the results of its last scheduled run.
+type SavedSearchNotificationState struct {
+	SavedSearch
+	LastRunAt          *time.Time // nil if the saved search has never been run
+	ResultsFingerprint string     // empty if the saved search has not been run since its query last changed
+	ResultHashes       []string   // the sorted hashes of the results of the last run
+}
@@ -52,6 +52,8 @@ func (e *ExternalService) RedactedConfig(ctx context.Context) (string, error) {
 		es.redactString(c.Token, "token")
 	case *schema.BitbucketCloudConnection:
 		es.redactString(c.AppPassword, "appPassword")
+	case *schema.AzureDevOpsConnection:
+		es.redactString(c.Token, "token")
 	case *schema.AWSCodeCommitConnection:
 		es.redactString(c.SecretAccessKey, "secretAccessKey")
 		es.redactString(c.GitCredentials.Password, "gitCredentials", "password")
@@ -79,6 +81,16 @@ func (e *ExternalService) RedactedConfig(ctx context.Context) (string, error) {
 		// Nothing to redact
 	case *schema.RubyPackagesConnection:
 		es.redactString(c.Repository, "repository")
+	case *schema.NuGetPackagesConnection:
+		err = es.redactURL(c.Repository, "repository")
+		if err != nil {
+			return "", err
+		}
+	case *schema.PhpPackagesConnection:
+		err = es.redactURL(c.Repository, "repository")
+		if err != nil {
+			return "", err
+		}
 	case *schema.JVMPackagesConnection:
 		if c.Maven != nil {
 			es.redactString(c.Maven.Credentials, "maven", "credentials")
@@ -152,6 +164,9 @@ func (e *ExternalService) UnredactConfig(ctx context.Context, old *ExternalServi
 	case *schema.BitbucketCloudConnection:
 		o := oldCfg.(*schema.BitbucketCloudConnection)
 		es.unredactString(c.AppPassword, o.AppPassword, "appPassword")
+	case *schema.AzureDevOpsConnection:
+		o := oldCfg.(*schema.AzureDevOpsConnection)
+		es.unredactString(c.Token, o.Token, "token")
 	case *schema.AWSCodeCommitConnection:
 		o := oldCfg.(*schema.AWSCodeCommitConnection)
 		es.unredactString(c.SecretAccessKey, o.SecretAccessKey, "secretAccessKey")
@@ -179,6 +194,18 @@ func (e *ExternalService) UnredactConfig(ctx context.Context, old *ExternalServi
 	case *schema.RubyPackagesConnection:
 		o := oldCfg.(*schema.RubyPackagesConnection)
 		es.unredactString(c.Repository, o.Repository, "repository")
+	case *schema.NuGetPackagesConnection:
+		o := oldCfg.(*schema.NuGetPackagesConnection)
+		err = es.unredactURL(c.Repository, o.Repository, "repository")
+		if err != nil {
+			return err
+		}
+	case *schema.PhpPackagesConnection:
+		o := oldCfg.(*schema.PhpPackagesConnection)
+		err = es.unredactURL(c.Repository, o.Repository, "repository")
+		if err != nil {
+			return err
+		}
 	case *schema.JVMPackagesConnection:
 		o := oldCfg.(*schema.JVMPackagesConnection)
 		if c.Maven != nil && o.Maven != nil {
@@ -46,6 +46,11 @@ func TestExternalService_RedactedConfig(t *testing.T) {
 			in:   schema.BitbucketCloudConnection{AppPassword: "foobar", Url: "https://bitbucket.org"},
 			out:  schema.BitbucketCloudConnection{AppPassword: RedactedSecret, Url: "https://bitbucket.org"},
 		},
+		{
+			kind: extsvc.KindAzureDevOps,
+			in:   schema.AzureDevOpsConnection{Token: "foobar", Url: "https://dev.azure.com"},
+			out:  schema.AzureDevOpsConnection{Token: RedactedSecret, Url: "https://dev.azure.com"},
+		},
 		{
 			kind: extsvc.KindAWSCodeCommit,
 			in: schema.AWSCodeCommitConnection{
@@ -207,6 +212,12 @@ func TestExternalService_UnredactConfig(t *testing.T) {
 			in:   schema.BitbucketCloudConnection{AppPassword: RedactedSecret, Url: "https://bitbucket.corp.com"},
 			out:  schema.BitbucketCloudConnection{AppPassword: "foobar", Url: "https://bitbucket.corp.com"},
 		},
+		{
+			kind: extsvc.KindAzureDevOps,
+			old:  schema.AzureDevOpsConnection{Token: "foobar", Url: "https://dev.azure.com"},
+			in:   schema.AzureDevOpsConnection{Token: RedactedSecret, Url: "https://azure.corp.com"},
+			out:  schema.AzureDevOpsConnection{Token: "foobar", Url: "https://azure.corp.com"},
+		},
 		{
 			kind: extsvc.KindAWSCodeCommit,
 			old: schema.AWSCodeCommitConnection{
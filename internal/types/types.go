@@ -820,6 +820,31 @@ type OrgStats struct {
 	CodeHostRepoCount int32
 }
 
+// Role is a named set of permissions that can be assigned to users and
+// organizations.
+type Role struct {
+	ID   int32
+	Name string
+	// System roles are built into the instance. They are implicitly held by
+	// the users they apply to and cannot be assigned or deleted.
+	System    bool
+	CreatedAt time.Time
+}
+
+// Permission is a namespaced action that can be granted to roles. The set of
+// permissions is defined by the instance, see the rbac package.
+type Permission struct {
+	ID        int32
+	Namespace string
+	Action    string
+	CreatedAt time.Time
+}
+
+// DisplayName returns the name of the permission as shown to users.
+func (p *Permission) DisplayName() string {
+	return p.Namespace + "#" + p.Action
+}
+
 type PhabricatorRepo struct {
 	ID       int32
 	Name     api.RepoName
@@ -1213,6 +1238,14 @@ type SearchAggregatedEvent struct {
 	LatenciesDay   []float64
 }
 
+// SmartSearchRuleAggregatedEvent counts how often queries generated by a Smart
+// Search rule were shown to users, and how often users clicked them.
+type SmartSearchRuleAggregatedEvent struct {
+	Rule        string
+	Impressions int32
+	Clicks      int32
+}
+
 type SurveyResponse struct {
 	ID           int32
 	UserID       *int32
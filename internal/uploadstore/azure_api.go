@@ -0,0 +1,275 @@
+package uploadstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type azureAPI interface {
+	CreateContainer(ctx context.Context, container string) error
+	GetBlob(ctx context.Context, container, name string) (io.ReadCloser, error)
+	PutBlock(ctx context.Context, container, name, blockID string, data []byte) error
+	PutBlockList(ctx context.Context, container, name string, blockIDs []string) error
+	DeleteBlob(ctx context.Context, container, name string) error
+	ListBlobs(ctx context.Context, container, prefix, marker string) (*azureBlobPage, error)
+}
+
+type azureBlob struct {
+	Name         string
+	LastModified time.Time
+}
+
+type azureBlobPage struct {
+	Blobs      []azureBlob
+	NextMarker string
+}
+
+// azureError is returned for unsuccessful responses of the Azure Blob Storage API.
+type azureError struct {
+	StatusCode int
+	Code       string
+}
+
+func (e *azureError) Error() string {
+	return fmt.Sprintf("unexpected status code %d from Azure Blob Storage (%s)", e.StatusCode, e.Code)
+}
+
+func isAzureError(err error, code string) bool {
+	var e *azureError
+	return errors.As(err, &e) && e.Code == code
+}
+
+// azureAPIVersion is the version of the Azure Blob Storage REST API that is requested.
+const azureAPIVersion = "2020-10-02"
+
+// azureRESTClient implements azureAPI against the Azure Blob Storage REST API using
+// Shared Key authorization.
+type azureRESTClient struct {
+	endpoint    *url.URL
+	accountName string
+	accountKey  []byte
+	doer        httpcli.Doer
+}
+
+var _ azureAPI = &azureRESTClient{}
+
+func newAzureRESTClient(doer httpcli.Doer, endpoint, accountName, accountKey string) (*azureRESTClient, error) {
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://%s.blob.core.windows.net", accountName)
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid endpoint")
+	}
+
+	key, err := base64.StdEncoding.DecodeString(accountKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid account key")
+	}
+
+	return &azureRESTClient{
+		endpoint:    u,
+		accountName: accountName,
+		accountKey:  key,
+		doer:        doer,
+	}, nil
+}
+
+func (c *azureRESTClient) CreateContainer(ctx context.Context, container string) error {
+	resp, err := c.do(ctx, http.MethodPut, container, "", url.Values{"restype": {"container"}}, nil, nil)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (c *azureRESTClient) GetBlob(ctx context.Context, container, name string) (io.ReadCloser, error) {
+	resp, err := c.do(ctx, http.MethodGet, container, name, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (c *azureRESTClient) PutBlock(ctx context.Context, container, name, blockID string, data []byte) error {
+	resp, err := c.do(ctx, http.MethodPut, container, name, url.Values{"comp": {"block"}, "blockid": {blockID}}, nil, data)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+type azureBlockList struct {
+	XMLName xml.Name `xml:"BlockList"`
+	Latest  []string `xml:"Latest"`
+}
+
+func (c *azureRESTClient) PutBlockList(ctx context.Context, container, name string, blockIDs []string) error {
+	body, err := xml.Marshal(azureBlockList{Latest: blockIDs})
+	if err != nil {
+		return err
+	}
+
+	header := http.Header{"Content-Type": {"application/xml"}}
+	resp, err := c.do(ctx, http.MethodPut, container, name, url.Values{"comp": {"blocklist"}}, header, append([]byte(xml.Header), body...))
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (c *azureRESTClient) DeleteBlob(ctx context.Context, container, name string) error {
+	resp, err := c.do(ctx, http.MethodDelete, container, name, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+type azureEnumerationResults struct {
+	Blobs []struct {
+		Name       string `xml:"Name"`
+		Properties struct {
+			LastModified string `xml:"Last-Modified"`
+		} `xml:"Properties"`
+	} `xml:"Blobs>Blob"`
+	NextMarker string `xml:"NextMarker"`
+}
+
+func (c *azureRESTClient) ListBlobs(ctx context.Context, container, prefix, marker string) (*azureBlobPage, error) {
+	query := url.Values{"restype": {"container"}, "comp": {"list"}}
+	if prefix != "" {
+		query.Set("prefix", prefix)
+	}
+	if marker != "" {
+		query.Set("marker", marker)
+	}
+
+	resp, err := c.do(ctx, http.MethodGet, container, "", query, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var results azureEnumerationResults
+	if err := xml.NewDecoder(resp.Body).Decode(&results); err != nil {
+		return nil, errors.Wrap(err, "failed to decode blob list")
+	}
+
+	page := &azureBlobPage{NextMarker: results.NextMarker}
+	for _, blob := range results.Blobs {
+		lastModified, err := http.ParseTime(blob.Properties.LastModified)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid last modified time of blob %q", blob.Name)
+		}
+		page.Blobs = append(page.Blobs, azureBlob{Name: blob.Name, LastModified: lastModified})
+	}
+
+	return page, nil
+}
+
+// do sends a signed request for the given container or blob. Responses with a
+// non-2xx status code are returned as an *azureError.
+func (c *azureRESTClient) do(ctx context.Context, method, container, name string, query url.Values, header http.Header, body []byte) (*http.Response, error) {
+	u := *c.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + container
+	if name != "" {
+		u.Path += "/" + name
+	}
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = int64(len(body))
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("x-ms-date", time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set("x-ms-version", azureAPIVersion)
+	req.Header.Set("Authorization", fmt.Sprintf("SharedKey %s:%s", c.accountName, azureSharedKeySignature(req, c.accountName, c.accountKey)))
+
+	resp, err := c.doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		return nil, &azureError{StatusCode: resp.StatusCode, Code: resp.Header.Get("x-ms-error-code")}
+	}
+
+	return resp, nil
+}
+
+// azureSharedKeySignature computes the Shared Key signature of the given request as
+// documented in https://learn.microsoft.com/en-us/rest/api/storageservices/authorize-with-shared-key.
+func azureSharedKeySignature(req *http.Request, accountName string, accountKey []byte) string {
+	var msHeaders []string
+	for key := range req.Header {
+		if key := strings.ToLower(key); strings.HasPrefix(key, "x-ms-") {
+			msHeaders = append(msHeaders, key)
+		}
+	}
+	sort.Strings(msHeaders)
+
+	var canonicalized strings.Builder
+	for _, key := range msHeaders {
+		fmt.Fprintf(&canonicalized, "%s:%s\n", key, strings.TrimSpace(req.Header.Get(key)))
+	}
+
+	canonicalized.WriteString("/" + accountName + req.URL.EscapedPath())
+	query := req.URL.Query()
+	queryKeys := make([]string, 0, len(query))
+	for key := range query {
+		queryKeys = append(queryKeys, key)
+	}
+	sort.Strings(queryKeys)
+	for _, key := range queryKeys {
+		values := query[key]
+		sort.Strings(values)
+		fmt.Fprintf(&canonicalized, "\n%s:%s", strings.ToLower(key), strings.Join(values, ","))
+	}
+
+	contentLength := ""
+	if req.ContentLength > 0 {
+		contentLength = strconv.FormatInt(req.ContentLength, 10)
+	}
+
+	stringToSign := strings.Join([]string{
+		req.Method,
+		req.Header.Get("Content-Encoding"),
+		req.Header.Get("Content-Language"),
+		contentLength,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		req.Header.Get("Date"),
+		req.Header.Get("If-Modified-Since"),
+		req.Header.Get("If-Match"),
+		req.Header.Get("If-None-Match"),
+		req.Header.Get("If-Unmodified-Since"),
+		req.Header.Get("Range"),
+		canonicalized.String(),
+	}, "\n")
+
+	mac := hmac.New(sha256.New, accountKey)
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,259 @@
+package uploadstore
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/inconshreveable/log15"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type azureStore struct {
+	container    string
+	manageBucket bool
+	client       azureAPI
+	operations   *Operations
+}
+
+var _ Store = &azureStore{}
+
+type AzureConfig struct {
+	AccountName string
+	AccountKey  string
+
+	// Endpoint overrides the blob service endpoint of the storage account, which
+	// defaults to https://<AccountName>.blob.core.windows.net.
+	Endpoint string
+}
+
+// azureBlockSize is the size of the blocks uploaded to compose a blob.
+const azureBlockSize = 8 * 1024 * 1024
+
+// newAzureFromConfig creates a new store backed by Azure Blob Storage. The bucket
+// configured for the store is used as the container name.
+func newAzureFromConfig(ctx context.Context, config Config, operations *Operations) (Store, error) {
+	client, err := newAzureRESTClient(httpcli.ExternalDoer, config.Azure.Endpoint, config.Azure.AccountName, config.Azure.AccountKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return newAzureWithClient(client, config.Bucket, config.ManageBucket, operations), nil
+}
+
+func newAzureWithClient(client azureAPI, container string, manageBucket bool, operations *Operations) *azureStore {
+	return &azureStore{
+		container:    container,
+		manageBucket: manageBucket,
+		client:       client,
+		operations:   operations,
+	}
+}
+
+func (s *azureStore) Init(ctx context.Context) error {
+	if !s.manageBucket {
+		return nil
+	}
+
+	if err := s.client.CreateContainer(ctx, s.container); err != nil && !isAzureError(err, "ContainerAlreadyExists") {
+		return errors.Wrap(err, "failed to create container")
+	}
+
+	return nil
+}
+
+func (s *azureStore) Get(ctx context.Context, key string) (_ io.ReadCloser, err error) {
+	ctx, _, endObservation := s.operations.Get.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	rc, err := s.client.GetBlob(ctx, s.container, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get object")
+	}
+
+	return rc, nil
+}
+
+func (s *azureStore) Upload(ctx context.Context, key string, r io.Reader) (_ int64, err error) {
+	ctx, _, endObservation := s.operations.Upload.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	w := s.newBlockWriter(ctx, key)
+	if _, err := io.Copy(w, r); err != nil {
+		return 0, errors.Wrap(err, "failed to upload object")
+	}
+	if err := w.Commit(); err != nil {
+		return 0, errors.Wrap(err, "failed to upload object")
+	}
+
+	return w.size, nil
+}
+
+func (s *azureStore) Compose(ctx context.Context, destination string, sources ...string) (_ int64, err error) {
+	ctx, _, endObservation := s.operations.Compose.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("destination", destination),
+		log.String("sources", strings.Join(sources, ", ")),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	defer func() {
+		if err == nil {
+			// Delete sources on success
+			if err := s.deleteSources(ctx, sources); err != nil {
+				log15.Error("Failed to delete source objects", "error", err)
+			}
+		}
+	}()
+
+	// Blob storage cannot concatenate blobs server-side without granting the
+	// service access to the sources, so the sources are streamed into the blocks
+	// of the destination blob instead.
+	w := s.newBlockWriter(ctx, destination)
+	for _, source := range sources {
+		if err := s.copyBlob(ctx, w, source); err != nil {
+			return 0, errors.Wrap(err, "failed to compose objects")
+		}
+	}
+	if err := w.Commit(); err != nil {
+		return 0, errors.Wrap(err, "failed to compose objects")
+	}
+
+	return w.size, nil
+}
+
+func (s *azureStore) Delete(ctx context.Context, key string) (err error) {
+	ctx, _, endObservation := s.operations.Delete.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if err := s.client.DeleteBlob(ctx, s.container, key); err != nil && !isAzureError(err, "BlobNotFound") {
+		return errors.Wrap(err, "failed to delete object")
+	}
+
+	return nil
+}
+
+func (s *azureStore) ExpireObjects(ctx context.Context, prefix string, maxAge time.Duration) (err error) {
+	ctx, _, endObservation := s.operations.Expire.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("prefix", prefix),
+		log.String("maxAge", maxAge.String()),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	cutoff := time.Now().Add(-maxAge)
+
+	marker := ""
+	for {
+		page, err := s.client.ListBlobs(ctx, s.container, prefix, marker)
+		if err != nil {
+			return errors.Wrap(err, "failed to list objects")
+		}
+
+		var expired []string
+		for _, blob := range page.Blobs {
+			if !blob.LastModified.After(cutoff) {
+				expired = append(expired, blob.Name)
+			}
+		}
+		if err := s.deleteSources(ctx, expired); err != nil {
+			return err
+		}
+
+		if page.NextMarker == "" {
+			return nil
+		}
+		marker = page.NextMarker
+	}
+}
+
+func (s *azureStore) copyBlob(ctx context.Context, w io.Writer, key string) error {
+	rc, err := s.client.GetBlob(ctx, s.container, key)
+	if err != nil {
+		return errors.Wrap(err, "failed to get source object")
+	}
+	defer rc.Close()
+
+	_, err = io.Copy(w, rc)
+	return err
+}
+
+func (s *azureStore) deleteSources(ctx context.Context, sources []string) error {
+	return goroutine.RunWorkersOverStrings(sources, func(index int, source string) error {
+		if err := s.client.DeleteBlob(ctx, s.container, source); err != nil && !isAzureError(err, "BlobNotFound") {
+			return errors.Wrap(err, "failed to delete source object")
+		}
+
+		return nil
+	})
+}
+
+func (s *azureStore) newBlockWriter(ctx context.Context, key string) *azureBlockWriter {
+	return &azureBlockWriter{ctx: ctx, store: s, key: key, buf: make([]byte, 0, azureBlockSize)}
+}
+
+// azureBlockWriter buffers written content and uploads it as uncommitted blocks of
+// the target blob. The blob becomes visible only once Commit is called.
+type azureBlockWriter struct {
+	ctx      context.Context
+	store    *azureStore
+	key      string
+	buf      []byte
+	blockIDs []string
+	size     int64
+}
+
+func (w *azureBlockWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := copy(w.buf[len(w.buf):cap(w.buf)], p)
+		w.buf = w.buf[:len(w.buf)+n]
+		p = p[n:]
+		written += n
+
+		if len(w.buf) == cap(w.buf) {
+			if err := w.flush(); err != nil {
+				return written, err
+			}
+		}
+	}
+
+	return written, nil
+}
+
+// Commit uploads any buffered content and commits the list of uploaded blocks. An
+// empty block list creates an empty blob.
+func (w *azureBlockWriter) Commit() error {
+	if len(w.buf) > 0 {
+		if err := w.flush(); err != nil {
+			return err
+		}
+	}
+
+	return w.store.client.PutBlockList(w.ctx, w.store.container, w.key, w.blockIDs)
+}
+
+func (w *azureBlockWriter) flush() error {
+	// All block IDs of a blob must have the same length.
+	blockID := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("block-%010d", len(w.blockIDs))))
+	if err := w.store.client.PutBlock(w.ctx, w.store.container, w.key, blockID, w.buf); err != nil {
+		return err
+	}
+
+	w.blockIDs = append(w.blockIDs, blockID)
+	w.size += int64(len(w.buf))
+	w.buf = w.buf[:0]
+	return nil
+}
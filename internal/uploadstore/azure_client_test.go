@@ -0,0 +1,255 @@
+package uploadstore
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestAzureStore(t *testing.T) {
+	server := newFakeAzureServer(t)
+	testStoreBehavior(t, testAzureClient(t, server, true))
+
+	if _, ok := server.containers["test-container"]; !ok {
+		t.Errorf("expected container to be created")
+	}
+}
+
+func TestAzureInitContainerExists(t *testing.T) {
+	server := newFakeAzureServer(t)
+	server.containers["test-container"] = map[string]*fakeAzureBlob{}
+
+	if err := testAzureClient(t, server, true).Init(context.Background()); err != nil {
+		t.Fatalf("unexpected error initializing client: %s", err)
+	}
+}
+
+func TestAzureUnmanagedInit(t *testing.T) {
+	server := newFakeAzureServer(t)
+
+	if err := testAzureClient(t, server, false).Init(context.Background()); err != nil {
+		t.Fatalf("unexpected error initializing client: %s", err)
+	}
+	if _, ok := server.containers["test-container"]; ok {
+		t.Errorf("unexpected container creation")
+	}
+}
+
+func TestAzureUploadMultipleBlocks(t *testing.T) {
+	server := newFakeAzureServer(t)
+	client := testAzureClient(t, server, true)
+
+	payload := strings.Repeat("x", 2*azureBlockSize+1)
+	if n, err := client.Upload(context.Background(), "test-key", strings.NewReader(payload)); err != nil {
+		t.Fatalf("unexpected error uploading key: %s", err)
+	} else if n != int64(len(payload)) {
+		t.Errorf("unexpected size. want=%d have=%d", len(payload), n)
+	}
+
+	if blob := server.containers["test-container"]["test-key"]; blob == nil {
+		t.Fatalf("expected blob to be committed")
+	} else if blob.blocks != 3 {
+		t.Errorf("unexpected number of blocks. want=%d have=%d", 3, blob.blocks)
+	} else if string(blob.data) != payload {
+		t.Errorf("unexpected blob contents")
+	}
+}
+
+func TestAzureExpireObjectsPaginates(t *testing.T) {
+	server := newFakeAzureServer(t)
+	server.pageSize = 1
+	client := testAzureClient(t, server, true)
+
+	for _, key := range []string{"a/1", "a/2", "a/3"} {
+		if _, err := client.Upload(context.Background(), key, strings.NewReader("TEST PAYLOAD")); err != nil {
+			t.Fatalf("unexpected error uploading key: %s", err)
+		}
+	}
+
+	if err := client.ExpireObjects(context.Background(), "a/", 0); err != nil {
+		t.Fatalf("unexpected error expiring objects: %s", err)
+	}
+	if n := len(server.containers["test-container"]); n != 0 {
+		t.Errorf("unexpected number of remaining blobs. want=%d have=%d", 0, n)
+	}
+}
+
+func testAzureClient(t *testing.T, server *fakeAzureServer, manageBucket bool) Store {
+	client, err := newAzureRESTClient(server.Client(), server.URL+"/"+server.accountName, server.accountName, base64.StdEncoding.EncodeToString(server.accountKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return newLazyStore(newAzureWithClient(client, "test-container", manageBucket, NewOperations(&observation.TestContext, "test", "brittlestore")))
+}
+
+// fakeAzureServer is an in-process implementation of the subset of the Azure Blob
+// Storage REST API used by the azure store. Like the storage emulator, it expects
+// the account name as the first path segment.
+type fakeAzureServer struct {
+	*httptest.Server
+	t           *testing.T
+	accountName string
+	accountKey  []byte
+	pageSize    int
+
+	mu         sync.Mutex
+	containers map[string]map[string]*fakeAzureBlob
+	staged     map[string]map[string][]byte
+}
+
+type fakeAzureBlob struct {
+	data         []byte
+	blocks       int
+	lastModified time.Time
+}
+
+func newFakeAzureServer(t *testing.T) *fakeAzureServer {
+	s := &fakeAzureServer{
+		t:           t,
+		accountName: "devstoreaccount1",
+		accountKey:  []byte("secret-account-key"),
+		pageSize:    5000,
+		containers:  map[string]map[string]*fakeAzureBlob{},
+		staged:      map[string]map[string][]byte{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *fakeAzureServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	body, _ := io.ReadAll(r.Body)
+	r.ContentLength = int64(len(body))
+
+	expected := fmt.Sprintf("SharedKey %s:%s", s.accountName, azureSharedKeySignature(r, s.accountName, s.accountKey))
+	if r.Header.Get("Authorization") != expected || r.Header.Get("x-ms-version") != azureAPIVersion {
+		s.fail(w, http.StatusForbidden, "AuthenticationFailed")
+		return
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"+s.accountName+"/"), "/", 2)
+	container, name := parts[0], ""
+	if len(parts) == 2 {
+		name = parts[1]
+	}
+	query := r.URL.Query()
+	blobs, containerExists := s.containers[container]
+
+	switch {
+	case r.Method == http.MethodPut && name == "" && query.Get("restype") == "container":
+		if containerExists {
+			s.fail(w, http.StatusConflict, "ContainerAlreadyExists")
+			return
+		}
+		s.containers[container] = map[string]*fakeAzureBlob{}
+		w.WriteHeader(http.StatusCreated)
+		return
+
+	case !containerExists:
+		s.fail(w, http.StatusNotFound, "ContainerNotFound")
+		return
+
+	case r.Method == http.MethodGet && name == "" && query.Get("comp") == "list":
+		s.listBlobs(w, blobs, query.Get("prefix"), query.Get("marker"))
+		return
+
+	case r.Method == http.MethodPut && query.Get("comp") == "block":
+		key := container + "/" + name
+		if s.staged[key] == nil {
+			s.staged[key] = map[string][]byte{}
+		}
+		s.staged[key][query.Get("blockid")] = body
+		w.WriteHeader(http.StatusCreated)
+		return
+
+	case r.Method == http.MethodPut && query.Get("comp") == "blocklist":
+		var blockList azureBlockList
+		if err := xml.Unmarshal(body, &blockList); err != nil {
+			s.fail(w, http.StatusBadRequest, "InvalidXmlDocument")
+			return
+		}
+		key := container + "/" + name
+		blob := &fakeAzureBlob{data: []byte{}, blocks: len(blockList.Latest), lastModified: time.Now()}
+		for _, blockID := range blockList.Latest {
+			data, ok := s.staged[key][blockID]
+			if !ok {
+				s.fail(w, http.StatusBadRequest, "InvalidBlockList")
+				return
+			}
+			blob.data = append(blob.data, data...)
+		}
+		delete(s.staged, key)
+		blobs[name] = blob
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+
+	blob, ok := blobs[name]
+	if !ok {
+		s.fail(w, http.StatusNotFound, "BlobNotFound")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		_, _ = w.Write(blob.data)
+	case http.MethodDelete:
+		delete(blobs, name)
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		s.fail(w, http.StatusMethodNotAllowed, "UnsupportedHttpVerb")
+	}
+}
+
+func (s *fakeAzureServer) listBlobs(w http.ResponseWriter, blobs map[string]*fakeAzureBlob, prefix, marker string) {
+	var names []string
+	for name := range blobs {
+		if strings.HasPrefix(name, prefix) && name >= marker {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var results azureEnumerationResults
+	if len(names) > s.pageSize {
+		results.NextMarker = names[s.pageSize]
+		names = names[:s.pageSize]
+	}
+	for _, name := range names {
+		var blob struct {
+			Name       string `xml:"Name"`
+			Properties struct {
+				LastModified string `xml:"Last-Modified"`
+			} `xml:"Properties"`
+		}
+		blob.Name = name
+		blob.Properties.LastModified = blobs[name].lastModified.UTC().Format(http.TimeFormat)
+		results.Blobs = append(results.Blobs, blob)
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(struct {
+		XMLName xml.Name `xml:"EnumerationResults"`
+		azureEnumerationResults
+	}{azureEnumerationResults: results})
+}
+
+func (s *fakeAzureServer) fail(w http.ResponseWriter, statusCode int, code string) {
+	w.Header().Set("x-ms-error-code", code)
+	w.WriteHeader(statusCode)
+}
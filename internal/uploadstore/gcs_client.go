@@ -9,6 +9,7 @@ import (
 	"cloud.google.com/go/storage"
 	"github.com/inconshreveable/log15"
 	"github.com/opentracing/opentracing-go/log"
+	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
@@ -160,6 +161,35 @@ func (s *gcsStore) Delete(ctx context.Context, key string) (err error) {
 	return errors.Wrap(s.client.Bucket(s.bucket).Object(key).Delete(ctx), "failed to delete object")
 }
 
+func (s *gcsStore) ExpireObjects(ctx context.Context, prefix string, maxAge time.Duration) (err error) {
+	ctx, _, endObservation := s.operations.Expire.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("prefix", prefix),
+		log.String("maxAge", maxAge.String()),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	bucket := s.client.Bucket(s.bucket)
+	cutoff := time.Now().Add(-maxAge)
+
+	var expired []string
+	it := bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "failed to list objects")
+		}
+
+		if !attrs.Updated.After(cutoff) {
+			expired = append(expired, attrs.Name)
+		}
+	}
+
+	return s.deleteSources(ctx, bucket, expired)
+}
+
 func (s *gcsStore) create(ctx context.Context, bucket gcsBucketHandle) error {
 	return bucket.Create(ctx, s.config.ProjectID, &storage.BucketAttrs{
 		Lifecycle: s.lifecycle(),
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"cloud.google.com/go/storage"
+	gcsiterator "google.golang.org/api/iterator"
 
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 )
@@ -249,6 +250,42 @@ func TestGCSDelete(t *testing.T) {
 	}
 }
 
+func TestGCSExpireObjects(t *testing.T) {
+	gcsClient := NewMockGcsAPI()
+	bucketHandle := NewMockGcsBucketHandle()
+	iterator := NewMockGcsObjectIterator()
+	gcsClient.BucketFunc.SetDefaultReturn(bucketHandle)
+	bucketHandle.ObjectsFunc.SetDefaultReturn(iterator)
+	iterator.NextFunc.PushReturn(&storage.ObjectAttrs{Name: "a/1", Updated: time.Now().Add(-2 * time.Hour)}, nil)
+	iterator.NextFunc.PushReturn(&storage.ObjectAttrs{Name: "a/2", Updated: time.Now()}, nil)
+	iterator.NextFunc.SetDefaultReturn(nil, gcsiterator.Done)
+
+	objectHandles := map[string]*MockGcsObjectHandle{}
+	bucketHandle.ObjectFunc.SetDefaultHook(func(name string) gcsObjectHandle {
+		objectHandles[name] = NewMockGcsObjectHandle()
+		return objectHandles[name]
+	})
+
+	client := testGCSClient(gcsClient, false)
+	if err := client.ExpireObjects(context.Background(), "a/", time.Hour); err != nil {
+		t.Fatalf("unexpected error expiring objects: %s", err)
+	}
+
+	if calls := bucketHandle.ObjectsFunc.History(); len(calls) != 1 {
+		t.Fatalf("unexpected number of Objects calls. want=%d have=%d", 1, len(calls))
+	} else if value := calls[0].Arg1.Prefix; value != "a/" {
+		t.Errorf("unexpected prefix argument. want=%s have=%s", "a/", value)
+	}
+
+	if len(objectHandles) != 1 {
+		t.Fatalf("unexpected number of deleted objects. want=%d have=%d", 1, len(objectHandles))
+	} else if handle, ok := objectHandles["a/1"]; !ok {
+		t.Fatalf("expected a/1 to be deleted")
+	} else if calls := handle.DeleteFunc.History(); len(calls) != 1 {
+		t.Fatalf("unexpected number of Delete calls. want=%d have=%d", 1, len(calls))
+	}
+}
+
 func TestGCSLifecycle(t *testing.T) {
 	client := rawGCSClient(nil, true)
 
@@ -0,0 +1,296 @@
+package uploadstore
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/inconshreveable/log15"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type localStore struct {
+	dir        string
+	operations *Operations
+}
+
+var _ Store = &localStore{}
+
+type LocalConfig struct {
+	// Root is the directory on local disk under which a directory is created for
+	// the bucket. It must be shared by all services that access the store.
+	Root string
+}
+
+// newLocalFromConfig creates a new store backed by a directory on local disk.
+func newLocalFromConfig(ctx context.Context, config Config, operations *Operations) (Store, error) {
+	if config.Local.Root == "" {
+		return nil, errors.New("no root directory configured for local upload store")
+	}
+
+	return newLocalWithDir(filepath.Join(config.Local.Root, config.Bucket), operations), nil
+}
+
+func newLocalWithDir(dir string, operations *Operations) *localStore {
+	return &localStore{
+		dir:        dir,
+		operations: operations,
+	}
+}
+
+func (s *localStore) Init(ctx context.Context) error {
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return errors.Wrap(err, "failed to create bucket directory")
+	}
+
+	return nil
+}
+
+func (s *localStore) Get(ctx context.Context, key string) (_ io.ReadCloser, err error) {
+	ctx, _, endObservation := s.operations.Get.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	name, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get object")
+	}
+
+	return f, nil
+}
+
+func (s *localStore) Upload(ctx context.Context, key string, r io.Reader) (_ int64, err error) {
+	ctx, _, endObservation := s.operations.Upload.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	name, err := s.path(key)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := writeFileAtomically(name, func(w io.Writer) (int64, error) {
+		return io.Copy(w, &contextReader{ctx: ctx, r: r})
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to upload object")
+	}
+
+	return n, nil
+}
+
+func (s *localStore) Compose(ctx context.Context, destination string, sources ...string) (_ int64, err error) {
+	ctx, _, endObservation := s.operations.Compose.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("destination", destination),
+		log.String("sources", strings.Join(sources, ", ")),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	name, err := s.path(destination)
+	if err != nil {
+		return 0, err
+	}
+
+	sourceNames := make([]string, 0, len(sources))
+	for _, source := range sources {
+		sourceName, err := s.path(source)
+		if err != nil {
+			return 0, err
+		}
+		sourceNames = append(sourceNames, sourceName)
+	}
+
+	defer func() {
+		if err == nil {
+			// Delete sources on success
+			if err := s.deleteSources(sourceNames); err != nil {
+				log15.Error("Failed to delete source objects", "error", err)
+			}
+		}
+	}()
+
+	n, err := writeFileAtomically(name, func(w io.Writer) (int64, error) {
+		var total int64
+		for _, sourceName := range sourceNames {
+			n, err := copyFile(ctx, w, sourceName)
+			total += n
+			if err != nil {
+				return total, err
+			}
+		}
+
+		return total, nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to compose objects")
+	}
+
+	return n, nil
+}
+
+func (s *localStore) Delete(ctx context.Context, key string) (err error) {
+	ctx, _, endObservation := s.operations.Delete.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	name, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(name); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "failed to delete object")
+	}
+
+	return nil
+}
+
+func (s *localStore) ExpireObjects(ctx context.Context, prefix string, maxAge time.Duration) (err error) {
+	ctx, _, endObservation := s.operations.Expire.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("prefix", prefix),
+		log.String("maxAge", maxAge.String()),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	cutoff := time.Now().Add(-maxAge)
+
+	err = filepath.WalkDir(s.dir, func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(s.dir, name)
+		if err != nil {
+			return err
+		}
+		if !strings.HasPrefix(filepath.ToSlash(rel), prefix) {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.ModTime().After(cutoff) {
+			return nil
+		}
+
+		if err := os.Remove(name); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	})
+
+	return errors.Wrap(err, "failed to expire objects")
+}
+
+// path returns the location of the object with the given key on disk. Keys
+// that would resolve to a location outside of the bucket directory are rejected.
+func (s *localStore) path(key string) (string, error) {
+	if key == "" || path.Clean("/" + key)[1:] != key {
+		return "", errors.Errorf("invalid object key %q", key)
+	}
+
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
+
+func (s *localStore) deleteSources(sourceNames []string) error {
+	var errs error
+	for _, sourceName := range sourceNames {
+		if err := os.Remove(sourceName); err != nil && !os.IsNotExist(err) {
+			errs = errors.Append(errs, errors.Wrap(err, "failed to delete source object"))
+		}
+	}
+
+	return errs
+}
+
+// writeFileAtomically writes the content produced by fn to a temporary file next to
+// the target file and renames it into place once it is completely written. Readers
+// therefore never observe a partially written object.
+func writeFileAtomically(name string, fn func(w io.Writer) (int64, error)) (_ int64, err error) {
+	dir := filepath.Dir(name)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return 0, err
+	}
+
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(name)+".tmp-*")
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	n, err := fn(tmp)
+	if err != nil {
+		return 0, err
+	}
+	if err := tmp.Sync(); err != nil {
+		return 0, err
+	}
+	if err := tmp.Close(); err != nil {
+		return 0, err
+	}
+	if err := os.Rename(tmp.Name(), name); err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+func copyFile(ctx context.Context, w io.Writer, name string) (int64, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	return io.Copy(w, &contextReader{ctx: ctx, r: f})
+}
+
+// contextReader is an io.Reader that stops reading once the given context is
+// canceled.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (r *contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	return r.r.Read(p)
+}
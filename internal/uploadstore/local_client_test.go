@@ -0,0 +1,65 @@
+package uploadstore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestLocalStore(t *testing.T) {
+	testStoreBehavior(t, testLocalClient(t.TempDir()))
+}
+
+func TestLocalUploadIsAtomic(t *testing.T) {
+	dir := t.TempDir()
+	client := testLocalClient(dir)
+
+	if _, err := client.Upload(context.Background(), "test-key", strings.NewReader("TEST PAYLOAD")); err != nil {
+		t.Fatalf("unexpected error uploading key: %s", err)
+	}
+
+	// A failed upload must neither replace the existing object nor leave a
+	// temporary file behind.
+	if _, err := client.Upload(context.Background(), "test-key", &erroringReader{}); err == nil {
+		t.Fatalf("expected error uploading from failing reader")
+	}
+
+	contents, err := os.ReadFile(filepath.Join(dir, "test-bucket", "test-key"))
+	if err != nil {
+		t.Fatalf("unexpected error reading object: %s", err)
+	} else if string(contents) != "TEST PAYLOAD" {
+		t.Errorf("unexpected contents. want=%q have=%q", "TEST PAYLOAD", contents)
+	}
+
+	entries, err := os.ReadDir(filepath.Join(dir, "test-bucket"))
+	if err != nil {
+		t.Fatalf("unexpected error reading directory: %s", err)
+	} else if len(entries) != 1 {
+		t.Errorf("unexpected number of files. want=%d have=%d", 1, len(entries))
+	}
+}
+
+func TestLocalInvalidKeys(t *testing.T) {
+	client := testLocalClient(t.TempDir())
+
+	for _, key := range []string{"", "../escape", "a/../../escape", "/absolute", "a//b"} {
+		if _, err := client.Upload(context.Background(), key, strings.NewReader("TEST PAYLOAD")); err == nil {
+			t.Errorf("expected error uploading invalid key %q", key)
+		}
+	}
+}
+
+func testLocalClient(root string) Store {
+	return newLazyStore(newLocalWithDir(filepath.Join(root, "test-bucket"), NewOperations(&observation.TestContext, "test", "brittlestore")))
+}
+
+type erroringReader struct{}
+
+func (erroringReader) Read(p []byte) (int, error) {
+	return 0, errors.New("read failed")
+}
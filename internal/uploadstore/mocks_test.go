@@ -180,6 +180,9 @@ type MockGcsBucketHandle struct {
 	// ObjectFunc is an instance of a mock function object controlling the
 	// behavior of the method Object.
 	ObjectFunc *GcsBucketHandleObjectFunc
+	// ObjectsFunc is an instance of a mock function object controlling the
+	// behavior of the method Objects.
+	ObjectsFunc *GcsBucketHandleObjectsFunc
 	// UpdateFunc is an instance of a mock function object controlling the
 	// behavior of the method Update.
 	UpdateFunc *GcsBucketHandleUpdateFunc
@@ -205,6 +208,11 @@ func NewMockGcsBucketHandle() *MockGcsBucketHandle {
 				return
 			},
 		},
+		ObjectsFunc: &GcsBucketHandleObjectsFunc{
+			defaultHook: func(context.Context, *storage.Query) (r0 gcsObjectIterator) {
+				return
+			},
+		},
 		UpdateFunc: &GcsBucketHandleUpdateFunc{
 			defaultHook: func(context.Context, storage.BucketAttrsToUpdate) (r0 error) {
 				return
@@ -232,6 +240,11 @@ func NewStrictMockGcsBucketHandle() *MockGcsBucketHandle {
 				panic("unexpected invocation of MockGcsBucketHandle.Object")
 			},
 		},
+		ObjectsFunc: &GcsBucketHandleObjectsFunc{
+			defaultHook: func(context.Context, *storage.Query) gcsObjectIterator {
+				panic("unexpected invocation of MockGcsBucketHandle.Objects")
+			},
+		},
 		UpdateFunc: &GcsBucketHandleUpdateFunc{
 			defaultHook: func(context.Context, storage.BucketAttrsToUpdate) error {
 				panic("unexpected invocation of MockGcsBucketHandle.Update")
@@ -248,6 +261,7 @@ type surrogateMockGcsBucketHandle interface {
 	Attrs(context.Context) (*storage.BucketAttrs, error)
 	Create(context.Context, string, *storage.BucketAttrs) error
 	Object(string) gcsObjectHandle
+	Objects(context.Context, *storage.Query) gcsObjectIterator
 	Update(context.Context, storage.BucketAttrsToUpdate) error
 }
 
@@ -265,6 +279,9 @@ func NewMockGcsBucketHandleFrom(i surrogateMockGcsBucketHandle) *MockGcsBucketHa
 		ObjectFunc: &GcsBucketHandleObjectFunc{
 			defaultHook: i.Object,
 		},
+		ObjectsFunc: &GcsBucketHandleObjectsFunc{
+			defaultHook: i.Objects,
+		},
 		UpdateFunc: &GcsBucketHandleUpdateFunc{
 			defaultHook: i.Update,
 		},
@@ -586,6 +603,111 @@ func (c GcsBucketHandleObjectFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// GcsBucketHandleObjectsFunc describes the behavior when the Objects method
+// of the parent MockGcsBucketHandle instance is invoked.
+type GcsBucketHandleObjectsFunc struct {
+	defaultHook func(context.Context, *storage.Query) gcsObjectIterator
+	hooks       []func(context.Context, *storage.Query) gcsObjectIterator
+	history     []GcsBucketHandleObjectsFuncCall
+	mutex       sync.Mutex
+}
+
+// Objects delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGcsBucketHandle) Objects(v0 context.Context, v1 *storage.Query) gcsObjectIterator {
+	r0 := m.ObjectsFunc.nextHook()(v0, v1)
+	m.ObjectsFunc.appendCall(GcsBucketHandleObjectsFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Objects method of
+// the parent MockGcsBucketHandle instance is invoked and the hook queue is
+// empty.
+func (f *GcsBucketHandleObjectsFunc) SetDefaultHook(hook func(context.Context, *storage.Query) gcsObjectIterator) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Objects method of the parent MockGcsBucketHandle instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GcsBucketHandleObjectsFunc) PushHook(hook func(context.Context, *storage.Query) gcsObjectIterator) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GcsBucketHandleObjectsFunc) SetDefaultReturn(r0 gcsObjectIterator) {
+	f.SetDefaultHook(func(context.Context, *storage.Query) gcsObjectIterator {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GcsBucketHandleObjectsFunc) PushReturn(r0 gcsObjectIterator) {
+	f.PushHook(func(context.Context, *storage.Query) gcsObjectIterator {
+		return r0
+	})
+}
+
+func (f *GcsBucketHandleObjectsFunc) nextHook() func(context.Context, *storage.Query) gcsObjectIterator {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GcsBucketHandleObjectsFunc) appendCall(r0 GcsBucketHandleObjectsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GcsBucketHandleObjectsFuncCall objects
+// describing the invocations of this function.
+func (f *GcsBucketHandleObjectsFunc) History() []GcsBucketHandleObjectsFuncCall {
+	f.mutex.Lock()
+	history := make([]GcsBucketHandleObjectsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GcsBucketHandleObjectsFuncCall is an object that describes an invocation
+// of method Objects on an instance of MockGcsBucketHandle.
+type GcsBucketHandleObjectsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *storage.Query
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 gcsObjectIterator
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GcsBucketHandleObjectsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GcsBucketHandleObjectsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // GcsBucketHandleUpdateFunc describes the behavior when the Update method
 // of the parent MockGcsBucketHandle instance is invoked.
 type GcsBucketHandleUpdateFunc struct {
@@ -1379,6 +1501,163 @@ func (c GcsObjectHandleNewWriterFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// MockGcsObjectIterator is a mock implementation of the gcsObjectIterator
+// interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/uploadstore) used for unit
+// testing.
+type MockGcsObjectIterator struct {
+	// NextFunc is an instance of a mock function object controlling the
+	// behavior of the method Next.
+	NextFunc *GcsObjectIteratorNextFunc
+}
+
+// NewMockGcsObjectIterator creates a new mock of the gcsObjectIterator
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockGcsObjectIterator() *MockGcsObjectIterator {
+	return &MockGcsObjectIterator{
+		NextFunc: &GcsObjectIteratorNextFunc{
+			defaultHook: func() (r0 *storage.ObjectAttrs, r1 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockGcsObjectIterator creates a new mock of the
+// gcsObjectIterator interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockGcsObjectIterator() *MockGcsObjectIterator {
+	return &MockGcsObjectIterator{
+		NextFunc: &GcsObjectIteratorNextFunc{
+			defaultHook: func() (*storage.ObjectAttrs, error) {
+				panic("unexpected invocation of MockGcsObjectIterator.Next")
+			},
+		},
+	}
+}
+
+// surrogateMockGcsObjectIterator is a copy of the gcsObjectIterator
+// interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/uploadstore). It is redefined
+// here as it is unexported in the source package.
+type surrogateMockGcsObjectIterator interface {
+	Next() (*storage.ObjectAttrs, error)
+}
+
+// NewMockGcsObjectIteratorFrom creates a new mock of the
+// MockGcsObjectIterator interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockGcsObjectIteratorFrom(i surrogateMockGcsObjectIterator) *MockGcsObjectIterator {
+	return &MockGcsObjectIterator{
+		NextFunc: &GcsObjectIteratorNextFunc{
+			defaultHook: i.Next,
+		},
+	}
+}
+
+// GcsObjectIteratorNextFunc describes the behavior when the Next method of
+// the parent MockGcsObjectIterator instance is invoked.
+type GcsObjectIteratorNextFunc struct {
+	defaultHook func() (*storage.ObjectAttrs, error)
+	hooks       []func() (*storage.ObjectAttrs, error)
+	history     []GcsObjectIteratorNextFuncCall
+	mutex       sync.Mutex
+}
+
+// Next delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGcsObjectIterator) Next() (*storage.ObjectAttrs, error) {
+	r0, r1 := m.NextFunc.nextHook()()
+	m.NextFunc.appendCall(GcsObjectIteratorNextFuncCall{r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Next method of the
+// parent MockGcsObjectIterator instance is invoked and the hook queue is
+// empty.
+func (f *GcsObjectIteratorNextFunc) SetDefaultHook(hook func() (*storage.ObjectAttrs, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Next method of the parent MockGcsObjectIterator instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *GcsObjectIteratorNextFunc) PushHook(hook func() (*storage.ObjectAttrs, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GcsObjectIteratorNextFunc) SetDefaultReturn(r0 *storage.ObjectAttrs, r1 error) {
+	f.SetDefaultHook(func() (*storage.ObjectAttrs, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GcsObjectIteratorNextFunc) PushReturn(r0 *storage.ObjectAttrs, r1 error) {
+	f.PushHook(func() (*storage.ObjectAttrs, error) {
+		return r0, r1
+	})
+}
+
+func (f *GcsObjectIteratorNextFunc) nextHook() func() (*storage.ObjectAttrs, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GcsObjectIteratorNextFunc) appendCall(r0 GcsObjectIteratorNextFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GcsObjectIteratorNextFuncCall objects
+// describing the invocations of this function.
+func (f *GcsObjectIteratorNextFunc) History() []GcsObjectIteratorNextFuncCall {
+	f.mutex.Lock()
+	history := make([]GcsObjectIteratorNextFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GcsObjectIteratorNextFuncCall is an object that describes an invocation
+// of method Next on an instance of MockGcsObjectIterator.
+type GcsObjectIteratorNextFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *storage.ObjectAttrs
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GcsObjectIteratorNextFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GcsObjectIteratorNextFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // MockS3API is a mock implementation of the s3API interface (from the
 // package github.com/sourcegraph/sourcegraph/internal/uploadstore) used for
 // unit testing.
@@ -1404,6 +1683,9 @@ type MockS3API struct {
 	// HeadObjectFunc is an instance of a mock function object controlling
 	// the behavior of the method HeadObject.
 	HeadObjectFunc *S3APIHeadObjectFunc
+	// ListObjectsV2Func is an instance of a mock function object
+	// controlling the behavior of the method ListObjectsV2.
+	ListObjectsV2Func *S3APIListObjectsV2Func
 	// PutBucketLifecycleConfigurationFunc is an instance of a mock function
 	// object controlling the behavior of the method
 	// PutBucketLifecycleConfiguration.
@@ -1452,6 +1734,11 @@ func NewMockS3API() *MockS3API {
 				return
 			},
 		},
+		ListObjectsV2Func: &S3APIListObjectsV2Func{
+			defaultHook: func(context.Context, *s3.ListObjectsV2Input) (r0 *s3.ListObjectsV2Output, r1 error) {
+				return
+			},
+		},
 		PutBucketLifecycleConfigurationFunc: &S3APIPutBucketLifecycleConfigurationFunc{
 			defaultHook: func(context.Context, *s3.PutBucketLifecycleConfigurationInput) (r0 *s3.PutBucketLifecycleConfigurationOutput, r1 error) {
 				return
@@ -1504,6 +1791,11 @@ func NewStrictMockS3API() *MockS3API {
 				panic("unexpected invocation of MockS3API.HeadObject")
 			},
 		},
+		ListObjectsV2Func: &S3APIListObjectsV2Func{
+			defaultHook: func(context.Context, *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error) {
+				panic("unexpected invocation of MockS3API.ListObjectsV2")
+			},
+		},
 		PutBucketLifecycleConfigurationFunc: &S3APIPutBucketLifecycleConfigurationFunc{
 			defaultHook: func(context.Context, *s3.PutBucketLifecycleConfigurationInput) (*s3.PutBucketLifecycleConfigurationOutput, error) {
 				panic("unexpected invocation of MockS3API.PutBucketLifecycleConfiguration")
@@ -1528,6 +1820,7 @@ type surrogateMockS3API interface {
 	DeleteObject(context.Context, *s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error)
 	GetObject(context.Context, *s3.GetObjectInput) (*s3.GetObjectOutput, error)
 	HeadObject(context.Context, *s3.HeadObjectInput) (*s3.HeadObjectOutput, error)
+	ListObjectsV2(context.Context, *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error)
 	PutBucketLifecycleConfiguration(context.Context, *s3.PutBucketLifecycleConfigurationInput) (*s3.PutBucketLifecycleConfigurationOutput, error)
 	UploadPartCopy(context.Context, *s3.UploadPartCopyInput) (*s3.UploadPartCopyOutput, error)
 }
@@ -1557,6 +1850,9 @@ func NewMockS3APIFrom(i surrogateMockS3API) *MockS3API {
 		HeadObjectFunc: &S3APIHeadObjectFunc{
 			defaultHook: i.HeadObject,
 		},
+		ListObjectsV2Func: &S3APIListObjectsV2Func{
+			defaultHook: i.ListObjectsV2,
+		},
 		PutBucketLifecycleConfigurationFunc: &S3APIPutBucketLifecycleConfigurationFunc{
 			defaultHook: i.PutBucketLifecycleConfiguration,
 		},
@@ -2319,6 +2615,113 @@ func (c S3APIHeadObjectFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// S3APIListObjectsV2Func describes the behavior when the ListObjectsV2
+// method of the parent MockS3API instance is invoked.
+type S3APIListObjectsV2Func struct {
+	defaultHook func(context.Context, *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error)
+	hooks       []func(context.Context, *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error)
+	history     []S3APIListObjectsV2FuncCall
+	mutex       sync.Mutex
+}
+
+// ListObjectsV2 delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockS3API) ListObjectsV2(v0 context.Context, v1 *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error) {
+	r0, r1 := m.ListObjectsV2Func.nextHook()(v0, v1)
+	m.ListObjectsV2Func.appendCall(S3APIListObjectsV2FuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListObjectsV2 method
+// of the parent MockS3API instance is invoked and the hook queue is empty.
+func (f *S3APIListObjectsV2Func) SetDefaultHook(hook func(context.Context, *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListObjectsV2 method of the parent MockS3API instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *S3APIListObjectsV2Func) PushHook(hook func(context.Context, *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *S3APIListObjectsV2Func) SetDefaultReturn(r0 *s3.ListObjectsV2Output, r1 error) {
+	f.SetDefaultHook(func(context.Context, *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *S3APIListObjectsV2Func) PushReturn(r0 *s3.ListObjectsV2Output, r1 error) {
+	f.PushHook(func(context.Context, *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error) {
+		return r0, r1
+	})
+}
+
+func (f *S3APIListObjectsV2Func) nextHook() func(context.Context, *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *S3APIListObjectsV2Func) appendCall(r0 S3APIListObjectsV2FuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of S3APIListObjectsV2FuncCall objects
+// describing the invocations of this function.
+func (f *S3APIListObjectsV2Func) History() []S3APIListObjectsV2FuncCall {
+	f.mutex.Lock()
+	history := make([]S3APIListObjectsV2FuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// S3APIListObjectsV2FuncCall is an object that describes an invocation of
+// method ListObjectsV2 on an instance of MockS3API.
+type S3APIListObjectsV2FuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *s3.ListObjectsV2Input
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *s3.ListObjectsV2Output
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c S3APIListObjectsV2FuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c S3APIListObjectsV2FuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // S3APIPutBucketLifecycleConfigurationFunc describes the behavior when the
 // PutBucketLifecycleConfiguration method of the parent MockS3API instance
 // is invoked.
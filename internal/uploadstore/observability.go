@@ -12,6 +12,7 @@ type Operations struct {
 	Upload  *observation.Operation
 	Compose *observation.Operation
 	Delete  *observation.Operation
+	Expire  *observation.Operation
 }
 
 func NewOperations(observationContext *observation.Context, domain, storeName string) *Operations {
@@ -35,5 +36,6 @@ func NewOperations(observationContext *observation.Context, domain, storeName st
 		Upload:  op("Upload"),
 		Compose: op("Compose"),
 		Delete:  op("Delete"),
+		Expire:  op("Expire"),
 	}
 }
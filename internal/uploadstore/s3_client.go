@@ -273,6 +273,43 @@ func (s *s3Store) Delete(ctx context.Context, key string) (err error) {
 	return errors.Wrap(err, "failed to delete object")
 }
 
+func (s *s3Store) ExpireObjects(ctx context.Context, prefix string, maxAge time.Duration) (err error) {
+	ctx, _, endObservation := s.operations.Expire.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("prefix", prefix),
+		log.String("maxAge", maxAge.String()),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	cutoff := time.Now().Add(-maxAge)
+
+	var continuationToken *string
+	for {
+		page, err := s.client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+			Bucket:            aws.String(s.bucket),
+			Prefix:            aws.String(prefix),
+			ContinuationToken: continuationToken,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to list objects")
+		}
+
+		var expired []string
+		for _, object := range page.Contents {
+			if object.Key != nil && object.LastModified != nil && !object.LastModified.After(cutoff) {
+				expired = append(expired, *object.Key)
+			}
+		}
+		if err := s.deleteSources(ctx, s.bucket, expired); err != nil {
+			return err
+		}
+
+		if !page.IsTruncated || page.NextContinuationToken == nil {
+			return nil
+		}
+		continuationToken = page.NextContinuationToken
+	}
+}
+
 func (s *s3Store) create(ctx context.Context) error {
 	_, err := s.client.CreateBucket(ctx, &s3.CreateBucketInput{
 		Bucket: aws.String(s.bucket),
@@ -379,6 +379,48 @@ func TestS3Delete(t *testing.T) {
 	}
 }
 
+func TestS3ExpireObjects(t *testing.T) {
+	old := time.Now().Add(-2 * time.Hour)
+	recent := time.Now()
+
+	s3Client := NewMockS3API()
+	s3Client.ListObjectsV2Func.PushReturn(&s3.ListObjectsV2Output{
+		Contents: []s3types.Object{
+			{Key: aws.String("a/1"), LastModified: &old},
+			{Key: aws.String("a/2"), LastModified: &recent},
+		},
+		IsTruncated:           true,
+		NextContinuationToken: aws.String("next"),
+	}, nil)
+	s3Client.ListObjectsV2Func.PushReturn(&s3.ListObjectsV2Output{
+		Contents: []s3types.Object{
+			{Key: aws.String("a/3"), LastModified: &old},
+		},
+	}, nil)
+
+	client := testS3Client(s3Client, nil)
+	if err := client.ExpireObjects(context.Background(), "a/", time.Hour); err != nil {
+		t.Fatalf("unexpected error expiring objects: %s", err)
+	}
+
+	if calls := s3Client.ListObjectsV2Func.History(); len(calls) != 2 {
+		t.Fatalf("unexpected number of ListObjectsV2 calls. want=%d have=%d", 2, len(calls))
+	} else if value := *calls[0].Arg1.Prefix; value != "a/" {
+		t.Errorf("unexpected prefix argument. want=%s have=%s", "a/", value)
+	} else if value := calls[1].Arg1.ContinuationToken; value == nil || *value != "next" {
+		t.Errorf("unexpected continuation token argument. want=%s have=%v", "next", value)
+	}
+
+	var keys []string
+	for _, call := range s3Client.DeleteObjectFunc.History() {
+		keys = append(keys, *call.Arg1.Key)
+	}
+	sort.Strings(keys)
+	if diff := cmp.Diff([]string{"a/1", "a/3"}, keys); diff != "" {
+		t.Errorf("unexpected deleted keys (-want +got):\n%s", diff)
+	}
+}
+
 func TestS3BucketLifecycleConfiguration(t *testing.T) {
 	if lifecycle := s3BucketLifecycleConfiguration("s3", time.Hour*24*3); lifecycle == nil || len(lifecycle.Rules) != 2 {
 		t.Fatalf("unexpected lifecycle rules")
@@ -3,6 +3,7 @@ package uploadstore
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -26,12 +27,19 @@ type Store interface {
 
 	// Delete removes the content at the given key.
 	Delete(ctx context.Context, key string) error
+
+	// ExpireObjects removes all objects under the given prefix that were last modified
+	// longer than maxAge ago. This is used by backends that cannot expire objects via
+	// a lifecycle configuration of the bucket.
+	ExpireObjects(ctx context.Context, prefix string, maxAge time.Duration) error
 }
 
 var storeConstructors = map[string]func(ctx context.Context, config Config, operations *Operations) (Store, error){
 	"s3":    newS3FromConfig,
 	"minio": newS3FromConfig,
 	"gcs":   newGCSFromConfig,
+	"local": newLocalFromConfig,
+	"azure": newAzureFromConfig,
 }
 
 // CreateLazy initialize a new store from the given configuration that is initialized
@@ -1,9 +1,13 @@
 package uploadstore
 
 import (
+	"context"
 	"flag"
+	"io"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/inconshreveable/log15"
 )
@@ -15,3 +19,95 @@ func TestMain(m *testing.M) {
 	}
 	os.Exit(m.Run())
 }
+
+// testStoreBehavior runs a store through the methods of the Store interface and
+// checks that they behave consistently across backends.
+func testStoreBehavior(t *testing.T, store Store) {
+	ctx := context.Background()
+
+	if err := store.Init(ctx); err != nil {
+		t.Fatalf("unexpected error initializing store: %s", err)
+	}
+
+	upload := func(key, content string) {
+		t.Helper()
+
+		n, err := store.Upload(ctx, key, strings.NewReader(content))
+		if err != nil {
+			t.Fatalf("unexpected error uploading %q: %s", key, err)
+		} else if n != int64(len(content)) {
+			t.Errorf("unexpected size of %q. want=%d have=%d", key, len(content), n)
+		}
+	}
+
+	get := func(key string) (string, error) {
+		t.Helper()
+
+		rc, err := store.Get(ctx, key)
+		if err != nil {
+			return "", err
+		}
+		defer rc.Close()
+
+		contents, err := io.ReadAll(rc)
+		return string(contents), err
+	}
+
+	upload("empty", "")
+	upload("a/src1", "TEST ")
+	upload("a/src2", "PAYLOAD")
+	upload("b/keep", "KEEP")
+
+	if contents, err := get("empty"); err != nil {
+		t.Fatalf("unexpected error getting key: %s", err)
+	} else if contents != "" {
+		t.Errorf("unexpected contents. want=%q have=%q", "", contents)
+	}
+
+	if n, err := store.Compose(ctx, "a/composed", "a/src1", "a/src2"); err != nil {
+		t.Fatalf("unexpected error composing objects: %s", err)
+	} else if n != int64(len("TEST PAYLOAD")) {
+		t.Errorf("unexpected composed size. want=%d have=%d", len("TEST PAYLOAD"), n)
+	}
+	if contents, err := get("a/composed"); err != nil {
+		t.Fatalf("unexpected error getting key: %s", err)
+	} else if contents != "TEST PAYLOAD" {
+		t.Errorf("unexpected contents. want=%q have=%q", "TEST PAYLOAD", contents)
+	}
+	for _, source := range []string{"a/src1", "a/src2"} {
+		if _, err := get(source); err == nil {
+			t.Errorf("expected source %q to be deleted after compose", source)
+		}
+	}
+
+	// Overwriting an object replaces its content
+	upload("b/keep", "KEPT")
+	if contents, err := get("b/keep"); err != nil {
+		t.Fatalf("unexpected error getting key: %s", err)
+	} else if contents != "KEPT" {
+		t.Errorf("unexpected contents. want=%q have=%q", "KEPT", contents)
+	}
+
+	if err := store.ExpireObjects(ctx, "a/", 0); err != nil {
+		t.Fatalf("unexpected error expiring objects: %s", err)
+	}
+	if err := store.ExpireObjects(ctx, "b/", time.Hour); err != nil {
+		t.Fatalf("unexpected error expiring objects: %s", err)
+	}
+	if _, err := get("a/composed"); err == nil {
+		t.Errorf("expected expired object to be deleted")
+	}
+	if _, err := get("b/keep"); err != nil {
+		t.Errorf("unexpected error getting object that has not expired: %s", err)
+	}
+
+	if err := store.Delete(ctx, "b/keep"); err != nil {
+		t.Fatalf("unexpected error deleting key: %s", err)
+	}
+	if _, err := get("b/keep"); err == nil {
+		t.Errorf("expected deleted object to be missing")
+	}
+	if err := store.Delete(ctx, "b/keep"); err != nil {
+		t.Errorf("unexpected error deleting missing key: %s", err)
+	}
+}
@@ -303,3 +303,42 @@ func TestCorrelateConflictingDocumentProperties(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestCorrelateFromElements(t *testing.T) {
+	input, err := os.ReadFile("../testdata/dump1.lsif")
+	if err != nil {
+		t.Fatalf("unexpected error reading test file: %s", err)
+	}
+
+	var elements []reader.Element
+	for pair := range reader.Read(context.Background(), bytes.NewReader(input)) {
+		if pair.Err != nil {
+			t.Fatalf("unexpected error reading input: %s", pair.Err)
+		}
+		elements = append(elements, pair.Element)
+	}
+
+	expectedState, err := correlateFromReader(context.Background(), bytes.NewReader(input), "root")
+	if err != nil {
+		t.Fatalf("unexpected error correlating input: %s", err)
+	}
+
+	state, err := correlateFromElements(elements, "root")
+	if err != nil {
+		t.Fatalf("unexpected error correlating elements: %s", err)
+	}
+
+	if diff := cmp.Diff(expectedState, state, datastructures.Comparers...); diff != "" {
+		t.Errorf("unexpected state (-want +got):\n%s", diff)
+	}
+}
+
+func TestCorrelateFromElementsMissingMetadata(t *testing.T) {
+	elements := []reader.Element{
+		{ID: 1, Type: "vertex", Label: "resultSet", Payload: reader.ResultSet{}},
+	}
+
+	if _, err := correlateFromElements(elements, ""); err != ErrMissingMetaData {
+		t.Fatalf("unexpected error. want=%q have=%q", ErrMissingMetaData, err)
+	}
+}
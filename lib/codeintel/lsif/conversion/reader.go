@@ -21,20 +21,24 @@ func Read(ctx context.Context, r io.Reader) <-chan Pair {
 		defer close(elements)
 
 		for pair := range reader.Read(ctx, r) {
-			element := Element{
-				ID:      pair.Element.ID,
-				Type:    pair.Element.Type,
-				Label:   pair.Element.Label,
-				Payload: translatePayload(pair.Element.Payload),
-			}
-
-			elements <- Pair{Element: element, Err: pair.Err}
+			elements <- Pair{Element: translateElement(pair.Element), Err: pair.Err}
 		}
 	}()
 
 	return elements
 }
 
+// translateElement converts an element produced by the LSIF protocol reader into an element
+// understood by the correlator.
+func translateElement(element reader.Element) Element {
+	return Element{
+		ID:      element.ID,
+		Type:    element.Type,
+		Label:   element.Label,
+		Payload: translatePayload(element.Payload),
+	}
+}
+
 func translatePayload(payload any) any {
 	switch v := payload.(type) {
 	case reader.Edge:
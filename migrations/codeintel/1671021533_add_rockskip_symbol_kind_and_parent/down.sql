@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS rockskip_symbols_repo_id_without_kind;
+DROP INDEX IF EXISTS rockskip_symbols_kind_parent_gin;
+
+ALTER TABLE rockskip_symbols
+    DROP COLUMN IF EXISTS kind,
+    DROP COLUMN IF EXISTS language,
+    DROP COLUMN IF EXISTS parent;
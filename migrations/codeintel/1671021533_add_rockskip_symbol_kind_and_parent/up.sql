@@ -0,0 +1,15 @@
+-- Symbols indexed before this migration have no kind, language or parent. These
+-- columns are left NULL for them, and Rockskip re-indexes a repository the next
+-- time it is searched if it still has such symbols.
+ALTER TABLE rockskip_symbols
+    ADD COLUMN IF NOT EXISTS kind text,
+    ADD COLUMN IF NOT EXISTS language text,
+    ADD COLUMN IF NOT EXISTS parent text;
+
+COMMENT ON COLUMN rockskip_symbols.kind IS 'The ctags kind of the symbol, e.g. function or class. NULL if the symbol was indexed before kinds were stored.';
+COMMENT ON COLUMN rockskip_symbols.language IS 'The ctags language of the file the symbol is defined in. NULL if the symbol was indexed before languages were stored.';
+COMMENT ON COLUMN rockskip_symbols.parent IS 'The name of the symbol that contains this symbol, or empty if it is not nested. NULL if the symbol was indexed before parents were stored.';
+
+CREATE INDEX IF NOT EXISTS rockskip_symbols_kind_parent_gin ON rockskip_symbols USING gin (singleton(lower(kind)), parent gin_trgm_ops, singleton(parent), singleton(lower(parent)));
+
+CREATE INDEX IF NOT EXISTS rockskip_symbols_repo_id_without_kind ON rockskip_symbols (repo_id) WHERE kind IS NULL;
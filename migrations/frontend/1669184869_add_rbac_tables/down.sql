@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS org_roles;
+DROP TABLE IF EXISTS user_roles;
+DROP TABLE IF EXISTS role_permissions;
+DROP TABLE IF EXISTS permissions;
+DROP TABLE IF EXISTS roles;
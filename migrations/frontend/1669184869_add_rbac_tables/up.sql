@@ -0,0 +1,60 @@
+CREATE TABLE IF NOT EXISTS roles (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    system BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT roles_name_not_blank CHECK (name <> '')
+);
+
+COMMENT ON COLUMN roles.name IS 'The uniquely identifying name of the role.';
+COMMENT ON COLUMN roles.system IS 'Whether the role is built into the instance. System roles are held implicitly and cannot be assigned or deleted.';
+
+CREATE TABLE IF NOT EXISTS permissions (
+    id SERIAL PRIMARY KEY,
+    namespace TEXT NOT NULL,
+    action TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT permissions_namespace_not_blank CHECK (namespace <> ''),
+    CONSTRAINT permissions_action_not_blank CHECK (action <> '')
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS permissions_unique_namespace_action ON permissions (namespace, action);
+
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role_id INTEGER NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+    permission_id INTEGER NOT NULL REFERENCES permissions(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (role_id, permission_id)
+);
+
+CREATE INDEX IF NOT EXISTS role_permissions_permission_id ON role_permissions (permission_id);
+
+CREATE TABLE IF NOT EXISTS user_roles (
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role_id INTEGER NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, role_id)
+);
+
+CREATE INDEX IF NOT EXISTS user_roles_role_id ON user_roles (role_id);
+
+CREATE TABLE IF NOT EXISTS org_roles (
+    org_id INTEGER NOT NULL REFERENCES orgs(id) ON DELETE CASCADE,
+    role_id INTEGER NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (org_id, role_id)
+);
+
+CREATE INDEX IF NOT EXISTS org_roles_role_id ON org_roles (role_id);
+
+INSERT INTO roles (name, system)
+VALUES
+    ('USER', TRUE),
+    ('SITE_ADMINISTRATOR', TRUE)
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO permissions (namespace, action)
+VALUES
+    ('BATCH_CHANGES', 'ADMIN'),
+    ('CODE_INSIGHTS', 'ADMIN')
+ON CONFLICT (namespace, action) DO NOTHING;
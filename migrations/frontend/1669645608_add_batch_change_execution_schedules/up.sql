@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS batch_change_execution_schedules (
+    id BIGSERIAL PRIMARY KEY,
+    batch_change_id BIGINT NOT NULL REFERENCES batch_changes(id) ON DELETE CASCADE DEFERRABLE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE DEFERRABLE,
+    interval_seconds INTEGER NOT NULL,
+    auto_apply BOOLEAN NOT NULL DEFAULT FALSE,
+    batch_spec_id BIGINT REFERENCES batch_specs(id) ON DELETE SET NULL DEFERRABLE,
+    next_run_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_run_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT batch_change_execution_schedules_interval_positive CHECK (interval_seconds > 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS batch_change_execution_schedules_batch_change_id ON batch_change_execution_schedules (batch_change_id);
+CREATE INDEX IF NOT EXISTS batch_change_execution_schedules_next_run_at ON batch_change_execution_schedules (next_run_at);
+
+COMMENT ON TABLE batch_change_execution_schedules IS 'Schedules on which batch changes are periodically re-executed server-side.';
+COMMENT ON COLUMN batch_change_execution_schedules.user_id IS 'The user who set up the schedule. Scheduled executions are performed on their behalf.';
+COMMENT ON COLUMN batch_change_execution_schedules.auto_apply IS 'Whether the batch spec produced by a scheduled execution is applied automatically once the execution completes.';
+COMMENT ON COLUMN batch_change_execution_schedules.batch_spec_id IS 'The batch spec of the scheduled execution currently in progress, if any.';
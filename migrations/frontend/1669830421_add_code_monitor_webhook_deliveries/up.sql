@@ -0,0 +1,59 @@
+ALTER TABLE cm_webhooks
+    ADD COLUMN IF NOT EXISTS payload_template TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS headers TEXT NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS encryption_key_id TEXT NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN cm_webhooks.payload_template IS 'Go template rendering the request body sent to the webhook. When empty, the default JSON payload is sent';
+COMMENT ON COLUMN cm_webhooks.headers IS 'JSON object of custom headers sent with every request to the webhook, encrypted with the code monitor webhook key';
+
+CREATE TABLE IF NOT EXISTS cm_teams_webhooks (
+    id BIGSERIAL PRIMARY KEY,
+    monitor BIGINT NOT NULL REFERENCES cm_monitors(id) ON DELETE CASCADE,
+    url TEXT NOT NULL,
+    enabled BOOLEAN NOT NULL,
+    include_results BOOLEAN NOT NULL DEFAULT FALSE,
+    created_by INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    changed_by INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS cm_teams_webhooks_monitor ON cm_teams_webhooks (monitor);
+
+COMMENT ON TABLE cm_teams_webhooks IS 'Microsoft Teams webhook actions configured on code monitors';
+COMMENT ON COLUMN cm_teams_webhooks.monitor IS 'The code monitor that the action is defined on';
+COMMENT ON COLUMN cm_teams_webhooks.url IS 'The Microsoft Teams webhook URL we send the code monitor event to';
+COMMENT ON COLUMN cm_teams_webhooks.enabled IS 'Whether this Microsoft Teams webhook action is enabled. When not enabled, the action will not be run when its code monitor generates events';
+COMMENT ON COLUMN cm_teams_webhooks.include_results IS 'Whether to include results in the message sent to Microsoft Teams';
+
+ALTER TABLE cm_action_jobs
+    ADD COLUMN IF NOT EXISTS teams_webhook BIGINT REFERENCES cm_teams_webhooks(id) ON DELETE CASCADE;
+
+COMMENT ON COLUMN cm_action_jobs.teams_webhook IS 'The ID of the cm_teams_webhooks action to execute if this is a Microsoft Teams webhook job. Mutually exclusive with email, webhook and slack_webhook';
+
+ALTER TABLE cm_action_jobs DROP CONSTRAINT IF EXISTS cm_action_jobs_only_one_action_type;
+ALTER TABLE cm_action_jobs ADD CONSTRAINT cm_action_jobs_only_one_action_type CHECK ((
+    CASE WHEN email IS NULL THEN 0 ELSE 1 END +
+    CASE WHEN webhook IS NULL THEN 0 ELSE 1 END +
+    CASE WHEN slack_webhook IS NULL THEN 0 ELSE 1 END +
+    CASE WHEN teams_webhook IS NULL THEN 0 ELSE 1 END
+) = 1);
+
+COMMENT ON CONSTRAINT cm_action_jobs_only_one_action_type ON cm_action_jobs IS 'Constrains that each queued code monitor action has exactly one action type';
+
+CREATE TABLE IF NOT EXISTS cm_action_delivery_attempts (
+    id BIGSERIAL PRIMARY KEY,
+    action_job INTEGER NOT NULL REFERENCES cm_action_jobs(id) ON DELETE CASCADE,
+    attempt INTEGER NOT NULL,
+    status_code INTEGER,
+    error TEXT,
+    duration_ms INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS cm_action_delivery_attempts_action_job ON cm_action_delivery_attempts (action_job);
+
+COMMENT ON TABLE cm_action_delivery_attempts IS 'Log of the attempts to deliver the notification of a code monitor webhook action job';
+COMMENT ON COLUMN cm_action_delivery_attempts.attempt IS 'The 1-based number of the attempt within the action job';
+COMMENT ON COLUMN cm_action_delivery_attempts.status_code IS 'The HTTP status code returned by the receiver, if a response was received';
+COMMENT ON COLUMN cm_action_delivery_attempts.error IS 'The error of a failed attempt, if any';
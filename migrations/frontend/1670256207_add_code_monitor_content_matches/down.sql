@@ -0,0 +1 @@
+ALTER TABLE cm_last_searched DROP COLUMN IF EXISTS content_match_hashes;
@@ -0,0 +1,4 @@
+ALTER TABLE cm_last_searched
+    ADD COLUMN IF NOT EXISTS content_match_hashes TEXT[] NOT NULL DEFAULT '{}';
+
+COMMENT ON COLUMN cm_last_searched.content_match_hashes IS 'For content monitors, the hashes of the file content matches found at the last searched indexed commits. Matches not in this set on the next run are reported as new';
@@ -0,0 +1,4 @@
+ALTER TABLE feature_flags
+    ADD COLUMN IF NOT EXISTS rules JSONB NOT NULL DEFAULT '[]';
+
+COMMENT ON COLUMN feature_flags.rules IS 'Ordered rules targeting users by their attributes and request client. The value of the first matching rule takes precedence over bool_value and rollout';
@@ -0,0 +1,11 @@
+ALTER TABLE saved_searches
+    DROP COLUMN IF EXISTS last_run_at,
+    DROP COLUMN IF EXISTS results_fingerprint,
+    DROP COLUMN IF EXISTS result_hashes;
+
+ALTER TABLE saved_searches DROP CONSTRAINT IF EXISTS saved_searches_notifications_user_owned;
+
+UPDATE saved_searches SET notify_owner = false, notify_slack = false WHERE notify_owner OR notify_slack;
+
+ALTER TABLE saved_searches DROP CONSTRAINT IF EXISTS saved_searches_notifications_disabled;
+ALTER TABLE saved_searches ADD CONSTRAINT saved_searches_notifications_disabled CHECK (notify_owner = false AND notify_slack = false);
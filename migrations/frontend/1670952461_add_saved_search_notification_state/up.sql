@@ -0,0 +1,12 @@
+ALTER TABLE saved_searches DROP CONSTRAINT IF EXISTS saved_searches_notifications_disabled;
+ALTER TABLE saved_searches DROP CONSTRAINT IF EXISTS saved_searches_notifications_user_owned;
+ALTER TABLE saved_searches ADD CONSTRAINT saved_searches_notifications_user_owned CHECK ((notify_owner = false AND notify_slack = false) OR user_id IS NOT NULL);
+
+ALTER TABLE saved_searches
+    ADD COLUMN IF NOT EXISTS last_run_at timestamp with time zone,
+    ADD COLUMN IF NOT EXISTS results_fingerprint text,
+    ADD COLUMN IF NOT EXISTS result_hashes text[] NOT NULL DEFAULT '{}'::text[];
+
+COMMENT ON COLUMN saved_searches.last_run_at IS 'The last time the saved search was run to check for result changes.';
+COMMENT ON COLUMN saved_searches.results_fingerprint IS 'A hash of the result set of the last run, or NULL if the saved search has not been run since its query last changed.';
+COMMENT ON COLUMN saved_searches.result_hashes IS 'The sorted hashes of the individual results of the last run, used to detect results which appeared or disappeared.';
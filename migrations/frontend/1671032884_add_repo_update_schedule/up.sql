@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS repo_update_schedule (
+    repo_id INTEGER PRIMARY KEY REFERENCES repo(id) ON DELETE CASCADE,
+    interval_seconds INTEGER NOT NULL,
+    due_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_error TEXT,
+    last_updated_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS repo_update_schedule_due_at ON repo_update_schedule (due_at);
+
+COMMENT ON TABLE repo_update_schedule IS 'The schedule on which repo-updater periodically asks gitserver to fetch repositories.';
+COMMENT ON COLUMN repo_update_schedule.interval_seconds IS 'The current interval between scheduled updates of the repository, including any backoff after failed updates.';
+COMMENT ON COLUMN repo_update_schedule.due_at IS 'The next time the repository is due to be enqueued for an update.';
+COMMENT ON COLUMN repo_update_schedule.last_error IS 'The error of the last scheduled update, or NULL if it succeeded.';
+COMMENT ON COLUMN repo_update_schedule.last_updated_at IS 'The time the last scheduled update finished.';
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS gitserver_repos_rebalance_state_idx;
+
+ALTER TABLE gitserver_repos
+    DROP COLUMN IF EXISTS rebalance_state,
+    DROP COLUMN IF EXISTS rebalance_from,
+    DROP COLUMN IF EXISTS rebalance_to,
+    DROP COLUMN IF EXISTS rebalance_error,
+    DROP COLUMN IF EXISTS rebalance_updated_at;
@@ -0,0 +1,14 @@
+ALTER TABLE gitserver_repos
+    ADD COLUMN IF NOT EXISTS rebalance_state TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS rebalance_from TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS rebalance_to TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS rebalance_error TEXT,
+    ADD COLUMN IF NOT EXISTS rebalance_updated_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS gitserver_repos_rebalance_state_idx ON gitserver_repos (rebalance_state, repo_id) WHERE rebalance_state <> '';
+
+COMMENT ON COLUMN gitserver_repos.rebalance_state IS 'The state of the move of the repository to another gitserver shard: queued, migrating, completed or errored. Empty if no move is planned.';
+COMMENT ON COLUMN gitserver_repos.rebalance_from IS 'The address of the gitserver shard the repository is moved from.';
+COMMENT ON COLUMN gitserver_repos.rebalance_to IS 'The address of the gitserver shard the repository is moved to.';
+COMMENT ON COLUMN gitserver_repos.rebalance_error IS 'The error of the last attempt to move the repository, if it failed.';
+COMMENT ON COLUMN gitserver_repos.rebalance_updated_at IS 'The time the rebalance state of the repository last changed.';
@@ -165,6 +165,51 @@ func (v *AuthProviders) UnmarshalJSON(data []byte) error {
 	return fmt.Errorf("tagged union type must have a %q property whose value is one of %s", "type", []string{"builtin", "saml", "openidconnect", "http-header", "github", "gitlab"})
 }
 
+// AzureDevOpsConnection description: Configuration for a connection to Azure DevOps.
+type AzureDevOpsConnection struct {
+	// EnforcePermissions description: Enforce Azure DevOps repository permissions. Users are matched to Azure DevOps identities through their verified email addresses and can access the repositories of all projects they are a member of.
+	EnforcePermissions bool `json:"enforcePermissions,omitempty"`
+	// Exclude description: A list of repositories to never mirror from Azure DevOps. Takes precedence over "orgs" and "projects" configuration.
+	//
+	// Supports excluding by name ({"name": "org/project/repo"}) or by ID ({"id": "5febef5a-833d-4e14-b9c0-14cb638f91e6"}).
+	Exclude []*ExcludedAzureDevOpsRepo `json:"exclude,omitempty"`
+	// GitURLType description: The type of Git URLs to use for cloning and fetching Git repositories on this Azure DevOps instance.
+	//
+	// If "http", Sourcegraph will access Azure DevOps repositories using Git URLs of the form https://dev.azure.com/myorg/myproject/_git/myrepo.
+	//
+	// If "ssh", Sourcegraph will access Azure DevOps repositories using Git URLs of the form git@ssh.dev.azure.com:v3/myorg/myproject/myrepo. See the documentation for how to provide SSH private keys and known_hosts: https://docs.sourcegraph.com/admin/repo/auth#repositories-that-need-http-s-or-ssh-authentication.
+	GitURLType string `json:"gitURLType,omitempty"`
+	// Orgs description: An array of organization names identifying Azure DevOps organizations whose repositories should be mirrored on Sourcegraph.
+	Orgs []string `json:"orgs,omitempty"`
+	// Projects description: An array of projects ("org/project") whose repositories should be mirrored on Sourcegraph.
+	Projects []string `json:"projects,omitempty"`
+	// RateLimit description: Rate limit applied when making background API requests to Azure DevOps.
+	RateLimit *AzureDevOpsRateLimit `json:"rateLimit,omitempty"`
+	// RepositoryPathPattern description: The pattern used to generate the corresponding Sourcegraph repository name for an Azure DevOps repository.
+	//
+	//  - "{host}" is replaced with the Azure DevOps URL's host (such as dev.azure.com), "{org}" with the organization name, "{project}" with the project name and "{repo}" with the repository name.
+	//
+	// For example, if your Azure DevOps is https://dev.azure.com and your Sourcegraph is https://src.example.com, then a repositoryPathPattern of "{host}/{org}/{project}/{repo}" would mean that an Azure DevOps repository at https://dev.azure.com/myorg/myproject/_git/myrepo is available on Sourcegraph at https://src.example.com/dev.azure.com/myorg/myproject/myrepo.
+	//
+	// It is important that the Sourcegraph repository name generated with this pattern be unique to this code host. If different code hosts generate repository names that collide, Sourcegraph's behavior is undefined.
+	RepositoryPathPattern string `json:"repositoryPathPattern,omitempty"`
+	// Token description: A personal access token with the Code (Read) scope, and additionally the Code (Read & write) scope if batch changes are used, the Project and Team (Read) scope if "projects" are used, and the Member Entitlement Management (Read) and Identity (Read) scopes if "enforcePermissions" is enabled.
+	Token string `json:"token"`
+	// Url description: URL of Azure DevOps, such as https://dev.azure.com. Generally, admin should not modify the value of this option because Azure DevOps Services is a hosted platform.
+	Url string `json:"url"`
+	// Username description: The username of the account owning the personal access token. Also set the corresponding "token" field.
+	Username string `json:"username"`
+	// WebhookSecret description: A shared secret used to authenticate incoming service hooks (minimum 12 characters). Configure it as the basic authentication password of the Azure DevOps service hook subscription.
+	WebhookSecret string `json:"webhookSecret,omitempty"`
+}
+
+// AzureDevOpsRateLimit description: Rate limit applied when making background API requests to Azure DevOps.
+type AzureDevOpsRateLimit struct {
+	// Enabled description: true if rate limiting is enabled.
+	Enabled bool `json:"enabled"`
+	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 500, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 500 requests immediately, provided that the complexity cost of each request is 1.
+	RequestsPerHour float64 `json:"requestsPerHour"`
+}
 type BackendInsight struct {
 	// Description description: The description of this insight
 	Description string          `json:"description,omitempty"`
@@ -491,6 +536,7 @@ type EncryptionKey struct {
 	Cloudkms *CloudKMSEncryptionKey
 	Awskms   *AWSKMSEncryptionKey
 	Mounted  *MountedEncryptionKey
+	Vault    *VaultEncryptionKey
 	Noop     *NoOpEncryptionKey
 }
 
@@ -504,6 +550,9 @@ func (v EncryptionKey) MarshalJSON() ([]byte, error) {
 	if v.Mounted != nil {
 		return json.Marshal(v.Mounted)
 	}
+	if v.Vault != nil {
+		return json.Marshal(v.Vault)
+	}
 	if v.Noop != nil {
 		return json.Marshal(v.Noop)
 	}
@@ -525,15 +574,18 @@ func (v *EncryptionKey) UnmarshalJSON(data []byte) error {
 		return json.Unmarshal(data, &v.Mounted)
 	case "noop":
 		return json.Unmarshal(data, &v.Noop)
+	case "vault":
+		return json.Unmarshal(data, &v.Vault)
 	}
-	return fmt.Errorf("tagged union type must have a %q property whose value is one of %s", "type", []string{"cloudkms", "awskms", "mounted", "noop"})
+	return fmt.Errorf("tagged union type must have a %q property whose value is one of %s", "type", []string{"cloudkms", "awskms", "mounted", "vault", "noop"})
 }
 
 // EncryptionKeys description: Configuration for encryption keys used to encrypt data at rest in the database.
 type EncryptionKeys struct {
 	BatchChangesCredentialKey *EncryptionKey `json:"batchChangesCredentialKey,omitempty"`
 	// CacheSize description: number of values to keep in LRU cache
-	CacheSize int `json:"cacheSize,omitempty"`
+	CacheSize             int            `json:"cacheSize,omitempty"`
+	CodeMonitorWebhookKey *EncryptionKey `json:"codeMonitorWebhookKey,omitempty"`
 	// EnableCache description: enable LRU cache for decryption APIs
 	EnableCache            bool           `json:"enableCache,omitempty"`
 	ExecutorSecretKey      *EncryptionKey `json:"executorSecretKey,omitempty"`
@@ -548,6 +600,14 @@ type ExcludedAWSCodeCommitRepo struct {
 	// Name description: The name of an AWS CodeCommit repository ("repo-name") to exclude from mirroring.
 	Name string `json:"name,omitempty"`
 }
+type ExcludedAzureDevOpsRepo struct {
+	// Id description: The ID of an Azure DevOps repository (as returned by the Azure DevOps API) to exclude from mirroring.
+	Id string `json:"id,omitempty"`
+	// Name description: The name of an Azure DevOps repository ("org/project/repo") to exclude from mirroring.
+	Name string `json:"name,omitempty"`
+	// Pattern description: Regular expression which matches against the name of an Azure DevOps repository ("org/project/repo").
+	Pattern string `json:"pattern,omitempty"`
+}
 type ExcludedBitbucketCloudRepo struct {
 	// Name description: The name of a Bitbucket Cloud repo ("myorg/myrepo") to exclude from mirroring.
 	Name string `json:"name,omitempty"`
@@ -645,12 +705,16 @@ type ExperimentalFeatures struct {
 	JvmPackages string `json:"jvmPackages,omitempty"`
 	// NpmPackages description: Allow adding npm package code host connections
 	NpmPackages string `json:"npmPackages,omitempty"`
+	// NugetPackages description: Allow adding NuGet package host connections
+	NugetPackages string `json:"nugetPackages,omitempty"`
 	// Pagure description: Allow adding Pagure code host connections
 	Pagure string `json:"pagure,omitempty"`
 	// PasswordPolicy description: DEPRECATED: this is now a standard feature see: auth.passwordPolicy
 	PasswordPolicy *PasswordPolicy `json:"passwordPolicy,omitempty"`
 	// Perforce description: Allow adding Perforce code host connections
 	Perforce string `json:"perforce,omitempty"`
+	// PhpPackages description: Allow adding PHP package host connections
+	PhpPackages string `json:"phpPackages,omitempty"`
 	// PythonPackages description: Allow adding Python package code host connections
 	PythonPackages string `json:"pythonPackages,omitempty"`
 	// Ranking description: Experimental search result ranking options.
@@ -1307,6 +1371,24 @@ type NpmRateLimit struct {
 	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 100, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 100 requests immediately, provided that the complexity cost of each request is 1.
 	RequestsPerHour float64 `json:"requestsPerHour"`
 }
+
+// NuGetPackagesConnection description: Configuration for a connection to NuGet packages
+type NuGetPackagesConnection struct {
+	// Dependencies description: An array of strings specifying NuGet packages to mirror in Sourcegraph.
+	Dependencies []string `json:"dependencies,omitempty"`
+	// RateLimit description: Rate limit applied when making background API requests to the configured NuGet feed.
+	RateLimit *NuGetRateLimit `json:"rateLimit,omitempty"`
+	// Repository description: The URL of the package content (flat container) resource of the NuGet V3 feed from which packages are downloaded.
+	Repository string `json:"repository,omitempty"`
+}
+
+// NuGetRateLimit description: Rate limit applied when making background API requests to the configured NuGet feed.
+type NuGetRateLimit struct {
+	// Enabled description: true if rate limiting is enabled.
+	Enabled bool `json:"enabled"`
+	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 100, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 100 requests immediately, provided that the complexity cost of each request is 1.
+	RequestsPerHour float64 `json:"requestsPerHour"`
+}
 type OAuthIdentity struct {
 	Type string `json:"type"`
 }
@@ -1534,6 +1616,24 @@ type PhabricatorConnection struct {
 	Url string `json:"url,omitempty"`
 }
 
+// PhpPackagesConnection description: Configuration for a connection to PHP packages
+type PhpPackagesConnection struct {
+	// Dependencies description: An array of strings specifying PHP packages to mirror in Sourcegraph.
+	Dependencies []string `json:"dependencies,omitempty"`
+	// RateLimit description: Rate limit applied when making background API requests to the configured Composer repository.
+	RateLimit *PhpRateLimit `json:"rateLimit,omitempty"`
+	// Repository description: The URL of the Composer repository, such as Packagist, from which package metadata is fetched.
+	Repository string `json:"repository,omitempty"`
+}
+
+// PhpRateLimit description: Rate limit applied when making background API requests to the configured Composer repository.
+type PhpRateLimit struct {
+	// Enabled description: true if rate limiting is enabled.
+	Enabled bool `json:"enabled"`
+	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 100, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 100 requests immediately, provided that the complexity cost of each request is 1.
+	RequestsPerHour float64 `json:"requestsPerHour"`
+}
+
 // PythonPackagesConnection description: Configuration for a connection to Python simple repository APIs compatible with PEP 503
 type PythonPackagesConnection struct {
 	// Dependencies description: An array of strings specifying Python packages to mirror in Sourcegraph.
@@ -2328,6 +2428,23 @@ type UsernameIdentity struct {
 	Type string `json:"type"`
 }
 
+// VaultEncryptionKey description: HashiCorp Vault Transit Encryption Key, used to encrypt data with a key managed by the Vault transit secrets engine. Data encrypted with a previous version of the key is re-encrypted with the latest version after the key is rotated.
+type VaultEncryptionKey struct {
+	// Address description: The address of the Vault server.
+	Address string `json:"address"`
+	// KeyName description: The name of the transit key.
+	KeyName string `json:"keyName"`
+	// MountPath description: The path the transit secrets engine is mounted at.
+	MountPath string `json:"mountPath,omitempty"`
+	// Namespace description: The Vault Enterprise namespace containing the transit secrets engine.
+	Namespace string `json:"namespace,omitempty"`
+	// TokenEnvVarName description: The name of an environment variable containing the Vault token. Exactly one of tokenEnvVarName and tokenFilepath must be set.
+	TokenEnvVarName string `json:"tokenEnvVarName,omitempty"`
+	// TokenFilepath description: The path to a file containing the Vault token. The file is read before every request, so that tokens renewed by a Vault agent are picked up. Exactly one of tokenEnvVarName and tokenFilepath must be set.
+	TokenFilepath string `json:"tokenFilepath,omitempty"`
+	Type          string `json:"type"`
+}
+
 // VersionContext description: Configuration of the version context
 type VersionContext struct {
 	// Description description: Description of the version context